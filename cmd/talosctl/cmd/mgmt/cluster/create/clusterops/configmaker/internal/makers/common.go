@@ -20,6 +20,7 @@ import (
 	sideronet "github.com/siderolabs/net"
 
 	"github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster/create/clusterops"
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster/internal/clusterspec"
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/mgmt/helpers"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	"github.com/siderolabs/talos/pkg/machinery/config"
@@ -81,6 +82,9 @@ type Maker[ExtraOps any] struct {
 	// express (e.g. a unique BGP loopback per node in full-CLOS).
 	PerNodePatches map[int][]configpatcher.Patch
 
+	// NodePools holds the spec pool of each node (keyed by node index), if the cluster is created from a spec.
+	NodePools map[int]*clusterspec.Pool
+
 	EOps ExtraOps
 
 	extraOptionsProvider ExtraOptionsProvider
@@ -144,6 +148,12 @@ func (m *Maker[T]) InitCommon() error {
 		m.WithOmni = true
 	}
 
+	// node counts are defined by the spec pools
+	if m.Ops.Spec != nil {
+		m.Ops.Controlplanes = m.Ops.Spec.ControlPlanes()
+		m.Ops.Workers = m.Ops.Spec.Workers()
+	}
+
 	if err := m.initVersionContract(); err != nil {
 		return err
 	}
@@ -490,6 +500,10 @@ func (m *Maker[T]) initGenOps() error {
 		genOptions = append(genOptions, generate.WithSkipUnattendedInstallConfig(true))
 	}
 
+	if m.Ops.SecretsBundle != nil {
+		genOptions = append(genOptions, generate.WithSecretsBundle(m.Ops.SecretsBundle))
+	}
+
 	m.GenOps = genOptions
 
 	return nil
@@ -526,6 +540,10 @@ func getConfigPatchBundleOps(cOps clusterops.Common) ([]bundle.Option, error) {
 }
 
 func (m *Maker[T]) initIPs() error {
+	if m.Ops.Spec != nil {
+		return m.initPoolIPs()
+	}
+
 	nodes := m.Ops.Controlplanes + m.Ops.Workers
 	ips := make([][]netip.Addr, len(m.Cidrs))
 
@@ -545,6 +563,66 @@ func (m *Maker[T]) initIPs() error {
 	return nil
 }
 
+// initPoolIPs keeps the addresses of the running nodes, and assigns the lowest free addresses to the new nodes.
+func (m *Maker[T]) initPoolIPs() error {
+	nodes := m.Ops.Spec.Nodes(m.Ops.RootOps.ClusterName)
+	ips := make([][]netip.Addr, len(m.Cidrs))
+
+	for cidrIndex, cidr := range m.Cidrs {
+		assigned := make([]netip.Addr, len(nodes))
+		used := map[netip.Addr]struct{}{}
+
+		for i, node := range nodes {
+			existing, ok := m.existingNode(node.Name)
+			if !ok {
+				continue
+			}
+
+			for _, ip := range existing.IPs {
+				if cidr.Contains(ip) {
+					assigned[i] = ip
+					used[ip] = struct{}{}
+
+					break
+				}
+			}
+		}
+
+		offset := nodesOffset
+
+		for i := range assigned {
+			for !assigned[i].IsValid() {
+				ip, err := sideronet.NthIPInNetwork(cidr, offset)
+				if err != nil {
+					return err
+				}
+
+				offset++
+
+				if _, ok := used[ip]; !ok {
+					assigned[i] = ip
+					used[ip] = struct{}{}
+				}
+			}
+		}
+
+		ips[cidrIndex] = assigned
+	}
+
+	m.IPs = ips
+
+	return nil
+}
+
+func (m *Maker[T]) existingNode(name string) (provision.NodeInfo, bool) {
+	idx := slices.IndexFunc(m.Ops.ExistingNodes, func(node provision.NodeInfo) bool { return node.Name == name })
+	if idx == -1 {
+		return provision.NodeInfo{}, false
+	}
+
+	return m.Ops.ExistingNodes[idx], true
+}
+
 func parseCPUShare(cpus string) (int64, error) {
 	cpu, ok := new(big.Rat).SetString(cpus)
 	if !ok {
@@ -637,6 +715,10 @@ func (m *Maker[T]) initNodeRequests() error {
 		return fmt.Errorf("error parsing worker resources: %s", err)
 	}
 
+	if m.Ops.Spec != nil {
+		return m.initPoolNodeRequests(controlplaneResources, workerResources)
+	}
+
 	if m.Ops.Controlplanes < 1 {
 		return errors.New("number of controlplanes can't be less than 1")
 	}
@@ -695,6 +777,101 @@ func (m *Maker[T]) initNodeRequests() error {
 	return nil
 }
 
+// initPoolNodeRequests creates the node requests for the node pools of the spec.
+//
+//nolint:gocyclo
+func (m *Maker[T]) initPoolNodeRequests(controlplaneResources, workerResources clusterops.ParsedNodeResources) error {
+	if m.Ops.WithUUIDHostnames {
+		return errors.New("UUID hostnames can't be used with the cluster spec")
+	}
+
+	specNodes := m.Ops.Spec.Nodes(m.Ops.RootOps.ClusterName)
+	nodes := make([]provision.NodeRequest, 0, len(specNodes))
+
+	m.NodePools = make(map[int]*clusterspec.Pool, len(specNodes))
+
+	if m.PerNodePatches == nil {
+		m.PerNodePatches = map[int][]configpatcher.Patch{}
+	}
+
+	poolPatches := map[string][]configpatcher.Patch{}
+
+	for i, specNode := range specNodes {
+		pool := specNode.Pool
+
+		machineType := machine.TypeWorker
+		resources := workerResources
+
+		if pool.Role == clusterspec.RoleControlPlane {
+			machineType = machine.TypeControlPlane
+			resources = controlplaneResources
+
+			// the init node can't be added to the running cluster
+			if m.Ops.WithInitNode && i == 0 && len(m.Ops.ExistingNodes) == 0 {
+				machineType = machine.TypeInit
+			}
+		}
+
+		if pool.CPUs != "" {
+			nanoCPUs, err := parseCPUShare(pool.CPUs)
+			if err != nil {
+				return fmt.Errorf("error parsing cpus of pool %q: %w", pool.Name, err)
+			}
+
+			resources.NanoCPUs = nanoCPUs
+		}
+
+		if pool.Memory != "" {
+			memory, err := clusterspec.ParseSize(pool.Memory)
+			if err != nil {
+				return fmt.Errorf("error parsing memory of pool %q: %w", pool.Name, err)
+			}
+
+			resources.Memory = memory
+		}
+
+		// keep the UUID of the running node
+		nodeUUID := uuid.New()
+		if existing, ok := m.existingNode(specNode.Name); ok && existing.UUID != uuid.Nil {
+			nodeUUID = existing.UUID
+		}
+
+		nodes = append(nodes, provision.NodeRequest{
+			Name:                specNode.Name,
+			IPs:                 getNodeIPs(m.IPs, i),
+			Type:                machineType,
+			Memory:              int64(resources.Memory.Bytes()),
+			NanoCPUs:            resources.NanoCPUs,
+			UUID:                new(nodeUUID),
+			SkipInjectingConfig: m.Ops.SkipInjectingConfig,
+		})
+
+		m.NodePools[i] = pool
+
+		if len(pool.ConfigPatches) == 0 {
+			continue
+		}
+
+		patches, ok := poolPatches[pool.Name]
+		if !ok {
+			var err error
+
+			patches, err = configpatcher.LoadPatches(pool.ConfigPatches)
+			if err != nil {
+				return fmt.Errorf("error parsing config patches of pool %q: %w", pool.Name, err)
+			}
+
+			poolPatches[pool.Name] = patches
+		}
+
+		m.PerNodePatches[i] = slices.Concat(m.PerNodePatches[i], patches)
+	}
+
+	m.ClusterRequest.Nodes = nodes
+
+	return nil
+}
+
 func getNodeIPs(ips [][]netip.Addr, nodeIndex int) []netip.Addr {
 	return xslices.Map(ips, func(ips []netip.Addr) netip.Addr {
 		return ips[nodeIndex]
@@ -78,6 +78,10 @@ func (m *Docker) ModifyClusterRequest() error {
 func (m *Docker) ModifyNodes() error {
 	m.ForEachNode(func(i int, node *provision.NodeRequest) {
 		node.Mounts = m.EOps.MountOpts.Value()
+
+		if pool := m.NodePools[i]; pool != nil {
+			node.Image = pool.Image
+		}
 	})
 
 	return nil
@@ -21,6 +21,7 @@ import (
 	"go.yaml.in/yaml/v4"
 
 	"github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster/create/clusterops"
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster/internal/clusterspec"
 	"github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster/internal/firewallpatch"
 	"github.com/siderolabs/talos/pkg/machinery/cel"
 	"github.com/siderolabs/talos/pkg/machinery/cel/celenv"
@@ -383,6 +384,12 @@ func (m *Qemu) ModifyNodes() error {
 		node.SkipInjectingConfig = m.Ops.SkipInjectingConfig
 		node.BadRTC = m.EOps.BadRTC
 		node.ExtraKernelArgs = extraKernelArgs
+
+		if pool := m.NodePools[i]; pool != nil {
+			if err := m.modifyPoolNode(i, node, pool); err != nil {
+				return err
+			}
+		}
 	}
 
 	m.ClusterRequest.SiderolinkRequest = m.SideroLinkBuilder.SiderolinkRequest()
@@ -390,6 +397,46 @@ func (m *Qemu) ModifyNodes() error {
 	return nil
 }
 
+// modifyPoolNode applies the NICs, firmware and boot image of the spec pool to the node.
+func (m *Qemu) modifyPoolNode(i int, node *provision.NodeRequest, pool *clusterspec.Pool) error {
+	node.ExtraNICs = max(pool.NICs-1, 0)
+	node.UEFI = pool.Firmware.UEFI
+	node.TPM = pool.Firmware.TPM
+	node.ISOPath = pool.Image
+
+	if !pool.Firmware.SecureBoot {
+		return nil
+	}
+
+	node.UEFI = new(true)
+
+	if node.TPM == "" {
+		node.TPM = clusterspec.TPM2
+	}
+
+	if node.ISOPath == "" {
+		node.ISOPath = m.EOps.SecureBootISOPath
+	}
+
+	if node.ISOPath == "" {
+		return fmt.Errorf("pool %q: secure boot requires either the pool image or the Image Factory", pool.Name)
+	}
+
+	if m.EOps.SecureBootInstallImage == "" {
+		return nil
+	}
+
+	// secure boot nodes should be upgraded with the secure boot installer
+	patch, err := configpatcher.LoadPatch(fmt.Appendf(nil, "machine:\n  install:\n    image: %s\n", m.EOps.SecureBootInstallImage))
+	if err != nil {
+		return err
+	}
+
+	m.PerNodePatches[i] = append(m.PerNodePatches[i], patch)
+
+	return nil
+}
+
 func (m *Qemu) addDiskEncryptionPatches() error {
 	var diskEncryptionPatches []configpatcher.Patch
 
@@ -536,7 +583,19 @@ func (m *Qemu) initDisks() error {
 		})
 	}
 
+	// spec pools with disks replace both primary and extra disks
+	poolDisks, err := m.getPoolDisks()
+	if err != nil {
+		return err
+	}
+
 	m.ForEachNode(func(i int, node *provision.NodeRequest) {
+		if disks, ok := poolDisks[i]; ok {
+			node.Disks = slices.Concat(node.Disks, disks)
+
+			return
+		}
+
 		node.Disks = slices.Concat(node.Disks, primaryDisks)
 	})
 
@@ -545,6 +604,10 @@ func (m *Qemu) initDisks() error {
 	}
 
 	m.ForEachNode(func(i int, node *provision.NodeRequest) {
+		if _, ok := poolDisks[i]; ok {
+			return
+		}
+
 		if node.Type == machine.TypeWorker || m.EOps.ExtraDisksOnControlplanes {
 			node.Disks = slices.Concat(node.Disks, extraDisks)
 		}
@@ -553,6 +616,39 @@ func (m *Qemu) initDisks() error {
 	return nil
 }
 
+// getPoolDisks returns the disks of the nodes in the spec pools which override the disks.
+func (m *Qemu) getPoolDisks() (map[int][]*provision.Disk, error) {
+	poolDisks := map[int][]*provision.Disk{}
+
+	for i, pool := range m.NodePools {
+		if len(pool.Disks) == 0 {
+			continue
+		}
+
+		disks := make([]*provision.Disk, 0, len(pool.Disks))
+
+		for _, disk := range pool.Disks {
+			size, err := clusterspec.ParseSize(disk.Size)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing disk size of pool %q: %w", pool.Name, err)
+			}
+
+			disks = append(disks, &provision.Disk{
+				Size:            size.Bytes(),
+				SkipPreallocate: !m.EOps.PreallocateDisks,
+				Driver:          disk.Driver,
+				BlockSize:       m.EOps.DiskBlockSize,
+				Tag:             disk.Tag,
+				Serial:          disk.Serial,
+			})
+		}
+
+		poolDisks[i] = disks
+	}
+
+	return poolDisks, nil
+}
+
 //nolint:gocyclo
 func (m *Qemu) initExtraDisks() error {
 	const GPTAlignment = 2 * 1024 * 1024 // 2 MB
@@ -818,7 +914,7 @@ func (m *Qemu) initBGPCLOS() error {
 			return err
 		}
 
-		m.PerNodePatches[i] = []configpatcher.Patch{configpatcher.NewStrategicMergePatch(ctr)}
+		m.PerNodePatches[i] = append(m.PerNodePatches[i], configpatcher.NewStrategicMergePatch(ctr))
 	}
 
 	// flannel auto-detects its VXLAN egress interface from the default route, but the full-CLOS default is
@@ -4,7 +4,11 @@
 
 package preset
 
-import "github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster/create/clusterops"
+import (
+	"fmt"
+
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster/create/clusterops"
+)
 
 // ISOSecureBoot configures Talos to boot from a disk image from the Image Factory.
 type ISOSecureBoot struct{}
@@ -32,3 +36,19 @@ func (ISOSecureBoot) ModifyOptions(presetOps Options, cOps *clusterops.Common, q
 
 	return nil
 }
+
+// SecureBootAssets returns the secure boot ISO URL and installer image from the Image Factory.
+//
+// They are used by the cluster spec node pools with secure boot enabled, independent of the selected presets.
+func SecureBootAssets(presetOps Options, cOps *clusterops.Common, qOps *clusterops.Qemu) (isoURL, installImage string, err error) {
+	presetOps.secureBoot = true
+
+	isoURL, err = getISOURL(presetOps, cOps, qOps)
+	if err != nil {
+		return "", "", err
+	}
+
+	installImage = fmt.Sprintf("%s/%s/%s:%s", presetOps.ImageFactoryURL.Host, "metal-installer"+secureBootSuffix, presetOps.SchematicID, cOps.TalosVersion)
+
+	return isoURL, installImage, nil
+}
@@ -116,6 +116,25 @@ func TestSecureboot(t *testing.T) {
 	require.Equal(t, "factory.talos.dev/metal-installer-secureboot/123schematic123:v9.9.9", qOps.NodeInstallImage)
 }
 
+func TestSecureBootAssets(t *testing.T) {
+	imageFactoryURL, err := url.Parse(constants.ImageFactoryURL)
+	require.NoError(t, err)
+
+	cOps, qOps := applyPreset(t, preset.ISO{}.Name())
+
+	isoURL, installImage, err := preset.SecureBootAssets(preset.Options{
+		SchematicID:     "123schematic123",
+		ImageFactoryURL: imageFactoryURL,
+	}, &cOps, &qOps)
+	require.NoError(t, err)
+
+	require.Equal(t, "https://factory.talos.dev/image/123schematic123/v9.9.9/metal-arm64-secureboot.iso", isoURL)
+	require.Equal(t, "factory.talos.dev/metal-installer-secureboot/123schematic123:v9.9.9", installImage)
+
+	// the cluster-wide boot assets are not affected
+	require.Equal(t, "https://factory.talos.dev/image/123schematic123/v9.9.9/metal-arm64.iso", qOps.NodeISOPath)
+}
+
 func TestDiskImage(t *testing.T) {
 	_, qOps := applyPreset(t, preset.DiskImage{}.Name())
 
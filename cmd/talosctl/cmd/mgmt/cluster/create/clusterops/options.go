@@ -14,11 +14,13 @@ import (
 
 	clustercmd "github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster"
 	"github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster/create/flags"
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster/internal/clusterspec"
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/mgmt/helpers"
 	"github.com/siderolabs/talos/pkg/bytesize"
 	"github.com/siderolabs/talos/pkg/cli"
 	"github.com/siderolabs/talos/pkg/images"
 	"github.com/siderolabs/talos/pkg/machinery/config/bundle"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/version"
 	"github.com/siderolabs/talos/pkg/provision"
@@ -85,6 +87,15 @@ type Common struct {
 	WithUUIDHostnames           bool
 	NetworkIPv6                 bool
 	OmniAPIEndpoint             string
+
+	// SpecPath is the path to the declarative cluster spec.
+	SpecPath string
+	// Spec is the declarative cluster spec, if set it defines the node pools of the cluster.
+	Spec *clusterspec.Cluster
+	// ExistingNodes are the nodes of the running cluster which is scaled with the spec.
+	ExistingNodes []provision.NodeInfo
+	// SecretsBundle overrides the generated cluster secrets, used to add nodes to the running cluster.
+	SecretsBundle *secrets.Bundle
 }
 
 // Docker are options specific to docker provisioner.
@@ -152,6 +163,10 @@ type Qemu struct {
 	ImageCacheTLSKeyFile      string
 	ImageCachePort            uint16
 
+	// SecureBootISOPath and SecureBootInstallImage are used for the spec node pools with secure boot enabled.
+	SecureBootISOPath      string
+	SecureBootInstallImage string
+
 	// DownloadHTTPAuth is a map of endpoint hosts to basic auth credentials used for
 	// HTTP boot asset downloads and for injecting CRI registry auth into generated
 	// Talos config.
@@ -8,7 +8,6 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
-	clustercmd "github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster"
 	"github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster/create/clusterops"
 	"github.com/siderolabs/talos/pkg/cli"
 	"github.com/siderolabs/talos/pkg/provision/providers"
@@ -42,6 +41,7 @@ func init() {
 
 	commonFlags := getCommonUserFacingFlags(&cOps)
 	commonFlags.StringVar(&cOps.NetworkCIDR, subnetFlag, cOps.NetworkCIDR, "Docker network subnet CIDR")
+	addSpecFlag(commonFlags, &cOps.SpecPath)
 
 	createDockerCmd := &cobra.Command{
 		Use:   "docker",
@@ -53,22 +53,17 @@ func init() {
 				return err
 			}
 
-			clusterConfigs, err := getDockerClusterRequest(cOps, dOps, provisioner)
-			if err != nil {
-				return err
-			}
-
-			cluster, err := provisioner.Create(cmd.Context(), clusterConfigs.ClusterRequest, clusterConfigs.ProvisionOptions...)
+			state, err := loadSpec(cmd.Context(), provisioner, providers.DockerProviderName, &cOps)
 			if err != nil {
 				return err
 			}
 
-			err = postCreate(cmd.Context(), cOps, cluster, clusterConfigs)
+			clusterConfigs, err := getDockerClusterRequest(cOps, dOps, provisioner)
 			if err != nil {
 				return err
 			}
 
-			return clustercmd.ShowCluster(cluster)
+			return createCluster(cmd.Context(), provisioner, cOps, clusterConfigs, state)
 		},
 	}
 
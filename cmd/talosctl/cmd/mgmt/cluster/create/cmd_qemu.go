@@ -34,6 +34,7 @@ func init() {
 	addControlplanesFlag(commonFlags, &cOps.Controlplanes)
 	addTalosVersionFlag(commonFlags, &cOps.TalosVersion, "the desired talos version")
 	commonFlags.StringVar(&cOps.NetworkCIDR, networkCIDRFlagName, "10.5.0.0/24", "CIDR of the cluster network")
+	addSpecFlag(commonFlags, &cOps.SpecPath)
 
 	getQemuFlags := func() *pflag.FlagSet {
 		qemu := pflag.NewFlagSet("qemu", pflag.PanicOnError)
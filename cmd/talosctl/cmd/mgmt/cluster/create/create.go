@@ -56,78 +56,87 @@ func downloadBootAssets(ctx context.Context, qOps *clusterops.Qemu) error {
 			disableArchive: true,
 		},
 	} {
-		if *downloadableImage.path == "" {
-			continue
+		if err := downloadBootAsset(ctx, qOps.DownloadHTTPAuth, downloadableImage.path, downloadableImage.disableArchive); err != nil {
+			return err
 		}
+	}
 
-		u, err := url.Parse(*downloadableImage.path)
-		if err != nil || !(u.Scheme == "http" || u.Scheme == "https") {
-			// not a URL
-			continue
-		}
+	return nil
+}
 
-		defaultStateDir, err := clientconfig.GetTalosDirectory()
-		if err != nil {
-			return err
-		}
+// downloadBootAsset downloads the boot asset if the path is a URL, and replaces the path with the downloaded path on the filesystem.
+func downloadBootAsset(ctx context.Context, auth map[string]clusterops.HTTPAuth, path *string, disableArchive bool) error {
+	if *path == "" {
+		return nil
+	}
 
-		cacheDir := filepath.Join(defaultStateDir, "cache")
+	u, err := url.Parse(*path)
+	if err != nil || !(u.Scheme == "http" || u.Scheme == "https") {
+		// not a URL
+		return nil
+	}
 
-		if err = os.MkdirAll(cacheDir, 0o755); err != nil {
-			return err
-		}
+	defaultStateDir, err := clientconfig.GetTalosDirectory()
+	if err != nil {
+		return err
+	}
 
-		destPath := strings.ReplaceAll(
-			strings.ReplaceAll(u.String(), "/", "-"),
-			":", "-",
-		)
+	cacheDir := filepath.Join(defaultStateDir, "cache")
 
-		_, err = os.Stat(filepath.Join(cacheDir, destPath))
-		if err == nil {
-			*downloadableImage.path = filepath.Join(cacheDir, destPath)
+	if err = os.MkdirAll(cacheDir, 0o755); err != nil {
+		return err
+	}
 
-			// already cached
-			continue
-		}
+	destPath := strings.ReplaceAll(
+		strings.ReplaceAll(u.String(), "/", "-"),
+		":", "-",
+	)
 
-		fmt.Fprintf(os.Stderr, "downloading asset from %q to %q\n", u.String(), filepath.Join(cacheDir, destPath))
+	_, err = os.Stat(filepath.Join(cacheDir, destPath))
+	if err == nil {
+		*path = filepath.Join(cacheDir, destPath)
 
-		client := getter.Client{
-			Getters: []getter.Getter{
-				&getter.HttpGetter{
-					HeadFirstTimeout: 30 * time.Minute,
-					ReadTimeout:      30 * time.Minute,
-				},
-			},
-		}
+		// already cached
+		return nil
+	}
 
-		if downloadableImage.disableArchive {
-			q := u.Query()
+	fmt.Fprintf(os.Stderr, "downloading asset from %q to %q\n", u.String(), filepath.Join(cacheDir, destPath))
 
-			q.Set("archive", "false")
+	client := getter.Client{
+		Getters: []getter.Getter{
+			&getter.HttpGetter{
+				HeadFirstTimeout: 30 * time.Minute,
+				ReadTimeout:      30 * time.Minute,
+			},
+		},
+	}
 
-			u.RawQuery = q.Encode()
-		}
+	if disableArchive {
+		q := u.Query()
 
-		if auth, ok := qOps.DownloadHTTPAuth[u.Host]; ok && u.User == nil {
-			u.User = url.UserPassword(auth.Username, auth.Password)
-		}
+		q.Set("archive", "false")
 
-		_, err = client.Get(ctx, &getter.Request{
-			Src:     u.String(),
-			Dst:     filepath.Join(cacheDir, destPath),
-			GetMode: getter.ModeFile,
-		})
-		if err != nil {
-			// clean up the destination on failure
-			os.Remove(filepath.Join(cacheDir, destPath)) //nolint:errcheck
+		u.RawQuery = q.Encode()
+	}
 
-			return err
-		}
+	if creds, ok := auth[u.Host]; ok && u.User == nil {
+		u.User = url.UserPassword(creds.Username, creds.Password)
+	}
+
+	_, err = client.Get(ctx, &getter.Request{
+		Src:     u.String(),
+		Dst:     filepath.Join(cacheDir, destPath),
+		GetMode: getter.ModeFile,
+	})
+	if err != nil {
+		// clean up the destination on failure
+		os.Remove(filepath.Join(cacheDir, destPath)) //nolint:errcheck
 
-		*downloadableImage.path = filepath.Join(cacheDir, destPath)
+		return err
 	}
 
+	*path = filepath.Join(cacheDir, destPath)
+
 	return nil
 }
 
@@ -171,6 +180,10 @@ func bootstrapCluster(ctx context.Context, clusterAccess *access.Adapter, cOps c
 		}
 	}
 
+	return waitForCluster(ctx, clusterAccess, cOps)
+}
+
+func waitForCluster(ctx context.Context, clusterAccess *access.Adapter, cOps clusterops.Common) error {
 	if !cOps.ClusterWait {
 		return nil
 	}
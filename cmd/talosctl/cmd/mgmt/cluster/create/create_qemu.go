@@ -14,7 +14,6 @@ import (
 	"strings"
 
 	"github.com/siderolabs/talos/cmd/talosctl/cmd/constants"
-	clustercmd "github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster"
 	"github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster/create/clusterops"
 	"github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster/create/clusterops/configmaker"
 	"github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster/create/clusterops/configmaker/preset"
@@ -22,6 +21,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
 	"github.com/siderolabs/talos/pkg/machinery/config/machine"
 	"github.com/siderolabs/talos/pkg/provision"
+	"github.com/siderolabs/talos/pkg/provision/providers"
 )
 
 //nolint:gocyclo,cyclop
@@ -32,11 +32,16 @@ func createQemuCluster(
 	presetOptions presetOptions,
 	provisioner provision.Provisioner,
 ) error {
+	state, err := loadSpec(ctx, provisioner, providers.QemuProviderName, &cOps)
+	if err != nil {
+		return err
+	}
+
 	if cOps.TalosVersion == "" || cOps.TalosVersion[0] != 'v' {
 		return fmt.Errorf("failed to parse talos version: version string must start with a 'v'")
 	}
 
-	_, err := config.ParseContractFromVersion(cOps.TalosVersion)
+	_, err = config.ParseContractFromVersion(cOps.TalosVersion)
 	if err != nil {
 		return fmt.Errorf("failed to parse talos version: %s", err)
 	}
@@ -59,12 +64,12 @@ func createQemuCluster(
 		fmt.Println("machine configuration containing 'SideroLinkConfig' will be written to the working path but will not be applied to the nodes")
 	}
 
-	err = preset.Apply(
-		preset.Options{
-			SchematicID:     presetOptions.schematicID,
-			ImageFactoryURL: factoryURL,
-		}, &cOps, &qOps, presetOptions.presets,
-	)
+	presetOps := preset.Options{
+		SchematicID:     presetOptions.schematicID,
+		ImageFactoryURL: factoryURL,
+	}
+
+	err = preset.Apply(presetOps, &cOps, &qOps, presetOptions.presets)
 	if err != nil {
 		return err
 	}
@@ -85,7 +90,11 @@ func createQemuCluster(
 		}
 	}
 
-	if err := downloadBootAssets(ctx, &qOps); err != nil {
+	if err = downloadBootAssets(ctx, &qOps); err != nil {
+		return err
+	}
+
+	if err = downloadSpecAssets(ctx, presetOps, &cOps, &qOps); err != nil {
 		return err
 	}
 
@@ -103,17 +112,7 @@ func createQemuCluster(
 		return err
 	}
 
-	cluster, err := provisioner.Create(ctx, clusterConfigs.ClusterRequest, clusterConfigs.ProvisionOptions...)
-	if err != nil {
-		return err
-	}
-
-	err = postCreate(ctx, cOps, cluster, clusterConfigs)
-	if err != nil {
-		return err
-	}
-
-	return clustercmd.ShowCluster(cluster)
+	return createCluster(ctx, provisioner, cOps, clusterConfigs, state)
 }
 
 func preCreate(cOps clusterops.Common, clusterConfigs clusterops.ClusterConfigs) error {
@@ -0,0 +1,336 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package create
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/siderolabs/gen/xslices"
+	"github.com/spf13/pflag"
+	"go.yaml.in/yaml/v4"
+
+	clustercmd "github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster"
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster/create/clusterops"
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster/create/clusterops/configmaker/preset"
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster/internal/clusterspec"
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+	"github.com/siderolabs/talos/pkg/provision"
+	"github.com/siderolabs/talos/pkg/provision/access"
+)
+
+const (
+	specFlagName = "spec"
+
+	// secretsFileName is the name of the file in the cluster state directory which stores the cluster secrets,
+	// they are used to generate the machine configuration of the nodes added to the running cluster.
+	secretsFileName = "secrets.yaml"
+)
+
+func addSpecFlag(flagset *pflag.FlagSet, bind *string) {
+	flagset.StringVar(bind, specFlagName, *bind,
+		"path to the declarative cluster spec with the node pools, re-run with the edited spec to scale the running cluster")
+}
+
+// specState is the state of the cluster created from the spec.
+type specState struct {
+	// spec is stored in the cluster state directory as it was loaded, before the assets are downloaded.
+	spec      *clusterspec.Cluster
+	statePath string
+	// existing is the running cluster, nil if the cluster is created.
+	existing provision.Cluster
+}
+
+// loadSpec loads the cluster spec and applies it to the options.
+//
+// If the cluster already exists, its secrets and nodes are loaded, so that the cluster is scaled to match the spec.
+//
+//nolint:gocyclo
+func loadSpec(ctx context.Context, provisioner provision.Provisioner, providerName string, cOps *clusterops.Common) (*specState, error) {
+	if cOps.SpecPath == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	spec, err := clusterspec.Load(cOps.SpecPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = spec.Validate(providerName); err != nil {
+		return nil, fmt.Errorf("invalid cluster spec: %w", err)
+	}
+
+	if spec.Name != "" {
+		cOps.RootOps.ClusterName = spec.Name
+	} else {
+		spec.Name = cOps.RootOps.ClusterName
+	}
+
+	spec.Provider = providerName
+
+	if spec.TalosVersion != "" {
+		cOps.TalosVersion = spec.TalosVersion
+	}
+
+	if spec.KubernetesVersion != "" {
+		cOps.KubernetesVersion = spec.KubernetesVersion
+	}
+
+	if spec.Network.CIDR != "" {
+		cOps.NetworkCIDR = spec.Network.CIDR
+	}
+
+	if spec.Network.MTU != 0 {
+		cOps.NetworkMTU = spec.Network.MTU
+	}
+
+	cOps.ConfigPatch = append(cOps.ConfigPatch, spec.ConfigPatches...)
+	cOps.Spec = spec
+
+	data, err := spec.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	state := &specState{
+		statePath: filepath.Join(cOps.RootOps.StateDir, cOps.RootOps.ClusterName),
+	}
+
+	// keep a copy, as the pool images are replaced with the downloaded paths
+	if state.spec, err = clusterspec.Parse(data); err != nil {
+		return nil, err
+	}
+
+	if _, err = os.Stat(state.statePath); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return state, nil
+		}
+
+		return nil, err
+	}
+
+	state.existing, err = provisioner.Reflect(ctx, cOps.RootOps.ClusterName, cOps.RootOps.StateDir)
+	if err != nil {
+		return nil, fmt.Errorf("error inspecting the existing cluster %q: %w", cOps.RootOps.ClusterName, err)
+	}
+
+	secretsBundle, err := secrets.LoadBundle(filepath.Join(state.statePath, secretsFileName))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("cluster %q already exists and was not created from a spec, destroy it first", cOps.RootOps.ClusterName)
+		}
+
+		return nil, fmt.Errorf("error loading cluster secrets: %w", err)
+	}
+
+	previous, err := clusterspec.LoadFromState(state.statePath)
+	if err != nil {
+		return nil, fmt.Errorf("error loading the cluster spec: %w", err)
+	}
+
+	if err = state.spec.ValidateScale(previous); err != nil {
+		return nil, fmt.Errorf("invalid cluster spec: %w", err)
+	}
+
+	cOps.SecretsBundle = secretsBundle
+	cOps.ExistingNodes = state.existing.Info().Nodes
+
+	return state, nil
+}
+
+// downloadSpecAssets downloads the boot assets of the spec node pools.
+func downloadSpecAssets(ctx context.Context, presetOps preset.Options, cOps *clusterops.Common, qOps *clusterops.Qemu) error {
+	if cOps.Spec == nil {
+		return nil
+	}
+
+	if slices.ContainsFunc(cOps.Spec.Pools, func(pool clusterspec.Pool) bool { return pool.Firmware.SecureBoot }) {
+		var err error
+
+		qOps.SecureBootISOPath, qOps.SecureBootInstallImage, err = preset.SecureBootAssets(presetOps, cOps, qOps)
+		if err != nil {
+			return err
+		}
+
+		if err = downloadBootAsset(ctx, qOps.DownloadHTTPAuth, &qOps.SecureBootISOPath, false); err != nil {
+			return err
+		}
+	}
+
+	for i := range cOps.Spec.Pools {
+		if err := downloadBootAsset(ctx, qOps.DownloadHTTPAuth, &cOps.Spec.Pools[i].Image, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createCluster creates the cluster, or scales the existing cluster created from the spec.
+func createCluster(
+	ctx context.Context,
+	provisioner provision.Provisioner,
+	cOps clusterops.Common,
+	clusterConfigs clusterops.ClusterConfigs,
+	state *specState,
+) error {
+	if state != nil && state.existing != nil {
+		cluster, err := scaleCluster(ctx, provisioner, cOps, clusterConfigs, state)
+		if err != nil {
+			return err
+		}
+
+		return clustercmd.ShowCluster(cluster)
+	}
+
+	cluster, err := provisioner.Create(ctx, clusterConfigs.ClusterRequest, clusterConfigs.ProvisionOptions...)
+	if err != nil {
+		return err
+	}
+
+	if state != nil {
+		if err = state.save(clusterConfigs); err != nil {
+			return err
+		}
+	}
+
+	err = postCreate(ctx, cOps, cluster, clusterConfigs)
+	if err != nil {
+		return err
+	}
+
+	return clustercmd.ShowCluster(cluster)
+}
+
+// scaleCluster adds and removes the nodes of the running cluster to match the spec.
+func scaleCluster(
+	ctx context.Context,
+	provisioner provision.Provisioner,
+	cOps clusterops.Common,
+	clusterConfigs clusterops.ClusterConfigs,
+	state *specState,
+) (provision.Cluster, error) {
+	scaler, ok := provisioner.(provision.ScaleProvisioner)
+	if !ok {
+		return nil, fmt.Errorf("provisioner %q doesn't support scaling the cluster", state.existing.Provisioner())
+	}
+
+	requested := clusterConfigs.ClusterRequest.Nodes
+	existing := state.existing.Info().Nodes
+
+	removed := xslices.Filter(existing, func(node provision.NodeInfo) bool {
+		return !slices.ContainsFunc(requested, func(nodeReq provision.NodeRequest) bool { return nodeReq.Name == node.Name })
+	})
+
+	if err := leaveEtcd(ctx, state.existing, removed, clusterConfigs.ProvisionOptions...); err != nil {
+		return nil, err
+	}
+
+	for _, node := range removed {
+		fmt.Fprintf(os.Stderr, "warning: node %q is removed, remove it from Kubernetes with 'kubectl delete node'\n", node.Name)
+	}
+
+	cluster, err := scaler.ScaleNodes(ctx, state.existing, clusterConfigs.ClusterRequest, clusterConfigs.ProvisionOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = state.save(clusterConfigs); err != nil {
+		return nil, err
+	}
+
+	added := xslices.Filter(requested, func(nodeReq provision.NodeRequest) bool {
+		return !slices.ContainsFunc(existing, func(node provision.NodeInfo) bool { return node.Name == nodeReq.Name })
+	})
+
+	if len(added) == 0 {
+		return cluster, nil
+	}
+
+	clusterAccess := access.NewAdapter(cluster, clusterConfigs.ProvisionOptions...)
+	defer clusterAccess.Close() //nolint:errcheck
+
+	if cOps.ApplyConfigEnabled {
+		fmt.Println("applying configuration to the new cluster nodes")
+
+		if err = clusterAccess.ApplyConfig(ctx, added, clusterConfigs.ClusterRequest.SiderolinkRequest, os.Stdout); err != nil {
+			return nil, err
+		}
+	}
+
+	if cOps.OmniAPIEndpoint != "" || (cOps.SkipInjectingConfig && !cOps.ApplyConfigEnabled) {
+		return cluster, nil
+	}
+
+	// kubeconfig was merged when the cluster was created
+	cOps.SkipKubeconfig = true
+
+	return cluster, waitForCluster(ctx, clusterAccess, cOps)
+}
+
+// leaveEtcd removes the control plane nodes from etcd before they are destroyed.
+//
+// The nodes leave one at a time, so that the etcd cluster keeps the quorum.
+func leaveEtcd(ctx context.Context, cluster provision.Cluster, nodes []provision.NodeInfo, opts ...provision.Option) error {
+	controlPlanes := xslices.Filter(nodes, func(node provision.NodeInfo) bool { return node.Type.IsControlPlane() })
+	if len(controlPlanes) == 0 {
+		return nil
+	}
+
+	clusterAccess := access.NewAdapter(cluster, opts...)
+	defer clusterAccess.Close() //nolint:errcheck
+
+	c, err := clusterAccess.Client()
+	if err != nil {
+		return err
+	}
+
+	defer c.Close() //nolint:errcheck
+
+	for _, node := range controlPlanes {
+		if len(node.IPs) == 0 {
+			return fmt.Errorf("control plane node %q has no IP address", node.Name)
+		}
+
+		fmt.Printf("removing control plane node %q from etcd\n", node.Name)
+
+		if err = c.EtcdLeaveCluster(client.WithNode(ctx, node.IPs[0].String()), &machineapi.EtcdLeaveClusterRequest{}); err != nil {
+			return fmt.Errorf("error removing control plane node %q from etcd: %w", node.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// save stores the spec and the cluster secrets in the cluster state directory.
+func (s *specState) save(clusterConfigs clusterops.ClusterConfigs) error {
+	if err := s.spec.SaveToState(s.statePath); err != nil {
+		return err
+	}
+
+	if s.existing != nil || clusterConfigs.ConfigBundle == nil {
+		return nil
+	}
+
+	secretsBundle, err := secrets.NewBundleFromConfig(secrets.NewFixedClock(time.Now()), clusterConfigs.ConfigBundle.ControlPlane())
+	if err != nil {
+		return fmt.Errorf("error extracting cluster secrets: %w", err)
+	}
+
+	data, err := yaml.Marshal(secretsBundle)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(s.statePath, secretsFileName), data, 0o600)
+}
@@ -0,0 +1,391 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package clusterspec implements the declarative cluster definition consumed by talosctl cluster create.
+package clusterspec
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+
+	"go.yaml.in/yaml/v4"
+
+	"github.com/siderolabs/talos/pkg/bytesize"
+)
+
+const (
+	// APIVersion is the current version of the cluster spec.
+	APIVersion = "v1alpha1"
+	// Kind is the kind of the cluster spec document.
+	Kind = "Cluster"
+)
+
+// Pool roles.
+const (
+	RoleControlPlane = "controlplane"
+	RoleWorker       = "worker"
+)
+
+// TPM versions.
+const (
+	TPMNone = "none"
+	TPM1_2  = "1.2"
+	TPM2    = "2.0"
+)
+
+// Cluster is the declarative definition of a local Talos cluster.
+type Cluster struct {
+	APIVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+
+	// Name of the cluster, defaults to the --name flag.
+	Name string `yaml:"name,omitempty"`
+	// Provider is the name of the provisioner the spec was written for (qemu, docker).
+	Provider string `yaml:"provider,omitempty"`
+
+	// TalosVersion is the version of Talos to boot (VMs only), containers use the image tag.
+	TalosVersion      string `yaml:"talosVersion,omitempty"`
+	KubernetesVersion string `yaml:"kubernetesVersion,omitempty"`
+
+	Network Network `yaml:"network,omitempty"`
+
+	// ConfigPatches are applied to all nodes of the cluster.
+	ConfigPatches []string `yaml:"configPatches,omitempty"`
+
+	Pools []Pool `yaml:"pools"`
+}
+
+// Network describes the cluster network.
+type Network struct {
+	CIDR string `yaml:"cidr,omitempty"`
+	MTU  int    `yaml:"mtu,omitempty"`
+}
+
+// Pool is a group of identical nodes.
+type Pool struct {
+	Name  string `yaml:"name"`
+	Role  string `yaml:"role"`
+	Count int    `yaml:"count"`
+
+	// CPUs is the share of CPUs as a fraction (e.g. "2.0").
+	CPUs string `yaml:"cpus,omitempty"`
+	// Memory is the memory limit (e.g. "2GiB").
+	Memory string `yaml:"memory,omitempty"`
+	// Disks override the default disks of the nodes (VMs only).
+	Disks []Disk `yaml:"disks,omitempty"`
+	// NICs is the number of network interfaces of the nodes (VMs only), defaults to 1.
+	NICs int `yaml:"nics,omitempty"`
+	// Firmware overrides the cluster-wide firmware settings (VMs only).
+	Firmware Firmware `yaml:"firmware,omitempty"`
+	// Image is the boot ISO (VMs) or the container image (containers) of the nodes.
+	Image string `yaml:"image,omitempty"`
+
+	// ConfigPatches are applied to the nodes of the pool, use @file to read a patch from file.
+	ConfigPatches []string `yaml:"configPatches,omitempty"`
+}
+
+// Disk describes a single disk of a node.
+type Disk struct {
+	Driver string `yaml:"driver,omitempty"`
+	Size   string `yaml:"size"`
+	Serial string `yaml:"serial,omitempty"`
+	Tag    string `yaml:"tag,omitempty"`
+}
+
+// Firmware describes the firmware of a node.
+type Firmware struct {
+	UEFI       *bool  `yaml:"uefi,omitempty"`
+	SecureBoot bool   `yaml:"secureBoot,omitempty"`
+	TPM        string `yaml:"tpm,omitempty"`
+}
+
+// IsZero implements yaml.IsZeroer.
+func (f Firmware) IsZero() bool {
+	return f.UEFI == nil && !f.SecureBoot && f.TPM == ""
+}
+
+// Node is a single node of the cluster as described by the spec.
+type Node struct {
+	Name string
+	Pool *Pool
+	// Index of the node in the pool.
+	Index int
+}
+
+// Load reads the cluster spec from the file.
+//
+// Relative paths of the @file config patches are resolved against the directory of the spec.
+func Load(path string) (*Cluster, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading cluster spec: %w", err)
+	}
+
+	cluster, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := filepath.Dir(path)
+
+	resolvePatchPaths(dir, cluster.ConfigPatches)
+
+	for i := range cluster.Pools {
+		resolvePatchPaths(dir, cluster.Pools[i].ConfigPatches)
+	}
+
+	return cluster, nil
+}
+
+func resolvePatchPaths(dir string, patches []string) {
+	for i, patch := range patches {
+		patchPath, ok := strings.CutPrefix(patch, "@")
+		if !ok || filepath.IsAbs(patchPath) {
+			continue
+		}
+
+		patches[i] = "@" + filepath.Join(dir, patchPath)
+	}
+}
+
+// Parse parses the cluster spec.
+func Parse(data []byte) (*Cluster, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+
+	var cluster Cluster
+
+	if err := dec.Decode(&cluster); err != nil {
+		return nil, fmt.Errorf("error parsing cluster spec: %w", err)
+	}
+
+	if cluster.APIVersion != APIVersion || cluster.Kind != Kind {
+		return nil, fmt.Errorf("unsupported cluster spec %s/%s, expected %s/%s", cluster.APIVersion, cluster.Kind, APIVersion, Kind)
+	}
+
+	return &cluster, nil
+}
+
+// Marshal encodes the cluster spec as YAML.
+func (c *Cluster) Marshal() ([]byte, error) {
+	var buf bytes.Buffer
+
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+
+	if err := enc.Encode(c); err != nil {
+		return nil, err
+	}
+
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Validate checks the cluster spec for the specified provider.
+//
+//nolint:gocyclo,cyclop
+func (c *Cluster) Validate(provider string) error {
+	var errs error
+
+	if c.Provider != "" && c.Provider != provider {
+		errs = errors.Join(errs, fmt.Errorf("cluster spec is written for provider %q, but %q is used", c.Provider, provider))
+	}
+
+	if len(c.Pools) == 0 {
+		errs = errors.Join(errs, errors.New("at least one pool is required"))
+	}
+
+	var (
+		poolNames    []string
+		controlPlane int
+	)
+
+	for _, pool := range c.Pools {
+		if pool.Name == "" {
+			errs = errors.Join(errs, errors.New("pool name is required"))
+		}
+
+		if slices.Contains(poolNames, pool.Name) {
+			errs = errors.Join(errs, fmt.Errorf("duplicate pool %q", pool.Name))
+		}
+
+		poolNames = append(poolNames, pool.Name)
+
+		switch pool.Role {
+		case RoleControlPlane:
+			controlPlane += pool.Count
+		case RoleWorker:
+		default:
+			errs = errors.Join(errs, fmt.Errorf("pool %q: role must be one of %q, %q", pool.Name, RoleControlPlane, RoleWorker))
+		}
+
+		if pool.Count < 0 {
+			errs = errors.Join(errs, fmt.Errorf("pool %q: count can't be negative", pool.Name))
+		}
+
+		if pool.CPUs != "" {
+			if _, ok := new(big.Rat).SetString(pool.CPUs); !ok {
+				errs = errors.Join(errs, fmt.Errorf("pool %q: invalid cpus %q", pool.Name, pool.CPUs))
+			}
+		}
+
+		if pool.Memory != "" {
+			if _, err := ParseSize(pool.Memory); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("pool %q: invalid memory: %w", pool.Name, err))
+			}
+		}
+
+		for i, disk := range pool.Disks {
+			if _, err := ParseSize(disk.Size); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("pool %q: disk %d: invalid size: %w", pool.Name, i, err))
+			}
+		}
+
+		if pool.NICs < 0 {
+			errs = errors.Join(errs, fmt.Errorf("pool %q: nics can't be negative", pool.Name))
+		}
+
+		switch pool.Firmware.TPM {
+		case "", TPMNone, TPM1_2, TPM2:
+		default:
+			errs = errors.Join(errs, fmt.Errorf("pool %q: tpm must be one of %q, %q, %q", pool.Name, TPMNone, TPM1_2, TPM2))
+		}
+
+		if pool.Firmware.SecureBoot && (pool.Firmware.UEFI != nil && !*pool.Firmware.UEFI) {
+			errs = errors.Join(errs, fmt.Errorf("pool %q: secure boot requires UEFI", pool.Name))
+		}
+
+		if provider == "docker" && (len(pool.Disks) > 0 || pool.NICs > 1 || !pool.Firmware.IsZero()) {
+			errs = errors.Join(errs, fmt.Errorf("pool %q: disks, nics and firmware are not supported by the docker provider", pool.Name))
+		}
+	}
+
+	if controlPlane < 1 {
+		errs = errors.Join(errs, errors.New("at least one control plane node is required"))
+	}
+
+	return errs
+}
+
+// ValidateScale checks that the running cluster created from the previous spec can be scaled to match the spec.
+//
+// Only the node counts of the existing pools can be changed, as the other settings of the pools
+// are not applied to the existing nodes.
+func (c *Cluster) ValidateScale(previous *Cluster) error {
+	var errs error
+
+	for _, pool := range c.Pools {
+		idx := slices.IndexFunc(previous.Pools, func(p Pool) bool { return p.Name == pool.Name })
+		if idx == -1 {
+			continue
+		}
+
+		if changed := pool.changedFields(previous.Pools[idx]); len(changed) > 0 {
+			errs = errors.Join(errs, fmt.Errorf("pool %q: %s can't be changed in the running cluster, only the count can be changed",
+				pool.Name, strings.Join(changed, ", ")))
+		}
+	}
+
+	return errs
+}
+
+// changedFields returns the names of the fields other than the count which differ from the previous pool.
+func (p Pool) changedFields(previous Pool) []string {
+	var changed []string
+
+	for _, field := range []struct {
+		name  string
+		equal bool
+	}{
+		{"role", p.Role == previous.Role},
+		{"cpus", p.CPUs == previous.CPUs},
+		{"memory", p.Memory == previous.Memory},
+		{"disks", slices.Equal(p.Disks, previous.Disks)},
+		{"nics", p.NICs == previous.NICs},
+		{"firmware", reflect.DeepEqual(p.Firmware, previous.Firmware)},
+		{"image", p.Image == previous.Image},
+		{"configPatches", slices.Equal(p.ConfigPatches, previous.ConfigPatches)},
+	} {
+		if !field.equal {
+			changed = append(changed, field.name)
+		}
+	}
+
+	return changed
+}
+
+// ControlPlanes returns the total number of control plane nodes.
+func (c *Cluster) ControlPlanes() int {
+	return c.count(RoleControlPlane)
+}
+
+// Workers returns the total number of worker nodes.
+func (c *Cluster) Workers() int {
+	return c.count(RoleWorker)
+}
+
+func (c *Cluster) count(role string) int {
+	var n int
+
+	for _, pool := range c.Pools {
+		if pool.Role == role {
+			n += pool.Count
+		}
+	}
+
+	return n
+}
+
+// Nodes returns the list of nodes described by the spec.
+//
+// Control plane nodes come first, followed by the workers, each group in the order of the pools.
+func (c *Cluster) Nodes(clusterName string) []Node {
+	nodes := make([]Node, 0, c.ControlPlanes()+c.Workers())
+
+	for _, role := range []string{RoleControlPlane, RoleWorker} {
+		for i := range c.Pools {
+			pool := &c.Pools[i]
+
+			if pool.Role != role {
+				continue
+			}
+
+			for idx := range pool.Count {
+				nodes = append(nodes, Node{
+					Name:  NodeName(clusterName, pool.Name, idx),
+					Pool:  pool,
+					Index: idx,
+				})
+			}
+		}
+	}
+
+	return nodes
+}
+
+// NodeName returns the name of the node with the specified index in the pool.
+func NodeName(clusterName, poolName string, index int) string {
+	return fmt.Sprintf("%s-%s-%d", clusterName, poolName, index+1)
+}
+
+// ParseSize parses the human-readable size, defaulting to MiB.
+func ParseSize(size string) (bytesize.ByteSize, error) {
+	bs := bytesize.WithDefaultUnit("MiB")
+
+	if err := bs.Set(size); err != nil {
+		return bytesize.ByteSize{}, err
+	}
+
+	return *bs, nil
+}
@@ -0,0 +1,324 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package clusterspec_test
+
+import (
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster/internal/clusterspec"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/provision"
+)
+
+const testSpec = `apiVersion: v1alpha1
+kind: Cluster
+name: hetero
+provider: qemu
+network:
+  cidr: 10.5.0.0/24
+configPatches:
+  - "@common.yaml"
+pools:
+  - name: workers-fast
+    role: worker
+    count: 2
+    disks:
+      - size: 10GiB
+      - driver: nvme
+        size: 20GiB
+        serial: fast
+  - name: cp
+    role: controlplane
+    count: 3
+    cpus: "2.0"
+    memory: 2GiB
+    firmware:
+      secureBoot: true
+      tpm: "2.0"
+  - name: workers-gpu
+    role: worker
+    count: 1
+    nics: 2
+    configPatches:
+      - |
+        machine:
+          nodeLabels:
+            gpu: "true"
+`
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	spec, err := clusterspec.Parse([]byte(testSpec))
+	require.NoError(t, err)
+
+	require.NoError(t, spec.Validate("qemu"))
+
+	assert.Equal(t, "hetero", spec.Name)
+	assert.Equal(t, 3, spec.ControlPlanes())
+	assert.Equal(t, 3, spec.Workers())
+	assert.True(t, spec.Pools[1].Firmware.SecureBoot)
+	assert.Equal(t, clusterspec.TPM2, spec.Pools[1].Firmware.TPM)
+
+	nodes := spec.Nodes("hetero")
+
+	names := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		names = append(names, node.Name)
+	}
+
+	assert.Equal(t, []string{
+		"hetero-cp-1",
+		"hetero-cp-2",
+		"hetero-cp-3",
+		"hetero-workers-fast-1",
+		"hetero-workers-fast-2",
+		"hetero-workers-gpu-1",
+	}, names)
+	assert.Equal(t, "workers-gpu", nodes[5].Pool.Name)
+
+	// round-trip
+	data, err := spec.Marshal()
+	require.NoError(t, err)
+
+	spec2, err := clusterspec.Parse(data)
+	require.NoError(t, err)
+	assert.Equal(t, spec, spec2)
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	specPath := filepath.Join(dir, "cluster.yaml")
+
+	require.NoError(t, os.WriteFile(specPath, []byte(`apiVersion: v1alpha1
+kind: Cluster
+configPatches:
+  - "@common.yaml"
+pools:
+  - name: cp
+    role: controlplane
+    count: 1
+    configPatches:
+      - "@/etc/patch.yaml"
+      - "@patches/cp.yaml"
+      - |
+        machine: {}
+`), 0o644))
+
+	spec, err := clusterspec.Load(specPath)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"@" + filepath.Join(dir, "common.yaml")}, spec.ConfigPatches)
+	assert.Equal(t, []string{"@/etc/patch.yaml", "@" + filepath.Join(dir, "patches", "cp.yaml"), "machine: {}\n"}, spec.Pools[0].ConfigPatches)
+}
+
+func TestParseErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name     string
+		spec     string
+		expected string
+	}{
+		{
+			name:     "version",
+			spec:     "apiVersion: v2\nkind: Cluster\npools: []\n",
+			expected: "unsupported cluster spec v2/Cluster",
+		},
+		{
+			name:     "unknown field",
+			spec:     "apiVersion: v1alpha1\nkind: Cluster\npools:\n  - name: cp\n    role: controlplane\n    cnt: 1\n",
+			expected: "field cnt not found",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := clusterspec.Parse([]byte(test.spec))
+			require.Error(t, err)
+			assert.ErrorContains(t, err, test.expected)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name     string
+		provider string
+		spec     clusterspec.Cluster
+		expected []string
+	}{
+		{
+			name:     "no control plane",
+			provider: "qemu",
+			spec: clusterspec.Cluster{
+				Pools: []clusterspec.Pool{
+					{Name: "cp", Role: clusterspec.RoleControlPlane},
+					{Name: "w", Role: clusterspec.RoleWorker, Count: 1},
+				},
+			},
+			expected: []string{"at least one control plane node is required"},
+		},
+		{
+			name:     "invalid pools",
+			provider: "qemu",
+			spec: clusterspec.Cluster{
+				Pools: []clusterspec.Pool{
+					{Name: "cp", Role: clusterspec.RoleControlPlane, Count: 1, Memory: "lots"},
+					{Name: "cp", Role: "etcd", Count: 1, Firmware: clusterspec.Firmware{TPM: "3.0"}},
+				},
+			},
+			expected: []string{
+				`pool "cp": invalid memory`,
+				`duplicate pool "cp"`,
+				`pool "cp": role must be one of`,
+				`pool "cp": tpm must be one of`,
+			},
+		},
+		{
+			name:     "docker",
+			provider: "docker",
+			spec: clusterspec.Cluster{
+				Provider: "qemu",
+				Pools: []clusterspec.Pool{
+					{Name: "cp", Role: clusterspec.RoleControlPlane, Count: 1, Disks: []clusterspec.Disk{{Size: "1GiB"}}},
+				},
+			},
+			expected: []string{
+				`cluster spec is written for provider "qemu", but "docker" is used`,
+				`pool "cp": disks, nics and firmware are not supported by the docker provider`,
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.spec.Validate(test.provider)
+			require.Error(t, err)
+
+			for _, expected := range test.expected {
+				assert.ErrorContains(t, err, expected)
+			}
+		})
+	}
+}
+
+func TestValidateScale(t *testing.T) {
+	t.Parallel()
+
+	previous := &clusterspec.Cluster{
+		Pools: []clusterspec.Pool{
+			{Name: "cp", Role: clusterspec.RoleControlPlane, Count: 1, CPUs: "2.0"},
+			{Name: "w", Role: clusterspec.RoleWorker, Count: 1, Memory: "2GiB", Disks: []clusterspec.Disk{{Size: "10GiB"}}},
+		},
+	}
+
+	scaled := &clusterspec.Cluster{
+		Pools: []clusterspec.Pool{
+			{Name: "cp", Role: clusterspec.RoleControlPlane, Count: 3, CPUs: "2.0"},
+			{Name: "w", Role: clusterspec.RoleWorker, Memory: "2GiB", Disks: []clusterspec.Disk{{Size: "10GiB"}}},
+			{Name: "gpu", Role: clusterspec.RoleWorker, Count: 1},
+		},
+	}
+
+	require.NoError(t, scaled.ValidateScale(previous))
+
+	edited := &clusterspec.Cluster{
+		Pools: []clusterspec.Pool{
+			{Name: "cp", Role: clusterspec.RoleControlPlane, Count: 1, CPUs: "4.0"},
+			{Name: "w", Role: clusterspec.RoleWorker, Count: 2, Memory: "4GiB", Disks: []clusterspec.Disk{{Size: "20GiB"}}},
+		},
+	}
+
+	err := edited.ValidateScale(previous)
+	require.Error(t, err)
+
+	assert.ErrorContains(t, err, `pool "cp": cpus can't be changed`)
+	assert.ErrorContains(t, err, `pool "w": memory, disks can't be changed`)
+}
+
+type testCluster struct {
+	info provision.ClusterInfo
+}
+
+func (c testCluster) Provisioner() string { return "qemu" }
+
+func (c testCluster) StatePath() (string, error) { return "", nil }
+
+func (c testCluster) Info() provision.ClusterInfo { return c.info }
+
+func TestForCluster(t *testing.T) {
+	t.Parallel()
+
+	statePath := t.TempDir()
+
+	cluster := testCluster{
+		info: provision.ClusterInfo{
+			ClusterName: "talos-default",
+			Network: provision.NetworkInfo{
+				CIDRs: []netip.Prefix{netip.MustParsePrefix("10.5.0.0/24")},
+				MTU:   1500,
+			},
+			Nodes: []provision.NodeInfo{
+				{Name: "talos-default-worker-1", Type: machine.TypeWorker, NanoCPUs: 2e9, Memory: 2048 * 1024 * 1024},
+				{Name: "talos-default-controlplane-1", Type: machine.TypeControlPlane, NanoCPUs: 2e9, Memory: 2048 * 1024 * 1024},
+				{Name: "talos-default-worker-2", Type: machine.TypeWorker, NanoCPUs: 2e9, Memory: 2048 * 1024 * 1024},
+				{Name: "talos-default-gpu-pool-1", Type: machine.TypeWorker, NanoCPUs: 5e8, Memory: 4096 * 1024 * 1024},
+			},
+		},
+	}
+
+	// derived from the running cluster
+	spec, err := clusterspec.ForCluster(cluster, statePath)
+	require.NoError(t, err)
+
+	assert.Equal(t, &clusterspec.Cluster{
+		APIVersion: clusterspec.APIVersion,
+		Kind:       clusterspec.Kind,
+		Name:       "talos-default",
+		Provider:   "qemu",
+		Network: clusterspec.Network{
+			CIDR: "10.5.0.0/24",
+			MTU:  1500,
+		},
+		Pools: []clusterspec.Pool{
+			{Name: "controlplane", Role: clusterspec.RoleControlPlane, Count: 1, CPUs: "2.00", Memory: "2048MiB"},
+			{Name: "worker", Role: clusterspec.RoleWorker, Count: 2, CPUs: "2.00", Memory: "2048MiB"},
+			{Name: "gpu-pool", Role: clusterspec.RoleWorker, Count: 1, CPUs: "0.50", Memory: "4096MiB"},
+		},
+	}, spec)
+
+	require.NoError(t, spec.Validate("qemu"))
+
+	// stored spec takes precedence
+	spec.Pools[1].Count = 5
+	require.NoError(t, spec.SaveToState(statePath))
+
+	stored, err := clusterspec.ForCluster(cluster, statePath)
+	require.NoError(t, err)
+	assert.Equal(t, spec, stored)
+
+	// no nodes and no spec
+	_, err = clusterspec.ForCluster(testCluster{}, t.TempDir())
+	require.ErrorIs(t, err, clusterspec.ErrNoSpec)
+
+	// broken spec
+	brokenState := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(brokenState, clusterspec.StateFileName), []byte("kind: Other\n"), 0o644))
+
+	_, err = clusterspec.ForCluster(testCluster{}, brokenState)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, clusterspec.ErrNoSpec)
+}
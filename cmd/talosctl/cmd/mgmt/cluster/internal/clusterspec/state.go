@@ -0,0 +1,138 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package clusterspec
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/provision"
+)
+
+// StateFileName is the name of the file in the cluster state directory which stores the spec.
+const StateFileName = "cluster.yaml"
+
+// LoadFromState reads the spec stored in the cluster state directory.
+//
+// If the cluster was created without a spec, it returns an error wrapping os.ErrNotExist.
+func LoadFromState(statePath string) (*Cluster, error) {
+	return Load(filepath.Join(statePath, StateFileName))
+}
+
+// SaveToState stores the spec in the cluster state directory.
+func (c *Cluster) SaveToState(statePath string) error {
+	data, err := c.Marshal()
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(statePath, StateFileName), data, 0o644)
+}
+
+// FromClusterInfo derives the spec from the running cluster.
+//
+// Nodes are grouped into pools by their names, so both the clusters created from a spec and
+// the clusters created with flags (controlplane and worker pools) are supported.
+func FromClusterInfo(provider string, info provision.ClusterInfo) *Cluster {
+	cluster := &Cluster{
+		APIVersion: APIVersion,
+		Kind:       Kind,
+		Name:       info.ClusterName,
+		Provider:   provider,
+	}
+
+	if len(info.Network.CIDRs) > 0 {
+		cluster.Network.CIDR = info.Network.CIDRs[0].String()
+	}
+
+	cluster.Network.MTU = info.Network.MTU
+
+	// control plane pools go first to keep the node order stable
+	nodes := slices.Clone(info.Nodes)
+	slices.SortStableFunc(nodes, func(a, b provision.NodeInfo) int {
+		return boolToInt(a.Type == machine.TypeWorker) - boolToInt(b.Type == machine.TypeWorker)
+	})
+
+	for _, node := range nodes {
+		poolName := PoolName(info.ClusterName, node.Name)
+
+		idx := slices.IndexFunc(cluster.Pools, func(p Pool) bool { return p.Name == poolName })
+		if idx == -1 {
+			role := RoleControlPlane
+			if node.Type == machine.TypeWorker {
+				role = RoleWorker
+			}
+
+			pool := Pool{
+				Name: poolName,
+				Role: role,
+			}
+
+			if node.NanoCPUs > 0 {
+				pool.CPUs = big.NewRat(node.NanoCPUs, 1e9).FloatString(2)
+			}
+
+			if node.Memory > 0 {
+				pool.Memory = strconv.FormatInt(node.Memory/1024/1024, 10) + "MiB"
+			}
+
+			cluster.Pools = append(cluster.Pools, pool)
+			idx = len(cluster.Pools) - 1
+		}
+
+		cluster.Pools[idx].Count++
+	}
+
+	return cluster
+}
+
+// PoolName returns the name of the pool the node belongs to.
+func PoolName(clusterName, nodeName string) string {
+	name := strings.TrimPrefix(nodeName, clusterName+"-")
+
+	if i := strings.LastIndexByte(name, '-'); i > 0 {
+		if _, err := strconv.Atoi(name[i+1:]); err == nil {
+			return name[:i]
+		}
+	}
+
+	return name
+}
+
+// ErrNoSpec is returned when the spec can't be found for the cluster.
+var ErrNoSpec = errors.New("cluster spec not found")
+
+// ForCluster returns the spec stored in the cluster state directory, or derives it from the running cluster.
+func ForCluster(cluster provision.Cluster, statePath string) (*Cluster, error) {
+	spec, err := LoadFromState(statePath)
+
+	switch {
+	case err == nil:
+		return spec, nil
+	case !errors.Is(err, os.ErrNotExist):
+		return nil, err
+	}
+
+	if len(cluster.Info().Nodes) == 0 {
+		return nil, fmt.Errorf("%w: cluster %q has no nodes", ErrNoSpec, cluster.Info().ClusterName)
+	}
+
+	return FromClusterInfo(cluster.Provisioner(), cluster.Info()), nil
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+
+	return 0
+}
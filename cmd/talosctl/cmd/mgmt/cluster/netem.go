@@ -0,0 +1,231 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/siderolabs/gen/xslices"
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/provision"
+)
+
+var netemAddCmdFlags struct {
+	from       []string
+	to         []string
+	latency    time.Duration
+	jitter     time.Duration
+	packetLoss float64
+	bandwidth  uint64
+	partition  bool
+}
+
+var netemRemoveCmdFlags struct {
+	all bool
+}
+
+// netemCmd represents the cluster netem command.
+var netemCmd = &cobra.Command{
+	Use:   "netem",
+	Short: "Inject network faults between the nodes of a local cluster",
+	Long: `Inject network faults (latency, jitter, packet loss, bandwidth limits and partitions) between the nodes
+of a local cluster with tc netem on the ports of the cluster bridge.
+
+Faults are applied between two groups of nodes in both directions. A group is a list of node names, IP addresses,
+or the 'controlplane' and 'worker' roles. Faults are applied to the nodes which exist when the fault is added or removed.`,
+}
+
+var netemAddCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Add a network fault between the groups of nodes",
+	Example: `  # add 100ms±10ms of latency between the control plane nodes and the workers
+  talosctl cluster netem add --from controlplane --to worker --latency 100ms --jitter 10ms
+
+  # isolate a node from all other nodes
+  talosctl cluster netem add --from talos-default-controlplane-1 --partition`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withNetworkFaultProvisioner(cmd.Context(), func(ctx context.Context, provisioner provision.NetworkFaultProvisioner, cluster provision.Cluster) error {
+			nodes := cluster.Info().Nodes
+
+			from, err := selectFaultNodes(nodes, netemAddCmdFlags.from)
+			if err != nil {
+				return err
+			}
+
+			to, err := selectFaultNodes(nodes, netemAddCmdFlags.to)
+			if err != nil {
+				return err
+			}
+
+			// by default, the fault applies to all other nodes
+			if len(netemAddCmdFlags.to) == 0 {
+				to = xslices.Filter(to, func(addr netip.Addr) bool { return !slices.Contains(from, addr) })
+			}
+
+			fault, err := provisioner.AddNetworkFault(ctx, cluster, provision.NetworkFault{
+				From:       from,
+				To:         to,
+				Latency:    netemAddCmdFlags.latency,
+				Jitter:     netemAddCmdFlags.jitter,
+				PacketLoss: netemAddCmdFlags.packetLoss,
+				Bandwidth:  netemAddCmdFlags.bandwidth,
+				Partition:  netemAddCmdFlags.partition,
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("added network fault %s: %s\n", fault.ID, fault)
+
+			return nil
+		})
+	},
+}
+
+var netemListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List the network faults applied to the cluster",
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withNetworkFaultProvisioner(cmd.Context(), func(ctx context.Context, provisioner provision.NetworkFaultProvisioner, cluster provision.Cluster) error {
+			faults, err := provisioner.ListNetworkFaults(ctx, cluster)
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintf(w, "ID\tFROM\tTO\tFAULT\n")
+
+			for _, fault := range faults {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+					fault.ID,
+					strings.Join(faultNodeNames(cluster.Info().Nodes, fault.From), ","),
+					strings.Join(faultNodeNames(cluster.Info().Nodes, fault.To), ","),
+					fault,
+				)
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+var netemRemoveCmd = &cobra.Command{
+	Use:     "remove <id>...",
+	Aliases: []string{"rm"},
+	Short:   "Remove network faults from the cluster",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 && !netemRemoveCmdFlags.all {
+			return fmt.Errorf("either fault IDs or --all should be specified")
+		}
+
+		if len(args) > 0 && netemRemoveCmdFlags.all {
+			return fmt.Errorf("fault IDs can't be used with --all")
+		}
+
+		return withNetworkFaultProvisioner(cmd.Context(), func(ctx context.Context, provisioner provision.NetworkFaultProvisioner, cluster provision.Cluster) error {
+			return provisioner.RemoveNetworkFaults(ctx, cluster, args...)
+		})
+	},
+}
+
+func withNetworkFaultProvisioner(ctx context.Context, f func(context.Context, provision.NetworkFaultProvisioner, provision.Cluster) error) error {
+	provisioner, cluster, err := stateProvisionerAndCluster(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer provisioner.Close() //nolint:errcheck
+
+	faultProvisioner, ok := provisioner.(provision.NetworkFaultProvisioner)
+	if !ok {
+		return fmt.Errorf("provisioner %q does not support network faults", cluster.Provisioner())
+	}
+
+	return f(ctx, faultProvisioner, cluster)
+}
+
+// selectFaultNodes returns the addresses of the nodes matching the given filters (by role, name or IP).
+// An empty filter list selects all nodes.
+func selectFaultNodes(all []provision.NodeInfo, filters []string) ([]netip.Addr, error) {
+	var selected []netip.Addr
+
+	for _, node := range all {
+		if len(filters) > 0 && !slices.ContainsFunc(filters, func(filter string) bool { return matchFaultNode(node, filter) }) {
+			continue
+		}
+
+		selected = append(selected, node.IPs...)
+	}
+
+	for _, filter := range filters {
+		if !slices.ContainsFunc(all, func(node provision.NodeInfo) bool { return matchFaultNode(node, filter) }) {
+			return nil, fmt.Errorf("no node found matching %q", filter)
+		}
+	}
+
+	return selected, nil
+}
+
+func matchFaultNode(node provision.NodeInfo, filter string) bool {
+	switch filter {
+	case machine.TypeControlPlane.String():
+		return node.Type.IsControlPlane()
+	case machine.TypeWorker.String():
+		return node.Type == machine.TypeWorker
+	}
+
+	return node.Name == filter || slices.ContainsFunc(node.IPs, func(ip netip.Addr) bool {
+		return ip.String() == filter
+	})
+}
+
+// faultNodeNames returns the names of the nodes with the given addresses.
+func faultNodeNames(all []provision.NodeInfo, addrs []netip.Addr) []string {
+	names := make([]string, 0, len(addrs))
+
+	for _, addr := range addrs {
+		idx := slices.IndexFunc(all, func(node provision.NodeInfo) bool { return slices.Contains(node.IPs, addr) })
+		if idx == -1 {
+			names = append(names, addr.String())
+
+			continue
+		}
+
+		if !slices.Contains(names, all[idx].Name) {
+			names = append(names, all[idx].Name)
+		}
+	}
+
+	return names
+}
+
+func init() {
+	netemAddCmd.Flags().StringSliceVar(&netemAddCmdFlags.from, "from", nil, "nodes of the first group: node names, IPs, 'controlplane' or 'worker'")
+	netemAddCmd.Flags().StringSliceVar(&netemAddCmdFlags.to, "to", nil, "nodes of the second group (default: all other nodes)")
+	netemAddCmd.Flags().DurationVar(&netemAddCmdFlags.latency, "latency", 0, "latency added to the packets")
+	netemAddCmd.Flags().DurationVar(&netemAddCmdFlags.jitter, "jitter", 0, "jitter of the latency")
+	netemAddCmd.Flags().Float64Var(&netemAddCmdFlags.packetLoss, "packet-loss", 0, "share of the dropped packets, e.g. 50% = 0.50")
+	netemAddCmd.Flags().Uint64Var(&netemAddCmdFlags.bandwidth, "bandwidth", 0, "bandwidth limit (in kbps)")
+	netemAddCmd.Flags().BoolVar(&netemAddCmdFlags.partition, "partition", false, "drop all traffic between the groups")
+	cli.Should(netemAddCmd.MarkFlagRequired("from"))
+
+	netemRemoveCmd.Flags().BoolVar(&netemRemoveCmdFlags.all, "all", false, "remove all network faults")
+
+	netemCmd.AddCommand(netemAddCmd, netemListCmd, netemRemoveCmd)
+	Cmd.AddCommand(netemCmd)
+}
@@ -35,7 +35,7 @@ nodes, matched by name or IP address. Local and remote QEMU clusters are support
 }
 
 func reboot(ctx context.Context) error {
-	provisioner, cluster, err := rebootProvisionerAndCluster(ctx)
+	provisioner, cluster, err := stateProvisionerAndCluster(ctx)
 	if err != nil {
 		return err
 	}
@@ -63,7 +63,8 @@ func reboot(ctx context.Context) error {
 	return nil
 }
 
-func rebootProvisionerAndCluster(ctx context.Context) (provision.Provisioner, provision.Cluster, error) {
+// stateProvisionerAndCluster returns the provisioner the cluster was created with, and the cluster itself.
+func stateProvisionerAndCluster(ctx context.Context) (provision.Provisioner, provision.Cluster, error) {
 	if PersistentFlags.RemoteEndpoint != "" {
 		provisioner, err := providers.Factory(ctx, providers.RemoteProviderName, providers.WithRemoteEndpoint(PersistentFlags.RemoteEndpoint))
 		if err != nil {
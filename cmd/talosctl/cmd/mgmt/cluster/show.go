@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"net/netip"
 	"os"
+	"path/filepath"
 	"slices"
 	"strings"
 	"text/tabwriter"
@@ -18,6 +19,7 @@ import (
 	"github.com/siderolabs/gen/xslices"
 	"github.com/spf13/cobra"
 
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster/internal/clusterspec"
 	"github.com/siderolabs/talos/pkg/provision"
 	"github.com/siderolabs/talos/pkg/provision/providers"
 )
@@ -33,6 +35,10 @@ var showCmd = &cobra.Command{
 	},
 }
 
+var showCmdFlags struct {
+	spec bool
+}
+
 func show(ctx context.Context) error {
 	provisioner, err := selectProvisioner(ctx)
 	if err != nil {
@@ -46,9 +52,30 @@ func show(ctx context.Context) error {
 		return err
 	}
 
+	if showCmdFlags.spec {
+		return showSpec(cluster)
+	}
+
 	return ShowCluster(cluster)
 }
 
+// showSpec prints the cluster spec which can be used to re-create or scale the cluster.
+func showSpec(cluster provision.Cluster) error {
+	spec, err := clusterspec.ForCluster(cluster, filepath.Join(PersistentFlags.StateDir, PersistentFlags.ClusterName))
+	if err != nil {
+		return err
+	}
+
+	data, err := spec.Marshal()
+	if err != nil {
+		return err
+	}
+
+	_, err = os.Stdout.Write(data)
+
+	return err
+}
+
 // selectProvisioner returns the remote provisioner if --remote-endpoint is
 // set on the parent cluster command, otherwise falls back to the legacy
 // per-subcommand --provisioner flag (docker by default).
@@ -123,6 +150,7 @@ func ShowCluster(cluster provision.Cluster) error {
 }
 
 func init() {
+	showCmd.Flags().BoolVar(&showCmdFlags.spec, "spec", false, "print the declarative cluster spec of the cluster")
 	AddProvisionerFlag(showCmd)
 
 	Cmd.AddCommand(showCmd)
@@ -27,6 +27,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
 	"github.com/siderolabs/talos/pkg/machinery/config/generate"
 	"github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/config/inventory"
 	"github.com/siderolabs/talos/pkg/machinery/config/machine"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
@@ -75,6 +76,8 @@ var genConfigCmdFlags struct {
 	withKubeSpan            bool
 	skipK8sEtcd             bool
 	withSecrets             string
+	inventory               string
+	nodePatch               []string
 }
 
 // NewConfigCmd builds the config generation subcommand with the given name.
@@ -85,7 +88,11 @@ func NewConfigCmd(name string) *cobra.Command {
 		Long: `The cluster endpoint is the URL for the Kubernetes API. If you decide to use
 a control plane node, common in a single node control plane setup, use port 6443 as
 this is the port that the API server binds to on every control plane node. For an HA
-setup, usually involving a load balancer, use the IP and port of the load balancer.`,
+setup, usually involving a load balancer, use the IP and port of the load balancer.
+
+With --inventory, a config is also generated for each node of the inventory (YAML or CSV):
+the --node-patch patches are Go templates rendered with the variables of the node and applied
+on top of the controlplane or worker config. The report of the variables used by each node is printed to stderr.`,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			err := validateClusterEndpoint(args[1])
@@ -182,6 +189,14 @@ func writeConfig(args []string) error {
 		return err
 	}
 
+	var inv *inventory.Inventory
+
+	if genConfigCmdFlags.inventory != "" {
+		if inv, err = inventory.Load(genConfigCmdFlags.inventory); err != nil {
+			return err
+		}
+	}
+
 	var genOptions []generate.Option //nolint:prealloc
 
 	for _, registryMirror := range genConfigCmdFlags.registryMirrors {
@@ -262,7 +277,37 @@ func writeConfig(args []string) error {
 		return err
 	}
 
-	return writeConfigBundle(configBundle, paths, commentsFlags)
+	if err = writeConfigBundle(configBundle, paths, commentsFlags); err != nil {
+		return err
+	}
+
+	if inv == nil {
+		return nil
+	}
+
+	return writeNodeConfigs(configBundle, inv, filepath.Dir(paths.controlPlane))
+}
+
+// writeNodeConfigs renders the node patches for each node of the inventory and writes the configs as <node name>.yaml.
+func writeNodeConfigs(configBundle *bundle.Bundle, inv *inventory.Inventory, outputDir string) error {
+	configs, err := helpers.RenderInventory(inv, genConfigCmdFlags.nodePatch, func(t machine.Type) configpatcher.Input {
+		if t.IsControlPlane() {
+			return configpatcher.WithConfig(configBundle.ControlPlane())
+		}
+
+		return configpatcher.WithConfig(configBundle.Worker())
+	}, os.Stderr)
+	if err != nil {
+		return err
+	}
+
+	for _, cfg := range configs {
+		if err = writeToDestination(cfg.Data, filepath.Join(outputDir, cfg.Node.Name+yamlExt), 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func validateFlags() error {
@@ -282,6 +327,14 @@ func validateFlags() error {
 		genConfigCmdFlags.output = genConfigCmdFlags.outputDir
 	}
 
+	if genConfigCmdFlags.inventory != "" && genConfigCmdFlags.output == stdoutOutput {
+		return errors.New("can't use inventory with stdout")
+	}
+
+	if len(genConfigCmdFlags.nodePatch) > 0 && genConfigCmdFlags.inventory == "" {
+		return errors.New("node patches require an inventory")
+	}
+
 	var err error
 
 	for _, outputType := range genConfigCmdFlags.outputTypes {
@@ -445,6 +498,10 @@ func init() {
 	genConfigCmd.Flags().StringArrayVar(&genConfigCmdFlags.configPatch, "config-patch", nil, "patch generated machineconfigs (applied to all node types), use @file to read a patch from file")
 	genConfigCmd.Flags().StringArrayVar(&genConfigCmdFlags.configPatchControlPlane, "config-patch-control-plane", nil, "patch generated machineconfigs (applied to 'init' and 'controlplane' types)")
 	genConfigCmd.Flags().StringArrayVar(&genConfigCmdFlags.configPatchWorker, "config-patch-worker", nil, "patch generated machineconfigs (applied to 'worker' type)")
+	genConfigCmd.Flags().StringVar(&genConfigCmdFlags.inventory, "inventory", "",
+		"inventory of nodes (YAML or CSV) to generate a config for each node as <node name>.yaml in the output directory")
+	genConfigCmd.Flags().StringArrayVar(&genConfigCmdFlags.nodePatch, "node-patch", nil,
+		"templated patch rendered with the variables of each node of the inventory and applied to the node config, use @file to read a patch from file")
 	genConfigCmd.Flags().StringSliceVar(&genConfigCmdFlags.registryMirrors, "registry-mirror", []string{}, "list of registry mirrors to use in format: <registry host>=<mirror URL>")
 	genConfigCmd.Flags().BoolVarP(&genConfigCmdFlags.withExamples, "with-examples", "", true, "renders all machine configs with the commented examples")
 	genConfigCmd.Flags().BoolVarP(&genConfigCmdFlags.withDocs, "with-docs", "", true, "renders all machine configs adding the documentation for each field")
@@ -0,0 +1,181 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package machineconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configlint"
+	"github.com/siderolabs/talos/pkg/machinery/version"
+)
+
+var lintCmdFlags struct {
+	rules       []string
+	disable     []string
+	enable      []string
+	minSeverity string
+	failOn      string
+	output      string
+	cluster     bool
+}
+
+// lintCmd represents the `machineconfig lint` command.
+var lintCmd = &cobra.Command{
+	Use:   "lint <machineconfig-file>...",
+	Short: "Check machine configs against the best practices",
+	Long: `Check machine configs against the best practices, reporting the configuration which is valid, but most likely a mistake.
+
+The built-in rules are run by default (except for the opt-in rules enabled with --enable), the user rules are written in CEL and loaded with --rules:
+
+    rules:
+      - id: no-debug
+        description: Debug mode should be disabled in production.
+        severity: warning
+        match: doc == "v1alpha1" && has(spec.debug) && spec.debug
+        message: debug mode is enabled
+
+The match expression is evaluated for each config document with the variables 'doc' (document ID, e.g. 'v1alpha1' or 'LinkConfig/eth0'),
+'machineType' and 'spec' (document contents).
+A document with a missing field doesn't match, other evaluation errors are reported as findings of the rule.
+The rule IDs should be unique across the builtin rules and all the rules files.
+
+A rule can be suppressed for a config document with a comment anywhere in the document:
+
+    # talos:lint-ignore <rule-id>[,<rule-id>...]
+
+With --cluster, the configs are linted as the configs of all nodes of a single cluster, which enables the cross-node rules.`,
+	Example: `  # lint the configs of all cluster nodes, and produce a SARIF report
+  talosctl machineconfig lint --cluster --output sarif nodes/*.yaml > lint.sarif`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		minSeverity, err := configlint.ParseSeverity(lintCmdFlags.minSeverity)
+		if err != nil {
+			return err
+		}
+
+		failOn, err := configlint.ParseSeverity(lintCmdFlags.failOn)
+		if err != nil {
+			return err
+		}
+
+		rules := configlint.BuiltinRules()
+
+		for _, path := range lintCmdFlags.rules {
+			userRules, err := loadLintRules(path, rules)
+			if err != nil {
+				return err
+			}
+
+			rules = append(rules, userRules...)
+		}
+
+		configs := make([]*configlint.Config, 0, len(args))
+
+		for _, path := range args {
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+
+			cfg, err := configlint.NewConfig(path, data)
+			if err != nil {
+				return fmt.Errorf("failed to load %q: %w", path, err)
+			}
+
+			configs = append(configs, cfg)
+		}
+
+		opts := []configlint.Option{
+			configlint.WithMinSeverity(minSeverity),
+			configlint.WithDisabled(lintCmdFlags.disable...),
+			configlint.WithEnabled(lintCmdFlags.enable...),
+		}
+
+		if lintCmdFlags.cluster {
+			opts = append(opts, configlint.WithCluster())
+		}
+
+		findings := configlint.Lint(configs, rules, opts...)
+
+		out := cmd.OutOrStdout()
+
+		switch lintCmdFlags.output {
+		case "text":
+			printLintFindings(out, findings)
+		case "json":
+			enc := json.NewEncoder(out)
+			enc.SetIndent("", "  ")
+
+			err = enc.Encode(findings)
+		case "sarif":
+			err = configlint.WriteSARIF(out, version.Tag, rules, findings)
+		default:
+			return fmt.Errorf("unsupported output format %q", lintCmdFlags.output)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if slices.ContainsFunc(findings, func(f configlint.Finding) bool { return f.Severity >= failOn }) {
+			return fmt.Errorf("found issues with %s severity or higher", failOn)
+		}
+
+		return nil
+	},
+}
+
+func loadLintRules(path string, existing []configlint.Rule) ([]configlint.Rule, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	rules, err := configlint.LoadRules(f, existing)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load rules %q: %w", path, err)
+	}
+
+	return rules, nil
+}
+
+func printLintFindings(w io.Writer, findings []configlint.Finding) {
+	for _, finding := range findings {
+		location := finding.File
+		if finding.Line > 0 {
+			location = fmt.Sprintf("%s:%d", location, finding.Line)
+		}
+
+		if finding.Document != "" {
+			location = fmt.Sprintf("%s (%s)", location, finding.Document)
+		}
+
+		fmt.Fprintf(w, "%s: %s: %s [%s]\n", location, finding.Severity, finding.Message, finding.Rule)
+	}
+
+	if len(findings) == 0 {
+		fmt.Fprintln(w, "no issues found")
+	}
+}
+
+func init() {
+	lintCmd.Flags().StringSliceVar(&lintCmdFlags.rules, "rules", nil, "files with the user lint rules written in CEL")
+	lintCmd.Flags().StringSliceVar(&lintCmdFlags.disable, "disable", nil, "IDs of the rules to disable")
+	lintCmd.Flags().StringSliceVar(&lintCmdFlags.enable, "enable", nil, "IDs of the opt-in rules to enable (e.g. install-disk-selector)")
+	lintCmd.Flags().StringVar(&lintCmdFlags.minSeverity, "min-severity", configlint.SeverityInfo.String(), "minimum severity of the reported issues (info, warning, error)")
+	lintCmd.Flags().StringVar(&lintCmdFlags.failOn, "fail-on", configlint.SeverityError.String(), "exit with a non-zero code if there are issues with this severity or higher (info, warning, error)")
+	lintCmd.Flags().StringVarP(&lintCmdFlags.output, "output", "o", "text", "output format (text, json, sarif)")
+	lintCmd.Flags().BoolVar(&lintCmdFlags.cluster, "cluster", false, "lint the configs as the configs of all nodes of a single cluster, enabling the cross-node rules")
+
+	Cmd.AddCommand(lintCmd)
+}
@@ -0,0 +1,72 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package machineconfig
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/mgmt/helpers"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+)
+
+var migrateCmdFlags struct {
+	output string
+}
+
+// migrateCmd represents the `machineconfig migrate` command.
+var migrateCmd = &cobra.Command{
+	Use:   "migrate <machineconfig-file>",
+	Short: "Migrate deprecated v1alpha1 sections of a machine config into config documents",
+	Long: `Migrate deprecated v1alpha1 sections of a machine config into the equivalent config documents,
+e.g. machine.network.nameservers into ResolverConfig, or machine.kubelet into KubeletConfig.
+
+Each section is migrated only if the effective configuration stays the same, otherwise it is kept as is and reported as skipped.
+The report of the migrated sections and the diff of the config are printed to stderr.
+
+To migrate the config of a running node, use 'talosctl migrate machineconfig'.`,
+	Example: `  # migrate a config file in place
+  talosctl machineconfig migrate worker.yaml --output worker.yaml`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := configloader.NewFromFile(args[0])
+		if err != nil {
+			return err
+		}
+
+		result, err := helpers.MigrateConfig(cfg, os.Stderr)
+		if err != nil {
+			return err
+		}
+
+		migratedData, err := result.Config.EncodeBytes(encoder.WithComments(encoder.CommentsDisabled))
+		if err != nil {
+			return err
+		}
+
+		if migrateCmdFlags.output == "" { // write to stdout
+			fmt.Printf("%s", migratedData)
+
+			return nil
+		}
+
+		// Create dir path, ignoring "already exists" messages
+		if err := os.MkdirAll(filepath.Dir(migrateCmdFlags.output), os.ModePerm); err != nil && !os.IsExist(err) {
+			return fmt.Errorf("failed to create output dir: %w", err)
+		}
+
+		return os.WriteFile(migrateCmdFlags.output, migratedData, 0o644)
+	},
+}
+
+func init() {
+	migrateCmd.Flags().StringVarP(&migrateCmdFlags.output, "output", "o", "", "output destination. if not specified, output will be printed to stdout")
+
+	Cmd.AddCommand(migrateCmd)
+}
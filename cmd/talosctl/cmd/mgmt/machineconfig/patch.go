@@ -5,31 +5,47 @@
 package machineconfig
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 
 	"github.com/spf13/cobra"
 
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/mgmt/helpers"
 	"github.com/siderolabs/talos/pkg/machinery/config/configpatcher"
+	"github.com/siderolabs/talos/pkg/machinery/config/inventory"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
 )
 
 var patchCmdFlags struct {
-	patches []string
-	output  string
+	patches   []string
+	output    string
+	inventory string
 }
 
 // patchCmd represents the `machineconfig patch` command.
 var patchCmd = &cobra.Command{
 	Use:   "patch <machineconfig-file>",
 	Short: "Patch a machine config",
-	Args:  cobra.ExactArgs(1),
+	Long: `Patch a machine config with strategic merge or JSON6902 patches.
+
+With --inventory, the patches are Go templates rendered for each node of the inventory (YAML or CSV),
+and the config for each node is written to the --output directory as <node name>.yaml.
+The report of the variables used by each node is printed to stderr.`,
+	Example: `  # render a config for each node of the inventory
+  talosctl machineconfig patch worker.yaml --inventory nodes.yaml --patch @node-patch.yaml --output _out/nodes`,
+	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		data, err := os.ReadFile(args[0])
 		if err != nil {
 			return err
 		}
 
+		if patchCmdFlags.inventory != "" {
+			return patchInventory(data)
+		}
+
 		patches, err := configpatcher.LoadPatches(patchCmdFlags.patches)
 		if err != nil {
 			return err
@@ -64,10 +80,41 @@ var patchCmd = &cobra.Command{
 	},
 }
 
+func patchInventory(data []byte) error {
+	if patchCmdFlags.output == "" {
+		return errors.New("--output directory is required with --inventory")
+	}
+
+	inv, err := inventory.Load(patchCmdFlags.inventory)
+	if err != nil {
+		return err
+	}
+
+	configs, err := helpers.RenderInventory(inv, patchCmdFlags.patches, func(machine.Type) configpatcher.Input {
+		return configpatcher.WithBytes(data)
+	}, os.Stderr)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(patchCmdFlags.output, os.ModePerm); err != nil {
+		return fmt.Errorf("failed to create output dir: %w", err)
+	}
+
+	for _, cfg := range configs {
+		if err = os.WriteFile(filepath.Join(patchCmdFlags.output, cfg.Node.Name+".yaml"), cfg.Data, 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func init() {
 	// use StringArrayVarP instead of StringSliceVarP to prevent cobra from splitting the patch string on commas
 	patchCmd.Flags().StringArrayVarP(&patchCmdFlags.patches, "patch", "p", nil, "patch generated machineconfigs (applied to all node types), use @file to read a patch from file")
 	patchCmd.Flags().StringVarP(&patchCmdFlags.output, "output", "o", "", "output destination. if not specified, output will be printed to stdout")
+	patchCmd.Flags().StringVar(&patchCmdFlags.inventory, "inventory", "", "render the patches as templates for each node of the inventory (YAML or CSV), --output is the directory for the node configs")
 
 	Cmd.AddCommand(patchCmd)
 }
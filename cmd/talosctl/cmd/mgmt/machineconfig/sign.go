@@ -0,0 +1,76 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package machineconfig
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/signature"
+)
+
+var signCmdFlags struct {
+	key    string
+	output string
+}
+
+// signCmd represents the `machineconfig sign` command.
+var signCmd = &cobra.Command{
+	Use:   "sign <machineconfig-file>",
+	Short: "Sign a machine config",
+	Long: `Creates a detached signature of the machine config with the private key.
+
+The signature can be sent along with the machine config with 'talosctl apply-config --signature',
+or published next to the machine config for the remote configuration source ('<url>.sig').`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if signCmdFlags.key == "" {
+			return errors.New("private key is required")
+		}
+
+		data, err := os.ReadFile(args[0])
+		if err != nil {
+			return err
+		}
+
+		keyData, err := os.ReadFile(signCmdFlags.key)
+		if err != nil {
+			return err
+		}
+
+		signer, err := signature.ParsePrivateKey(keyData)
+		if err != nil {
+			return err
+		}
+
+		sig, err := signature.Sign(data, signer)
+		if err != nil {
+			return fmt.Errorf("failed to sign machine config: %w", err)
+		}
+
+		output := signCmdFlags.output
+		if output == "" {
+			output = args[0] + ".sig"
+		}
+
+		if output == "-" {
+			_, err = os.Stdout.Write(sig)
+
+			return err
+		}
+
+		return os.WriteFile(output, sig, 0o644)
+	},
+}
+
+func init() {
+	signCmd.Flags().StringVarP(&signCmdFlags.key, "key", "k", "", "PEM-encoded private key (Ed25519, ECDSA or RSA) to sign the machine config with")
+	signCmd.Flags().StringVarP(&signCmdFlags.output, "output", "o", "", "output destination for the signature, defaults to '<machineconfig-file>.sig' ('-' for stdout)")
+
+	Cmd.AddCommand(signCmd)
+}
@@ -0,0 +1,80 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package machineconfig
+
+import (
+	"crypto"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/signature"
+)
+
+var verifyCmdFlags struct {
+	trustedKeys []string
+	signature   string
+}
+
+// verifyCmd represents the `machineconfig verify` command.
+var verifyCmd = &cobra.Command{
+	Use:   "verify <machineconfig-file>",
+	Short: "Verify the signature of a machine config",
+	Long:  `Verifies the detached signature of the machine config against the trusted public keys.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(verifyCmdFlags.trustedKeys) == 0 {
+			return errors.New("at least one trusted key is required")
+		}
+
+		data, err := os.ReadFile(args[0])
+		if err != nil {
+			return err
+		}
+
+		sigPath := verifyCmdFlags.signature
+		if sigPath == "" {
+			sigPath = args[0] + ".sig"
+		}
+
+		sig, err := os.ReadFile(sigPath)
+		if err != nil {
+			return err
+		}
+
+		var keys []crypto.PublicKey
+
+		for _, path := range verifyCmdFlags.trustedKeys {
+			keyData, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+
+			parsed, err := signature.ParsePublicKeys(keyData)
+			if err != nil {
+				return fmt.Errorf("failed to parse trusted key %q: %w", path, err)
+			}
+
+			keys = append(keys, parsed...)
+		}
+
+		if err = signature.Verify(data, sig, keys); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "%s: signature is valid\n", args[0])
+
+		return nil
+	},
+}
+
+func init() {
+	verifyCmd.Flags().StringSliceVarP(&verifyCmdFlags.trustedKeys, "trusted-key", "t", nil, "PEM-encoded public key (or certificate) trusted to sign the machine config")
+	verifyCmd.Flags().StringVarP(&verifyCmdFlags.signature, "signature", "s", "", "detached signature of the machine config, defaults to '<machineconfig-file>.sig'")
+
+	Cmd.AddCommand(verifyCmd)
+}
@@ -30,6 +30,7 @@ var applyConfigCmdFlags struct {
 
 	patches          []string
 	filename         string
+	signature        string
 	dryRun           bool
 	configTryTimeout time.Duration
 }
@@ -72,6 +73,19 @@ var applyConfigCmd = &cobra.Command{
 			return errors.New("no configuration data read")
 		}
 
+		var signature []byte
+
+		if applyConfigCmdFlags.signature != "" {
+			if len(applyConfigCmdFlags.patches) != 0 {
+				return errors.New("config patches can't be used with the signed configuration")
+			}
+
+			signature, err = os.ReadFile(applyConfigCmdFlags.signature)
+			if err != nil {
+				return fmt.Errorf("failed to read signature from %q: %w", applyConfigCmdFlags.signature, err)
+			}
+		}
+
 		if len(applyConfigCmdFlags.patches) != 0 {
 			var (
 				cfg     configpatcher.Input
@@ -112,6 +126,7 @@ var applyConfigCmd = &cobra.Command{
 					Mode:           applyConfigCmdFlags.Mode.Mode,
 					DryRun:         applyConfigCmdFlags.dryRun,
 					TryModeTimeout: durationpb.New(applyConfigCmdFlags.configTryTimeout),
+					Signature:      signature,
 				})
 			},
 		)
@@ -135,6 +150,7 @@ var applyConfigCmd = &cobra.Command{
 func init() {
 	applyConfigCmdFlags.InsecureFlags.AddFlags(applyConfigCmd)
 	applyConfigCmd.Flags().StringVarP(&applyConfigCmdFlags.filename, "file", "f", "", "the filename of the updated configuration")
+	applyConfigCmd.Flags().StringVar(&applyConfigCmdFlags.signature, "signature", "", "the filename of the detached signature of the configuration (see 'talosctl machineconfig sign')")
 	applyConfigCmd.Flags().BoolVar(&applyConfigCmdFlags.dryRun, "dry-run", false, "check how the config change will be applied in dry-run mode")
 	applyConfigCmd.Flags().StringArrayVarP(&applyConfigCmdFlags.patches, "config-patch", "p", nil, "the list of config patches to apply to the local config file before sending it to the node")
 	applyConfigCmd.Flags().DurationVar(&applyConfigCmdFlags.configTryTimeout, "timeout", constants.ConfigTryTimeout, "the config will be rolled back after specified timeout (if try mode is selected)")
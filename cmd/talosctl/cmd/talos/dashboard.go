@@ -32,6 +32,13 @@ Keyboard shortcuts:
  - <C-u> - scroll logs/process list half page up
  - <C-f> - scroll logs/process list one page down
  - <C-b> - scroll logs/process list one page up
+
+Cluster screen shortcuts:
+
+ - <Enter> - show the details of the selected node
+ - s - sort by the next column
+ - r - reverse the sort order
+ - / - filter nodes
 `,
 	Args: cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -52,7 +59,7 @@ Keyboard shortcuts:
 		return dashboard.Run(
 			ctx, c,
 			dashboard.WithInterval(dashboardCmdFlags.interval),
-			dashboard.WithScreens(dashboard.ScreenSummary, dashboard.ScreenMonitor, dashboard.ScreenResourceExplorer),
+			dashboard.WithScreens(dashboard.ScreenSummary, dashboard.ScreenMonitor, dashboard.ScreenResourceExplorer, dashboard.ScreenClusterOverview),
 			dashboard.WithAllowExitKeys(true),
 			dashboard.WithNodes(clientFactory.Nodes()...),
 		)
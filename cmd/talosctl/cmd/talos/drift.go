@@ -0,0 +1,226 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/global"
+	"github.com/siderolabs/talos/pkg/machinery/config/configdrift"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+)
+
+var driftCmdFlags struct {
+	ignore   []string
+	filter   string
+	desired  string
+	output   string
+	exitCode bool
+}
+
+// driftCmd represents the drift command.
+var driftCmd = &cobra.Command{
+	Use:   "drift",
+	Short: "Report the machine configuration drift across the nodes.",
+	Long: `Collect the machine configuration of all the nodes, group them by machine type, and report the nodes with a configuration different from the rest of the group.
+
+The secrets are redacted, and the fields which are expected to differ between the nodes are removed before the comparison,
+so the drift in the secrets is not reported.
+Each --ignore pattern is '<document>[:<path>]': the document is a glob matched against the document kind (and '/<name>' for named documents, e.g. 'LinkConfig/eth0'),
+the path is the dotted field path where '*' matches any single element, e.g. 'v1alpha1:machine.network.hostname'.
+The --filter CEL expression is evaluated for each field with the variables 'doc', 'path', 'value', 'machineType' and 'spec' (document contents),
+the matching fields are ignored as well.
+
+With --desired, each node config is also compared against the desired config from the directory:
+'<node>.yaml' if it exists, otherwise '<machine type>.yaml' (e.g. 'controlplane.yaml' or 'worker.yaml').`,
+	Example: `  # report the configuration drift across the cluster nodes
+  talosctl -n 10.5.0.2,10.5.0.3,10.5.0.4 drift
+
+  # ignore the node labels, compare against the desired configs and fail on drift
+  talosctl -n 10.5.0.2,10.5.0.3,10.5.0.4 drift --filter 'path.startsWith("machine.nodeLabels.")' --desired ./configs --output json --exit-code`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		switch driftCmdFlags.output {
+		case "text", "json":
+		default:
+			return fmt.Errorf("unsupported output format %q", driftCmdFlags.output)
+		}
+
+		normalizer, err := configdrift.NewNormalizer(driftCmdFlags.ignore, driftCmdFlags.filter)
+		if err != nil {
+			return err
+		}
+
+		ctx := cmd.Context()
+
+		clientFactory, err := NewClientFactory(ctx, nil)
+		if err != nil {
+			return err
+		}
+
+		defer clientFactory.Close() //nolint:errcheck
+
+		nodes, err := collectNodeConfigs(ctx, clientFactory, normalizer)
+		if err != nil {
+			return err
+		}
+
+		var report configdrift.Report
+
+		if report.Groups, err = configdrift.GroupOutliers(nodes); err != nil {
+			return err
+		}
+
+		if driftCmdFlags.desired != "" {
+			if report.Desired, err = compareDesired(nodes, normalizer, driftCmdFlags.desired); err != nil {
+				return err
+			}
+		}
+
+		if driftCmdFlags.output == "json" {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+
+			if err = enc.Encode(report); err != nil {
+				return err
+			}
+		} else {
+			printDriftReport(os.Stdout, report)
+		}
+
+		if driftCmdFlags.exitCode && report.Drifted() {
+			return errors.New("configuration drift detected")
+		}
+
+		return nil
+	},
+}
+
+func collectNodeConfigs(ctx context.Context, clientFactory *global.ClientFactory, normalizer *configdrift.Normalizer) ([]configdrift.Node, error) {
+	nodes := make([]configdrift.Node, 0, len(clientFactory.Nodes()))
+
+	for _, node := range clientFactory.Nodes() {
+		nodeCtx, nodeClient, err := clientFactory.BuildClient(ctx, node)
+		if err != nil {
+			return nil, fmt.Errorf("error building client for node %s: %w", node, err)
+		}
+
+		mc, err := nodeClient.COSI.Get(nodeCtx,
+			resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.ActiveID, resource.VersionUndefined),
+			state.WithGetUnmarshalOptions(state.WithSkipProtobufUnmarshal()),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error getting machine config on node %s: %w", node, err)
+		}
+
+		body, err := extractMachineConfigBody(mc)
+		if err != nil {
+			return nil, fmt.Errorf("error extracting machine config on node %s: %w", node, err)
+		}
+
+		cfg, err := configloader.NewFromBytes(body)
+		if err != nil {
+			return nil, fmt.Errorf("error loading machine config on node %s: %w", node, err)
+		}
+
+		normalized, err := normalizer.Normalize(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("error normalizing machine config on node %s: %w", node, err)
+		}
+
+		nodes = append(nodes, configdrift.Node{Name: node, Config: normalized})
+	}
+
+	return nodes, nil
+}
+
+// compareDesired compares each node config against the desired config, and returns the drifted nodes.
+func compareDesired(nodes []configdrift.Node, normalizer *configdrift.Normalizer, dir string) ([]configdrift.Drift, error) {
+	var drifts []configdrift.Drift
+
+	for _, node := range nodes {
+		desiredPath := filepath.Join(dir, node.Name+".yaml")
+
+		if _, err := os.Stat(desiredPath); errors.Is(err, os.ErrNotExist) {
+			desiredPath = filepath.Join(dir, node.Config.MachineType+".yaml")
+		}
+
+		cfg, err := configloader.NewFromFile(desiredPath)
+		if err != nil {
+			return nil, fmt.Errorf("error loading desired config for node %s: %w", node.Name, err)
+		}
+
+		desired, err := normalizer.Normalize(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("error normalizing desired config %s: %w", desiredPath, err)
+		}
+
+		drift, err := configdrift.Compare(node.Name, node.Config, desiredPath, desired)
+		if err != nil {
+			return nil, err
+		}
+
+		if drift.Drifted() {
+			drifts = append(drifts, drift)
+		}
+	}
+
+	return drifts, nil
+}
+
+func printDriftReport(w io.Writer, report configdrift.Report) {
+	printDrift := func(drift configdrift.Drift) {
+		fmt.Fprintf(w, "\n%s differs from %s in %d field(s):\n", drift.Node, drift.Against, len(drift.Fields))
+
+		for _, field := range drift.Fields {
+			fmt.Fprintf(w, "  %s\n", field)
+		}
+
+		fmt.Fprintf(w, "\n%s", drift.Diff)
+	}
+
+	for _, group := range report.Groups {
+		fmt.Fprintf(w, "%s: %d node(s), reference %s, %d outlier(s)\n", group.MachineType, len(group.Nodes), group.Reference, len(group.Outliers))
+
+		for _, drift := range group.Outliers {
+			printDrift(drift)
+		}
+	}
+
+	if driftCmdFlags.desired != "" {
+		fmt.Fprintf(w, "desired: %d node(s) drifted from %s\n", len(report.Desired), driftCmdFlags.desired)
+
+		for _, drift := range report.Desired {
+			printDrift(drift)
+		}
+	}
+
+	if !report.Drifted() {
+		fmt.Fprintln(w, "no configuration drift detected")
+	}
+}
+
+func init() {
+	driftCmd.Flags().StringSliceVar(&driftCmdFlags.ignore, "ignore", configdrift.DefaultIgnore,
+		"fields to ignore in the comparison, as '<document>[:<path>]' patterns")
+	driftCmd.Flags().StringVar(&driftCmdFlags.filter, "filter", "",
+		"CEL expression matching additional fields to ignore (variables: doc, path, value)")
+	driftCmd.Flags().StringVar(&driftCmdFlags.desired, "desired", "",
+		"directory with the desired configs ('<node>.yaml' or '<machine type>.yaml') to compare the nodes against")
+	driftCmd.Flags().StringVarP(&driftCmdFlags.output, "output", "o", "text", "output format (text, json)")
+	driftCmd.Flags().BoolVar(&driftCmdFlags.exitCode, "exit-code", false, "exit with a non-zero code if any drift is detected")
+	addCommand(driftCmd)
+}
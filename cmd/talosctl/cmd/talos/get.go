@@ -18,6 +18,7 @@ import (
 	"github.com/siderolabs/talos/cmd/talosctl/cmd/talos/output"
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/global"
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/resourcefilter"
 	"github.com/siderolabs/talos/pkg/cli"
 	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
 )
@@ -25,9 +26,13 @@ import (
 var getCmdFlags struct {
 	global.InsecureFlags
 
-	namespace string
-	output    string
-	watch     bool
+	namespace     string
+	output        string
+	labelSelector string
+	fieldSelector string
+	filter        string
+	sortBy        string
+	watch         bool
 }
 
 // getCmd represents the get (resources) command.
@@ -37,8 +42,20 @@ var getCmd = &cobra.Command{
 	SuggestFor: []string{},
 	Short:      "Get a specific resource or list of resources (use 'talosctl get rd' to see all available resource types).",
 	Long: `Similar to 'kubectl get', 'talosctl get' returns a set of resources from the OS.
-To get a list of all available resource definitions, issue 'talosctl get rd'`,
-	Example: "",
+To get a list of all available resource definitions, issue 'talosctl get rd'
+
+Label selectors and 'metadata.id' field selector terms are evaluated by the node, so only the matching resources are returned.
+The node can't query the resource contents, so the other field selectors and CEL filters are evaluated on the client
+after the resources are received from the node, use them together with a label selector to reduce the amount of data transferred.
+The field paths are dotted paths in the resource YAML representation, e.g. 'spec.operationalState' or 'metadata.phase'.
+CEL filters can access 'metadata' and 'spec' variables, an expression which fails to evaluate
+(e.g. on a missing field, use 'has()' for the optional fields) is reported as an error.
+
+With '--output diff' each watch event is printed as a diff of the resource YAML against the previous version.`,
+	Example: `  talosctl get links -l talos.dev/managed-by=networkd
+  talosctl get addresses --field-selector spec.linkName=eth0
+  talosctl get members --filter 'spec.machineType == "controlplane"' --sort-by spec.hostname
+  talosctl get links -w -o diff`,
 	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		switch len(args) {
 		case 0:
@@ -70,6 +87,15 @@ func getResources(ctx context.Context, args []string, clientFactory *global.Clie
 		return err
 	}
 
+	if getCmdFlags.watch && getCmdFlags.sortBy != "" {
+		return errors.New("--sort-by can't be used with --watch")
+	}
+
+	filter, err := resourcefilter.NewFilter(getCmdFlags.labelSelector, getCmdFlags.fieldSelector, getCmdFlags.filter)
+	if err != nil {
+		return err
+	}
+
 	out, err := output.NewWriter(getCmdFlags.output)
 	if err != nil {
 		return err
@@ -101,22 +127,28 @@ func getResources(ctx context.Context, args []string, clientFactory *global.Clie
 	}
 
 	if getCmdFlags.watch { // get -w <type> OR get -w <type> <id>
-		return watchResources(ctx, out, clientFactory, rd, resourceID)
+		return watchResources(ctx, out, clientFactory, rd, resourceID, filter)
 	}
 
 	// get <type>
 	// get <type> <id>
-	return listResources(ctx, out, clientFactory, rd, resourceID)
+	return listResources(ctx, out, clientFactory, rd, resourceID, filter)
 }
 
-func listResources(ctx context.Context, out output.Writer, clientFactory *global.ClientFactory, rd *meta.ResourceDefinition, resourceID string) error {
+//nolint:gocyclo
+func listResources(
+	ctx context.Context, out output.Writer, clientFactory *global.ClientFactory, rd *meta.ResourceDefinition, resourceID string, filter *resourcefilter.Filter,
+) error {
 	if err := out.WriteHeader(rd, false); err != nil {
 		return err
 	}
 
 	resourceType := rd.TypedSpec().Type
 
-	var errs error
+	var (
+		errs  error
+		items []resourcefilter.Item
+	)
 
 	for _, node := range clientFactory.Nodes() {
 		nodeCtx, nodeClient, err := clientFactory.BuildClient(ctx, node)
@@ -127,10 +159,12 @@ func listResources(ctx context.Context, out output.Writer, clientFactory *global
 		}
 
 		if resourceID == "" {
-			items, err := nodeClient.COSI.List(
+			list, err := nodeClient.COSI.List(
 				nodeCtx,
 				resource.NewMetadata(getCmdFlags.namespace, resourceType, "", resource.VersionUndefined),
 				state.WithListUnmarshalOptions(state.WithSkipProtobufUnmarshal()),
+				state.WithLabelQuery(filter.LabelQuery()...),
+				state.WithIDQuery(filter.IDQuery()...),
 			)
 			if err != nil {
 				errs = errors.Join(errs, fmt.Errorf("error listing resources on node %s: %w", node, err))
@@ -138,10 +172,8 @@ func listResources(ctx context.Context, out output.Writer, clientFactory *global
 				continue
 			}
 
-			for _, item := range items.Items {
-				if err = out.WriteResource(node, item, 0); err != nil {
-					errs = errors.Join(errs, err)
-				}
+			for _, item := range list.Items {
+				items = append(items, resourcefilter.Item{Resource: item, Node: node})
 			}
 		} else {
 			r, err := nodeClient.COSI.Get(
@@ -155,17 +187,45 @@ func listResources(ctx context.Context, out output.Writer, clientFactory *global
 				continue
 			}
 
-			if err = out.WriteResource(node, r, 0); err != nil {
-				errs = errors.Join(errs, err)
-			}
+			items = append(items, resourcefilter.Item{Resource: r, Node: node})
+		}
+	}
+
+	// filter first, so that only the matching resources are sorted
+	matched := make([]resourcefilter.Item, 0, len(items))
+
+	for _, item := range items {
+		ok, err := filter.Match(item.Resource)
+		if err != nil {
+			errs = errors.Join(errs, err)
+
+			continue
+		}
+
+		if ok {
+			matched = append(matched, item)
+		}
+	}
+
+	if getCmdFlags.sortBy != "" {
+		if err := resourcefilter.SortBy(matched, getCmdFlags.sortBy); err != nil {
+			return errors.Join(errs, err)
+		}
+	}
+
+	for _, item := range matched {
+		if err := out.WriteResource(item.Node, item.Resource, 0); err != nil {
+			errs = errors.Join(errs, err)
 		}
 	}
 
 	return errs
 }
 
-//nolint:gocyclo
-func watchResources(ctx context.Context, out output.Writer, clientFactory *global.ClientFactory, rd *meta.ResourceDefinition, resourceID string) error {
+//nolint:gocyclo,cyclop
+func watchResources(
+	ctx context.Context, out output.Writer, clientFactory *global.ClientFactory, rd *meta.ResourceDefinition, resourceID string, filter *resourcefilter.Filter,
+) error {
 	resourceType := rd.TypedSpec().Type
 
 	if err := out.WriteHeader(rd, true); err != nil {
@@ -189,6 +249,8 @@ func watchResources(ctx context.Context, out output.Writer, clientFactory *globa
 				watchCh,
 				state.WithBootstrapContents(true),
 				state.WithWatchKindUnmarshalOptions(state.WithSkipProtobufUnmarshal()),
+				state.WatchWithLabelQuery(filter.LabelQuery()...),
+				state.WatchWithIDQuery(filter.IDQuery()...),
 			)
 		} else {
 			err = nodeClient.COSI.Watch(
@@ -208,6 +270,9 @@ func watchResources(ctx context.Context, out output.Writer, clientFactory *globa
 
 	bootstrapped := resourceID != "" // if we're watching a specific resource, we can consider it bootstrapped immediately, otherwise we need to wait for the bootstrapped event
 
+	// resources which matched the filter on the last event, keyed by node and ID
+	matchedResources := map[string]struct{}{}
+
 	for {
 		var nev nodeAndEvent
 
@@ -236,7 +301,16 @@ func watchResources(ctx context.Context, out output.Writer, clientFactory *globa
 			continue
 		}
 
-		if err := out.WriteResource(nev.node, nev.ev.Resource, nev.ev.Type); err != nil {
+		eventType, emit, err := filterEvent(filter, matchedResources, nev)
+		if err != nil {
+			return err
+		}
+
+		if !emit {
+			continue
+		}
+
+		if err := out.WriteResource(nev.node, nev.ev.Resource, eventType); err != nil {
 			return err
 		}
 
@@ -248,6 +322,43 @@ func watchResources(ctx context.Context, out output.Writer, clientFactory *globa
 	}
 }
 
+// filterEvent maps the watch event to the event of the filtered view: the resources which start
+// matching the filter are reported as created, and the resources which stop matching as destroyed.
+func filterEvent(filter *resourcefilter.Filter, matchedResources map[string]struct{}, nev nodeAndEvent) (state.EventType, bool, error) {
+	if filter.Empty() {
+		return nev.ev.Type, true, nil
+	}
+
+	key := nev.node + "/" + nev.ev.Resource.Metadata().ID()
+	_, wasMatched := matchedResources[key]
+
+	if nev.ev.Type == state.Destroyed {
+		delete(matchedResources, key)
+
+		return state.Destroyed, wasMatched, nil
+	}
+
+	matched, err := filter.Match(nev.ev.Resource)
+	if err != nil {
+		return 0, false, err
+	}
+
+	switch {
+	case matched && wasMatched:
+		return nev.ev.Type, true, nil
+	case matched:
+		matchedResources[key] = struct{}{}
+
+		return state.Created, true, nil
+	case wasMatched:
+		delete(matchedResources, key)
+
+		return state.Destroyed, true, nil
+	default:
+		return 0, false, nil
+	}
+}
+
 type nodeAndEvent struct {
 	node string
 	ev   state.Event
@@ -395,7 +506,11 @@ func completeNodes(cmd *cobra.Command, args []string, toComplete string) ([]stri
 
 func init() {
 	getCmd.Flags().StringVar(&getCmdFlags.namespace, "namespace", "", "resource namespace (default is to use default namespace per resource)")
-	getCmd.Flags().StringVarP(&getCmdFlags.output, "output", "o", "table", "output mode (json, table, yaml, jsonpath)")
+	getCmd.Flags().StringVarP(&getCmdFlags.output, "output", "o", "table", "output mode (json, table, yaml, jsonpath, diff)")
+	getCmd.Flags().StringVarP(&getCmdFlags.labelSelector, "selector", "l", "", "label selector to filter on, evaluated on the node (e.g. key1=value1,key2 in (a,b),!key3)")
+	getCmd.Flags().StringVar(&getCmdFlags.fieldSelector, "field-selector", "", "field selector to filter on, evaluated on the client except for the metadata.id terms (e.g. spec.linkName=eth0,metadata.phase!=running)")
+	getCmd.Flags().StringVar(&getCmdFlags.filter, "filter", "", "CEL expression to filter on, evaluated on the client, with 'metadata' and 'spec' variables")
+	getCmd.Flags().StringVar(&getCmdFlags.sortBy, "sort-by", "", "field path to sort the resources by (e.g. spec.hostname)")
 	getCmd.Flags().BoolVarP(&getCmdFlags.watch, "watch", "w", false, "watch resource changes")
 	getCmdFlags.InsecureFlags.AddFlags(getCmd)
 	cli.Should(getCmd.RegisterFlagCompletionFunc("output", output.CompleteOutputArg))
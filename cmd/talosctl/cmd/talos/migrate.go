@@ -0,0 +1,112 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	mgmthelpers "github.com/siderolabs/talos/cmd/talosctl/pkg/mgmt/helpers"
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+var migrateCmdFlags struct {
+	helpers.Mode
+
+	dryRun           bool
+	configTryTimeout time.Duration
+}
+
+func migrateFn(ctx context.Context, c *client.Client, node string, mc resource.Resource) error {
+	body, err := extractMachineConfigBody(mc)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := configloader.NewFromBytes(body)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "node %s:\n", node)
+
+	result, err := mgmthelpers.MigrateConfig(cfg, os.Stderr)
+	if err != nil {
+		return fmt.Errorf("node %s: %w", node, err)
+	}
+
+	if !result.Migrated() {
+		fmt.Fprintln(os.Stderr, "Apply was skipped: no changes detected.")
+
+		return nil
+	}
+
+	migrated, err := result.Config.EncodeBytes(encoder.WithComments(encoder.CommentsDisabled))
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.ApplyConfiguration(ctx, &machine.ApplyConfigurationRequest{
+		Data:           migrated,
+		Mode:           migrateCmdFlags.Mode.Mode,
+		DryRun:         migrateCmdFlags.dryRun,
+		TryModeTimeout: durationpb.New(migrateCmdFlags.configTryTimeout),
+	})
+	if err != nil {
+		return fmt.Errorf("error applying migrated config on node %s: %w", node, err)
+	}
+
+	helpers.PrintApplyResults(resp)
+
+	return nil
+}
+
+// migrateCmd represents the migrate command.
+var migrateCmd = &cobra.Command{
+	Use:   "migrate machineconfig",
+	Short: "Migrate deprecated v1alpha1 sections of the machine configuration of a Talos node into config documents.",
+	Long: `Migrate deprecated v1alpha1 sections of the machine configuration of a Talos node into the equivalent config documents.
+
+Each section is migrated only if the effective configuration stays the same, otherwise it is kept as is and reported as skipped.
+The report of the migrated sections and the diff of the config are printed before the migrated config is applied.
+Use --dry-run to review the changes without applying them.`,
+	Example: `  # review the migration of the node config
+  talosctl -n 10.5.0.2 migrate machineconfig --dry-run`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		clientFactory, err := NewClientFactory(ctx, &migrateCmdFlags)
+		if err != nil {
+			return err
+		}
+
+		defer clientFactory.Close() //nolint:errcheck
+
+		if err := helpers.ClientVersionCheck(ctx, clientFactory); err != nil {
+			return err
+		}
+
+		return helpers.MachineConfigUpdater(ctx, clientFactory, migrateFn, args)
+	},
+}
+
+func init() {
+	migrateCmd.Flags().BoolVar(&migrateCmdFlags.dryRun, "dry-run", false, "print the migration report and config diff without applying the changes")
+	migrateCmd.Flags().DurationVar(&migrateCmdFlags.configTryTimeout, "timeout", constants.ConfigTryTimeout, "the config will be rolled back after specified timeout (if try mode is selected)")
+	helpers.AddModeFlags(&migrateCmdFlags.Mode, migrateCmd)
+	addCommand(migrateCmd)
+}
@@ -0,0 +1,462 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/client/multiplex"
+)
+
+var netdiagCmdFlags struct {
+	address string
+	link    string
+	vrf     string
+}
+
+func netdiagSource() *machine.NetworkDiagnosticsSource {
+	return &machine.NetworkDiagnosticsSource{
+		Address: netdiagCmdFlags.address,
+		Link:    netdiagCmdFlags.link,
+		Vrf:     netdiagCmdFlags.vrf,
+	}
+}
+
+// netdiagRun runs the diagnostics on the nodes, and prints each response as it arrives.
+func netdiagRun[T any](
+	ctx context.Context,
+	initiate func(context.Context, *client.Client) (grpc.ServerStreamingClient[T], error),
+	printResponse func(node string, resp *T),
+) error {
+	clientFactory, err := NewClientFactory(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer clientFactory.Close() //nolint:errcheck
+
+	var errs error
+
+	for resp := range multiplex.StreamingViaFactory(ctx, clientFactory, initiate) {
+		if resp.Err != nil {
+			errs = errors.Join(errs, fmt.Errorf("error from node %s: %w", resp.Node, resp.Err))
+
+			continue
+		}
+
+		printResponse(resp.Node, resp.Payload)
+	}
+
+	return errs
+}
+
+// formatRTT formats the duration with the microsecond precision.
+func formatRTT(d *durationpb.Duration) string {
+	if d == nil {
+		return "-"
+	}
+
+	return d.AsDuration().Round(time.Microsecond).String()
+}
+
+// netdiagCmd represents the netdiag command.
+var netdiagCmd = &cobra.Command{
+	Use:   "netdiag",
+	Short: "Run network diagnostics from the node",
+	Long: `Run network diagnostics from the node network namespace.
+
+The probes use the node routing, including VRFs and KubeSpan.
+Use --source, --link and --vrf to select the source address and the link or VRF the probes are sent through.`,
+	Args: cobra.NoArgs,
+}
+
+var netdiagPingCmdFlags struct {
+	count    uint32
+	interval time.Duration
+	timeout  time.Duration
+	size     uint32
+	ttl      uint32
+}
+
+// netdiagPingCmd represents the netdiag ping command.
+var netdiagPingCmd = &cobra.Command{
+	Use:   "ping <target>",
+	Short: "Send ICMP echo requests to the target",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return netdiagRun(cmd.Context(),
+			func(ctx context.Context, c *client.Client) (grpc.ServerStreamingClient[machine.NetworkDiagnosticsPingResponse], error) {
+				return c.NetworkDiagnosticsClient.Ping(ctx, &machine.NetworkDiagnosticsPingRequest{
+					Source:   netdiagSource(),
+					Target:   args[0],
+					Count:    netdiagPingCmdFlags.count,
+					Interval: durationpb.New(netdiagPingCmdFlags.interval),
+					Timeout:  durationpb.New(netdiagPingCmdFlags.timeout),
+					Size:     netdiagPingCmdFlags.size,
+					Ttl:      netdiagPingCmdFlags.ttl,
+				})
+			},
+			func(node string, resp *machine.NetworkDiagnosticsPingResponse) {
+				if resp.GetError() != "" {
+					fmt.Printf("%s: seq=%d from=%s error: %s\n", node, resp.GetSeq(), resp.GetFrom(), resp.GetError())
+
+					return
+				}
+
+				fmt.Printf("%s: seq=%d from=%s ttl=%d time=%s\n", node, resp.GetSeq(), resp.GetFrom(), resp.GetTtl(), formatRTT(resp.GetRtt()))
+			},
+		)
+	},
+}
+
+var netdiagTracerouteCmdFlags struct {
+	icmp    bool
+	maxHops uint32
+	probes  uint32
+	timeout time.Duration
+	port    uint32
+}
+
+// netdiagTracerouteCmd represents the netdiag traceroute command.
+var netdiagTracerouteCmd = &cobra.Command{
+	Use:   "traceroute <target>",
+	Short: "Discover the hops on the path to the target",
+	Long: `Discover the hops on the path to the target.
+
+UDP probes are sent by default, use --icmp to send ICMP echo requests instead.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		protocol := machine.NetworkDiagnosticsTracerouteRequest_UDP
+		if netdiagTracerouteCmdFlags.icmp {
+			protocol = machine.NetworkDiagnosticsTracerouteRequest_ICMP
+		}
+
+		return netdiagRun(cmd.Context(),
+			func(ctx context.Context, c *client.Client) (grpc.ServerStreamingClient[machine.NetworkDiagnosticsTracerouteResponse], error) {
+				return c.NetworkDiagnosticsClient.Traceroute(ctx, &machine.NetworkDiagnosticsTracerouteRequest{
+					Source:   netdiagSource(),
+					Target:   args[0],
+					Protocol: protocol,
+					MaxHops:  netdiagTracerouteCmdFlags.maxHops,
+					Probes:   netdiagTracerouteCmdFlags.probes,
+					Timeout:  durationpb.New(netdiagTracerouteCmdFlags.timeout),
+					Port:     netdiagTracerouteCmdFlags.port,
+				})
+			},
+			func(node string, resp *machine.NetworkDiagnosticsTracerouteResponse) {
+				from := resp.GetFrom()
+				if from == "" {
+					from = "*"
+				}
+
+				line := fmt.Sprintf("%s: hop=%d probe=%d from=%s time=%s", node, resp.GetHop(), resp.GetProbe(), from, formatRTT(resp.GetRtt()))
+
+				if resp.GetError() != "" {
+					line += " error: " + resp.GetError()
+				}
+
+				if resp.GetReached() {
+					line += " (reached)"
+				}
+
+				fmt.Println(line)
+			},
+		)
+	},
+}
+
+var netdiagDNSCmdFlags struct {
+	types    []string
+	resolver string
+	tcp      bool
+	timeout  time.Duration
+}
+
+// netdiagDNSCmd represents the netdiag dns command.
+var netdiagDNSCmd = &cobra.Command{
+	Use:   "dns <name>",
+	Short: "Resolve a name through the host DNS or the chosen resolver",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return netdiagRun(cmd.Context(),
+			func(ctx context.Context, c *client.Client) (grpc.ServerStreamingClient[machine.NetworkDiagnosticsDNSLookupResponse], error) {
+				return c.NetworkDiagnosticsClient.DNSLookup(ctx, &machine.NetworkDiagnosticsDNSLookupRequest{
+					Source:   netdiagSource(),
+					Name:     args[0],
+					Types:    netdiagDNSCmdFlags.types,
+					Resolver: netdiagDNSCmdFlags.resolver,
+					Tcp:      netdiagDNSCmdFlags.tcp,
+					Timeout:  durationpb.New(netdiagDNSCmdFlags.timeout),
+				})
+			},
+			func(node string, resp *machine.NetworkDiagnosticsDNSLookupResponse) {
+				if resp.GetError() != "" {
+					fmt.Printf("%s: %s via %s error: %s\n", node, resp.GetType(), resp.GetResolver(), resp.GetError())
+
+					return
+				}
+
+				fmt.Printf("%s: %s via %s %s time=%s\n", node, resp.GetType(), resp.GetResolver(), resp.GetRcode(), formatRTT(resp.GetRtt()))
+
+				for _, record := range resp.GetAnswers() {
+					fmt.Printf("%s:   %s\t%d\t%s\t%s\n", node, record.GetName(), record.GetTtl(), record.GetType(), record.GetValue())
+				}
+			},
+		)
+	},
+}
+
+var netdiagTCPCmdFlags struct {
+	count    uint32
+	interval time.Duration
+	timeout  time.Duration
+}
+
+// netdiagTCPCmd represents the netdiag tcp command.
+var netdiagTCPCmd = &cobra.Command{
+	Use:   "tcp <host:port>",
+	Short: "Open TCP connections to the target",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return netdiagRun(cmd.Context(),
+			func(ctx context.Context, c *client.Client) (grpc.ServerStreamingClient[machine.NetworkDiagnosticsTCPConnectResponse], error) {
+				return c.NetworkDiagnosticsClient.TCPConnect(ctx, &machine.NetworkDiagnosticsTCPConnectRequest{
+					Source:   netdiagSource(),
+					Target:   args[0],
+					Count:    netdiagTCPCmdFlags.count,
+					Interval: durationpb.New(netdiagTCPCmdFlags.interval),
+					Timeout:  durationpb.New(netdiagTCPCmdFlags.timeout),
+				})
+			},
+			func(node string, resp *machine.NetworkDiagnosticsTCPConnectResponse) {
+				if resp.GetError() != "" {
+					fmt.Printf("%s: seq=%d error: %s\n", node, resp.GetSeq(), resp.GetError())
+
+					return
+				}
+
+				fmt.Printf("%s: seq=%d local=%s remote=%s time=%s\n", node, resp.GetSeq(), resp.GetLocalAddress(), resp.GetRemoteAddress(), formatRTT(resp.GetRtt()))
+			},
+		)
+	},
+}
+
+var netdiagTLSCmdFlags struct {
+	serverName string
+	alpn       []string
+	insecure   bool
+	timeout    time.Duration
+}
+
+// netdiagTLSCmd represents the netdiag tls command.
+var netdiagTLSCmd = &cobra.Command{
+	Use:   "tls <host:port>",
+	Short: "Perform the TLS handshake with the target and show the certificate chain",
+	Long: `Perform the TLS handshake with the target and show the certificate chain.
+
+The certificate chain is verified against the node trusted CAs, and the verification error is reported.
+Use --insecure to not fail on the verification error.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return netdiagRun(cmd.Context(),
+			func(ctx context.Context, c *client.Client) (grpc.ServerStreamingClient[machine.NetworkDiagnosticsTLSHandshakeResponse], error) {
+				return c.NetworkDiagnosticsClient.TLSHandshake(ctx, &machine.NetworkDiagnosticsTLSHandshakeRequest{
+					Source:             netdiagSource(),
+					Target:             args[0],
+					ServerName:         netdiagTLSCmdFlags.serverName,
+					Alpn:               netdiagTLSCmdFlags.alpn,
+					InsecureSkipVerify: netdiagTLSCmdFlags.insecure,
+					Timeout:            durationpb.New(netdiagTLSCmdFlags.timeout),
+				})
+			},
+			printTLSHandshake,
+		)
+	},
+}
+
+func printTLSHandshake(node string, resp *machine.NetworkDiagnosticsTLSHandshakeResponse) {
+	fmt.Printf("%s: local=%s remote=%s connect=%s handshake=%s\n",
+		node, resp.GetLocalAddress(), resp.GetRemoteAddress(), formatRTT(resp.GetConnectTime()), formatRTT(resp.GetHandshakeTime()))
+
+	if resp.GetVersion() != "" {
+		fmt.Printf("%s: version=%s cipher=%s alpn=%s\n", node, resp.GetVersion(), resp.GetCipherSuite(), resp.GetAlpn())
+	}
+
+	for i, cert := range resp.GetCertificates() {
+		fmt.Printf("%s: certificate %d:\n", node, i)
+		fmt.Printf("%s:   subject:     %s\n", node, cert.GetSubject())
+		fmt.Printf("%s:   issuer:      %s\n", node, cert.GetIssuer())
+
+		if names := slices.Concat(cert.GetDnsNames(), cert.GetIpAddresses()); len(names) > 0 {
+			fmt.Printf("%s:   SANs:        %s\n", node, strings.Join(names, ", "))
+		}
+
+		fmt.Printf("%s:   valid:       %s - %s (expires %s)\n", node,
+			cert.GetNotBefore().AsTime().Format(time.RFC3339), cert.GetNotAfter().AsTime().Format(time.RFC3339), humanize.Time(cert.GetNotAfter().AsTime()))
+		fmt.Printf("%s:   serial:      %s\n", node, cert.GetSerialNumber())
+		fmt.Printf("%s:   algorithm:   %s (%s)\n", node, cert.GetSignatureAlgorithm(), cert.GetPublicKeyAlgorithm())
+		fmt.Printf("%s:   sha256:      %s\n", node, cert.GetFingerprint())
+
+		if cert.GetIsCa() {
+			fmt.Printf("%s:   CA:          true\n", node)
+		}
+	}
+
+	if resp.GetVerificationError() != "" {
+		fmt.Printf("%s: verification error: %s\n", node, resp.GetVerificationError())
+	}
+
+	if resp.GetError() != "" {
+		fmt.Printf("%s: error: %s\n", node, resp.GetError())
+	}
+}
+
+var netdiagHTTPCmdFlags struct {
+	count    uint32
+	interval time.Duration
+	timeout  time.Duration
+	insecure bool
+	headers  map[string]string
+}
+
+// netdiagHTTPCmd represents the netdiag http command.
+var netdiagHTTPCmd = &cobra.Command{
+	Use:   "http <url>",
+	Short: "Perform HTTP GET requests and show the timing of each phase",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return netdiagRun(cmd.Context(),
+			func(ctx context.Context, c *client.Client) (grpc.ServerStreamingClient[machine.NetworkDiagnosticsHTTPGetResponse], error) {
+				return c.NetworkDiagnosticsClient.HTTPGet(ctx, &machine.NetworkDiagnosticsHTTPGetRequest{
+					Source:             netdiagSource(),
+					Url:                args[0],
+					Count:              netdiagHTTPCmdFlags.count,
+					Interval:           durationpb.New(netdiagHTTPCmdFlags.interval),
+					Timeout:            durationpb.New(netdiagHTTPCmdFlags.timeout),
+					InsecureSkipVerify: netdiagHTTPCmdFlags.insecure,
+					Headers:            netdiagHTTPCmdFlags.headers,
+				})
+			},
+			func(node string, resp *machine.NetworkDiagnosticsHTTPGetResponse) {
+				line := fmt.Sprintf("%s: seq=%d", node, resp.GetSeq())
+
+				if resp.GetStatusCode() != 0 {
+					line += fmt.Sprintf(" status=%d proto=%s remote=%s size=%s", resp.GetStatusCode(), resp.GetProto(), resp.GetRemoteAddress(), humanize.Bytes(resp.GetBodySize()))
+				}
+
+				line += fmt.Sprintf(" dns=%s connect=%s tls=%s first-byte=%s total=%s",
+					formatRTT(resp.GetDnsTime()), formatRTT(resp.GetConnectTime()), formatRTT(resp.GetTlsTime()), formatRTT(resp.GetFirstByteTime()), formatRTT(resp.GetTotalTime()))
+
+				if resp.GetError() != "" {
+					line += " error: " + resp.GetError()
+				}
+
+				fmt.Println(line)
+			},
+		)
+	},
+}
+
+var netdiagPMTUCmdFlags struct {
+	maxMTU  uint32
+	timeout time.Duration
+}
+
+// netdiagPMTUCmd represents the netdiag pmtu command.
+var netdiagPMTUCmd = &cobra.Command{
+	Use:   "pmtu <target>",
+	Short: "Discover the path MTU to the target",
+	Long: `Discover the path MTU to the target.
+
+ICMP echo requests with the 'don't fragment' flag are sent, and the MTU is searched between the minimum MTU and the MTU of the outgoing link.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return netdiagRun(cmd.Context(),
+			func(ctx context.Context, c *client.Client) (grpc.ServerStreamingClient[machine.NetworkDiagnosticsPathMTUResponse], error) {
+				return c.NetworkDiagnosticsClient.PathMTU(ctx, &machine.NetworkDiagnosticsPathMTURequest{
+					Source:  netdiagSource(),
+					Target:  args[0],
+					MaxMtu:  netdiagPMTUCmdFlags.maxMTU,
+					Timeout: durationpb.New(netdiagPMTUCmdFlags.timeout),
+				})
+			},
+			func(node string, resp *machine.NetworkDiagnosticsPathMTUResponse) {
+				switch {
+				case resp.GetPathMtu() != 0:
+					fmt.Printf("%s: path MTU %d\n", node, resp.GetPathMtu())
+				case resp.GetMtu() == 0:
+					fmt.Printf("%s: error: %s\n", node, resp.GetError())
+				case resp.GetOk():
+					fmt.Printf("%s: mtu=%d ok\n", node, resp.GetMtu())
+				default:
+					fmt.Printf("%s: mtu=%d error: %s\n", node, resp.GetMtu(), resp.GetError())
+				}
+			},
+		)
+	},
+}
+
+func init() {
+	netdiagCmd.PersistentFlags().StringVar(&netdiagCmdFlags.address, "source", "", "source address of the probes")
+	netdiagCmd.PersistentFlags().StringVar(&netdiagCmdFlags.link, "link", "", "link to send the probes through")
+	netdiagCmd.PersistentFlags().StringVar(&netdiagCmdFlags.vrf, "vrf", "", "VRF to send the probes through")
+	netdiagCmd.MarkFlagsMutuallyExclusive("link", "vrf")
+
+	netdiagPingCmd.Flags().Uint32Var(&netdiagPingCmdFlags.count, "count", 4, "number of echo requests to send")
+	netdiagPingCmd.Flags().DurationVarP(&netdiagPingCmdFlags.interval, "interval", "i", time.Second, "interval between the echo requests")
+	netdiagPingCmd.Flags().DurationVar(&netdiagPingCmdFlags.timeout, "timeout", time.Second, "timeout to wait for each echo reply")
+	netdiagPingCmd.Flags().Uint32VarP(&netdiagPingCmdFlags.size, "size", "s", 56, "size of the echo payload in bytes")
+	netdiagPingCmd.Flags().Uint32Var(&netdiagPingCmdFlags.ttl, "ttl", 0, "TTL (hop limit) of the echo requests (default: system default)")
+	netdiagCmd.AddCommand(netdiagPingCmd)
+
+	netdiagTracerouteCmd.Flags().BoolVar(&netdiagTracerouteCmdFlags.icmp, "icmp", false, "send ICMP echo requests instead of UDP probes")
+	netdiagTracerouteCmd.Flags().Uint32Var(&netdiagTracerouteCmdFlags.maxHops, "max-hops", 30, "maximum number of hops")
+	netdiagTracerouteCmd.Flags().Uint32Var(&netdiagTracerouteCmdFlags.probes, "probes", 3, "number of probes per hop")
+	netdiagTracerouteCmd.Flags().DurationVar(&netdiagTracerouteCmdFlags.timeout, "timeout", time.Second, "timeout to wait for each probe")
+	netdiagTracerouteCmd.Flags().Uint32Var(&netdiagTracerouteCmdFlags.port, "port", 33434, "destination port of the first UDP probe")
+	netdiagCmd.AddCommand(netdiagTracerouteCmd)
+
+	netdiagDNSCmd.Flags().StringSliceVarP(&netdiagDNSCmdFlags.types, "type", "t", []string{"A", "AAAA"}, "record types to query")
+	netdiagDNSCmd.Flags().StringVar(&netdiagDNSCmdFlags.resolver, "resolver", "", "resolver address with an optional port (default: host DNS)")
+	netdiagDNSCmd.Flags().BoolVar(&netdiagDNSCmdFlags.tcp, "tcp", false, "use TCP instead of UDP")
+	netdiagDNSCmd.Flags().DurationVar(&netdiagDNSCmdFlags.timeout, "timeout", 5*time.Second, "timeout of each query")
+	netdiagCmd.AddCommand(netdiagDNSCmd)
+
+	netdiagTCPCmd.Flags().Uint32Var(&netdiagTCPCmdFlags.count, "count", 1, "number of connections to open")
+	netdiagTCPCmd.Flags().DurationVarP(&netdiagTCPCmdFlags.interval, "interval", "i", time.Second, "interval between the connections")
+	netdiagTCPCmd.Flags().DurationVar(&netdiagTCPCmdFlags.timeout, "timeout", 5*time.Second, "timeout of each connection")
+	netdiagCmd.AddCommand(netdiagTCPCmd)
+
+	netdiagTLSCmd.Flags().StringVar(&netdiagTLSCmdFlags.serverName, "server-name", "", "server name for SNI and verification (default: target host)")
+	netdiagTLSCmd.Flags().StringSliceVar(&netdiagTLSCmdFlags.alpn, "alpn", nil, "ALPN protocols to offer")
+	netdiagTLSCmd.Flags().BoolVar(&netdiagTLSCmdFlags.insecure, "insecure", false, "don't fail on the certificate verification error")
+	netdiagTLSCmd.Flags().DurationVar(&netdiagTLSCmdFlags.timeout, "timeout", 10*time.Second, "timeout of the connection and the handshake")
+	netdiagCmd.AddCommand(netdiagTLSCmd)
+
+	netdiagHTTPCmd.Flags().Uint32Var(&netdiagHTTPCmdFlags.count, "count", 1, "number of requests")
+	netdiagHTTPCmd.Flags().DurationVarP(&netdiagHTTPCmdFlags.interval, "interval", "i", time.Second, "interval between the requests")
+	netdiagHTTPCmd.Flags().DurationVar(&netdiagHTTPCmdFlags.timeout, "timeout", 10*time.Second, "timeout of each request")
+	netdiagHTTPCmd.Flags().BoolVar(&netdiagHTTPCmdFlags.insecure, "insecure", false, "skip the TLS certificate verification")
+	netdiagHTTPCmd.Flags().StringToStringVarP(&netdiagHTTPCmdFlags.headers, "header", "H", nil, "request headers")
+	netdiagCmd.AddCommand(netdiagHTTPCmd)
+
+	netdiagPMTUCmd.Flags().Uint32Var(&netdiagPMTUCmdFlags.maxMTU, "max-mtu", 0, "maximum MTU to probe (default: MTU of the outgoing link)")
+	netdiagPMTUCmd.Flags().DurationVar(&netdiagPMTUCmdFlags.timeout, "timeout", time.Second, "timeout to wait for each probe")
+	netdiagCmd.AddCommand(netdiagPMTUCmd)
+
+	addCommand(netdiagCmd)
+}
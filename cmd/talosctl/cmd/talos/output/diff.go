@@ -0,0 +1,95 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/state"
+	yaml "go.yaml.in/yaml/v4"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/textdiff"
+)
+
+// Diff outputs resource changes as unified diffs of the YAML representation.
+//
+// The first event for each resource is printed as a diff from an empty document.
+type Diff struct {
+	writer io.Writer
+	last   map[string]string
+}
+
+// NewDiff initializes diff resource output.
+func NewDiff(writer io.Writer) *Diff {
+	return &Diff{
+		writer: writer,
+		last:   map[string]string{},
+	}
+}
+
+// WriteHeader implements output.Writer interface.
+func (d *Diff) WriteHeader(definition *meta.ResourceDefinition, withEvents bool) error {
+	return nil
+}
+
+// WriteResource implements output.Writer interface.
+func (d *Diff) WriteResource(node string, r resource.Resource, event state.EventType) error {
+	if r.Metadata().Type() == config.MachineConfigType && r.Metadata().Annotations().Empty() {
+		r = &mcYamlRepr{r}
+	}
+
+	key := fmt.Sprintf("%s/%s/%s/%s", node, r.Metadata().Namespace(), r.Metadata().Type(), r.Metadata().ID())
+
+	var current string
+
+	if event != state.Destroyed {
+		out, err := resource.MarshalYAML(r)
+		if err != nil {
+			return err
+		}
+
+		var sb strings.Builder
+
+		enc := yaml.NewEncoder(&sb)
+		enc.SetIndent(4)
+
+		if err = enc.Encode(out); err != nil {
+			return err
+		}
+
+		current = sb.String()
+	}
+
+	previous := d.last[key]
+
+	if event == state.Destroyed {
+		delete(d.last, key)
+	} else {
+		d.last[key] = current
+	}
+
+	if previous == current {
+		return nil
+	}
+
+	diff, err := textdiff.DiffWithCustomPaths(previous, current, "a/"+key, "b/"+key)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(d.writer, "# node: %s, event: %s\n%s", node, strings.ToLower(event.String()), diff)
+
+	return nil
+}
+
+// Flush implements output.Writer interface.
+func (d *Diff) Flush() error {
+	return nil
+}
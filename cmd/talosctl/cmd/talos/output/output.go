@@ -35,6 +35,8 @@ func NewWriter(format string) (Writer, error) {
 		return NewYAML(writer), nil
 	case format == "json":
 		return NewJSON(writer), nil
+	case format == "diff":
+		return NewDiff(writer), nil
 	case strings.HasPrefix(format, "jsonpath="):
 		path := format[len("jsonpath="):]
 
@@ -52,5 +54,5 @@ func NewWriter(format string) (Writer, error) {
 
 // CompleteOutputArg represents tab completion for `--output` argument.
 func CompleteOutputArg(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-	return []string{"json", "table", "yaml", "jsonpath"}, cobra.ShellCompDirectiveNoFileComp
+	return []string{"json", "table", "yaml", "jsonpath", "diff"}, cobra.ShellCompDirectiveNoFileComp
 }
@@ -6,19 +6,30 @@ package talos
 
 import (
 	"context"
+	stdx509 "crypto/x509"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"slices"
 	"strings"
+	"time"
 
+	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/siderolabs/gen/maps"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/action"
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/internal/pkg/secureerase"
 	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/api/storage"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 	"github.com/siderolabs/talos/pkg/machinery/client/multiplex"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 )
 
 var wipeOptions = map[string]machineapi.ResetRequest_WipeMode{
@@ -75,8 +86,11 @@ var resetCmdFlags struct {
 	graceful           bool
 	reboot             bool
 	wipeMode           WipeMode
+	wipeMethod         string
 	userDisksToWipe    []string
 	systemLabelsToWipe []string
+	eraseReportDir     string
+	eraseReportTimeout time.Duration
 }
 
 // resetCmd represents the reset command.
@@ -90,7 +104,10 @@ var resetCmd = &cobra.Command{
 			resetCmdFlags.wait = true
 		}
 
-		resetRequest := buildResetRequest()
+		resetRequest, err := buildResetRequest()
+		if err != nil {
+			return err
+		}
 
 		ctx := cmd.Context()
 
@@ -101,6 +118,14 @@ var resetCmd = &cobra.Command{
 
 		defer clientFactory.Close() //nolint:errcheck
 
+		if resetCmdFlags.eraseReportDir != "" {
+			if err = helpers.ClientVersionCheck(ctx, clientFactory); err != nil {
+				return err
+			}
+
+			return resetWithEraseReport(ctx, clientFactory, resetRequest)
+		}
+
 		if !resetCmdFlags.wait {
 			if err := helpers.ClientVersionCheck(ctx, clientFactory); err != nil {
 				return err
@@ -165,7 +190,7 @@ var resetCmd = &cobra.Command{
 	},
 }
 
-func buildResetRequest() *machineapi.ResetRequest {
+func buildResetRequest() (*machineapi.ResetRequest, error) {
 	systemPartitionsToWipe := make([]*machineapi.ResetPartitionSpec, 0, len(resetCmdFlags.systemLabelsToWipe))
 
 	for _, label := range resetCmdFlags.systemLabelsToWipe {
@@ -175,13 +200,157 @@ func buildResetRequest() *machineapi.ResetRequest {
 		})
 	}
 
-	return &machineapi.ResetRequest{
+	method, ok := storage.BlockDeviceWipeDescriptor_Method_value[resetCmdFlags.wipeMethod]
+	if !ok {
+		return nil, fmt.Errorf("invalid wipe method %q", resetCmdFlags.wipeMethod)
+	}
+
+	req := &machineapi.ResetRequest{
 		Graceful:               resetCmdFlags.graceful,
 		Reboot:                 resetCmdFlags.reboot,
 		UserDisksToWipe:        resetCmdFlags.userDisksToWipe,
 		Mode:                   machineapi.ResetRequest_WipeMode(resetCmdFlags.wipeMode),
 		SystemPartitionsToWipe: systemPartitionsToWipe,
+		WipeMethod:             storage.BlockDeviceWipeDescriptor_Method(method),
+	}
+
+	if resetCmdFlags.eraseReportDir != "" {
+		req.EraseReportTimeout = durationpb.New(resetCmdFlags.eraseReportTimeout)
+	}
+
+	return req, nil
+}
+
+// resetWithEraseReport resets the nodes, and fetches the erase report from each node before it powers off.
+func resetWithEraseReport(ctx context.Context, clientFactory multiplex.ClientFactory, resetRequest *machineapi.ResetRequest) error {
+	if err := os.MkdirAll(resetCmdFlags.eraseReportDir, 0o755); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, resetCmdFlags.timeout)
+	defer cancel()
+
+	responseChan := multiplex.UnaryViaFactory(
+		ctx, clientFactory,
+		func(ctx context.Context, c *client.Client) (*verifiedEraseReport, error) {
+			// resolve the roots before the reset, as the report can't be verified otherwise
+			roots, err := eraseReportRoots(c)
+			if err != nil {
+				return nil, err
+			}
+
+			if err = c.ResetGeneric(ctx, resetRequest); err != nil {
+				return nil, err
+			}
+
+			signed, err := fetchEraseReport(ctx, c)
+			if err != nil {
+				return nil, err
+			}
+
+			return &verifiedEraseReport{signed: signed, roots: roots}, nil
+		},
+	)
+
+	var errs error
+
+	for resp := range responseChan {
+		if resp.Err == nil {
+			resp.Err = saveEraseReport(resp.Node, resp.Value.signed, resp.Value.roots)
+		}
+
+		if resp.Err != nil {
+			errs = errors.Join(errs, fmt.Errorf("error executing reset on node %s: %w", resp.Node, resp.Err))
+		}
+	}
+
+	return errs
+}
+
+// fetchEraseReport waits for the erase report to be published by the node.
+func fetchEraseReport(ctx context.Context, c *client.Client) (*secureerase.SignedReport, error) {
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		// the API might be unavailable for a short period of time while the services are stopped, so keep retrying
+		eraseReport, err := safe.StateGetByID[*runtime.EraseReport](ctx, c.COSI, runtime.EraseReportID)
+		if err == nil {
+			return &secureerase.SignedReport{
+				Report:      eraseReport.TypedSpec().Report,
+				Signature:   eraseReport.TypedSpec().Signature,
+				Certificate: eraseReport.TypedSpec().Certificate,
+			}, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for the erase report: %w", err)
+		case <-ticker.C:
+		}
+	}
+}
+
+// verifiedEraseReport is the erase report along with the roots its certificate should chain to.
+type verifiedEraseReport struct {
+	signed *secureerase.SignedReport
+	roots  *stdx509.CertPool
+}
+
+// eraseReportRoots returns the Talos API CA from the talosconfig context.
+//
+// The erase report is signed with the apid server certificate, which is issued by the same CA.
+func eraseReportRoots(c *client.Client) (*stdx509.CertPool, error) {
+	configContext := c.GetConfigContext()
+	if configContext == nil || configContext.CA == "" {
+		return nil, errors.New("talosconfig context has no CA, the erase report can't be verified")
+	}
+
+	caBytes, err := base64.StdEncoding.DecodeString(configContext.CA)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding CA: %w", err)
+	}
+
+	roots := stdx509.NewCertPool()
+
+	if !roots.AppendCertsFromPEM(caBytes) {
+		return nil, errors.New("failed to parse the talosconfig CA")
+	}
+
+	return roots, nil
+}
+
+// saveEraseReport verifies the erase report signature and the certificate chain, and saves the report to the erase report directory.
+func saveEraseReport(node string, signed *secureerase.SignedReport, roots *stdx509.CertPool) error {
+	report, err := signed.Verify(roots)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(signed, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(resetCmdFlags.eraseReportDir, node+".json")
+
+	if err = os.WriteFile(path, data, 0o644); err != nil {
+		return err
 	}
+
+	fmt.Printf("%s: erase report saved to %s\n", node, path)
+
+	for _, device := range report.Devices {
+		result := device.Result
+
+		if device.Error != "" {
+			result += ": " + device.Error
+		}
+
+		fmt.Printf("%s: %s (serial %q) %s: %s\n", node, device.Device, device.Serial, device.Method, result)
+	}
+
+	return nil
 }
 
 func resetGetActorID(ctx context.Context, c *client.Client, req *machineapi.ResetRequest) (string, error) {
@@ -203,6 +372,9 @@ func init() {
 	resetCmd.Flags().Var(&resetCmdFlags.wipeMode, "wipe-mode", "disk reset mode")
 	resetCmd.Flags().StringSliceVar(&resetCmdFlags.userDisksToWipe, "user-disks-to-wipe", nil, "if set, wipes defined devices in the list")
 	resetCmd.Flags().StringSliceVar(&resetCmdFlags.systemLabelsToWipe, "system-labels-to-wipe", nil, "if set, just wipe selected system disk partitions by label but keep other partitions intact")
+	resetCmd.Flags().StringVar(&resetCmdFlags.wipeMethod, "wipe-method", wipeMethodValues()[0], fmt.Sprintf("wipe method to use %s", wipeMethodValues()))
+	resetCmd.Flags().StringVar(&resetCmdFlags.eraseReportDir, "erase-report", "", "if set, fetch the signed erase report from each node before it powers off, and save it to the specified directory (waits up to --timeout)")
+	resetCmd.Flags().DurationVar(&resetCmdFlags.eraseReportTimeout, "erase-report-timeout", 5*time.Minute, "time the node waits for the erase report to be fetched before powering off")
 	resetCmdFlags.addTrackActionFlags(resetCmd)
 	addCommand(resetCmd)
 }
@@ -17,7 +17,10 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
 	"github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+	"github.com/siderolabs/talos/pkg/rotate/pki/aggregator"
+	"github.com/siderolabs/talos/pkg/rotate/pki/etcd"
 	"github.com/siderolabs/talos/pkg/rotate/pki/kubernetes"
+	"github.com/siderolabs/talos/pkg/rotate/pki/serviceaccount"
 	"github.com/siderolabs/talos/pkg/rotate/pki/talos"
 )
 
@@ -30,18 +33,29 @@ var rotateCACmdFlags struct {
 	dryRun           bool
 	rotateTalos      bool
 	rotateKubernetes bool
+	rotateEtcd       bool
+	rotateAggregator bool
+
+	rotateServiceAccount  bool
+	serviceAccountOverlap time.Duration
 }
 
 // rotateCACmd represents the rotate-ca command.
 var rotateCACmd = &cobra.Command{
 	Use:   "rotate-ca",
-	Short: "Rotate cluster CAs (Talos and Kubernetes APIs).",
+	Short: "Rotate cluster CAs (Talos and Kubernetes APIs, etcd, aggregator) and the service account key.",
 	Long: `The command can rotate both Talos and Kubernetes root CAs (for the API).
 By default both CAs are rotated, but you can choose to rotate just one or another.
 The command starts by generating new CAs, and gracefully applying it to the cluster.
 
-For Kubernetes, the command only rotates the API server issuing CA, and other Kubernetes
-PKI can be rotated by applying machine config changes to the controlplane nodes.`,
+Additionally, the command can rotate the etcd CA (--etcd), the Kubernetes aggregator CA (--aggregator)
+and the Kubernetes service account signing key (--service-account).
+
+The etcd CA is rotated one control plane node at a time, restarting etcd on each node
+and waiting for it to become healthy, so that the etcd quorum is preserved.
+
+During the service account key rotation, both old and new public keys are accepted
+for the duration of --service-account-overlap, so that the workloads can refresh their tokens.`,
 	Args: cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		err := rotateCACmdFlags.clusterState.InitNodeInfos()
@@ -104,12 +118,30 @@ func rotateCA(ctx context.Context, c *client.Client) error {
 		}
 	}
 
+	if rotateCACmdFlags.rotateEtcd {
+		if err = rotateEtcdCA(ctx, c, encoderOpt, clusterInfo, newBundle); err != nil {
+			return fmt.Errorf("error rotating etcd CA: %w", err)
+		}
+	}
+
 	if rotateCACmdFlags.rotateKubernetes {
 		if err = rotateKubernetesCA(ctx, c, encoderOpt, clusterInfo, newBundle); err != nil {
 			return fmt.Errorf("error rotating Kubernetes CA: %w", err)
 		}
 	}
 
+	if rotateCACmdFlags.rotateAggregator {
+		if err = rotateAggregatorCA(ctx, c, encoderOpt, clusterInfo, newBundle); err != nil {
+			return fmt.Errorf("error rotating Kubernetes aggregator CA: %w", err)
+		}
+	}
+
+	if rotateCACmdFlags.rotateServiceAccount {
+		if err = rotateServiceAccountKey(ctx, c, encoderOpt, clusterInfo, newBundle); err != nil {
+			return fmt.Errorf("error rotating Kubernetes service account key: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -188,6 +220,98 @@ func rotateKubernetesCA(ctx context.Context, c *client.Client, encoderOpt encode
 	return nil
 }
 
+func rotateEtcdCA(ctx context.Context, c *client.Client, encoderOpt encoder.Option, clusterInfo cluster.Info, newBundle *secrets.Bundle) error {
+	options := etcd.Options{
+		DryRun: rotateCACmdFlags.dryRun,
+
+		TalosClient: c,
+		ClusterInfo: clusterInfo,
+
+		NewEtcdCA: newBundle.Certs.Etcd,
+
+		EncoderOption: encoderOpt,
+
+		Printf: func(format string, args ...any) { fmt.Printf(format, args...) },
+	}
+
+	if err := etcd.Rotate(ctx, options); err != nil {
+		return err
+	}
+
+	if rotateCACmdFlags.dryRun {
+		fmt.Println("> Dry-run mode enabled, no changes were made to the cluster, re-run with `--dry-run=false` to apply the changes.")
+
+		return nil
+	}
+
+	fmt.Printf("> etcd CA rotation done.\n")
+
+	return nil
+}
+
+func rotateAggregatorCA(ctx context.Context, c *client.Client, encoderOpt encoder.Option, clusterInfo cluster.Info, newBundle *secrets.Bundle) error {
+	options := aggregator.Options{
+		DryRun: rotateCACmdFlags.dryRun,
+
+		TalosClient: c,
+		ClusterInfo: clusterInfo,
+
+		KubernetesEndpoint: rotateCACmdFlags.forceEndpoint,
+
+		NewAggregatorCA: newBundle.Certs.K8sAggregator,
+
+		EncoderOption: encoderOpt,
+
+		Printf: func(format string, args ...any) { fmt.Printf(format, args...) },
+	}
+
+	if err := aggregator.Rotate(ctx, options); err != nil {
+		return err
+	}
+
+	if rotateCACmdFlags.dryRun {
+		fmt.Println("> Dry-run mode enabled, no changes were made to the cluster, re-run with `--dry-run=false` to apply the changes.")
+
+		return nil
+	}
+
+	fmt.Printf("> Kubernetes aggregator CA rotation done.\n")
+
+	return nil
+}
+
+func rotateServiceAccountKey(ctx context.Context, c *client.Client, encoderOpt encoder.Option, clusterInfo cluster.Info, newBundle *secrets.Bundle) error {
+	options := serviceaccount.Options{
+		DryRun: rotateCACmdFlags.dryRun,
+
+		TalosClient: c,
+		ClusterInfo: clusterInfo,
+
+		KubernetesEndpoint: rotateCACmdFlags.forceEndpoint,
+
+		NewServiceAccountKey: newBundle.Certs.K8sServiceAccount,
+		Overlap:              rotateCACmdFlags.serviceAccountOverlap,
+
+		EncoderOption: encoderOpt,
+
+		Printf: func(format string, args ...any) { fmt.Printf(format, args...) },
+	}
+
+	if err := serviceaccount.Rotate(ctx, options); err != nil {
+		return err
+	}
+
+	if rotateCACmdFlags.dryRun {
+		fmt.Println("> Dry-run mode enabled, no changes were made to the cluster, re-run with `--dry-run=false` to apply the changes.")
+
+		return nil
+	}
+
+	fmt.Printf("> Kubernetes service account key rotation done.\n")
+
+	return nil
+}
+
 func init() {
 	addCommand(rotateCACmd)
 	rotateCACmd.Flags().StringVar(&rotateCACmdFlags.clusterState.InitNode, "init-node", "", "specify IPs of init node")
@@ -200,4 +324,8 @@ func init() {
 	rotateCACmd.Flags().BoolVarP(&rotateCACmdFlags.dryRun, "dry-run", "", true, "dry-run mode (no changes to the cluster)")
 	rotateCACmd.Flags().BoolVarP(&rotateCACmdFlags.rotateTalos, "talos", "", true, "rotate Talos API CA")
 	rotateCACmd.Flags().BoolVarP(&rotateCACmdFlags.rotateKubernetes, "kubernetes", "", true, "rotate Kubernetes API CA")
+	rotateCACmd.Flags().BoolVarP(&rotateCACmdFlags.rotateEtcd, "etcd", "", false, "rotate etcd CA")
+	rotateCACmd.Flags().BoolVarP(&rotateCACmdFlags.rotateAggregator, "aggregator", "", false, "rotate Kubernetes aggregator CA")
+	rotateCACmd.Flags().BoolVarP(&rotateCACmdFlags.rotateServiceAccount, "service-account", "", false, "rotate Kubernetes service account signing key")
+	rotateCACmd.Flags().DurationVar(&rotateCACmdFlags.serviceAccountOverlap, "service-account-overlap", time.Hour, "duration both old and new service account keys are accepted")
 }
@@ -0,0 +1,110 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package helpers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configpatcher"
+	"github.com/siderolabs/talos/pkg/machinery/config/inventory"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+)
+
+// NodeConfig is a machine config rendered for a node of the inventory.
+type NodeConfig struct {
+	Node inventory.Node
+	Data []byte
+}
+
+// RenderInventory renders the templated patches for each node of the inventory and applies them
+// on top of the base config for the node type.
+//
+// The report of the variables used by each node is written to w, the report covers all nodes even if some of them fail to render.
+func RenderInventory(inv *inventory.Inventory, patches []string, base func(machine.Type) configpatcher.Input, w io.Writer) ([]NodeConfig, error) {
+	renderer, err := inventory.NewRenderer(inv, patches)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing templated patches: %w", err)
+	}
+
+	var (
+		configs []NodeConfig
+		errs    []error
+	)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	fmt.Fprintf(tw, "NODE\tTYPE\tVARIABLES\tUNSET\tUNUSED\n")
+
+	for i, node := range inv.Nodes {
+		nodePatches, report, err := renderer.Render(i)
+
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n",
+			node.Name,
+			node.Type,
+			formatVars(report.Used),
+			formatList(report.Unset),
+			formatList(report.Unused),
+		)
+
+		if err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		patched, err := configpatcher.Apply(base(node.Type), nodePatches)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("node %q: %w", node.Name, err))
+
+			continue
+		}
+
+		data, err := patched.Bytes()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("node %q: %w", node.Name, err))
+
+			continue
+		}
+
+		configs = append(configs, NodeConfig{Node: node, Data: data})
+	}
+
+	if err = tw.Flush(); err != nil {
+		return nil, err
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return configs, nil
+}
+
+func formatVars(vars map[string]any) string {
+	if len(vars) == 0 {
+		return "-"
+	}
+
+	parts := make([]string, 0, len(vars))
+
+	for _, name := range slices.Sorted(maps.Keys(vars)) {
+		parts = append(parts, fmt.Sprintf("%s=%v", name, vars[name]))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func formatList(list []string) string {
+	if len(list) == 0 {
+		return "-"
+	}
+
+	return strings.Join(list, ",")
+}
@@ -0,0 +1,84 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package helpers
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configdiff"
+	"github.com/siderolabs/talos/pkg/machinery/config/configmigrate"
+	"github.com/siderolabs/talos/pkg/machinery/textdiff"
+)
+
+// MigrateConfig migrates the legacy v1alpha1 sections of the config and writes the report to w.
+//
+// The report lists each legacy section with the documents replacing it, followed by the diff of the config.
+// An error is returned if the migration changes the effective configuration.
+func MigrateConfig(cfg config.Provider, w io.Writer) (*configmigrate.Result, error) {
+	result, err := configmigrate.Migrate(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result.Sections) == 0 {
+		fmt.Fprintln(w, "no legacy sections found")
+
+		return result, nil
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	fmt.Fprintf(tw, "SECTION\tDOCUMENTS\tEFFECTIVE CONFIG\n")
+
+	for _, section := range result.Sections {
+		var status string
+
+		switch {
+		case section.Skipped != "":
+			status = "skipped: " + section.Skipped
+		case section.Identical():
+			status = "identical"
+		default:
+			status = "changed"
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", section.Path, formatList(section.Documents), status)
+	}
+
+	if err = tw.Flush(); err != nil {
+		return nil, err
+	}
+
+	for _, section := range result.Sections {
+		if section.Identical() {
+			continue
+		}
+
+		diff, err := textdiff.Diff(section.Before, section.After)
+		if err != nil {
+			return nil, err
+		}
+
+		fmt.Fprintf(w, "\n%s:\n%s\n", section.Path, strings.TrimSpace(diff))
+	}
+
+	if result.Migrated() {
+		diff, err := configdiff.DiffConfigs(cfg, result.Config)
+		if err != nil {
+			return nil, err
+		}
+
+		fmt.Fprintf(w, "\n%s\n", strings.TrimSpace(diff))
+	}
+
+	if err = result.Verify(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,255 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package resourcefilter filters and sorts the COSI resources by labels, fields and CEL expressions.
+//
+// The label selectors and the resource ID field terms are pushed down to the COSI state queries.
+// The COSI state can't query the resource contents, so the other field selectors and the CEL expressions
+// are evaluated on the client for each of the returned resources.
+//
+// The field paths are dotted paths in the resource YAML representation, e.g. 'metadata.id' or 'spec.operationalState'.
+package resourcefilter
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	yaml "go.yaml.in/yaml/v4"
+
+	"github.com/siderolabs/talos/pkg/machinery/cel"
+	"github.com/siderolabs/talos/pkg/machinery/cel/celenv"
+)
+
+// Fields returns the resource representation as a map with 'metadata' and 'spec' keys.
+func Fields(r resource.Resource) (map[string]any, error) {
+	out, err := resource.MarshalYAML(r)
+	if err != nil {
+		return nil, err
+	}
+
+	yamlBytes, err := yaml.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]any
+
+	if err = yaml.Unmarshal(yamlBytes, &fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+// FieldValue returns the value of the field by the dotted path, list elements are addressed by the index.
+func FieldValue(fields map[string]any, path string) (any, bool) {
+	var v any = fields
+
+	for elem := range strings.SplitSeq(strings.TrimPrefix(path, "."), ".") {
+		switch typed := v.(type) {
+		case map[string]any:
+			var ok bool
+
+			if v, ok = typed[elem]; !ok {
+				return nil, false
+			}
+		case []any:
+			idx, err := strconv.Atoi(elem)
+			if err != nil || idx < 0 || idx >= len(typed) {
+				return nil, false
+			}
+
+			v = typed[idx]
+		default:
+			return nil, false
+		}
+	}
+
+	return v, true
+}
+
+type fieldTerm struct {
+	path   string
+	value  string
+	negate bool
+}
+
+var fieldTermRe = regexp.MustCompile(`^([^\s=!]+)\s*(==|!=|=)\s*(.*)$`)
+
+// Filter matches the resources by labels, fields and CEL expression.
+type Filter struct {
+	expr   *cel.Expression
+	labels []resource.LabelQueryOption
+	fields []fieldTerm
+}
+
+// NewFilter creates a new Filter, all of the arguments are optional.
+//
+// The field selector is a comma-separated list of 'path=value', 'path==value' or 'path!=value' terms, all of which should match.
+// The expression is a CEL boolean expression (see celenv.Resource).
+func NewFilter(labelSelector, fieldSelector, expression string) (*Filter, error) {
+	labels, err := ParseLabelSelector(labelSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &Filter{labels: labels}
+
+	for _, term := range splitTerms(fieldSelector) {
+		m := fieldTermRe.FindStringSubmatch(term)
+		if m == nil {
+			return nil, fmt.Errorf("invalid field selector %q: unexpected term %q", fieldSelector, term)
+		}
+
+		f.fields = append(f.fields, fieldTerm{
+			path:   m[1],
+			value:  strings.TrimSpace(m[3]),
+			negate: m[2] == "!=",
+		})
+	}
+
+	if expression != "" {
+		expr, err := cel.ParseBooleanExpression(expression, celenv.Resource())
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter expression: %w", err)
+		}
+
+		f.expr = &expr
+	}
+
+	return f, nil
+}
+
+// LabelQuery returns the label query options to be pushed down to the COSI state.
+func (f *Filter) LabelQuery() []resource.LabelQueryOption {
+	return f.labels
+}
+
+// IDQuery returns the ID query options to be pushed down to the COSI state.
+//
+// Only the 'metadata.id' equality terms of the field selector can be evaluated by the COSI state.
+func (f *Filter) IDQuery() []resource.IDQueryOption {
+	for _, term := range f.fields {
+		if term.path == "metadata.id" && !term.negate {
+			return []resource.IDQueryOption{resource.IDRegexpMatch(regexp.MustCompile("^" + regexp.QuoteMeta(term.value) + "$"))}
+		}
+	}
+
+	return nil
+}
+
+// Empty returns true if the filter matches all resources.
+func (f *Filter) Empty() bool {
+	return len(f.labels) == 0 && len(f.fields) == 0 && f.expr == nil
+}
+
+// Match checks whether the resource matches the filter.
+//
+// The CEL expression evaluation failures (e.g. because of a missing field) are returned as errors,
+// use 'has()' to check for the optional fields.
+func (f *Filter) Match(r resource.Resource) (bool, error) {
+	if !LabelQuery(f.labels).Matches(*r.Metadata().Labels()) {
+		return false, nil
+	}
+
+	if len(f.fields) == 0 && f.expr == nil {
+		return true, nil
+	}
+
+	fields, err := Fields(r)
+	if err != nil {
+		return false, err
+	}
+
+	for _, term := range f.fields {
+		v, ok := FieldValue(fields, term.path)
+
+		if (ok && fmt.Sprint(v) == term.value) == term.negate {
+			return false, nil
+		}
+	}
+
+	if f.expr == nil {
+		return true, nil
+	}
+
+	matched, err := f.expr.EvalBool(celenv.Resource(), map[string]any{
+		"metadata": fields["metadata"],
+		"spec":     fields["spec"],
+	})
+	if err != nil {
+		return false, fmt.Errorf("error evaluating filter on %s: %w", r.Metadata().ID(), err)
+	}
+
+	return matched, nil
+}
+
+// Item is a resource returned from a node.
+type Item struct {
+	Resource resource.Resource
+	Node     string
+}
+
+// SortBy sorts the items by the value of the field, the items without the field go last.
+//
+// The values are compared as numbers if both are numeric, and as strings otherwise.
+func SortBy(items []Item, path string) error {
+	type sortKey struct {
+		value   string
+		number  float64
+		present bool
+		numeric bool
+	}
+
+	type keyedItem struct {
+		item Item
+		key  sortKey
+	}
+
+	keyed := make([]keyedItem, 0, len(items))
+
+	for _, item := range items {
+		fields, err := Fields(item.Resource)
+		if err != nil {
+			return err
+		}
+
+		var key sortKey
+
+		if v, ok := FieldValue(fields, path); ok {
+			key.present = true
+			key.value = fmt.Sprint(v)
+			key.number, err = strconv.ParseFloat(key.value, 64)
+			key.numeric = err == nil
+		}
+
+		keyed = append(keyed, keyedItem{item: item, key: key})
+	}
+
+	slices.SortStableFunc(keyed, func(a, b keyedItem) int {
+		switch {
+		case a.key.present != b.key.present:
+			if a.key.present {
+				return -1
+			}
+
+			return 1
+		case a.key.numeric && b.key.numeric:
+			return cmp.Compare(a.key.number, b.key.number)
+		default:
+			return cmp.Compare(a.key.value, b.key.value)
+		}
+	})
+
+	for i := range keyed {
+		items[i] = keyed[i].item
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package resourcefilter_test
+
+import (
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/resourcefilter"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+func newLink(id string, mtu uint32, state nethelpers.OperationalState, labels map[string]string) *network.LinkStatus {
+	link := network.NewLinkStatus(network.NamespaceName, id)
+	link.TypedSpec().MTU = mtu
+	link.TypedSpec().OperationalState = state
+
+	for k, v := range labels {
+		link.Metadata().Labels().Set(k, v)
+	}
+
+	return link
+}
+
+func TestParseLabelSelector(t *testing.T) {
+	t.Parallel()
+
+	labels := map[string]string{
+		"app":      "web",
+		"tier":     "frontend",
+		"priority": "10",
+	}
+
+	for _, test := range []struct {
+		selector string
+		matches  bool
+	}{
+		{"", true},
+		{"app", true},
+		{"!app", false},
+		{"!missing", true},
+		{"app=web", true},
+		{"app==web", true},
+		{"app!=web", false},
+		{"app=web,tier=backend", false},
+		{"app in (web, api)", true},
+		{"app notin (web,api)", false},
+		{"app in (web,api),tier notin (backend)", true},
+		{"priority<20", true},
+		{"priority<5", false},
+		{"priority>5", true},
+		{"priority>10", false},
+	} {
+		t.Run(test.selector, func(t *testing.T) {
+			t.Parallel()
+
+			opts, err := resourcefilter.ParseLabelSelector(test.selector)
+			require.NoError(t, err)
+
+			var l resource.Labels
+
+			for k, v := range labels {
+				l.Set(k, v)
+			}
+
+			assert.Equal(t, test.matches, resourcefilter.LabelQuery(opts).Matches(l))
+		})
+	}
+
+	for _, selector := range []string{
+		"app in web",
+		"=web",
+		"app,,tier",
+	} {
+		_, err := resourcefilter.ParseLabelSelector(selector)
+		assert.Error(t, err, selector)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	link := newLink("eth0", 1500, nethelpers.OperStateUp, map[string]string{"app": "web"})
+
+	for _, test := range []struct {
+		name          string
+		labelSelector string
+		fieldSelector string
+		expression    string
+		matches       bool
+	}{
+		{name: "empty", matches: true},
+		{name: "label", labelSelector: "app=web", matches: true},
+		{name: "label mismatch", labelSelector: "app=api", matches: false},
+		{name: "field", fieldSelector: "metadata.id=eth0,spec.mtu=1500", matches: true},
+		{name: "field mismatch", fieldSelector: "spec.mtu!=1500", matches: false},
+		{name: "field missing", fieldSelector: "spec.missing!=foo", matches: true},
+		{name: "cel", expression: `spec.mtu > 1000 && metadata.id.startsWith("eth")`, matches: true},
+		{name: "cel mismatch", expression: `spec.operationalState == "down"`, matches: false},
+		{name: "cel optional field", expression: `has(spec.missing) && spec.missing == "foo"`, matches: false},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			filter, err := resourcefilter.NewFilter(test.labelSelector, test.fieldSelector, test.expression)
+			require.NoError(t, err)
+
+			matched, err := filter.Match(link)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.matches, matched)
+		})
+	}
+
+	// the resource ID terms are pushed down to the ID query
+	filter, err := resourcefilter.NewFilter("", "metadata.id!=eth1,metadata.id=eth0.1", "")
+	require.NoError(t, err)
+
+	idQuery := resource.IDQuery{}
+
+	for _, opt := range filter.IDQuery() {
+		opt(&idQuery)
+	}
+
+	assert.True(t, idQuery.Matches(resource.NewMetadata(network.NamespaceName, network.LinkStatusType, "eth0.1", resource.VersionUndefined)))
+	assert.False(t, idQuery.Matches(resource.NewMetadata(network.NamespaceName, network.LinkStatusType, "eth0x1", resource.VersionUndefined)))
+
+	filter, err = resourcefilter.NewFilter("", "spec.mtu=1500", "")
+	require.NoError(t, err)
+	assert.Empty(t, filter.IDQuery())
+
+	filter, err = resourcefilter.NewFilter("", "", `spec.missing == "foo"`)
+	require.NoError(t, err)
+
+	_, err = filter.Match(link)
+	assert.ErrorContains(t, err, "error evaluating filter on eth0")
+
+	_, err = resourcefilter.NewFilter("", "spec.mtu", "")
+	assert.Error(t, err)
+
+	_, err = resourcefilter.NewFilter("", "", "spec.mtu +")
+	assert.Error(t, err)
+}
+
+func TestSortBy(t *testing.T) {
+	t.Parallel()
+
+	items := []resourcefilter.Item{
+		{Node: "a", Resource: newLink("eth0", 9000, nethelpers.OperStateUp, nil)},
+		{Node: "a", Resource: newLink("eth1", 1500, nethelpers.OperStateDown, nil)},
+		{Node: "b", Resource: newLink("eth2", 576, nethelpers.OperStateUp, nil)},
+	}
+
+	ids := func() []string {
+		result := make([]string, 0, len(items))
+
+		for _, item := range items {
+			result = append(result, item.Resource.Metadata().ID())
+		}
+
+		return result
+	}
+
+	require.NoError(t, resourcefilter.SortBy(items, "spec.mtu"))
+	assert.Equal(t, []string{"eth2", "eth1", "eth0"}, ids())
+
+	require.NoError(t, resourcefilter.SortBy(items, "spec.operationalState"))
+	assert.Equal(t, []string{"eth1", "eth2", "eth0"}, ids())
+
+	require.NoError(t, resourcefilter.SortBy(items, "metadata.id"))
+	assert.Equal(t, []string{"eth0", "eth1", "eth2"}, ids())
+
+	require.NoError(t, resourcefilter.SortBy(items, "spec.missing"))
+	assert.Equal(t, []string{"eth0", "eth1", "eth2"}, ids())
+}
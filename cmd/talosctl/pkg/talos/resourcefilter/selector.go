@@ -0,0 +1,117 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package resourcefilter
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+)
+
+var (
+	setTermRe      = regexp.MustCompile(`^([^\s=!<>(),]+)\s+(in|notin)\s*\(([^()]*)\)$`)
+	operatorTermRe = regexp.MustCompile(`^([^\s=!<>(),]+)\s*(==|!=|=|<|>)\s*([^\s=!<>(),]*)$`)
+	keyRe          = regexp.MustCompile(`^[^\s=!<>(),]+$`)
+)
+
+// ParseLabelSelector parses the label selector into the COSI label query, so that it can be evaluated by the COSI state.
+//
+// The selector is a comma-separated list of terms, all of which should match (as in kubectl):
+// 'key', '!key', 'key=value', 'key==value', 'key!=value', 'key in (a,b)', 'key notin (a,b)', 'key<number', 'key>number'.
+func ParseLabelSelector(selector string) ([]resource.LabelQueryOption, error) {
+	var opts []resource.LabelQueryOption
+
+	for _, term := range splitTerms(selector) {
+		opt, err := parseLabelTerm(term)
+		if err != nil {
+			return nil, fmt.Errorf("invalid label selector %q: %w", selector, err)
+		}
+
+		opts = append(opts, opt)
+	}
+
+	return opts, nil
+}
+
+// LabelQuery builds the label query from the options.
+func LabelQuery(opts []resource.LabelQueryOption) resource.LabelQuery {
+	var query resource.LabelQuery
+
+	for _, opt := range opts {
+		opt(&query)
+	}
+
+	return query
+}
+
+func parseLabelTerm(term string) (resource.LabelQueryOption, error) {
+	if m := setTermRe.FindStringSubmatch(term); m != nil {
+		values := strings.Split(m[3], ",")
+
+		for i := range values {
+			values[i] = strings.TrimSpace(values[i])
+		}
+
+		if m[2] == "notin" {
+			return resource.LabelIn(m[1], values, resource.NotMatches), nil
+		}
+
+		return resource.LabelIn(m[1], values), nil
+	}
+
+	if m := operatorTermRe.FindStringSubmatch(term); m != nil {
+		switch m[2] {
+		case "=", "==":
+			return resource.LabelEqual(m[1], m[3]), nil
+		case "!=":
+			return resource.LabelEqual(m[1], m[3], resource.NotMatches), nil
+		case "<":
+			return resource.LabelLTNumeric(m[1], m[3]), nil
+		case ">":
+			return resource.LabelLTENumeric(m[1], m[3], resource.NotMatches), nil
+		}
+	}
+
+	if key, ok := strings.CutPrefix(term, "!"); ok && keyRe.MatchString(key) {
+		return resource.LabelExists(key, resource.NotMatches), nil
+	}
+
+	if keyRe.MatchString(term) {
+		return resource.LabelExists(term), nil
+	}
+
+	return nil, fmt.Errorf("unexpected term %q", term)
+}
+
+// splitTerms splits the comma-separated terms, ignoring the commas in parentheses.
+func splitTerms(s string) []string {
+	var (
+		terms []string
+		depth int
+		start int
+	)
+
+	for i, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				terms = append(terms, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+
+	if last := strings.TrimSpace(s[start:]); last != "" || len(terms) > 0 {
+		terms = append(terms, last)
+	}
+
+	return terms
+}
@@ -0,0 +1,21 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/machinery/meta"
+)
+
+// checkMetaKeyWritable denies changes to the META keys which can only be set at install time.
+func checkMetaKeyWritable(key uint8) error {
+	if key == meta.ConfigTrustPolicy {
+		return status.Error(codes.PermissionDenied, "configuration trust policy can only be set at install time")
+	}
+
+	return nil
+}
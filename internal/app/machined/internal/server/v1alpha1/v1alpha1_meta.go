@@ -26,6 +26,10 @@ func (s *Server) MetaWrite(ctx context.Context, req *machine.MetaWriteRequest) (
 		return nil, status.Errorf(codes.InvalidArgument, "key must be a uint8")
 	}
 
+	if err := checkMetaKeyWritable(uint8(req.Key)); err != nil {
+		return nil, err
+	}
+
 	ok, err := s.Controller.Runtime().State().Machine().Meta().SetTagBytes(ctx, uint8(req.Key), req.Value)
 	if err != nil {
 		return nil, err
@@ -59,6 +63,10 @@ func (s *Server) MetaDelete(ctx context.Context, req *machine.MetaDeleteRequest)
 		return nil, status.Errorf(codes.InvalidArgument, "key must be a uint8")
 	}
 
+	if err := checkMetaKeyWritable(uint8(req.Key)); err != nil {
+		return nil, err
+	}
+
 	ok, err := s.Controller.Runtime().State().Machine().Meta().DeleteTag(ctx, uint8(req.Key))
 	if err != nil {
 		return nil, err
@@ -22,7 +22,6 @@ import (
 	"slices"
 	"strconv"
 	"strings"
-	"sync"
 	"syscall"
 	"time"
 
@@ -38,6 +37,7 @@ import (
 	"github.com/pkg/xattr"
 	"github.com/prometheus/procfs"
 	"github.com/rs/xid"
+	"github.com/siderolabs/gen/xerrors"
 	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/go-kmsg"
 	"github.com/siderolabs/go-pointer"
@@ -59,21 +59,25 @@ import (
 	"github.com/siderolabs/talos/internal/app/lifecycle"
 	"github.com/siderolabs/talos/internal/app/lvmd"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/configapply"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/options"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system"
 	"github.com/siderolabs/talos/internal/app/mdd"
+	"github.com/siderolabs/talos/internal/app/netdiag"
 	"github.com/siderolabs/talos/internal/app/resources"
 	machinestorage "github.com/siderolabs/talos/internal/app/storage"
 	storaged "github.com/siderolabs/talos/internal/app/storaged"
 	"github.com/siderolabs/talos/internal/pkg/containers"
 	taloscontainerd "github.com/siderolabs/talos/internal/pkg/containers/containerd"
 	"github.com/siderolabs/talos/internal/pkg/containers/cri"
+	"github.com/siderolabs/talos/internal/pkg/disruption"
 	"github.com/siderolabs/talos/internal/pkg/etcd"
 	"github.com/siderolabs/talos/internal/pkg/install"
 	"github.com/siderolabs/talos/internal/pkg/miniprocfs"
 	"github.com/siderolabs/talos/internal/pkg/partition"
 	"github.com/siderolabs/talos/internal/pkg/pcap"
+	"github.com/siderolabs/talos/internal/pkg/secureerase"
 	"github.com/siderolabs/talos/pkg/archiver"
 	"github.com/siderolabs/talos/pkg/chunker"
 	"github.com/siderolabs/talos/pkg/chunker/stream"
@@ -89,7 +93,6 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/config"
 	configconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/configdiff"
-	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
 	"github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
 	machinetype "github.com/siderolabs/talos/pkg/machinery/config/machine"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
@@ -99,6 +102,7 @@ import (
 	crires "github.com/siderolabs/talos/pkg/machinery/resources/cri"
 	etcdresource "github.com/siderolabs/talos/pkg/machinery/resources/etcd"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 	timeresource "github.com/siderolabs/talos/pkg/machinery/resources/time"
 	"github.com/siderolabs/talos/pkg/machinery/role"
 	"github.com/siderolabs/talos/pkg/machinery/version"
@@ -130,8 +134,6 @@ type Server struct {
 	Logger *zap.Logger
 
 	server *grpc.Server
-
-	applyConfigMu sync.Mutex
 }
 
 func (s *Server) checkSupported(feature runtime.ModeCapability) error {
@@ -163,20 +165,10 @@ func (s *Server) Register(obj *grpc.Server) {
 	storage.RegisterStorageServiceServer(obj, &storaged.Server{Controller: s.Controller})
 	machine.RegisterLVMServiceServer(obj, lvmd.NewService(s.Controller, s.Logger))
 	machine.RegisterMDServiceServer(obj, mdd.NewService(s.Controller, s.Logger))
+	machine.RegisterNetworkDiagnosticsServiceServer(obj, netdiag.NewService(s.Logger))
 	timeapi.RegisterTimeServiceServer(obj, &TimeServer{ConfigProvider: s.Controller.Runtime()})
 }
 
-// modeWrapper overrides RequiresInstall() based on actual installed status.
-type modeWrapper struct {
-	runtime.Mode
-
-	installed bool
-}
-
-func (m modeWrapper) RequiresInstall() bool {
-	return m.Mode.RequiresInstall() && !m.installed
-}
-
 // ApplyConfiguration implements machine.MachineService.
 //
 //nolint:gocyclo,cyclop
@@ -185,11 +177,6 @@ func (s *Server) ApplyConfiguration(ctx context.Context, in *machine.ApplyConfig
 		return nil, status.Error(codes.Unimplemented, "API is not implemented in agent mode")
 	}
 
-	if !s.applyConfigMu.TryLock() {
-		return nil, status.Error(codes.FailedPrecondition, "another apply configuration is already in progress")
-	}
-	defer s.applyConfigMu.Unlock()
-
 	roles := authz.GetRoles(ctx)
 	inMaintenance := !s.Controller.Runtime().ConfigCompleteForBoot()
 
@@ -201,22 +188,6 @@ func (s *Server) ApplyConfiguration(ctx context.Context, in *machine.ApplyConfig
 
 	var modeDetails string
 
-	if in.Mode != machine.ApplyConfigurationRequest_TRY {
-		s.Controller.Runtime().CancelConfigRollbackTimeout()
-	}
-
-	cfgProvider, err := configloader.NewFromBytes(in.GetData())
-	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-
-	// as we are not in maintenance mode, the v1alpha1 config should be always present
-	// in the future we should allow to remove v1alpha1, but for now for better UX we deny
-	// such requests to avoid confusion
-	if !inMaintenance && cfgProvider.RawV1Alpha1() == nil {
-		return nil, status.Error(codes.InvalidArgument, "the applied machine configuration doesn't contain v1alpha1 config, did you mean to patch the machine config instead?")
-	}
-
 	if in.Mode == machine.ApplyConfigurationRequest_REBOOT { //nolint:staticcheck // backwards compatibility
 		if inMaintenance {
 			in.Mode = machine.ApplyConfigurationRequest_NO_REBOOT
@@ -225,14 +196,10 @@ func (s *Server) ApplyConfiguration(ctx context.Context, in *machine.ApplyConfig
 		}
 	}
 
-	validationMode := modeWrapper{
-		Mode:      s.Controller.Runtime().State().Platform().Mode(),
-		installed: s.Controller.Runtime().State().Machine().Installed(),
-	}
-
-	warnings, err := cfgProvider.ValidateAtRuntime(ctx, s.Controller.Runtime().State().V1Alpha2().Resources(), validationMode)
-	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+	req := configapply.Request{
+		Data:      in.GetData(),
+		Signature: in.GetSignature(),
+		DryRun:    in.DryRun,
 	}
 
 	//nolint:exhaustive
@@ -242,22 +209,49 @@ func (s *Server) ApplyConfiguration(ctx context.Context, in *machine.ApplyConfig
 		in.Mode = machine.ApplyConfigurationRequest_NO_REBOOT
 		mode = fmt.Sprintf("%s(%s)", mode, in.Mode)
 
-		fallthrough
-	// --mode=try
-	case machine.ApplyConfigurationRequest_TRY:
 		fallthrough
 	// --mode=no-reboot
 	case machine.ApplyConfigurationRequest_NO_REBOOT:
+		req.Mode = configapply.ModeNoReboot
+		modeDetails = "Applied configuration without a reboot"
+	// --mode=try
+	case machine.ApplyConfigurationRequest_TRY:
+		req.Mode = configapply.ModeTry
+		req.TryTimeout = constants.ConfigTryTimeout
+
+		if in.TryModeTimeout != nil {
+			req.TryTimeout = in.TryModeTimeout.AsDuration()
+		}
+
 		modeDetails = "Applied configuration without a reboot"
 	// --mode=staged
 	case machine.ApplyConfigurationRequest_STAGED:
+		req.Mode = configapply.ModeStaged
 		modeDetails = "Staged configuration to be applied after the next reboot"
 	default:
 		return nil, status.Errorf(codes.InvalidArgument, "incorrect mode '%s' specified for the apply config call", in.Mode.String())
 	}
 
+	if !in.DryRun {
+		log.Printf("apply config request: mode %s", strings.ToLower(mode))
+	}
+
+	result, err := s.Controller.Runtime().ApplyConfig(ctx, req)
+
+	switch {
+	case err == nil:
+	case xerrors.TagIs[configapply.InProgressTag](err):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case xerrors.TagIs[configapply.InvalidTag](err):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case xerrors.TagIs[configapply.UntrustedTag](err):
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return nil, err
+	}
+
 	if in.DryRun {
-		details, err := generateDiff(s.Controller.Runtime(), cfgProvider)
+		details, err := generateDiff(s.Controller.Runtime(), result.Config)
 		if err != nil {
 			return nil, fmt.Errorf("failed to generate diff: %w", err)
 		}
@@ -274,47 +268,15 @@ func (s *Server) ApplyConfiguration(ctx context.Context, in *machine.ApplyConfig
 		}, nil
 	}
 
-	log.Printf("apply config request: mode %s", strings.ToLower(mode))
-
-	//nolint:exhaustive
-	switch in.Mode {
-	// --mode=try
-	case machine.ApplyConfigurationRequest_TRY:
-		timeout := constants.ConfigTryTimeout
-		if in.TryModeTimeout != nil {
-			timeout = in.TryModeTimeout.AsDuration()
-		}
-
-		modeDetails += fmt.Sprintf("\nThe config is applied in 'try' mode and will be automatically reverted back in %s", timeout.String())
-
-		if err := s.Controller.Runtime().RollbackToConfigAfter(timeout); err != nil {
-			return nil, err
-		}
-
-		if err := s.Controller.Runtime().SetConfig(cfgProvider); err != nil {
-			return nil, err
-		}
-	// --mode=no-reboot
-	case machine.ApplyConfigurationRequest_NO_REBOOT:
-		if err := s.Controller.Runtime().SetPersistedConfig(cfgProvider); err != nil {
-			return nil, err
-		}
-
-		if err := s.Controller.Runtime().SetConfig(cfgProvider); err != nil {
-			return nil, err
-		}
-	// --mode=staged
-	case machine.ApplyConfigurationRequest_STAGED:
-		if err := s.Controller.Runtime().SetPersistedConfig(cfgProvider); err != nil {
-			return nil, err
-		}
+	if req.Mode == configapply.ModeTry {
+		modeDetails += fmt.Sprintf("\nThe config is applied in 'try' mode and will be automatically reverted back in %s", req.TryTimeout.String())
 	}
 
 	return &machine.ApplyConfigurationResponse{
 		Messages: []*machine.ApplyConfiguration{
 			{
 				Mode:        in.Mode,
-				Warnings:    warnings,
+				Warnings:    result.Warnings,
 				ModeDetails: modeDetails,
 			},
 		},
@@ -347,7 +309,7 @@ func (s *Server) Reboot(ctx context.Context, in *machine.RebootRequest) (reply *
 	rebootCtx := context.WithValue(context.Background(), runtime.ActorIDCtxKey{}, actorID)
 
 	go func() {
-		if err := s.Controller.Run(rebootCtx, runtime.SequenceReboot, in); err != nil {
+		if err := s.runDisruptive(rebootCtx, runtimeres.DisruptionOperationReboot, runtime.SequenceReboot, in); err != nil {
 			if !runtime.IsRebootError(err) {
 				log.Println("reboot failed:", err)
 			}
@@ -365,6 +327,22 @@ func (s *Server) Reboot(ctx context.Context, in *machine.RebootRequest) (reply *
 	return reply, nil
 }
 
+// runDisruptive runs the sequence once the maintenance policy allows the disruptive operation.
+func (s *Server) runDisruptive(ctx context.Context, operation string, seq runtime.Sequence, in any) error {
+	release, err := disruption.WaitForSlot(ctx, s.Controller.Runtime().State().V1Alpha2().Resources(), operation, s.Logger)
+	if err != nil {
+		return err
+	}
+
+	// the slot is released by the DisruptionController once the machine is back up
+	if err = s.Controller.Run(ctx, seq, in); err != nil && !runtime.IsRebootError(err) {
+		// the node was not disrupted
+		release()
+	}
+
+	return err
+}
+
 // Rollback implements the machine.MachineServer interface.
 func (s *Server) Rollback(ctx context.Context, in *machine.RollbackRequest) (*machine.RollbackResponse, error) {
 	log.Printf("rollback via API received")
@@ -575,7 +553,7 @@ func (s *Server) Upgrade(ctx context.Context, in *machine.UpgradeRequest) (*mach
 		}
 
 		go func() {
-			if err := s.Controller.Run(runCtx, runtime.SequenceStageUpgrade, in); err != nil {
+			if err := s.runDisruptive(runCtx, runtimeres.DisruptionOperationUpgrade, runtime.SequenceStageUpgrade, in); err != nil {
 				if !runtime.IsRebootError(err) {
 					log.Println("reboot for staged upgrade failed:", err)
 				}
@@ -583,7 +561,7 @@ func (s *Server) Upgrade(ctx context.Context, in *machine.UpgradeRequest) (*mach
 		}()
 	default:
 		go func() {
-			if err := s.Controller.Run(runCtx, runtime.SequenceUpgrade, in); err != nil {
+			if err := s.runDisruptive(runCtx, runtimeres.DisruptionOperationUpgrade, runtime.SequenceUpgrade, in); err != nil {
 				if !runtime.IsRebootError(err) {
 					log.Println("upgrade failed:", err)
 				}
@@ -607,6 +585,7 @@ type ResetOptions struct {
 
 	systemDiskTargets []*partition.VolumeWipeTarget
 	systemDiskPaths   []string
+	eraseReport       *secureerase.Report
 }
 
 // GetSystemDiskTargets implements runtime.ResetOptions interface.
@@ -623,6 +602,13 @@ func (opt *ResetOptions) GetSystemDiskPaths() []string {
 	return opt.systemDiskPaths
 }
 
+// EraseReport implements v1alpha1.EraseReporter interface.
+//
+// It returns nil if the erase report is not enabled.
+func (opt *ResetOptions) EraseReport() *secureerase.Report {
+	return opt.eraseReport
+}
+
 // String implements runtime.ResetOptions interface.
 func (opt *ResetOptions) String() string {
 	return strings.Join(xslices.Map(opt.systemDiskTargets, func(t *partition.VolumeWipeTarget) string { return t.String() }), ", ")
@@ -644,6 +630,28 @@ func (s *Server) Reset(ctx context.Context, in *machine.ResetRequest) (reply *ma
 		ResetRequest: in,
 	}
 
+	if _, ok := storage.BlockDeviceWipeDescriptor_Method_name[int32(in.GetWipeMethod())]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "reset failed: unsupported wipe method %s", in.GetWipeMethod())
+	}
+
+	secureErase := in.GetWipeMethod() != storage.BlockDeviceWipeDescriptor_FAST || in.GetEraseReportTimeout() != nil
+
+	if secureErase && s.Controller.Runtime().State().Platform().Mode() == runtime.ModeContainer {
+		return nil, status.Error(codes.FailedPrecondition, "reset failed: wipe method and erase report are not supported in container mode")
+	}
+
+	if secureErase && len(in.GetSystemPartitionsToWipe()) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "reset failed: wipe method and erase report are only supported when wiping whole disks, not SystemPartitionsToWipe")
+	}
+
+	if in.GetEraseReportTimeout() != nil {
+		if in.GetEraseReportTimeout().AsDuration() <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "reset failed: erase report timeout should be positive")
+		}
+
+		opts.eraseReport = &secureerase.Report{}
+	}
+
 	if len(in.GetUserDisksToWipe()) > 0 {
 		if in.Mode == machine.ResetRequest_SYSTEM_DISK {
 			return nil, errors.New("reset failed: invalid input, wipe mode SYSTEM_DISK doesn't support UserDisksToWipe parameter")
@@ -14,11 +14,15 @@ import (
 	"github.com/siderolabs/gen/optional"
 	"go.uber.org/zap"
 
+	configconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
 	clustertypes "github.com/siderolabs/talos/pkg/machinery/config/types/cluster"
 	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 )
 
+// EmbeddedDiscoveryEndpointPrefix is the name prefix of the embedded discovery service endpoints.
+const EmbeddedDiscoveryEndpointPrefix = "embedded/"
+
 // ConfigController watches v1alpha1.Config, updates discovery config.
 type ConfigController = transform.Controller[*config.MachineConfig, *cluster.Config]
 
@@ -39,15 +43,18 @@ func NewConfigController() *ConfigController {
 				return optional.Some(cluster.NewConfig(config.NamespaceName, cluster.ConfigID))
 			},
 			TransformFunc: func(ctx context.Context, r controller.Reader, logger *zap.Logger, machineConfig *config.MachineConfig, res *cluster.Config) error {
-				var err error
-
 				cfg := machineConfig.Config()
 
 				// Both the legacy v1alpha1 service endpoint, and the new multi-doc DiscoveryServiceConfig(s) get
 				// surfaced via the .DiscoveryServiceConfigs() interface. By now the configs have been validated.
 				discoveryServiceConfigs := cfg.DiscoveryServiceConfigs()
 
-				if len(discoveryServiceConfigs) > 0 {
+				embeddedEndpoints, err := embeddedDiscoveryEndpoints(cfg)
+				if err != nil {
+					return err
+				}
+
+				if len(discoveryServiceConfigs) > 0 || len(embeddedEndpoints) > 0 {
 					res.TypedSpec().ServiceEndpoints = []cluster.ServiceEndpoint{}
 
 					for _, discoveryServiceConfig := range discoveryServiceConfigs {
@@ -63,6 +70,8 @@ func NewConfigController() *ConfigController {
 						})
 					}
 
+					res.TypedSpec().ServiceEndpoints = append(res.TypedSpec().ServiceEndpoints, embeddedEndpoints...)
+
 					identity := cfg.DiscoveryIdentityConfig()
 					if identity == nil {
 						return errors.New("cluster identity is required when discovery service is configured")
@@ -105,3 +114,54 @@ func NewConfigController() *ConfigController {
 		},
 	)
 }
+
+// embeddedDiscoveryEndpoints returns the discovery service endpoints of the control plane nodes running the embedded discovery service.
+//
+// The embedded discovery service uses the Talos API certificate, so the endpoints are verified against the Talos OS CAs
+// (the endpoint hosts are added to the certificate SANs by the secrets.RootOSController).
+func embeddedDiscoveryEndpoints(cfg configconfig.Config) ([]cluster.ServiceEndpoint, error) {
+	endpoints, err := clustertypes.EmbeddedDiscoveryEndpoints(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if endpoints == nil {
+		return nil, nil
+	}
+
+	caCerts := osCACerts(cfg)
+	if len(caCerts) == 0 {
+		return nil, errors.New("embedded discovery service requires the Talos OS CA")
+	}
+
+	result := make([]cluster.ServiceEndpoint, 0, len(endpoints))
+
+	for _, endpoint := range endpoints {
+		result = append(result, cluster.ServiceEndpoint{
+			Name:     EmbeddedDiscoveryEndpointPrefix + endpoint,
+			Endpoint: endpoint,
+			CACerts:  caCerts,
+		})
+	}
+
+	return result, nil
+}
+
+// osCACerts returns the PEM-encoded Talos OS CA certificates (issuing and accepted).
+func osCACerts(cfg configconfig.Config) []byte {
+	if cfg.Machine() == nil {
+		return nil
+	}
+
+	var caCerts []byte
+
+	if issuingCA := cfg.Machine().Security().IssuingCA(); issuingCA != nil {
+		caCerts = append(caCerts, issuingCA.Crt...)
+	}
+
+	for _, acceptedCA := range cfg.Machine().Security().AcceptedCAs() {
+		caCerts = append(caCerts, acceptedCA.Crt...)
+	}
+
+	return caCerts
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/siderolabs/crypto/x509"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
@@ -288,6 +289,49 @@ func (suite *ConfigSuite) TestReconcileMultidocIdentity() {
 	rtestutils.AssertNoResource[*cluster.Config](suite.Ctx(), suite.T(), suite.State(), cluster.ConfigID)
 }
 
+// TestReconcileEmbeddedDiscoveryDefault verifies that the embedded discovery service endpoint defaults
+// to the host of the cluster control plane endpoint.
+func (suite *ConfigSuite) TestReconcileEmbeddedDiscoveryDefault() {
+	cfg := config.NewMachineConfig(must(container.New(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{
+				MachineCA: &x509.PEMEncodedCertificateAndKey{
+					Crt: []byte("ca"),
+				},
+			},
+			ClusterConfig: &v1alpha1.ClusterConfig{
+				ControlPlane: &v1alpha1.ControlPlaneConfig{
+					Endpoint: &v1alpha1.Endpoint{
+						URL: must(url.Parse("https://cp.example.com:6443")),
+					},
+				},
+			},
+		},
+		clustertypes.NewEmbeddedDiscoveryConfigV1Alpha1(),
+		clustertypes.NewDiscoveryIdentityConfigV1Alpha1("cluster1", "kCQsKr4B28VUl7qw1sVkTDNF9fFH++ViIuKsss+C6kc="),
+	)))
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{cluster.ConfigID},
+		func(res *cluster.Config, asrt *assert.Assertions) {
+			spec := res.TypedSpec()
+
+			asrt.Equal(
+				[]cluster.ServiceEndpoint{
+					{
+						Name:     clusterctrl.EmbeddedDiscoveryEndpointPrefix + "cp.example.com:50002",
+						Endpoint: "cp.example.com:50002",
+						CACerts:  []byte("ca"),
+					},
+				},
+				spec.ServiceEndpoints,
+			)
+			asrt.Equal("cluster1", spec.ServiceClusterID)
+		})
+}
+
 func TestConfigSuite(t *testing.T) {
 	t.Parallel()
 
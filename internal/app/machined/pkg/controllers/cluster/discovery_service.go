@@ -9,6 +9,7 @@ import (
 	"context"
 	"crypto/aes"
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net/netip"
 	"sync"
@@ -53,6 +54,7 @@ type DiscoveryServiceController struct {
 type discoveryServiceClientSpec struct {
 	endpoint      string // resolved host:port
 	insecure      bool
+	caCerts       []byte
 	clusterID     string
 	affiliateID   string
 	encryptionKey []byte
@@ -62,6 +64,7 @@ type discoveryServiceClientSpec struct {
 func (spec discoveryServiceClientSpec) Equal(other discoveryServiceClientSpec) bool {
 	return spec.endpoint == other.endpoint &&
 		spec.insecure == other.insecure &&
+		bytes.Equal(spec.caCerts, other.caCerts) &&
 		spec.clusterID == other.clusterID &&
 		spec.affiliateID == other.affiliateID &&
 		bytes.Equal(spec.encryptionKey, other.encryptionKey)
@@ -275,6 +278,7 @@ func (ctrl *DiscoveryServiceController) reconcileClients(ctx context.Context, r
 		shouldRun[serviceEndpoint.Name] = discoveryServiceClientSpec{
 			endpoint:      serviceEndpoint.Endpoint,
 			insecure:      serviceEndpoint.Insecure,
+			caCerts:       serviceEndpoint.CACerts,
 			clusterID:     clusterCfgSpec.ServiceClusterID,
 			affiliateID:   localAffiliateID,
 			encryptionKey: clusterCfgSpec.ServiceEncryptionKey,
@@ -320,9 +324,21 @@ func (ctrl *DiscoveryServiceController) startClient(ctx context.Context, logger
 		return fmt.Errorf("error initializing AES cipher: %w", err)
 	}
 
+	rootCAs := httpdefaults.RootCAs
+
+	if len(spec.caCerts) > 0 {
+		pool := x509.NewCertPool()
+
+		if !pool.AppendCertsFromPEM(spec.caCerts) {
+			return fmt.Errorf("error parsing CA certificates for discovery client %q", name)
+		}
+
+		rootCAs = func() *x509.CertPool { return pool }
+	}
+
 	tlsConfigFunc := func() *tls.Config {
 		return &tls.Config{
-			RootCAs: httpdefaults.RootCAs(),
+			RootCAs: rootCAs(),
 		}
 	}
 
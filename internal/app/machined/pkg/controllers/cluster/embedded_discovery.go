@@ -0,0 +1,295 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/discovery-api/api/v1alpha1/server/pb"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+
+	"github.com/siderolabs/talos/internal/pkg/discovery/embedded"
+	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+// EmbeddedDiscoveryGCInterval is the interval between the cleanups of the expired affiliates.
+const EmbeddedDiscoveryGCInterval = time.Minute
+
+// EmbeddedDiscoveryController runs the embedded discovery service on the control plane nodes.
+//
+// The affiliate data is kept in memory and replicated to the other control plane members.
+type EmbeddedDiscoveryController struct {
+	state           *embedded.State
+	replicator      *embedded.Replicator
+	server          *grpc.Server
+	discoveryServer *embedded.Server
+	port            int
+
+	certificate atomic.Pointer[tls.Certificate]
+	rootCAs     atomic.Pointer[x509.CertPool]
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *EmbeddedDiscoveryController) Name() string {
+	return "cluster.EmbeddedDiscoveryController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *EmbeddedDiscoveryController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.ActiveID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineTypeType,
+			ID:        optional.Some(config.MachineTypeID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.APIType,
+			ID:        optional.Some(secrets.APIID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: config.NamespaceName,
+			Type:      cluster.ConfigType,
+			ID:        optional.Some(cluster.ConfigID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: cluster.NamespaceName,
+			Type:      cluster.IdentityType,
+			ID:        optional.Some(cluster.LocalIdentity),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: cluster.NamespaceName,
+			Type:      cluster.MemberType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *EmbeddedDiscoveryController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *EmbeddedDiscoveryController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	ctrl.state = embedded.NewState()
+
+	defer ctrl.stop(logger)
+
+	ticker := time.NewTicker(EmbeddedDiscoveryGCInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+			ctrl.state.GarbageCollect(time.Now())
+
+			continue
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		machineType, err := safe.ReaderGetByID[*config.MachineType](ctx, r, config.MachineTypeID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine type: %w", err)
+		}
+
+		if cfg == nil || cfg.Config().EmbeddedDiscoveryConfig() == nil || machineType == nil || !machineType.MachineType().IsControlPlane() {
+			ctrl.stop(logger)
+
+			continue
+		}
+
+		apiCerts, err := safe.ReaderGetByID[*secrets.API](ctx, r, secrets.APIID)
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting API certificates: %w", err)
+		}
+
+		certificate, err := tls.X509KeyPair(apiCerts.TypedSpec().Server.Crt, apiCerts.TypedSpec().Server.Key)
+		if err != nil {
+			return fmt.Errorf("error parsing API certificate: %w", err)
+		}
+
+		ctrl.certificate.Store(&certificate)
+
+		rootCAs := x509.NewCertPool()
+
+		if !rootCAs.AppendCertsFromPEM(osCACerts(cfg.Config())) {
+			return errors.New("error parsing Talos OS CA certificates")
+		}
+
+		ctrl.rootCAs.Store(rootCAs)
+
+		port := cfg.Config().EmbeddedDiscoveryConfig().Port()
+
+		if ctrl.server != nil && ctrl.port != port {
+			logger.Info("embedded discovery service port changed, restarting")
+
+			ctrl.stop(logger)
+		}
+
+		if ctrl.server == nil {
+			if err = ctrl.start(ctx, port, logger); err != nil {
+				return fmt.Errorf("error starting embedded discovery service: %w", err)
+			}
+		}
+
+		discoveryConfig, err := safe.ReaderGetByID[*cluster.Config](ctx, r, cluster.ConfigID)
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting discovery config: %w", err)
+		}
+
+		// only the local cluster is served
+		ctrl.discoveryServer.SetClusterID(discoveryConfig.TypedSpec().ServiceClusterID)
+
+		identity, err := safe.ReaderGetByID[*cluster.Identity](ctx, r, cluster.LocalIdentity)
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting local identity: %w", err)
+		}
+
+		members, err := safe.ReaderListAll[*cluster.Member](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing cluster members: %w", err)
+		}
+
+		// replicate to the other control plane members discovered via the embedded discovery service
+		var peers []string
+
+		for member := range members.All() {
+			spec := member.TypedSpec()
+
+			if !spec.MachineType.IsControlPlane() || spec.NodeID == identity.TypedSpec().NodeID || len(spec.Addresses) == 0 {
+				continue
+			}
+
+			peers = append(peers, net.JoinHostPort(spec.Addresses[0].String(), strconv.Itoa(port)))
+		}
+
+		slices.Sort(peers)
+
+		if err = ctrl.replicator.SetPeers(ctx, peers); err != nil {
+			return fmt.Errorf("error updating embedded discovery service peers: %w", err)
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *EmbeddedDiscoveryController) start(ctx context.Context, port int, logger *zap.Logger) error {
+	listener, err := (&net.ListenConfig{}).Listen(ctx, "tcp", net.JoinHostPort("", strconv.Itoa(port)))
+	if err != nil {
+		return err
+	}
+
+	serverTLSConfig := &tls.Config{
+		MinVersion: tls.VersionTLS13,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return ctrl.certificate.Load(), nil
+		},
+		// the client certificate is only presented by the replicas, and it is verified by the server
+		ClientAuth: tls.RequestClientCert,
+	}
+
+	ctrl.replicator = embedded.NewReplicator(ctrl.state, logger, func() *tls.Config {
+		return &tls.Config{
+			MinVersion: tls.VersionTLS13,
+			RootCAs:    ctrl.rootCAs.Load(),
+			GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+				return ctrl.certificate.Load(), nil
+			},
+		}
+	})
+
+	ctrl.discoveryServer = embedded.NewServer(ctrl.state, ctrl.replicator, ctrl.rootCAs.Load)
+
+	server := grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(serverTLSConfig)),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             10 * time.Second,
+			PermitWithoutStream: true,
+		}),
+	)
+	pb.RegisterClusterServer(server, ctrl.discoveryServer)
+
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			logger.Error("embedded discovery service failed", zap.Error(err))
+		}
+	}()
+
+	ctrl.server = server
+	ctrl.port = port
+
+	logger.Info("embedded discovery service started", zap.Int("port", port))
+
+	return nil
+}
+
+func (ctrl *EmbeddedDiscoveryController) stop(logger *zap.Logger) {
+	if ctrl.server == nil {
+		return
+	}
+
+	ctrl.server.Stop()
+	ctrl.server = nil
+
+	ctrl.replicator.Stop()
+	ctrl.replicator = nil
+	ctrl.discoveryServer = nil
+
+	// the data is not kept while the service is disabled
+	ctrl.state = embedded.NewState()
+	ctrl.port = 0
+
+	logger.Info("embedded discovery service stopped")
+}
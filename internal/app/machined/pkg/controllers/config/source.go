@@ -0,0 +1,438 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/configapply"
+	"github.com/siderolabs/talos/pkg/httpdefaults"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configdiff"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/signature"
+	configresource "github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// OCISignatureAnnotation is the OCI manifest annotation which holds the detached signature of the machine configuration.
+const OCISignatureAnnotation = "dev.talos.config.signature"
+
+// maxConfigSourceSize bounds the size of the machine configuration (and the signature) pulled from the remote source.
+const maxConfigSourceSize = 16 * 1024 * 1024
+
+// ConfigApplier applies the machine configuration.
+type ConfigApplier interface {
+	ApplyConfig(context.Context, configapply.Request) (*configapply.Result, error)
+}
+
+// SourceController periodically pulls the machine configuration from the remote source.
+type SourceController struct {
+	ConfigApplier ConfigApplier
+	Reboot        func(context.Context) error
+
+	// WaitForDisruption blocks the reboot until the maintenance policy allows it.
+	//
+	// The returned function releases the lock slot if the reboot fails.
+	WaitForDisruption func(ctx context.Context, logger *zap.Logger) (func(), error)
+
+	// HTTPClient is used to fetch the configuration over https://, defaults to the client with the system root CAs.
+	HTTPClient *http.Client
+	// RemoteOptions are passed to the OCI registry client.
+	RemoteOptions []remote.Option
+
+	lastRevision string
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *SourceController) Name() string {
+	return "config.SourceController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *SourceController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: configresource.NamespaceName,
+			Type:      configresource.MachineConfigType,
+			ID:        optional.Some(configresource.ActiveID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *SourceController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtime.ConfigSourceStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *SourceController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.HTTPClient == nil {
+		ctrl.HTTPClient = &http.Client{
+			Transport: httpdefaults.PatchTransport(cleanhttp.DefaultTransport()),
+		}
+	}
+
+	var (
+		ticker  *time.Ticker
+		tickerC <-chan time.Time
+
+		source   config.ConfigSourceConfig
+		interval time.Duration
+	)
+
+	tickerStop := func() {
+		if ticker == nil {
+			return
+		}
+
+		ticker.Stop()
+
+		ticker = nil
+		tickerC = nil
+	}
+
+	defer tickerStop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-tickerC:
+		case <-r.EventCh():
+			cfg, err := safe.ReaderGetByID[*configresource.MachineConfig](ctx, r, configresource.ActiveID)
+			if err != nil && !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting machine config: %w", err)
+			}
+
+			var newSource config.ConfigSourceConfig
+
+			if cfg != nil {
+				newSource = cfg.Config().ConfigSourceConfig()
+			}
+
+			if newSource == nil {
+				tickerStop()
+
+				source = nil
+
+				r.StartTrackingOutputs()
+
+				if err = safe.CleanupOutputs[*runtime.ConfigSourceStatus](ctx, r); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			if source != nil && sameConfigSource(source, newSource) {
+				// source is not changed, wait for the next tick
+				continue
+			}
+
+			source = newSource
+
+			if ticker == nil || interval != source.Interval() {
+				tickerStop()
+
+				interval = source.Interval()
+				ticker = time.NewTicker(interval)
+				tickerC = ticker.C
+			}
+		}
+
+		if source == nil {
+			continue
+		}
+
+		revision, applied, syncErr := ctrl.sync(ctx, r, logger, source)
+		if syncErr != nil {
+			logger.Error("failed to sync machine configuration from the remote source", zap.String("url", redactURL(source.URL())), zap.Error(syncErr))
+		}
+
+		if err := safe.WriterModify(ctx, r, runtime.NewConfigSourceStatus(), func(status *runtime.ConfigSourceStatus) error {
+			spec := status.TypedSpec()
+
+			spec.URL = redactURL(source.URL())
+			spec.Mode = string(source.ApplyMode())
+			spec.LastSyncTime = time.Now()
+			spec.Synced = syncErr == nil
+
+			if syncErr != nil {
+				spec.Error = syncErr.Error()
+			} else {
+				spec.Error = ""
+				spec.Revision = revision
+			}
+
+			if applied {
+				spec.LastAppliedTime = spec.LastSyncTime
+			}
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating config source status: %w", err)
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// sync pulls, verifies and applies the machine configuration.
+//
+// It returns the revision of the pulled configuration, and whether the configuration was applied.
+//
+//nolint:gocyclo
+func (ctrl *SourceController) sync(ctx context.Context, r controller.Reader, logger *zap.Logger, source config.ConfigSourceConfig) (string, bool, error) {
+	keys, err := parseTrustedKeys(source.TrustedKeys())
+	if err != nil {
+		return "", false, err
+	}
+
+	data, sig, err := ctrl.fetch(ctx, source)
+	if err != nil {
+		return "", false, err
+	}
+
+	if err = signature.Verify(data, sig, keys); err != nil {
+		return "", false, err
+	}
+
+	digest := sha256.Sum256(data)
+	revision := hex.EncodeToString(digest[:])
+
+	if revision == ctrl.lastRevision {
+		return revision, false, nil
+	}
+
+	cfg, err := configloader.NewFromBytes(data)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to load pulled machine configuration: %w", err)
+	}
+
+	activeCfg, err := safe.ReaderGetByID[*configresource.MachineConfig](ctx, r, configresource.ActiveID)
+	if err != nil {
+		return "", false, fmt.Errorf("error getting machine config: %w", err)
+	}
+
+	diff, err := configdiff.DiffConfigs(activeCfg.Provider(), cfg)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to diff machine configuration: %w", err)
+	}
+
+	if diff == "" {
+		// the pulled configuration is already applied (e.g. after a reboot)
+		ctrl.lastRevision = revision
+
+		return revision, false, nil
+	}
+
+	logger.Info("applying machine configuration from the remote source",
+		zap.String("url", redactURL(source.URL())),
+		zap.String("revision", revision),
+		zap.String("mode", string(source.ApplyMode())),
+	)
+
+	req := configapply.Request{
+		Data:      data,
+		Signature: sig,
+		Mode:      configapply.ModeStaged,
+	}
+
+	if source.ApplyMode() == config.ConfigSourceApplyModeNoReboot {
+		req.Mode = configapply.ModeNoReboot
+	}
+
+	result, err := ctrl.ConfigApplier.ApplyConfig(ctx, req)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to apply pulled machine configuration: %w", err)
+	}
+
+	for _, w := range result.Warnings {
+		logger.Warn("config validation warning", zap.String("warning", w))
+	}
+
+	if source.ApplyMode() == config.ConfigSourceApplyModeReboot {
+		// record the revision before the reboot, so that the reboot is not triggered again if it fails
+		ctrl.lastRevision = revision
+
+		release := func() {}
+
+		if ctrl.WaitForDisruption != nil {
+			if release, err = ctrl.WaitForDisruption(ctx, logger); err != nil {
+				return "", true, fmt.Errorf("failed to wait for the maintenance policy to allow the reboot: %w", err)
+			}
+		}
+
+		if err = ctrl.Reboot(ctx); err != nil {
+			// the node was not disrupted
+			release()
+
+			return "", true, fmt.Errorf("failed to reboot: %w", err)
+		}
+	}
+
+	ctrl.lastRevision = revision
+
+	return revision, true, nil
+}
+
+func (ctrl *SourceController) fetch(ctx context.Context, source config.ConfigSourceConfig) ([]byte, []byte, error) {
+	switch source.URL().Scheme {
+	case "oci":
+		return ctrl.fetchOCI(ctx, source.URL())
+	default:
+		data, err := ctrl.fetchHTTP(ctx, source.URL())
+		if err != nil {
+			return nil, nil, err
+		}
+
+		sig, err := ctrl.fetchHTTP(ctx, source.SignatureURL())
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to fetch signature: %w", err)
+		}
+
+		return data, sig, nil
+	}
+}
+
+func (ctrl *SourceController) fetchHTTP(ctx context.Context, u *url.URL) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := ctrl.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching %q: %w", redactURL(u), err)
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error fetching %q: unexpected status code %d", redactURL(u), resp.StatusCode)
+	}
+
+	return readLimited(resp.Body)
+}
+
+func (ctrl *SourceController) fetchOCI(ctx context.Context, u *url.URL) ([]byte, []byte, error) {
+	ref, err := name.ParseReference(u.Host + u.Path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse OCI reference: %w", err)
+	}
+
+	img, err := remote.Image(ref, append([]remote.Option{remote.WithContext(ctx)}, ctrl.RemoteOptions...)...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error fetching %q: %w", ref, err)
+	}
+
+	manifest, err := img.Manifest()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error fetching manifest of %q: %w", ref, err)
+	}
+
+	sig, ok := manifest.Annotations[OCISignatureAnnotation]
+	if !ok {
+		return nil, nil, fmt.Errorf("manifest of %q doesn't have %q annotation", ref, OCISignatureAnnotation)
+	}
+
+	layers, err := img.Layers()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error fetching layers of %q: %w", ref, err)
+	}
+
+	if len(layers) != 1 {
+		return nil, nil, fmt.Errorf("expected a single layer in %q, got %d", ref, len(layers))
+	}
+
+	rc, err := layers[0].Compressed()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error fetching layer of %q: %w", ref, err)
+	}
+
+	defer rc.Close() //nolint:errcheck
+
+	data, err := readLimited(rc)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error fetching layer of %q: %w", ref, err)
+	}
+
+	return data, []byte(sig), nil
+}
+
+func readLimited(r io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, maxConfigSourceSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) > maxConfigSourceSize {
+		return nil, fmt.Errorf("response is too large, limit is %d bytes", maxConfigSourceSize)
+	}
+
+	return data, nil
+}
+
+func parseTrustedKeys(trustedKeys []string) ([]crypto.PublicKey, error) {
+	keys, err := signature.ParsePublicKeys([]byte(strings.Join(trustedKeys, "\n")))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse trusted keys: %w", err)
+	}
+
+	return keys, nil
+}
+
+func sameConfigSource(a, b config.ConfigSourceConfig) bool {
+	return a.URL().String() == b.URL().String() &&
+		optionalURLString(a.SignatureURL()) == optionalURLString(b.SignatureURL()) &&
+		a.Interval() == b.Interval() &&
+		a.ApplyMode() == b.ApplyMode() &&
+		slices.Equal(a.TrustedKeys(), b.TrustedKeys())
+}
+
+func optionalURLString(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+
+	return u.String()
+}
+
+// redactURL strips the query and user info from the URL, as they might contain credentials.
+func redactURL(u *url.URL) string {
+	redacted := *u
+	redacted.User = nil
+	redacted.RawQuery = ""
+
+	return redacted.String()
+}
@@ -0,0 +1,432 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	configctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/config"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/configapply"
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	configconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config/signature"
+	runtimecfg "github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	configresource "github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// configApplyRuntimeMock implements the runtime for the configuration apply.
+type configApplyRuntimeMock struct {
+	*configSetterMock
+
+	mu                sync.Mutex
+	rollbackCancelled int
+}
+
+func (m *configApplyRuntimeMock) ConfigCompleteForBoot() bool {
+	return true
+}
+
+func (m *configApplyRuntimeMock) RollbackToConfigAfter(time.Duration) error {
+	return nil
+}
+
+func (m *configApplyRuntimeMock) CancelConfigRollbackTimeout() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.rollbackCancelled++
+}
+
+func (m *configApplyRuntimeMock) rollbackCancelledCount() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.rollbackCancelled
+}
+
+type SourceSuite struct {
+	ctest.DefaultSuite
+
+	server       *httptest.Server
+	configSetter *configSetterMock
+	applyRuntime *configApplyRuntimeMock
+	signer       ed25519.PrivateKey
+	trustedKey   string
+
+	mu          sync.Mutex
+	data        []byte
+	signature   []byte
+	rebooted    int
+	rebootErr   error
+	trustPolicy []byte
+	// disruptionCh blocks the reboot until it is closed (if set)
+	disruptionCh chan struct{}
+	// released counts the lock slot releases
+	released int
+}
+
+func (suite *SourceSuite) serve(data []byte, sig []byte) {
+	suite.mu.Lock()
+	defer suite.mu.Unlock()
+
+	suite.data = data
+	suite.signature = sig
+}
+
+func (suite *SourceSuite) generateConfig(mode configconfig.ConfigSourceApplyMode, image string) config.Provider {
+	input, err := generate.NewInput("test", "https://localhost:6443", constants.DefaultKubernetesVersion)
+	suite.Require().NoError(err)
+
+	v1alpha1Cfg, err := input.Config(machine.TypeWorker)
+	suite.Require().NoError(err)
+
+	v1alpha1Cfg.RawV1Alpha1().MachineConfig.MachineInstall = &v1alpha1.InstallConfig{
+		InstallImage: image,
+	}
+
+	sourceCfg := runtimecfg.NewConfigSourceV1Alpha1()
+	sourceCfg.SourceURL.URL = must(url.Parse(suite.server.URL + "/worker.yaml"))
+	sourceCfg.SourceInterval = runtimecfg.MinConfigSourceInterval
+	sourceCfg.SourceApplyMode = mode
+	sourceCfg.SourceTrustedKeys = []string{suite.trustedKey}
+
+	cfg, err := container.New(append(v1alpha1Cfg.Documents(), sourceCfg)...)
+	suite.Require().NoError(err)
+
+	return cfg
+}
+
+func (suite *SourceSuite) serveConfig(cfg config.Provider) string {
+	data, err := cfg.Bytes()
+	suite.Require().NoError(err)
+
+	sig, err := signature.Sign(data, suite.signer)
+	suite.Require().NoError(err)
+
+	suite.serve(data, sig)
+
+	digest := sha256.Sum256(data)
+
+	return hex.EncodeToString(digest[:])
+}
+
+func (suite *SourceSuite) TestNoReboot() {
+	revision := suite.serveConfig(suite.generateConfig(configconfig.ConfigSourceApplyModeNoReboot, "installer:pulled"))
+
+	suite.Create(configresource.NewMachineConfig(suite.generateConfig(configconfig.ConfigSourceApplyModeNoReboot, "installer:initial")))
+
+	select {
+	case cfg := <-suite.configSetter.persistedCfgCh:
+		suite.Assert().Equal("installer:pulled", cfg.RawV1Alpha1().MachineConfig.MachineInstall.InstallImage)
+	case <-suite.Ctx().Done():
+		suite.FailNow("timed out waiting for persisted config")
+	}
+
+	select {
+	case cfg := <-suite.configSetter.cfgCh:
+		suite.Assert().Equal("installer:pulled", cfg.RawV1Alpha1().MachineConfig.MachineInstall.InstallImage)
+	case <-suite.Ctx().Done():
+		suite.FailNow("timed out waiting for config")
+	}
+
+	ctest.AssertResource(suite, runtime.ConfigSourceStatusID, func(status *runtime.ConfigSourceStatus, asrt *assert.Assertions) {
+		asrt.True(status.TypedSpec().Synced)
+		asrt.Equal(revision, status.TypedSpec().Revision)
+		asrt.Equal("no-reboot", status.TypedSpec().Mode)
+		asrt.Empty(status.TypedSpec().Error)
+		asrt.False(status.TypedSpec().LastAppliedTime.IsZero())
+	})
+
+	suite.mu.Lock()
+	suite.Assert().Zero(suite.rebooted)
+	suite.mu.Unlock()
+
+	// the pending 'try' mode rollback is cancelled
+	suite.Assert().Equal(1, suite.applyRuntime.rollbackCancelledCount())
+}
+
+func (suite *SourceSuite) TestReboot() {
+	suite.serveConfig(suite.generateConfig(configconfig.ConfigSourceApplyModeReboot, "installer:pulled"))
+
+	suite.Create(configresource.NewMachineConfig(suite.generateConfig(configconfig.ConfigSourceApplyModeReboot, "installer:initial")))
+
+	select {
+	case <-suite.configSetter.persistedCfgCh:
+	case <-suite.Ctx().Done():
+		suite.FailNow("timed out waiting for persisted config")
+	}
+
+	ctest.AssertResource(suite, runtime.ConfigSourceStatusID, func(status *runtime.ConfigSourceStatus, asrt *assert.Assertions) {
+		asrt.True(status.TypedSpec().Synced)
+	})
+
+	suite.mu.Lock()
+	suite.Assert().Equal(1, suite.rebooted)
+	suite.mu.Unlock()
+
+	suite.Assert().Empty(suite.configSetter.cfgCh)
+}
+
+func (suite *SourceSuite) TestRebootWaitsForDisruption() {
+	suite.mu.Lock()
+	suite.disruptionCh = make(chan struct{})
+	suite.mu.Unlock()
+
+	suite.serveConfig(suite.generateConfig(configconfig.ConfigSourceApplyModeReboot, "installer:pulled"))
+
+	suite.Create(configresource.NewMachineConfig(suite.generateConfig(configconfig.ConfigSourceApplyModeReboot, "installer:initial")))
+
+	select {
+	case <-suite.configSetter.persistedCfgCh:
+	case <-suite.Ctx().Done():
+		suite.FailNow("timed out waiting for persisted config")
+	}
+
+	// the configuration is staged, but the reboot waits for the maintenance policy
+	time.Sleep(100 * time.Millisecond)
+
+	suite.mu.Lock()
+	suite.Assert().Zero(suite.rebooted)
+	suite.mu.Unlock()
+
+	close(suite.disruptionCh)
+
+	ctest.AssertResource(suite, runtime.ConfigSourceStatusID, func(status *runtime.ConfigSourceStatus, asrt *assert.Assertions) {
+		asrt.True(status.TypedSpec().Synced)
+	})
+
+	suite.mu.Lock()
+	suite.Assert().Equal(1, suite.rebooted)
+	suite.Assert().Zero(suite.released)
+	suite.mu.Unlock()
+}
+
+func (suite *SourceSuite) TestRebootFailureReleasesSlot() {
+	suite.mu.Lock()
+	suite.rebootErr = errors.New("reboot failed")
+	suite.mu.Unlock()
+
+	suite.serveConfig(suite.generateConfig(configconfig.ConfigSourceApplyModeReboot, "installer:pulled"))
+
+	suite.Create(configresource.NewMachineConfig(suite.generateConfig(configconfig.ConfigSourceApplyModeReboot, "installer:initial")))
+
+	ctest.AssertResource(suite, runtime.ConfigSourceStatusID, func(status *runtime.ConfigSourceStatus, asrt *assert.Assertions) {
+		asrt.Contains(status.TypedSpec().Error, "reboot failed")
+	})
+
+	suite.mu.Lock()
+	suite.Assert().Equal(1, suite.rebooted)
+	suite.Assert().Equal(1, suite.released)
+	suite.mu.Unlock()
+}
+
+func (suite *SourceSuite) TestAlreadyApplied() {
+	cfg := suite.generateConfig(configconfig.ConfigSourceApplyModeReboot, "installer:initial")
+
+	revision := suite.serveConfig(cfg)
+
+	suite.Create(configresource.NewMachineConfig(cfg))
+
+	ctest.AssertResource(suite, runtime.ConfigSourceStatusID, func(status *runtime.ConfigSourceStatus, asrt *assert.Assertions) {
+		asrt.True(status.TypedSpec().Synced)
+		asrt.Equal(revision, status.TypedSpec().Revision)
+		asrt.True(status.TypedSpec().LastAppliedTime.IsZero())
+	})
+
+	suite.Assert().Empty(suite.configSetter.persistedCfgCh)
+
+	suite.mu.Lock()
+	suite.Assert().Zero(suite.rebooted)
+	suite.mu.Unlock()
+}
+
+func (suite *SourceSuite) TestInvalidSignature() {
+	suite.serveConfig(suite.generateConfig(configconfig.ConfigSourceApplyModeNoReboot, "installer:pulled"))
+
+	// sign other data
+	sig, err := signature.Sign([]byte("foo"), suite.signer)
+	suite.Require().NoError(err)
+
+	suite.mu.Lock()
+	suite.signature = sig
+	suite.mu.Unlock()
+
+	cfg := suite.generateConfig(configconfig.ConfigSourceApplyModeNoReboot, "installer:initial")
+
+	suite.Create(configresource.NewMachineConfig(cfg))
+
+	ctest.AssertResource(suite, runtime.ConfigSourceStatusID, func(status *runtime.ConfigSourceStatus, asrt *assert.Assertions) {
+		asrt.False(status.TypedSpec().Synced)
+		asrt.Equal(signature.ErrVerificationFailed.Error(), status.TypedSpec().Error)
+		asrt.Equal(suite.server.URL+"/worker.yaml", status.TypedSpec().URL)
+	})
+
+	suite.Assert().Empty(suite.configSetter.persistedCfgCh)
+
+	// remove the config source, status should be cleaned up
+	input, err := generate.NewInput("test", "https://localhost:6443", constants.DefaultKubernetesVersion)
+	suite.Require().NoError(err)
+
+	v1alpha1Cfg, err := input.Config(machine.TypeWorker)
+	suite.Require().NoError(err)
+
+	mc := configresource.NewMachineConfig(v1alpha1Cfg)
+	mc.Metadata().SetVersion(must(suite.State().Get(suite.Ctx(), mc.Metadata())).Metadata().Version())
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), mc))
+
+	ctest.AssertNoResource[*runtime.ConfigSourceStatus](suite, runtime.ConfigSourceStatusID)
+}
+
+func (suite *SourceSuite) TestTrustPolicy() {
+	// the configuration trust policy set at install time trusts another key
+	_, otherSigner, err := ed25519.GenerateKey(rand.Reader)
+	suite.Require().NoError(err)
+
+	der, err := x509.MarshalPKIXPublicKey(otherSigner.Public())
+	suite.Require().NoError(err)
+
+	suite.mu.Lock()
+	suite.trustPolicy = pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	suite.mu.Unlock()
+
+	suite.serveConfig(suite.generateConfig(configconfig.ConfigSourceApplyModeNoReboot, "installer:pulled"))
+
+	suite.Create(configresource.NewMachineConfig(suite.generateConfig(configconfig.ConfigSourceApplyModeNoReboot, "installer:initial")))
+
+	ctest.AssertResource(suite, runtime.ConfigSourceStatusID, func(status *runtime.ConfigSourceStatus, asrt *assert.Assertions) {
+		asrt.False(status.TypedSpec().Synced)
+		asrt.Contains(status.TypedSpec().Error, "the machine configuration should be signed by a trusted key")
+	})
+
+	suite.Assert().Empty(suite.configSetter.persistedCfgCh)
+	suite.Assert().Empty(suite.configSetter.cfgCh)
+}
+
+func TestSourceSuite(t *testing.T) {
+	t.Parallel()
+
+	s := &SourceSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+		},
+	}
+
+	s.DefaultSuite.AfterSetup = func(*ctest.DefaultSuite) {
+		s.server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+
+			switch r.URL.Path {
+			case "/worker.yaml":
+				w.Write(s.data) //nolint:errcheck
+			case "/worker.yaml.sig":
+				w.Write(s.signature) //nolint:errcheck
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+
+		var err error
+
+		_, s.signer, err = ed25519.GenerateKey(rand.Reader)
+		s.Require().NoError(err)
+
+		der, err := x509.MarshalPKIXPublicKey(s.signer.Public())
+		s.Require().NoError(err)
+
+		s.trustedKey = string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+
+		s.configSetter = &configSetterMock{
+			cfgCh:          make(chan config.Provider, 1),
+			persistedCfgCh: make(chan config.Provider, 1),
+		}
+
+		s.rebooted = 0
+		s.rebootErr = nil
+		s.trustPolicy = nil
+		s.disruptionCh = nil
+		s.released = 0
+
+		s.applyRuntime = &configApplyRuntimeMock{
+			configSetterMock: s.configSetter,
+		}
+
+		s.Require().NoError(s.Runtime().RegisterController(&configctrl.SourceController{
+			ConfigApplier: &configapply.Applier{
+				Runtime:        s.applyRuntime,
+				ResourceState:  s.State(),
+				ValidationMode: validationModeMock{},
+				TrustPolicy: func() ([]byte, bool) {
+					s.mu.Lock()
+					defer s.mu.Unlock()
+
+					return s.trustPolicy, s.trustPolicy != nil
+				},
+			},
+			Reboot: func(context.Context) error {
+				s.mu.Lock()
+				defer s.mu.Unlock()
+
+				s.rebooted++
+
+				return s.rebootErr
+			},
+			WaitForDisruption: func(ctx context.Context, _ *zap.Logger) (func(), error) {
+				s.mu.Lock()
+				disruptionCh := s.disruptionCh
+				s.mu.Unlock()
+
+				release := func() {
+					s.mu.Lock()
+					defer s.mu.Unlock()
+
+					s.released++
+				}
+
+				if disruptionCh == nil {
+					return release, nil
+				}
+
+				select {
+				case <-disruptionCh:
+					return release, nil
+				case <-ctx.Done():
+					return nil, ctx.Err()
+				}
+			},
+			HTTPClient: s.server.Client(),
+		}))
+	}
+
+	s.DefaultSuite.AfterTearDown = func(*ctest.DefaultSuite) {
+		s.server.Close()
+	}
+
+	suite.Run(t, s)
+}
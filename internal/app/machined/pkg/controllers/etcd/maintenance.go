@@ -0,0 +1,347 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	pkgetcd "github.com/siderolabs/talos/internal/pkg/etcd"
+	configconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	clustercfg "github.com/siderolabs/talos/pkg/machinery/config/types/cluster"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// DefaultQuotaBackendBytes is the default etcd database quota, used if the etcd doesn't report the quota.
+const DefaultQuotaBackendBytes = 2 * 1024 * 1024 * 1024
+
+// defragmentationLockKey is the etcd lock key which makes sure that only one member is defragmented at a time.
+const defragmentationLockKey = "talos:etcd:defragmentation"
+
+// MemberDBStatus describes the database of an etcd member.
+type MemberDBStatus struct {
+	MemberID    uint64
+	Leader      uint64
+	IsLearner   bool
+	DBSize      int64
+	DBSizeInUse int64
+	DBSizeQuota int64
+}
+
+// IsLeader returns true if the member is the leader of the cluster.
+func (status MemberDBStatus) IsLeader() bool {
+	return status.MemberID == status.Leader
+}
+
+// Fragmentation returns the fraction of the database size which is not in use.
+func (status MemberDBStatus) Fragmentation() float64 {
+	if status.DBSize == 0 {
+		return 0
+	}
+
+	return 1 - float64(status.DBSizeInUse)/float64(status.DBSize)
+}
+
+// MaintenanceBackend performs the etcd maintenance operations.
+type MaintenanceBackend interface {
+	// LocalStatus returns the database status of the local member.
+	LocalStatus(ctx context.Context) (MemberDBStatus, error)
+	// MemberStatuses returns the database status of all cluster members.
+	MemberStatuses(ctx context.Context) ([]MemberDBStatus, error)
+	// Defragment defragments the database of the local member.
+	Defragment(ctx context.Context) error
+	// WithLock runs f while holding the cluster-wide maintenance lock.
+	WithLock(ctx context.Context, logger *zap.Logger, f func() error) error
+}
+
+// MaintenanceController tracks the local etcd member database and performs automatic maintenance.
+type MaintenanceController struct {
+	// Backend defaults to the local etcd client.
+	Backend MaintenanceBackend
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *MaintenanceController) Name() string {
+	return "etcd.MaintenanceController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *MaintenanceController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.ActiveID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      v1alpha1.ServiceType,
+			ID:        optional.Some(etcdServiceID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *MaintenanceController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: etcd.MaintenanceStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *MaintenanceController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.Backend == nil {
+		ctrl.Backend = localMaintenanceBackend{}
+	}
+
+	interval := clustercfg.DefaultEtcdMaintenanceCheckInterval
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		var maintenanceCfg configconfig.EtcdMaintenanceConfig
+
+		if cfg != nil {
+			maintenanceCfg = cfg.Config().EtcdMaintenanceConfig()
+		}
+
+		// the check interval follows the config changes, falling back to the default once the config is removed
+		newInterval := clustercfg.DefaultEtcdMaintenanceCheckInterval
+
+		if maintenanceCfg != nil {
+			newInterval = maintenanceCfg.CheckInterval()
+		}
+
+		if newInterval != interval {
+			interval = newInterval
+			ticker.Reset(interval)
+		}
+
+		etcdService, err := safe.ReaderGetByID[*v1alpha1.Service](ctx, r, etcdServiceID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting etcd service resource: %w", err)
+		}
+
+		if etcdService == nil || etcdService.Metadata().Phase() != resource.PhaseRunning || !etcdService.TypedSpec().Healthy {
+			continue
+		}
+
+		if err = ctrl.check(ctx, r, logger, maintenanceCfg); err != nil {
+			// etcd might be temporarily unavailable, so don't fail the controller
+			logger.Warn("etcd maintenance check failed", zap.Error(err))
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+//nolint:gocyclo
+func (ctrl *MaintenanceController) check(ctx context.Context, r controller.Runtime, logger *zap.Logger, cfg configconfig.EtcdMaintenanceConfig) error {
+	local, err := ctrl.Backend.LocalStatus(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting etcd status: %w", err)
+	}
+
+	if err = ctrl.updateStatus(ctx, r, local, nil); err != nil {
+		return err
+	}
+
+	if cfg == nil || !cfg.DefragmentationEnabled() || !cfg.InMaintenanceWindow(time.Now()) {
+		return nil
+	}
+
+	if local.Fragmentation() < cfg.DefragmentationThreshold() {
+		return nil
+	}
+
+	return ctrl.Backend.WithLock(ctx, logger, func() error {
+		// re-check the status under the lock, as the leadership might have changed while waiting
+		local, err = ctrl.Backend.LocalStatus(ctx)
+		if err != nil {
+			return fmt.Errorf("error getting etcd status: %w", err)
+		}
+
+		if local.Fragmentation() < cfg.DefragmentationThreshold() {
+			return nil
+		}
+
+		if local.IsLeader() {
+			members, err := ctrl.Backend.MemberStatuses(ctx)
+			if err != nil {
+				return fmt.Errorf("error getting etcd member statuses: %w", err)
+			}
+
+			for _, member := range members {
+				if member.MemberID != local.MemberID && !member.IsLearner && member.Fragmentation() >= cfg.DefragmentationThreshold() {
+					logger.Info("postponing etcd leader defragmentation until followers are defragmented", zap.String("follower", etcd.FormatMemberID(member.MemberID)))
+
+					return nil
+				}
+			}
+		}
+
+		logger.Info("defragmenting etcd", zap.Int64("db_size", local.DBSize), zap.Int64("db_size_in_use", local.DBSizeInUse))
+
+		event := etcd.MaintenanceEvent{
+			Operation:    etcd.MaintenanceOperationDefragment,
+			StartTime:    time.Now(),
+			DBSizeBefore: local.DBSize,
+		}
+
+		defragErr := ctrl.Backend.Defragment(ctx)
+
+		event.Duration = time.Since(event.StartTime)
+
+		if defragErr != nil {
+			event.Error = defragErr.Error()
+		}
+
+		after, err := ctrl.Backend.LocalStatus(ctx)
+		if err == nil {
+			local = after
+			event.DBSizeAfter = after.DBSize
+		}
+
+		logger.Info("etcd defragmentation finished", zap.Int64("db_size", event.DBSizeAfter), zap.Duration("duration", event.Duration), zap.Error(defragErr))
+
+		return errors.Join(defragErr, ctrl.updateStatus(ctx, r, local, &event))
+	})
+}
+
+func (ctrl *MaintenanceController) updateStatus(ctx context.Context, r controller.Runtime, local MemberDBStatus, event *etcd.MaintenanceEvent) error {
+	return safe.WriterModify(ctx, r, etcd.NewMaintenanceStatus(etcd.NamespaceName, etcd.MaintenanceStatusID), func(status *etcd.MaintenanceStatus) error {
+		spec := status.TypedSpec()
+
+		spec.MemberID = etcd.FormatMemberID(local.MemberID)
+		spec.Leader = local.IsLeader()
+		spec.DBSize = local.DBSize
+		spec.DBSizeInUse = local.DBSizeInUse
+		spec.DBSizeQuota = local.DBSizeQuota
+		spec.LastCheckTime = time.Now()
+
+		if spec.DBSizeQuota == 0 {
+			spec.DBSizeQuota = DefaultQuotaBackendBytes
+		}
+
+		if event != nil {
+			spec.AddEvent(*event)
+		}
+
+		return nil
+	})
+}
+
+// localMaintenanceBackend performs the maintenance via the local etcd client.
+type localMaintenanceBackend struct{}
+
+var localEndpoint = nethelpers.JoinHostPort("localhost", constants.EtcdClientPort)
+
+func (localMaintenanceBackend) LocalStatus(ctx context.Context) (MemberDBStatus, error) {
+	client, err := pkgetcd.NewLocalClient(ctx)
+	if err != nil {
+		return MemberDBStatus{}, err
+	}
+
+	defer client.Close() //nolint:errcheck
+
+	return memberStatus(ctx, client, localEndpoint)
+}
+
+func (localMaintenanceBackend) MemberStatuses(ctx context.Context) ([]MemberDBStatus, error) {
+	client, err := pkgetcd.NewLocalClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.Close() //nolint:errcheck
+
+	resp, err := client.MemberList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]MemberDBStatus, 0, len(resp.Members))
+
+	for _, member := range resp.Members {
+		if len(member.ClientURLs) == 0 {
+			continue
+		}
+
+		status, err := memberStatus(ctx, client, member.ClientURLs[0])
+		if err != nil {
+			return nil, fmt.Errorf("error getting status of member %s: %w", etcd.FormatMemberID(member.ID), err)
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+func (localMaintenanceBackend) Defragment(ctx context.Context) error {
+	client, err := pkgetcd.NewLocalClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer client.Close() //nolint:errcheck
+
+	_, err = client.Defragment(ctx, localEndpoint)
+
+	return err
+}
+
+func (localMaintenanceBackend) WithLock(ctx context.Context, logger *zap.Logger, f func() error) error {
+	return pkgetcd.WithLock(ctx, defragmentationLockKey, logger, f)
+}
+
+func memberStatus(ctx context.Context, client *pkgetcd.Client, endpoint string) (MemberDBStatus, error) {
+	resp, err := client.Status(ctx, endpoint)
+	if err != nil {
+		return MemberDBStatus{}, err
+	}
+
+	return MemberDBStatus{
+		MemberID:    resp.Header.MemberId,
+		Leader:      resp.Leader,
+		IsLearner:   resp.IsLearner,
+		DBSize:      resp.DbSize,
+		DBSizeInUse: resp.DbSizeInUse,
+		DBSizeQuota: resp.DbSizeQuota,
+	}, nil
+}
@@ -0,0 +1,258 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	etcdctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/cluster"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+type mockMaintenanceBackend struct {
+	mu sync.Mutex
+
+	local   etcdctrl.MemberDBStatus
+	members []etcdctrl.MemberDBStatus
+
+	memberStatusCalls int
+	defragmentations  int
+}
+
+func (mock *mockMaintenanceBackend) LocalStatus(context.Context) (etcdctrl.MemberDBStatus, error) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	return mock.local, nil
+}
+
+func (mock *mockMaintenanceBackend) MemberStatuses(context.Context) ([]etcdctrl.MemberDBStatus, error) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	mock.memberStatusCalls++
+
+	return append([]etcdctrl.MemberDBStatus{mock.local}, mock.members...), nil
+}
+
+func (mock *mockMaintenanceBackend) Defragment(context.Context) error {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	mock.defragmentations++
+	mock.local.DBSize = mock.local.DBSizeInUse
+
+	return nil
+}
+
+func (mock *mockMaintenanceBackend) WithLock(_ context.Context, _ *zap.Logger, f func() error) error {
+	return f()
+}
+
+func (mock *mockMaintenanceBackend) MemberStatusCalls() int {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	return mock.memberStatusCalls
+}
+
+func (mock *mockMaintenanceBackend) Defragmentations() int {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	return mock.defragmentations
+}
+
+func TestMaintenanceSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &MaintenanceSuite{})
+}
+
+type MaintenanceSuite struct {
+	ctest.DefaultSuite
+
+	backend *mockMaintenanceBackend
+}
+
+func (suite *MaintenanceSuite) SetupTest() {
+	suite.backend = &mockMaintenanceBackend{}
+
+	suite.DefaultSuite.AfterSetup = func(s *ctest.DefaultSuite) {
+		s.Require().NoError(s.Runtime().RegisterController(&etcdctrl.MaintenanceController{Backend: suite.backend}))
+	}
+
+	suite.DefaultSuite.SetupTest()
+}
+
+func (suite *MaintenanceSuite) startEtcd(maintenanceCfg *cluster.EtcdMaintenanceConfigV1Alpha1) {
+	if maintenanceCfg != nil {
+		cfg, err := container.New(maintenanceCfg)
+		suite.Require().NoError(err)
+
+		suite.Create(config.NewMachineConfig(cfg))
+	}
+
+	etcdService := v1alpha1.NewService("etcd")
+	etcdService.TypedSpec().Running = true
+	etcdService.TypedSpec().Healthy = true
+
+	suite.Create(etcdService)
+}
+
+func (suite *MaintenanceSuite) TestStatusOnly() {
+	suite.backend.local = etcdctrl.MemberDBStatus{
+		MemberID:    1,
+		Leader:      2,
+		DBSize:      1000,
+		DBSizeInUse: 100,
+	}
+
+	suite.startEtcd(nil)
+
+	ctest.AssertResource(suite, etcd.MaintenanceStatusID, func(status *etcd.MaintenanceStatus, asrt *assert.Assertions) {
+		asrt.Equal("0000000000000001", status.TypedSpec().MemberID)
+		asrt.False(status.TypedSpec().Leader)
+		asrt.EqualValues(1000, status.TypedSpec().DBSize)
+		asrt.EqualValues(100, status.TypedSpec().DBSizeInUse)
+		asrt.EqualValues(etcdctrl.DefaultQuotaBackendBytes, status.TypedSpec().DBSizeQuota)
+		asrt.Empty(status.TypedSpec().History)
+	})
+
+	// no maintenance config, no defragmentation
+	suite.Assert().Zero(suite.backend.Defragmentations())
+}
+
+func (suite *MaintenanceSuite) TestDefragmentFollower() {
+	suite.backend.local = etcdctrl.MemberDBStatus{
+		MemberID:    1,
+		Leader:      2,
+		DBSize:      1000,
+		DBSizeInUse: 400,
+		DBSizeQuota: 10000,
+	}
+
+	suite.startEtcd(cluster.NewEtcdMaintenanceConfigV1Alpha1())
+
+	ctest.AssertResource(suite, etcd.MaintenanceStatusID, func(status *etcd.MaintenanceStatus, asrt *assert.Assertions) {
+		asrt.EqualValues(400, status.TypedSpec().DBSize)
+
+		if asrt.Len(status.TypedSpec().History, 1) {
+			event := status.TypedSpec().History[0]
+
+			asrt.Equal(etcd.MaintenanceOperationDefragment, event.Operation)
+			asrt.EqualValues(1000, event.DBSizeBefore)
+			asrt.EqualValues(400, event.DBSizeAfter)
+			asrt.Empty(event.Error)
+		}
+	})
+
+	suite.Assert().Equal(1, suite.backend.Defragmentations())
+}
+
+func (suite *MaintenanceSuite) TestBelowThreshold() {
+	suite.backend.local = etcdctrl.MemberDBStatus{
+		MemberID:    1,
+		Leader:      2,
+		DBSize:      1000,
+		DBSizeInUse: 600,
+	}
+
+	suite.startEtcd(cluster.NewEtcdMaintenanceConfigV1Alpha1())
+
+	ctest.AssertResource(suite, etcd.MaintenanceStatusID, func(status *etcd.MaintenanceStatus, asrt *assert.Assertions) {
+		asrt.EqualValues(1000, status.TypedSpec().DBSize)
+	})
+
+	suite.Assert().Zero(suite.backend.Defragmentations())
+}
+
+func (suite *MaintenanceSuite) TestLeaderWaitsForFollowers() {
+	suite.backend.local = etcdctrl.MemberDBStatus{
+		MemberID:    1,
+		Leader:      1,
+		DBSize:      1000,
+		DBSizeInUse: 100,
+	}
+	suite.backend.members = []etcdctrl.MemberDBStatus{
+		{
+			MemberID:    2,
+			Leader:      1,
+			DBSize:      1000,
+			DBSizeInUse: 100,
+		},
+		{
+			// learners are not taken into account
+			MemberID:    3,
+			Leader:      1,
+			IsLearner:   true,
+			DBSize:      1000,
+			DBSizeInUse: 100,
+		},
+	}
+
+	suite.startEtcd(cluster.NewEtcdMaintenanceConfigV1Alpha1())
+
+	ctest.AssertResource(suite, etcd.MaintenanceStatusID, func(status *etcd.MaintenanceStatus, asrt *assert.Assertions) {
+		asrt.True(status.TypedSpec().Leader)
+	})
+
+	suite.Require().Eventually(func() bool { return suite.backend.MemberStatusCalls() > 0 }, 3*time.Second, 10*time.Millisecond)
+	suite.Assert().Zero(suite.backend.Defragmentations())
+
+	// the follower got defragmented, so the leader can proceed
+	suite.backend.mu.Lock()
+	suite.backend.members[0].DBSize = 100
+	suite.backend.mu.Unlock()
+
+	// trigger the controller via the service update
+	ctest.UpdateWithConflicts(suite, v1alpha1.NewService("etcd"), func(r *v1alpha1.Service) error {
+		r.TypedSpec().Unknown = true
+
+		return nil
+	})
+
+	ctest.AssertResource(suite, etcd.MaintenanceStatusID, func(status *etcd.MaintenanceStatus, asrt *assert.Assertions) {
+		asrt.Len(status.TypedSpec().History, 1)
+	})
+
+	suite.Assert().Equal(1, suite.backend.Defragmentations())
+}
+
+func (suite *MaintenanceSuite) TestOutsideWindow() {
+	suite.backend.local = etcdctrl.MemberDBStatus{
+		MemberID:    1,
+		Leader:      2,
+		DBSize:      1000,
+		DBSizeInUse: 100,
+	}
+
+	maintenanceCfg := cluster.NewEtcdMaintenanceConfigV1Alpha1()
+	maintenanceCfg.MaintenanceWindow = &cluster.EtcdMaintenanceWindow{
+		// the window which started an hour ago and has already finished
+		WindowStart:    time.Now().UTC().Add(-time.Hour).Format("15:04"),
+		WindowDuration: time.Minute,
+	}
+
+	suite.startEtcd(maintenanceCfg)
+
+	ctest.AssertResource(suite, etcd.MaintenanceStatusID, func(status *etcd.MaintenanceStatus, asrt *assert.Assertions) {
+		asrt.EqualValues(1000, status.TypedSpec().DBSize)
+	})
+
+	suite.Assert().Zero(suite.backend.Defragmentations())
+}
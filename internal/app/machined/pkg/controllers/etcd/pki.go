@@ -5,6 +5,7 @@
 package etcd
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"os"
@@ -17,6 +18,7 @@ import (
 	"github.com/siderolabs/gen/optional"
 	"go.uber.org/zap"
 
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system"
 	"github.com/siderolabs/talos/internal/pkg/selinux"
 	"github.com/siderolabs/talos/pkg/filetree"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
@@ -24,8 +26,18 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
 )
 
+// ServiceManager is the interface to the v1alpha1 services subsystems.
+type ServiceManager interface {
+	IsRunning(id string) (system.Service, bool, error)
+	Stop(ctx context.Context, serviceIDs ...string) (err error)
+	Start(serviceIDs ...string) error
+}
+
 // PKIController renders manifests based on templates and config/secrets.
-type PKIController struct{}
+type PKIController struct {
+	// V1Alpha1Services is used to restart etcd when the accepted CAs change.
+	V1Alpha1Services ServiceManager
+}
 
 // Name implements controller.Controller interface.
 func (ctrl *PKIController) Name() string {
@@ -63,7 +75,12 @@ func (ctrl *PKIController) Outputs() []controller.Output {
 // Run implements controller.Controller interface.
 //
 //nolint:gocyclo
-func (ctrl *PKIController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+func (ctrl *PKIController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	var (
+		lastCABundle   []byte
+		restartPending bool
+	)
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -97,7 +114,15 @@ func (ctrl *PKIController) Run(ctx context.Context, r controller.Runtime, _ *zap
 			return err
 		}
 
-		if err = os.WriteFile(constants.EtcdCACert, rootScrts.TypedSpec().EtcdCA.Crt, 0o400); err != nil {
+		caBundle := rootScrts.TypedSpec().AcceptedCABundle()
+
+		if lastCABundle != nil && !bytes.Equal(lastCABundle, caBundle) {
+			restartPending = true
+		}
+
+		lastCABundle = caBundle
+
+		if err = os.WriteFile(constants.EtcdCACert, caBundle, 0o400); err != nil {
 			return fmt.Errorf("failed to write CA certificate: %w", err)
 		}
 
@@ -150,6 +175,59 @@ func (ctrl *PKIController) Run(ctx context.Context, r controller.Runtime, _ *zap
 			return fmt.Errorf("error updating PKI status: %w", err)
 		}
 
+		// etcd loads the trusted CAs only on startup, so it should be restarted on CA rotation,
+		// but only once the certificates are re-issued with the current CA
+		if restartPending && issuedBy(etcdCerts.EtcdPeer, rootScrts.TypedSpec().EtcdCA) {
+			if err = ctrl.restartEtcd(ctx, logger); err != nil {
+				return err
+			}
+
+			restartPending = false
+		}
+
 		r.ResetRestartBackoff()
 	}
 }
+
+func (ctrl *PKIController) restartEtcd(ctx context.Context, logger *zap.Logger) error {
+	if ctrl.V1Alpha1Services == nil {
+		return nil
+	}
+
+	_, running, err := ctrl.V1Alpha1Services.IsRunning(etcdServiceID)
+	if err != nil || !running {
+		// etcd is not loaded or not running, it will pick up new CAs on start
+		return nil //nolint:nilerr
+	}
+
+	logger.Info("restarting etcd to apply accepted CAs change")
+
+	if err = ctrl.V1Alpha1Services.Stop(ctx, etcdServiceID); err != nil {
+		return fmt.Errorf("error stopping etcd: %w", err)
+	}
+
+	if err = ctrl.V1Alpha1Services.Start(etcdServiceID); err != nil {
+		return fmt.Errorf("error starting etcd: %w", err)
+	}
+
+	return nil
+}
+
+// issuedBy checks whether the certificate is signed by the CA.
+func issuedBy(cert, ca *x509.PEMEncodedCertificateAndKey) bool {
+	if cert == nil || ca == nil {
+		return false
+	}
+
+	parsedCert, err := cert.GetCert()
+	if err != nil {
+		return false
+	}
+
+	caCert, err := (&x509.PEMEncodedCertificate{Crt: ca.Crt}).GetCert()
+	if err != nil {
+		return false
+	}
+
+	return parsedCert.CheckSignatureFrom(caCert) == nil
+}
@@ -0,0 +1,119 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package sriov
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+
+	"github.com/mdlayher/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// VirtualFunctionSettings are the settings of a virtual function applied via the physical function link.
+type VirtualFunctionSettings struct {
+	Index        int
+	HardwareAddr net.HardwareAddr
+	VLANID       uint16
+	Trust        bool
+	SpoofCheck   bool
+}
+
+// ConfigureVirtualFunctions applies virtual function settings via the physical function link.
+func ConfigureVirtualFunctions(pfLinkName string, settings []VirtualFunctionSettings) error {
+	iface, err := net.InterfaceByName(pfLinkName)
+	if err != nil {
+		return fmt.Errorf("error looking up physical function link %q: %w", pfLinkName, err)
+	}
+
+	conn, err := netlink.Dial(unix.NETLINK_ROUTE, nil)
+	if err != nil {
+		return fmt.Errorf("error dialing rtnetlink socket: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	for _, vf := range settings {
+		data, err := EncodeSetLink(iface.Index, vf)
+		if err != nil {
+			return fmt.Errorf("error encoding settings for VF %d: %w", vf.Index, err)
+		}
+
+		if _, err = conn.Execute(netlink.Message{
+			Header: netlink.Header{
+				Type:  unix.RTM_SETLINK,
+				Flags: netlink.Request | netlink.Acknowledge,
+			},
+			Data: data,
+		}); err != nil {
+			return fmt.Errorf("error configuring VF %d of %q: %w", vf.Index, pfLinkName, err)
+		}
+	}
+
+	return nil
+}
+
+// EncodeSetLink encodes RTM_SETLINK message body which applies virtual function settings.
+func EncodeSetLink(pfIndex int, vf VirtualFunctionSettings) ([]byte, error) {
+	// struct ifinfomsg
+	header := make([]byte, unix.SizeofIfInfomsg)
+	header[0] = unix.AF_UNSPEC
+	binary.NativeEndian.PutUint32(header[4:8], uint32(pfIndex))
+
+	encoder := netlink.NewAttributeEncoder()
+
+	encoder.Nested(unix.IFLA_VFINFO_LIST, func(list *netlink.AttributeEncoder) error {
+		list.Nested(unix.IFLA_VF_INFO, func(info *netlink.AttributeEncoder) error {
+			if len(vf.HardwareAddr) > 0 {
+				// struct ifla_vf_mac
+				mac := make([]byte, 4+32)
+				binary.NativeEndian.PutUint32(mac[0:4], uint32(vf.Index))
+				copy(mac[4:], vf.HardwareAddr)
+
+				info.Bytes(unix.IFLA_VF_MAC, mac)
+			}
+
+			// struct ifla_vf_vlan, QoS is left unset
+			info.Bytes(unix.IFLA_VF_VLAN, vfUint32s(vf.Index, uint32(vf.VLANID), 0))
+
+			// struct ifla_vf_spoofchk
+			info.Bytes(unix.IFLA_VF_SPOOFCHK, vfUint32s(vf.Index, boolToUint32(vf.SpoofCheck)))
+
+			// struct ifla_vf_trust
+			info.Bytes(unix.IFLA_VF_TRUST, vfUint32s(vf.Index, boolToUint32(vf.Trust)))
+
+			return nil
+		})
+
+		return nil
+	})
+
+	attrs, err := encoder.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(header, attrs...), nil
+}
+
+func vfUint32s(index int, values ...uint32) []byte {
+	b := make([]byte, 4*(len(values)+1))
+	binary.NativeEndian.PutUint32(b, uint32(index))
+
+	for i, v := range values {
+		binary.NativeEndian.PutUint32(b[4*(i+1):], v)
+	}
+
+	return b
+}
+
+func boolToUint32(v bool) uint32 {
+	if v {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,218 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package sriov implements management of SR-IOV physical and virtual functions via sysfs and rtnetlink.
+package sriov
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// DefaultSysfsRoot is the default mount point of sysfs.
+const DefaultSysfsRoot = "/sys"
+
+// ErrNotSupported is returned when the PCI device doesn't support SR-IOV.
+var ErrNotSupported = errors.New("device doesn't support SR-IOV")
+
+// PhysicalFunction is a PCI device which supports SR-IOV.
+type PhysicalFunction struct {
+	// Root is the sysfs mount point.
+	Root string
+	// Address is the PCI address of the device.
+	Address string
+}
+
+// NewPhysicalFunction returns a physical function with the specified PCI address.
+func NewPhysicalFunction(root, address string) PhysicalFunction {
+	return PhysicalFunction{
+		Root:    root,
+		Address: address,
+	}
+}
+
+func (pf PhysicalFunction) path(elem ...string) string {
+	return devicePath(pf.Root, pf.Address, elem...)
+}
+
+// TotalVFs returns the maximum number of virtual functions supported by the device.
+func (pf PhysicalFunction) TotalVFs() (int, error) {
+	totalVFs, err := readInt(pf.path("sriov_totalvfs"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, ErrNotSupported
+	}
+
+	return totalVFs, err
+}
+
+// NumVFs returns the current number of virtual functions.
+func (pf PhysicalFunction) NumVFs() (int, error) {
+	return readInt(pf.path("sriov_numvfs"))
+}
+
+// SetNumVFs sets the number of virtual functions.
+//
+// If autoprobe is false, the virtual functions are not bound to the default driver on creation.
+// The kernel doesn't allow changing the number of VFs directly, so VFs are removed first.
+func (pf PhysicalFunction) SetNumVFs(numVFs int, autoprobe bool) error {
+	current, err := pf.NumVFs()
+	if err != nil {
+		return err
+	}
+
+	autoprobeValue := "0"
+	if autoprobe {
+		autoprobeValue = "1"
+	}
+
+	if err = writeString(pf.path("sriov_drivers_autoprobe"), autoprobeValue); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	if current == numVFs {
+		return nil
+	}
+
+	if current != 0 {
+		if err = writeString(pf.path("sriov_numvfs"), "0"); err != nil {
+			return err
+		}
+	}
+
+	if numVFs == 0 {
+		return nil
+	}
+
+	return writeString(pf.path("sriov_numvfs"), strconv.Itoa(numVFs))
+}
+
+// LinkName returns the name of the network interface of the physical function, if any.
+func (pf PhysicalFunction) LinkName() (string, error) {
+	return firstEntry(pf.path("net"))
+}
+
+// VirtualFunction returns the virtual function by its index.
+func (pf PhysicalFunction) VirtualFunction(index int) (VirtualFunction, error) {
+	target, err := os.Readlink(pf.path(fmt.Sprintf("virtfn%d", index)))
+	if err != nil {
+		return VirtualFunction{}, err
+	}
+
+	return VirtualFunction{
+		Root:    pf.Root,
+		Index:   index,
+		Address: filepath.Base(target),
+	}, nil
+}
+
+// VirtualFunction is a PCI device created by a physical function.
+type VirtualFunction struct {
+	// Root is the sysfs mount point.
+	Root string
+	// Index of the virtual function.
+	Index int
+	// Address is the PCI address of the device.
+	Address string
+}
+
+func (vf VirtualFunction) path(elem ...string) string {
+	return devicePath(vf.Root, vf.Address, elem...)
+}
+
+// Driver returns the name of the driver the virtual function is bound to, if any.
+func (vf VirtualFunction) Driver() (string, error) {
+	target, err := os.Readlink(vf.path("driver"))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return filepath.Base(target), nil
+}
+
+// LinkName returns the name of the network interface of the virtual function, if any.
+func (vf VirtualFunction) LinkName() (string, error) {
+	return firstEntry(vf.path("net"))
+}
+
+// BindDriver binds the virtual function to the specified driver.
+func (vf VirtualFunction) BindDriver(driver string) error {
+	if err := writeString(vf.path("driver_override"), driver); err != nil {
+		return err
+	}
+
+	// the device might not be bound to any driver
+	if err := writeString(vf.path("driver", "unbind"), vf.Address); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return writeString(filepath.Join(vf.Root, "bus", "pci", "drivers_probe"), vf.Address)
+}
+
+// ClearDriverOverride removes the driver override of the virtual function, and rebinds it to the default driver.
+//
+// If the driver is not overridden, it does nothing.
+func (vf VirtualFunction) ClearDriverOverride() error {
+	override, err := os.ReadFile(vf.path("driver_override"))
+	if err != nil {
+		return err
+	}
+
+	if value := strings.TrimSpace(string(override)); value == "" || value == "(null)" {
+		return nil
+	}
+
+	// an empty value clears the override, but the write should not be empty to reach the kernel
+	if err = writeString(vf.path("driver_override"), "\n"); err != nil {
+		return err
+	}
+
+	if err = writeString(vf.path("driver", "unbind"), vf.Address); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return writeString(filepath.Join(vf.Root, "bus", "pci", "drivers_probe"), vf.Address)
+}
+
+func devicePath(root, address string, elem ...string) string {
+	return filepath.Join(append([]string{root, "bus", "pci", "devices", address}, elem...)...)
+}
+
+func readInt(path string) (int, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.Atoi(strings.TrimSpace(string(contents)))
+}
+
+func writeString(path, value string) error {
+	return os.WriteFile(path, []byte(value), 0o200)
+}
+
+func firstEntry(path string) (string, error) {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	if len(entries) == 0 {
+		return "", nil
+	}
+
+	return entries[0].Name(), nil
+}
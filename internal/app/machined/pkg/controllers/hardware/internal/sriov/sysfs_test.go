@@ -0,0 +1,136 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package sriov_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/hardware/internal/sriov"
+)
+
+const (
+	pfAddress = "0000:04:00.0"
+	vfAddress = "0000:04:02.0"
+)
+
+func setupSysfs(t *testing.T) string {
+	t.Helper()
+
+	root := t.TempDir()
+
+	devices := filepath.Join(root, "bus", "pci", "devices")
+	drivers := filepath.Join(root, "bus", "pci", "drivers")
+
+	for _, dir := range []string{
+		filepath.Join(devices, pfAddress, "net", "enp4s0f0"),
+		filepath.Join(devices, vfAddress, "net", "enp4s0f0v0"),
+		filepath.Join(drivers, "iavf"),
+	} {
+		require.NoError(t, os.MkdirAll(dir, 0o755))
+	}
+
+	for file, contents := range map[string]string{
+		filepath.Join(devices, pfAddress, "sriov_totalvfs"):          "64\n",
+		filepath.Join(devices, pfAddress, "sriov_numvfs"):            "0\n",
+		filepath.Join(devices, pfAddress, "sriov_drivers_autoprobe"): "1\n",
+		filepath.Join(devices, vfAddress, "driver_override"):         "(null)\n",
+		filepath.Join(drivers, "iavf", "unbind"):                     "",
+		filepath.Join(root, "bus", "pci", "drivers_probe"):           "",
+	} {
+		require.NoError(t, os.WriteFile(file, []byte(contents), 0o644))
+	}
+
+	require.NoError(t, os.Symlink(filepath.Join("..", vfAddress), filepath.Join(devices, pfAddress, "virtfn0")))
+	require.NoError(t, os.Symlink(filepath.Join(drivers, "iavf"), filepath.Join(devices, vfAddress, "driver")))
+
+	return root
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+
+	contents, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	return string(contents)
+}
+
+func TestPhysicalFunction(t *testing.T) {
+	t.Parallel()
+
+	root := setupSysfs(t)
+
+	pf := sriov.NewPhysicalFunction(root, pfAddress)
+
+	totalVFs, err := pf.TotalVFs()
+	require.NoError(t, err)
+	assert.Equal(t, 64, totalVFs)
+
+	linkName, err := pf.LinkName()
+	require.NoError(t, err)
+	assert.Equal(t, "enp4s0f0", linkName)
+
+	require.NoError(t, pf.SetNumVFs(4, false))
+
+	numVFs, err := pf.NumVFs()
+	require.NoError(t, err)
+	assert.Equal(t, 4, numVFs)
+	assert.Equal(t, "0", readFile(t, filepath.Join(root, "bus", "pci", "devices", pfAddress, "sriov_drivers_autoprobe")))
+
+	vf, err := pf.VirtualFunction(0)
+	require.NoError(t, err)
+	assert.Equal(t, vfAddress, vf.Address)
+
+	driver, err := vf.Driver()
+	require.NoError(t, err)
+	assert.Equal(t, "iavf", driver)
+
+	vfLinkName, err := vf.LinkName()
+	require.NoError(t, err)
+	assert.Equal(t, "enp4s0f0v0", vfLinkName)
+
+	require.NoError(t, vf.BindDriver("vfio-pci"))
+
+	assert.Equal(t, "vfio-pci", readFile(t, filepath.Join(root, "bus", "pci", "devices", vfAddress, "driver_override")))
+	assert.Equal(t, vfAddress, readFile(t, filepath.Join(root, "bus", "pci", "drivers", "iavf", "unbind")))
+	assert.Equal(t, vfAddress, readFile(t, filepath.Join(root, "bus", "pci", "drivers_probe")))
+
+	_, err = pf.VirtualFunction(1)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	// clear the override, and rebind the VF to the default driver
+	for _, path := range []string{
+		filepath.Join(root, "bus", "pci", "drivers", "iavf", "unbind"),
+		filepath.Join(root, "bus", "pci", "drivers_probe"),
+	} {
+		require.NoError(t, os.WriteFile(path, nil, 0o644))
+	}
+
+	require.NoError(t, vf.ClearDriverOverride())
+
+	assert.Equal(t, "\n", readFile(t, filepath.Join(root, "bus", "pci", "devices", vfAddress, "driver_override")))
+	assert.Equal(t, vfAddress, readFile(t, filepath.Join(root, "bus", "pci", "drivers", "iavf", "unbind")))
+	assert.Equal(t, vfAddress, readFile(t, filepath.Join(root, "bus", "pci", "drivers_probe")))
+
+	// no override, nothing to do
+	require.NoError(t, os.WriteFile(filepath.Join(root, "bus", "pci", "drivers_probe"), nil, 0o644))
+	require.NoError(t, vf.ClearDriverOverride())
+
+	assert.Empty(t, readFile(t, filepath.Join(root, "bus", "pci", "drivers_probe")))
+}
+
+func TestPhysicalFunctionNotSupported(t *testing.T) {
+	t.Parallel()
+
+	root := setupSysfs(t)
+
+	_, err := sriov.NewPhysicalFunction(root, vfAddress).TotalVFs()
+	assert.ErrorIs(t, err, sriov.ErrNotSupported)
+}
@@ -67,7 +67,6 @@ func (ctrl *PCIDevicesController) Run(ctx context.Context, r controller.Runtime,
 		return nil
 	}
 
-	// [TODO]: a single run for now, need to figure out how to trigger rescan
 	for {
 		select {
 		case <-ctx.Done():
@@ -90,6 +89,32 @@ func (ctrl *PCIDevicesController) Run(ctx context.Context, r controller.Runtime,
 		}
 	}
 
+	// rescan PCI devices when SR-IOV virtual functions are created or removed
+	if err := r.UpdateInputs(append(ctrl.Inputs(), controller.Input{
+		Namespace: hardware.NamespaceName,
+		Type:      hardware.SRIOVStatusType,
+		Kind:      controller.InputWeak,
+	})); err != nil {
+		return fmt.Errorf("error updating inputs: %w", err)
+	}
+
+	for {
+		if err := ctrl.scan(ctx, r, logger); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+	}
+}
+
+// scan populates PCI devices from sysfs.
+//
+//nolint:gocyclo
+func (ctrl *PCIDevicesController) scan(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
 	deviceIDs, err := os.ReadDir("/sys/bus/pci/devices")
 	if err != nil {
 		return fmt.Errorf("error scanning devices: %w", err)
@@ -26,6 +26,8 @@ import (
 type PCRStatusController struct {
 	V1Alpha1Mode runtimetalos.Mode
 	TPMExtender  func(pcr int, data []byte) error
+	// PhaseRecorder records the PCR value before it is extended past the signed `enter-machined` phase.
+	PhaseRecorder func() error
 
 	numberOfExtensions int
 }
@@ -194,6 +196,12 @@ func (ctrl *PCRStatusController) Run(ctx context.Context, r controller.Runtime,
 				return fmt.Errorf("error destroying PCRStatus resource: %w", err)
 			}
 
+			if ctrl.PhaseRecorder != nil {
+				if err = ctrl.PhaseRecorder(); err != nil {
+					logger.Warn("failed to record PCR value in the enter-machined phase", zap.Error(err))
+				}
+			}
+
 			if err := ctrl.TPMExtender(constants.UKIPCR, []byte(secureboot.StartTheWorld)); err != nil {
 				return fmt.Errorf("error performing PCR extension: %w", err)
 			}
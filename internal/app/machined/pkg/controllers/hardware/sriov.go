@@ -0,0 +1,302 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardware
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/hardware/internal/sriov"
+	runtimectrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	v1alpha1runtime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// SRIOVController creates SR-IOV virtual functions and applies their settings.
+type SRIOVController struct {
+	V1Alpha1Mode v1alpha1runtime.Mode
+
+	// SysfsRoot is the sysfs mount point, defaults to /sys.
+	SysfsRoot string
+
+	// applied tracks the configuration applied to each physical function.
+	applied map[string]hardware.SRIOVConfigSpec
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *SRIOVController) Name() string {
+	return "hardware.SRIOVController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *SRIOVController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *SRIOVController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: hardware.SRIOVStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *SRIOVController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// SR-IOV can't be managed from a container or in agent mode.
+	if ctrl.V1Alpha1Mode.InContainer() || ctrl.V1Alpha1Mode.IsAgent() {
+		return nil
+	}
+
+	if ctrl.SysfsRoot == "" {
+		ctrl.SysfsRoot = sriov.DefaultSysfsRoot
+	}
+
+	if ctrl.applied == nil {
+		ctrl.applied = map[string]hardware.SRIOVConfigSpec{}
+	}
+
+	// wait for udevd to be healthy, so that the physical function drivers are loaded.
+	if err := runtimectrl.WaitForDevicesReady(ctx, r,
+		[]controller.Input{
+			{
+				Namespace: hardware.NamespaceName,
+				Type:      hardware.SRIOVConfigType,
+				Kind:      controller.InputWeak,
+			},
+			{
+				// link statuses are watched to refresh the names of the virtual function links
+				Namespace: network.NamespaceName,
+				Type:      network.LinkStatusType,
+				Kind:      controller.InputWeak,
+			},
+		}); err != nil {
+		return fmt.Errorf("error waiting for devices to be ready: %w", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		sriovConfigs, err := safe.ReaderListAll[*hardware.SRIOVConfig](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing SR-IOV configs: %w", err)
+		}
+
+		r.StartTrackingOutputs()
+
+		touchedIDs := map[string]struct{}{}
+
+		// errors are collected per physical function, so that a failure of one doesn't block the others
+		var errs error
+
+		for cfg := range sriovConfigs.All() {
+			spec := cfg.TypedSpec()
+			pf := sriov.NewPhysicalFunction(ctrl.SysfsRoot, spec.PhysicalFunction)
+
+			totalVFs, err := pf.TotalVFs()
+			if err != nil {
+				if errors.Is(err, sriov.ErrNotSupported) {
+					logger.Warn("PCI device doesn't support SR-IOV, skipping", zap.String("pci_address", spec.PhysicalFunction))
+
+					continue
+				}
+
+				errs = errors.Join(errs, fmt.Errorf("error reading total VFs of %s: %w", spec.PhysicalFunction, err))
+
+				continue
+			}
+
+			if int(spec.NumVFs) > totalVFs {
+				logger.Warn("requested number of VFs exceeds the device limit, skipping",
+					zap.String("pci_address", spec.PhysicalFunction),
+					zap.Uint32("num_vfs", spec.NumVFs),
+					zap.Int("total_vfs", totalVFs),
+				)
+
+				continue
+			}
+
+			touchedIDs[spec.PhysicalFunction] = struct{}{}
+
+			if applied, ok := ctrl.applied[spec.PhysicalFunction]; !ok || !sriovConfigSpecEqual(applied, *spec) {
+				if err = ctrl.apply(pf, spec); err != nil {
+					errs = errors.Join(errs, err)
+				} else {
+					ctrl.applied[spec.PhysicalFunction] = *spec
+
+					logger.Info("SR-IOV virtual functions configured",
+						zap.String("pci_address", spec.PhysicalFunction),
+						zap.Uint32("num_vfs", spec.NumVFs),
+						zap.String("driver", spec.Driver),
+					)
+				}
+			}
+
+			if err = ctrl.updateStatus(ctx, r, pf, spec, totalVFs); err != nil {
+				errs = errors.Join(errs, err)
+			}
+		}
+
+		// remove virtual functions of physical functions which are no longer configured.
+		for pciAddress := range ctrl.applied {
+			if _, ok := touchedIDs[pciAddress]; ok {
+				continue
+			}
+
+			if err = sriov.NewPhysicalFunction(ctrl.SysfsRoot, pciAddress).SetNumVFs(0, true); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("error removing VFs of %s: %w", pciAddress, err))
+
+				continue
+			}
+
+			delete(ctrl.applied, pciAddress)
+
+			logger.Info("SR-IOV virtual functions removed", zap.String("pci_address", pciAddress))
+		}
+
+		if err = safe.CleanupOutputs[*hardware.SRIOVStatus](ctx, r); err != nil {
+			return err
+		}
+
+		if errs != nil {
+			return errs
+		}
+	}
+}
+
+// apply creates the virtual functions, binds them to the target driver and applies per-VF settings.
+func (ctrl *SRIOVController) apply(pf sriov.PhysicalFunction, spec *hardware.SRIOVConfigSpec) error {
+	// when the driver is overridden, do not let the default driver grab the VFs on creation
+	if err := pf.SetNumVFs(int(spec.NumVFs), spec.Driver == ""); err != nil {
+		return fmt.Errorf("error setting number of VFs of %s: %w", spec.PhysicalFunction, err)
+	}
+
+	for i := range int(spec.NumVFs) {
+		vf, err := pf.VirtualFunction(i)
+		if err != nil {
+			return fmt.Errorf("error looking up VF %d of %s: %w", i, spec.PhysicalFunction, err)
+		}
+
+		if spec.Driver == "" {
+			// the driver might have been overridden by the previous configuration, so bring back the default one
+			if err = vf.ClearDriverOverride(); err != nil {
+				return fmt.Errorf("error clearing driver override of VF %d of %s: %w", i, spec.PhysicalFunction, err)
+			}
+
+			continue
+		}
+
+		if err = vf.BindDriver(spec.Driver); err != nil {
+			return fmt.Errorf("error binding VF %d of %s to %q: %w", i, spec.PhysicalFunction, spec.Driver, err)
+		}
+	}
+
+	pfLinkName, err := pf.LinkName()
+	if err != nil {
+		return fmt.Errorf("error looking up link of %s: %w", spec.PhysicalFunction, err)
+	}
+
+	// VF settings are applied via the physical function netdev, so PFs without a network driver can't have them
+	if pfLinkName == "" {
+		return nil
+	}
+
+	settings := make([]sriov.VirtualFunctionSettings, 0, len(spec.VFs))
+
+	for _, vf := range spec.VFs {
+		settings = append(settings, sriov.VirtualFunctionSettings{
+			Index:        int(vf.Index),
+			HardwareAddr: net.HardwareAddr(vf.HardwareAddr),
+			VLANID:       vf.VLANID,
+			Trust:        vf.Trust,
+			SpoofCheck:   vf.SpoofCheck,
+		})
+	}
+
+	return sriov.ConfigureVirtualFunctions(pfLinkName, settings)
+}
+
+func (ctrl *SRIOVController) updateStatus(ctx context.Context, r controller.Runtime, pf sriov.PhysicalFunction, spec *hardware.SRIOVConfigSpec, totalVFs int) error {
+	numVFs, err := pf.NumVFs()
+	if err != nil {
+		return fmt.Errorf("error reading number of VFs of %s: %w", spec.PhysicalFunction, err)
+	}
+
+	pfLinkName, err := pf.LinkName()
+	if err != nil {
+		return fmt.Errorf("error looking up link of %s: %w", spec.PhysicalFunction, err)
+	}
+
+	vfs := make([]hardware.SRIOVVirtualFunctionStatus, 0, numVFs)
+
+	for i := range numVFs {
+		vf, err := pf.VirtualFunction(i)
+		if err != nil {
+			return fmt.Errorf("error looking up VF %d of %s: %w", i, spec.PhysicalFunction, err)
+		}
+
+		driver, err := vf.Driver()
+		if err != nil {
+			return fmt.Errorf("error reading driver of VF %s: %w", vf.Address, err)
+		}
+
+		linkName, err := vf.LinkName()
+		if err != nil {
+			return fmt.Errorf("error looking up link of VF %s: %w", vf.Address, err)
+		}
+
+		vfs = append(vfs, hardware.SRIOVVirtualFunctionStatus{
+			Index:      uint32(i),
+			PCIAddress: vf.Address,
+			LinkName:   linkName,
+			Driver:     driver,
+		})
+	}
+
+	if err = safe.WriterModify(ctx, r, hardware.NewSRIOVStatus(spec.PhysicalFunction), func(res *hardware.SRIOVStatus) error {
+		res.TypedSpec().PhysicalFunction = spec.PhysicalFunction
+		res.TypedSpec().LinkName = pfLinkName
+		res.TypedSpec().TotalVFs = uint32(totalVFs)
+		res.TypedSpec().NumVFs = uint32(numVFs)
+		res.TypedSpec().VFs = vfs
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("error updating SR-IOV status: %w", err)
+	}
+
+	return nil
+}
+
+func sriovConfigSpecEqual(a, b hardware.SRIOVConfigSpec) bool {
+	return a.PhysicalFunction == b.PhysicalFunction &&
+		a.LinkName == b.LinkName &&
+		a.NumVFs == b.NumVFs &&
+		a.Driver == b.Driver &&
+		slices.EqualFunc(a.VFs, b.VFs, func(x, y hardware.SRIOVVirtualFunctionSpec) bool {
+			return x.Index == y.Index &&
+				bytes.Equal(x.HardwareAddr, y.HardwareAddr) &&
+				x.VLANID == y.VLANID &&
+				x.Trust == y.Trust &&
+				x.SpoofCheck == y.SpoofCheck
+		})
+}
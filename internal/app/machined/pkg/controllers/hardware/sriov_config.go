@@ -0,0 +1,231 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardware
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	hardwarepb "github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/hardware"
+	"github.com/siderolabs/talos/pkg/machinery/cel/celenv"
+	configconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// SRIOVConfigController resolves SR-IOV configuration documents to physical functions.
+type SRIOVConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *SRIOVConfigController) Name() string {
+	return "hardware.SRIOVConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *SRIOVConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.ActiveID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.LinkStatusType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: hardware.NamespaceName,
+			Type:      hardware.PCIDeviceType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *SRIOVConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: hardware.SRIOVConfigType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *SRIOVConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		linkStatuses, err := safe.ReaderListAll[*network.LinkStatus](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing link statuses: %w", err)
+		}
+
+		pciDevices, err := safe.ReaderListAll[*hardware.PCIDevice](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing PCI devices: %w", err)
+		}
+
+		r.StartTrackingOutputs()
+
+		var sriovConfigs []configconfig.SRIOVConfig
+
+		if cfg != nil {
+			sriovConfigs = cfg.Config().SRIOVConfigs()
+		}
+
+		linkNameResolver := network.NewLinkResolver(linkStatuses.All)
+
+		// PCI address -> link name of the physical function
+		linksByPCIAddress := map[string]string{}
+
+		for link := range linkStatuses.All() {
+			if link.TypedSpec().BusPath != "" && link.TypedSpec().Physical() {
+				linksByPCIAddress[link.TypedSpec().BusPath] = link.Metadata().ID()
+			}
+		}
+
+		// PCI address -> config document name, first document wins
+		claimedBy := map[string]string{}
+
+		for _, sriovConfig := range sriovConfigs {
+			physicalFunctions, err := ctrl.resolvePhysicalFunctions(sriovConfig, linkNameResolver, linkStatuses, pciDevices)
+			if err != nil {
+				return err
+			}
+
+			for _, pf := range physicalFunctions {
+				if owner, claimed := claimedBy[pf]; claimed {
+					logger.Warn("physical function is already configured by another document, skipping",
+						zap.String("pci_address", pf),
+						zap.String("document", sriovConfig.Name()),
+						zap.String("owner", owner),
+					)
+
+					continue
+				}
+
+				claimedBy[pf] = sriovConfig.Name()
+
+				if err = safe.WriterModify(ctx, r, hardware.NewSRIOVConfig(pf), func(res *hardware.SRIOVConfig) error {
+					spec := res.TypedSpec()
+
+					spec.PhysicalFunction = pf
+					spec.LinkName = linksByPCIAddress[pf]
+					spec.NumVFs = uint32(sriovConfig.NumVFs())
+					spec.Driver = sriovConfig.VFDriver()
+					spec.VFs = virtualFunctionSpecs(sriovConfig)
+
+					return nil
+				}); err != nil {
+					return fmt.Errorf("error updating SR-IOV config: %w", err)
+				}
+			}
+		}
+
+		if err = safe.CleanupOutputs[*hardware.SRIOVConfig](ctx, r); err != nil {
+			return err
+		}
+	}
+}
+
+// resolvePhysicalFunctions returns sorted list of PCI addresses of physical functions matching the config document.
+func (ctrl *SRIOVConfigController) resolvePhysicalFunctions(
+	sriovConfig configconfig.SRIOVConfig,
+	linkNameResolver *network.LinkResolver,
+	linkStatuses safe.List[*network.LinkStatus],
+	pciDevices safe.List[*hardware.PCIDevice],
+) ([]string, error) {
+	if linkName, ok := sriovConfig.PhysicalFunctionLink().Get(); ok {
+		link, found := linkStatuses.Find(func(link *network.LinkStatus) bool {
+			return link.Metadata().ID() == linkNameResolver.Resolve(linkName)
+		})
+		if !found || link.TypedSpec().BusPath == "" {
+			return nil, nil
+		}
+
+		return []string{link.TypedSpec().BusPath}, nil
+	}
+
+	selector, ok := sriovConfig.PhysicalFunctionSelector().Get()
+	if !ok {
+		return nil, nil
+	}
+
+	var physicalFunctions []string
+
+	for pciDevice := range pciDevices.All() {
+		var spec hardwarepb.PCIDeviceSpec
+
+		if err := proto.ResourceSpecToProto(pciDevice, &spec); err != nil {
+			return nil, fmt.Errorf("error converting PCI device spec (%s) to proto: %w", pciDevice.Metadata().ID(), err)
+		}
+
+		matches, err := selector.EvalBool(celenv.PCIDeviceLocator(), map[string]any{
+			"pci_device":  &spec,
+			"pci_address": pciDevice.Metadata().ID(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error evaluating PCI device selector: %w", err)
+		}
+
+		if matches {
+			physicalFunctions = append(physicalFunctions, pciDevice.Metadata().ID())
+		}
+	}
+
+	slices.Sort(physicalFunctions)
+
+	return physicalFunctions, nil
+}
+
+// virtualFunctionSpecs expands per-VF settings to the full list of virtual functions, filling in defaults.
+func virtualFunctionSpecs(sriovConfig configconfig.SRIOVConfig) []hardware.SRIOVVirtualFunctionSpec {
+	vfs := make([]hardware.SRIOVVirtualFunctionSpec, sriovConfig.NumVFs())
+
+	for i := range vfs {
+		vfs[i] = hardware.SRIOVVirtualFunctionSpec{
+			Index:      uint32(i),
+			SpoofCheck: true,
+		}
+	}
+
+	for _, vf := range sriovConfig.VFs() {
+		if vf.Index() < 0 || vf.Index() >= len(vfs) {
+			continue
+		}
+
+		spec := &vfs[vf.Index()]
+
+		spec.HardwareAddr = vf.HardwareAddress().ValueOrZero()
+		spec.VLANID = vf.VLANID()
+		spec.Trust = vf.Trust()
+		spec.SpoofCheck = vf.SpoofCheck()
+	}
+
+	return vfs
+}
@@ -0,0 +1,136 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardware_test
+
+import (
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/hardware"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	hardwareconfigtype "github.com/siderolabs/talos/pkg/machinery/config/types/hardware"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	hardwareres "github.com/siderolabs/talos/pkg/machinery/resources/hardware"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type SRIOVConfigSuite struct {
+	ctest.DefaultSuite
+}
+
+func TestSRIOVConfigSuite(t *testing.T) {
+	suite.Run(t, &SRIOVConfigSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&hardware.SRIOVConfigController{}))
+			},
+		},
+	})
+}
+
+func (suite *SRIOVConfigSuite) TestLink() {
+	link := network.NewLinkStatus(network.NamespaceName, "enp4s0f0")
+	link.TypedSpec().Type = nethelpers.LinkEther
+	link.TypedSpec().BusPath = "0000:04:00.0"
+	suite.Create(link)
+
+	sriovConfig := hardwareconfigtype.NewSRIOVConfigV1Alpha1("enp4s0f0-vfs")
+	sriovConfig.PhysicalFunction.PFLink = "enp4s0f0"
+	sriovConfig.SRIOVNumVFs = 2
+	sriovConfig.SRIOVDriver = "vfio-pci"
+	sriovConfig.SRIOVVFs = []hardwareconfigtype.SRIOVVirtualFunctionConfig{
+		{
+			VFIndex:           1,
+			VFHardwareAddress: nethelpers.HardwareAddr{0x2e, 0x3c, 0x4d, 0x5e, 0x6f, 0x70},
+			VFVLANID:          100,
+			VFTrust:           new(true),
+		},
+	}
+
+	cfg, err := container.New(sriovConfig)
+	suite.Require().NoError(err)
+
+	machineConfig := config.NewMachineConfig(cfg)
+	suite.Create(machineConfig)
+
+	ctest.AssertResource(suite, "0000:04:00.0", func(res *hardwareres.SRIOVConfig, asrt *assert.Assertions) {
+		asrt.Equal(hardwareres.SRIOVConfigSpec{
+			PhysicalFunction: "0000:04:00.0",
+			LinkName:         "enp4s0f0",
+			NumVFs:           2,
+			Driver:           "vfio-pci",
+			VFs: []hardwareres.SRIOVVirtualFunctionSpec{
+				{
+					Index:      0,
+					SpoofCheck: true,
+				},
+				{
+					Index:        1,
+					HardwareAddr: nethelpers.HardwareAddr{0x2e, 0x3c, 0x4d, 0x5e, 0x6f, 0x70},
+					VLANID:       100,
+					Trust:        true,
+					SpoofCheck:   true,
+				},
+			},
+		}, *res.TypedSpec())
+	})
+
+	suite.Destroy(machineConfig)
+
+	rtestutils.AssertNoResource[*hardwareres.SRIOVConfig](suite.Ctx(), suite.T(), suite.State(), "0000:04:00.0")
+}
+
+func (suite *SRIOVConfigSuite) TestSelector() {
+	for _, pciDevice := range []struct {
+		id     string
+		driver string
+	}{
+		{"0000:04:00.0", "ice"},
+		{"0000:04:00.1", "ice"},
+		{"0000:05:00.0", "igb"},
+	} {
+		res := hardwareres.NewPCIDeviceInfo(pciDevice.id)
+		res.TypedSpec().Driver = pciDevice.driver
+		suite.Create(res)
+	}
+
+	link := network.NewLinkStatus(network.NamespaceName, "enp4s0f1")
+	link.TypedSpec().Type = nethelpers.LinkEther
+	link.TypedSpec().BusPath = "0000:04:00.1"
+	suite.Create(link)
+
+	sriovConfig := hardwareconfigtype.NewSRIOVConfigV1Alpha1("ice-vfs")
+	suite.Require().NoError(sriovConfig.PhysicalFunction.PFSelector.Match.UnmarshalText([]byte(`pci_device.driver == "ice"`)))
+	sriovConfig.SRIOVNumVFs = 1
+
+	// the second document conflicts with the first one and is ignored
+	conflictingConfig := hardwareconfigtype.NewSRIOVConfigV1Alpha1("other-vfs")
+	suite.Require().NoError(conflictingConfig.PhysicalFunction.PFSelector.Match.UnmarshalText([]byte(`glob("0000:04:*", pci_address)`)))
+	conflictingConfig.SRIOVNumVFs = 8
+
+	cfg, err := container.New(sriovConfig, conflictingConfig)
+	suite.Require().NoError(err)
+
+	suite.Create(config.NewMachineConfig(cfg))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{"0000:04:00.0", "0000:04:00.1"},
+		func(res *hardwareres.SRIOVConfig, asrt *assert.Assertions) {
+			asrt.EqualValues(1, res.TypedSpec().NumVFs)
+
+			if res.Metadata().ID() == "0000:04:00.1" {
+				asrt.Equal("enp4s0f1", res.TypedSpec().LinkName)
+			} else {
+				asrt.Empty(res.TypedSpec().LinkName)
+			}
+		},
+	)
+
+	rtestutils.AssertNoResource[*hardwareres.SRIOVConfig](suite.Ctx(), suite.T(), suite.State(), "0000:05:00.0")
+}
@@ -259,6 +259,17 @@ func NewControlPlaneAPIServerController() *ControlPlaneAPIServerController {
 					extraArgs[k] = k8s.ArgValues{Values: v}
 				}
 
+				extraVolumes := convertVolumes(cfgProvider.K8sAPIServerConfig().ExtraVolumes())
+
+				if cfgProvider.K8sKMSConfig() != nil {
+					extraVolumes = append(extraVolumes, k8s.ExtraVolume{
+						Name:      "kms-plugin",
+						HostPath:  filepath.Dir(constants.KubernetesKMSPluginSocketPath),
+						MountPath: filepath.Dir(constants.KubernetesKMSPluginSocketPath),
+						ReadOnly:  true,
+					})
+				}
+
 				*res.TypedSpec() = k8s.APIServerConfigSpec{
 					Image:                   cfgProvider.K8sAPIServerConfig().Image(),
 					CloudProvider:           cloudProvider,
@@ -267,7 +278,7 @@ func NewControlPlaneAPIServerController() *ControlPlaneAPIServerController {
 					LocalPort:               cfgProvider.K8sAPIServerConfig().APIPort(),
 					ServiceCIDRs:            xslices.Map(cfgProvider.K8sNetworkConfig().ServiceCIDRs(), netip.Prefix.String),
 					ExtraArgs:               extraArgs,
-					ExtraVolumes:            convertVolumes(cfgProvider.K8sAPIServerConfig().ExtraVolumes()),
+					ExtraVolumes:            extraVolumes,
 					EnvironmentVariables:    cfgProvider.K8sAPIServerConfig().Env(),
 					AdvertisedAddress:       advertisedAddress,
 					Resources:               convertResources(cfgProvider.K8sAPIServerConfig().Resources()),
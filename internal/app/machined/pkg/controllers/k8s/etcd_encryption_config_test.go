@@ -124,6 +124,75 @@ resources:
 	})
 }
 
+func (suite *EtcdEncryptionConfigSuite) TestKMSPlugin() {
+	root := secrets.NewKubernetesRoot(secrets.KubernetesRootID)
+	root.TypedSpec().SecretboxEncryptionSecret = "/FYehPLp5F8POCNQRVDEUb7Hmt+KkV44e+fQL4HMexs="
+	root.TypedSpec().KMSPluginEnabled = true
+	suite.Create(root)
+
+	ctest.AssertResource(suite, k8s.EtcdEncryptionConfigID, func(res *k8s.EtcdEncryptionConfig, asrt *assert.Assertions) {
+		asrt.Equal(
+			`apiVersion: v1
+kind: EncryptionConfig
+resources:
+- providers:
+  - kms:
+      apiVersion: v2
+      endpoint: unix:///system/run/kms-plugin/kms.sock
+      name: talos
+  - secretbox:
+      keys:
+      - name: key2
+        secret: /FYehPLp5F8POCNQRVDEUb7Hmt+KkV44e+fQL4HMexs=
+  - identity: {}
+  resources:
+  - secrets
+`,
+			res.TypedSpec().Configuration,
+		)
+	})
+}
+
+func (suite *EtcdEncryptionConfigSuite) TestKMSPluginExplicitConfigWithoutSecrets() {
+	root := secrets.NewKubernetesRoot(secrets.KubernetesRootID)
+	root.TypedSpec().EtcdEncryptionConfig = map[string]any{
+		"resources": []any{
+			map[string]any{
+				"resources": []string{"configmaps"},
+				"providers": []any{
+					map[string]any{
+						"identity": map[string]any{},
+					},
+				},
+			},
+		},
+	}
+	root.TypedSpec().KMSPluginEnabled = true
+	suite.Create(root)
+
+	ctest.AssertResource(suite, k8s.EtcdEncryptionConfigID, func(res *k8s.EtcdEncryptionConfig, asrt *assert.Assertions) {
+		asrt.Equal(
+			`apiVersion: v1
+kind: EncryptionConfig
+resources:
+- providers:
+  - kms:
+      apiVersion: v2
+      endpoint: unix:///system/run/kms-plugin/kms.sock
+      name: talos
+  - identity: {}
+  resources:
+  - secrets
+- providers:
+  - identity: {}
+  resources:
+  - configmaps
+`,
+			res.TypedSpec().Configuration,
+		)
+	})
+}
+
 func (suite *EtcdEncryptionConfigSuite) TestRemoveOnSecretsDestroy() {
 	root := secrets.NewKubernetesRoot(secrets.KubernetesRootID)
 	root.TypedSpec().SecretboxEncryptionSecret = "/FYehPLp5F8POCNQRVDEUb7Hmt+KkV44e+fQL4HMexs="
@@ -6,6 +6,7 @@ package k8stemplates
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/siderolabs/go-kubernetes/kubernetes/compatibility"
@@ -21,8 +22,24 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/version"
 )
 
+// KMSPluginProviderName is the name of the Talos-managed KMS plugin provider in the encryption configuration.
+const KMSPluginProviderName = "talos"
+
 // APIServerEncryptionConfig returns the encryption configuration for the API server.
 func APIServerEncryptionConfig(rootK8sSecrets *secrets.KubernetesRootSpec) (runtime.Object, error) {
+	obj, err := apiServerEncryptionConfig(rootK8sSecrets)
+	if err != nil {
+		return nil, err
+	}
+
+	if rootK8sSecrets.KMSPluginEnabled {
+		addKMSPluginProvider(obj)
+	}
+
+	return obj, nil
+}
+
+func apiServerEncryptionConfig(rootK8sSecrets *secrets.KubernetesRootSpec) (*apiserverv1.EncryptionConfiguration, error) {
 	if cfg := rootK8sSecrets.EtcdEncryptionConfig; cfg != nil {
 		var obj apiserverv1.EncryptionConfiguration
 
@@ -85,6 +102,40 @@ func APIServerEncryptionConfig(rootK8sSecrets *secrets.KubernetesRootSpec) (runt
 	return &obj, nil
 }
 
+// addKMSPluginProvider makes the Talos-managed KMS plugin the write provider for secrets.
+//
+// The existing providers are kept to read the data which was written before the plugin was enabled.
+func addKMSPluginProvider(obj *apiserverv1.EncryptionConfiguration) {
+	kmsProvider := apiserverv1.ProviderConfiguration{
+		KMS: &apiserverv1.KMSConfiguration{
+			APIVersion: "v2",
+			Name:       KMSPluginProviderName,
+			Endpoint:   "unix://" + constants.KubernetesKMSPluginSocketPath,
+		},
+	}
+
+	for i := range obj.Resources {
+		if !slices.Contains(obj.Resources[i].Resources, "secrets") {
+			continue
+		}
+
+		obj.Resources[i].Providers = slices.Insert(obj.Resources[i].Providers, 0, kmsProvider)
+
+		return
+	}
+
+	// secrets were not encrypted before, so they are still stored in plaintext
+	obj.Resources = slices.Insert(obj.Resources, 0, apiserverv1.ResourceConfiguration{
+		Resources: []string{"secrets"},
+		Providers: []apiserverv1.ProviderConfiguration{
+			kmsProvider,
+			{
+				Identity: &apiserverv1.IdentityConfiguration{},
+			},
+		},
+	})
+}
+
 // APIServerPod builds a static pod for the kube-apiserver based on the config.
 func APIServerPod(configResource *k8s.APIServerConfig, secretsVersion, configVersion string) (runtime.Object, error) {
 	cfg := configResource.TypedSpec()
@@ -0,0 +1,333 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	pkgetcd "github.com/siderolabs/talos/internal/pkg/etcd"
+	"github.com/siderolabs/talos/internal/pkg/kmsplugin"
+	"github.com/siderolabs/talos/internal/pkg/kmsplugin/api"
+	"github.com/siderolabs/talos/internal/pkg/selinux"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// KMSPluginSyncInterval is the interval between the KMS plugin state syncs.
+const KMSPluginSyncInterval = time.Minute
+
+// KMSPluginController runs the Talos-managed KMS v2 plugin for kube-apiserver.
+type KMSPluginController struct {
+	keyring       *kmsplugin.Keyring
+	keyringConfig kmsPluginConfig
+	etcdClient    *pkgetcd.Client
+	server        *grpc.Server
+}
+
+// kmsPluginConfig is the set of settings which require the plugin restart if changed.
+type kmsPluginConfig struct {
+	nodeID           string
+	nodeUUID         string
+	kmsEndpoint      string
+	tpmPCRs          []int
+	rotationInterval time.Duration
+	keyRetention     time.Duration
+}
+
+func (cfg kmsPluginConfig) Equal(other kmsPluginConfig) bool {
+	return cfg.nodeID == other.nodeID &&
+		cfg.nodeUUID == other.nodeUUID &&
+		cfg.kmsEndpoint == other.kmsEndpoint &&
+		slices.Equal(cfg.tpmPCRs, other.tpmPCRs) &&
+		cfg.rotationInterval == other.rotationInterval &&
+		cfg.keyRetention == other.keyRetention
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *KMSPluginController) Name() string {
+	return "k8s.KMSPluginController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *KMSPluginController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.ActiveID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      v1alpha1.ServiceType,
+			ID:        optional.Some("etcd"),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: cluster.NamespaceName,
+			Type:      cluster.IdentityType,
+			ID:        optional.Some(cluster.LocalIdentity),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: hardware.NamespaceName,
+			Type:      hardware.SystemInformationType,
+			ID:        optional.Some(hardware.SystemInformationID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *KMSPluginController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: k8s.KMSPluginStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *KMSPluginController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	defer ctrl.stop(logger)
+
+	ticker := time.NewTicker(KMSPluginSyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		if cfg == nil || cfg.Config().K8sKMSConfig() == nil {
+			ctrl.stop(logger)
+
+			if err = r.Destroy(ctx, k8s.NewKMSPluginStatus().Metadata()); err != nil && !state.IsNotFoundError(err) {
+				return fmt.Errorf("error destroying KMS plugin status: %w", err)
+			}
+
+			continue
+		}
+
+		kmsCfg := cfg.Config().K8sKMSConfig()
+
+		// the plugin state is stored in etcd
+		etcdService, err := safe.ReaderGetByID[*v1alpha1.Service](ctx, r, "etcd")
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting etcd service resource: %w", err)
+		}
+
+		if etcdService == nil || etcdService.Metadata().Phase() != resource.PhaseRunning || !etcdService.TypedSpec().Healthy {
+			continue
+		}
+
+		identity, err := safe.ReaderGetByID[*cluster.Identity](ctx, r, cluster.LocalIdentity)
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting local identity: %w", err)
+		}
+
+		pluginCfg := kmsPluginConfig{
+			nodeID:           identity.TypedSpec().NodeID,
+			rotationInterval: kmsCfg.KeyRotationInterval(),
+			keyRetention:     kmsCfg.KeyRetention(),
+		}
+
+		var wrapper kmsplugin.KeyWrapper
+
+		switch {
+		case kmsCfg.TPMEnabled():
+			pluginCfg.tpmPCRs = kmsCfg.TPMPCRs()
+
+			wrapper = kmsplugin.NewTPMWrapper(pluginCfg.tpmPCRs)
+		default:
+			systemInformation, err := safe.ReaderGetByID[*hardware.SystemInformation](ctx, r, hardware.SystemInformationID)
+			if err != nil {
+				if state.IsNotFoundError(err) {
+					continue
+				}
+
+				return fmt.Errorf("error getting system information: %w", err)
+			}
+
+			pluginCfg.nodeUUID = systemInformation.TypedSpec().UUID
+			pluginCfg.kmsEndpoint = kmsCfg.KMSEndpoint()
+
+			wrapper = kmsplugin.NewKMSWrapper(pluginCfg.kmsEndpoint, pluginCfg.nodeUUID)
+		}
+
+		if ctrl.keyring != nil && !ctrl.keyringConfig.Equal(pluginCfg) {
+			logger.Info("KMS plugin configuration changed, restarting")
+
+			ctrl.stop(logger)
+		}
+
+		if ctrl.keyring == nil {
+			if err = ctrl.start(ctx, pluginCfg, wrapper, logger); err != nil {
+				return fmt.Errorf("error starting KMS plugin: %w", err)
+			}
+		}
+
+		if err = ctrl.keyring.Sync(ctx); err != nil {
+			// etcd or the key wrapper might be temporarily unavailable, so don't fail the controller
+			logger.Warn("KMS plugin sync failed", zap.Error(err))
+		}
+
+		keyringStatus := ctrl.keyring.Status()
+
+		if err = safe.WriterModify(ctx, r, k8s.NewKMSPluginStatus(), func(status *k8s.KMSPluginStatus) error {
+			spec := status.TypedSpec()
+
+			spec.Healthy = keyringStatus.Healthy && keyringStatus.Error == nil
+			spec.Provider = wrapper.Provider()
+			spec.NodeKeyID = keyringStatus.NodeKeyID
+			spec.KeyID = keyringStatus.KeyID
+			spec.KeyCreated = keyringStatus.KeyCreated
+			spec.KeyCount = keyringStatus.KeyCount
+			spec.LastSyncTime = keyringStatus.LastSync
+			spec.Error = ""
+
+			if keyringStatus.Error != nil {
+				spec.Error = keyringStatus.Error.Error()
+			}
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating KMS plugin status: %w", err)
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *KMSPluginController) start(ctx context.Context, pluginCfg kmsPluginConfig, wrapper kmsplugin.KeyWrapper, logger *zap.Logger) error {
+	etcdClient, err := pkgetcd.NewLocalClient(ctx)
+	if err != nil {
+		return fmt.Errorf("error creating etcd client: %w", err)
+	}
+
+	keyring := kmsplugin.NewKeyring(kmsplugin.KeyringConfig{
+		Store:            kmsplugin.NewEtcdStore(etcdClient.Client, constants.EtcdTalosKMSPluginStateKey),
+		Wrapper:          wrapper,
+		NodeID:           pluginCfg.nodeID,
+		RotationInterval: pluginCfg.rotationInterval,
+		KeyRetention:     pluginCfg.keyRetention,
+	})
+
+	listener, err := listenKMSPluginSocket(ctx)
+	if err != nil {
+		etcdClient.Close() //nolint:errcheck
+
+		return err
+	}
+
+	server := grpc.NewServer()
+	api.RegisterKeyManagementServiceServer(server, kmsplugin.NewServer(keyring, logger))
+
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			logger.Error("KMS plugin server failed", zap.Error(err))
+		}
+	}()
+
+	ctrl.keyring = keyring
+	ctrl.keyringConfig = pluginCfg
+	ctrl.etcdClient = etcdClient
+	ctrl.server = server
+
+	logger.Info("KMS plugin started", zap.String("provider", wrapper.Provider()), zap.String("socket", constants.KubernetesKMSPluginSocketPath))
+
+	return nil
+}
+
+func (ctrl *KMSPluginController) stop(logger *zap.Logger) {
+	if ctrl.server != nil {
+		ctrl.server.Stop()
+		ctrl.server = nil
+
+		if err := os.RemoveAll(constants.KubernetesKMSPluginSocketPath); err != nil {
+			logger.Warn("failed to remove KMS plugin socket", zap.Error(err))
+		}
+	}
+
+	if ctrl.etcdClient != nil {
+		ctrl.etcdClient.Close() //nolint:errcheck
+		ctrl.etcdClient = nil
+	}
+
+	ctrl.keyring = nil
+	ctrl.keyringConfig = kmsPluginConfig{}
+}
+
+func listenKMSPluginSocket(ctx context.Context) (net.Listener, error) {
+	socketDir := filepath.Dir(constants.KubernetesKMSPluginSocketPath)
+
+	if err := os.MkdirAll(socketDir, 0o750); err != nil {
+		return nil, err
+	}
+
+	// set the final leaf to be world-executable to make kube-apiserver connect to the socket
+	if err := os.Chmod(socketDir, 0o751); err != nil {
+		return nil, err
+	}
+
+	// clean up the socket if it already exists (important for Talos in a container)
+	if err := os.RemoveAll(constants.KubernetesKMSPluginSocketPath); err != nil {
+		return nil, err
+	}
+
+	listener, err := (&net.ListenConfig{}).Listen(ctx, "unix", constants.KubernetesKMSPluginSocketPath)
+	if err != nil {
+		return nil, fmt.Errorf("error listening on KMS plugin socket: %w", err)
+	}
+
+	if err = selinux.SetLabel(constants.KubernetesKMSPluginSocketPath, constants.KubernetesKMSPluginSocketLabel); err != nil {
+		listener.Close() //nolint:errcheck
+
+		return nil, err
+	}
+
+	// chown the socket to make it accessible to kube-apiserver
+	if err = os.Chown(constants.KubernetesKMSPluginSocketPath, constants.KubernetesAPIServerRunUser, constants.KubernetesAPIServerRunGroup); err != nil {
+		listener.Close() //nolint:errcheck
+
+		return nil, err
+	}
+
+	return listener, nil
+}
@@ -237,11 +237,18 @@ func (ctrl *NodeStatusController) Run(ctx context.Context, r controller.Runtime,
 					res.TypedSpec().Labels = node.Labels
 					res.TypedSpec().Annotations = node.Annotations
 					res.TypedSpec().NodeReady = false
+					res.TypedSpec().MemoryPressure = false
+					res.TypedSpec().DiskPressure = false
 					res.TypedSpec().PodCIDRs = podCIDRs
 
 					for _, condition := range node.Status.Conditions {
-						if condition.Type == corev1.NodeReady {
+						switch condition.Type { //nolint:exhaustive
+						case corev1.NodeReady:
 							res.TypedSpec().NodeReady = condition.Status == corev1.ConditionTrue
+						case corev1.NodeMemoryPressure:
+							res.TypedSpec().MemoryPressure = condition.Status == corev1.ConditionTrue
+						case corev1.NodeDiskPressure:
+							res.TypedSpec().DiskPressure = condition.Status == corev1.ConditionTrue
 						}
 					}
 
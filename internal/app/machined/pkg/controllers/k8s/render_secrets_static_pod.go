@@ -189,7 +189,11 @@ func (ctrl *RenderSecretsStaticPodController) Run(ctx context.Context, r control
 				gid:          constants.KubernetesAPIServerRunGroup,
 				secrets: []secret{
 					{
-						getter:       func() *x509.PEMEncodedCertificateAndKey { return rootEtcdSecrets.EtcdCA },
+						getter: func() *x509.PEMEncodedCertificateAndKey {
+							return &x509.PEMEncodedCertificateAndKey{
+								Crt: rootEtcdSecrets.AcceptedCABundle(),
+							}
+						},
 						certFilename: "etcd-client-ca.crt",
 					},
 					{
@@ -340,7 +344,8 @@ func (ctrl *RenderSecretsStaticPodController) Run(ctx context.Context, r control
 
 		if err = safe.WriterModify(ctx, r, k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID), func(r *k8s.SecretsStatus) error {
 			r.TypedSpec().Ready = true
-			r.TypedSpec().Version = secretsRes.Metadata().Version().String()
+			// etcd secrets change on etcd CA rotation, and kube-apiserver should be restarted to pick up new etcd client certs
+			r.TypedSpec().Version = secretsRes.Metadata().Version().String() + "-" + etcdRes.Metadata().Version().String()
 
 			return nil
 		}); err != nil {
@@ -18,6 +18,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/jsimonetti/rtnetlink/v2"
 	"github.com/mdlayher/arp"
+	"github.com/mdlayher/ndp"
 	"github.com/siderolabs/go-pointer"
 	"go.uber.org/zap"
 	"golang.org/x/sys/unix"
@@ -267,8 +268,14 @@ func (ctrl *AddressSpecController) syncAddress(ctx context.Context, r controller
 		logger.Info("assigned address", zap.Stringer("address", address.TypedSpec().Address), zap.String("link", address.TypedSpec().LinkName))
 
 		if address.TypedSpec().AnnounceWithARP {
-			if err := ctrl.gratuitousARP(logger, linkIndex, address.TypedSpec().Address.Addr()); err != nil {
-				logger.Warn("failure sending gratuitous ARP", zap.Stringer("address", address.TypedSpec().Address), zap.String("link", address.TypedSpec().LinkName), zap.Error(err))
+			if address.TypedSpec().Address.Addr().Is4() {
+				if err := ctrl.gratuitousARP(logger, linkIndex, address.TypedSpec().Address.Addr()); err != nil {
+					logger.Warn("failure sending gratuitous ARP", zap.Stringer("address", address.TypedSpec().Address), zap.String("link", address.TypedSpec().LinkName), zap.Error(err))
+				}
+			} else {
+				if err := ctrl.unsolicitedNeighborAdvertisement(logger, linkIndex, address.TypedSpec().Address.Addr()); err != nil {
+					logger.Warn("failure sending unsolicited neighbor advertisement", zap.Stringer("address", address.TypedSpec().Address), zap.String("link", address.TypedSpec().LinkName), zap.Error(err))
+				}
 			}
 		}
 	}
@@ -313,3 +320,46 @@ func (ctrl *AddressSpecController) gratuitousARP(logger *zap.Logger, linkIndex u
 
 	return nil
 }
+
+// unsolicitedNeighborAdvertisement is the IPv6 counterpart of the gratuitous ARP (RFC 4861, 7.2.6).
+func (ctrl *AddressSpecController) unsolicitedNeighborAdvertisement(logger *zap.Logger, linkIndex uint32, ip netip.Addr) error {
+	if !ip.Is6() {
+		return nil
+	}
+
+	iface, err := net.InterfaceByIndex(int(linkIndex))
+	if err != nil {
+		return err
+	}
+
+	if len(iface.HardwareAddr) != 6 {
+		// not ethernet
+		return nil
+	}
+
+	conn, _, err := ndp.Listen(iface, ndp.LinkLocal)
+	if err != nil {
+		return fmt.Errorf("error creating NDP connection: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	na := &ndp.NeighborAdvertisement{
+		Override:      true,
+		TargetAddress: ip,
+		Options: []ndp.Option{
+			&ndp.LinkLayerAddress{
+				Direction: ndp.Target,
+				Addr:      iface.HardwareAddr,
+			},
+		},
+	}
+
+	if err = conn.WriteTo(na, nil, allNodesMulticast); err != nil {
+		return fmt.Errorf("error sending unsolicited neighbor advertisement: %w", err)
+	}
+
+	logger.Info("sent unsolicited neighbor advertisement", zap.Stringer("address", ip), zap.String("link", iface.Name))
+
+	return nil
+}
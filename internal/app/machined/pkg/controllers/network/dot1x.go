@@ -0,0 +1,406 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"crypto/tls"
+	stdx509 "crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/siderolabs/crypto/x509"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/dot1x"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// Dot1XTransport sends and receives EAPOL frames on the link.
+type Dot1XTransport interface {
+	Send(pdu []byte) error
+	Receive() <-chan []byte
+	io.Closer
+}
+
+// Dot1XTransportFactory creates the EAPOL transport for the link.
+type Dot1XTransportFactory func(logger *zap.Logger, linkName string) (Dot1XTransport, error)
+
+// Dot1XController runs IEEE 802.1X supplicants on the links with network.Dot1XConfig.
+type Dot1XController struct {
+	// TransportFactory can be overridden for unit-testing.
+	TransportFactory Dot1XTransportFactory
+
+	supplicants map[string]*dot1xSupplicantState
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *Dot1XController) Name() string {
+	return "network.Dot1XController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *Dot1XController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.Dot1XConfigType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.LinkStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *Dot1XController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.Dot1XStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// dot1xSupplicantState describes a state of the running supplicant.
+type dot1xSupplicantState struct {
+	Spec network.Dot1XConfigSpec
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu     sync.Mutex
+	status dot1x.Status
+}
+
+func (state *dot1xSupplicantState) Start(ctx context.Context, notifyCh chan<- struct{}, logger *zap.Logger, factory Dot1XTransportFactory, linkName string) {
+	state.wg.Add(1)
+
+	ctx, state.cancel = context.WithCancel(ctx)
+
+	go func() {
+		defer state.wg.Done()
+
+		state.runWithRestarts(ctx, notifyCh, logger, factory, linkName)
+	}()
+}
+
+func (state *dot1xSupplicantState) runWithRestarts(ctx context.Context, notifyCh chan<- struct{}, logger *zap.Logger, factory Dot1XTransportFactory, linkName string) {
+	backoff := backoff.NewExponentialBackOff()
+
+	// disable number of retries limit
+	backoff.MaxElapsedTime = 0
+
+	for ctx.Err() == nil {
+		err := state.run(ctx, notifyCh, logger, factory, linkName)
+		if err == nil {
+			return
+		}
+
+		logger.Warn("802.1X supplicant failed", zap.Error(err))
+
+		state.update(notifyCh, func(status *dot1x.Status) {
+			status.Authorized = false
+			status.LastError = err
+		})
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff.NextBackOff()):
+		}
+	}
+}
+
+func (state *dot1xSupplicantState) run(ctx context.Context, notifyCh chan<- struct{}, logger *zap.Logger, factory Dot1XTransportFactory, linkName string) error {
+	config, err := dot1xSupplicantConfig(&state.Spec)
+	if err != nil {
+		return err
+	}
+
+	transport, err := factory(logger, linkName)
+	if err != nil {
+		return err
+	}
+
+	defer transport.Close() //nolint:errcheck
+
+	return dot1x.NewSupplicant(config, transport, logger).Run(ctx, func(status dot1x.Status) {
+		state.update(notifyCh, func(s *dot1x.Status) {
+			*s = status
+		})
+	})
+}
+
+func (state *dot1xSupplicantState) update(notifyCh chan<- struct{}, f func(*dot1x.Status)) {
+	state.mu.Lock()
+	f(&state.status)
+	state.mu.Unlock()
+
+	select {
+	case notifyCh <- struct{}{}:
+	default:
+	}
+}
+
+func (state *dot1xSupplicantState) Status() dot1x.Status {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	return state.status
+}
+
+func (state *dot1xSupplicantState) Stop() {
+	state.cancel()
+
+	state.wg.Wait()
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *Dot1XController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// buffered, so that supplicants don't block on status updates
+	notifyCh := make(chan struct{}, 1)
+
+	ctrl.supplicants = make(map[string]*dot1xSupplicantState)
+
+	defer func() {
+		for _, supplicant := range ctrl.supplicants {
+			supplicant.Stop()
+		}
+	}()
+
+	if ctrl.TransportFactory == nil {
+		ctrl.TransportFactory = func(logger *zap.Logger, linkName string) (Dot1XTransport, error) {
+			return dot1x.NewPacketTransport(logger, linkName)
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-notifyCh:
+		}
+
+		if err := ctrl.reconcile(ctx, r, logger, notifyCh); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+//nolint:gocyclo
+func (ctrl *Dot1XController) reconcile(ctx context.Context, r controller.Runtime, logger *zap.Logger, notifyCh chan<- struct{}) error {
+	linkStatuses, err := safe.ReaderListAll[*network.LinkStatus](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing link statuses: %w", err)
+	}
+
+	linkNameResolver := network.NewLinkResolver(linkStatuses.All)
+
+	linkUp := make(map[string]bool)
+
+	for linkStatus := range linkStatuses.All() {
+		linkUp[linkStatus.Metadata().ID()] = linkStatus.TypedSpec().LinkState
+	}
+
+	configs, err := safe.ReaderListAll[*network.Dot1XConfig](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing 802.1X configs: %w", err)
+	}
+
+	// figure out which supplicants should run, by resolved link name
+	configured := make(map[string]*network.Dot1XConfigSpec)
+	shouldRun := make(map[string]*network.Dot1XConfigSpec)
+
+	for cfg := range configs.All() {
+		linkName := linkNameResolver.Resolve(cfg.TypedSpec().LinkName)
+
+		up, exists := linkUp[linkName]
+		if !exists {
+			continue
+		}
+
+		configured[linkName] = cfg.TypedSpec()
+
+		// the supplicant is restarted on each carrier change, as the port is unauthorized by the switch
+		if up {
+			shouldRun[linkName] = cfg.TypedSpec()
+		}
+	}
+
+	for linkName, supplicant := range ctrl.supplicants {
+		if spec, exists := shouldRun[linkName]; !exists || supplicant.Spec != *spec {
+			logger.Debug("stopping 802.1X supplicant", zap.String("link", linkName))
+
+			supplicant.Stop()
+			delete(ctrl.supplicants, linkName)
+		}
+	}
+
+	for linkName, spec := range shouldRun {
+		if _, exists := ctrl.supplicants[linkName]; !exists {
+			logger.Debug("starting 802.1X supplicant", zap.String("link", linkName))
+
+			ctrl.supplicants[linkName] = &dot1xSupplicantState{
+				Spec: *spec,
+			}
+
+			ctrl.supplicants[linkName].Start(ctx, notifyCh, logger.With(zap.String("link", linkName)), ctrl.TransportFactory, linkName)
+		}
+	}
+
+	r.StartTrackingOutputs()
+
+	for linkName, spec := range configured {
+		status := dot1x.Status{
+			State: dot1x.StateDisconnected,
+		}
+
+		if supplicant, running := ctrl.supplicants[linkName]; running {
+			status = supplicant.Status()
+
+			if status.State == "" {
+				status.State = dot1x.StateConnecting
+			}
+		}
+
+		if err = safe.WriterModify(ctx, r, network.NewDot1XStatus(network.NamespaceName, linkName), func(res *network.Dot1XStatus) error {
+			*res.TypedSpec() = network.Dot1XStatusSpec{
+				LinkName:          linkName,
+				Method:            spec.Method,
+				Identity:          spec.Identity,
+				State:             string(status.State),
+				Authorized:        status.Authorized,
+				LastAuthenticated: status.LastAuthenticated,
+				Authentications:   uint32(status.Authentications),
+				Failures:          uint32(status.Failures),
+			}
+
+			if status.LastError != nil {
+				res.TypedSpec().LastError = status.LastError.Error()
+			}
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error writing 802.1X status: %w", err)
+		}
+	}
+
+	if err = safe.CleanupOutputs[*network.Dot1XStatus](ctx, r); err != nil {
+		return fmt.Errorf("error cleaning up 802.1X statuses: %w", err)
+	}
+
+	return nil
+}
+
+// dot1xClientCertificateTTL is the lifetime of the client certificate generated from the issuer.
+const dot1xClientCertificateTTL = 24 * time.Hour
+
+func dot1xSupplicantConfig(spec *network.Dot1XConfigSpec) (dot1x.Config, error) {
+	config := dot1x.Config{
+		Identity:          spec.Identity,
+		AnonymousIdentity: spec.AnonymousIdentity,
+	}
+
+	rootCAs := stdx509.NewCertPool()
+
+	if !rootCAs.AppendCertsFromPEM([]byte(spec.CA)) {
+		return config, errors.New("failed to parse CA certificate")
+	}
+
+	tlsConfig := &tls.Config{
+		RootCAs:    rootCAs,
+		ServerName: spec.ServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if spec.ServerName == "" {
+		// the server name is not known, so verify the certificate chain only
+		tlsConfig.InsecureSkipVerify = true //nolint:gosec
+		tlsConfig.VerifyConnection = func(cs tls.ConnectionState) error {
+			return verifyCertificateChain(cs.PeerCertificates, rootCAs)
+		}
+	}
+
+	switch spec.Method {
+	case network.Dot1XMethodTLS:
+		config.Method = dot1x.MethodTLS
+
+		switch {
+		case spec.ClientCertificate != "":
+			cert, err := tls.X509KeyPair([]byte(spec.ClientCertificate), []byte(spec.ClientKey))
+			if err != nil {
+				return config, fmt.Errorf("failed to load client certificate: %w", err)
+			}
+
+			tlsConfig.Certificates = []tls.Certificate{cert}
+		case spec.ClientIssuerCertificate != "":
+			ca, err := x509.NewCertificateAuthorityFromCertificateAndKey(&x509.PEMEncodedCertificateAndKey{
+				Crt: []byte(spec.ClientIssuerCertificate),
+				Key: []byte(spec.ClientIssuerKey),
+			})
+			if err != nil {
+				return config, fmt.Errorf("failed to load client issuer: %w", err)
+			}
+
+			// a fresh certificate is issued for each handshake, so it never expires
+			tlsConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+				keyPair, err := x509.NewKeyPair(ca,
+					x509.CommonName(spec.Identity),
+					x509.NotAfter(time.Now().Add(dot1xClientCertificateTTL)),
+					x509.KeyUsage(stdx509.KeyUsageDigitalSignature),
+					x509.ExtKeyUsage([]stdx509.ExtKeyUsage{stdx509.ExtKeyUsageClientAuth}),
+				)
+				if err != nil {
+					return nil, fmt.Errorf("failed to generate client certificate: %w", err)
+				}
+
+				return keyPair.Certificate, nil
+			}
+		default:
+			return config, errors.New("client certificate is not configured")
+		}
+	case network.Dot1XMethodPEAP:
+		config.Method = dot1x.MethodPEAP
+		config.Password = spec.Password
+	default:
+		return config, fmt.Errorf("unsupported 802.1X method %q", spec.Method)
+	}
+
+	config.TLSConfig = tlsConfig
+
+	return config, nil
+}
+
+func verifyCertificateChain(certs []*stdx509.Certificate, rootCAs *stdx509.CertPool) error {
+	if len(certs) == 0 {
+		return errors.New("no server certificate")
+	}
+
+	opts := stdx509.VerifyOptions{
+		Roots:         rootCAs,
+		Intermediates: stdx509.NewCertPool(),
+	}
+
+	for _, cert := range certs[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+
+	_, err := certs[0].Verify(opts)
+
+	return err
+}
@@ -0,0 +1,117 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	configtypes "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// Dot1XConfigController manages network.Dot1XConfig based on machine configuration.
+type Dot1XConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *Dot1XConfigController) Name() string {
+	return "network.Dot1XConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *Dot1XConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.ActiveID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *Dot1XConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.Dot1XConfigType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *Dot1XConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		r.StartTrackingOutputs()
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error reading machine configuration: %w", err)
+		}
+
+		if cfg != nil {
+			if err = ctrl.apply(ctx, r, cfg.Config().NetworkDot1XConfigs()); err != nil {
+				return fmt.Errorf("error applying Dot1XConfig: %w", err)
+			}
+		}
+
+		if err = safe.CleanupOutputs[*network.Dot1XConfig](ctx, r); err != nil {
+			return fmt.Errorf("error cleaning up Dot1XConfig: %w", err)
+		}
+	}
+}
+
+func (ctrl *Dot1XConfigController) apply(ctx context.Context, r controller.Runtime, configs []configtypes.NetworkDot1XConfig) error {
+	for _, cfg := range configs {
+		if err := safe.WriterModify(ctx, r, network.NewDot1XConfig(network.NamespaceName, cfg.Name()), func(res *network.Dot1XConfig) error {
+			*res.TypedSpec() = network.Dot1XConfigSpec{
+				LinkName:          cfg.Name(),
+				Identity:          cfg.Identity(),
+				AnonymousIdentity: cfg.AnonymousIdentity(),
+				CA:                string(cfg.CA()),
+				ServerName:        cfg.ServerName(),
+			}
+
+			if tlsConfig, ok := cfg.TLS().Get(); ok {
+				res.TypedSpec().Method = network.Dot1XMethodTLS
+
+				if identity := tlsConfig.ClientIdentity(); identity != nil {
+					res.TypedSpec().ClientCertificate = string(identity.Crt)
+					res.TypedSpec().ClientKey = string(identity.Key)
+				}
+
+				if issuer := tlsConfig.ClientIssuer(); issuer != nil {
+					res.TypedSpec().ClientIssuerCertificate = string(issuer.Crt)
+					res.TypedSpec().ClientIssuerKey = string(issuer.Key)
+				}
+			}
+
+			if peapConfig, ok := cfg.PEAP().Get(); ok {
+				res.TypedSpec().Method = network.Dot1XMethodPEAP
+				res.TypedSpec().Password = peapConfig.Password()
+			}
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error writing Dot1XConfig: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	networkcfg "github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type Dot1XConfigSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *Dot1XConfigSuite) TestReconcile() {
+	cfg1 := networkcfg.NewDot1XConfigV1Alpha1("enp0s1")
+	cfg1.Dot1XIdentity = "node1"
+	cfg1.Dot1XCA = "CA"
+	cfg1.Dot1XTLS = &networkcfg.Dot1XTLSConfig{
+		TLSClientIssuer: &meta.CertificateAndKey{
+			Cert: "cert",
+			Key:  "key",
+		},
+	}
+
+	cfg2 := networkcfg.NewDot1XConfigV1Alpha1("enp0s2")
+	cfg2.Dot1XIdentity = `EXAMPLE\node1`
+	cfg2.Dot1XAnonymousIdentity = "anonymous"
+	cfg2.Dot1XCA = "CA"
+	cfg2.Dot1XServerName = "radius.example.com"
+	cfg2.Dot1XPEAP = &networkcfg.Dot1XPEAPConfig{
+		PEAPPassword: "secret",
+	}
+
+	ctr, err := container.New(cfg1, cfg2)
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(ctr)
+	suite.Create(cfg)
+
+	ctest.AssertResource(suite, "enp0s1", func(res *network.Dot1XConfig, asrt *assert.Assertions) {
+		asrt.Equal(network.Dot1XConfigSpec{
+			LinkName:                "enp0s1",
+			Method:                  network.Dot1XMethodTLS,
+			Identity:                "node1",
+			CA:                      "CA",
+			ClientIssuerCertificate: "cert",
+			ClientIssuerKey:         "key",
+		}, *res.TypedSpec())
+	})
+	ctest.AssertResource(suite, "enp0s2", func(res *network.Dot1XConfig, asrt *assert.Assertions) {
+		asrt.Equal(network.Dot1XConfigSpec{
+			LinkName:          "enp0s2",
+			Method:            network.Dot1XMethodPEAP,
+			Identity:          `EXAMPLE\node1`,
+			AnonymousIdentity: "anonymous",
+			CA:                "CA",
+			ServerName:        "radius.example.com",
+			Password:          "secret",
+		}, *res.TypedSpec())
+	})
+
+	suite.Destroy(cfg)
+
+	ctest.AssertNoResource[*network.Dot1XConfig](suite, "enp0s1")
+	ctest.AssertNoResource[*network.Dot1XConfig](suite, "enp0s2")
+}
+
+func TestDot1XConfigSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &Dot1XConfigSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.Dot1XConfigController{}))
+			},
+		},
+	})
+}
@@ -0,0 +1,135 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/siderolabs/crypto/x509"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/dot1x"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type Dot1XSuite struct {
+	ctest.DefaultSuite
+}
+
+// rejectingAuthenticator requests the identity and rejects the authentication.
+type rejectingAuthenticator struct {
+	receiveCh chan []byte
+}
+
+func (auth *rejectingAuthenticator) Send(pdu []byte) error {
+	frame, err := dot1x.ParseEAPOLFrame(pdu)
+	if err != nil {
+		return err
+	}
+
+	var reply *dot1x.EAPPacket
+
+	switch frame.Type {
+	case dot1x.EAPOLTypeStart:
+		reply = &dot1x.EAPPacket{
+			Code:       dot1x.EAPCodeRequest,
+			Identifier: 1,
+			Type:       dot1x.EAPTypeIdentity,
+		}
+	case dot1x.EAPOLTypeEAP:
+		packet, err := dot1x.ParseEAPPacket(frame.Body)
+		if err != nil {
+			return err
+		}
+
+		reply = &dot1x.EAPPacket{
+			Code:       dot1x.EAPCodeFailure,
+			Identifier: packet.Identifier,
+		}
+	default:
+		return nil
+	}
+
+	auth.receiveCh <- (&dot1x.EAPOLFrame{Version: 2, Type: dot1x.EAPOLTypeEAP, Body: reply.Marshal()}).Marshal()
+
+	return nil
+}
+
+func (auth *rejectingAuthenticator) Receive() <-chan []byte {
+	return auth.receiveCh
+}
+
+func (auth *rejectingAuthenticator) Close() error {
+	return nil
+}
+
+func (suite *Dot1XSuite) TestReconcile() {
+	ca, err := x509.NewSelfSignedCertificateAuthority()
+	suite.Require().NoError(err)
+
+	cfg := network.NewDot1XConfig(network.NamespaceName, "eth0")
+	*cfg.TypedSpec() = network.Dot1XConfigSpec{
+		LinkName: "eth0",
+		Method:   network.Dot1XMethodPEAP,
+		Identity: "node1",
+		CA:       string(ca.CrtPEM),
+		Password: "secret",
+	}
+
+	suite.Create(cfg)
+
+	// no link, no status
+	ctest.AssertNoResource[*network.Dot1XStatus](suite, "eth0")
+
+	linkStatus := network.NewLinkStatus(network.NamespaceName, "eth0")
+	suite.Create(linkStatus)
+
+	ctest.AssertResource(suite, "eth0", func(res *network.Dot1XStatus, asrt *assert.Assertions) {
+		asrt.Equal(network.Dot1XStatusSpec{
+			LinkName: "eth0",
+			Method:   network.Dot1XMethodPEAP,
+			Identity: "node1",
+			State:    string(dot1x.StateDisconnected),
+		}, *res.TypedSpec())
+	})
+
+	linkStatus.TypedSpec().LinkState = true
+	suite.Update(linkStatus)
+
+	ctest.AssertResource(suite, "eth0", func(res *network.Dot1XStatus, asrt *assert.Assertions) {
+		asrt.Equal(string(dot1x.StateHeld), res.TypedSpec().State)
+		asrt.False(res.TypedSpec().Authorized)
+		asrt.EqualValues(1, res.TypedSpec().Failures)
+		asrt.Equal("authentication rejected by the authenticator", res.TypedSpec().LastError)
+	})
+
+	suite.Destroy(cfg)
+
+	ctest.AssertNoResource[*network.Dot1XStatus](suite, "eth0")
+}
+
+func TestDot1XSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &Dot1XSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.Dot1XController{
+					TransportFactory: func(*zap.Logger, string) (netctrl.Dot1XTransport, error) {
+						return &rejectingAuthenticator{
+							receiveCh: make(chan []byte, 4),
+						}, nil
+					},
+				}))
+			},
+		},
+	})
+}
@@ -0,0 +1,141 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dot1x
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+)
+
+// EtherType is the EtherType of EAPOL frames.
+const EtherType = 0x888e
+
+// PAEGroupAddress is the destination address of EAPOL frames sent by the supplicant (IEEE 802.1X-2010, 11.1.1).
+var PAEGroupAddress = net.HardwareAddr{0x01, 0x80, 0xc2, 0x00, 0x00, 0x03}
+
+// EAPOL protocol version and packet types.
+const (
+	eapolVersion = 2
+
+	EAPOLTypeEAP    = 0
+	EAPOLTypeStart  = 1
+	EAPOLTypeLogoff = 2
+)
+
+// EAP codes (RFC 3748).
+const (
+	EAPCodeRequest  = 1
+	EAPCodeResponse = 2
+	EAPCodeSuccess  = 3
+	EAPCodeFailure  = 4
+)
+
+// EAP method types.
+const (
+	EAPTypeIdentity     = 1
+	EAPTypeNotification = 2
+	EAPTypeNak          = 3
+	EAPTypeTLS          = 13
+	EAPTypePEAP         = 25
+	EAPTypeMSCHAPv2     = 26
+	EAPTypeTLV          = 33
+)
+
+// EAPOLFrame is the EAPOL PDU (without the Ethernet header).
+type EAPOLFrame struct {
+	Version uint8
+	Type    uint8
+	Body    []byte
+}
+
+// Marshal encodes the frame.
+func (frame *EAPOLFrame) Marshal() []byte {
+	b := make([]byte, 4, 4+len(frame.Body))
+
+	b[0] = frame.Version
+	b[1] = frame.Type
+	binary.BigEndian.PutUint16(b[2:4], uint16(len(frame.Body)))
+
+	return append(b, frame.Body...)
+}
+
+// ParseEAPOLFrame decodes the EAPOL frame.
+//
+// Trailing bytes (Ethernet padding) are ignored.
+func ParseEAPOLFrame(b []byte) (*EAPOLFrame, error) {
+	if len(b) < 4 {
+		return nil, fmt.Errorf("EAPOL frame too short: %d bytes", len(b))
+	}
+
+	length := int(binary.BigEndian.Uint16(b[2:4]))
+	if len(b) < 4+length {
+		return nil, fmt.Errorf("EAPOL frame truncated: %d bytes, expected %d", len(b), 4+length)
+	}
+
+	return &EAPOLFrame{
+		Version: b[0],
+		Type:    b[1],
+		Body:    b[4 : 4+length],
+	}, nil
+}
+
+// EAPPacket is the EAP packet.
+//
+// Type and Data are only present in requests and responses.
+type EAPPacket struct {
+	Code       uint8
+	Identifier uint8
+	Type       uint8
+	Data       []byte
+}
+
+// Marshal encodes the packet.
+func (packet *EAPPacket) Marshal() []byte {
+	if packet.Code != EAPCodeRequest && packet.Code != EAPCodeResponse {
+		return []byte{packet.Code, packet.Identifier, 0, 4}
+	}
+
+	b := make([]byte, 5, 5+len(packet.Data))
+
+	b[0] = packet.Code
+	b[1] = packet.Identifier
+	binary.BigEndian.PutUint16(b[2:4], uint16(5+len(packet.Data)))
+	b[4] = packet.Type
+
+	return append(b, packet.Data...)
+}
+
+// ParseEAPPacket decodes the EAP packet.
+func ParseEAPPacket(b []byte) (*EAPPacket, error) {
+	if len(b) < 4 {
+		return nil, fmt.Errorf("EAP packet too short: %d bytes", len(b))
+	}
+
+	length := int(binary.BigEndian.Uint16(b[2:4]))
+	if length < 4 || len(b) < length {
+		return nil, fmt.Errorf("invalid EAP packet length %d (%d bytes)", length, len(b))
+	}
+
+	packet := &EAPPacket{
+		Code:       b[0],
+		Identifier: b[1],
+	}
+
+	switch packet.Code {
+	case EAPCodeRequest, EAPCodeResponse:
+		if length < 5 {
+			return nil, fmt.Errorf("EAP packet too short for the type: %d bytes", length)
+		}
+
+		packet.Type = b[4]
+		packet.Data = b[5:length]
+	case EAPCodeSuccess, EAPCodeFailure:
+	default:
+		return nil, fmt.Errorf("unknown EAP code %d", packet.Code)
+	}
+
+	return packet, nil
+}
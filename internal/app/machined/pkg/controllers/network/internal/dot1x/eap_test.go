@@ -0,0 +1,84 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dot1x_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/dot1x"
+)
+
+func TestEAPOLFrame(t *testing.T) {
+	t.Parallel()
+
+	frame := &dot1x.EAPOLFrame{
+		Version: 2,
+		Type:    dot1x.EAPOLTypeEAP,
+		Body:    []byte{1, 2, 3},
+	}
+
+	b := frame.Marshal()
+	assert.Equal(t, []byte{2, 0, 0, 3, 1, 2, 3}, b)
+
+	// Ethernet padding is ignored
+	parsed, err := dot1x.ParseEAPOLFrame(append(b, 0, 0, 0))
+	require.NoError(t, err)
+	assert.Equal(t, frame, parsed)
+
+	_, err = dot1x.ParseEAPOLFrame([]byte{2, 0, 0, 5, 1})
+	assert.Error(t, err)
+}
+
+func TestEAPPacket(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name    string
+		packet  *dot1x.EAPPacket
+		encoded []byte
+	}{
+		{
+			name: "identity",
+			packet: &dot1x.EAPPacket{
+				Code:       dot1x.EAPCodeResponse,
+				Identifier: 7,
+				Type:       dot1x.EAPTypeIdentity,
+				Data:       []byte("talos"),
+			},
+			encoded: []byte{2, 7, 0, 10, 1, 't', 'a', 'l', 'o', 's'},
+		},
+		{
+			name: "success",
+			packet: &dot1x.EAPPacket{
+				Code:       dot1x.EAPCodeSuccess,
+				Identifier: 8,
+			},
+			encoded: []byte{3, 8, 0, 4},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.encoded, test.packet.Marshal())
+
+			parsed, err := dot1x.ParseEAPPacket(test.encoded)
+			require.NoError(t, err)
+			assert.Equal(t, test.packet, parsed)
+		})
+	}
+
+	for _, invalid := range [][]byte{
+		{1, 1, 0},
+		{1, 1, 0, 4},
+		{1, 1, 0, 9, 1},
+		{9, 1, 0, 4},
+	} {
+		_, err := dot1x.ParseEAPPacket(invalid)
+		assert.Error(t, err)
+	}
+}
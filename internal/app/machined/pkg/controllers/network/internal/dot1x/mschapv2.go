@@ -0,0 +1,194 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dot1x
+
+import (
+	"crypto/des" //nolint:gosec // DES is required by MS-CHAPv2
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // SHA-1 is required by MS-CHAPv2
+	"crypto/subtle"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+
+	"golang.org/x/crypto/md4" //nolint:staticcheck // MD4 is required by MS-CHAPv2
+)
+
+// MS-CHAPv2 opcodes (draft-kamath-pppext-eap-mschapv2).
+const (
+	mschapv2OpChallenge = 1
+	mschapv2OpResponse  = 2
+	mschapv2OpSuccess   = 3
+	mschapv2OpFailure   = 4
+
+	mschapv2ChallengeLength = 16
+	mschapv2ResponseLength  = 49
+)
+
+var (
+	mschapv2Magic1 = []byte("Magic server to client signing constant")
+	mschapv2Magic2 = []byte("Pad to make it do more than one iteration")
+)
+
+// mschapv2 implements the client side of EAP-MSCHAPv2.
+type mschapv2 struct {
+	identity string
+	password string
+
+	peerChallenge []byte
+	authChallenge []byte
+	ntResponse    []byte
+
+	done bool
+}
+
+// process the MS-CHAPv2 request data (following the EAP type) and return the response data.
+func (m *mschapv2) process(data []byte) ([]byte, error) {
+	if len(data) < 4 {
+		return nil, fmt.Errorf("MS-CHAPv2 packet too short: %d bytes", len(data))
+	}
+
+	opCode, id := data[0], data[1]
+
+	switch opCode {
+	case mschapv2OpChallenge:
+		if len(data) < 5+mschapv2ChallengeLength || data[4] != mschapv2ChallengeLength {
+			return nil, errors.New("invalid MS-CHAPv2 challenge")
+		}
+
+		m.authChallenge = append([]byte(nil), data[5:5+mschapv2ChallengeLength]...)
+		m.peerChallenge = make([]byte, mschapv2ChallengeLength)
+
+		if _, err := rand.Read(m.peerChallenge); err != nil {
+			return nil, err
+		}
+
+		m.ntResponse = mschapv2NTResponse(m.authChallenge, m.peerChallenge, mschapv2Username(m.identity), m.password)
+
+		resp := make([]byte, 5, 5+mschapv2ResponseLength+len(m.identity))
+
+		resp[0] = mschapv2OpResponse
+		resp[1] = id
+		binary.BigEndian.PutUint16(resp[2:4], uint16(5+mschapv2ResponseLength+len(m.identity)))
+		resp[4] = mschapv2ResponseLength
+
+		resp = append(resp, m.peerChallenge...)
+		resp = append(resp, make([]byte, 8)...) // reserved
+		resp = append(resp, m.ntResponse...)
+		resp = append(resp, 0) // flags
+		resp = append(resp, m.identity...)
+
+		return resp, nil
+	case mschapv2OpSuccess:
+		if m.ntResponse == nil {
+			return nil, errors.New("unexpected MS-CHAPv2 success")
+		}
+
+		expected := mschapv2AuthenticatorResponse(m.password, m.ntResponse, m.peerChallenge, m.authChallenge, mschapv2Username(m.identity))
+		message := string(data[4:])
+
+		// the message is "S=<auth_string> M=<message>"
+		if len(message) < len(expected) || subtle.ConstantTimeCompare([]byte(message[:len(expected)]), []byte(expected)) != 1 {
+			return nil, errors.New("MS-CHAPv2 server authentication failed")
+		}
+
+		m.done = true
+
+		return []byte{mschapv2OpSuccess}, nil
+	case mschapv2OpFailure:
+		return []byte{mschapv2OpFailure}, fmt.Errorf("MS-CHAPv2 authentication failed: %s", strings.TrimSpace(string(data[4:])))
+	default:
+		return nil, fmt.Errorf("unexpected MS-CHAPv2 opcode %d", opCode)
+	}
+}
+
+// mschapv2Username strips the domain from the identity.
+func mschapv2Username(identity string) string {
+	if idx := strings.LastIndexByte(identity, '\\'); idx >= 0 {
+		return identity[idx+1:]
+	}
+
+	return identity
+}
+
+func ntPasswordHash(password string) []byte {
+	h := md4.New()
+
+	for _, c := range utf16.Encode([]rune(password)) {
+		h.Write([]byte{byte(c), byte(c >> 8)}) //nolint:errcheck
+	}
+
+	return h.Sum(nil)
+}
+
+func mschapv2ChallengeHash(peerChallenge, authChallenge []byte, username string) []byte {
+	h := sha1.New() //nolint:gosec
+
+	h.Write(peerChallenge)    //nolint:errcheck
+	h.Write(authChallenge)    //nolint:errcheck
+	h.Write([]byte(username)) //nolint:errcheck
+
+	return h.Sum(nil)[:8]
+}
+
+// mschapv2NTResponse calculates the NT-Response (RFC 2759, 8.1).
+func mschapv2NTResponse(authChallenge, peerChallenge []byte, username, password string) []byte {
+	challenge := mschapv2ChallengeHash(peerChallenge, authChallenge, username)
+
+	zPasswordHash := make([]byte, 21)
+	copy(zPasswordHash, ntPasswordHash(password))
+
+	response := make([]byte, 24)
+
+	for i := range 3 {
+		block, err := des.NewCipher(desKey(zPasswordHash[i*7 : i*7+7])) //nolint:gosec
+		if err != nil {
+			panic(err) // the key is always 8 bytes
+		}
+
+		block.Encrypt(response[i*8:], challenge)
+	}
+
+	return response
+}
+
+// mschapv2AuthenticatorResponse calculates the expected authenticator response (RFC 2759, 8.7).
+func mschapv2AuthenticatorResponse(password string, ntResponse, peerChallenge, authChallenge []byte, username string) string {
+	passwordHashHash := md4.New()
+	passwordHashHash.Write(ntPasswordHash(password)) //nolint:errcheck
+
+	h := sha1.New() //nolint:gosec
+
+	h.Write(passwordHashHash.Sum(nil)) //nolint:errcheck
+	h.Write(ntResponse)                //nolint:errcheck
+	h.Write(mschapv2Magic1)            //nolint:errcheck
+
+	digest := h.Sum(nil)
+
+	h = sha1.New() //nolint:gosec
+
+	h.Write(digest)                                                        //nolint:errcheck
+	h.Write(mschapv2ChallengeHash(peerChallenge, authChallenge, username)) //nolint:errcheck
+	h.Write(mschapv2Magic2)                                                //nolint:errcheck
+
+	return "S=" + strings.ToUpper(hex.EncodeToString(h.Sum(nil)))
+}
+
+// desKey expands the 7-byte key into the 8-byte DES key.
+func desKey(key []byte) []byte {
+	return []byte{
+		key[0],
+		key[0]<<7 | key[1]>>1,
+		key[1]<<6 | key[2]>>2,
+		key[2]<<5 | key[3]>>3,
+		key[3]<<4 | key[4]>>4,
+		key[4]<<3 | key[5]>>5,
+		key[5]<<2 | key[6]>>6,
+		key[6] << 1,
+	}
+}
@@ -0,0 +1,43 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dot1x
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Test vectors from RFC 2759, section 9.2.
+func TestMSCHAPv2Vectors(t *testing.T) {
+	t.Parallel()
+
+	const (
+		username = "User"
+		password = "clientPass"
+	)
+
+	authChallenge, _ := hex.DecodeString("5B5D7C7D7B3F2F3E3C2C602132262628") //nolint:errcheck
+	peerChallenge, _ := hex.DecodeString("21402324255E262A28295F2B3A337C7E") //nolint:errcheck
+
+	assert.Equal(t, "44ebba8d5312b8d611474411f56989ae", hex.EncodeToString(ntPasswordHash(password)), "password hash")
+	assert.Equal(t, "d02e4386bce91226", hex.EncodeToString(mschapv2ChallengeHash(peerChallenge, authChallenge, username)), "challenge")
+
+	ntResponse := mschapv2NTResponse(authChallenge, peerChallenge, username, password)
+	assert.Equal(t, "82309ecd8d708b5ea08faa3981cd83544233114a3d85d6df", hex.EncodeToString(ntResponse), "NT response")
+
+	assert.Equal(t,
+		"S=407A5589115FD0D6209F510FE9C04566932CDA56",
+		mschapv2AuthenticatorResponse(password, ntResponse, peerChallenge, authChallenge, username),
+	)
+}
+
+func TestMSCHAPv2Username(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "node", mschapv2Username(`EXAMPLE\node`))
+	assert.Equal(t, "node@example.com", mschapv2Username("node@example.com"))
+}
@@ -0,0 +1,172 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dot1x
+
+import (
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// EAP-TLV types (draft-josefsson-pppext-eap-tls-eap).
+const (
+	tlvMandatory  = 0x8000
+	tlvTypeResult = 3
+
+	tlvResultSuccess = 1
+	tlvResultFailure = 2
+)
+
+// peapTunnel implements the inner authentication of PEAPv0 with EAP-MSCHAPv2.
+//
+// Crypto binding is not supported, so the authenticator should not require it.
+type peapTunnel struct {
+	identity string
+	mschap   *mschapv2
+
+	success bool
+}
+
+func newPEAPTunnel(identity, password string) *peapTunnel {
+	return &peapTunnel{
+		identity: identity,
+		mschap: &mschapv2{
+			identity: identity,
+			password: password,
+		},
+	}
+}
+
+func (p *peapTunnel) run(conn *tls.Conn) error {
+	buf := make([]byte, 16384)
+
+	for {
+		n, err := conn.Read(buf)
+		if err != nil {
+			return err
+		}
+
+		resp, err := p.process(buf[:n])
+
+		if resp != nil {
+			if _, werr := conn.Write(resp); werr != nil {
+				return werr
+			}
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// process the inner EAP packet and return the response.
+//
+// PEAPv0 omits the EAP header of the inner packets, except for the EAP-TLV packets.
+func (p *peapTunnel) process(data []byte) ([]byte, error) {
+	var (
+		packet     *EAPPacket
+		compressed bool
+		err        error
+	)
+
+	if len(data) >= 5 && data[0] == EAPCodeRequest && int(binary.BigEndian.Uint16(data[2:4])) == len(data) && data[4] == EAPTypeTLV {
+		if packet, err = ParseEAPPacket(data); err != nil {
+			return nil, err
+		}
+	} else {
+		if len(data) < 1 {
+			return nil, errors.New("empty PEAP inner packet")
+		}
+
+		packet = &EAPPacket{Code: EAPCodeRequest, Type: data[0], Data: data[1:]}
+		compressed = true
+	}
+
+	resp := &EAPPacket{
+		Code:       EAPCodeResponse,
+		Identifier: packet.Identifier,
+		Type:       packet.Type,
+	}
+
+	switch packet.Type {
+	case EAPTypeIdentity:
+		resp.Data = []byte(p.identity)
+	case EAPTypeMSCHAPv2:
+		resp.Data, err = p.mschap.process(packet.Data)
+	case EAPTypeTLV:
+		resp.Data, err = p.processTLV(packet.Data)
+	default:
+		resp.Type = EAPTypeNak
+		resp.Data = []byte{EAPTypeMSCHAPv2}
+	}
+
+	if resp.Data == nil && err != nil {
+		return nil, err
+	}
+
+	b := resp.Marshal()
+
+	if compressed {
+		b = b[4:]
+	}
+
+	return b, err
+}
+
+func (p *peapTunnel) processTLV(data []byte) ([]byte, error) {
+	var (
+		result uint16
+		found  bool
+	)
+
+	for len(data) > 0 {
+		if len(data) < 4 {
+			return nil, errors.New("truncated EAP-TLV")
+		}
+
+		typ := binary.BigEndian.Uint16(data[0:2])
+		length := int(binary.BigEndian.Uint16(data[2:4]))
+
+		if len(data) < 4+length {
+			return nil, errors.New("truncated EAP-TLV")
+		}
+
+		value := data[4 : 4+length]
+		data = data[4+length:]
+
+		// other TLVs (e.g. crypto binding) are ignored
+		if typ&^tlvMandatory == tlvTypeResult {
+			if length != 2 {
+				return nil, errors.New("invalid EAP-TLV result")
+			}
+
+			result, found = binary.BigEndian.Uint16(value), true
+		}
+	}
+
+	if !found {
+		return nil, errors.New("EAP-TLV without the result")
+	}
+
+	if result == tlvResultSuccess && p.mschap.done {
+		p.success = true
+
+		return resultTLV(tlvResultSuccess), nil
+	}
+
+	return resultTLV(tlvResultFailure), fmt.Errorf("PEAP authentication failed: result %d", result)
+}
+
+func resultTLV(result uint16) []byte {
+	b := make([]byte, 6)
+
+	binary.BigEndian.PutUint16(b[0:2], tlvMandatory|tlvTypeResult)
+	binary.BigEndian.PutUint16(b[2:4], 2)
+	binary.BigEndian.PutUint16(b[4:6], result)
+
+	return b
+}
@@ -0,0 +1,402 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package dot1x implements IEEE 802.1X supplicant for wired links.
+package dot1x
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Method is the EAP authentication method.
+type Method int
+
+// Supported EAP methods.
+const (
+	MethodTLS Method = iota
+	MethodPEAP
+)
+
+func (method Method) eapType() uint8 {
+	if method == MethodPEAP {
+		return EAPTypePEAP
+	}
+
+	return EAPTypeTLS
+}
+
+// State is the supplicant state.
+type State string
+
+// Supplicant states.
+const (
+	// StateDisconnected: the link is down, the supplicant is not running.
+	StateDisconnected State = "disconnected"
+	// StateConnecting: EAPOL-Start is sent, waiting for the authenticator.
+	StateConnecting State = "connecting"
+	// StateAuthenticating: EAP exchange is in progress.
+	StateAuthenticating State = "authenticating"
+	// StateAuthenticated: the port is authorized.
+	StateAuthenticated State = "authenticated"
+	// StateHeld: the authentication failed, waiting before the next attempt.
+	StateHeld State = "held"
+	// StateOpen: no authenticator responded, the port is assumed to be not controlled.
+	StateOpen State = "open"
+)
+
+// Default timers (IEEE 802.1X-2010, 8.8).
+const (
+	DefaultStartPeriod = 30 * time.Second
+	DefaultHeldPeriod  = 60 * time.Second
+	DefaultAuthPeriod  = 30 * time.Second
+	DefaultMaxStart    = 3
+)
+
+// Config is the supplicant configuration.
+type Config struct {
+	// Identity is the identity used for the authentication.
+	Identity string
+	// AnonymousIdentity is sent in the outer EAP-Response/Identity if set.
+	AnonymousIdentity string
+
+	Method Method
+
+	// TLSConfig is used for EAP-TLS and the PEAP tunnel.
+	TLSConfig *tls.Config
+	// Password is used for PEAP/MSCHAPv2.
+	Password string
+
+	StartPeriod time.Duration
+	HeldPeriod  time.Duration
+	AuthPeriod  time.Duration
+	MaxStart    int
+}
+
+// Transport sends and receives EAPOL PDUs.
+type Transport interface {
+	Send(pdu []byte) error
+	Receive() <-chan []byte
+}
+
+// Status is the supplicant status.
+type Status struct {
+	State State
+	// Authorized is true if the traffic can be sent over the port, it stays set during the re-authentication.
+	Authorized bool
+
+	LastAuthenticated time.Time
+	Authentications   int
+	Failures          int
+	LastError         error
+}
+
+// Supplicant runs the IEEE 802.1X supplicant state machine on the link.
+type Supplicant struct {
+	config    Config
+	transport Transport
+	logger    *zap.Logger
+
+	status Status
+
+	method      *tlsMethod
+	methodErr   error
+	lastRequest *EAPPacket
+	lastReply   []byte
+	starts      int
+}
+
+// NewSupplicant initializes a new supplicant.
+func NewSupplicant(config Config, transport Transport, logger *zap.Logger) *Supplicant {
+	if config.StartPeriod == 0 {
+		config.StartPeriod = DefaultStartPeriod
+	}
+
+	if config.HeldPeriod == 0 {
+		config.HeldPeriod = DefaultHeldPeriod
+	}
+
+	if config.AuthPeriod == 0 {
+		config.AuthPeriod = DefaultAuthPeriod
+	}
+
+	if config.MaxStart == 0 {
+		config.MaxStart = DefaultMaxStart
+	}
+
+	return &Supplicant{
+		config:    config,
+		transport: transport,
+		logger:    logger,
+	}
+}
+
+// Run the supplicant until the context is canceled.
+//
+// The notify callback is called each time the status changes.
+func (s *Supplicant) Run(ctx context.Context, notify func(Status)) error {
+	defer s.resetMethod()
+
+	s.status.State = StateConnecting
+	notify(s.status)
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if s.status.State == StateAuthenticated || s.status.State == StateAuthenticating {
+				if err := s.send(EAPOLTypeLogoff, nil); err != nil {
+					s.logger.Debug("failed to send EAPOL-Logoff", zap.Error(err))
+				}
+			}
+
+			return nil
+		case <-timer.C:
+			changed, err := s.timeout(timer)
+			if err != nil {
+				return err
+			}
+
+			if changed {
+				notify(s.status)
+			}
+		case pdu := <-s.transport.Receive():
+			changed, err := s.handleFrame(pdu, timer)
+			if err != nil {
+				return err
+			}
+
+			if changed {
+				notify(s.status)
+			}
+		}
+	}
+}
+
+func (s *Supplicant) timeout(timer *time.Timer) (bool, error) {
+	switch s.status.State {
+	case StateConnecting:
+		if s.starts >= s.config.MaxStart {
+			s.logger.Info("no 802.1X authenticator responded, assuming the port is not controlled")
+
+			s.status.State = StateOpen
+			s.status.Authorized = true
+
+			return true, nil
+		}
+
+		s.starts++
+
+		if err := s.send(EAPOLTypeStart, nil); err != nil {
+			return false, fmt.Errorf("error sending EAPOL-Start: %w", err)
+		}
+
+		timer.Reset(s.config.StartPeriod)
+	case StateAuthenticating:
+		s.fail(errors.New("authentication timed out"), timer)
+
+		return true, nil
+	case StateHeld:
+		s.status.State = StateConnecting
+		s.starts = 0
+
+		timer.Reset(0)
+
+		return true, nil
+	case StateAuthenticated, StateOpen, StateDisconnected:
+	}
+
+	return false, nil
+}
+
+func (s *Supplicant) handleFrame(pdu []byte, timer *time.Timer) (bool, error) {
+	frame, err := ParseEAPOLFrame(pdu)
+	if err != nil {
+		s.logger.Debug("ignoring malformed EAPOL frame", zap.Error(err))
+
+		return false, nil
+	}
+
+	if frame.Type != EAPOLTypeEAP {
+		return false, nil
+	}
+
+	packet, err := ParseEAPPacket(frame.Body)
+	if err != nil {
+		s.logger.Debug("ignoring malformed EAP packet", zap.Error(err))
+
+		return false, nil
+	}
+
+	switch packet.Code {
+	case EAPCodeRequest:
+		return s.handleRequest(packet, timer)
+	case EAPCodeSuccess:
+		if s.status.State != StateAuthenticating || s.method == nil || !s.method.succeeded() {
+			s.logger.Debug("ignoring unexpected EAP-Success", zap.String("state", string(s.status.State)))
+
+			return false, nil
+		}
+
+		s.resetMethod()
+
+		s.status.State = StateAuthenticated
+		s.status.Authorized = true
+		s.status.LastAuthenticated = time.Now()
+		s.status.Authentications++
+		s.status.LastError = nil
+
+		s.logger.Info("802.1X authentication succeeded")
+
+		timer.Stop()
+
+		return true, nil
+	case EAPCodeFailure:
+		if s.status.State == StateHeld || s.status.State == StateConnecting {
+			return false, nil
+		}
+
+		err = s.methodErr
+		if err == nil {
+			err = errors.New("authentication rejected by the authenticator")
+		}
+
+		s.fail(err, timer)
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (s *Supplicant) handleRequest(packet *EAPPacket, timer *time.Timer) (bool, error) {
+	// the authenticator retransmits the request if the response was lost
+	if s.lastRequest != nil && s.lastRequest.Identifier == packet.Identifier && s.lastRequest.Type == packet.Type && s.lastReply != nil {
+		return false, s.sendEAP(s.lastReply)
+	}
+
+	changed := false
+
+	reply := &EAPPacket{
+		Code:       EAPCodeResponse,
+		Identifier: packet.Identifier,
+		Type:       packet.Type,
+	}
+
+	switch packet.Type {
+	case EAPTypeIdentity:
+		// start of the (re-)authentication
+		s.resetMethod()
+
+		reply.Data = []byte(s.outerIdentity())
+
+		if s.status.State != StateAuthenticating {
+			s.status.State = StateAuthenticating
+			changed = true
+		}
+	case EAPTypeNotification:
+		s.logger.Info("802.1X notification", zap.String("message", string(packet.Data)))
+	case s.config.Method.eapType():
+		if s.method == nil {
+			s.method = s.newMethod()
+		}
+
+		data, err := s.method.process(packet.Data)
+		if err != nil {
+			s.logger.Warn("802.1X authentication error", zap.Error(err))
+
+			s.methodErr = err
+		}
+
+		if data == nil {
+			// wait for EAP-Failure or the timeout
+			return changed, nil
+		}
+
+		reply.Data = data
+
+		if s.status.State != StateAuthenticating {
+			s.status.State = StateAuthenticating
+			changed = true
+		}
+	default:
+		reply.Type = EAPTypeNak
+		reply.Data = []byte{s.config.Method.eapType()}
+	}
+
+	timer.Reset(s.config.AuthPeriod)
+
+	s.lastRequest = packet
+	s.lastReply = reply.Marshal()
+
+	return changed, s.sendEAP(s.lastReply)
+}
+
+func (s *Supplicant) fail(err error, timer *time.Timer) {
+	s.resetMethod()
+
+	s.logger.Warn("802.1X authentication failed", zap.Error(err))
+
+	s.status.State = StateHeld
+	s.status.Authorized = false
+	s.status.Failures++
+	s.status.LastError = err
+
+	timer.Reset(s.config.HeldPeriod)
+}
+
+func (s *Supplicant) outerIdentity() string {
+	if s.config.AnonymousIdentity != "" {
+		return s.config.AnonymousIdentity
+	}
+
+	return s.config.Identity
+}
+
+func (s *Supplicant) newMethod() *tlsMethod {
+	tlsConfig := s.config.TLSConfig.Clone()
+	tlsConfig.MaxVersion = tls.VersionTLS12
+
+	method := &tlsMethod{
+		config: tlsConfig,
+	}
+
+	if s.config.Method == MethodPEAP {
+		method.peap = newPEAPTunnel(s.config.Identity, s.config.Password)
+	}
+
+	return method
+}
+
+func (s *Supplicant) resetMethod() {
+	if s.method != nil {
+		s.method.close()
+		s.method = nil
+	}
+
+	s.methodErr = nil
+	s.lastRequest = nil
+	s.lastReply = nil
+}
+
+func (s *Supplicant) sendEAP(packet []byte) error {
+	return s.send(EAPOLTypeEAP, packet)
+}
+
+func (s *Supplicant) send(typ uint8, body []byte) error {
+	frame := &EAPOLFrame{
+		Version: eapolVersion,
+		Type:    typ,
+		Body:    body,
+	}
+
+	return s.transport.Send(frame.Marshal())
+}
@@ -0,0 +1,621 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dot1x
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+type testPKI struct {
+	pool   *x509.CertPool
+	server tls.Certificate
+	client tls.Certificate
+}
+
+func newTestPKI(t *testing.T) *testPKI {
+	t.Helper()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	ca, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	issue := func(serial int64, template *x509.Certificate) tls.Certificate {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		template.SerialNumber = big.NewInt(serial)
+		template.NotBefore = time.Now().Add(-time.Hour)
+		template.NotAfter = time.Now().Add(time.Hour)
+		template.KeyUsage = x509.KeyUsageDigitalSignature
+
+		der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+		require.NoError(t, err)
+
+		return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ca)
+
+	return &testPKI{
+		pool: pool,
+		server: issue(2, &x509.Certificate{
+			Subject:     pkix.Name{CommonName: "radius.example.com"},
+			DNSNames:    []string{"radius.example.com"},
+			ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		}),
+		client: issue(3, &x509.Certificate{
+			Subject:     pkix.Name{CommonName: "node"},
+			ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		}),
+	}
+}
+
+// authenticator is a minimal in-memory 802.1X authenticator with the embedded authentication server.
+type authenticator struct {
+	t *testing.T
+
+	method    uint8
+	tlsConfig *tls.Config
+	identity  string
+	password  string
+
+	toSupplicant   chan []byte
+	fromSupplicant chan []byte
+
+	id uint8
+}
+
+func newAuthenticator(t *testing.T, method uint8, tlsConfig *tls.Config) *authenticator {
+	return &authenticator{
+		t:              t,
+		method:         method,
+		tlsConfig:      tlsConfig,
+		toSupplicant:   make(chan []byte, 16),
+		fromSupplicant: make(chan []byte, 16),
+	}
+}
+
+// Send implements Transport.
+func (a *authenticator) Send(pdu []byte) error {
+	a.fromSupplicant <- append([]byte(nil), pdu...)
+
+	return nil
+}
+
+// Receive implements Transport.
+func (a *authenticator) Receive() <-chan []byte {
+	return a.toSupplicant
+}
+
+func (a *authenticator) sendEAP(packet *EAPPacket) {
+	a.toSupplicant <- (&EAPOLFrame{Version: eapolVersion, Type: EAPOLTypeEAP, Body: packet.Marshal()}).Marshal()
+}
+
+func (a *authenticator) request(typ uint8, data []byte) {
+	a.id++
+
+	a.sendEAP(&EAPPacket{Code: EAPCodeRequest, Identifier: a.id, Type: typ, Data: data})
+}
+
+func (a *authenticator) receive() *EAPOLFrame {
+	select {
+	case pdu := <-a.fromSupplicant:
+		frame, err := ParseEAPOLFrame(pdu)
+		require.NoError(a.t, err)
+
+		return frame
+	case <-time.After(5 * time.Second):
+		require.FailNow(a.t, "timeout waiting for the supplicant")
+
+		return nil
+	}
+}
+
+func (a *authenticator) response() *EAPPacket {
+	frame := a.receive()
+	require.EqualValues(a.t, EAPOLTypeEAP, frame.Type)
+
+	packet, err := ParseEAPPacket(frame.Body)
+	require.NoError(a.t, err)
+	require.EqualValues(a.t, EAPCodeResponse, packet.Code)
+	require.Equal(a.t, a.id, packet.Identifier)
+
+	return packet
+}
+
+// authenticate runs a single authentication exchange, and returns the result sent to the supplicant.
+func (a *authenticator) authenticate() error {
+	a.request(EAPTypeIdentity, nil)
+
+	resp := a.response()
+	require.EqualValues(a.t, EAPTypeIdentity, resp.Type)
+
+	var inner func(*tls.Conn) error
+
+	if a.method == EAPTypePEAP {
+		inner = a.peapInner
+	}
+
+	server := newTLSServer(a.t, a.tlsConfig, inner)
+	defer server.close()
+
+	a.request(a.method, []byte{tlsFlagStart})
+
+	for {
+		var msg []byte
+
+		for {
+			resp = a.response()
+			require.Equal(a.t, a.method, resp.Type)
+
+			flags, payload := resp.Data[0], resp.Data[1:]
+			if flags&tlsFlagLength != 0 {
+				payload = payload[4:]
+			}
+
+			msg = append(msg, payload...)
+
+			if flags&tlsFlagMore == 0 {
+				break
+			}
+
+			a.request(a.method, []byte{0})
+		}
+
+		if server.finished {
+			// acknowledgement of the last flight
+			return a.result(server.err)
+		}
+
+		out := server.step(msg)
+
+		if server.finished && (server.err != nil || len(out) == 0) {
+			return a.result(server.err)
+		}
+
+		// send the output in small fragments to exercise the reassembly
+		const fragmentSize = 300
+
+		for first := true; ; first = false {
+			data := []byte{0}
+
+			if len(out) > fragmentSize {
+				data[0] |= tlsFlagMore
+
+				if first {
+					data[0] |= tlsFlagLength
+					data = binary.BigEndian.AppendUint32(data, uint32(len(out)))
+				}
+			}
+
+			fragment := out[:min(len(out), fragmentSize)]
+			out = out[len(fragment):]
+
+			a.request(a.method, append(data, fragment...))
+
+			if len(out) == 0 {
+				break
+			}
+
+			resp = a.response()
+			require.Equal(a.t, []byte{0}, resp.Data)
+		}
+	}
+}
+
+func (a *authenticator) result(err error) error {
+	code := uint8(EAPCodeSuccess)
+
+	if err != nil {
+		code = EAPCodeFailure
+	}
+
+	a.sendEAP(&EAPPacket{Code: code, Identifier: a.id})
+
+	return err
+}
+
+// peapInner implements the inner EAP-MSCHAPv2 authentication server.
+func (a *authenticator) peapInner(conn *tls.Conn) error {
+	buf := make([]byte, 4096)
+
+	read := func() ([]byte, error) {
+		n, err := conn.Read(buf)
+
+		return buf[:n], err
+	}
+
+	mschap := func(opCode, id uint8, data []byte) []byte {
+		b := []byte{EAPTypeMSCHAPv2, opCode, id, 0, 0}
+		b = append(b, data...)
+
+		binary.BigEndian.PutUint16(b[3:5], uint16(len(b)-1))
+
+		return b
+	}
+
+	if _, err := conn.Write([]byte{EAPTypeIdentity}); err != nil {
+		return err
+	}
+
+	resp, err := read()
+	if err != nil {
+		return err
+	}
+
+	if resp[0] != EAPTypeIdentity || string(resp[1:]) != a.identity {
+		return fmt.Errorf("unexpected inner identity response %q", resp)
+	}
+
+	authChallenge := make([]byte, mschapv2ChallengeLength)
+	rand.Read(authChallenge) //nolint:errcheck
+
+	if _, err = conn.Write(mschap(mschapv2OpChallenge, 1, append([]byte{mschapv2ChallengeLength}, append(authChallenge, "radius"...)...))); err != nil {
+		return err
+	}
+
+	if resp, err = read(); err != nil {
+		return err
+	}
+
+	if resp[0] != EAPTypeMSCHAPv2 || resp[1] != mschapv2OpResponse || len(resp) < 55 {
+		return fmt.Errorf("unexpected MS-CHAPv2 response %x", resp)
+	}
+
+	peerChallenge, ntResponse := resp[6:22], resp[30:54]
+	username := mschapv2Username(string(resp[55:]))
+
+	if !bytes.Equal(ntResponse, mschapv2NTResponse(authChallenge, peerChallenge, username, a.password)) {
+		if _, err = conn.Write(mschap(mschapv2OpFailure, 1, []byte("E=691 R=0 V=3"))); err != nil {
+			return err
+		}
+
+		if _, err = read(); err != nil {
+			return err
+		}
+
+		return errors.New("invalid password")
+	}
+
+	authResponse := mschapv2AuthenticatorResponse(a.password, ntResponse, peerChallenge, authChallenge, username)
+
+	if _, err = conn.Write(mschap(mschapv2OpSuccess, 1, []byte(authResponse+" M=OK"))); err != nil {
+		return err
+	}
+
+	if resp, err = read(); err != nil {
+		return err
+	}
+
+	if !bytes.Equal(resp, []byte{EAPTypeMSCHAPv2, mschapv2OpSuccess}) {
+		return fmt.Errorf("unexpected MS-CHAPv2 success response %x", resp)
+	}
+
+	if _, err = conn.Write((&EAPPacket{Code: EAPCodeRequest, Identifier: 2, Type: EAPTypeTLV, Data: resultTLV(tlvResultSuccess)}).Marshal()); err != nil {
+		return err
+	}
+
+	if resp, err = read(); err != nil {
+		return err
+	}
+
+	packet, err := ParseEAPPacket(resp)
+	if err != nil {
+		return err
+	}
+
+	if packet.Type != EAPTypeTLV || !bytes.Equal(packet.Data, resultTLV(tlvResultSuccess)) {
+		return fmt.Errorf("unexpected EAP-TLV response %x", resp)
+	}
+
+	return nil
+}
+
+// tlsServer runs the server side of the TLS session over tunnelConn.
+type tlsServer struct {
+	conn     *tunnelConn
+	cancel   context.CancelFunc
+	resultCh chan error
+
+	finished bool
+	err      error
+}
+
+func newTLSServer(t *testing.T, config *tls.Config, inner func(*tls.Conn) error) *tlsServer {
+	ctx, cancel := context.WithCancel(t.Context())
+
+	server := &tlsServer{
+		conn: &tunnelConn{
+			inputCh: make(chan []byte),
+			flushCh: make(chan []byte),
+			closed:  ctx.Done(),
+		},
+		cancel:   cancel,
+		resultCh: make(chan error, 1),
+	}
+
+	conn := tls.Server(server.conn, config)
+
+	go func() {
+		err := conn.HandshakeContext(ctx)
+		if err == nil && inner != nil {
+			err = inner(conn)
+		}
+
+		server.resultCh <- err
+	}()
+
+	// wait for the server to read the ClientHello
+	<-server.conn.flushCh
+
+	return server
+}
+
+func (server *tlsServer) step(input []byte) []byte {
+	select {
+	case server.conn.inputCh <- input:
+	case err := <-server.resultCh:
+		return server.finish(err)
+	}
+
+	select {
+	case out := <-server.conn.flushCh:
+		return out
+	case err := <-server.resultCh:
+		return server.finish(err)
+	}
+}
+
+func (server *tlsServer) finish(err error) []byte {
+	server.finished = true
+	server.err = err
+
+	out := server.conn.out
+	server.conn.out = nil
+
+	return out
+}
+
+func (server *tlsServer) close() {
+	server.cancel()
+
+	if !server.finished {
+		<-server.resultCh
+	}
+}
+
+type statusRecorder chan Status
+
+func (recorder statusRecorder) notify(status Status) {
+	recorder <- status
+}
+
+func (recorder statusRecorder) waitFor(t *testing.T, state State) Status {
+	t.Helper()
+
+	for {
+		select {
+		case status := <-recorder:
+			if status.State == state {
+				return status
+			}
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "timeout waiting for the state", "state %q", state)
+		}
+	}
+}
+
+func runSupplicant(t *testing.T, config Config, transport Transport) statusRecorder {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	recorder := make(statusRecorder, 32)
+	errCh := make(chan error, 1)
+
+	supplicant := NewSupplicant(config, transport, zaptest.NewLogger(t))
+
+	go func() {
+		errCh <- supplicant.Run(ctx, recorder.notify)
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+
+		assert.NoError(t, <-errCh)
+	})
+
+	return recorder
+}
+
+func TestSupplicantEAPTLS(t *testing.T) {
+	t.Parallel()
+
+	pki := newTestPKI(t)
+
+	auth := newAuthenticator(t, EAPTypeTLS, &tls.Config{
+		Certificates: []tls.Certificate{pki.server},
+		ClientCAs:    pki.pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MaxVersion:   tls.VersionTLS12,
+	})
+
+	recorder := runSupplicant(t, Config{
+		Identity: "node",
+		Method:   MethodTLS,
+		TLSConfig: &tls.Config{
+			RootCAs:      pki.pool,
+			ServerName:   "radius.example.com",
+			Certificates: []tls.Certificate{pki.client},
+		},
+	}, auth)
+
+	frame := auth.receive()
+	require.EqualValues(t, EAPOLTypeStart, frame.Type)
+
+	require.NoError(t, auth.authenticate())
+
+	status := recorder.waitFor(t, StateAuthenticated)
+	assert.True(t, status.Authorized)
+	assert.Equal(t, 1, status.Authentications)
+
+	// re-authentication keeps the port authorized
+	require.NoError(t, auth.authenticate())
+
+	status = recorder.waitFor(t, StateAuthenticated)
+	assert.True(t, status.Authorized)
+	assert.Equal(t, 2, status.Authentications)
+}
+
+func TestSupplicantEAPTLSUntrustedServer(t *testing.T) {
+	t.Parallel()
+
+	pki := newTestPKI(t)
+	otherPKI := newTestPKI(t)
+
+	auth := newAuthenticator(t, EAPTypeTLS, &tls.Config{
+		Certificates: []tls.Certificate{otherPKI.server},
+		MaxVersion:   tls.VersionTLS12,
+	})
+
+	recorder := runSupplicant(t, Config{
+		Identity: "node",
+		Method:   MethodTLS,
+		TLSConfig: &tls.Config{
+			RootCAs:      pki.pool,
+			ServerName:   "radius.example.com",
+			Certificates: []tls.Certificate{pki.client},
+		},
+		HeldPeriod: time.Hour,
+	}, auth)
+
+	auth.receive()
+
+	require.Error(t, auth.authenticate())
+
+	status := recorder.waitFor(t, StateHeld)
+	assert.False(t, status.Authorized)
+	assert.Equal(t, 1, status.Failures)
+	assert.ErrorContains(t, status.LastError, "certificate")
+}
+
+func TestSupplicantPEAP(t *testing.T) {
+	t.Parallel()
+
+	pki := newTestPKI(t)
+
+	for _, test := range []struct {
+		name     string
+		password string
+
+		expectedState State
+		expectedError string
+	}{
+		{
+			name:          "success",
+			password:      "secret",
+			expectedState: StateAuthenticated,
+		},
+		{
+			name:          "wrong password",
+			password:      "wrong",
+			expectedState: StateHeld,
+			expectedError: "MS-CHAPv2 authentication failed: E=691",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			auth := newAuthenticator(t, EAPTypePEAP, &tls.Config{
+				Certificates: []tls.Certificate{pki.server},
+				MaxVersion:   tls.VersionTLS12,
+			})
+			auth.identity = `EXAMPLE\node`
+			auth.password = "secret"
+
+			recorder := runSupplicant(t, Config{
+				Identity:          `EXAMPLE\node`,
+				AnonymousIdentity: "anonymous",
+				Method:            MethodPEAP,
+				Password:          test.password,
+				TLSConfig: &tls.Config{
+					RootCAs:    pki.pool,
+					ServerName: "radius.example.com",
+				},
+				HeldPeriod: time.Hour,
+			}, auth)
+
+			auth.receive()
+
+			err := auth.authenticate()
+
+			status := recorder.waitFor(t, test.expectedState)
+
+			if test.expectedError == "" {
+				require.NoError(t, err)
+				assert.True(t, status.Authorized)
+				assert.NoError(t, status.LastError)
+			} else {
+				require.Error(t, err)
+				assert.False(t, status.Authorized)
+				assert.ErrorContains(t, status.LastError, test.expectedError)
+			}
+		})
+	}
+}
+
+type silentTransport struct {
+	ch chan []byte
+}
+
+func (silentTransport) Send([]byte) error { return nil }
+
+func (transport silentTransport) Receive() <-chan []byte { return transport.ch }
+
+func TestSupplicantNoAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	recorder := runSupplicant(t, Config{
+		Method:      MethodTLS,
+		TLSConfig:   &tls.Config{},
+		StartPeriod: 10 * time.Millisecond,
+		MaxStart:    2,
+	}, silentTransport{ch: make(chan []byte)})
+
+	status := recorder.waitFor(t, StateOpen)
+	assert.True(t, status.Authorized)
+}
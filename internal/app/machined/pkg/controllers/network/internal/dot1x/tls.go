@@ -0,0 +1,294 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dot1x
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+)
+
+// EAP-TLS flags (RFC 5216, 3.1).
+const (
+	tlsFlagLength = 0x80
+	tlsFlagMore   = 0x40
+	tlsFlagStart  = 0x20
+
+	// tlsMaxFragment keeps the EAPOL frames within the Ethernet MTU.
+	tlsMaxFragment = 1024
+)
+
+// tlsMethod implements TLS-based EAP methods (EAP-TLS and PEAP).
+//
+// The TLS session runs in a goroutine over tunnelConn, which exchanges the TLS records
+// with the EAP layer: each time the TLS session waits for the input, the buffered output is sent
+// to the authenticator, and the next request from the authenticator is delivered as the input.
+type tlsMethod struct {
+	config *tls.Config
+
+	// peap runs the inner authentication over the established TLS session, nil for EAP-TLS.
+	peap *peapTunnel
+
+	conn     *tunnelConn
+	cancel   context.CancelFunc
+	resultCh chan error
+	finished bool
+
+	incoming  []byte
+	outgoing  []byte
+	fragments int
+
+	done bool
+}
+
+func (m *tlsMethod) process(data []byte) ([]byte, error) {
+	if len(data) < 1 {
+		return nil, errors.New("TLS request too short")
+	}
+
+	flags, payload := data[0], data[1:]
+
+	if flags&tlsFlagLength != 0 {
+		if len(payload) < 4 {
+			return nil, errors.New("TLS request too short for the length")
+		}
+
+		payload = payload[4:]
+	}
+
+	var (
+		out []byte
+		err error
+	)
+
+	switch {
+	case flags&tlsFlagStart != 0:
+		// (re)start the TLS session
+		m.close()
+		m.start()
+
+		out, err = m.step(nil)
+	case len(m.outgoing) > 0:
+		// the request acknowledges the previous fragment, send the next one
+		return m.nextFragment(), nil
+	default:
+		m.incoming = append(m.incoming, payload...)
+
+		if flags&tlsFlagMore != 0 {
+			// acknowledge the fragment
+			return []byte{0}, nil
+		}
+
+		if m.conn == nil {
+			return nil, errors.New("TLS session is not started")
+		}
+
+		input := m.incoming
+		m.incoming = nil
+
+		if len(input) == 0 {
+			return []byte{0}, nil
+		}
+
+		out, err = m.step(input)
+	}
+
+	if err != nil && len(out) == 0 {
+		return nil, err
+	}
+
+	m.outgoing, m.fragments = out, 0
+
+	return m.nextFragment(), err
+}
+
+func (m *tlsMethod) nextFragment() []byte {
+	if len(m.outgoing) == 0 {
+		// acknowledgement
+		return []byte{0}
+	}
+
+	var flags byte
+
+	fragment := m.outgoing
+
+	if len(fragment) > tlsMaxFragment {
+		fragment = fragment[:tlsMaxFragment]
+		flags |= tlsFlagMore
+	}
+
+	resp := []byte{flags}
+
+	// the first fragment of the fragmented message carries the total length
+	if m.fragments == 0 && flags&tlsFlagMore != 0 {
+		resp[0] |= tlsFlagLength
+		resp = binary.BigEndian.AppendUint32(resp, uint32(len(m.outgoing)))
+	}
+
+	resp = append(resp, fragment...)
+
+	m.outgoing = m.outgoing[len(fragment):]
+	m.fragments++
+
+	return resp
+}
+
+func (m *tlsMethod) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	m.cancel = cancel
+	m.conn = &tunnelConn{
+		inputCh: make(chan []byte),
+		flushCh: make(chan []byte),
+		closed:  ctx.Done(),
+	}
+	m.resultCh = make(chan error, 1)
+	m.finished = false
+	m.done = false
+
+	if m.peap != nil {
+		m.peap = newPEAPTunnel(m.peap.identity, m.peap.mschap.password)
+	}
+
+	conn := tls.Client(m.conn, m.config)
+	peap := m.peap
+
+	go func() {
+		err := conn.HandshakeContext(ctx)
+		if err == nil && peap != nil {
+			err = peap.run(conn)
+		}
+
+		m.resultCh <- err
+	}()
+}
+
+// step delivers the input to the TLS session and waits for its output.
+func (m *tlsMethod) step(input []byte) ([]byte, error) {
+	if m.finished {
+		return nil, errors.New("TLS session is finished")
+	}
+
+	if input != nil {
+		select {
+		case m.conn.inputCh <- input:
+		case err := <-m.resultCh:
+			return m.finish(err)
+		}
+	}
+
+	select {
+	case out := <-m.conn.flushCh:
+		return out, nil
+	case err := <-m.resultCh:
+		return m.finish(err)
+	}
+}
+
+func (m *tlsMethod) finish(err error) ([]byte, error) {
+	m.finished = true
+
+	// pending output (e.g. TLS alert or the last response of the inner method) is still sent
+	out := m.conn.out
+	m.conn.out = nil
+
+	if err != nil {
+		return out, fmt.Errorf("TLS session failed: %w", err)
+	}
+
+	// EAP-TLS is done once the handshake is complete
+	if m.peap == nil {
+		m.done = true
+	}
+
+	return out, nil
+}
+
+func (m *tlsMethod) succeeded() bool {
+	if m.peap != nil {
+		return m.peap.success
+	}
+
+	return m.done
+}
+
+func (m *tlsMethod) close() {
+	if m.cancel == nil {
+		return
+	}
+
+	m.cancel()
+	m.cancel = nil
+
+	if !m.finished {
+		<-m.resultCh
+	}
+
+	m.conn = nil
+	m.incoming, m.outgoing = nil, nil
+}
+
+// tunnelConn implements net.Conn for the TLS session tunneled over EAP.
+type tunnelConn struct {
+	inputCh chan []byte
+	flushCh chan []byte
+	closed  <-chan struct{}
+
+	in  []byte
+	out []byte
+}
+
+func (c *tunnelConn) Read(b []byte) (int, error) {
+	if len(c.in) == 0 {
+		out := c.out
+		c.out = nil
+
+		select {
+		case c.flushCh <- out:
+		case <-c.closed:
+			return 0, io.EOF
+		}
+
+		select {
+		case c.in = <-c.inputCh:
+		case <-c.closed:
+			return 0, io.EOF
+		}
+	}
+
+	n := copy(b, c.in)
+	c.in = c.in[n:]
+
+	return n, nil
+}
+
+func (c *tunnelConn) Write(b []byte) (int, error) {
+	select {
+	case <-c.closed:
+		return 0, net.ErrClosed
+	default:
+	}
+
+	c.out = append(c.out, b...)
+
+	return len(b), nil
+}
+
+func (c *tunnelConn) Close() error                     { return nil }
+func (c *tunnelConn) LocalAddr() net.Addr              { return tunnelAddr{} }
+func (c *tunnelConn) RemoteAddr() net.Addr             { return tunnelAddr{} }
+func (c *tunnelConn) SetDeadline(time.Time) error      { return nil }
+func (c *tunnelConn) SetReadDeadline(time.Time) error  { return nil }
+func (c *tunnelConn) SetWriteDeadline(time.Time) error { return nil }
+
+type tunnelAddr struct{}
+
+func (tunnelAddr) Network() string { return "eap" }
+func (tunnelAddr) String() string  { return "eap" }
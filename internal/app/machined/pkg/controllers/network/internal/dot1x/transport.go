@@ -0,0 +1,126 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dot1x
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/mdlayher/packet"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+)
+
+// PacketTransport implements Transport over the packet socket bound to the link.
+type PacketTransport struct {
+	logger *zap.Logger
+
+	conn      *packet.Conn
+	receiveCh chan []byte
+	done      chan struct{}
+}
+
+// NewPacketTransport creates a new transport for the link.
+func NewPacketTransport(logger *zap.Logger, linkName string) (*PacketTransport, error) {
+	ifi, err := net.InterfaceByName(linkName)
+	if err != nil {
+		return nil, fmt.Errorf("error looking up link %q: %w", linkName, err)
+	}
+
+	conn, err := packet.Listen(ifi, packet.Datagram, EtherType, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error listening for EAPOL: %w", err)
+	}
+
+	if err = joinPAEGroup(conn, ifi.Index); err != nil {
+		conn.Close() //nolint:errcheck
+
+		return nil, err
+	}
+
+	transport := &PacketTransport{
+		logger:    logger,
+		conn:      conn,
+		receiveCh: make(chan []byte),
+		done:      make(chan struct{}),
+	}
+
+	go transport.receiveLoop()
+
+	return transport, nil
+}
+
+// joinPAEGroup makes the link accept the frames sent to the PAE group address.
+func joinPAEGroup(conn *packet.Conn, ifindex int) error {
+	rawConn, err := conn.SyscallConn()
+	if err != nil {
+		return err
+	}
+
+	mreq := unix.PacketMreq{
+		Ifindex: int32(ifindex),
+		Type:    unix.PACKET_MR_MULTICAST,
+		Alen:    uint16(len(PAEGroupAddress)),
+	}
+
+	copy(mreq.Address[:], PAEGroupAddress)
+
+	var sockErr error
+
+	if err = rawConn.Control(func(fd uintptr) {
+		sockErr = unix.SetsockoptPacketMreq(int(fd), unix.SOL_PACKET, unix.PACKET_ADD_MEMBERSHIP, &mreq)
+	}); err != nil {
+		return err
+	}
+
+	if sockErr != nil {
+		return fmt.Errorf("error joining PAE group: %w", sockErr)
+	}
+
+	return nil
+}
+
+// Send implements Transport.
+func (transport *PacketTransport) Send(pdu []byte) error {
+	_, err := transport.conn.WriteTo(pdu, &packet.Addr{HardwareAddr: PAEGroupAddress})
+
+	return err
+}
+
+// Receive implements Transport.
+func (transport *PacketTransport) Receive() <-chan []byte {
+	return transport.receiveCh
+}
+
+// Close the transport.
+func (transport *PacketTransport) Close() error {
+	close(transport.done)
+
+	return transport.conn.Close()
+}
+
+func (transport *PacketTransport) receiveLoop() {
+	buf := make([]byte, 65536)
+
+	for {
+		n, _, err := transport.conn.ReadFrom(buf)
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				transport.logger.Warn("error receiving EAPOL frame", zap.Error(err))
+			}
+
+			return
+		}
+
+		pdu := append([]byte(nil), buf[:n]...)
+
+		select {
+		case transport.receiveCh <- pdu:
+		case <-transport.done:
+			return
+		}
+	}
+}
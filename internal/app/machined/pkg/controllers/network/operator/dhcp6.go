@@ -36,14 +36,21 @@ type DHCP6 struct {
 	linkName            string
 	clientIdentifier    network.ClientIdentifierSpec
 	skipHostnameRequest bool
-
-	mu          sync.Mutex
-	addresses   []network.AddressSpecSpec
-	hostname    []network.HostnameSpecSpec
-	resolvers   []network.ResolverSpecSpec
-	timeservers []network.TimeServerSpecSpec
+	prefixDelegation    network.DHCP6PrefixDelegationSpec
+
+	mu                sync.Mutex
+	addresses         []network.AddressSpecSpec
+	hostname          []network.HostnameSpecSpec
+	resolvers         []network.ResolverSpecSpec
+	timeservers       []network.TimeServerSpecSpec
+	delegatedPrefixes []network.DelegatedPrefixSpec
 }
 
+// prefixDelegationIAID is the identity association ID of the IA_PD.
+//
+// The client requests a single delegated prefix per link, so the IAID is constant.
+var prefixDelegationIAID = [4]byte{0, 0, 0, 1}
+
 // NewDHCP6 creates DHCPv6 operator.
 func NewDHCP6(logger *zap.Logger, linkName string, config network.DHCP6OperatorSpec, state state.State) *DHCP6 {
 	return &DHCP6{
@@ -52,6 +59,7 @@ func NewDHCP6(logger *zap.Logger, linkName string, config network.DHCP6OperatorS
 		linkName:            linkName,
 		clientIdentifier:    config.ClientIdentifier,
 		skipHostnameRequest: config.SkipHostnameRequest,
+		prefixDelegation:    config.PrefixDelegation,
 	}
 }
 
@@ -113,6 +121,14 @@ func (d *DHCP6) AddressSpecs() []network.AddressSpecSpec {
 	return d.addresses
 }
 
+// DelegatedPrefixes implements DelegatedPrefixReporter interface.
+func (d *DHCP6) DelegatedPrefixes() []network.DelegatedPrefixSpec {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.delegatedPrefixes
+}
+
 // LinkSpecs implements Operator interface.
 func (d *DHCP6) LinkSpecs() []network.LinkSpecSpec {
 	return nil
@@ -147,6 +163,7 @@ func (d *DHCP6) TimeServerSpecs() []network.TimeServerSpecSpec {
 	return d.timeservers
 }
 
+//nolint:gocyclo
 func (d *DHCP6) parseReply(reply *dhcpv6.Message) (leaseTime time.Duration) {
 	d.mu.Lock()
 	defer d.mu.Unlock()
@@ -173,6 +190,28 @@ func (d *DHCP6) parseReply(reply *dhcpv6.Message) (leaseTime time.Duration) {
 		d.addresses = nil
 	}
 
+	d.delegatedPrefixes = nil
+
+	if delegatedPrefix, ok := d.parseDelegatedPrefix(reply); ok {
+		for _, downstream := range delegatedPrefix.Downstream {
+			d.addresses = append(d.addresses, network.AddressSpecSpec{
+				Address:     downstream.Address,
+				LinkName:    downstream.LinkName,
+				Family:      nethelpers.FamilyInet6,
+				Scope:       nethelpers.ScopeGlobal,
+				Flags:       nethelpers.AddressFlags(nethelpers.AddressPermanent),
+				ConfigLayer: network.ConfigOperator,
+			})
+		}
+
+		d.delegatedPrefixes = []network.DelegatedPrefixSpec{delegatedPrefix}
+
+		// renew before the delegated prefix expires
+		if leaseTime == 0 || delegatedPrefix.ValidLifetime < leaseTime {
+			leaseTime = delegatedPrefix.ValidLifetime
+		}
+	}
+
 	if len(reply.Options.DNS()) > 0 {
 		convertIP := func(ip net.IP) netip.Addr {
 			result, _ := netipx.FromStdIP(ip)
@@ -224,6 +263,60 @@ func (d *DHCP6) parseReply(reply *dhcpv6.Message) (leaseTime time.Duration) {
 	return leaseTime
 }
 
+// parseDelegatedPrefix picks the delegated prefix from the reply and carves out the subnets for the downstream links.
+func (d *DHCP6) parseDelegatedPrefix(reply *dhcpv6.Message) (network.DelegatedPrefixSpec, bool) {
+	if !d.prefixDelegation.Enabled {
+		return network.DelegatedPrefixSpec{}, false
+	}
+
+	iapd := reply.Options.OneIAPD()
+	if iapd == nil {
+		d.logger.Warn("no delegated prefix in the reply", zap.String("link", d.linkName))
+
+		return network.DelegatedPrefixSpec{}, false
+	}
+
+	prefixes := iapd.Options.Prefixes()
+	if len(prefixes) == 0 || prefixes[0].Prefix == nil {
+		d.logger.Warn("no delegated prefix in the reply", zap.String("link", d.linkName))
+
+		return network.DelegatedPrefixSpec{}, false
+	}
+
+	prefix, ok := netipx.FromStdIPNet(prefixes[0].Prefix)
+	if !ok || !prefix.Addr().Is6() {
+		d.logger.Warn("invalid delegated prefix", zap.String("link", d.linkName), zap.Stringer("prefix", prefixes[0].Prefix))
+
+		return network.DelegatedPrefixSpec{}, false
+	}
+
+	spec := network.DelegatedPrefixSpec{
+		LinkName:          d.linkName,
+		Prefix:            prefix.Masked(),
+		PreferredLifetime: prefixes[0].PreferredLifetime,
+		ValidLifetime:     prefixes[0].ValidLifetime,
+	}
+
+	for _, downstream := range d.prefixDelegation.Downstream {
+		subnet, err := nethelpers.Subnet(spec.Prefix, downstream.SubnetID, int(downstream.SubnetLength))
+		if err != nil {
+			d.logger.Warn("failed to assign delegated subnet", zap.String("link", downstream.LinkName), zap.Error(err))
+
+			continue
+		}
+
+		spec.Downstream = append(spec.Downstream, network.DelegatedPrefixDownstreamSpec{
+			LinkName: downstream.LinkName,
+			Subnet:   subnet,
+			// the node takes the first address of the subnet
+			Address:              netip.PrefixFrom(subnet.Addr().Next(), subnet.Bits()),
+			RouterAdvertisements: downstream.RouterAdvertisements,
+		})
+	}
+
+	return spec, true
+}
+
 func (d *DHCP6) renew(ctx context.Context) (time.Duration, error) {
 	cli, err := nclient6.New(d.linkName)
 	if err != nil {
@@ -237,7 +330,24 @@ func (d *DHCP6) renew(ctx context.Context) (time.Duration, error) {
 		return 0, fmt.Errorf("error getting DHCPv6 client identifier: %w", err)
 	}
 
-	reply, err := cli.RapidSolicit(ctx, clientIdentifierModifiers...)
+	modifiers := clientIdentifierModifiers
+
+	if d.prefixDelegation.Enabled {
+		var hints []*dhcpv6.OptIAPrefix
+
+		if d.prefixDelegation.PrefixLength > 0 {
+			hints = append(hints, &dhcpv6.OptIAPrefix{
+				Prefix: &net.IPNet{
+					IP:   net.IPv6zero,
+					Mask: net.CIDRMask(int(d.prefixDelegation.PrefixLength), 128),
+				},
+			})
+		}
+
+		modifiers = append(modifiers, dhcpv6.WithIAPD(prefixDelegationIAID, hints...))
+	}
+
+	reply, err := cli.RapidSolicit(ctx, modifiers...)
 	if err != nil {
 		return 0, err
 	}
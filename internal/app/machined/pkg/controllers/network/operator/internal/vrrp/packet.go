@@ -0,0 +1,154 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package vrrp
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/netip"
+	"time"
+)
+
+// VRRP protocol constants (RFC 9568).
+const (
+	Version             = 3
+	TypeAdvertisement   = 1
+	ProtocolNumber      = 112
+	TTL                 = 255
+	headerLength        = 8
+	maxAdvertIntervalCS = 0xfff
+)
+
+// VRRP multicast groups.
+var (
+	MulticastGroupIPv4 = netip.MustParseAddr("224.0.0.18")
+	MulticastGroupIPv6 = netip.MustParseAddr("ff02::12")
+)
+
+// Advertisement is the VRRPv3 advertisement message.
+type Advertisement struct {
+	VirtualRouterID   uint8
+	Priority          uint8
+	MaxAdvertInterval time.Duration
+	Addresses         []netip.Addr
+}
+
+// Marshal encodes the advertisement sent from src to dst.
+//
+// Source and destination addresses are used to calculate the checksum.
+func (adv *Advertisement) Marshal(src, dst netip.Addr) ([]byte, error) {
+	if src.Is4() != dst.Is4() {
+		return nil, errors.New("source and destination address families mismatch")
+	}
+
+	if len(adv.Addresses) > 255 {
+		return nil, fmt.Errorf("too many addresses: %d", len(adv.Addresses))
+	}
+
+	interval := adv.MaxAdvertInterval / (10 * time.Millisecond)
+	if interval < 1 || interval > maxAdvertIntervalCS {
+		return nil, fmt.Errorf("advertisement interval %s is out of range", adv.MaxAdvertInterval)
+	}
+
+	addrLen := src.BitLen() / 8
+
+	b := make([]byte, headerLength, headerLength+len(adv.Addresses)*addrLen)
+
+	b[0] = Version<<4 | TypeAdvertisement
+	b[1] = adv.VirtualRouterID
+	b[2] = adv.Priority
+	b[3] = uint8(len(adv.Addresses))
+	binary.BigEndian.PutUint16(b[4:6], uint16(interval))
+
+	for _, addr := range adv.Addresses {
+		if addr.BitLen() != src.BitLen() {
+			return nil, fmt.Errorf("address %s family mismatch", addr)
+		}
+
+		b = append(b, addr.AsSlice()...)
+	}
+
+	binary.BigEndian.PutUint16(b[6:8], checksum(b, src, dst))
+
+	return b, nil
+}
+
+// ParseAdvertisement decodes the advertisement received from src to dst.
+func ParseAdvertisement(b []byte, src, dst netip.Addr) (*Advertisement, error) {
+	if len(b) < headerLength {
+		return nil, fmt.Errorf("packet too short: %d bytes", len(b))
+	}
+
+	if version := b[0] >> 4; version != Version {
+		return nil, fmt.Errorf("unsupported version %d", version)
+	}
+
+	if typ := b[0] & 0x0f; typ != TypeAdvertisement {
+		return nil, fmt.Errorf("unsupported type %d", typ)
+	}
+
+	if checksum(b, src, dst) != 0 {
+		return nil, errors.New("invalid checksum")
+	}
+
+	addrLen := src.BitLen() / 8
+	count := int(b[3])
+
+	if len(b) < headerLength+count*addrLen {
+		return nil, fmt.Errorf("packet too short for %d addresses: %d bytes", count, len(b))
+	}
+
+	adv := &Advertisement{
+		VirtualRouterID:   b[1],
+		Priority:          b[2],
+		MaxAdvertInterval: time.Duration(binary.BigEndian.Uint16(b[4:6])&maxAdvertIntervalCS) * 10 * time.Millisecond,
+		Addresses:         make([]netip.Addr, 0, count),
+	}
+
+	for i := range count {
+		addr, _ := netip.AddrFromSlice(b[headerLength+i*addrLen : headerLength+(i+1)*addrLen])
+
+		adv.Addresses = append(adv.Addresses, addr)
+	}
+
+	return adv, nil
+}
+
+// checksum calculates the checksum over the VRRP message and the IP pseudo-header.
+//
+// If the checksum field of the message is filled in, a valid message has the checksum of zero.
+func checksum(b []byte, src, dst netip.Addr) uint16 {
+	var pseudoHeader []byte
+
+	pseudoHeader = append(pseudoHeader, src.AsSlice()...)
+	pseudoHeader = append(pseudoHeader, dst.AsSlice()...)
+
+	if src.Is4() {
+		pseudoHeader = append(pseudoHeader, 0, ProtocolNumber)
+		pseudoHeader = binary.BigEndian.AppendUint16(pseudoHeader, uint16(len(b)))
+	} else {
+		pseudoHeader = binary.BigEndian.AppendUint32(pseudoHeader, uint32(len(b)))
+		pseudoHeader = append(pseudoHeader, 0, 0, 0, ProtocolNumber)
+	}
+
+	var sum uint32
+
+	for _, data := range [][]byte{pseudoHeader, b} {
+		for i := 0; i+1 < len(data); i += 2 {
+			sum += uint32(binary.BigEndian.Uint16(data[i : i+2]))
+		}
+
+		if len(data)%2 == 1 {
+			sum += uint32(data[len(data)-1]) << 8
+		}
+	}
+
+	for sum > 0xffff {
+		sum = sum&0xffff + sum>>16
+	}
+
+	return ^uint16(sum)
+}
@@ -0,0 +1,132 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package vrrp_test
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/operator/internal/vrrp"
+)
+
+func TestAdvertisementMarshal(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		src  netip.Addr
+		dst  netip.Addr
+		adv  vrrp.Advertisement
+	}{
+		{
+			name: "ipv4",
+			src:  netip.MustParseAddr("192.168.0.2"),
+			dst:  vrrp.MulticastGroupIPv4,
+			adv: vrrp.Advertisement{
+				VirtualRouterID:   51,
+				Priority:          150,
+				MaxAdvertInterval: time.Second,
+				Addresses:         []netip.Addr{netip.MustParseAddr("192.168.0.100")},
+			},
+		},
+		{
+			name: "ipv6",
+			src:  netip.MustParseAddr("fe80::1"),
+			dst:  vrrp.MulticastGroupIPv6,
+			adv: vrrp.Advertisement{
+				VirtualRouterID:   1,
+				Priority:          0,
+				MaxAdvertInterval: 40950 * time.Millisecond,
+				Addresses:         []netip.Addr{netip.MustParseAddr("2001:db8::100"), netip.MustParseAddr("2001:db8::101")},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			b, err := test.adv.Marshal(test.src, test.dst)
+			require.NoError(t, err)
+
+			assert.Len(t, b, 8+len(test.adv.Addresses)*test.src.BitLen()/8)
+			assert.Equal(t, byte(0x31), b[0])
+			assert.Equal(t, test.adv.VirtualRouterID, b[1])
+			assert.Equal(t, test.adv.Priority, b[2])
+			assert.Equal(t, byte(len(test.adv.Addresses)), b[3])
+
+			parsed, err := vrrp.ParseAdvertisement(b, test.src, test.dst)
+			require.NoError(t, err)
+
+			assert.Equal(t, &test.adv, parsed)
+
+			// different source address breaks the checksum
+			_, err = vrrp.ParseAdvertisement(b, test.src.Next(), test.dst)
+			require.EqualError(t, err, "invalid checksum")
+
+			// corrupted packet
+			b[2]++
+
+			_, err = vrrp.ParseAdvertisement(b, test.src, test.dst)
+			require.EqualError(t, err, "invalid checksum")
+		})
+	}
+}
+
+func TestAdvertisementMarshalErrors(t *testing.T) {
+	t.Parallel()
+
+	src := netip.MustParseAddr("192.168.0.2")
+
+	_, err := (&vrrp.Advertisement{VirtualRouterID: 1, MaxAdvertInterval: time.Minute}).Marshal(src, vrrp.MulticastGroupIPv4)
+	require.EqualError(t, err, "advertisement interval 1m0s is out of range")
+
+	_, err = (&vrrp.Advertisement{VirtualRouterID: 1, MaxAdvertInterval: time.Second}).Marshal(src, vrrp.MulticastGroupIPv6)
+	require.EqualError(t, err, "source and destination address families mismatch")
+
+	_, err = (&vrrp.Advertisement{
+		VirtualRouterID:   1,
+		MaxAdvertInterval: time.Second,
+		Addresses:         []netip.Addr{netip.MustParseAddr("2001:db8::1")},
+	}).Marshal(src, vrrp.MulticastGroupIPv4)
+	require.EqualError(t, err, "address 2001:db8::1 family mismatch")
+}
+
+func TestParseAdvertisementErrors(t *testing.T) {
+	t.Parallel()
+
+	src := netip.MustParseAddr("192.168.0.2")
+
+	for _, test := range []struct {
+		name          string
+		data          []byte
+		expectedError string
+	}{
+		{
+			name:          "short",
+			data:          []byte{0x31, 1, 100},
+			expectedError: "packet too short: 3 bytes",
+		},
+		{
+			name:          "VRRPv2",
+			data:          []byte{0x21, 1, 100, 0, 0, 1, 0, 0},
+			expectedError: "unsupported version 2",
+		},
+		{
+			name:          "unknown type",
+			data:          []byte{0x32, 1, 100, 0, 0, 100, 0, 0},
+			expectedError: "unsupported type 2",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := vrrp.ParseAdvertisement(test.data, src, vrrp.MulticastGroupIPv4)
+			require.EqualError(t, err, test.expectedError)
+		})
+	}
+}
@@ -0,0 +1,317 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package vrrp
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+
+	"go.uber.org/zap"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+)
+
+// IPTransport implements Transport over raw IP sockets.
+type IPTransport struct {
+	logger *zap.Logger
+
+	linkName string
+	family   int
+	excluded []netip.Addr
+
+	conn  net.PacketConn
+	conn4 *ipv4.PacketConn
+	conn6 *ipv6.PacketConn
+
+	receiveCh chan Received
+	done      chan struct{}
+}
+
+// NewIPTransport creates a new transport for the link.
+//
+// The family of the transport is picked based on the family of the virtual addresses,
+// and virtual addresses are never used as the source address of the advertisements.
+func NewIPTransport(logger *zap.Logger, linkName string, virtualAddresses []netip.Addr) (*IPTransport, error) {
+	if len(virtualAddresses) == 0 {
+		return nil, errors.New("no virtual addresses")
+	}
+
+	ifi, err := net.InterfaceByName(linkName)
+	if err != nil {
+		return nil, fmt.Errorf("error looking up link %q: %w", linkName, err)
+	}
+
+	transport := &IPTransport{
+		logger:    logger,
+		linkName:  linkName,
+		family:    4,
+		excluded:  virtualAddresses,
+		receiveCh: make(chan Received),
+		done:      make(chan struct{}),
+	}
+
+	if !virtualAddresses[0].Is4() {
+		transport.family = 6
+	}
+
+	switch transport.family {
+	case 4:
+		err = transport.listen4(ifi)
+	default:
+		err = transport.listen6(ifi)
+	}
+
+	if err != nil {
+		if transport.conn != nil {
+			transport.conn.Close() //nolint:errcheck
+		}
+
+		return nil, err
+	}
+
+	go transport.receiveLoop(ifi.Index)
+
+	return transport, nil
+}
+
+func (transport *IPTransport) listen4(ifi *net.Interface) error {
+	conn, err := net.ListenPacket("ip4:"+strconv.Itoa(ProtocolNumber), "0.0.0.0")
+	if err != nil {
+		return fmt.Errorf("error listening for VRRP: %w", err)
+	}
+
+	transport.conn = conn
+	transport.conn4 = ipv4.NewPacketConn(conn)
+
+	if err = transport.conn4.JoinGroup(ifi, &net.IPAddr{IP: MulticastGroupIPv4.AsSlice()}); err != nil {
+		return fmt.Errorf("error joining VRRP multicast group: %w", err)
+	}
+
+	if err = transport.conn4.SetControlMessage(ipv4.FlagTTL|ipv4.FlagDst|ipv4.FlagInterface, true); err != nil {
+		return fmt.Errorf("error enabling control messages: %w", err)
+	}
+
+	if err = transport.conn4.SetMulticastInterface(ifi); err != nil {
+		return fmt.Errorf("error setting multicast interface: %w", err)
+	}
+
+	if err = transport.conn4.SetMulticastTTL(TTL); err != nil {
+		return fmt.Errorf("error setting multicast TTL: %w", err)
+	}
+
+	return transport.conn4.SetMulticastLoopback(false)
+}
+
+func (transport *IPTransport) listen6(ifi *net.Interface) error {
+	conn, err := net.ListenPacket("ip6:"+strconv.Itoa(ProtocolNumber), "::")
+	if err != nil {
+		return fmt.Errorf("error listening for VRRP: %w", err)
+	}
+
+	transport.conn = conn
+	transport.conn6 = ipv6.NewPacketConn(conn)
+
+	if err = transport.conn6.JoinGroup(ifi, &net.IPAddr{IP: MulticastGroupIPv6.AsSlice()}); err != nil {
+		return fmt.Errorf("error joining VRRP multicast group: %w", err)
+	}
+
+	if err = transport.conn6.SetControlMessage(ipv6.FlagHopLimit|ipv6.FlagDst|ipv6.FlagInterface, true); err != nil {
+		return fmt.Errorf("error enabling control messages: %w", err)
+	}
+
+	if err = transport.conn6.SetMulticastInterface(ifi); err != nil {
+		return fmt.Errorf("error setting multicast interface: %w", err)
+	}
+
+	if err = transport.conn6.SetMulticastHopLimit(TTL); err != nil {
+		return fmt.Errorf("error setting multicast hop limit: %w", err)
+	}
+
+	return transport.conn6.SetMulticastLoopback(false)
+}
+
+//nolint:gocyclo
+func (transport *IPTransport) receiveLoop(ifIndex int) {
+	defer close(transport.receiveCh)
+
+	buf := make([]byte, 1500)
+
+	for {
+		var (
+			n        int
+			src      net.Addr
+			dst      net.IP
+			ttl      int
+			incoming int
+			err      error
+		)
+
+		switch transport.family {
+		case 4:
+			var cm *ipv4.ControlMessage
+
+			n, cm, src, err = transport.conn4.ReadFrom(buf)
+			if cm != nil {
+				dst, ttl, incoming = cm.Dst, cm.TTL, cm.IfIndex
+			}
+		default:
+			var cm *ipv6.ControlMessage
+
+			n, cm, src, err = transport.conn6.ReadFrom(buf)
+			if cm != nil {
+				dst, ttl, incoming = cm.Dst, cm.HopLimit, cm.IfIndex
+			}
+		}
+
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				transport.logger.Warn("error receiving VRRP advertisement", zap.Error(err))
+			}
+
+			return
+		}
+
+		// packets from other links are delivered to the socket as well
+		if incoming != ifIndex {
+			continue
+		}
+
+		// RFC 9568, 7.1: the TTL/hop limit must be 255
+		if ttl != TTL {
+			continue
+		}
+
+		srcIPAddr, ok := src.(*net.IPAddr)
+		if !ok {
+			continue
+		}
+
+		srcAddr, ok := netip.AddrFromSlice(srcIPAddr.IP)
+		if !ok {
+			continue
+		}
+
+		dstAddr, ok := netip.AddrFromSlice(dst)
+		if !ok {
+			continue
+		}
+
+		srcAddr, dstAddr = srcAddr.Unmap(), dstAddr.Unmap()
+
+		adv, err := ParseAdvertisement(buf[:n], srcAddr, dstAddr)
+		if err != nil {
+			transport.logger.Debug("dropping invalid VRRP advertisement", zap.Stringer("source", srcAddr), zap.Error(err))
+
+			continue
+		}
+
+		select {
+		case transport.receiveCh <- Received{
+			Source:        srcAddr,
+			Advertisement: adv,
+		}:
+		case <-transport.done:
+			return
+		}
+	}
+}
+
+// Send implements Transport interface.
+func (transport *IPTransport) Send(adv *Advertisement) error {
+	src, err := transport.LocalAddr()
+	if err != nil {
+		return err
+	}
+
+	ifi, err := net.InterfaceByName(transport.linkName)
+	if err != nil {
+		return fmt.Errorf("error looking up link %q: %w", transport.linkName, err)
+	}
+
+	switch transport.family {
+	case 4:
+		b, err := adv.Marshal(src, MulticastGroupIPv4)
+		if err != nil {
+			return err
+		}
+
+		_, err = transport.conn4.WriteTo(b, &ipv4.ControlMessage{Src: src.AsSlice(), IfIndex: ifi.Index}, &net.IPAddr{IP: MulticastGroupIPv4.AsSlice()})
+
+		return err
+	default:
+		b, err := adv.Marshal(src, MulticastGroupIPv6)
+		if err != nil {
+			return err
+		}
+
+		_, err = transport.conn6.WriteTo(b, &ipv6.ControlMessage{Src: src.AsSlice(), IfIndex: ifi.Index, HopLimit: TTL}, &net.IPAddr{IP: MulticastGroupIPv6.AsSlice(), Zone: ifi.Name})
+
+		return err
+	}
+}
+
+// Receive implements Transport interface.
+func (transport *IPTransport) Receive() <-chan Received {
+	return transport.receiveCh
+}
+
+// LocalAddr implements Transport interface.
+//
+// For IPv4, the first address of the link which is not a virtual address is used, for IPv6 it is the link-local address.
+func (transport *IPTransport) LocalAddr() (netip.Addr, error) {
+	ifi, err := net.InterfaceByName(transport.linkName)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("error looking up link %q: %w", transport.linkName, err)
+	}
+
+	addrs, err := ifi.Addrs()
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("error listing addresses of link %q: %w", transport.linkName, err)
+	}
+
+addrLoop:
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if !ok {
+			continue
+		}
+
+		ip, ok := netip.AddrFromSlice(ipNet.IP)
+		if !ok {
+			continue
+		}
+
+		ip = ip.Unmap()
+
+		for _, excluded := range transport.excluded {
+			if ip == excluded {
+				continue addrLoop
+			}
+		}
+
+		switch transport.family {
+		case 4:
+			if ip.Is4() {
+				return ip, nil
+			}
+		default:
+			if ip.Is6() && ip.IsLinkLocalUnicast() {
+				return ip.WithZone(""), nil
+			}
+		}
+	}
+
+	return netip.Addr{}, fmt.Errorf("no IPv%d address found on link %q", transport.family, transport.linkName)
+}
+
+// Close the transport.
+func (transport *IPTransport) Close() error {
+	close(transport.done)
+
+	return transport.conn.Close()
+}
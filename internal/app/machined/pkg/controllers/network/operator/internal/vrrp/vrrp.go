@@ -0,0 +1,241 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package vrrp implements VRRPv3 (RFC 9568) virtual router election.
+package vrrp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// State is the state of the virtual router.
+type State int
+
+// Virtual router states.
+const (
+	StateBackup State = iota
+	StateMaster
+)
+
+// String implements fmt.Stringer interface.
+func (state State) String() string {
+	switch state {
+	case StateBackup:
+		return "backup"
+	case StateMaster:
+		return "master"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(state))
+	}
+}
+
+// Status is the observed status of the virtual router.
+type Status struct {
+	State State
+	// Master is the primary address of the current master, if known.
+	Master netip.Addr
+}
+
+// Config is the configuration of the virtual router.
+type Config struct {
+	VirtualRouterID       uint8
+	Priority              uint8
+	Preempt               bool
+	AdvertisementInterval time.Duration
+	Addresses             []netip.Addr
+}
+
+// Received is the advertisement received from another router.
+type Received struct {
+	Source        netip.Addr
+	Advertisement *Advertisement
+}
+
+// Transport sends and receives VRRP advertisements on a link.
+type Transport interface {
+	// Send the advertisement to the VRRP multicast group.
+	Send(adv *Advertisement) error
+	// Receive returns the channel of received advertisements.
+	Receive() <-chan Received
+	// LocalAddr returns the primary address of the node on the link.
+	LocalAddr() (netip.Addr, error)
+}
+
+// Router implements the VRRP virtual router state machine.
+type Router struct {
+	logger    *zap.Logger
+	config    Config
+	transport Transport
+
+	status            Status
+	masterAdvInterval time.Duration
+}
+
+// NewRouter creates a new virtual router.
+func NewRouter(logger *zap.Logger, config Config, transport Transport) *Router {
+	return &Router{
+		logger:            logger,
+		config:            config,
+		transport:         transport,
+		masterAdvInterval: config.AdvertisementInterval,
+	}
+}
+
+// skewTime returns the time to skew the master down interval.
+func (router *Router) skewTime() time.Duration {
+	return time.Duration(256-int64(router.config.Priority)) * router.masterAdvInterval / 256
+}
+
+// masterDownInterval returns the interval for the backup to declare the master down.
+func (router *Router) masterDownInterval() time.Duration {
+	return 3*router.masterAdvInterval + router.skewTime()
+}
+
+// Run the virtual router until the context is canceled.
+//
+// The onChange callback is called each time the status changes.
+// When the context is canceled while the router is the master, it sends an advertisement with priority zero
+// to trigger fast failover to the backup routers.
+//
+//nolint:gocyclo,cyclop
+func (router *Router) Run(ctx context.Context, onChange func(Status)) error {
+	timer := time.NewTimer(router.masterDownInterval())
+	defer timer.Stop()
+
+	router.status = Status{State: StateBackup}
+	onChange(router.status)
+
+	setStatus := func(status Status) {
+		if status == router.status {
+			return
+		}
+
+		router.logger.Info("VRRP status changed", zap.Stringer("state", status.State), zap.Stringer("master", status.Master))
+
+		router.status = status
+		onChange(status)
+	}
+
+	resetTimer := func(d time.Duration) {
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+
+		timer.Reset(d)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			if router.status.State == StateMaster {
+				if err := router.advertise(0); err != nil {
+					router.logger.Warn("failed to send VRRP shutdown advertisement", zap.Error(err))
+				}
+			}
+
+			return nil
+		case <-timer.C:
+			switch router.status.State {
+			case StateBackup:
+				// master is down, take over
+				if err := router.advertise(router.config.Priority); err != nil {
+					router.logger.Warn("failed to send VRRP advertisement", zap.Error(err))
+
+					// can't announce, so stay in the backup state
+					resetTimer(router.masterDownInterval())
+
+					continue
+				}
+
+				local, _ := router.transport.LocalAddr() //nolint:errcheck
+
+				setStatus(Status{State: StateMaster, Master: local})
+				resetTimer(router.config.AdvertisementInterval)
+			case StateMaster:
+				if err := router.advertise(router.config.Priority); err != nil {
+					router.logger.Warn("failed to send VRRP advertisement", zap.Error(err))
+				}
+
+				resetTimer(router.config.AdvertisementInterval)
+			}
+		case received, ok := <-router.transport.Receive():
+			if !ok {
+				return errors.New("VRRP transport closed")
+			}
+
+			adv := received.Advertisement
+
+			if adv.VirtualRouterID != router.config.VirtualRouterID || adv.MaxAdvertInterval == 0 {
+				continue
+			}
+
+			switch router.status.State {
+			case StateBackup:
+				switch {
+				case adv.Priority == 0:
+					// master is shutting down
+					setStatus(Status{State: StateBackup})
+					resetTimer(router.skewTime())
+				case !router.config.Preempt || adv.Priority >= router.config.Priority:
+					router.masterAdvInterval = adv.MaxAdvertInterval
+
+					setStatus(Status{State: StateBackup, Master: received.Source})
+					resetTimer(router.masterDownInterval())
+				default:
+					// lower priority master, let the timer expire to preempt it
+				}
+			case StateMaster:
+				switch {
+				case adv.Priority == 0:
+					// another master is shutting down, assert ourselves
+					if err := router.advertise(router.config.Priority); err != nil {
+						router.logger.Warn("failed to send VRRP advertisement", zap.Error(err))
+					}
+
+					resetTimer(router.config.AdvertisementInterval)
+				case router.yieldsTo(adv.Priority, received.Source):
+					router.masterAdvInterval = adv.MaxAdvertInterval
+
+					setStatus(Status{State: StateBackup, Master: received.Source})
+					resetTimer(router.masterDownInterval())
+				default:
+					// lower priority router, it will become backup after receiving our advertisement
+				}
+			}
+		}
+	}
+}
+
+// yieldsTo returns true if the master should become backup after receiving the advertisement.
+func (router *Router) yieldsTo(priority uint8, source netip.Addr) bool {
+	if priority != router.config.Priority {
+		return priority > router.config.Priority
+	}
+
+	local, err := router.transport.LocalAddr()
+	if err != nil {
+		return true
+	}
+
+	// the router with the higher primary address wins
+	return source.Compare(local) > 0
+}
+
+func (router *Router) advertise(priority uint8) error {
+	return router.transport.Send(&Advertisement{
+		VirtualRouterID:   router.config.VirtualRouterID,
+		Priority:          priority,
+		MaxAdvertInterval: router.config.AdvertisementInterval,
+		Addresses:         router.config.Addresses,
+	})
+}
@@ -0,0 +1,236 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package vrrp_test
+
+import (
+	"context"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/operator/internal/vrrp"
+)
+
+const advertisementInterval = 20 * time.Millisecond
+
+// bus is an in-memory network delivering advertisements to all attached routers.
+type bus struct {
+	mu        sync.Mutex
+	endpoints []*endpoint
+}
+
+func (b *bus) attach(addr string) *endpoint {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ep := &endpoint{
+		bus:       b,
+		addr:      netip.MustParseAddr(addr),
+		receiveCh: make(chan vrrp.Received, 16),
+	}
+
+	b.endpoints = append(b.endpoints, ep)
+
+	return ep
+}
+
+type endpoint struct {
+	bus       *bus
+	addr      netip.Addr
+	receiveCh chan vrrp.Received
+
+	mu   sync.Mutex
+	down bool
+}
+
+func (ep *endpoint) setDown(down bool) {
+	ep.mu.Lock()
+	defer ep.mu.Unlock()
+
+	ep.down = down
+}
+
+func (ep *endpoint) isDown() bool {
+	ep.mu.Lock()
+	defer ep.mu.Unlock()
+
+	return ep.down
+}
+
+func (ep *endpoint) Send(adv *vrrp.Advertisement) error {
+	if ep.isDown() {
+		return nil
+	}
+
+	ep.bus.mu.Lock()
+	defer ep.bus.mu.Unlock()
+
+	for _, other := range ep.bus.endpoints {
+		if other == ep || other.isDown() {
+			continue
+		}
+
+		select {
+		case other.receiveCh <- vrrp.Received{Source: ep.addr, Advertisement: adv}:
+		default:
+		}
+	}
+
+	return nil
+}
+
+func (ep *endpoint) Receive() <-chan vrrp.Received {
+	return ep.receiveCh
+}
+
+func (ep *endpoint) LocalAddr() (netip.Addr, error) {
+	return ep.addr, nil
+}
+
+type testRouter struct {
+	endpoint *endpoint
+	cancel   context.CancelFunc
+	done     chan struct{}
+
+	mu     sync.Mutex
+	status vrrp.Status
+}
+
+func (r *testRouter) Status() vrrp.Status {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.status
+}
+
+func (r *testRouter) Stop() {
+	r.cancel()
+
+	<-r.done
+}
+
+func startRouter(t *testing.T, b *bus, addr string, priority uint8, preempt bool) *testRouter {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(t.Context())
+
+	r := &testRouter{
+		endpoint: b.attach(addr),
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+
+	router := vrrp.NewRouter(zaptest.NewLogger(t), vrrp.Config{
+		VirtualRouterID:       51,
+		Priority:              priority,
+		Preempt:               preempt,
+		AdvertisementInterval: advertisementInterval,
+		Addresses:             []netip.Addr{netip.MustParseAddr("192.168.0.100")},
+	}, r.endpoint)
+
+	go func() {
+		defer close(r.done)
+
+		assert.NoError(t, router.Run(ctx, func(status vrrp.Status) {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+
+			r.status = status
+		}))
+	}()
+
+	t.Cleanup(r.Stop)
+
+	return r
+}
+
+func assertMaster(t *testing.T, master *testRouter, backups ...*testRouter) {
+	t.Helper()
+
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		asrt := assert.New(collect)
+
+		asrt.Equal(vrrp.Status{State: vrrp.StateMaster, Master: master.endpoint.addr}, master.Status())
+
+		for _, backup := range backups {
+			asrt.Equal(vrrp.Status{State: vrrp.StateBackup, Master: master.endpoint.addr}, backup.Status())
+		}
+	}, 2*time.Second, advertisementInterval/2)
+}
+
+func TestElection(t *testing.T) {
+	t.Parallel()
+
+	var b bus
+
+	r1 := startRouter(t, &b, "192.168.0.1", 100, true)
+	r2 := startRouter(t, &b, "192.168.0.2", 200, true)
+	r3 := startRouter(t, &b, "192.168.0.3", 100, true)
+
+	assertMaster(t, r2, r1, r3)
+
+	// graceful shutdown of the master, equal priority: the higher address wins
+	r2.Stop()
+
+	assertMaster(t, r3, r1)
+
+	// master failure
+	r3.endpoint.setDown(true)
+
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		assert.Equal(collect, vrrp.StateMaster, r1.Status().State)
+	}, 2*time.Second, advertisementInterval/2)
+
+	// master is back, it yields to the higher address
+	r3.endpoint.setDown(false)
+
+	assertMaster(t, r3, r1)
+}
+
+func TestPreemption(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name    string
+		preempt bool
+	}{
+		{
+			name:    "preempt",
+			preempt: true,
+		},
+		{
+			name:    "no preempt",
+			preempt: false,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var b bus
+
+			low := startRouter(t, &b, "192.168.0.1", 50, test.preempt)
+
+			assertMaster(t, low)
+
+			high := startRouter(t, &b, "192.168.0.2", 150, test.preempt)
+
+			if test.preempt {
+				assertMaster(t, high, low)
+			} else {
+				assertMaster(t, low, high)
+
+				// stays the backup
+				time.Sleep(10 * advertisementInterval)
+
+				assertMaster(t, low, high)
+			}
+		})
+	}
+}
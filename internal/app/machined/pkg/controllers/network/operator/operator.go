@@ -25,3 +25,13 @@ type Operator interface {
 	ResolverSpecs() []network.ResolverSpecSpec
 	TimeServerSpecs() []network.TimeServerSpecSpec
 }
+
+// VIPStatusReporter is implemented by the operators which manage virtual IPs.
+type VIPStatusReporter interface {
+	VIPStatus() network.VIPStatusSpec
+}
+
+// DelegatedPrefixReporter is implemented by the operators which acquire delegated prefixes.
+type DelegatedPrefixReporter interface {
+	DelegatedPrefixes() []network.DelegatedPrefixSpec
+}
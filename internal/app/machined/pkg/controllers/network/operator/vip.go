@@ -37,11 +37,13 @@ type VIP struct {
 	linkName      string
 	sharedIP      netip.Addr
 	gratuitousARP bool
+	vrrp          network.VIPVRRPSpec
 
 	state state.State
 
 	mu     sync.Mutex
 	leader bool
+	owner  string
 
 	handler vip.Handler
 }
@@ -64,6 +66,7 @@ func NewVIP(logger *zap.Logger, linkName string, spec network.VIPOperatorSpec, s
 		linkName:      linkName,
 		sharedIP:      spec.IP,
 		gratuitousARP: spec.GratuitousARP,
+		vrrp:          spec.VRRP,
 		state:         state,
 		handler:       handler,
 	}
@@ -76,8 +79,14 @@ func (vip *VIP) Prefix() string {
 
 // Run the operator loop.
 func (vip *VIP) Run(ctx context.Context, notifyCh chan<- struct{}) {
+	elect := vip.campaign
+
+	if vip.vrrp != (network.VIPVRRPSpec{}) {
+		elect = vip.runVRRP
+	}
+
 	for {
-		err := vip.campaign(ctx, notifyCh)
+		err := elect(ctx, notifyCh)
 		if err != nil {
 			if !errors.Is(err, context.Canceled) {
 				vip.logger.Warn("campaign failure", zap.Error(err), zap.String("link", vip.linkName), zap.Stringer("ip", vip.sharedIP))
@@ -102,11 +111,9 @@ func (vip *VIP) AddressSpecs() []network.AddressSpecSpec {
 	}
 
 	family := nethelpers.FamilyInet6
-	gratuitousARP := false
 
 	if vip.sharedIP.Is4() {
 		family = nethelpers.FamilyInet4
-		gratuitousARP = vip.gratuitousARP
 	}
 
 	return []network.AddressSpecSpec{
@@ -116,12 +123,32 @@ func (vip *VIP) AddressSpecs() []network.AddressSpecSpec {
 			Family:          family,
 			Scope:           nethelpers.ScopeGlobal,
 			Flags:           nethelpers.AddressFlags(nethelpers.AddressPermanent),
-			AnnounceWithARP: gratuitousARP,
+			AnnounceWithARP: vip.gratuitousARP,
 			ConfigLayer:     network.ConfigOperator,
 		},
 	}
 }
 
+// VIPStatus implements VIPStatusReporter interface.
+func (vip *VIP) VIPStatus() network.VIPStatusSpec {
+	vip.mu.Lock()
+	defer vip.mu.Unlock()
+
+	election := network.VIPElectionEtcd
+
+	if vip.vrrp != (network.VIPVRRPSpec{}) {
+		election = network.VIPElectionVRRP
+	}
+
+	return network.VIPStatusSpec{
+		IP:       vip.sharedIP,
+		LinkName: vip.linkName,
+		Election: election,
+		Leader:   vip.leader,
+		Owner:    vip.owner,
+	}
+}
+
 // LinkSpecs implements Operator interface.
 func (vip *VIP) LinkSpecs() []network.LinkSpecSpec {
 	return nil
@@ -167,8 +194,12 @@ func (vip *VIP) waitForPreconditions(ctx context.Context) error {
 		return fmt.Errorf("etcd health wait failure: %w", err)
 	}
 
+	return vip.waitForKubeletLifecycle(ctx)
+}
+
+func (vip *VIP) waitForKubeletLifecycle(ctx context.Context) error {
 	// wait for the kubelet lifecycle to be up, and not being torn down
-	_, err = vip.state.WatchFor(ctx, resource.NewMetadata(k8s.NamespaceName, k8s.KubeletLifecycleType, k8s.KubeletLifecycleID, resource.VersionUndefined),
+	_, err := vip.state.WatchFor(ctx, resource.NewMetadata(k8s.NamespaceName, k8s.KubeletLifecycleType, k8s.KubeletLifecycleID, resource.VersionUndefined),
 		state.WithCondition(func(r resource.Resource) (bool, error) {
 			if resource.IsTombstone(r) {
 				return false, nil
@@ -255,6 +286,12 @@ func (vip *VIP) campaign(ctx context.Context, notifyCh chan<- struct{}) error {
 		}
 	}
 
+	// observe the current leader while campaigning to report the owner of the shared IP
+	observeCtx, observeCancel := context.WithCancel(ctx)
+	defer observeCancel()
+
+	ownerCh := election.Observe(observeCtx)
+
 	// buffered, as the loop below stops waiting for the campaign on other events, and the
 	// goroutine should not be leaked blocking on the send
 	campaignErrCh := make(chan error, 1)
@@ -289,6 +326,8 @@ campaignLoop:
 			}
 
 			// node won the election campaign!
+			observeCancel()
+
 			break campaignLoop
 		case <-sess.Done():
 			vip.logger.Info("etcd session closed")
@@ -296,6 +335,16 @@ campaignLoop:
 			return nil
 		case <-ctx.Done():
 			return nil
+		case resp, ok := <-ownerCh:
+			if !ok {
+				ownerCh = nil
+
+				continue
+			}
+
+			if err = vip.setOwner(ctx, notifyCh, string(resp.Kvs[0].Value)); err != nil {
+				return err
+			}
 		case event := <-watchCh:
 			// note: here we don't wait for kube-apiserver, as it might not be up on cluster bootstrap, but VIP should be still assigned
 			// break the loop when etcd is stopped
@@ -320,6 +369,10 @@ campaignLoop:
 		election.Resign(resignCtx) //nolint:errcheck
 	}()
 
+	if err = vip.setOwner(ctx, notifyCh, hostname); err != nil {
+		return err
+	}
+
 	if err = vip.markAsLeader(ctx, notifyCh, true); err != nil {
 		return err
 	}
@@ -353,6 +406,8 @@ observeLoop:
 			if string(resp.Kvs[0].Value) != hostname {
 				vip.logger.Info("detected new leader", zap.ByteString("leader", resp.Kvs[0].Value))
 
+				vip.setOwner(ctx, notifyCh, string(resp.Kvs[0].Value)) //nolint:errcheck
+
 				break observeLoop
 			}
 		case event := <-watchCh:
@@ -403,3 +458,27 @@ func (vip *VIP) markAsLeader(ctx context.Context, notifyCh chan<- struct{}, lead
 		return handlerErr
 	}
 }
+
+// setOwner updates the owner of the shared IP, and notifies the controller if it changed.
+func (vip *VIP) setOwner(ctx context.Context, notifyCh chan<- struct{}, owner string) error {
+	changed := func() bool {
+		vip.mu.Lock()
+		defer vip.mu.Unlock()
+
+		changed := vip.owner != owner
+		vip.owner = owner
+
+		return changed
+	}()
+
+	if !changed {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case notifyCh <- struct{}{}:
+		return nil
+	}
+}
@@ -0,0 +1,140 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package operator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/operator/internal/vrrp"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+// runVRRP elects the owner of the shared IP using VRRP.
+//
+// Unlike the etcd election, VRRP doesn't depend on any cluster services, so it can be used on any node.
+//
+//nolint:gocyclo
+func (vip *VIP) runVRRP(ctx context.Context, notifyCh chan<- struct{}) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if err := vip.waitForKubeletLifecycle(ctx); err != nil {
+		return fmt.Errorf("error waiting for preconditions: %w", err)
+	}
+
+	// put a finalizer on the kubelet lifecycle and remove once the shared IP is released
+	kubeletLifecycle := resource.NewMetadata(k8s.NamespaceName, k8s.KubeletLifecycleType, k8s.KubeletLifecycleID, resource.VersionUndefined)
+	if err := vip.state.AddFinalizer(ctx, kubeletLifecycle, vip.Prefix()); err != nil {
+		return fmt.Errorf("error adding kubelet lifecycle finalizer: %w", err)
+	}
+
+	defer func() {
+		vip.state.RemoveFinalizer(ctx, kubeletLifecycle, vip.Prefix()) //nolint:errcheck
+	}()
+
+	watchCh := make(chan state.Event)
+
+	if err := vip.state.Watch(ctx, kubeletLifecycle, watchCh); err != nil {
+		return fmt.Errorf("error setting up kubelet lifecycle watch: %w", err)
+	}
+
+	transport, err := vrrp.NewIPTransport(vip.logger, vip.linkName, []netip.Addr{vip.sharedIP})
+	if err != nil {
+		return fmt.Errorf("error setting up VRRP transport: %w", err)
+	}
+
+	defer transport.Close() //nolint:errcheck
+
+	router := vrrp.NewRouter(vip.logger, vrrp.Config{
+		VirtualRouterID:       vip.vrrp.VirtualRouterID,
+		Priority:              vip.vrrp.Priority,
+		Preempt:               vip.vrrp.Preempt,
+		AdvertisementInterval: vip.vrrp.AdvertisementInterval,
+		Addresses:             []netip.Addr{vip.sharedIP},
+	}, transport)
+
+	defer func() {
+		if err = vip.markAsLeader(ctx, notifyCh, false); err != nil && !errors.Is(err, context.Canceled) {
+			vip.logger.Info("failed disabling shared IP", zap.String("link", vip.linkName), zap.Stringer("ip", vip.sharedIP), zap.Error(err))
+		}
+	}()
+
+	routerCtx, routerCancel := context.WithCancel(ctx)
+	statusCh := make(chan vrrp.Status)
+	routerErrCh := make(chan error, 1)
+
+	go func() {
+		routerErrCh <- router.Run(routerCtx, func(status vrrp.Status) {
+			select {
+			case statusCh <- status:
+			case <-routerCtx.Done():
+			}
+		})
+	}()
+
+	// stop the router first, so that it sends the shutdown advertisement while the shared IP is still assigned
+	defer func() {
+		routerCancel()
+
+		<-routerErrCh
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err = <-routerErrCh:
+			// put the error back, so that the deferred cleanup doesn't block
+			routerErrCh <- err
+
+			return err
+		case status := <-statusCh:
+			owner := ""
+
+			if status.Master.IsValid() {
+				owner = status.Master.String()
+			}
+
+			if err = vip.setOwner(ctx, notifyCh, owner); err != nil {
+				return err
+			}
+
+			leader := status.State == vrrp.StateMaster
+
+			if leader == vip.isLeader() {
+				continue
+			}
+
+			if leader {
+				vip.logger.Info("enabled shared IP", zap.String("link", vip.linkName), zap.Stringer("ip", vip.sharedIP))
+			} else {
+				vip.logger.Info("removing shared IP", zap.String("link", vip.linkName), zap.Stringer("ip", vip.sharedIP))
+			}
+
+			if err = vip.markAsLeader(ctx, notifyCh, leader); err != nil {
+				return err
+			}
+		case event := <-watchCh:
+			// release the shared IP if the kubelet lifecycle is entering teardown phase
+			if event.Resource != nil && event.Resource.Metadata().Phase() == resource.PhaseTearingDown {
+				return nil
+			}
+		}
+	}
+}
+
+func (vip *VIP) isLeader() bool {
+	vip.mu.Lock()
+	defer vip.mu.Unlock()
+
+	return vip.leader
+}
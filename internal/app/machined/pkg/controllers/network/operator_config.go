@@ -268,7 +268,7 @@ func (ctrl *OperatorConfigController) Run(ctx context.Context, r controller.Runt
 			}
 
 			for _, dhcp6 := range cfg.Config().NetworkDHCPv6Configs() {
-				specs = append(specs, network.OperatorSpecSpec{
+				spec := network.OperatorSpecSpec{
 					Operator:  network.OperatorDHCP6,
 					LinkName:  linkNameResolver.Resolve(dhcp6.Name()),
 					RequireUp: true,
@@ -281,7 +281,24 @@ func (ctrl *OperatorConfigController) Run(ctx context.Context, r controller.Runt
 						},
 					},
 					ConfigLayer: network.ConfigMachineConfiguration,
-				})
+				}
+
+				if pd, ok := dhcp6.PrefixDelegation().Get(); ok {
+					spec.DHCP6.PrefixDelegation = network.DHCP6PrefixDelegationSpec{
+						Enabled:      true,
+						PrefixLength: pd.PrefixLength().ValueOrZero(),
+						Downstream: xslices.Map(pd.Downstream(), func(downstream talosconfig.NetworkDHCPv6PrefixDelegationDownstreamConfig) network.DHCP6PrefixDelegationDownstreamSpec {
+							return network.DHCP6PrefixDelegationDownstreamSpec{
+								LinkName:             linkNameResolver.Resolve(downstream.Link()),
+								SubnetID:             downstream.SubnetID(),
+								SubnetLength:         downstream.SubnetLength(),
+								RouterAdvertisements: downstream.RouterAdvertisements(),
+							}
+						}),
+					}
+				}
+
+				specs = append(specs, spec)
 			}
 		}
 
@@ -392,6 +392,16 @@ func (suite *OperatorConfigSuite) TestMachineConfigurationNewStyle() {
 	dhcp2.ConfigRouteMetric = 512
 	dhcp2.ConfigClientIdentifier = new(nethelpers.ClientIdentifierDUID)
 	dhcp2.ConfigDUIDRaw = nethelpers.HardwareAddr{0x00, 0x01, 0x00, 0x01, 0x23, 0x45, 0x67, 0x89, 0xAB, 0xCD, 0xEF, 0x01}
+	dhcp2.PrefixDelegationConfig = &networkcfg.DHCPv6PrefixDelegationConfig{
+		PDPrefixLength: 56,
+		PDDownstream: []networkcfg.DHCPv6PrefixDelegationDownstream{
+			{
+				DownstreamLink:                 "br0",
+				DownstreamSubnetID:             1,
+				DownstreamRouterAdvertisements: true,
+			},
+		},
+	}
 
 	dhcp3 := networkcfg.NewDHCPv4ConfigV1Alpha1("eth23")
 
@@ -440,6 +450,18 @@ func (suite *OperatorConfigSuite) TestMachineConfigurationNewStyle() {
 				asrt.False(r.TypedSpec().DHCP6.SkipHostnameRequest)
 				asrt.Equal(nethelpers.ClientIdentifierDUID, r.TypedSpec().DHCP6.ClientIdentifier.ClientIdentifier)
 				asrt.NotEmpty(r.TypedSpec().DHCP6.ClientIdentifier.DUIDRawHex)
+				asrt.Equal(network.DHCP6PrefixDelegationSpec{
+					Enabled:      true,
+					PrefixLength: 56,
+					Downstream: []network.DHCP6PrefixDelegationDownstreamSpec{
+						{
+							LinkName:             "br0",
+							SubnetID:             1,
+							SubnetLength:         64,
+							RouterAdvertisements: true,
+						},
+					},
+				}, r.TypedSpec().DHCP6.PrefixDelegation)
 			}
 		},
 	)
@@ -53,6 +53,11 @@ func (ctrl *OperatorSpecController) Inputs() []controller.Input {
 			Type:      network.LinkStatusType,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.Dot1XStatusType,
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -83,6 +88,14 @@ func (ctrl *OperatorSpecController) Outputs() []controller.Output {
 			Type: network.TimeServerSpecType,
 			Kind: controller.OutputShared,
 		},
+		{
+			Type: network.VIPStatusType,
+			Kind: controller.OutputExclusive,
+		},
+		{
+			Type: network.DelegatedPrefixType,
+			Kind: controller.OutputExclusive,
+		},
 	}
 }
 
@@ -199,6 +212,20 @@ func (ctrl *OperatorSpecController) reconcileOperators(ctx context.Context, r co
 		linkStatuses[linkStatus.Metadata().ID()] = linkStatus.TypedSpec().OperationalState == nethelpers.OperStateUnknown || linkStatus.TypedSpec().OperationalState == nethelpers.OperStateUp
 	}
 
+	// links with 802.1X port authentication which are not authorized yet
+	unauthorizedLinks := make(map[string]struct{})
+
+	dot1xStatuses, err := safe.ReaderListAll[*network.Dot1XStatus](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing 802.1X statuses: %w", err)
+	}
+
+	for dot1xStatus := range dot1xStatuses.All() {
+		if !dot1xStatus.TypedSpec().Authorized {
+			unauthorizedLinks[dot1xStatus.Metadata().ID()] = struct{}{}
+		}
+	}
+
 	// list operator specs
 	operatorSpecs, err := safe.ReaderListAll[*network.OperatorSpec](ctx, r)
 	if err != nil {
@@ -221,6 +248,11 @@ func (ctrl *OperatorSpecController) reconcileOperators(ctx context.Context, r co
 			continue
 		}
 
+		// link is not authorized by the switch yet, so operator traffic would be dropped
+		if _, unauthorized := unauthorizedLinks[operatorSpec.TypedSpec().LinkName]; unauthorized {
+			continue
+		}
+
 		shouldRun[operatorSpec.Metadata().ID()] = operatorSpec.TypedSpec()
 	}
 
@@ -369,21 +401,57 @@ func (ctrl *OperatorSpecController) reconcileOperatorOutputs(ctx context.Context
 				return fmt.Errorf("error applying spec: %w", err)
 			}
 		}
+
+		if reporter, ok := op.Operator.(operator.VIPStatusReporter); ok {
+			vipStatus := reporter.VIPStatus()
+
+			if err := safe.WriterModify(
+				ctx, r,
+				network.NewVIPStatus(network.NamespaceName, vipStatus.IP.String()),
+				func(r *network.VIPStatus) error {
+					*r.TypedSpec() = vipStatus
+
+					return nil
+				},
+			); err != nil {
+				return fmt.Errorf("error applying status: %w", err)
+			}
+		}
+
+		if reporter, ok := op.Operator.(operator.DelegatedPrefixReporter); ok {
+			for _, delegatedPrefix := range reporter.DelegatedPrefixes() {
+				if err := safe.WriterModify(
+					ctx, r,
+					network.NewDelegatedPrefix(network.NamespaceName, delegatedPrefix.LinkName),
+					func(r *network.DelegatedPrefix) error {
+						*r.TypedSpec() = delegatedPrefix
+
+						return nil
+					},
+				); err != nil {
+					return fmt.Errorf("error applying delegated prefix: %w", err)
+				}
+			}
+		}
 	}
 
 	// clean up not touched specs
 	if err := r.CleanupOutputs(
 		ctx,
-		xslices.Map([]resource.Type{
-			network.AddressSpecType,
-			network.LinkSpecType,
-			network.RouteSpecType,
-			network.HostnameSpecType,
-			network.ResolverSpecType,
-			network.TimeServerSpecType,
-		}, func(t resource.Type) resource.Kind {
-			return resource.NewMetadata(network.ConfigNamespaceName, t, "", resource.VersionUndefined)
-		})...,
+		append(
+			xslices.Map([]resource.Type{
+				network.AddressSpecType,
+				network.LinkSpecType,
+				network.RouteSpecType,
+				network.HostnameSpecType,
+				network.ResolverSpecType,
+				network.TimeServerSpecType,
+			}, func(t resource.Type) resource.Kind {
+				return resource.NewMetadata(network.ConfigNamespaceName, t, "", resource.VersionUndefined)
+			}),
+			resource.NewMetadata(network.NamespaceName, network.VIPStatusType, "", resource.VersionUndefined),
+			resource.NewMetadata(network.NamespaceName, network.DelegatedPrefixType, "", resource.VersionUndefined),
+		)...,
 	); err != nil {
 		return fmt.Errorf("error during outputs cleanup: %w", err)
 	}
@@ -129,10 +129,28 @@ func (mock *mockOperator) TimeServerSpecs() []network.TimeServerSpecSpec {
 	return mock.timeservers
 }
 
+type mockVIPOperator struct {
+	*mockOperator
+}
+
+func (mock mockVIPOperator) VIPStatus() network.VIPStatusSpec {
+	return network.VIPStatusSpec{
+		IP:       mock.spec.VIP.IP,
+		LinkName: mock.spec.LinkName,
+		Election: network.VIPElectionVRRP,
+	}
+}
+
 func (suite *OperatorSpecSuite) newOperator(_ *zap.Logger, spec *network.OperatorSpecSpec) operator.Operator {
-	return &mockOperator{
+	mock := &mockOperator{
 		spec: *spec,
 	}
+
+	if spec.Operator == network.OperatorVIP {
+		return mockVIPOperator{mock}
+	}
+
+	return mock
 }
 
 func (suite *OperatorSpecSuite) assertRunning(runningIDs []string, assertFunc func(*mockOperator) error) error {
@@ -350,6 +368,53 @@ func (suite *OperatorSpecSuite) TestPanic() {
 	)
 }
 
+func (suite *OperatorSpecSuite) TestDot1X() {
+	specDHCP := network.NewOperatorSpec(network.NamespaceName, "dhcp4/eth0")
+	*specDHCP.TypedSpec() = network.OperatorSpecSpec{
+		Operator:  network.OperatorDHCP4,
+		LinkName:  "eth0",
+		RequireUp: true,
+	}
+
+	suite.Create(specDHCP)
+
+	linkState := network.NewLinkStatus(network.NamespaceName, "eth0")
+	*linkState.TypedSpec() = network.LinkStatusSpec{
+		OperationalState: nethelpers.OperStateUp,
+	}
+
+	suite.Create(linkState)
+
+	dot1xStatus := network.NewDot1XStatus(network.NamespaceName, "eth0")
+	dot1xStatus.TypedSpec().State = "authenticating"
+
+	suite.Create(dot1xStatus)
+
+	// the link is not authorized, operator should not be running
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertRunning(nil, func(op *mockOperator) error { return nil })
+			},
+		),
+	)
+
+	ctest.UpdateWithConflicts(suite, dot1xStatus, func(r *network.Dot1XStatus) error {
+		r.TypedSpec().State = "authenticated"
+		r.TypedSpec().Authorized = true
+
+		return nil
+	})
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertRunning([]string{"dhcp4/eth0"}, func(op *mockOperator) error { return nil })
+			},
+		),
+	)
+}
+
 func (suite *OperatorSpecSuite) TestOperatorOutputs() {
 	specDHCP := network.NewOperatorSpec(network.NamespaceName, "dhcp4/eth0")
 	*specDHCP.TypedSpec() = network.OperatorSpecSpec{
@@ -460,6 +525,44 @@ func (suite *OperatorSpecSuite) TestOperatorOutputs() {
 	)
 }
 
+func (suite *OperatorSpecSuite) TestVIPStatus() {
+	specVIP := network.NewOperatorSpec(network.NamespaceName, "vip/eth0")
+	*specVIP.TypedSpec() = network.OperatorSpecSpec{
+		Operator:  network.OperatorVIP,
+		LinkName:  "eth0",
+		RequireUp: true,
+		VIP: network.VIPOperatorSpec{
+			IP: netip.MustParseAddr("1.2.3.4"),
+			VRRP: network.VIPVRRPSpec{
+				VirtualRouterID: 51,
+			},
+		},
+	}
+
+	suite.Create(specVIP)
+
+	linkState := network.NewLinkStatus(network.NamespaceName, "eth0")
+	*linkState.TypedSpec() = network.LinkStatusSpec{
+		OperationalState: nethelpers.OperStateUp,
+	}
+
+	suite.Create(linkState)
+
+	ctest.AssertResource(suite, "1.2.3.4", func(r *network.VIPStatus, asrt *assert.Assertions) {
+		asrt.Equal("eth0", r.TypedSpec().LinkName)
+		asrt.Equal(network.VIPElectionVRRP, r.TypedSpec().Election)
+	})
+
+	// bring down the interface, operator should be stopped, and status removed
+	ctest.UpdateWithConflicts(suite, linkState, func(r *network.LinkStatus) error {
+		r.TypedSpec().OperationalState = nethelpers.OperStateDown
+
+		return nil
+	})
+
+	ctest.AssertNoResource[*network.VIPStatus](suite, "1.2.3.4")
+}
+
 func TestOperatorSpecSuite(t *testing.T) {
 	t.Parallel()
 
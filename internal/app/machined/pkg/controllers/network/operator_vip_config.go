@@ -262,6 +262,15 @@ func (ctrl *OperatorVIPConfigController) handleVIPConfigDoc(ctx context.Context,
 		if err := vip.GetNetworkAndDeviceIDs(ctx, &spec.VIP.HCloud, cfg.VIP(), logger); err != nil {
 			return network.OperatorSpecSpec{}, err
 		}
+	case talosconfig.NetworkLayer2VIPConfig:
+		if vrrp, ok := v.VRRP().Get(); ok {
+			spec.VIP.VRRP = network.VIPVRRPSpec{
+				VirtualRouterID:       vrrp.VirtualRouterID(),
+				Priority:              vrrp.Priority(),
+				Preempt:               vrrp.Preempt(),
+				AdvertisementInterval: vrrp.AdvertisementInterval(),
+			}
+		}
 	default:
 		// nothing to do
 	}
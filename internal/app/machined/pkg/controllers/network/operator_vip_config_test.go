@@ -169,6 +169,10 @@ func (suite *OperatorVIPConfigSuite) TestMachineConfigurationVIP() {
 
 	vip2 := networkcfg.NewLayer2VIPConfigV1Alpha1("fd7a:115c:a1e0:ab12:4843:cd96:6277:2302")
 	vip2.LinkName = "enxa"
+	vip2.VRRPConfig = &networkcfg.Layer2VIPVRRPConfig{
+		VRRPVirtualRouterID: 51,
+		VRRPPriority:        150,
+	}
 
 	ctr, err := container.New(vip1, vip2)
 	suite.Require().NoError(err)
@@ -188,12 +192,19 @@ func (suite *OperatorVIPConfigSuite) TestMachineConfigurationVIP() {
 			case "configuration/vip/eth33/2.3.4.5":
 				asrt.Equal("eth33", r.TypedSpec().LinkName)
 				asrt.EqualValues(netip.MustParseAddr("2.3.4.5"), r.TypedSpec().VIP.IP)
+				asrt.Equal(network.VIPVRRPSpec{}, r.TypedSpec().VIP.VRRP)
 			case "configuration/vip/eth5/fd7a:115c:a1e0:ab12:4843:cd96:6277:2302":
 				asrt.Equal("eth5", r.TypedSpec().LinkName)
 				asrt.EqualValues(
 					netip.MustParseAddr("fd7a:115c:a1e0:ab12:4843:cd96:6277:2302"),
 					r.TypedSpec().VIP.IP,
 				)
+				asrt.Equal(network.VIPVRRPSpec{
+					VirtualRouterID:       51,
+					Priority:              150,
+					Preempt:               true,
+					AdvertisementInterval: time.Second,
+				}, r.TypedSpec().VIP.VRRP)
 			}
 		},
 	)
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"net"
 	"net/netip"
+	"slices"
 	"sort"
 	"time"
 
@@ -23,9 +24,12 @@ import (
 	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 )
 
-// raInterval is how often Router Advertisements are emitted on each unnumbered link.
+// raInterval is how often Router Advertisements are emitted on each link.
 const raInterval = 10 * time.Second
 
+// raRouterLifetime is the router lifetime advertised on the links with delegated subnets.
+const raRouterLifetime = 3 * raInterval
+
 var allNodesMulticast = netip.MustParseAddr("ff02::1")
 
 // RouterAdvertisementController sends IPv6 Router Advertisements on links used for unnumbered
@@ -34,15 +38,36 @@ var allNodesMulticast = netip.MustParseAddr("ff02::1")
 //
 // It also enables net.ipv6.conf.<iface>.accept_ra=2 on those links (accept RAs while forwarding)
 // so this node learns the neighbor's link-local in return.
+//
+// On the downstream links which got a subnet of the DHCPv6 delegated prefix, the node advertises itself
+// as the default router and announces the subnet for SLAAC.
 type RouterAdvertisementController struct {
 	senders map[string]raSender
 }
 
 type raSender struct {
+	config raConfig
 	cancel context.CancelFunc
 	done   chan struct{}
 }
 
+// raConfig describes the Router Advertisements sent on a link.
+type raConfig struct {
+	// unnumbered is set for the links used for unnumbered BGP peering.
+	unnumbered bool
+	prefixes   []raPrefix
+}
+
+type raPrefix struct {
+	prefix            netip.Prefix
+	preferredLifetime time.Duration
+	validLifetime     time.Duration
+}
+
+func (config raConfig) equal(other raConfig) bool {
+	return config.unnumbered == other.unnumbered && slices.Equal(config.prefixes, other.prefixes)
+}
+
 func (sender raSender) stop() {
 	sender.cancel()
 	<-sender.done
@@ -61,6 +86,11 @@ func (ctrl *RouterAdvertisementController) Inputs() []controller.Input {
 			Type:      network.BGPInstanceConfigType,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.DelegatedPrefixType,
+			Kind:      controller.InputWeak,
+		},
 		{
 			Namespace: network.NamespaceName,
 			Type:      network.LinkStatusType,
@@ -119,6 +149,11 @@ func (ctrl *RouterAdvertisementController) reconcile(ctx context.Context, r cont
 		return fmt.Errorf("error listing BGP instance configs: %w", err)
 	}
 
+	delegatedPrefixes, err := safe.ReaderListAll[*network.DelegatedPrefix](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing delegated prefixes: %w", err)
+	}
+
 	linkStatuses, err := safe.ReaderListAll[*network.LinkStatus](ctx, r)
 	if err != nil {
 		return fmt.Errorf("error listing link statuses: %w", err)
@@ -131,32 +166,59 @@ func (ctrl *RouterAdvertisementController) reconcile(ctx context.Context, r cont
 		readyLinks[linkStatus.Metadata().ID()] = struct{}{}
 	}
 
-	interfaces := map[string]struct{}{}
+	interfaces := map[string]raConfig{}
+	unnumbered := map[string]struct{}{}
 
 	for configResource := range configResources.All() {
 		for _, neighbor := range configResource.TypedSpec().Neighbors {
 			link := linkResolver.Resolve(neighbor.Link)
 			if _, ready := readyLinks[link]; ready {
-				interfaces[link] = struct{}{}
+				config := interfaces[link]
+				config.unnumbered = true
+				interfaces[link] = config
+
+				unnumbered[link] = struct{}{}
 			}
 		}
 	}
 
-	// reconcile RA-sender goroutines: start for new interfaces, stop for removed ones.
+	for delegatedPrefix := range delegatedPrefixes.All() {
+		for _, downstream := range delegatedPrefix.TypedSpec().Downstream {
+			if !downstream.RouterAdvertisements {
+				continue
+			}
+
+			link := linkResolver.Resolve(downstream.LinkName)
+			if _, ready := readyLinks[link]; !ready {
+				continue
+			}
+
+			config := interfaces[link]
+			config.prefixes = append(config.prefixes, raPrefix{
+				prefix:            downstream.Subnet,
+				preferredLifetime: min(delegatedPrefix.TypedSpec().PreferredLifetime, delegatedPrefix.TypedSpec().ValidLifetime),
+				validLifetime:     delegatedPrefix.TypedSpec().ValidLifetime,
+			})
+			interfaces[link] = config
+		}
+	}
+
+	// reconcile RA-sender goroutines: start for new interfaces, restart for changed ones, stop for removed ones.
 	for iface, sender := range ctrl.senders {
-		if _, ok := interfaces[iface]; !ok {
+		if config, ok := interfaces[iface]; !ok || !config.equal(sender.config) {
 			sender.stop()
 			delete(ctrl.senders, iface)
 		}
 	}
 
-	for iface := range interfaces {
+	for iface, config := range interfaces {
 		if _, ok := ctrl.senders[iface]; ok {
 			continue
 		}
 
 		senderCtx, cancel := context.WithCancel(ctx)
 		sender := raSender{
+			config: config,
 			cancel: cancel,
 			done:   make(chan struct{}),
 		}
@@ -167,7 +229,7 @@ func (ctrl *RouterAdvertisementController) reconcile(ctx context.Context, r cont
 			defer close(sender.done)
 
 			if err := panicsafe.Run(func() {
-				ctrl.runSender(senderCtx, iface, logger)
+				ctrl.runSender(senderCtx, iface, config, logger)
 			}); err != nil {
 				logger.Error("router advertisement sender panicked", zap.String("interface", iface), zap.Error(err))
 			}
@@ -177,7 +239,7 @@ func (ctrl *RouterAdvertisementController) reconcile(ctx context.Context, r cont
 	// enable accept_ra=2 on each unnumbered interface so we learn the neighbor's link-local.
 	r.StartTrackingOutputs()
 
-	for _, iface := range sortedKeys(interfaces) {
+	for _, iface := range sortedKeys(unnumbered) {
 		id := kernel.Sysctl + "." + fmt.Sprintf("net/ipv6/conf/%s/accept_ra", iface)
 
 		if err = safe.WriterModify(ctx, r, runtimeres.NewKernelParamDefaultSpec(runtimeres.NamespaceName, id), func(spec *runtimeres.KernelParamDefaultSpec) error {
@@ -194,12 +256,12 @@ func (ctrl *RouterAdvertisementController) reconcile(ctx context.Context, r cont
 }
 
 // runSender periodically emits Router Advertisements on the given interface until the context is done.
-func (ctrl *RouterAdvertisementController) runSender(ctx context.Context, iface string, logger *zap.Logger) {
+func (ctrl *RouterAdvertisementController) runSender(ctx context.Context, iface string, config raConfig, logger *zap.Logger) {
 	ticker := time.NewTicker(raInterval)
 	defer ticker.Stop()
 
 	for {
-		if err := ctrl.sendOnce(iface); err != nil {
+		if err := ctrl.sendOnce(iface, config); err != nil {
 			logger.Debug("failed to send router advertisement", zap.String("interface", iface), zap.Error(err))
 		}
 
@@ -211,7 +273,7 @@ func (ctrl *RouterAdvertisementController) runSender(ctx context.Context, iface
 	}
 }
 
-func (ctrl *RouterAdvertisementController) sendOnce(iface string) error {
+func (ctrl *RouterAdvertisementController) sendOnce(iface string, config raConfig) error {
 	ifi, err := net.InterfaceByName(iface)
 	if err != nil {
 		return err
@@ -238,6 +300,23 @@ func (ctrl *RouterAdvertisementController) sendOnce(iface string) error {
 		},
 	}
 
+	// On links with delegated subnets, the node is the default router for the hosts attached to the link.
+	if len(config.prefixes) > 0 {
+		ra.RouterLifetime = raRouterLifetime
+	}
+
+	for _, prefix := range config.prefixes {
+		ra.Options = append(ra.Options, &ndp.PrefixInformation{
+			PrefixLength: uint8(prefix.prefix.Bits()),
+			OnLink:       true,
+			// SLAAC requires /64 prefixes
+			AutonomousAddressConfiguration: prefix.prefix.Bits() == 64,
+			ValidLifetime:                  prefix.validLifetime,
+			PreferredLifetime:              prefix.preferredLifetime,
+			Prefix:                         prefix.prefix.Addr(),
+		})
+	}
+
 	return conn.WriteTo(ra, nil, allNodesMulticast)
 }
 
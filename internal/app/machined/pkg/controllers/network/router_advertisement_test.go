@@ -5,6 +5,7 @@
 package network_test
 
 import (
+	"net/netip"
 	"testing"
 	"time"
 
@@ -49,6 +50,45 @@ func (suite *RouterAdvertisementControllerSuite) TestResolvesLinkAliasAndCleansU
 	)
 }
 
+func (suite *RouterAdvertisementControllerSuite) TestDelegatedPrefixDoesNotAcceptRA() {
+	config := network.NewBGPInstanceConfig("fabric")
+	config.TypedSpec().Neighbors = []network.BGPNeighborConfigSpec{{Link: "eth0"}}
+	suite.Create(config)
+
+	delegatedPrefix := network.NewDelegatedPrefix(network.NamespaceName, "eth0")
+	delegatedPrefix.TypedSpec().LinkName = "eth0"
+	delegatedPrefix.TypedSpec().Prefix = netip.MustParsePrefix("2001:db8:0:100::/56")
+	delegatedPrefix.TypedSpec().ValidLifetime = time.Hour
+	delegatedPrefix.TypedSpec().PreferredLifetime = time.Hour
+	delegatedPrefix.TypedSpec().Downstream = []network.DelegatedPrefixDownstreamSpec{
+		{
+			LinkName:             "br0",
+			Subnet:               netip.MustParsePrefix("2001:db8:0:101::/64"),
+			Address:              netip.MustParsePrefix("2001:db8:0:101::1/64"),
+			RouterAdvertisements: true,
+		},
+	}
+	suite.Create(delegatedPrefix)
+
+	for _, name := range []string{"eth0", "br0"} {
+		suite.Create(network.NewLinkStatus(network.NamespaceName, name))
+	}
+
+	// only the unnumbered link accepts RAs, the downstream link is the one sending them
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), kernel.Sysctl+".net/ipv6/conf/eth0/accept_ra",
+		func(res *runtimeres.KernelParamDefaultSpec, assertions *assert.Assertions) {
+			assertions.Equal("2", res.TypedSpec().Value)
+		}, rtestutils.WithNamespace(runtimeres.NamespaceName))
+
+	rtestutils.AssertNoResource[*runtimeres.KernelParamDefaultSpec](
+		suite.Ctx(),
+		suite.T(),
+		suite.State(),
+		kernel.Sysctl+".net/ipv6/conf/br0/accept_ra",
+		rtestutils.WithNamespace(runtimeres.NamespaceName),
+	)
+}
+
 func TestRouterAdvertisementControllerSuite(t *testing.T) {
 	t.Parallel()
 
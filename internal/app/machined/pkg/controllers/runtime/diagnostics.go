@@ -14,6 +14,7 @@ import (
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime/internal/diagnostics"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/etcd"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
@@ -51,6 +52,11 @@ func (ctrl *DiagnosticsController) Inputs() []controller.Input {
 			Type:      k8s.NodenameType,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: etcd.NamespaceName,
+			Type:      etcd.MaintenanceStatusType,
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -0,0 +1,473 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	_ "time/tzdata" // maintenance windows might be configured in any timezone, while the rootfs has no zoneinfo
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/talos/internal/pkg/disruption"
+	"github.com/siderolabs/talos/pkg/grpc/middleware/auth/basic"
+	securityapi "github.com/siderolabs/talos/pkg/machinery/api/security"
+	"github.com/siderolabs/talos/pkg/machinery/client/resolver"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+// DisruptionPollInterval is the interval between the checks of the maintenance windows and the cluster-wide queue.
+const DisruptionPollInterval = 15 * time.Second
+
+// DisruptionLockBackend coordinates the disruption lock slots across the cluster.
+type DisruptionLockBackend interface {
+	Acquire(ctx context.Context, req disruption.Request, lockTTL time.Duration) (disruption.Status, error)
+	Release(ctx context.Context, node, address string) error
+}
+
+// DisruptionController queues the disruptive operations according to the maintenance policy.
+//
+// The operations wait for the maintenance window to open, and for a slot of the cluster-wide lock.
+// Control plane nodes coordinate the lock via etcd, worker nodes go through trustd on the control plane nodes.
+type DisruptionController struct {
+	// Backend overrides the lock backend selected by the machine type.
+	Backend DisruptionLockBackend
+
+	// held is set if the node might hold the lock slot
+	held bool
+	// granted is set if the current operations were granted the slot
+	granted bool
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *DisruptionController) Name() string {
+	return "runtime.DisruptionController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *DisruptionController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.ActiveID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineTypeType,
+			ID:        optional.Some(config.MachineTypeID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.DisruptionRequestType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.MachineStatusType,
+			ID:        optional.Some(runtime.MachineStatusID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: k8s.NamespaceName,
+			Type:      k8s.NodenameType,
+			ID:        optional.Some(k8s.NodenameID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: k8s.NamespaceName,
+			Type:      k8s.NodeLabelSpecType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: k8s.ControlPlaneNamespaceName,
+			Type:      k8s.EndpointType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.OSRootType,
+			ID:        optional.Some(secrets.OSRootID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *DisruptionController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtime.DisruptionStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *DisruptionController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	ticker := time.NewTicker(DisruptionPollInterval)
+	defer ticker.Stop()
+
+	// the slot might be still held from the operation which caused the reboot, it is released once the machine is ready
+	ctrl.held = true
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		requests, err := safe.ReaderListAll[*runtime.DisruptionRequest](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing disruption requests: %w", err)
+		}
+
+		var (
+			operations  []string
+			requestedAt time.Time
+		)
+
+		for req := range requests.All() {
+			operations = append(operations, req.TypedSpec().Operation)
+
+			if requestedAt.IsZero() || req.TypedSpec().RequestedAt.Before(requestedAt) {
+				requestedAt = req.TypedSpec().RequestedAt
+			}
+		}
+
+		slices.Sort(operations)
+
+		var policy talosconfig.MaintenancePolicyConfig
+
+		if cfg != nil {
+			policy = cfg.Config().MaintenancePolicyConfig()
+		}
+
+		status := runtime.DisruptionStatusSpec{
+			State:      runtime.DisruptionStateIdle,
+			Operations: operations,
+		}
+
+		if len(operations) == 0 {
+			ctrl.granted = false
+		}
+
+		if policy == nil {
+			// no policy, nothing to wait for
+			if len(operations) > 0 {
+				status.State = runtime.DisruptionStateGranted
+			}
+		} else if err = ctrl.reconcile(ctx, r, logger, policy, &status, requestedAt); err != nil {
+			return err
+		}
+
+		if err = safe.WriterModify(ctx, r, runtime.NewDisruptionStatus(), func(res *runtime.DisruptionStatus) error {
+			*res.TypedSpec() = status
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating disruption status: %w", err)
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+//nolint:gocyclo
+func (ctrl *DisruptionController) reconcile(
+	ctx context.Context, r controller.Reader, logger *zap.Logger,
+	policy talosconfig.MaintenancePolicyConfig, status *runtime.DisruptionStatusSpec, requestedAt time.Time,
+) error {
+	if len(status.Operations) > 0 {
+		status.State = runtime.DisruptionStateWaitingLock
+	}
+
+	machineType, err := safe.ReaderGetByID[*config.MachineType](ctx, r, config.MachineTypeID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil
+		}
+
+		return fmt.Errorf("error getting machine type: %w", err)
+	}
+
+	nodename, err := safe.ReaderGetByID[*k8s.Nodename](ctx, r, k8s.NodenameID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			// the node identity in the cluster is not known yet
+			return nil
+		}
+
+		return fmt.Errorf("error getting nodename: %w", err)
+	}
+
+	node := nodename.TypedSpec().Nodename
+
+	backend, err := ctrl.backend(ctx, r, logger, machineType.MachineType().IsControlPlane())
+	if err != nil {
+		return err
+	}
+
+	if backend == nil {
+		status.Error = "waiting for the control plane endpoints"
+
+		return nil
+	}
+
+	if len(status.Operations) == 0 {
+		if !ctrl.held {
+			return nil
+		}
+
+		machineStatus, err := safe.ReaderGetByID[*runtime.MachineStatus](ctx, r, runtime.MachineStatusID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine status: %w", err)
+		}
+
+		// the node is considered disrupted until it is back up
+		if machineStatus == nil || machineStatus.TypedSpec().Stage != runtime.MachineStageRunning || !machineStatus.TypedSpec().Status.Ready {
+			return nil
+		}
+
+		if err = backend.Release(ctx, node, ""); err != nil {
+			logger.Warn("error releasing disruption lock slot", zap.Error(err))
+
+			status.Error = err.Error()
+
+			return nil
+		}
+
+		ctrl.held = false
+
+		return nil
+	}
+
+	now := time.Now()
+
+	// once granted, the operations proceed even if the window closes in the meantime
+	if !ctrl.granted && !policy.InMaintenanceWindow(now) {
+		status.State = runtime.DisruptionStateWaitingWindow
+		status.NextWindow = policy.NextMaintenanceWindow(now)
+
+		return nil
+	}
+
+	labelSpecs, err := safe.ReaderListAll[*k8s.NodeLabelSpec](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing node labels: %w", err)
+	}
+
+	labels := map[string]string{}
+
+	for labelSpec := range labelSpecs.All() {
+		labels[labelSpec.TypedSpec().Key] = labelSpec.TypedSpec().Value
+	}
+
+	lockStatus, err := backend.Acquire(ctx, disruption.Request{
+		Node:         node,
+		ControlPlane: machineType.MachineType().IsControlPlane(),
+		Labels:       labels,
+		Operation:    strings.Join(status.Operations, ","),
+		RequestedAt:  requestedAt,
+		Limits: disruption.Limits{
+			MaxNodes:             policy.MaxNodes(),
+			MaxControlPlaneNodes: policy.MaxControlPlaneNodes(),
+			Labels:               policy.LabelLimits(),
+		},
+	}, policy.LockTTL())
+	if err != nil {
+		logger.Warn("error acquiring disruption lock slot", zap.Error(err))
+
+		status.Error = err.Error()
+
+		return nil
+	}
+
+	status.Holders = lockStatus.Holders
+
+	if !lockStatus.Granted {
+		status.QueuePosition = lockStatus.QueuePosition
+
+		return nil
+	}
+
+	if !ctrl.granted {
+		logger.Info("disruption lock slot granted", zap.Strings("operations", status.Operations))
+	}
+
+	status.State = runtime.DisruptionStateGranted
+
+	ctrl.held = true
+	ctrl.granted = true
+
+	return nil
+}
+
+// backend returns the lock backend for the node.
+//
+// The nil backend is returned if the worker node doesn't know the control plane endpoints yet.
+func (ctrl *DisruptionController) backend(ctx context.Context, r controller.Reader, logger *zap.Logger, controlPlane bool) (DisruptionLockBackend, error) {
+	if ctrl.Backend != nil {
+		return ctrl.Backend, nil
+	}
+
+	if controlPlane {
+		return &etcdDisruptionBackend{logger: logger}, nil
+	}
+
+	endpointResources, err := safe.ReaderList[*k8s.Endpoint](ctx, r, resource.NewMetadata(k8s.ControlPlaneNamespaceName, k8s.EndpointType, "", resource.VersionUndefined))
+	if err != nil {
+		return nil, fmt.Errorf("error getting endpoints resources: %w", err)
+	}
+
+	var endpointAddrs k8s.EndpointList
+
+	for res := range endpointResources.All() {
+		endpointAddrs = endpointAddrs.Merge(res)
+	}
+
+	if endpointAddrs.IsEmpty() {
+		return nil, nil
+	}
+
+	osRoot, err := safe.ReaderGetByID[*secrets.OSRoot](ctx, r, secrets.OSRootID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("error getting OS root: %w", err)
+	}
+
+	return &trustdDisruptionBackend{
+		endpoints:   endpointAddrs.Strings(),
+		token:       osRoot.TypedSpec().Token,
+		acceptedCAs: osRoot.TypedSpec().AcceptedCAs,
+	}, nil
+}
+
+// etcdDisruptionBackend coordinates the lock via the local etcd member.
+type etcdDisruptionBackend struct {
+	logger *zap.Logger
+}
+
+func (backend *etcdDisruptionBackend) Acquire(ctx context.Context, req disruption.Request, lockTTL time.Duration) (disruption.Status, error) {
+	return disruption.Acquire(ctx, backend.logger, req, lockTTL)
+}
+
+func (backend *etcdDisruptionBackend) Release(ctx context.Context, node, address string) error {
+	return disruption.Release(ctx, backend.logger, node, address)
+}
+
+// trustdDisruptionBackend coordinates the lock via trustd running on the control plane nodes.
+type trustdDisruptionBackend struct {
+	endpoints   []string
+	token       string
+	acceptedCAs []*x509.PEMEncodedCertificate
+}
+
+const trustdDisruptionTimeout = 10 * time.Second
+
+func (backend *trustdDisruptionBackend) Acquire(ctx context.Context, req disruption.Request, lockTTL time.Duration) (disruption.Status, error) {
+	labelLimits := make(map[string]int64, len(req.Limits.Labels))
+
+	for key, maxNodes := range req.Limits.Labels {
+		labelLimits[key] = int64(maxNodes)
+	}
+
+	resp, err := backend.call(ctx, &securityapi.DisruptionLockRequest{
+		Node:         req.Node,
+		ControlPlane: req.ControlPlane,
+		Labels:       req.Labels,
+		Operation:    req.Operation,
+		RequestedAt:  timestamppb.New(req.RequestedAt),
+		Limits: &securityapi.DisruptionLimits{
+			MaxNodes:             int64(req.Limits.MaxNodes),
+			MaxControlPlaneNodes: int64(req.Limits.MaxControlPlaneNodes),
+			Labels:               labelLimits,
+		},
+		LockTtl: durationpb.New(lockTTL),
+	})
+	if err != nil {
+		return disruption.Status{}, err
+	}
+
+	if resp.GetError() != "" {
+		return disruption.Status{}, errors.New(resp.GetError())
+	}
+
+	// pending request is reported as queued with unknown position
+	return disruption.Status{
+		Granted:       resp.GetGranted(),
+		QueuePosition: int(resp.GetQueuePosition()),
+		Holders:       resp.GetHolders(),
+	}, nil
+}
+
+func (backend *trustdDisruptionBackend) Release(ctx context.Context, node, _ string) error {
+	resp, err := backend.call(ctx, &securityapi.DisruptionLockRequest{
+		Node:        node,
+		RequestedAt: timestamppb.Now(),
+		Release:     true,
+	})
+	if err != nil {
+		return err
+	}
+
+	if resp.GetError() != "" {
+		return errors.New(resp.GetError())
+	}
+
+	return nil
+}
+
+func (backend *trustdDisruptionBackend) call(ctx context.Context, req *securityapi.DisruptionLockRequest) (*securityapi.DisruptionLockResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, trustdDisruptionTimeout)
+	defer cancel()
+
+	endpoints := resolver.EnsureEndpointsHavePorts(backend.endpoints, constants.TrustdPort)
+
+	conn, err := basic.NewConnection(fmt.Sprintf("%s:///%s", resolver.RoundRobinResolverScheme, strings.Join(endpoints, ",")), basic.NewTokenCredentials(backend.token), backend.acceptedCAs)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to trustd: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	resp, err := securityapi.NewSecurityServiceClient(conn).DisruptionLock(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("error requesting disruption lock slot: %w", err)
+	}
+
+	return resp, nil
+}
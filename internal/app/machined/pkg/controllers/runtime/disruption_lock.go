@@ -0,0 +1,208 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/pkg/disruption"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// DisruptionLockController processes the disruption lock requests of the worker nodes on the control plane nodes.
+//
+// The requests are recorded by trustd as DisruptionLockRequest resources, and the results are published as DisruptionLockStatus resources
+// which trustd returns to the worker nodes.
+// The release requests are removed by trustd once the release is reported in the DisruptionLockStatus.
+type DisruptionLockController struct {
+	// Backend overrides the etcd lock backend.
+	Backend DisruptionLockBackend
+
+	processed map[resource.ID]processedDisruptionLockRequest
+}
+
+type processedDisruptionLockRequest struct {
+	version resource.Version
+	status  *runtime.DisruptionLockStatusSpec
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *DisruptionLockController) Name() string {
+	return "runtime.DisruptionLockController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *DisruptionLockController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineTypeType,
+			ID:        optional.Some(config.MachineTypeID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.DisruptionLockRequestType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *DisruptionLockController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtime.DisruptionLockStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *DisruptionLockController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// stale requests are expired on the ticker
+	ticker := time.NewTicker(disruption.QueueTTL / 4)
+	defer ticker.Stop()
+
+	ctrl.processed = map[resource.ID]processedDisruptionLockRequest{}
+
+	backend := ctrl.Backend
+	if backend == nil {
+		backend = &etcdDisruptionBackend{logger: logger}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		machineType, err := safe.ReaderGetByID[*config.MachineType](ctx, r, config.MachineTypeID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine type: %w", err)
+		}
+
+		requests, err := safe.ReaderListAll[*runtime.DisruptionLockRequest](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing disruption lock requests: %w", err)
+		}
+
+		r.StartTrackingOutputs()
+
+		touched := map[resource.ID]struct{}{}
+
+		for req := range requests.All() {
+			if machineType == nil || !machineType.MachineType().IsControlPlane() {
+				break
+			}
+
+			spec := req.TypedSpec()
+
+			// the nodes refresh the requests while waiting, the requests which are not refreshed are ignored
+			if time.Since(spec.RefreshedAt) > disruption.QueueTTL {
+				continue
+			}
+
+			touched[req.Metadata().ID()] = struct{}{}
+
+			processed, ok := ctrl.processed[req.Metadata().ID()]
+
+			if !ok || !processed.version.Equal(req.Metadata().Version()) {
+				processed = processedDisruptionLockRequest{
+					version: req.Metadata().Version(),
+					status:  ctrl.process(ctx, logger, backend, spec),
+				}
+
+				ctrl.processed[req.Metadata().ID()] = processed
+			}
+
+			if err = safe.WriterModify(ctx, r, runtime.NewDisruptionLockStatus(req.Metadata().ID()), func(res *runtime.DisruptionLockStatus) error {
+				*res.TypedSpec() = *processed.status
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating disruption lock status: %w", err)
+			}
+		}
+
+		for id := range ctrl.processed {
+			if _, ok := touched[id]; !ok {
+				delete(ctrl.processed, id)
+			}
+		}
+
+		if err = safe.CleanupOutputs[*runtime.DisruptionLockStatus](ctx, r); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// process handles the request against the lock backend.
+func (ctrl *DisruptionLockController) process(
+	ctx context.Context, logger *zap.Logger, backend DisruptionLockBackend, spec *runtime.DisruptionLockRequestSpec,
+) *runtime.DisruptionLockStatusSpec {
+	status := &runtime.DisruptionLockStatusSpec{
+		RequestedAt: spec.RequestedAt,
+	}
+
+	if spec.Release {
+		if err := backend.Release(ctx, spec.Node, spec.Address); err != nil {
+			logger.Warn("error releasing disruption lock slot", zap.String("node", spec.Node), zap.Error(err))
+
+			status.Error = err.Error()
+		}
+
+		return status
+	}
+
+	labelLimits := make(map[string]int, len(spec.LabelLimits))
+
+	for _, limit := range spec.LabelLimits {
+		labelLimits[limit.Key] = limit.MaxNodes
+	}
+
+	lockStatus, err := backend.Acquire(ctx, disruption.Request{
+		Node:         spec.Node,
+		ControlPlane: spec.ControlPlane,
+		Labels:       spec.Labels,
+		Operation:    spec.Operation,
+		RequestedAt:  spec.RequestedAt,
+		Limits: disruption.Limits{
+			MaxNodes:             spec.MaxNodes,
+			MaxControlPlaneNodes: spec.MaxControlPlaneNodes,
+			Labels:               labelLimits,
+		},
+		Address: spec.Address,
+	}, spec.LockTTL)
+	if err != nil {
+		logger.Warn("error acquiring disruption lock slot", zap.String("node", spec.Node), zap.Error(err))
+
+		status.Error = err.Error()
+
+		return status
+	}
+
+	status.Granted = lockStatus.Granted
+	status.QueuePosition = lockStatus.QueuePosition
+	status.Holders = lockStatus.Holders
+
+	return status
+}
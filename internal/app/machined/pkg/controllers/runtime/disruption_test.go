@@ -0,0 +1,228 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	runtimectrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/internal/pkg/disruption"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	runtimecfg "github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+type mockDisruptionBackend struct {
+	mu sync.Mutex
+
+	status   disruption.Status
+	requests []disruption.Request
+	released []string
+}
+
+func (backend *mockDisruptionBackend) Acquire(_ context.Context, req disruption.Request, _ time.Duration) (disruption.Status, error) {
+	backend.mu.Lock()
+	defer backend.mu.Unlock()
+
+	backend.requests = append(backend.requests, req)
+
+	return backend.status, nil
+}
+
+func (backend *mockDisruptionBackend) Release(_ context.Context, node, _ string) error {
+	backend.mu.Lock()
+	defer backend.mu.Unlock()
+
+	backend.released = append(backend.released, node)
+
+	return nil
+}
+
+func (backend *mockDisruptionBackend) setStatus(status disruption.Status) {
+	backend.mu.Lock()
+	defer backend.mu.Unlock()
+
+	backend.status = status
+}
+
+func (backend *mockDisruptionBackend) lastRequest() (disruption.Request, int) {
+	backend.mu.Lock()
+	defer backend.mu.Unlock()
+
+	if len(backend.requests) == 0 {
+		return disruption.Request{}, 0
+	}
+
+	return backend.requests[len(backend.requests)-1], len(backend.requests)
+}
+
+func (backend *mockDisruptionBackend) releasedNodes() []string {
+	backend.mu.Lock()
+	defer backend.mu.Unlock()
+
+	return append([]string(nil), backend.released...)
+}
+
+type DisruptionSuite struct {
+	ctest.DefaultSuite
+
+	backend *mockDisruptionBackend
+}
+
+func TestDisruptionSuite(t *testing.T) {
+	s := &DisruptionSuite{}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 10 * time.Second,
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			s.backend = &mockDisruptionBackend{}
+
+			suite.Require().NoError(suite.Runtime().RegisterController(&runtimectrl.DisruptionController{
+				Backend: s.backend,
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+func (suite *DisruptionSuite) setup(policy *runtimecfg.MaintenancePolicyV1Alpha1) {
+	cfg, err := container.New(policy)
+	suite.Require().NoError(err)
+
+	suite.Create(config.NewMachineConfig(cfg))
+
+	machineType := config.NewMachineType()
+	machineType.SetMachineType(machine.TypeWorker)
+	suite.Create(machineType)
+
+	nodename := k8s.NewNodename(k8s.NamespaceName, k8s.NodenameID)
+	nodename.TypedSpec().Nodename = "worker-1"
+	suite.Create(nodename)
+
+	label := k8s.NewNodeLabelSpec("topology.kubernetes.io/zone")
+	label.TypedSpec().Key = "topology.kubernetes.io/zone"
+	label.TypedSpec().Value = "zone-a"
+	suite.Create(label)
+}
+
+func (suite *DisruptionSuite) TestNoPolicy() {
+	ctest.AssertResource(suite, runtime.DisruptionStatusID, func(status *runtime.DisruptionStatus, asrt *assert.Assertions) {
+		asrt.Equal(runtime.DisruptionStateIdle, status.TypedSpec().State)
+	})
+
+	suite.Create(runtime.NewDisruptionRequest(runtime.DisruptionOperationReboot))
+
+	ctest.AssertResource(suite, runtime.DisruptionStatusID, func(status *runtime.DisruptionStatus, asrt *assert.Assertions) {
+		asrt.Equal(runtime.DisruptionStateGranted, status.TypedSpec().State)
+		asrt.Equal([]string{runtime.DisruptionOperationReboot}, status.TypedSpec().Operations)
+	})
+
+	_, calls := suite.backend.lastRequest()
+	suite.Assert().Zero(calls)
+}
+
+func (suite *DisruptionSuite) TestQueue() {
+	policy := runtimecfg.NewMaintenancePolicyV1Alpha1()
+	policy.MaintenanceConcurrency.ConcurrencyLabels = []runtimecfg.MaintenanceLabelLimit{
+		{
+			LabelKey: "topology.kubernetes.io/zone",
+		},
+	}
+
+	suite.setup(policy)
+
+	suite.backend.setStatus(disruption.Status{QueuePosition: 2, Holders: []string{"worker-2"}})
+
+	request := runtime.NewDisruptionRequest(runtime.DisruptionOperationUpgrade)
+	request.TypedSpec().Operation = runtime.DisruptionOperationUpgrade
+	request.TypedSpec().RequestedAt = time.Now()
+	suite.Create(request)
+
+	ctest.AssertResource(suite, runtime.DisruptionStatusID, func(status *runtime.DisruptionStatus, asrt *assert.Assertions) {
+		asrt.Equal(runtime.DisruptionStateWaitingLock, status.TypedSpec().State)
+		asrt.Equal(2, status.TypedSpec().QueuePosition)
+		asrt.Equal([]string{"worker-2"}, status.TypedSpec().Holders)
+	})
+
+	req, _ := suite.backend.lastRequest()
+	suite.Assert().Equal("worker-1", req.Node)
+	suite.Assert().False(req.ControlPlane)
+	suite.Assert().Equal(map[string]string{"topology.kubernetes.io/zone": "zone-a"}, req.Labels)
+	suite.Assert().Equal(disruption.Limits{
+		MaxNodes:             1,
+		MaxControlPlaneNodes: 1,
+		Labels:               map[string]int{"topology.kubernetes.io/zone": 1},
+	}, req.Limits)
+
+	suite.backend.setStatus(disruption.Status{Granted: true, Holders: []string{"worker-1"}})
+
+	// trigger the reconcile
+	request.TypedSpec().RequestedAt = request.TypedSpec().RequestedAt.Add(-time.Second)
+	suite.Update(request)
+
+	ctest.AssertResource(suite, runtime.DisruptionStatusID, func(status *runtime.DisruptionStatus, asrt *assert.Assertions) {
+		asrt.Equal(runtime.DisruptionStateGranted, status.TypedSpec().State)
+		asrt.Equal([]string{"worker-1"}, status.TypedSpec().Holders)
+	})
+
+	suite.Destroy(request)
+
+	ctest.AssertResource(suite, runtime.DisruptionStatusID, func(status *runtime.DisruptionStatus, asrt *assert.Assertions) {
+		asrt.Equal(runtime.DisruptionStateIdle, status.TypedSpec().State)
+	})
+
+	// the slot is released once the machine is back up
+	suite.Assert().Empty(suite.backend.releasedNodes())
+
+	machineStatus := runtime.NewMachineStatus()
+	machineStatus.TypedSpec().Stage = runtime.MachineStageRunning
+	machineStatus.TypedSpec().Status.Ready = true
+	suite.Create(machineStatus)
+
+	suite.AssertWithin(time.Second, 10*time.Millisecond, func() error {
+		if released := suite.backend.releasedNodes(); len(released) != 1 || released[0] != "worker-1" {
+			return fmt.Errorf("unexpected released nodes: %v", released)
+		}
+
+		return nil
+	})
+}
+
+func (suite *DisruptionSuite) TestWindow() {
+	now := time.Now().UTC()
+
+	// the window opens in half an hour
+	policy := runtimecfg.NewMaintenancePolicyV1Alpha1()
+	policy.MaintenanceWindows = []runtimecfg.MaintenanceWindow{
+		{
+			WindowSchedule: fmt.Sprintf("%d * * * *", (now.Minute()+30)%60),
+			WindowDuration: time.Minute,
+		},
+	}
+
+	suite.setup(policy)
+
+	suite.Create(runtime.NewDisruptionRequest(runtime.DisruptionOperationReboot))
+
+	ctest.AssertResource(suite, runtime.DisruptionStatusID, func(status *runtime.DisruptionStatus, asrt *assert.Assertions) {
+		asrt.Equal(runtime.DisruptionStateWaitingWindow, status.TypedSpec().State)
+		asrt.WithinDuration(now.Add(30*time.Minute), status.TypedSpec().NextWindow, time.Minute)
+	})
+
+	_, calls := suite.backend.lastRequest()
+	suite.Assert().Zero(calls)
+}
@@ -80,6 +80,28 @@ func (ctrl *ExtensionServiceConfigController) Run(ctx context.Context, r control
 
 					spec.TypedSpec().Environment = extConfig.Environment()
 
+					resources := extConfig.Resources()
+
+					spec.TypedSpec().Resources = runtime.ExtensionServiceResourcesSpec{
+						CPUWeight:        resources.CPUWeight(),
+						CPUMaxMillicores: resources.CPUMaxMillicores(),
+						MemoryMax:        resources.MemoryMax(),
+						MemoryHigh:       resources.MemoryHigh(),
+						IOWeight:         resources.IOWeight(),
+					}
+
+					if oomScoreAdj, ok := resources.OOMScoreAdj().Get(); ok {
+						spec.TypedSpec().Resources.OOMScoreAdj = new(int32(oomScoreAdj))
+					}
+
+					security := extConfig.Security()
+
+					spec.TypedSpec().Security = runtime.ExtensionServiceSecuritySpec{
+						DropCapabilities: security.DropCapabilities(),
+						SeccompProfile:   security.SeccompProfile(),
+						ReadonlyRootfs:   security.ReadonlyRootfs(),
+					}
+
 					return nil
 				}); err != nil {
 					return err
@@ -15,6 +15,7 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
 	cntrconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/block"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime/extensions"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
@@ -139,3 +140,40 @@ func (suite *ExtensionServiceConfigSuite) TestReconcileExtensionServiceConfig()
 
 	ctest.AssertNoResource[*runtimeres.ExtensionServiceConfig](suite, "test-extension-a")
 }
+
+func (suite *ExtensionServiceConfigSuite) TestReconcileExtensionServiceOverrides() {
+	cfg := extensions.NewServicesConfigV1Alpha1()
+	cfg.ServiceName = "test-extension"
+	cfg.ServiceResources = extensions.ResourcesConfig{
+		ResourcesCPUWeight:   50,
+		ResourcesCPUMax:      "250m",
+		ResourcesMemoryMax:   block.MustByteSize("128MiB"),
+		ResourcesOOMScoreAdj: new(900),
+	}
+	cfg.ServiceSecurity = extensions.SecurityConfig{
+		SecurityDropCapabilities: []string{"CAP_SYS_ADMIN"},
+		SecuritySeccompProfile:   "runtime/default",
+		SecurityReadonlyRootfs:   true,
+	}
+
+	cntr, err := container.New(cfg)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(cntr)))
+
+	ctest.AssertResource(suite, "test-extension", func(config *runtimeres.ExtensionServiceConfig, asrt *assert.Assertions) {
+		spec := config.TypedSpec()
+
+		asrt.Equal(runtimeres.ExtensionServiceResourcesSpec{
+			CPUWeight:        50,
+			CPUMaxMillicores: 250,
+			MemoryMax:        128 * 1024 * 1024,
+			OOMScoreAdj:      new(int32(900)),
+		}, spec.Resources)
+		asrt.Equal(runtimeres.ExtensionServiceSecuritySpec{
+			DropCapabilities: []string{"CAP_SYS_ADMIN"},
+			SeccompProfile:   "runtime/default",
+			ReadonlyRootfs:   true,
+		}, spec.Security)
+	})
+}
@@ -46,5 +46,10 @@ func Checks() []CheckDescription {
 			Hysteresis: 30 * time.Second,
 			Check:      KubeletCSRNotApprovedCheck,
 		},
+		{
+			ID:         "etcd-quota",
+			Hysteresis: 30 * time.Second,
+			Check:      EtcdQuotaCheck,
+		},
 	}
 }
@@ -0,0 +1,58 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package diagnostics
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/dustin/go-humanize"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/types/cluster"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// EtcdQuotaCheck checks whether the etcd database size is approaching the quota.
+func EtcdQuotaCheck(ctx context.Context, r controller.Reader, logger *zap.Logger) (*runtime.DiagnosticSpec, error) {
+	status, err := safe.ReaderGetByID[*etcd.MaintenanceStatus](ctx, r, etcd.MaintenanceStatusID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("error reading etcd maintenance status: %w", err)
+	}
+
+	threshold := cluster.DefaultEtcdQuotaWarningThreshold / 100.0
+
+	cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, fmt.Errorf("error reading machine config: %w", err)
+	}
+
+	if cfg != nil && cfg.Config().EtcdMaintenanceConfig() != nil {
+		threshold = cfg.Config().EtcdMaintenanceConfig().QuotaWarningThreshold()
+	}
+
+	spec := status.TypedSpec()
+
+	if spec.QuotaUsage() < threshold {
+		return nil, nil
+	}
+
+	return &runtime.DiagnosticSpec{
+		Message: "etcd database size is approaching the quota",
+		Details: []string{
+			fmt.Sprintf("database size: %s (%.0f%% of the quota %s)", humanize.IBytes(uint64(spec.DBSize)), spec.QuotaUsage()*100, humanize.IBytes(uint64(spec.DBSizeQuota))),
+			fmt.Sprintf("database size in use: %s", humanize.IBytes(uint64(spec.DBSizeInUse))),
+		},
+	}, nil
+}
@@ -0,0 +1,120 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package diagnostics_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime/internal/diagnostics"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/cluster"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+func TestEtcdQuotaCheck(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), time.Minute)
+	t.Cleanup(cancel)
+
+	for _, test := range []struct {
+		name string
+
+		setup func(t *testing.T, ctx context.Context, st state.State)
+
+		expectedWarning *runtime.DiagnosticSpec
+	}{
+		{
+			name: "no status",
+
+			setup: func(t *testing.T, ctx context.Context, st state.State) {},
+		},
+		{
+			name: "below default threshold",
+
+			setup: func(t *testing.T, ctx context.Context, st state.State) {
+				status := etcd.NewMaintenanceStatus(etcd.NamespaceName, etcd.MaintenanceStatusID)
+				status.TypedSpec().DBSize = 700 * 1024 * 1024
+				status.TypedSpec().DBSizeInUse = 600 * 1024 * 1024
+				status.TypedSpec().DBSizeQuota = 1024 * 1024 * 1024
+				require.NoError(t, st.Create(ctx, status))
+			},
+		},
+		{
+			name: "above default threshold",
+
+			setup: func(t *testing.T, ctx context.Context, st state.State) {
+				status := etcd.NewMaintenanceStatus(etcd.NamespaceName, etcd.MaintenanceStatusID)
+				status.TypedSpec().DBSize = 900 * 1024 * 1024
+				status.TypedSpec().DBSizeInUse = 600 * 1024 * 1024
+				status.TypedSpec().DBSizeQuota = 1024 * 1024 * 1024
+				require.NoError(t, st.Create(ctx, status))
+			},
+
+			expectedWarning: &runtime.DiagnosticSpec{
+				Message: "etcd database size is approaching the quota",
+				Details: []string{
+					"database size: 900 MiB (88% of the quota 1.0 GiB)",
+					"database size in use: 600 MiB",
+				},
+			},
+		},
+		{
+			name: "above configured threshold",
+
+			setup: func(t *testing.T, ctx context.Context, st state.State) {
+				maintenanceCfg := cluster.NewEtcdMaintenanceConfigV1Alpha1()
+				maintenanceCfg.QuotaWarningThresholdPercent = 60
+
+				cfg, err := container.New(maintenanceCfg)
+				require.NoError(t, err)
+
+				require.NoError(t, st.Create(ctx, config.NewMachineConfig(cfg)))
+
+				status := etcd.NewMaintenanceStatus(etcd.NamespaceName, etcd.MaintenanceStatusID)
+				status.TypedSpec().DBSize = 700 * 1024 * 1024
+				status.TypedSpec().DBSizeInUse = 600 * 1024 * 1024
+				status.TypedSpec().DBSizeQuota = 1024 * 1024 * 1024
+				require.NoError(t, st.Create(ctx, status))
+			},
+
+			expectedWarning: &runtime.DiagnosticSpec{
+				Message: "etcd database size is approaching the quota",
+				Details: []string{
+					"database size: 700 MiB (68% of the quota 1.0 GiB)",
+					"database size in use: 600 MiB",
+				},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := zaptest.NewLogger(t)
+			st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+			test.setup(t, ctx, st)
+
+			spec, err := diagnostics.EtcdQuotaCheck(ctx, st, logger)
+			require.NoError(t, err)
+
+			if test.expectedWarning == nil {
+				require.Nil(t, spec)
+			} else {
+				require.Equal(t, test.expectedWarning, spec)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"go.uber.org/zap"
 
 	v1alpha1runtime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/disruption"
 	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 )
 
@@ -30,14 +31,20 @@ type RebootController struct {
 
 	PreRebootFunc func(ctx context.Context) error
 
+	// WaitForDisruption blocks the reboot until the maintenance policy allows it.
+	//
+	// The returned function releases the lock slot if the reboot fails.
+	WaitForDisruption func(ctx context.Context, logger *zap.Logger) (func(), error)
+
 	Reboot func(ctx context.Context) error
 }
 
 // NewRebootController creates a RebootController wired to the runtime.
 func NewRebootController(rt v1alpha1runtime.Runtime, reboot func(ctx context.Context) error) *RebootController {
 	return &RebootController{
-		V1Alpha1Mode: rt.State().Platform().Mode(),
-		Reboot:       reboot,
+		V1Alpha1Mode:      rt.State().Platform().Mode(),
+		Reboot:            reboot,
+		WaitForDisruption: WaitForRebootSlot(rt.State().V1Alpha2().Resources()),
 		PreRebootFunc: func(ctx context.Context) error {
 			for {
 				if err := rt.State().Machine().Meta().Reload(ctx); err != nil {
@@ -65,6 +72,16 @@ func NewRebootController(rt v1alpha1runtime.Runtime, reboot func(ctx context.Con
 	}
 }
 
+// WaitForRebootSlot returns a function which blocks the reboot until the maintenance policy allows it.
+//
+// The slot is released by the DisruptionController once the machine is back up,
+// the returned release function should be called if the reboot fails.
+func WaitForRebootSlot(st state.State) func(ctx context.Context, logger *zap.Logger) (func(), error) {
+	return func(ctx context.Context, logger *zap.Logger) (func(), error) {
+		return disruption.WaitForSlot(ctx, st, runtime.DisruptionOperationReboot, logger)
+	}
+}
+
 // Name implements controller.Controller interface.
 func (ctrl *RebootController) Name() string {
 	return "runtime.RebootController"
@@ -114,6 +131,18 @@ func (ctrl *RebootController) Run(ctx context.Context, r controller.Runtime, log
 
 		logger.Info("reboot requested via RebootRequest resource")
 
+		release := func() {}
+
+		if ctrl.WaitForDisruption != nil {
+			if release, err = ctrl.WaitForDisruption(ctx, logger); err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+
+				return err
+			}
+		}
+
 		if err := ctrl.PreRebootFunc(ctx); err != nil {
 			logger.Error("failed to flush META before reboot", zap.Error(err))
 		}
@@ -121,6 +150,9 @@ func (ctrl *RebootController) Run(ctx context.Context, r controller.Runtime, log
 		go func() {
 			if err := ctrl.Reboot(ctx); err != nil {
 				logger.Error("failed to reboot", zap.Error(err))
+
+				// the node was not disrupted
+				release()
 			}
 		}()
 
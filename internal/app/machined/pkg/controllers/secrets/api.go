@@ -19,19 +19,26 @@ import (
 	"github.com/siderolabs/gen/optional"
 	"go.uber.org/zap"
 
+	"github.com/siderolabs/talos/internal/pkg/attestation"
 	"github.com/siderolabs/talos/pkg/grpc/gen"
+	securityapi "github.com/siderolabs/talos/pkg/machinery/api/security"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/machine"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/resources/security"
 	timeresource "github.com/siderolabs/talos/pkg/machinery/resources/time"
 	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/role"
 )
 
 // APIController manages secrets.API based on configuration to provide apid certificate.
-type APIController struct{}
+type APIController struct {
+	// PhaseLog provides the PCR 11 value in the signed boot phase for the node attestation.
+	PhaseLog *attestation.PhaseLog
+}
 
 // Name implements controller.Controller interface.
 func (ctrl *APIController) Name() string {
@@ -176,12 +183,20 @@ func (ctrl *APIController) reconcile(ctx context.Context, r controller.Runtime,
 	}
 
 	if !isControlplane {
-		// worker nodes depend on endpoint list
-		inputs = append(inputs, controller.Input{
-			Namespace: k8s.ControlPlaneNamespaceName,
-			Type:      k8s.EndpointType,
-			Kind:      controller.InputWeak,
-		})
+		// worker nodes depend on endpoint list and node attestation policy
+		inputs = append(inputs,
+			controller.Input{
+				Namespace: k8s.ControlPlaneNamespaceName,
+				Type:      k8s.EndpointType,
+				Kind:      controller.InputWeak,
+			},
+			controller.Input{
+				Namespace: security.NamespaceName,
+				Type:      security.NodeAttestationPolicyType,
+				ID:        optional.Some(security.NodeAttestationPolicyID),
+				Kind:      controller.InputWeak,
+			},
+		)
 	}
 
 	if err := r.UpdateInputs(inputs); err != nil {
@@ -360,6 +375,17 @@ func (ctrl *APIController) generateWorker(ctx context.Context, r controller.Runt
 
 	defer remoteGen.Close() //nolint:errcheck
 
+	attestationPolicy, err := safe.ReaderGetByID[*security.NodeAttestationPolicy](ctx, r, security.NodeAttestationPolicyID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return fmt.Errorf("error getting node attestation policy: %w", err)
+	}
+
+	if attestationPolicy != nil {
+		if attest := ctrl.attestFunc(logger, attestationPolicy.TypedSpec()); attest != nil {
+			remoteGen.WithAttestation(attest)
+		}
+	}
+
 	// use the last CA in the list of accepted CAs as a template
 	if len(rootSpec.AcceptedCAs) == 0 {
 		return errors.New("no accepted CAs")
@@ -442,6 +468,31 @@ func (ctrl *APIController) generateWorker(ctx context.Context, r controller.Runt
 	return nil
 }
 
+// attestFunc returns the function producing the TPM attestation evidence according to the policy.
+func (ctrl *APIController) attestFunc(logger *zap.Logger, policy *security.NodeAttestationPolicySpec) gen.AttestFunc {
+	mode, err := config.ParseNodeAttestationMode(policy.Mode)
+	if err != nil || mode == config.NodeAttestationModeDisabled {
+		return nil
+	}
+
+	attester := &attestation.Attester{
+		PCRs:     policy.PCRs,
+		PhaseLog: ctrl.PhaseLog,
+	}
+
+	return func(ctx context.Context, csr []byte, challengeFunc gen.ChallengeFunc) (*securityapi.NodeAttestation, error) {
+		evidence, err := attester.Attest(ctx, csr, challengeFunc)
+		if err != nil && mode == config.NodeAttestationModeOptional {
+			// trustd accepts the request without evidence, and records the attestation failure
+			logger.Warn("node attestation failed, requesting the certificate without attestation", zap.Error(err))
+
+			return nil, nil //nolint:nilnil
+		}
+
+		return evidence, err
+	}
+}
+
 //nolint:dupl,gocyclo
 func (ctrl *APIController) reconcileMaintenance(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
 	inputs := []controller.Input{
@@ -8,8 +8,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/netip"
 	"net/url"
+	"slices"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/controller/generic"
@@ -18,6 +20,7 @@ import (
 	"github.com/siderolabs/gen/optional"
 	"go.uber.org/zap"
 
+	clustertypes "github.com/siderolabs/talos/pkg/machinery/config/types/cluster"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
@@ -72,6 +75,7 @@ func NewRootEtcdController() *RootEtcdController {
 				etcdSecrets := res.TypedSpec()
 
 				etcdSecrets.EtcdCA = cfgProvider.Cluster().Etcd().CA()
+				etcdSecrets.AcceptedCAs = cfgProvider.Cluster().Etcd().AcceptedCAs()
 
 				return nil
 			},
@@ -168,6 +172,8 @@ func NewRootKubernetesController() *RootKubernetesController {
 					k8sSecrets.EtcdEncryptionConfig = nil
 				}
 
+				k8sSecrets.KMSPluginEnabled = cfgProvider.K8sKMSConfig() != nil
+
 				return nil
 			},
 		},
@@ -204,7 +210,26 @@ func NewRootOSController() *RootOSController {
 				osSecrets.CertSANIPs = nil
 				osSecrets.CertSANDNSNames = nil
 
-				for _, san := range cfgProvider.Machine().Security().CertSANs() {
+				certSANs := slices.Clone(cfgProvider.Machine().Security().CertSANs())
+
+				if cfgProvider.Machine().Type().IsControlPlane() {
+					// the embedded discovery service is served with the Talos API certificate
+					embeddedDiscoveryEndpoints, err := clustertypes.EmbeddedDiscoveryEndpoints(cfgProvider)
+					if err != nil {
+						return fmt.Errorf("error getting embedded discovery endpoints: %w", err)
+					}
+
+					for _, endpoint := range embeddedDiscoveryEndpoints {
+						host, _, err := net.SplitHostPort(endpoint)
+						if err != nil {
+							return err
+						}
+
+						certSANs = append(certSANs, host)
+					}
+				}
+
+				for _, san := range certSANs {
 					if ip, err := netip.ParseAddr(san); err == nil {
 						osSecrets.CertSANIPs = append(osSecrets.CertSANIPs, ip)
 					} else {
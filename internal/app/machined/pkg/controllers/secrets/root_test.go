@@ -5,6 +5,7 @@
 package secrets_test
 
 import (
+	"net/netip"
 	"testing"
 	"time"
 
@@ -17,8 +18,11 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
 	secretsctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/secrets"
 	talosconfig "github.com/siderolabs/talos/pkg/machinery/config"
+	configconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
 	"github.com/siderolabs/talos/pkg/machinery/config/generate"
 	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	clustertypes "github.com/siderolabs/talos/pkg/machinery/config/types/cluster"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
 )
@@ -42,7 +46,7 @@ type RootSuite struct {
 	ctest.DefaultSuite
 }
 
-func (suite *RootSuite) genConfig(controlplane bool, versionContract *talosconfig.VersionContract) talosconfig.Config {
+func (suite *RootSuite) genConfig(controlplane bool, versionContract *talosconfig.VersionContract, extraDocs ...configconfig.Document) talosconfig.Config {
 	input, err := generate.NewInput(
 		"test-cluster", "http://localhost:6443", "1.28.0",
 		generate.WithVersionContract(versionContract),
@@ -59,6 +63,11 @@ func (suite *RootSuite) genConfig(controlplane bool, versionContract *talosconfi
 
 	suite.Require().NoError(err)
 
+	if len(extraDocs) > 0 {
+		cfg, err = container.New(append(cfg.Documents(), extraDocs...)...)
+		suite.Require().NoError(err)
+	}
+
 	machineCfg := config.NewMachineConfig(cfg)
 	suite.Require().NoError(suite.State().Create(suite.Ctx(), machineCfg))
 
@@ -72,6 +81,7 @@ func (suite *RootSuite) TestReconcileControlPlane() {
 		suite.Ctx(), suite.T(), suite.State(), []resource.ID{secrets.EtcdRootID},
 		func(res *secrets.EtcdRoot, asrt *assert.Assertions) {
 			asrt.Equal(res.TypedSpec().EtcdCA, cfg.Cluster().Etcd().CA())
+			asrt.Equal(res.TypedSpec().AcceptedCAs, cfg.Cluster().Etcd().AcceptedCAs())
 		},
 	)
 	rtestutils.AssertResources(
@@ -150,3 +160,33 @@ func (suite *RootSuite) TestReconcileWorker() {
 	rtestutils.AssertNoResource[*secrets.EtcdRoot](suite.Ctx(), suite.T(), suite.State(), secrets.EtcdRootID)
 	rtestutils.AssertNoResource[*secrets.KubernetesRoot](suite.Ctx(), suite.T(), suite.State(), secrets.KubernetesRootID)
 }
+
+func (suite *RootSuite) TestReconcileEmbeddedDiscoverySANs() {
+	embeddedCfg := clustertypes.NewEmbeddedDiscoveryConfigV1Alpha1()
+
+	suite.genConfig(true, talosconfig.TalosVersionCurrent, embeddedCfg)
+
+	// the embedded discovery service endpoint defaults to the cluster endpoint host
+	rtestutils.AssertResources(
+		suite.Ctx(), suite.T(), suite.State(), []resource.ID{secrets.OSRootID},
+		func(res *secrets.OSRoot, asrt *assert.Assertions) {
+			asrt.Contains(res.TypedSpec().CertSANDNSNames, "localhost")
+		},
+	)
+}
+
+func (suite *RootSuite) TestReconcileEmbeddedDiscoverySANsWorker() {
+	embeddedCfg := clustertypes.NewEmbeddedDiscoveryConfigV1Alpha1()
+	embeddedCfg.ServiceEndpoints = []string{"172.20.0.2", "cp.example.com:3000"}
+
+	suite.genConfig(false, talosconfig.TalosVersionCurrent, embeddedCfg)
+
+	// workers don't serve the embedded discovery service
+	rtestutils.AssertResources(
+		suite.Ctx(), suite.T(), suite.State(), []resource.ID{secrets.OSRootID},
+		func(res *secrets.OSRoot, asrt *assert.Assertions) {
+			asrt.NotContains(res.TypedSpec().CertSANIPs, netip.MustParseAddr("172.20.0.2"))
+			asrt.NotContains(res.TypedSpec().CertSANDNSNames, "cp.example.com")
+		},
+	)
+}
@@ -0,0 +1,101 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint:revive
+package security
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	configres "github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/security"
+)
+
+// NodeAttestationConfigController watches machine config and produces NodeAttestationPolicy resource.
+type NodeAttestationConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *NodeAttestationConfigController) Name() string {
+	return "security.NodeAttestationConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NodeAttestationConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: configres.NamespaceName,
+			Type:      configres.MachineConfigType,
+			ID:        optional.Some(configres.ActiveID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NodeAttestationConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: security.NodeAttestationPolicyType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *NodeAttestationConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		r.StartTrackingOutputs()
+
+		machineConfig, err := safe.ReaderGetByID[*configres.MachineConfig](ctx, r, configres.ActiveID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("failed to get machine config: %w", err)
+		}
+
+		if machineConfig != nil {
+			if cfg := machineConfig.Config().NodeAttestationConfig(); cfg != nil {
+				if err = safe.WriterModify(ctx, r, security.NewNodeAttestationPolicy(), func(res *security.NodeAttestationPolicy) error {
+					spec := res.TypedSpec()
+
+					spec.Mode = string(cfg.Mode())
+					spec.PCRs = cfg.PCRs()
+					spec.PCRSigningKeys = cfg.PCRSigningKeys()
+					spec.EndorsementCAs = cfg.EndorsementCAs()
+
+					allowed := cfg.AllowedPCRs()
+					spec.AllowedPCRValues = make([]security.NodeAttestationPCRValueSpec, 0, len(allowed))
+
+					for _, pcr := range slices.Sorted(maps.Keys(allowed)) {
+						spec.AllowedPCRValues = append(spec.AllowedPCRValues, security.NodeAttestationPCRValueSpec{
+							PCR:    pcr,
+							Values: allowed[pcr],
+						})
+					}
+
+					return nil
+				}); err != nil {
+					return fmt.Errorf("failed to update node attestation policy: %w", err)
+				}
+			}
+		}
+
+		if err = safe.CleanupOutputs[*security.NodeAttestationPolicy](ctx, r); err != nil {
+			return fmt.Errorf("failed to cleanup outputs: %w", err)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package security_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	securityctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/security"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	securitycfg "github.com/siderolabs/talos/pkg/machinery/config/types/security"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/security"
+)
+
+type NodeAttestationConfigSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *NodeAttestationConfigSuite) TestReconcileNoConfig() {
+	ctest.AssertNoResource[*security.NodeAttestationPolicy](suite, security.NodeAttestationPolicyID)
+}
+
+func (suite *NodeAttestationConfigSuite) TestReconcile() {
+	attestationCfg := securitycfg.NewNodeAttestationConfigV1Alpha1()
+	attestationCfg.ConfigMode = "required"
+	attestationCfg.AllowedPCRValues = []securitycfg.NodeAttestationPCRValueV1Alpha1{
+		{
+			PCRIndex:  11,
+			PCRValues: []string{"bb"},
+		},
+		{
+			PCRIndex:  7,
+			PCRValues: []string{"aa"},
+		},
+	}
+
+	cont, err := container.New(attestationCfg)
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(cont)
+	suite.Create(cfg)
+
+	ctest.AssertResource(suite, security.NodeAttestationPolicyID, func(policy *security.NodeAttestationPolicy, asrt *assert.Assertions) {
+		asrt.Equal("required", policy.TypedSpec().Mode)
+		asrt.Equal([]int{7, 11}, policy.TypedSpec().PCRs)
+		asrt.Equal([]security.NodeAttestationPCRValueSpec{
+			{PCR: 7, Values: []string{"aa"}},
+			{PCR: 11, Values: []string{"bb"}},
+		}, policy.TypedSpec().AllowedPCRValues)
+	})
+
+	suite.Destroy(cfg)
+
+	ctest.AssertNoResource[*security.NodeAttestationPolicy](suite, security.NodeAttestationPolicyID)
+}
+
+func TestNodeAttestationConfigSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &NodeAttestationConfigSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(s *ctest.DefaultSuite) {
+				s.Require().NoError(s.Runtime().RegisterController(&securityctrl.NodeAttestationConfigController{}))
+			},
+		},
+	})
+}
@@ -0,0 +1,210 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package configapply implements applying the machine configuration.
+//
+// The machine configuration is applied both via the API and by the controllers (e.g. pulled from the remote source),
+// so the same locking, rollback and validation rules apply in both cases.
+package configapply
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/xerrors"
+
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/signature"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// Mode is the machine configuration apply mode.
+type Mode int
+
+// Apply modes.
+const (
+	// ModeNoReboot applies the configuration immediately and persists it.
+	ModeNoReboot Mode = iota
+	// ModeTry applies the configuration immediately, and rolls it back after the timeout.
+	ModeTry
+	// ModeStaged persists the configuration to be applied after the next reboot.
+	ModeStaged
+)
+
+// Error tags of the apply failures.
+//
+//nolint:revive
+type (
+	InProgressTag struct{}
+	InvalidTag    struct{}
+	UntrustedTag  struct{}
+)
+
+// Runtime is the subset of the machined runtime which is used to apply the configuration.
+type Runtime interface {
+	ConfigCompleteForBoot() bool
+	RollbackToConfigAfter(time.Duration) error
+	CancelConfigRollbackTimeout()
+	SetConfig(config.Provider) error
+	SetPersistedConfig(config.Provider) error
+}
+
+// Request is a machine configuration apply request.
+type Request struct {
+	// Data is the machine configuration.
+	Data []byte
+	// Signature is the detached signature of the machine configuration (optional).
+	Signature []byte
+	// Mode of the apply.
+	Mode Mode
+	// TryTimeout is the rollback timeout for ModeTry.
+	TryTimeout time.Duration
+	// DryRun validates the configuration without applying it.
+	DryRun bool
+}
+
+// Result is the result of the machine configuration apply.
+type Result struct {
+	// Config is the loaded machine configuration.
+	Config config.Provider
+	// Warnings of the configuration validation.
+	Warnings []string
+}
+
+// Applier applies the machine configuration.
+//
+// Only a single apply can be in progress at a time.
+type Applier struct {
+	Runtime       Runtime
+	ResourceState state.State
+	// ValidationMode is the runtime mode the configuration is validated against.
+	ValidationMode validation.RuntimeMode
+	// TrustPolicy returns the configuration trust policy (trusted public keys), if it was set at install time.
+	TrustPolicy func() ([]byte, bool)
+
+	mu sync.Mutex
+}
+
+// ApplyConfig validates and applies the machine configuration.
+//
+//nolint:gocyclo
+func (a *Applier) ApplyConfig(ctx context.Context, req Request) (*Result, error) {
+	if !a.mu.TryLock() {
+		return nil, xerrors.NewTaggedf[InProgressTag]("another apply configuration is already in progress")
+	}
+
+	defer a.mu.Unlock()
+
+	// any apply except for the 'try' mode confirms the configuration, so that it is not rolled back
+	if req.Mode != ModeTry {
+		a.Runtime.CancelConfigRollbackTimeout()
+	}
+
+	inMaintenance := !a.Runtime.ConfigCompleteForBoot()
+
+	cfgProvider, err := configloader.NewFromBytes(req.Data)
+	if err != nil {
+		return nil, xerrors.NewTagged[InvalidTag](err)
+	}
+
+	// as we are not in maintenance mode, the v1alpha1 config should be always present
+	// in the future we should allow to remove v1alpha1, but for now for better UX we deny
+	// such requests to avoid confusion
+	if !inMaintenance && cfgProvider.RawV1Alpha1() == nil {
+		return nil, xerrors.NewTaggedf[InvalidTag](
+			"the applied machine configuration doesn't contain v1alpha1 config, did you mean to patch the machine config instead?",
+		)
+	}
+
+	if err = a.verifySignature(req.Data, req.Signature); err != nil {
+		return nil, err
+	}
+
+	warnings, err := cfgProvider.ValidateAtRuntime(ctx, a.ResourceState, a.ValidationMode)
+	if err != nil {
+		return nil, xerrors.NewTagged[InvalidTag](err)
+	}
+
+	result := &Result{
+		Config:   cfgProvider,
+		Warnings: warnings,
+	}
+
+	if req.DryRun {
+		return result, nil
+	}
+
+	switch req.Mode {
+	case ModeTry:
+		if err = a.Runtime.RollbackToConfigAfter(req.TryTimeout); err != nil {
+			return nil, err
+		}
+
+		if err = a.Runtime.SetConfig(cfgProvider); err != nil {
+			return nil, err
+		}
+	case ModeNoReboot:
+		if err = a.Runtime.SetPersistedConfig(cfgProvider); err != nil {
+			return nil, fmt.Errorf("failed to persist machine configuration: %w", err)
+		}
+
+		if err = a.Runtime.SetConfig(cfgProvider); err != nil {
+			return nil, err
+		}
+	case ModeStaged:
+		if err = a.Runtime.SetPersistedConfig(cfgProvider); err != nil {
+			return nil, fmt.Errorf("failed to persist machine configuration: %w", err)
+		}
+	default:
+		return nil, errors.New("unknown apply mode")
+	}
+
+	return result, nil
+}
+
+// verifySignature enforces the configuration trust policy (if set at install time).
+//
+// If the policy is set, the applied configuration should be signed by one of the trusted keys.
+func (a *Applier) verifySignature(data, sig []byte) error {
+	if a.TrustPolicy == nil {
+		return nil
+	}
+
+	policy, ok := a.TrustPolicy()
+	if !ok {
+		return nil
+	}
+
+	keys, err := signature.ParsePublicKeys(policy)
+	if err != nil {
+		return fmt.Errorf("failed to parse configuration trust policy: %w", err)
+	}
+
+	if len(sig) == 0 {
+		return xerrors.NewTaggedf[UntrustedTag]("the machine configuration should be signed by a trusted key, but the signature is missing")
+	}
+
+	if err = signature.Verify(data, sig, keys); err != nil {
+		return xerrors.NewTaggedf[UntrustedTag]("the machine configuration should be signed by a trusted key: %w", err)
+	}
+
+	return nil
+}
+
+// InstalledMode overrides RequiresInstall() based on the actual installed status.
+type InstalledMode struct {
+	validation.RuntimeMode
+
+	Installed func() bool
+}
+
+// RequiresInstall implements validation.RuntimeMode.
+func (m InstalledMode) RequiresInstall() bool {
+	return m.RuntimeMode.RequiresInstall() && !m.Installed()
+}
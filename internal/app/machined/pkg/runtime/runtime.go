@@ -10,6 +10,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/configapply"
 	"github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
 )
@@ -58,6 +59,8 @@ type Runtime interface { //nolint:interfacebloat
 	CancelConfigRollbackTimeout()
 	SetConfig(config.Provider) error
 	SetPersistedConfig(config.Provider) error
+	// ApplyConfig validates and applies the machine configuration.
+	ApplyConfig(context.Context, configapply.Request) (*configapply.Result, error)
 	State() State
 	Events() EventStream
 	Logging() LoggingManager
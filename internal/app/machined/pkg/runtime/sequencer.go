@@ -8,7 +8,10 @@ import (
 	"context"
 	"fmt"
 
+	"google.golang.org/protobuf/types/known/durationpb"
+
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/api/storage"
 )
 
 // Sequence represents a sequence type.
@@ -139,6 +142,8 @@ type ResetOptions interface {
 	GetReboot() bool
 	GetMode() machine.ResetRequest_WipeMode
 	GetUserDisksToWipe() []string
+	GetWipeMethod() storage.BlockDeviceWipeDescriptor_Method
+	GetEraseReportTimeout() *durationpb.Duration
 	GetSystemDiskTargets() []PartitionTarget
 	GetSystemDiskPaths() []string
 }
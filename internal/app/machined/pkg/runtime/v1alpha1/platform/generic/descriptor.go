@@ -0,0 +1,324 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package generic
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+	"go.yaml.in/yaml/v4"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Endpoint response formats.
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+// EncodingBase64 is the base64 encoding of the user data.
+const EncodingBase64 = "base64"
+
+// Descriptor describes how to fetch the metadata of the platform, and how to map it to the platform configuration.
+type Descriptor struct {
+	// Token is an optional token handshake performed before fetching the metadata.
+	Token *Token `yaml:"token,omitempty"`
+	// Endpoints are the metadata endpoints.
+	//
+	// Each response is available to the mappings as a variable named after the endpoint.
+	Endpoints []Endpoint `yaml:"endpoints"`
+	// UserData is the source of the machine configuration.
+	UserData *UserData `yaml:"userData,omitempty"`
+	// Mappings from the metadata to the platform configuration.
+	Mappings Mappings `yaml:"mappings"`
+
+	env      *cel.Env
+	programs map[string]cel.Program
+}
+
+// Request describes an HTTP request to the metadata service.
+type Request struct {
+	// URL of the request.
+	URL string `yaml:"url"`
+	// Method of the request, defaults to GET (PUT for the token handshake).
+	Method string `yaml:"method,omitempty"`
+	// Headers of the request.
+	Headers map[string]string `yaml:"headers,omitempty"`
+}
+
+// Token describes the token handshake.
+//
+// The response body is used as a token, and it is sent with every metadata request in the header.
+type Token struct {
+	Request `yaml:",inline"`
+
+	// Header is the name of the request header carrying the token.
+	Header string `yaml:"header"`
+}
+
+// Endpoint describes a metadata endpoint.
+type Endpoint struct {
+	Request `yaml:",inline"`
+
+	// Name of the endpoint, it should be a valid CEL identifier.
+	Name string `yaml:"name"`
+	// Format of the response: json (default) or text.
+	Format string `yaml:"format,omitempty"`
+	// Optional endpoints resolve to null if the metadata service returns 404.
+	Optional bool `yaml:"optional,omitempty"`
+}
+
+// UserData describes the source of the machine configuration.
+//
+// Either URL or expression should be set.
+type UserData struct {
+	Request `yaml:",inline"`
+
+	// Expression is a CEL expression evaluating to the machine configuration from the metadata endpoints.
+	Expression string `yaml:"expression,omitempty"`
+	// Encoding of the user data, empty (raw) or base64.
+	Encoding string `yaml:"encoding,omitempty"`
+}
+
+// Mappings are CEL expressions mapping the metadata to the platform configuration.
+//
+// Each expression can reference the metadata endpoints by name, empty expressions are skipped.
+type Mappings struct {
+	// Hostname (string).
+	Hostname string `yaml:"hostname,omitempty"`
+	// InstanceID (string).
+	InstanceID string `yaml:"instanceID,omitempty"`
+	// InstanceType (string).
+	InstanceType string `yaml:"instanceType,omitempty"`
+	// Region (string).
+	Region string `yaml:"region,omitempty"`
+	// Zone (string).
+	Zone string `yaml:"zone,omitempty"`
+	// ProviderID (string).
+	ProviderID string `yaml:"providerID,omitempty"`
+	// Spot (bool).
+	Spot string `yaml:"spot,omitempty"`
+	// Tags (map of strings).
+	Tags string `yaml:"tags,omitempty"`
+	// ExternalIPs (list of strings).
+	ExternalIPs string `yaml:"externalIPs,omitempty"`
+	// Addresses (list of {"link": string, "address": CIDR string}).
+	Addresses string `yaml:"addresses,omitempty"`
+	// Routes (list of {"link": string, "gateway": string, "destination": CIDR string, "metric": int}).
+	//
+	// Destination defaults to the default route, metric defaults to 1024.
+	Routes string `yaml:"routes,omitempty"`
+	// DHCP4 (list of link names).
+	DHCP4 string `yaml:"dhcp4,omitempty"`
+	// DHCP6 (list of link names).
+	DHCP6 string `yaml:"dhcp6,omitempty"`
+	// DNS (list of nameserver IPs).
+	DNS string `yaml:"dns,omitempty"`
+}
+
+// userDataMapping is the name of the user data expression in the compiled programs.
+const userDataMapping = "userData"
+
+var endpointNameRe = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// ParseDescriptor parses and validates the descriptor, and compiles the mappings.
+func ParseDescriptor(data []byte) (*Descriptor, error) {
+	var descriptor Descriptor
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+
+	if err := dec.Decode(&descriptor); err != nil {
+		return nil, fmt.Errorf("failed to parse platform descriptor: %w", err)
+	}
+
+	if err := descriptor.validate(); err != nil {
+		return nil, fmt.Errorf("invalid platform descriptor: %w", err)
+	}
+
+	if err := descriptor.compile(); err != nil {
+		return nil, fmt.Errorf("invalid platform descriptor: %w", err)
+	}
+
+	return &descriptor, nil
+}
+
+//nolint:gocyclo
+func (d *Descriptor) validate() error {
+	var errs error
+
+	if d.Token != nil {
+		if d.Token.URL == "" {
+			errs = errors.Join(errs, errors.New("token: url is required"))
+		}
+
+		if d.Token.Header == "" {
+			errs = errors.Join(errs, errors.New("token: header is required"))
+		}
+	}
+
+	names := map[string]struct{}{}
+
+	for i, endpoint := range d.Endpoints {
+		if !endpointNameRe.MatchString(endpoint.Name) {
+			errs = errors.Join(errs, fmt.Errorf("endpoints[%d]: name %q is invalid", i, endpoint.Name))
+		}
+
+		if _, ok := names[endpoint.Name]; ok {
+			errs = errors.Join(errs, fmt.Errorf("endpoints[%d]: duplicate name %q", i, endpoint.Name))
+		}
+
+		names[endpoint.Name] = struct{}{}
+
+		if endpoint.URL == "" {
+			errs = errors.Join(errs, fmt.Errorf("endpoints[%d]: url is required", i))
+		}
+
+		if !slices.Contains([]string{"", FormatJSON, FormatText}, endpoint.Format) {
+			errs = errors.Join(errs, fmt.Errorf("endpoints[%d]: format %q is not supported", i, endpoint.Format))
+		}
+	}
+
+	if d.UserData != nil {
+		if (d.UserData.URL == "") == (d.UserData.Expression == "") {
+			errs = errors.Join(errs, errors.New("userData: exactly one of url or expression should be set"))
+		}
+
+		if d.UserData.Encoding != "" && d.UserData.Encoding != EncodingBase64 {
+			errs = errors.Join(errs, fmt.Errorf("userData: encoding %q is not supported", d.UserData.Encoding))
+		}
+	}
+
+	return errs
+}
+
+func (d *Descriptor) compile() error {
+	opts := []cel.EnvOption{
+		ext.Strings(),
+		ext.Encoders(),
+		ext.Lists(),
+	}
+
+	for _, endpoint := range d.Endpoints {
+		opts = append(opts, cel.Variable(endpoint.Name, cel.DynType))
+	}
+
+	var err error
+
+	d.env, err = cel.NewEnv(opts...)
+	if err != nil {
+		return err
+	}
+
+	d.programs = map[string]cel.Program{}
+
+	expressions := d.Mappings.expressions()
+
+	if d.UserData != nil && d.UserData.Expression != "" {
+		expressions = append(expressions, namedExpression{name: userDataMapping, expression: d.UserData.Expression})
+	}
+
+	var errs error
+
+	for _, expr := range expressions {
+		if expr.expression == "" {
+			continue
+		}
+
+		ast, issues := d.env.Compile(expr.expression)
+		if issues != nil && issues.Err() != nil {
+			errs = errors.Join(errs, fmt.Errorf("mapping %q: %w", expr.name, issues.Err()))
+
+			continue
+		}
+
+		prg, err := d.env.Program(ast)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("mapping %q: %w", expr.name, err))
+
+			continue
+		}
+
+		d.programs[expr.name] = prg
+	}
+
+	return errs
+}
+
+type namedExpression struct {
+	name       string
+	expression string
+}
+
+func (m Mappings) expressions() []namedExpression {
+	return []namedExpression{
+		{name: "hostname", expression: m.Hostname},
+		{name: "instanceID", expression: m.InstanceID},
+		{name: "instanceType", expression: m.InstanceType},
+		{name: "region", expression: m.Region},
+		{name: "zone", expression: m.Zone},
+		{name: "providerID", expression: m.ProviderID},
+		{name: "spot", expression: m.Spot},
+		{name: "tags", expression: m.Tags},
+		{name: "externalIPs", expression: m.ExternalIPs},
+		{name: "addresses", expression: m.Addresses},
+		{name: "routes", expression: m.Routes},
+		{name: "dhcp4", expression: m.DHCP4},
+		{name: "dhcp6", expression: m.DHCP6},
+		{name: "dns", expression: m.DNS},
+	}
+}
+
+// eval evaluates the mapping and decodes the result into the target.
+//
+// If the mapping is not set, or the result is null, the target is not modified.
+func (d *Descriptor) eval(mapping string, metadata map[string]any, target any) error {
+	prg, ok := d.programs[mapping]
+	if !ok {
+		return nil
+	}
+
+	out, _, err := prg.Eval(metadata)
+	if err != nil {
+		return fmt.Errorf("error evaluating mapping %q: %w", mapping, err)
+	}
+
+	// round-trip the result via JSON to decode it into the target
+	val, err := out.ConvertToNative(reflect.TypeFor[*structpb.Value]())
+	if err != nil {
+		return fmt.Errorf("error converting result of mapping %q: %w", mapping, err)
+	}
+
+	if _, isNull := val.(*structpb.Value).GetKind().(*structpb.Value_NullValue); isNull {
+		return nil
+	}
+
+	encoded, err := protojson.Marshal(val.(*structpb.Value))
+	if err != nil {
+		return fmt.Errorf("error converting result of mapping %q: %w", mapping, err)
+	}
+
+	if err = json.Unmarshal(encoded, target); err != nil {
+		return fmt.Errorf("unexpected result type of mapping %q: %w", mapping, err)
+	}
+
+	return nil
+}
+
+func (r Request) method(defaultMethod string) string {
+	if r.Method == "" {
+		return defaultMethod
+	}
+
+	return r.Method
+}
@@ -0,0 +1,393 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package generic provides the generic platform implementation.
+//
+// The generic platform is driven by a declarative descriptor, which describes the metadata endpoints
+// and CEL mappings from the metadata to the platform configuration.
+package generic
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"log"
+	"net/netip"
+	"slices"
+	"sync"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/xslices"
+	"github.com/siderolabs/go-procfs/procfs"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/errors"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/internal/netutils"
+	"github.com/siderolabs/talos/pkg/download"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/imager/quirks"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// Generic is the concrete type that implements the runtime.Platform interface.
+type Generic struct {
+	mu         sync.Mutex
+	descriptor *Descriptor
+}
+
+// AddressMapping is the result of the addresses mapping.
+type AddressMapping struct {
+	Link    string `json:"link"`
+	Address string `json:"address"`
+}
+
+// RouteMapping is the result of the routes mapping.
+type RouteMapping struct {
+	Link        string `json:"link"`
+	Gateway     string `json:"gateway"`
+	Destination string `json:"destination"`
+	Metric      uint32 `json:"metric"`
+}
+
+// Name implements the runtime.Platform interface.
+func (g *Generic) Name() string {
+	return "generic"
+}
+
+// ParseMetadata converts the metadata into platform network configuration using the descriptor mappings.
+//
+//nolint:gocyclo,cyclop
+func (g *Generic) ParseMetadata(descriptor *Descriptor, metadata map[string]any) (*runtime.PlatformNetworkConfig, error) {
+	networkConfig := &runtime.PlatformNetworkConfig{}
+
+	platformMetadata := &runtimeres.PlatformMetadataSpec{
+		Platform: g.Name(),
+	}
+
+	for _, m := range []struct {
+		mapping string
+		target  any
+	}{
+		{mapping: "hostname", target: &platformMetadata.Hostname},
+		{mapping: "instanceID", target: &platformMetadata.InstanceID},
+		{mapping: "instanceType", target: &platformMetadata.InstanceType},
+		{mapping: "region", target: &platformMetadata.Region},
+		{mapping: "zone", target: &platformMetadata.Zone},
+		{mapping: "providerID", target: &platformMetadata.ProviderID},
+		{mapping: "spot", target: &platformMetadata.Spot},
+		{mapping: "tags", target: &platformMetadata.Tags},
+	} {
+		if err := descriptor.eval(m.mapping, metadata, m.target); err != nil {
+			return nil, err
+		}
+	}
+
+	if platformMetadata.Hostname != "" {
+		hostnameSpec := network.HostnameSpecSpec{
+			ConfigLayer: network.ConfigPlatform,
+		}
+
+		if err := hostnameSpec.ParseFQDN(platformMetadata.Hostname); err != nil {
+			return nil, err
+		}
+
+		networkConfig.Hostnames = append(networkConfig.Hostnames, hostnameSpec)
+	}
+
+	var (
+		addresses   []AddressMapping
+		routes      []RouteMapping
+		dhcp4Links  []string
+		dhcp6Links  []string
+		dnsServers  []string
+		externalIPs []string
+	)
+
+	for _, m := range []struct {
+		mapping string
+		target  any
+	}{
+		{mapping: "addresses", target: &addresses},
+		{mapping: "routes", target: &routes},
+		{mapping: "dhcp4", target: &dhcp4Links},
+		{mapping: "dhcp6", target: &dhcp6Links},
+		{mapping: "dns", target: &dnsServers},
+		{mapping: "externalIPs", target: &externalIPs},
+	} {
+		if err := descriptor.eval(m.mapping, metadata, m.target); err != nil {
+			return nil, err
+		}
+	}
+
+	var links []string
+
+	addLink := func(name string) error {
+		if name == "" {
+			return stderrors.New("link name is required")
+		}
+
+		if !slices.Contains(links, name) {
+			links = append(links, name)
+
+			networkConfig.Links = append(networkConfig.Links, network.LinkSpecSpec{
+				Name:        name,
+				Up:          true,
+				ConfigLayer: network.ConfigPlatform,
+			})
+		}
+
+		return nil
+	}
+
+	for _, addr := range addresses {
+		if err := addLink(addr.Link); err != nil {
+			return nil, fmt.Errorf("address %q: %w", addr.Address, err)
+		}
+
+		ipPrefix, err := netip.ParsePrefix(addr.Address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse address: %w", err)
+		}
+
+		family := nethelpers.FamilyInet4
+		if ipPrefix.Addr().Is6() {
+			family = nethelpers.FamilyInet6
+		}
+
+		networkConfig.Addresses = append(networkConfig.Addresses,
+			network.AddressSpecSpec{
+				ConfigLayer: network.ConfigPlatform,
+				LinkName:    addr.Link,
+				Address:     ipPrefix,
+				Scope:       nethelpers.ScopeGlobal,
+				Flags:       nethelpers.AddressFlags(nethelpers.AddressPermanent),
+				Family:      family,
+			},
+		)
+	}
+
+	for _, rt := range routes {
+		if err := addLink(rt.Link); err != nil {
+			return nil, fmt.Errorf("route via %q: %w", rt.Gateway, err)
+		}
+
+		gw, err := netip.ParseAddr(rt.Gateway)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse route gateway: %w", err)
+		}
+
+		family := nethelpers.FamilyInet4
+		if gw.Is6() {
+			family = nethelpers.FamilyInet6
+		}
+
+		route := network.RouteSpecSpec{
+			ConfigLayer: network.ConfigPlatform,
+			Gateway:     gw,
+			OutLinkName: rt.Link,
+			Table:       nethelpers.TableMain,
+			Protocol:    nethelpers.ProtocolStatic,
+			Type:        nethelpers.TypeUnicast,
+			Family:      family,
+			Priority:    network.DefaultRouteMetric,
+		}
+
+		if rt.Destination != "" {
+			if route.Destination, err = netip.ParsePrefix(rt.Destination); err != nil {
+				return nil, fmt.Errorf("failed to parse route destination: %w", err)
+			}
+		}
+
+		if rt.Metric != 0 {
+			route.Priority = rt.Metric
+		}
+
+		route.Normalize()
+
+		networkConfig.Routes = append(networkConfig.Routes, route)
+	}
+
+	for _, link := range dhcp4Links {
+		if err := addLink(link); err != nil {
+			return nil, fmt.Errorf("dhcp4: %w", err)
+		}
+
+		networkConfig.Operators = append(networkConfig.Operators, network.OperatorSpecSpec{
+			Operator:  network.OperatorDHCP4,
+			LinkName:  link,
+			RequireUp: true,
+			DHCP4: network.DHCP4OperatorSpec{
+				RouteMetric: network.DefaultRouteMetric,
+			},
+			ConfigLayer: network.ConfigPlatform,
+		})
+	}
+
+	for _, link := range dhcp6Links {
+		if err := addLink(link); err != nil {
+			return nil, fmt.Errorf("dhcp6: %w", err)
+		}
+
+		networkConfig.Operators = append(networkConfig.Operators, network.OperatorSpecSpec{
+			Operator:  network.OperatorDHCP6,
+			LinkName:  link,
+			RequireUp: true,
+			DHCP6: network.DHCP6OperatorSpec{
+				RouteMetric: network.DefaultRouteMetric,
+			},
+			ConfigLayer: network.ConfigPlatform,
+		})
+	}
+
+	if len(dnsServers) > 0 {
+		var dnsIPs []netip.Addr
+
+		for _, server := range dnsServers {
+			ip, err := netip.ParseAddr(server)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse DNS server: %w", err)
+			}
+
+			dnsIPs = append(dnsIPs, ip)
+		}
+
+		resolverSpec := network.ResolverSpecSpec{
+			NameServers: xslices.Map(dnsIPs, func(addr netip.Addr) network.NameServerSpec {
+				return network.NameServerSpec{
+					Addr:     addr,
+					Protocol: nethelpers.DNSProtocolDefault,
+				}
+			}),
+			ConfigLayer: network.ConfigPlatform,
+		}
+		resolverSpec.Convert()
+
+		networkConfig.Resolvers = append(networkConfig.Resolvers, resolverSpec)
+	}
+
+	for _, ipStr := range externalIPs {
+		ip, err := netip.ParseAddr(ipStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse external IP: %w", err)
+		}
+
+		networkConfig.ExternalIPs = append(networkConfig.ExternalIPs, ip)
+	}
+
+	networkConfig.Metadata = platformMetadata
+
+	return networkConfig, nil
+}
+
+// Configuration implements the runtime.Platform interface.
+func (g *Generic) Configuration(ctx context.Context, r state.State) ([]byte, error) {
+	if err := netutils.Wait(ctx, r); err != nil {
+		return nil, err
+	}
+
+	descriptor, err := g.getDescriptor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if descriptor.UserData == nil {
+		return nil, errors.ErrNoConfigSource
+	}
+
+	client := newMetadataClient(descriptor)
+
+	var metadata map[string]any
+
+	if descriptor.UserData.Expression != "" {
+		if metadata, err = client.getMetadata(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	log.Printf("fetching machine config from the generic platform")
+
+	userData, err := client.getUserData(ctx, metadata)
+	if err != nil {
+		if stderrors.Is(err, errNotFound) {
+			return nil, errors.ErrNoConfigSource
+		}
+
+		return nil, err
+	}
+
+	if len(userData) == 0 {
+		return nil, errors.ErrNoConfigSource
+	}
+
+	return userData, nil
+}
+
+// Mode implements the runtime.Platform interface.
+func (g *Generic) Mode() runtime.Mode {
+	return runtime.ModeCloud
+}
+
+// KernelArgs implements the runtime.Platform interface.
+func (g *Generic) KernelArgs(string, quirks.Quirks) procfs.Parameters {
+	return nil
+}
+
+// NetworkConfiguration implements the runtime.Platform interface.
+func (g *Generic) NetworkConfiguration(ctx context.Context, _ state.State, ch chan<- *runtime.PlatformNetworkConfig) error {
+	descriptor, err := g.getDescriptor(ctx)
+	if err != nil {
+		return err
+	}
+
+	metadata, err := newMetadataClient(descriptor).getMetadata(ctx)
+	if err != nil {
+		return err
+	}
+
+	networkConfig, err := g.ParseMetadata(descriptor, metadata)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case ch <- networkConfig:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	return nil
+}
+
+// getDescriptor downloads and parses the descriptor specified with the kernel argument.
+func (g *Generic) getDescriptor(ctx context.Context) (*Descriptor, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.descriptor != nil {
+		return g.descriptor, nil
+	}
+
+	param := procfs.ProcCmdline().Get(constants.KernelParamPlatformDescriptor).First()
+	if param == nil {
+		return nil, fmt.Errorf("generic platform requires the %s kernel argument", constants.KernelParamPlatformDescriptor)
+	}
+
+	log.Printf("fetching generic platform descriptor from: %q", *param)
+
+	data, err := download.Download(ctx, *param)
+	if err != nil {
+		return nil, err
+	}
+
+	descriptor, err := ParseDescriptor(data)
+	if err != nil {
+		return nil, err
+	}
+
+	g.descriptor = descriptor
+
+	return descriptor, nil
+}
@@ -0,0 +1,120 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package generic_test
+
+import (
+	_ "embed"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.yaml.in/yaml/v4"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/generic"
+)
+
+//go:embed testdata/descriptor.yaml
+var rawDescriptor []byte
+
+//go:embed testdata/metadata.json
+var rawMetadata []byte
+
+//go:embed testdata/expected.yaml
+var expectedNetworkConfig string
+
+func TestParseMetadata(t *testing.T) {
+	p := &generic.Generic{}
+
+	descriptor, err := generic.ParseDescriptor(rawDescriptor)
+	require.NoError(t, err)
+
+	var metadata map[string]any
+
+	require.NoError(t, json.Unmarshal(rawMetadata, &metadata))
+
+	networkConfig, err := p.ParseMetadata(descriptor, metadata)
+	require.NoError(t, err)
+
+	marshaled, err := yaml.Marshal(networkConfig)
+	require.NoError(t, err)
+
+	assert.Equal(t, expectedNetworkConfig, string(marshaled))
+}
+
+func TestParseDescriptor(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name       string
+		descriptor string
+
+		expectedError string
+	}{
+		{
+			name: "minimal",
+			descriptor: `endpoints:
+  - name: meta
+    url: http://169.254.169.254/meta
+mappings:
+  hostname: meta.hostname
+`,
+		},
+		{
+			name: "unknown field",
+			descriptor: `endpoints:
+  - name: meta
+    uri: http://169.254.169.254/meta
+`,
+			expectedError: "failed to parse platform descriptor: yaml: construct errors: line 3: field uri not found in type generic.Endpoint",
+		},
+		{
+			name: "invalid endpoints",
+			descriptor: `token:
+  url: http://169.254.169.254/token
+endpoints:
+  - name: meta-data
+    url: http://169.254.169.254/meta
+  - name: meta
+    format: xml
+  - name: meta
+    url: http://169.254.169.254/meta
+userData:
+  url: http://169.254.169.254/user-data
+  expression: meta.userData
+  encoding: gzip
+`,
+			expectedError: "invalid platform descriptor: token: header is required\n" +
+				"endpoints[0]: name \"meta-data\" is invalid\n" +
+				"endpoints[1]: url is required\n" +
+				"endpoints[1]: format \"xml\" is not supported\n" +
+				"endpoints[2]: duplicate name \"meta\"\n" +
+				"userData: exactly one of url or expression should be set\n" +
+				"userData: encoding \"gzip\" is not supported",
+		},
+		{
+			name: "invalid mapping",
+			descriptor: `endpoints:
+  - name: meta
+    url: http://169.254.169.254/meta
+mappings:
+  hostname: metadata.hostname
+`,
+			expectedError: "invalid platform descriptor: mapping \"hostname\": ERROR: <input>:1:1: undeclared reference to 'metadata' (in container '')\n | metadata.hostname\n | ^",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := generic.ParseDescriptor([]byte(test.descriptor))
+
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
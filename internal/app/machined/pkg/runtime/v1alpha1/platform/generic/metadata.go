@@ -0,0 +1,180 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package generic
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"strings"
+
+	"github.com/siderolabs/talos/pkg/download"
+)
+
+var errNotFound = errors.New("not found")
+
+// metadataClient fetches the metadata as described by the descriptor.
+type metadataClient struct {
+	descriptor *Descriptor
+	client     *http.Client
+	token      string
+}
+
+func newMetadataClient(descriptor *Descriptor) *metadataClient {
+	return &metadataClient{
+		descriptor: descriptor,
+		client:     http.DefaultClient,
+	}
+}
+
+// getMetadata fetches all metadata endpoints.
+//
+// The result is a map of endpoint name to the decoded response.
+func (client *metadataClient) getMetadata(ctx context.Context) (map[string]any, error) {
+	metadata := make(map[string]any, len(client.descriptor.Endpoints))
+
+	for _, endpoint := range client.descriptor.Endpoints {
+		body, err := client.fetch(ctx, endpoint.Request)
+		if err != nil {
+			if errors.Is(err, errNotFound) && endpoint.Optional {
+				metadata[endpoint.Name] = nil
+
+				continue
+			}
+
+			return nil, fmt.Errorf("error fetching metadata endpoint %q: %w", endpoint.Name, err)
+		}
+
+		switch endpoint.Format {
+		case FormatText:
+			metadata[endpoint.Name] = strings.TrimSpace(string(body))
+		default:
+			var value any
+
+			if err = json.Unmarshal(body, &value); err != nil {
+				return nil, fmt.Errorf("error decoding metadata endpoint %q: %w", endpoint.Name, err)
+			}
+
+			metadata[endpoint.Name] = value
+		}
+	}
+
+	return metadata, nil
+}
+
+// getUserData fetches the user data.
+func (client *metadataClient) getUserData(ctx context.Context, metadata map[string]any) ([]byte, error) {
+	userData := client.descriptor.UserData
+
+	var (
+		data []byte
+		err  error
+	)
+
+	if userData.URL != "" {
+		data, err = client.fetch(ctx, userData.Request)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		var value string
+
+		if err = client.descriptor.eval(userDataMapping, metadata, &value); err != nil {
+			return nil, err
+		}
+
+		data = []byte(value)
+	}
+
+	if userData.Encoding == EncodingBase64 {
+		data, err = base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+		if err != nil {
+			return nil, fmt.Errorf("error decoding user data: %w", err)
+		}
+	}
+
+	return data, nil
+}
+
+func (client *metadataClient) fetch(ctx context.Context, request Request) ([]byte, error) {
+	headers := maps.Clone(request.Headers)
+
+	if client.descriptor.Token != nil {
+		token, err := client.getToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if headers == nil {
+			headers = map[string]string{}
+		}
+
+		headers[client.descriptor.Token.Header] = token
+	}
+
+	if request.method(http.MethodGet) == http.MethodGet {
+		return download.Download(ctx, request.URL,
+			download.WithHeaders(headers),
+			download.WithErrorOnNotFound(errNotFound),
+		)
+	}
+
+	return client.do(ctx, request.method(http.MethodGet), request.URL, headers)
+}
+
+func (client *metadataClient) getToken(ctx context.Context) (string, error) {
+	if client.token != "" {
+		return client.token, nil
+	}
+
+	token := client.descriptor.Token
+
+	body, err := client.do(ctx, token.method(http.MethodPut), token.URL, token.Headers)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch metadata token: %w", err)
+	}
+
+	client.token = strings.TrimSpace(string(body))
+	if client.token == "" {
+		return "", errors.New("failed to fetch metadata token: empty token")
+	}
+
+	return client.token, nil
+}
+
+func (client *metadataClient) do(ctx context.Context, method, url string, headers map[string]string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errNotFound
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 32)) //nolint:errcheck
+
+		return nil, fmt.Errorf("unexpected status code %d from %q, body %q", resp.StatusCode, url, string(body))
+	}
+
+	return io.ReadAll(resp.Body)
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/equinixmetal"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/exoscale"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/gcp"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/generic"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/hcloud"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/metal"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/nocloud"
@@ -114,6 +115,8 @@ func newPlatform(platform string) (p runtime.Platform, err error) {
 		p = &digitalocean.DigitalOcean{}
 	case "gcp":
 		p = &gcp.GCP{}
+	case "generic":
+		p = &generic.Generic{}
 	case "hcloud":
 		p = &hcloud.Hcloud{}
 	case constants.PlatformMetal:
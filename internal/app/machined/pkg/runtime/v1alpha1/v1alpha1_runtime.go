@@ -16,7 +16,9 @@ import (
 	"github.com/cosi-project/runtime/pkg/state"
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/configapply"
 	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/meta"
 	machineconfig "github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
@@ -35,15 +37,31 @@ type Runtime struct {
 
 	rollbackTimerMu sync.Mutex
 	rollbackTimer   *time.Timer
+
+	applier *configapply.Applier
 }
 
 // NewRuntime initializes and returns the v1alpha1 runtime.
 func NewRuntime(s runtime.State, e runtime.EventStream, l runtime.LoggingManager) *Runtime {
-	return &Runtime{
+	r := &Runtime{
 		s: s,
 		e: e,
 		l: l,
 	}
+
+	r.applier = &configapply.Applier{
+		Runtime:       r,
+		ResourceState: s.V1Alpha2().Resources(),
+		ValidationMode: configapply.InstalledMode{
+			RuntimeMode: s.Platform().Mode(),
+			Installed:   s.Machine().Installed,
+		},
+		TrustPolicy: func() ([]byte, bool) {
+			return s.Machine().Meta().ReadTagBytes(meta.ConfigTrustPolicy)
+		},
+	}
+
+	return r
 }
 
 func (r *Runtime) configProvider() config.Provider {
@@ -126,6 +144,11 @@ func (r *Runtime) SetPersistedConfig(cfg config.Provider) error {
 	return r.s.V1Alpha2().SetConfig(context.TODO(), machineconfig.PersistentID, cfg)
 }
 
+// ApplyConfig implements the Runtime interface.
+func (r *Runtime) ApplyConfig(ctx context.Context, req configapply.Request) (*configapply.Result, error) {
+	return r.applier.ApplyConfig(ctx, req)
+}
+
 // State implements the Runtime interface.
 func (r *Runtime) State() runtime.State {
 	return r.s
@@ -266,7 +266,7 @@ func (*Sequencer) Reboot(r runtime.Runtime, in *machineapi.RebootRequest) []runt
 				"dbus",
 				StopDBus,
 			).
-			AppendList(stopAllPhaselist(r, true))
+			AppendList(stopAllPhaselist(r, true, false))
 	}
 
 	return phases.Append("reboot", Reboot)
@@ -299,11 +299,14 @@ func (*Sequencer) Reset(r runtime.Runtime, in runtime.ResetOptions) []runtime.Ph
 		resetSystemDisk = true
 	}
 
+	// with the erase report, the API is kept running until the report is published
+	eraseReport := in.GetEraseReportTimeout() != nil
+
 	skipNodeRegistration := r.Config() != nil && r.Config().K8sNodeConfig() != nil && r.Config().K8sNodeConfig().SkipNodeRegistration()
 
 	switch r.State().Platform().Mode() { //nolint:exhaustive
 	case runtime.ModeContainer:
-		phases = phases.AppendList(stopAllPhaselist(r, false)).
+		phases = phases.AppendList(stopAllPhaselist(r, false, false)).
 			Append(
 				"shutdown",
 				Shutdown,
@@ -332,7 +335,7 @@ func (*Sequencer) Reset(r runtime.Runtime, in runtime.ResetOptions) []runtime.Ph
 			"preReset",
 			SendResetSignal,
 		).AppendList(
-			phaseListErrorHandler(logError, stopAllPhaselist(r, withKexec)...),
+			phaseListErrorHandler(logError, stopAllPhaselist(r, withKexec, eraseReport)...),
 		).Append(
 			"forceCleanup",
 			ForceCleanup,
@@ -348,6 +351,14 @@ func (*Sequencer) Reset(r runtime.Runtime, in runtime.ResetOptions) []runtime.Ph
 			len(in.GetUserDisksToWipe()) > 0 && resetUserDisks,
 			"resetUserDisks",
 			ResetUserDisks,
+		).AppendWhen(
+			eraseReport,
+			"eraseReport",
+			PublishEraseReport,
+		).AppendWhen(
+			eraseReport,
+			"stopEverything",
+			StopAllServices,
 		).AppendWhen(
 			in.GetReboot(),
 			"reboot",
@@ -380,7 +391,7 @@ func (*Sequencer) Shutdown(r runtime.Runtime, in *machineapi.ShutdownRequest) []
 		"dbus",
 		StopDBus,
 	).
-		AppendList(stopAllPhaselist(r, false)).
+		AppendList(stopAllPhaselist(r, false, false)).
 		Append("shutdown", Shutdown)
 
 	return phases
@@ -401,7 +412,7 @@ func (*Sequencer) StageUpgrade(r runtime.Runtime, in *machineapi.UpgradeRequest)
 			"dbus",
 			StopDBus,
 		).AppendList(
-			stopAllPhaselist(r, in.GetRebootMode() == machineapi.UpgradeRequest_DEFAULT),
+			stopAllPhaselist(r, in.GetRebootMode() == machineapi.UpgradeRequest_DEFAULT, false),
 		).Append(
 			"reboot",
 			Reboot,
@@ -504,7 +515,10 @@ func (*Sequencer) Upgrade(r runtime.Runtime, in *machineapi.UpgradeRequest) []ru
 	return phases
 }
 
-func stopAllPhaselist(r runtime.Runtime, enableKexec bool) PhaseList {
+// stopAllPhaselist returns the phases which stop all services and unmount the volumes.
+//
+// If keepAPI is set, the services required to serve the API are kept running.
+func stopAllPhaselist(r runtime.Runtime, enableKexec, keepAPI bool) PhaseList {
 	phases := PhaseList{}
 
 	switch r.State().Platform().Mode() { //nolint:exhaustive
@@ -535,9 +549,14 @@ func stopAllPhaselist(r runtime.Runtime, enableKexec bool) PhaseList {
 		).Append(
 			"volumeFinalize",
 			TeardownVolumeLifecycle,
-		).Append(
+		).AppendWhen(
+			!keepAPI,
 			"stopEverything",
 			StopAllServices,
+		).AppendWhen(
+			keepAPI,
+			"stopEverything",
+			StopAllServicesExceptAPI,
 		).AppendWhen(
 			// kexec is prepared after all services are stopped, as `kexec_file_load` needs to allocate
 			// memory for the kernel and the initramfs, and it might fail on low-memory machines while the
@@ -54,11 +54,13 @@ import (
 	"github.com/siderolabs/talos/internal/pkg/logind"
 	mountv3 "github.com/siderolabs/talos/internal/pkg/mount/v3"
 	"github.com/siderolabs/talos/internal/pkg/partition"
+	"github.com/siderolabs/talos/internal/pkg/secureerase"
 	"github.com/siderolabs/talos/pkg/conditions"
 	"github.com/siderolabs/talos/pkg/images"
 	"github.com/siderolabs/talos/pkg/kernel/kspp"
 	"github.com/siderolabs/talos/pkg/kubernetes"
 	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/api/storage"
 	configconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/machine"
 	blockcfg "github.com/siderolabs/talos/pkg/machinery/config/types/block"
@@ -68,8 +70,11 @@ import (
 	blockres "github.com/siderolabs/talos/pkg/machinery/resources/block"
 	crires "github.com/siderolabs/talos/pkg/machinery/resources/cri"
 	resourcefiles "github.com/siderolabs/talos/pkg/machinery/resources/files"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 	resourceruntime "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
 	resourcev1alpha1 "github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
 	"github.com/siderolabs/talos/pkg/minimal"
 )
@@ -459,6 +464,23 @@ func StopAllServices(runtime.Sequence, any) (runtime.TaskExecutionFunc, string)
 	}, "stopAllServices"
 }
 
+// StopAllServicesExceptAPI represents the task to stop all services except for the ones required to serve the API.
+func StopAllServicesExceptAPI(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
+		keep := []string{"apid", "containerd", "machined"}
+
+		var serviceIDs []string
+
+		for _, svc := range system.Services(r).List() {
+			if id := svc.AsProto().GetId(); !slices.Contains(keep, id) {
+				serviceIDs = append(serviceIDs, id)
+			}
+		}
+
+		return system.Services(r).StopWithRevDepenencies(ctx, serviceIDs...)
+	}, "stopAllServicesExceptAPI"
+}
+
 // DenyNewServices represents the DenyNewServices task.
 func DenyNewServices(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
@@ -1043,8 +1065,6 @@ func ResetSystemDiskPartitions(seq runtime.Sequence, _ any) (runtime.TaskExecuti
 }
 
 // ResetSystemDisk represents the task to reset the system disk.
-//
-//nolint:gocyclo
 func ResetSystemDisk(_ runtime.Sequence, data any) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
 		in, ok := data.(SystemDiskTargets)
@@ -1053,26 +1073,9 @@ func ResetSystemDisk(_ runtime.Sequence, data any) (runtime.TaskExecutionFunc, s
 		}
 
 		for _, systemDiskPath := range in.GetSystemDiskPaths() {
-			if err := func(devPath string) error {
-				logger.Printf("wiping system disk %s", devPath)
-
-				dev, err := block.NewFromPath(devPath, block.OpenForWrite())
-				if err != nil {
-					return err
-				}
+			logger.Printf("wiping system disk %s", systemDiskPath)
 
-				if err = dev.RetryLockWithTimeout(ctx, true, time.Minute); err != nil {
-					return fmt.Errorf("failed to lock device %s: %w", devPath, err)
-				}
-
-				defer dev.Close() //nolint:errcheck
-
-				if err = partition.WipeWithSignatures(dev, devPath, logger.Printf); err != nil {
-					return err
-				}
-
-				return nil
-			}(systemDiskPath); err != nil {
+			if err := eraseDisk(ctx, logger, r, systemDiskPath, in.GetWipeMethod(), eraseReportOf(data)); err != nil {
 				return fmt.Errorf("failed to wipe system disk %s: %w", systemDiskPath, err)
 			}
 		}
@@ -1089,41 +1092,169 @@ func ResetUserDisks(_ runtime.Sequence, data any) (runtime.TaskExecutionFunc, st
 			return errors.New("unexpected runtime data")
 		}
 
-		wipeDevice := func(deviceName string) error {
-			dev, err := block.NewFromPath(deviceName, block.OpenForWrite())
-			if err != nil {
+		for _, deviceName := range in.GetUserDisksToWipe() {
+			logger.Printf("wiping user disk %s", deviceName)
+
+			if err := eraseDisk(ctx, logger, r, deviceName, in.GetWipeMethod(), eraseReportOf(data)); err != nil {
 				return err
 			}
+		}
 
-			defer func() {
-				if closeErr := dev.Close(); closeErr != nil {
-					logger.Printf("failed to close device %s: %s", deviceName, closeErr)
-				}
-			}()
+		return nil
+	}, "resetUserDisks"
+}
 
-			if err = dev.RetryLockWithTimeout(ctx, true, time.Minute); err != nil {
-				return fmt.Errorf("failed to lock device %s: %w", deviceName, err)
-			}
+// EraseReporter is implemented by the reset options which collect the erase report.
+type EraseReporter interface {
+	EraseReport() *secureerase.Report
+}
 
-			defer dev.Unlock() //nolint:errcheck
+func eraseReportOf(data any) *secureerase.Report {
+	if reporter, ok := data.(EraseReporter); ok {
+		return reporter.EraseReport()
+	}
 
-			logger.Printf("wiping user disk %s", deviceName)
+	return nil
+}
 
-			if err = partition.WipeWithSignatures(dev, deviceName, logger.Printf); err != nil {
-				return err
-			}
+// eraseDisk wipes the whole disk with the specified method.
+//
+// If the erase report is enabled, the result is recorded in the report, and the error is not returned,
+// so that the remaining disks are still wiped and the report is published.
+func eraseDisk(
+	ctx context.Context, logger *log.Logger, r runtime.Runtime, devPath string, method storage.BlockDeviceWipeDescriptor_Method, report *secureerase.Report,
+) error {
+	deviceReport := secureerase.DeviceReport{
+		Device:  devPath,
+		Method:  method.String(),
+		Started: time.Now(),
+	}
 
-			return nil
+	err := func() error {
+		dev, err := block.NewFromPath(devPath, block.OpenForWrite())
+		if err != nil {
+			return err
 		}
 
-		for _, deviceName := range in.GetUserDisksToWipe() {
-			if err := wipeDevice(deviceName); err != nil {
-				return err
+		defer func() {
+			if closeErr := dev.Close(); closeErr != nil {
+				logger.Printf("failed to close device %s: %s", devPath, closeErr)
 			}
+		}()
+
+		if err = dev.RetryLockWithTimeout(ctx, true, time.Minute); err != nil {
+			return fmt.Errorf("failed to lock device %s: %w", devPath, err)
+		}
+
+		defer dev.Unlock() //nolint:errcheck
+
+		return secureerase.Erase(ctx, dev, devPath, method, logger.Printf)
+	}()
+
+	if report == nil {
+		return err
+	}
+
+	deviceReport.Finished = time.Now()
+	deviceReport.Result = secureerase.ResultSuccess
+
+	if err != nil {
+		logger.Printf("failed to wipe %s, the failure is recorded in the erase report: %s", devPath, err)
+
+		deviceReport.Result = secureerase.ResultFailed
+		deviceReport.Error = err.Error()
+	}
+
+	disks, listErr := safe.StateListAll[*blockres.Disk](ctx, r.State().V1Alpha2().Resources())
+	if listErr != nil {
+		return listErr
+	}
+
+	for disk := range disks.All() {
+		if disk.TypedSpec().DevPath != devPath {
+			continue
+		}
+
+		deviceReport.Model = disk.TypedSpec().Model
+		deviceReport.Serial = disk.TypedSpec().Serial
+		deviceReport.WWID = disk.TypedSpec().WWID
+		deviceReport.Size = disk.TypedSpec().Size
+	}
+
+	report.Devices = append(report.Devices, deviceReport)
+
+	return nil
+}
+
+// PublishEraseReport represents the task to sign and publish the erase report.
+//
+// The API is kept running for the erase report timeout, so that the report can be fetched.
+func PublishEraseReport(_ runtime.Sequence, data any) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
+		in, ok := data.(runtime.ResetOptions)
+		if !ok {
+			return errors.New("unexpected runtime data")
+		}
+
+		report := eraseReportOf(data)
+		if report == nil {
+			return errors.New("erase report is not enabled")
+		}
+
+		st := r.State().V1Alpha2().Resources()
+
+		hostnameStatus, err := safe.StateGetByID[*network.HostnameStatus](ctx, st, network.HostnameID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("failed to get hostname: %w", err)
+		}
+
+		if hostnameStatus != nil {
+			report.Hostname = hostnameStatus.TypedSpec().FQDN()
+		}
+
+		systemInformation, err := safe.StateGetByID[*hardware.SystemInformation](ctx, st, hardware.SystemInformationID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("failed to get system information: %w", err)
+		}
+
+		if systemInformation != nil {
+			report.SystemUUID = systemInformation.TypedSpec().UUID
+			report.SystemSerial = systemInformation.TypedSpec().SerialNumber
+		}
+
+		report.Timestamp = time.Now()
+
+		apiCerts, err := safe.StateGetByID[*secrets.API](ctx, st, secrets.APIID)
+		if err != nil {
+			return fmt.Errorf("failed to get API certificates: %w", err)
+		}
+
+		signed, err := secureerase.Sign(report, apiCerts.TypedSpec().Server)
+		if err != nil {
+			return err
+		}
+
+		eraseReport := resourceruntime.NewEraseReport()
+		eraseReport.TypedSpec().Report = signed.Report
+		eraseReport.TypedSpec().Signature = signed.Signature
+		eraseReport.TypedSpec().Certificate = signed.Certificate
+
+		if err = st.Create(ctx, eraseReport); err != nil {
+			return fmt.Errorf("failed to publish the erase report: %w", err)
+		}
+
+		timeout := in.GetEraseReportTimeout().AsDuration()
+
+		logger.Printf("erase report published, waiting %s for it to be fetched", timeout)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(timeout):
 		}
 
 		return nil
-	}, "resetUserDisks"
+	}, "publishEraseReport"
 }
 
 type targets struct {
@@ -1141,6 +1272,10 @@ func (opt targets) GetSystemDiskPaths() []string {
 	return opt.systemDiskPaths
 }
 
+func (opt targets) GetWipeMethod() storage.BlockDeviceWipeDescriptor_Method {
+	return storage.BlockDeviceWipeDescriptor_FAST
+}
+
 func (opt targets) String() string {
 	return strings.Join(xslices.Map(opt.systemDiskTargets, func(t *partition.VolumeWipeTarget) string { return t.String() }), ", ")
 }
@@ -1192,6 +1327,7 @@ func parseTargets(ctx context.Context, r runtime.Runtime, wipeStr string) (Syste
 type SystemDiskTargets interface {
 	GetSystemDiskTargets() []runtime.PartitionTarget
 	GetSystemDiskPaths() []string
+	GetWipeMethod() storage.BlockDeviceWipeDescriptor_Method
 	fmt.Stringer
 }
 
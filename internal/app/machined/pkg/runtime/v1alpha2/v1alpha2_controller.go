@@ -43,6 +43,7 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	runtimelogging "github.com/siderolabs/talos/internal/app/machined/pkg/runtime/logging"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system"
+	"github.com/siderolabs/talos/internal/pkg/attestation"
 	"github.com/siderolabs/talos/internal/pkg/lvm"
 	"github.com/siderolabs/talos/internal/pkg/md"
 	"github.com/siderolabs/talos/internal/pkg/selinux"
@@ -154,6 +155,9 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		return fmt.Errorf("failed to initialize MD: %w", err)
 	}
 
+	// PCR 11 value in the enter-machined phase is recorded for the attestation against the signed PCR policy
+	phaseLog := &attestation.PhaseLog{}
+
 	for _, c := range []controller.Controller{
 		&block.DevicesController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
@@ -224,6 +228,7 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&cluster.AffiliateMergeController{},
 		cluster.NewConfigController(),
 		&cluster.DiscoveryServiceController{},
+		&cluster.EmbeddedDiscoveryController{},
 		&cluster.EndpointController{},
 		cluster.NewInfoController(),
 		&cluster.KubernetesPullController{},
@@ -248,6 +253,11 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		},
 		&config.MachineTypeController{},
 		&config.PersistenceController{},
+		&config.SourceController{
+			ConfigApplier:     ctrl.v1alpha1Runtime,
+			Reboot:            ctrl.reboot,
+			WaitForDisruption: runtimecontrollers.WaitForRebootSlot(ctrl.v1alpha1Runtime.State().V1Alpha2().Resources()),
+		},
 		&cri.ImageCacheConfigController{
 			V1Alpha1ServiceManager: system.Services(ctrl.v1alpha1Runtime),
 		},
@@ -271,8 +281,11 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		},
 		&etcd.AdvertisedPeerController{},
 		etcd.NewConfigController(),
-		&etcd.PKIController{},
+		&etcd.PKIController{
+			V1Alpha1Services: system.Services(ctrl.v1alpha1Runtime),
+		},
 		&etcd.SpecController{},
+		&etcd.MaintenanceController{},
 		&etcd.MemberController{},
 		&files.CRIBaseRuntimeSpecController{},
 		&files.CRIConfigController{
@@ -301,7 +314,13 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&hardware.PCIDriverRebindController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
 		},
-		&hardware.PCRStatusController{},
+		&hardware.PCRStatusController{
+			PhaseRecorder: phaseLog.Record,
+		},
+		&hardware.SRIOVConfigController{},
+		&hardware.SRIOVController{
+			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
+		},
 		&hardware.SystemInfoController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
 		},
@@ -322,6 +341,7 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&k8s.EndpointController{},
 		&k8s.EtcdEncryptionConfigController{},
 		&k8s.ExtraManifestController{},
+		&k8s.KMSPluginController{},
 		k8s.NewKubeletConfigController(),
 		&k8s.KubeletKubeconfigController{},
 		&k8s.KubeletServiceController{
@@ -376,6 +396,8 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 			Logger: dnsCacheLogger,
 		},
 		&network.DNSUpstreamController{},
+		&network.Dot1XConfigController{},
+		&network.Dot1XController{},
 		&network.EtcFileController{
 			EtcRoot:         networkEtcRoot,
 			BindMountTarget: networkBindMountTarget,
@@ -468,6 +490,8 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		},
 		&runtimecontrollers.DiagnosticsController{},
 		&runtimecontrollers.DiagnosticsLoggerController{},
+		&runtimecontrollers.DisruptionController{},
+		&runtimecontrollers.DisruptionLockController{},
 		&runtimecontrollers.DropUpgradeFallbackController{
 			MetaProvider: ctrl.v1alpha1Runtime.State().Machine(),
 		},
@@ -548,7 +572,9 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 			V1Alpha1Services: system.Services(ctrl.v1alpha1Runtime),
 		},
 		&secrets.APICertSANsController{},
-		&secrets.APIController{},
+		&secrets.APIController{
+			PhaseLog: phaseLog,
+		},
 		&secrets.EncryptionSaltController{},
 		&secrets.EtcdController{},
 		secrets.NewKubeletController(),
@@ -563,6 +589,7 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&secrets.TrustedRootsController{},
 		&secrets.TrustdController{},
 		&security.ImageVerificationConfigController{},
+		&security.NodeAttestationConfigController{},
 		&security.TUFTrustedRootController{},
 		&siderolink.ConfigController{
 			Cmdline:      procfs.ProcCmdline(),
@@ -138,6 +138,7 @@ func NewState() (*State, error) {
 		&etcd.PKIStatus{},
 		&etcd.Spec{},
 		&etcd.Member{},
+		&etcd.MaintenanceStatus{},
 		&files.EtcFileSpec{},
 		&files.EtcFileStatus{},
 		&hardware.CPUCore{},
@@ -147,6 +148,8 @@ func NewState() (*State, error) {
 		&hardware.PCIDriverRebindStatus{},
 		&hardware.PCRStatus{},
 		&hardware.Processor{},
+		&hardware.SRIOVConfig{},
+		&hardware.SRIOVStatus{},
 		&hardware.SystemInformation{},
 		&k8s.AdmissionControlConfig{},
 		&k8s.AuditPolicyConfig{},
@@ -159,6 +162,7 @@ func NewState() (*State, error) {
 		&k8s.Endpoint{},
 		&k8s.EtcdEncryptionConfig{},
 		&k8s.ExtraManifestsConfig{},
+		&k8s.KMSPluginStatus{},
 		&k8s.KubeletConfig{},
 		&k8s.KubeletKubeconfig{},
 		&k8s.KubeletLifecycle{},
@@ -192,9 +196,12 @@ func NewState() (*State, error) {
 		&network.AddressSpec{},
 		&network.BGPInstanceConfig{},
 		&network.BGPPeerStatus{},
+		&network.DelegatedPrefix{},
 		&network.DeviceConfigSpec{},
 		&network.DNSResolveCache{},
 		&network.DNSUpstream{},
+		&network.Dot1XConfig{},
+		&network.Dot1XStatus{},
 		&network.EthernetSpec{},
 		&network.EthernetStatus{},
 		&network.HardwareAddr{},
@@ -223,6 +230,7 @@ func NewState() (*State, error) {
 		&network.Status{},
 		&network.TimeServerStatus{},
 		&network.TimeServerSpec{},
+		&network.VIPStatus{},
 		&perf.CPU{},
 		&perf.Memory{},
 		&cri.BaseRuntimeSpecConfig{},
@@ -230,10 +238,16 @@ func NewState() (*State, error) {
 		&cri.RegistriesConfig{},
 		&runtime.APIServiceConfig{},
 		&runtime.BootedEntry{},
+		&runtime.ConfigSourceStatus{},
 		&runtime.BootID{},
 		&runtime.DevicesStatus{},
 		&runtime.Diagnostic{},
+		&runtime.DisruptionLockRequest{},
+		&runtime.DisruptionLockStatus{},
+		&runtime.DisruptionRequest{},
+		&runtime.DisruptionStatus{},
 		&runtime.Environment{},
+		&runtime.EraseReport{},
 		&runtime.EventSinkConfig{},
 		&runtime.ExtensionServiceConfig{},
 		&runtime.ExtensionServiceConfigStatus{},
@@ -278,6 +292,8 @@ func NewState() (*State, error) {
 		&secrets.OSRoot{},
 		&secrets.Trustd{},
 		&security.ImageVerificationRule{},
+		&security.NodeAttestation{},
+		&security.NodeAttestationPolicy{},
 		&security.TUFTrustedRoot{},
 		&siderolink.Config{},
 		&siderolink.Status{},
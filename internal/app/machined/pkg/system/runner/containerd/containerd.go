@@ -420,12 +420,15 @@ func (c *containerdRunner) newOCISpecOpts(image oci.Image) []oci.SpecOpts {
 		c.opts.OCISpecOpts...,
 	)
 
-	if c.opts.OverrideSeccompProfile != nil {
+	switch {
+	case c.opts.SeccompDisabled:
+		// no seccomp profile, the process is unconfined
+	case c.opts.OverrideSeccompProfile != nil:
 		specOpts = append(
 			specOpts,
 			WithCustomSeccompProfile(c.opts.OverrideSeccompProfile),
 		)
-	} else {
+	default:
 		specOpts = append(
 			specOpts,
 			seccomp.WithDefaultProfile(), // add seccomp profile last, as it depends on process capabilities
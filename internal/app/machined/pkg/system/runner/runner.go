@@ -72,6 +72,8 @@ type Options struct {
 	CgroupPath string
 	// OverrideSeccompProfile default Linux seccomp profile.
 	OverrideSeccompProfile func(*specs.LinuxSeccomp)
+	// SeccompDisabled disables the seccomp profile.
+	SeccompDisabled bool
 	// DroppedCapabilities is the list of capabilities to drop.
 	DroppedCapabilities []string
 	// SelinuxLabel is the SELinux label to be assigned
@@ -206,6 +208,13 @@ func WithCustomSeccompProfile(override func(*specs.LinuxSeccomp)) Option {
 	}
 }
 
+// WithoutSeccompProfile disables the seccomp profile.
+func WithoutSeccompProfile() Option {
+	return func(args *Options) {
+		args.SeccompDisabled = true
+	}
+}
+
 // WithDroppedCapabilities sets the list of capabilities to drop.
 func WithDroppedCapabilities(caps map[string]struct{}) Option {
 	return func(args *Options) {
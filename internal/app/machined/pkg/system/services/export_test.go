@@ -10,6 +10,8 @@ import (
 	"github.com/containerd/containerd/v2/pkg/oci"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/gen/xslices"
+
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 )
 
 // CreateOverlayMountRequests exposes createOverlayMountRequests for tests.
@@ -19,12 +21,22 @@ func CreateOverlayMountRequests(ctx context.Context, st state.State) error {
 
 // GetOCIOptions gets all OCI options from an Extension.
 func (svc *Extension) GetOCIOptions() ([]oci.SpecOpts, error) {
+	return svc.GetOCIOptionsWithOverrides(runtimeres.ExtensionServiceConfigSpec{})
+}
+
+// GetOCIOptionsWithOverrides gets all OCI options from an Extension with the config overrides applied.
+func (svc *Extension) GetOCIOptionsWithOverrides(overrides runtimeres.ExtensionServiceConfigSpec) ([]oci.SpecOpts, error) {
 	envVars, err := svc.parseEnvironment()
 	if err != nil {
 		return nil, err
 	}
 
-	return svc.getOCIOptions(envVars, svc.Spec.Container.Mounts), nil
+	sandbox, err := svc.buildSandbox(overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	return svc.getOCIOptions(envVars, svc.Spec.Container.Mounts, sandbox), nil
 }
 
 // PromotionEndpoints exposes promotionEndpoints for tests.
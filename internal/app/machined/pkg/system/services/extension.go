@@ -25,7 +25,6 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system/runner"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system/runner/containerd"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system/runner/restart"
-	"github.com/siderolabs/talos/internal/pkg/capability"
 	"github.com/siderolabs/talos/internal/pkg/environment"
 	"github.com/siderolabs/talos/internal/pkg/mount/v3"
 	"github.com/siderolabs/talos/pkg/conditions"
@@ -121,16 +120,16 @@ func (svc *Extension) Volumes(runtime.Runtime) []string {
 	return nil
 }
 
-func (svc *Extension) getOCIOptions(envVars []string, mounts []specs.Mount) []oci.SpecOpts {
+func (svc *Extension) getOCIOptions(envVars []string, mounts []specs.Mount, sandbox extensionSandbox) []oci.SpecOpts {
 	ociOpts := []oci.SpecOpts{
-		oci.WithRootFSPath(filepath.Join(constants.ExtensionServiceRootfsPath, svc.Spec.Name)),
+		oci.WithRootFSPath(svc.rootfsPath()),
 		containerd.WithRootfsPropagation(svc.Spec.Container.Security.RootfsPropagation),
 		oci.WithMounts(mounts),
 		oci.WithHostNamespace(specs.NetworkNamespace),
 		oci.WithHostNamespace(specs.IPCNamespace),
 		oci.WithSelinuxLabel(""),
 		oci.WithApparmorProfile(""),
-		oci.WithCapabilities(capability.AllGrantableCapabilities()),
+		oci.WithCapabilities(sandbox.capabilities),
 		oci.WithAllDevicesAllowed,
 		oci.WithEnv(envVars),
 		func(_ context.Context, _ oci.Client, _ *containers.Container, spec *oci.Spec) error {
@@ -142,7 +141,7 @@ func (svc *Extension) getOCIOptions(envVars []string, mounts []specs.Mount) []oc
 		},
 	}
 
-	if !svc.Spec.Container.Security.WriteableRootfs {
+	if sandbox.readonlyRootfs {
 		ociOpts = append(ociOpts, oci.WithRootFSReadonly())
 	}
 
@@ -158,9 +157,21 @@ func (svc *Extension) getOCIOptions(envVars []string, mounts []specs.Mount) []oc
 		ociOpts = append(ociOpts, oci.WithReadonlyPaths(svc.Spec.Container.Security.ReadonlyPaths))
 	}
 
+	if sandbox.oomScoreAdj != nil {
+		ociOpts = append(ociOpts, containerd.WithOOMScoreAdj(*sandbox.oomScoreAdj))
+	}
+
+	if len(sandbox.cgroupResources) > 0 {
+		ociOpts = append(ociOpts, withUnifiedResources(sandbox.cgroupResources))
+	}
+
 	return ociOpts
 }
 
+func (svc *Extension) rootfsPath() string {
+	return filepath.Join(constants.ExtensionServiceRootfsPath, svc.Spec.Name)
+}
+
 // Runner implements the Service interface.
 //
 //nolint:gocyclo
@@ -192,10 +203,14 @@ func (svc *Extension) Runner(r runtime.Runtime) (runner.Runner, error) {
 		return nil, err
 	}
 
+	var overrides runtimeres.ExtensionServiceConfigSpec
+
 	configSpec, err := safe.StateGetByID[*runtimeres.ExtensionServiceConfig](context.Background(), r.State().V1Alpha2().Resources(), svc.Spec.Name)
 	if err == nil {
 		spec := configSpec.TypedSpec()
 
+		overrides = *spec
+
 		for _, ext := range spec.Files {
 			mounts = append(mounts, specs.Mount{
 				Source:      filepath.Join(constants.ExtensionServiceUserConfigPath, svc.Spec.Name, strings.ReplaceAll(strings.TrimPrefix(ext.MountPath, "/"), "/", "-")),
@@ -221,7 +236,12 @@ func (svc *Extension) Runner(r runtime.Runtime) (runner.Runner, error) {
 		restartType = restart.UntilSuccess
 	}
 
-	ociSpecOpts := svc.getOCIOptions(envVars, mounts)
+	sandbox, err := svc.buildSandbox(overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	ociSpecOpts := svc.getOCIOptions(envVars, mounts, sandbox)
 
 	logToConsole := false
 
@@ -233,17 +253,28 @@ func (svc *Extension) Runner(r runtime.Runtime) (runner.Runner, error) {
 		logToConsole = true
 	}
 
+	runnerOpts := []runner.Option{
+		runner.WithLoggingManager(r.Logging()),
+		runner.WithNamespace(constants.SystemContainerdNamespace),
+		runner.WithContainerdAddress(constants.SystemContainerdAddress),
+		runner.WithEnv(environment.Get(r.Config())),
+		runner.WithOCISpecOpts(ociSpecOpts...),
+		runner.WithCgroupPath(filepath.Join(constants.CgroupExtensions, svc.Spec.Name)),
+		runner.WithOOMScoreAdj(-600),
+	}
+
+	switch {
+	case sandbox.seccompProfile == extservices.SeccompProfileUnconfined:
+		runnerOpts = append(runnerOpts, runner.WithoutSeccompProfile())
+	case sandbox.seccompOverride != nil:
+		runnerOpts = append(runnerOpts, runner.WithCustomSeccompProfile(sandbox.seccompOverride))
+	}
+
 	return restart.New(
 		containerd.NewRunner(
 			logToConsole,
 			&args,
-			runner.WithLoggingManager(r.Logging()),
-			runner.WithNamespace(constants.SystemContainerdNamespace),
-			runner.WithContainerdAddress(constants.SystemContainerdAddress),
-			runner.WithEnv(environment.Get(r.Config())),
-			runner.WithOCISpecOpts(ociSpecOpts...),
-			runner.WithCgroupPath(filepath.Join(constants.CgroupExtensions, svc.Spec.Name)),
-			runner.WithOOMScoreAdj(-600),
+			runnerOpts...,
 		),
 		restart.WithType(restartType),
 	), nil
@@ -0,0 +1,236 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/containerd/containerd/v2/core/containers"
+	"github.com/containerd/containerd/v2/pkg/oci"
+	"github.com/dustin/go-humanize"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+
+	"github.com/siderolabs/talos/internal/pkg/capability"
+	"github.com/siderolabs/talos/internal/pkg/containermode"
+	extservices "github.com/siderolabs/talos/pkg/machinery/extensions/services"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// cpuPeriod is the cgroup v2 `cpu.max` period (in microseconds).
+const cpuPeriod = 100000
+
+// extensionSandbox is the effective sandbox of the extension service.
+//
+// It combines the settings from the extension spec with the overrides from the ExtensionServiceConfig.
+type extensionSandbox struct {
+	capabilities    []string
+	readonlyRootfs  bool
+	seccompProfile  string
+	seccompOverride func(*specs.LinuxSeccomp)
+	oomScoreAdj     *int
+	cgroupResources map[string]string
+}
+
+// buildSandbox merges the extension spec security and resources settings with the overrides.
+func (svc *Extension) buildSandbox(overrides runtimeres.ExtensionServiceConfigSpec) (extensionSandbox, error) {
+	var (
+		sandbox extensionSandbox
+		err     error
+	)
+
+	security := svc.Spec.Container.Security
+
+	if sandbox.capabilities, err = svc.buildCapabilities(overrides.Security.DropCapabilities); err != nil {
+		return sandbox, err
+	}
+
+	sandbox.readonlyRootfs = !security.WriteableRootfs || overrides.Security.ReadonlyRootfs
+
+	sandbox.seccompProfile = security.SeccompProfile
+
+	if overrides.Security.SeccompProfile != "" {
+		sandbox.seccompProfile = overrides.Security.SeccompProfile
+	}
+
+	if path, ok := strings.CutPrefix(sandbox.seccompProfile, extservices.SeccompProfileLocalhostPrefix); ok {
+		var profile *specs.LinuxSeccomp
+
+		if profile, err = loadSeccompProfile(filepath.Join(svc.rootfsPath(), path)); err != nil {
+			return sandbox, err
+		}
+
+		sandbox.seccompOverride = func(s *specs.LinuxSeccomp) {
+			*s = *profile
+		}
+	}
+
+	switch {
+	case overrides.Resources.OOMScoreAdj != nil:
+		sandbox.oomScoreAdj = new(int(*overrides.Resources.OOMScoreAdj))
+	case svc.Spec.Container.Resources.OOMScoreAdj != nil:
+		sandbox.oomScoreAdj = new(*svc.Spec.Container.Resources.OOMScoreAdj)
+	}
+
+	// cgroup limits can't be managed when running in a container
+	if !containermode.InContainer() {
+		if sandbox.cgroupResources, err = svc.buildCgroupResources(overrides.Resources); err != nil {
+			return sandbox, err
+		}
+	}
+
+	return sandbox, nil
+}
+
+// buildCapabilities returns the list of capabilities granted to the extension service.
+//
+// The extension starts with all grantable capabilities, then the capabilities are dropped and added as per the extension spec,
+// and finally the capabilities are dropped as per the overrides.
+func (svc *Extension) buildCapabilities(dropOverrides []string) ([]string, error) {
+	grantable := capability.AllGrantableCapabilities()
+
+	capabilities := make(map[string]struct{}, len(grantable))
+
+	for _, c := range grantable {
+		capabilities[c] = struct{}{}
+	}
+
+	drop := func(dropped []string) {
+		for _, c := range dropped {
+			c = strings.ToUpper(c)
+
+			if c == extservices.CapabilityAll {
+				clear(capabilities)
+
+				return
+			}
+
+			delete(capabilities, c)
+		}
+	}
+
+	drop(svc.Spec.Container.Security.Capabilities.Drop)
+
+	for _, c := range svc.Spec.Container.Security.Capabilities.Add {
+		c = strings.ToUpper(c)
+
+		if !slices.Contains(grantable, c) {
+			return nil, fmt.Errorf("capability %q can't be granted to the extension service %q", c, svc.Spec.Name)
+		}
+
+		capabilities[c] = struct{}{}
+	}
+
+	drop(dropOverrides)
+
+	result := make([]string, 0, len(capabilities))
+
+	for c := range capabilities {
+		result = append(result, c)
+	}
+
+	slices.Sort(result)
+
+	return result, nil
+}
+
+// buildCgroupResources returns the cgroup v2 unified resources for the extension service.
+func (svc *Extension) buildCgroupResources(overrides runtimeres.ExtensionServiceResourcesSpec) (map[string]string, error) {
+	spec := svc.Spec.Container.Resources
+	resources := map[string]string{}
+
+	cpuWeight := spec.CPUWeight
+
+	if overrides.CPUWeight != 0 {
+		cpuWeight = overrides.CPUWeight
+	}
+
+	if cpuWeight != 0 {
+		resources["cpu.weight"] = strconv.FormatUint(cpuWeight, 10)
+	}
+
+	cpuMillicores := overrides.CPUMaxMillicores
+
+	if cpuMillicores == 0 && spec.CPUMax != "" {
+		var err error
+
+		if cpuMillicores, err = extservices.ParseMillicores(spec.CPUMax); err != nil {
+			return nil, err
+		}
+	}
+
+	if cpuMillicores != 0 {
+		resources["cpu.max"] = fmt.Sprintf("%d %d", cpuMillicores*cpuPeriod/1000, cpuPeriod)
+	}
+
+	for _, limit := range []struct {
+		key      string
+		spec     string
+		override uint64
+	}{
+		{key: "memory.max", spec: spec.MemoryMax, override: overrides.MemoryMax},
+		{key: "memory.high", spec: spec.MemoryHigh, override: overrides.MemoryHigh},
+	} {
+		value := limit.override
+
+		if value == 0 && limit.spec != "" {
+			var err error
+
+			if value, err = humanize.ParseBytes(limit.spec); err != nil {
+				return nil, fmt.Errorf("failed to parse %s: %w", limit.key, err)
+			}
+		}
+
+		if value != 0 {
+			resources[limit.key] = strconv.FormatUint(value, 10)
+		}
+	}
+
+	ioWeight := spec.IOWeight
+
+	if overrides.IOWeight != 0 {
+		ioWeight = overrides.IOWeight
+	}
+
+	if ioWeight != 0 {
+		resources["io.weight"] = "default " + strconv.FormatUint(ioWeight, 10)
+	}
+
+	return resources, nil
+}
+
+func loadSeccompProfile(path string) (*specs.LinuxSeccomp, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read seccomp profile: %w", err)
+	}
+
+	var profile specs.LinuxSeccomp
+
+	if err = json.Unmarshal(contents, &profile); err != nil {
+		return nil, fmt.Errorf("failed to parse seccomp profile %q: %w", path, err)
+	}
+
+	return &profile, nil
+}
+
+// withUnifiedResources sets the cgroup v2 resources.
+func withUnifiedResources(unified map[string]string) oci.SpecOpts {
+	return func(_ context.Context, _ oci.Client, _ *containers.Container, spec *oci.Spec) error {
+		if spec.Linux.Resources == nil {
+			spec.Linux.Resources = &specs.LinuxResources{}
+		}
+
+		spec.Linux.Resources.Unified = unified
+
+		return nil
+	}
+}
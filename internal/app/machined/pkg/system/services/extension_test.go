@@ -18,6 +18,7 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system/services"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system/services/mocks"
 	extservices "github.com/siderolabs/talos/pkg/machinery/extensions/services"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 )
 
 type MockClient struct {
@@ -185,4 +186,123 @@ func TestGetOCIOptions(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, []string{"FOO=BARFROMENVFILE"}, spec.Process.Env)
 	})
+
+	t.Run("capabilities are dropped and added", func(t *testing.T) {
+		// given
+		svc := &services.Extension{
+			Spec: extservices.Spec{
+				Container: extservices.Container{
+					Security: extservices.Security{
+						Capabilities: extservices.Capabilities{
+							Drop: []string{"ALL"},
+							Add:  []string{"cap_net_admin", "CAP_NET_RAW", "CAP_SYS_TIME"},
+						},
+					},
+				},
+			},
+		}
+
+		// when
+		ociOpts, err := svc.GetOCIOptionsWithOverrides(runtimeres.ExtensionServiceConfigSpec{
+			Security: runtimeres.ExtensionServiceSecuritySpec{
+				DropCapabilities: []string{"CAP_SYS_TIME"},
+			},
+		})
+		assert.NoError(t, err)
+
+		spec, err := oci.GenerateSpec(namespaces.WithNamespace(t.Context(), "testNamespace"), &mockClient, &containers.Container{}, ociOpts...)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"CAP_NET_ADMIN", "CAP_NET_RAW"}, spec.Process.Capabilities.Bounding)
+	})
+
+	t.Run("capabilities which can't be granted are rejected", func(t *testing.T) {
+		// given
+		svc := &services.Extension{
+			Spec: extservices.Spec{
+				Name: "test",
+				Container: extservices.Container{
+					Security: extservices.Security{
+						Capabilities: extservices.Capabilities{
+							Add: []string{"CAP_SYS_MODULE"},
+						},
+					},
+				},
+			},
+		}
+
+		// when
+		_, err := svc.GetOCIOptions()
+
+		// then
+		assert.EqualError(t, err, `capability "CAP_SYS_MODULE" can't be granted to the extension service "test"`)
+	})
+
+	t.Run("root fs can be forced to be readonly", func(t *testing.T) {
+		// given
+		svc := &services.Extension{
+			Spec: extservices.Spec{
+				Container: extservices.Container{
+					Security: extservices.Security{
+						WriteableRootfs: true,
+					},
+				},
+			},
+		}
+
+		// when
+		ociOpts, err := svc.GetOCIOptionsWithOverrides(runtimeres.ExtensionServiceConfigSpec{
+			Security: runtimeres.ExtensionServiceSecuritySpec{
+				ReadonlyRootfs: true,
+			},
+		})
+		assert.NoError(t, err)
+
+		spec, err := oci.GenerateSpec(namespaces.WithNamespace(t.Context(), "testNamespace"), &mockClient, &containers.Container{}, ociOpts...)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, true, spec.Root.Readonly)
+	})
+
+	t.Run("resource limits are applied with overrides", func(t *testing.T) {
+		// given
+		svc := &services.Extension{
+			Spec: extservices.Spec{
+				Container: extservices.Container{
+					Resources: extservices.Resources{
+						CPUWeight:   50,
+						CPUMax:      "1500m",
+						MemoryMax:   "512MiB",
+						IOWeight:    20,
+						OOMScoreAdj: new(100),
+					},
+				},
+			},
+		}
+
+		// when
+		ociOpts, err := svc.GetOCIOptionsWithOverrides(runtimeres.ExtensionServiceConfigSpec{
+			Resources: runtimeres.ExtensionServiceResourcesSpec{
+				CPUMaxMillicores: 250,
+				MemoryHigh:       128 * 1024 * 1024,
+				OOMScoreAdj:      new(int32(500)),
+			},
+		})
+		assert.NoError(t, err)
+
+		spec, err := oci.GenerateSpec(namespaces.WithNamespace(t.Context(), "testNamespace"), &mockClient, &containers.Container{}, ociOpts...)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"cpu.weight":  "50",
+			"cpu.max":     "25000 100000",
+			"memory.max":  "536870912",
+			"memory.high": "134217728",
+			"io.weight":   "default 20",
+		}, spec.Linux.Resources.Unified)
+		assert.Equal(t, 500, *spec.Process.OOMScoreAdj)
+	})
 }
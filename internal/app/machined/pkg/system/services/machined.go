@@ -54,6 +54,14 @@ var rules = map[string]role.Set{
 
 	"/machine.StorageService/Statfs": role.MakeSet(role.Admin, role.Operator, role.Reader),
 
+	"/machine.NetworkDiagnosticsService/DNSLookup":    role.MakeSet(role.Admin, role.Operator),
+	"/machine.NetworkDiagnosticsService/HTTPGet":      role.MakeSet(role.Admin, role.Operator),
+	"/machine.NetworkDiagnosticsService/PathMTU":      role.MakeSet(role.Admin, role.Operator),
+	"/machine.NetworkDiagnosticsService/Ping":         role.MakeSet(role.Admin, role.Operator),
+	"/machine.NetworkDiagnosticsService/TCPConnect":   role.MakeSet(role.Admin, role.Operator),
+	"/machine.NetworkDiagnosticsService/TLSHandshake": role.MakeSet(role.Admin, role.Operator),
+	"/machine.NetworkDiagnosticsService/Traceroute":   role.MakeSet(role.Admin, role.Operator),
+
 	"/machine.MachineService/ApplyConfiguration": role.MakeSet(
 		role.Admin,
 		// for maintenance only, verified in the handler
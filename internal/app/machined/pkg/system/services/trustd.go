@@ -39,6 +39,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/resources/security"
 	timeresource "github.com/siderolabs/talos/pkg/machinery/resources/time"
 )
 
@@ -63,6 +64,16 @@ func (t *Trustd) PreFunc(ctx context.Context, r runtime.Runtime) error {
 	resources := state.Filter(
 		r.State().V1Alpha2().Resources(),
 		func(ctx context.Context, access state.Access) error {
+			// trustd records the results of node attestation
+			if access.ResourceNamespace == security.NamespaceName && access.ResourceType == security.NodeAttestationType {
+				return nil
+			}
+
+			// trustd records the disruption lock requests of the worker nodes
+			if access.ResourceNamespace == runtimeres.NamespaceName && access.ResourceType == runtimeres.DisruptionLockRequestType {
+				return nil
+			}
+
 			if !access.Verb.Readonly() {
 				return errors.New("write access denied")
 			}
@@ -70,6 +81,8 @@ func (t *Trustd) PreFunc(ctx context.Context, r runtime.Runtime) error {
 			switch {
 			case access.ResourceNamespace == secrets.NamespaceName && access.ResourceType == secrets.TrustdType && access.ResourceID == secrets.TrustdID:
 			case access.ResourceNamespace == secrets.NamespaceName && access.ResourceType == secrets.OSRootType && access.ResourceID == secrets.OSRootID:
+			case access.ResourceNamespace == security.NamespaceName && access.ResourceType == security.NodeAttestationPolicyType && access.ResourceID == security.NodeAttestationPolicyID:
+			case access.ResourceNamespace == runtimeres.NamespaceName && access.ResourceType == runtimeres.DisruptionLockStatusType:
 			default:
 				return errors.New("access denied")
 			}
@@ -0,0 +1,157 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package netdiag
+
+import (
+	"net"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+const resolvConfPath = "/etc/resolv.conf"
+
+// defaultResolver is used if resolv.conf can't be read: the host DNS listener.
+var defaultResolver = netip.MustParseAddrPort("127.0.0.53:53")
+
+// DNSLookup implements machine.NetworkDiagnosticsServiceServer.
+func (svc *Service) DNSLookup(req *machine.NetworkDiagnosticsDNSLookupRequest, srv grpc.ServerStreamingServer[machine.NetworkDiagnosticsDNSLookupResponse]) error {
+	ctx := srv.Context()
+
+	src, err := parseSource(req.GetSource())
+	if err != nil {
+		return err
+	}
+
+	if req.GetName() == "" {
+		return status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	types, err := parseRecordTypes(req.GetTypes())
+	if err != nil {
+		return err
+	}
+
+	resolver, err := parseResolver(req.GetResolver())
+	if err != nil {
+		return err
+	}
+
+	svc.logger.Info("dns lookup", zap.String("name", req.GetName()), zap.String("resolver", resolver), zap.Stringer("source", src))
+
+	network := "udp"
+	if req.GetTcp() {
+		network = "tcp"
+	}
+
+	timeout := durationOr(req.GetTimeout(), 5*time.Second)
+
+	client := &dns.Client{
+		Net:     network,
+		Timeout: timeout,
+		Dialer:  src.dialer(network, timeout),
+	}
+
+	for _, qtype := range types {
+		name := req.GetName()
+
+		if qtype == dns.TypePTR {
+			if reverse, err := dns.ReverseAddr(name); err == nil {
+				name = reverse
+			}
+		}
+
+		msg := new(dns.Msg)
+		msg.SetQuestion(dns.Fqdn(name), qtype)
+
+		resp := &machine.NetworkDiagnosticsDNSLookupResponse{
+			Type:     dns.TypeToString[qtype],
+			Resolver: resolver,
+		}
+
+		reply, rtt, err := client.ExchangeContext(ctx, msg, resolver)
+		if err != nil {
+			resp.Error = err.Error()
+		} else {
+			resp.Rcode = dns.RcodeToString[reply.Rcode]
+			resp.Rtt = durationpb.New(rtt)
+			resp.Answers = records(reply.Answer)
+		}
+
+		if err = srv.Send(resp); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func parseRecordTypes(types []string) ([]uint16, error) {
+	if len(types) == 0 {
+		return []uint16{dns.TypeA, dns.TypeAAAA}, nil
+	}
+
+	result := make([]uint16, 0, len(types))
+
+	for _, t := range types {
+		qtype, ok := dns.StringToType[strings.ToUpper(t)]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown record type %q", t)
+		}
+
+		result = append(result, qtype)
+	}
+
+	return result, nil
+}
+
+// parseResolver returns the resolver address with the port.
+func parseResolver(resolver string) (string, error) {
+	if resolver == "" {
+		conf, err := dns.ClientConfigFromFile(resolvConfPath)
+		if err != nil || len(conf.Servers) == 0 {
+			return defaultResolver.String(), nil //nolint:nilerr
+		}
+
+		return net.JoinHostPort(conf.Servers[0], conf.Port), nil
+	}
+
+	if addrPort, err := netip.ParseAddrPort(resolver); err == nil {
+		return addrPort.String(), nil
+	}
+
+	addr, err := netip.ParseAddr(resolver)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "invalid resolver address %q", resolver)
+	}
+
+	return netip.AddrPortFrom(addr, 53).String(), nil
+}
+
+func records(rrs []dns.RR) []*machine.NetworkDiagnosticsDNSRecord {
+	result := make([]*machine.NetworkDiagnosticsDNSRecord, 0, len(rrs))
+
+	for _, rr := range rrs {
+		hdr := rr.Header()
+
+		result = append(result, &machine.NetworkDiagnosticsDNSRecord{
+			Name:  hdr.Name,
+			Type:  dns.TypeToString[hdr.Rrtype],
+			Ttl:   hdr.Ttl,
+			Value: strings.TrimPrefix(rr.String(), hdr.String()),
+		})
+	}
+
+	return result
+}
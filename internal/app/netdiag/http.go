@@ -0,0 +1,175 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package netdiag
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"net/url"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+// httpTimings records the phases of an HTTP request.
+//
+// The trace hooks might be called concurrently (e.g. when dialing multiple addresses), the first call wins.
+type httpTimings struct {
+	mu sync.Mutex
+
+	start, dnsStart, dnsDone, connectStart, connectDone, tlsStart, tlsDone, firstByte time.Time
+
+	remoteAddress string
+}
+
+func (t *httpTimings) mark(ts *time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if ts.IsZero() {
+		*ts = time.Now()
+	}
+}
+
+func (t *httpTimings) trace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		DNSStart:             func(httptrace.DNSStartInfo) { t.mark(&t.dnsStart) },
+		DNSDone:              func(httptrace.DNSDoneInfo) { t.mark(&t.dnsDone) },
+		ConnectStart:         func(string, string) { t.mark(&t.connectStart) },
+		ConnectDone:          func(string, string, error) { t.mark(&t.connectDone) },
+		TLSHandshakeStart:    func() { t.mark(&t.tlsStart) },
+		TLSHandshakeDone:     func(tls.ConnectionState, error) { t.mark(&t.tlsDone) },
+		GotFirstResponseByte: func() { t.mark(&t.firstByte) },
+		GotConn: func(info httptrace.GotConnInfo) {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+
+			t.remoteAddress = info.Conn.RemoteAddr().String()
+		},
+	}
+}
+
+// since returns the duration between the timestamps, or nil if either is not set.
+func since(start, end time.Time) *durationpb.Duration {
+	if start.IsZero() || end.IsZero() {
+		return nil
+	}
+
+	return durationpb.New(end.Sub(start))
+}
+
+// HTTPGet implements machine.NetworkDiagnosticsServiceServer.
+func (svc *Service) HTTPGet(req *machine.NetworkDiagnosticsHTTPGetRequest, srv grpc.ServerStreamingServer[machine.NetworkDiagnosticsHTTPGetResponse]) error {
+	ctx := srv.Context()
+
+	src, err := parseSource(req.GetSource())
+	if err != nil {
+		return err
+	}
+
+	u, err := url.Parse(req.GetUrl())
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return status.Errorf(codes.InvalidArgument, "invalid URL %q", req.GetUrl())
+	}
+
+	interval, err := parseInterval(req.GetInterval())
+	if err != nil {
+		return err
+	}
+
+	svc.logger.Info("http get", zap.String("url", u.Redacted()), zap.Stringer("source", src))
+
+	timeout := durationOr(req.GetTimeout(), 10*time.Second)
+
+	transport := &http.Transport{
+		DialContext:       src.dialer("tcp", timeout).DialContext,
+		DisableKeepAlives: true,
+		ForceAttemptHTTP2: true,
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: req.GetInsecureSkipVerify(), //nolint:gosec
+		},
+	}
+
+	defer transport.CloseIdleConnections()
+
+	client := &http.Client{
+		Transport: transport,
+		Timeout:   timeout,
+		// report the redirect response itself
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	return repeat(ctx, req.GetCount(), interval, func(seq uint32) error {
+		resp := &machine.NetworkDiagnosticsHTTPGetResponse{
+			Seq: seq,
+		}
+
+		if err := httpGet(ctx, client, u.String(), req.GetHeaders(), resp); err != nil {
+			resp.Error = err.Error()
+		}
+
+		return srv.Send(resp)
+	})
+}
+
+func httpGet(ctx context.Context, client *http.Client, u string, headers map[string]string, resp *machine.NetworkDiagnosticsHTTPGetResponse) error {
+	var timings httpTimings
+
+	defer func() {
+		timings.mu.Lock()
+		defer timings.mu.Unlock()
+
+		resp.RemoteAddress = timings.remoteAddress
+		resp.DnsTime = since(timings.dnsStart, timings.dnsDone)
+		resp.ConnectTime = since(timings.connectStart, timings.connectDone)
+		resp.TlsTime = since(timings.tlsStart, timings.tlsDone)
+		resp.FirstByteTime = since(timings.start, timings.firstByte)
+	}()
+
+	httpReq, err := http.NewRequestWithContext(httptrace.WithClientTrace(ctx, timings.trace()), http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+
+	for k, v := range headers {
+		if http.CanonicalHeaderKey(k) == "Host" {
+			httpReq.Host = v
+
+			continue
+		}
+
+		httpReq.Header.Set(k, v)
+	}
+
+	timings.start = time.Now()
+
+	httpResp, err := client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+
+	defer httpResp.Body.Close() //nolint:errcheck
+
+	resp.StatusCode = uint32(httpResp.StatusCode)
+	resp.Proto = httpResp.Proto
+
+	n, err := io.Copy(io.Discard, httpResp.Body)
+	resp.BodySize = uint64(n)
+	resp.TotalTime = durationpb.New(time.Since(timings.start))
+
+	return err
+}
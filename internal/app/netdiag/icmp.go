@@ -0,0 +1,395 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package netdiag
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net"
+	"net/netip"
+	"os"
+	"syscall"
+	"time"
+
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	protocolICMP   = 1
+	protocolUDP    = 17
+	protocolICMPv6 = 58
+
+	ipv4HeaderLen = 20
+	ipv6HeaderLen = 40
+	icmpHeaderLen = 8
+)
+
+// icmpSocket is a raw ICMP (ICMPv6) socket.
+type icmpSocket struct {
+	conn net.PacketConn
+	p4   *ipv4.PacketConn
+	p6   *ipv6.PacketConn
+
+	id int
+	v6 bool
+}
+
+// icmpReply is a received ICMP message.
+type icmpReply struct {
+	received time.Time
+	msg      *icmp.Message
+	raw      []byte
+	from     netip.Addr
+	ttl      int
+}
+
+func listenICMP(ctx context.Context, src source, v6 bool) (*icmpSocket, error) {
+	network, laddr := "ip4:icmp", "0.0.0.0"
+
+	if v6 {
+		network, laddr = "ip6:ipv6-icmp", "::"
+	}
+
+	if src.addr.IsValid() {
+		laddr = src.addr.String()
+	}
+
+	lc := net.ListenConfig{Control: src.control}
+
+	conn, err := lc.ListenPacket(ctx, network, laddr)
+	if err != nil {
+		return nil, fmt.Errorf("error opening ICMP socket: %w", err)
+	}
+
+	s := &icmpSocket{
+		conn: conn,
+		id:   (os.Getpid() ^ rand.IntN(0x10000)) & 0xffff,
+		v6:   v6,
+	}
+
+	if v6 {
+		s.p6 = ipv6.NewPacketConn(conn)
+		err = s.p6.SetControlMessage(ipv6.FlagHopLimit, true)
+	} else {
+		s.p4 = ipv4.NewPacketConn(conn)
+		err = s.p4.SetControlMessage(ipv4.FlagTTL, true)
+	}
+
+	if err != nil {
+		conn.Close() //nolint:errcheck
+
+		return nil, fmt.Errorf("error enabling control messages: %w", err)
+	}
+
+	return s, nil
+}
+
+func (s *icmpSocket) Close() error {
+	return s.conn.Close()
+}
+
+// setTTL sets the TTL (hop limit) of the outgoing packets.
+func (s *icmpSocket) setTTL(ttl int) error {
+	if s.v6 {
+		return s.p6.SetHopLimit(ttl)
+	}
+
+	return s.p4.SetTTL(ttl)
+}
+
+// setDontFragment disables the fragmentation of the outgoing packets, bypassing the path MTU cache.
+func (s *icmpSocket) setDontFragment() error {
+	sc, ok := s.conn.(syscall.Conn)
+	if !ok {
+		return errors.New("unsupported connection type")
+	}
+
+	raw, err := sc.SyscallConn()
+	if err != nil {
+		return err
+	}
+
+	var sockErr error
+
+	if err = raw.Control(func(fd uintptr) {
+		if s.v6 {
+			sockErr = unix.SetsockoptInt(int(fd), unix.IPPROTO_IPV6, unix.IPV6_MTU_DISCOVER, unix.IPV6_PMTUDISC_PROBE)
+			if sockErr == nil {
+				sockErr = unix.SetsockoptInt(int(fd), unix.IPPROTO_IPV6, unix.IPV6_DONTFRAG, 1)
+			}
+		} else {
+			sockErr = unix.SetsockoptInt(int(fd), unix.IPPROTO_IP, unix.IP_MTU_DISCOVER, unix.IP_PMTUDISC_PROBE)
+		}
+	}); err != nil {
+		return err
+	}
+
+	return sockErr
+}
+
+// sendEcho sends an echo request with the payload of the given size.
+func (s *icmpSocket) sendEcho(dst netip.Addr, seq, size int) error {
+	var typ icmp.Type = ipv4.ICMPTypeEcho
+
+	if s.v6 {
+		typ = ipv6.ICMPTypeEchoRequest
+	}
+
+	msg := icmp.Message{
+		Type: typ,
+		Body: &icmp.Echo{
+			ID:   s.id,
+			Seq:  seq & 0xffff,
+			Data: make([]byte, size),
+		},
+	}
+
+	b, err := msg.Marshal(nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.conn.WriteTo(b, &net.IPAddr{IP: dst.AsSlice(), Zone: dst.Zone()})
+
+	return err
+}
+
+// recv receives the next ICMP message until the deadline.
+func (s *icmpSocket) recv(deadline time.Time) (icmpReply, error) {
+	if err := s.conn.SetReadDeadline(deadline); err != nil {
+		return icmpReply{}, err
+	}
+
+	buf := make([]byte, 65536)
+
+	for {
+		var (
+			n    int
+			ttl  int
+			from net.Addr
+			err  error
+		)
+
+		if s.v6 {
+			var cm *ipv6.ControlMessage
+
+			n, cm, from, err = s.p6.ReadFrom(buf)
+			if cm != nil {
+				ttl = cm.HopLimit
+			}
+		} else {
+			var cm *ipv4.ControlMessage
+
+			n, cm, from, err = s.p4.ReadFrom(buf)
+			if cm != nil {
+				ttl = cm.TTL
+			}
+		}
+
+		if err != nil {
+			return icmpReply{}, err
+		}
+
+		proto := protocolICMP
+		if s.v6 {
+			proto = protocolICMPv6
+		}
+
+		msg, err := icmp.ParseMessage(proto, buf[:n])
+		if err != nil {
+			// not a valid ICMP message, skip it
+			continue
+		}
+
+		var addr netip.Addr
+
+		if ipAddr, ok := from.(*net.IPAddr); ok {
+			addr, _ = netip.AddrFromSlice(ipAddr.IP)
+			addr = addr.Unmap().WithZone(ipAddr.Zone)
+		}
+
+		return icmpReply{
+			received: time.Now(),
+			msg:      msg,
+			raw:      append([]byte(nil), buf[:n]...),
+			from:     addr,
+			ttl:      ttl,
+		}, nil
+	}
+}
+
+// isTimeout returns true if the error is the read deadline.
+func isTimeout(err error) bool {
+	var netErr net.Error
+
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// quotedProbe is the original datagram quoted in an ICMP error message.
+type quotedProbe struct {
+	dst      netip.Addr
+	protocol int
+
+	// for ICMP echo requests
+	echoID  int
+	echoSeq int
+
+	// for UDP datagrams
+	srcPort int
+	dstPort int
+}
+
+// parseQuoted parses the original datagram quoted in an ICMP error message.
+func parseQuoted(data []byte, v6 bool) (quotedProbe, bool) {
+	var (
+		probe   quotedProbe
+		payload []byte
+	)
+
+	if v6 {
+		if len(data) < ipv6HeaderLen || data[0]>>4 != 6 {
+			return probe, false
+		}
+
+		probe.protocol = int(data[6])
+		probe.dst = netip.AddrFrom16([16]byte(data[24:40]))
+		payload = data[ipv6HeaderLen:]
+	} else {
+		if len(data) < ipv4HeaderLen || data[0]>>4 != 4 {
+			return probe, false
+		}
+
+		headerLen := int(data[0]&0x0f) * 4
+		if headerLen < ipv4HeaderLen || len(data) < headerLen {
+			return probe, false
+		}
+
+		probe.protocol = int(data[9])
+		probe.dst = netip.AddrFrom4([4]byte(data[16:20]))
+		payload = data[headerLen:]
+	}
+
+	if len(payload) < 8 {
+		return probe, false
+	}
+
+	switch probe.protocol {
+	case protocolICMP, protocolICMPv6:
+		probe.echoID = int(binary.BigEndian.Uint16(payload[4:6]))
+		probe.echoSeq = int(binary.BigEndian.Uint16(payload[6:8]))
+	case protocolUDP:
+		probe.srcPort = int(binary.BigEndian.Uint16(payload[0:2]))
+		probe.dstPort = int(binary.BigEndian.Uint16(payload[2:4]))
+	default:
+		return probe, false
+	}
+
+	return probe, true
+}
+
+// quoted returns the original datagram quoted in the ICMP error message.
+func (r icmpReply) quoted() (quotedProbe, bool) {
+	var data []byte
+
+	switch body := r.msg.Body.(type) {
+	case *icmp.DstUnreach:
+		data = body.Data
+	case *icmp.TimeExceeded:
+		data = body.Data
+	case *icmp.PacketTooBig:
+		data = body.Data
+	default:
+		return quotedProbe{}, false
+	}
+
+	return parseQuoted(data, r.msg.Type.Protocol() == protocolICMPv6)
+}
+
+// echoReply returns true if the message is the reply to the echo request.
+func (r icmpReply) echoReply(id, seq int) bool {
+	if r.msg.Type != ipv4.ICMPTypeEchoReply && r.msg.Type != ipv6.ICMPTypeEchoReply {
+		return false
+	}
+
+	echo, ok := r.msg.Body.(*icmp.Echo)
+
+	return ok && echo.ID == id && echo.Seq == seq&0xffff
+}
+
+// quotesEcho returns true if the message is an error for the echo request.
+func (r icmpReply) quotesEcho(id, seq int) bool {
+	probe, ok := r.quoted()
+
+	return ok && (probe.protocol == protocolICMP || probe.protocol == protocolICMPv6) && probe.echoID == id && probe.echoSeq == seq&0xffff
+}
+
+// timeExceeded returns true if the message is 'time exceeded'.
+func (r icmpReply) timeExceeded() bool {
+	return r.msg.Type == ipv4.ICMPTypeTimeExceeded || r.msg.Type == ipv6.ICMPTypeTimeExceeded
+}
+
+// portUnreachable returns true if the message is 'port unreachable'.
+func (r icmpReply) portUnreachable() bool {
+	return (r.msg.Type == ipv4.ICMPTypeDestinationUnreachable && r.msg.Code == 3) ||
+		(r.msg.Type == ipv6.ICMPTypeDestinationUnreachable && r.msg.Code == 4)
+}
+
+// nextHopMTU returns the MTU reported by 'fragmentation needed' or 'packet too big', or zero.
+func (r icmpReply) nextHopMTU() int {
+	switch {
+	case r.msg.Type == ipv4.ICMPTypeDestinationUnreachable && r.msg.Code == 4 && len(r.raw) >= icmpHeaderLen:
+		return int(binary.BigEndian.Uint16(r.raw[6:8]))
+	case r.msg.Type == ipv6.ICMPTypePacketTooBig:
+		if body, ok := r.msg.Body.(*icmp.PacketTooBig); ok {
+			return body.MTU
+		}
+	}
+
+	return 0
+}
+
+// describe returns the description of an ICMP error message.
+func (r icmpReply) describe() string {
+	switch r.msg.Type {
+	case ipv4.ICMPTypeDestinationUnreachable:
+		switch r.msg.Code {
+		case 0:
+			return "network unreachable"
+		case 1:
+			return "host unreachable"
+		case 2:
+			return "protocol unreachable"
+		case 3:
+			return "port unreachable"
+		case 4:
+			return fmt.Sprintf("fragmentation needed (mtu %d)", r.nextHopMTU())
+		case 9, 10, 13:
+			return "administratively prohibited"
+		}
+	case ipv6.ICMPTypeDestinationUnreachable:
+		switch r.msg.Code {
+		case 0:
+			return "no route to destination"
+		case 1:
+			return "administratively prohibited"
+		case 3:
+			return "address unreachable"
+		case 4:
+			return "port unreachable"
+		}
+	case ipv6.ICMPTypePacketTooBig:
+		return fmt.Sprintf("packet too big (mtu %d)", r.nextHopMTU())
+	case ipv4.ICMPTypeTimeExceeded, ipv6.ICMPTypeTimeExceeded:
+		return "time exceeded"
+	}
+
+	return fmt.Sprintf("%v (code %d)", r.msg.Type, r.msg.Code)
+}
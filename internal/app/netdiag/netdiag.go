@@ -0,0 +1,226 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package netdiag implements machine.NetworkDiagnosticsService.
+package netdiag
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+// minInterval is the minimum interval between the probes.
+const minInterval = 10 * time.Millisecond
+
+var errTimeout = errors.New("timeout")
+
+// Service implements machine.NetworkDiagnosticsService.
+type Service struct {
+	machine.UnimplementedNetworkDiagnosticsServiceServer
+
+	logger *zap.Logger
+}
+
+// NewService creates a new NetworkDiagnosticsService.
+func NewService(logger *zap.Logger) *Service {
+	return &Service{
+		logger: logger.With(zap.String("service", "netdiag")),
+	}
+}
+
+// source is the source of the probes.
+type source struct {
+	addr netip.Addr
+	// device is the link or VRF the sockets are bound to.
+	device string
+}
+
+func parseSource(src *machine.NetworkDiagnosticsSource) (source, error) {
+	if src.GetLink() != "" && src.GetVrf() != "" {
+		return source{}, status.Error(codes.InvalidArgument, "link and vrf are mutually exclusive")
+	}
+
+	s := source{
+		device: cmp.Or(src.GetLink(), src.GetVrf()),
+	}
+
+	if src.GetAddress() != "" {
+		addr, err := netip.ParseAddr(src.GetAddress())
+		if err != nil {
+			return source{}, status.Errorf(codes.InvalidArgument, "invalid source address: %s", err)
+		}
+
+		s.addr = addr
+	}
+
+	return s, nil
+}
+
+func (s source) String() string {
+	switch {
+	case s.addr.IsValid() && s.device != "":
+		return fmt.Sprintf("%s%%%s", s.addr, s.device)
+	case s.addr.IsValid():
+		return s.addr.String()
+	default:
+		return s.device
+	}
+}
+
+// control binds the socket to the link or VRF.
+func (s source) control(_, _ string, c syscall.RawConn) error {
+	if s.device == "" {
+		return nil
+	}
+
+	var bindErr error
+
+	if err := c.Control(func(fd uintptr) {
+		bindErr = unix.BindToDevice(int(fd), s.device)
+	}); err != nil {
+		return err
+	}
+
+	if bindErr != nil {
+		return fmt.Errorf("error binding to %q: %w", s.device, bindErr)
+	}
+
+	return nil
+}
+
+// dialer returns the dialer for the network ("tcp" or "udp") using the source.
+func (s source) dialer(network string, timeout time.Duration) *net.Dialer {
+	d := &net.Dialer{
+		Timeout: timeout,
+		Control: s.control,
+	}
+
+	if s.addr.IsValid() {
+		switch network {
+		case "udp":
+			d.LocalAddr = net.UDPAddrFromAddrPort(netip.AddrPortFrom(s.addr, 0))
+		default:
+			d.LocalAddr = net.TCPAddrFromAddrPort(netip.AddrPortFrom(s.addr, 0))
+		}
+	}
+
+	return d
+}
+
+// resolveTarget resolves the target to an address matching the family of the source address (if set).
+func resolveTarget(ctx context.Context, src source, target string) (netip.Addr, error) {
+	if target == "" {
+		return netip.Addr{}, status.Error(codes.InvalidArgument, "target is required")
+	}
+
+	if addr, err := netip.ParseAddr(target); err == nil {
+		addr = addr.Unmap()
+
+		if src.addr.IsValid() && src.addr.Is4() != addr.Is4() {
+			return netip.Addr{}, status.Error(codes.InvalidArgument, "source and target address families don't match")
+		}
+
+		return addr, nil
+	}
+
+	network := "ip"
+
+	if src.addr.IsValid() {
+		network = "ip6"
+
+		if src.addr.Is4() {
+			network = "ip4"
+		}
+	}
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, network, target)
+	if err != nil {
+		return netip.Addr{}, status.Errorf(codes.InvalidArgument, "error resolving target: %s", err)
+	}
+
+	// prefer IPv4 as the most common case
+	for _, addr := range addrs {
+		if addr.Unmap().Is4() {
+			return addr.Unmap(), nil
+		}
+	}
+
+	return addrs[0], nil
+}
+
+// durationOr returns the duration, or the default if it is not set.
+func durationOr(d *durationpb.Duration, def time.Duration) time.Duration {
+	if d == nil || d.AsDuration() <= 0 {
+		return def
+	}
+
+	return d.AsDuration()
+}
+
+// parseInterval returns the interval between the probes.
+func parseInterval(d *durationpb.Duration) (time.Duration, error) {
+	interval := durationOr(d, time.Second)
+
+	if interval < minInterval {
+		return 0, status.Errorf(codes.InvalidArgument, "interval should be at least %s", minInterval)
+	}
+
+	return interval, nil
+}
+
+// sleep waits for the duration, or until the context is canceled.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// repeat runs the probe count times with the interval between the probes.
+func repeat(ctx context.Context, count uint32, interval time.Duration, probe func(seq uint32) error) error {
+	for seq := range max(count, 1) {
+		if seq > 0 {
+			if err := sleep(ctx, interval); err != nil {
+				return err
+			}
+		}
+
+		if err := probe(seq); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	return err.Error()
+}
@@ -0,0 +1,132 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package netdiag //nolint:testpackage
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+func TestParseSource(t *testing.T) {
+	t.Parallel()
+
+	src, err := parseSource(&machine.NetworkDiagnosticsSource{Address: "10.5.0.2", Vrf: "vrf-blue"})
+	require.NoError(t, err)
+
+	assert.Equal(t, netip.MustParseAddr("10.5.0.2"), src.addr)
+	assert.Equal(t, "vrf-blue", src.device)
+	assert.Equal(t, "10.5.0.2%vrf-blue", src.String())
+
+	_, err = parseSource(&machine.NetworkDiagnosticsSource{Link: "eth0", Vrf: "vrf-blue"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = parseSource(&machine.NetworkDiagnosticsSource{Address: "eth0"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestParseQuoted(t *testing.T) {
+	t.Parallel()
+
+	// IPv4 header (with options) + ICMP echo request id=0x1234 seq=7
+	icmpV4 := []byte{
+		0x46, 0, 0, 60, 0, 0, 0x40, 0, 1, protocolICMP, 0, 0, 10, 5, 0, 2, 1, 1, 1, 1,
+		1, 1, 0, 0, // options
+		8, 0, 0, 0, 0x12, 0x34, 0, 7,
+	}
+
+	probe, ok := parseQuoted(icmpV4, false)
+	require.True(t, ok)
+
+	assert.Equal(t, netip.MustParseAddr("1.1.1.1"), probe.dst)
+	assert.Equal(t, 0x1234, probe.echoID)
+	assert.Equal(t, 7, probe.echoSeq)
+
+	// IPv6 header + UDP datagram 40000 -> 33435
+	udpV6 := make([]byte, ipv6HeaderLen+8)
+	udpV6[0] = 0x60
+	udpV6[6] = protocolUDP
+	dst := netip.MustParseAddr("2001:db8::1").As16()
+	copy(udpV6[24:40], dst[:])
+	copy(udpV6[40:], []byte{0x9c, 0x40, 0x82, 0x9b})
+
+	probe, ok = parseQuoted(udpV6, true)
+	require.True(t, ok)
+
+	assert.Equal(t, netip.MustParseAddr("2001:db8::1"), probe.dst)
+	assert.Equal(t, 40000, probe.srcPort)
+	assert.Equal(t, 33435, probe.dstPort)
+
+	_, ok = parseQuoted(icmpV4[:24], false)
+	assert.False(t, ok)
+
+	_, ok = parseQuoted(udpV6, false)
+	assert.False(t, ok)
+}
+
+func TestNextMTU(t *testing.T) {
+	t.Parallel()
+
+	// path MTU 1400 behind a link with MTU 1500, without 'fragmentation needed' hints
+	const pathMTU = 1400
+
+	lo, hi, mtu := minIPv4MTU-1, 1501, 1500
+	probes := 0
+
+	for {
+		probes++
+
+		if mtu <= pathMTU {
+			lo = mtu
+		} else {
+			hi = mtu
+		}
+
+		if hi-lo <= 1 {
+			break
+		}
+
+		mtu = nextMTU(lo, hi, 0)
+	}
+
+	assert.Equal(t, pathMTU, lo)
+	assert.LessOrEqual(t, probes, 12)
+
+	// hint is used if it's within the range
+	assert.Equal(t, 1400, nextMTU(67, 1500, 1400))
+	assert.Equal(t, 783, nextMTU(67, 1500, 1500))
+}
+
+func TestParseResolver(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		resolver string
+		expected string
+	}{
+		{resolver: "1.1.1.1", expected: "1.1.1.1:53"},
+		{resolver: "1.1.1.1:5353", expected: "1.1.1.1:5353"},
+		{resolver: "2001:4860:4860::8888", expected: "[2001:4860:4860::8888]:53"},
+		{resolver: "[2001:4860:4860::8888]:5353", expected: "[2001:4860:4860::8888]:5353"},
+	} {
+		t.Run(test.resolver, func(t *testing.T) {
+			t.Parallel()
+
+			resolver, err := parseResolver(test.resolver)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, resolver)
+		})
+	}
+
+	_, err := parseResolver("dns.example.com")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
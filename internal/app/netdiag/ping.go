@@ -0,0 +1,116 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package netdiag
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+const maxPingSize = 65000
+
+// Ping implements machine.NetworkDiagnosticsServiceServer.
+func (svc *Service) Ping(req *machine.NetworkDiagnosticsPingRequest, srv grpc.ServerStreamingServer[machine.NetworkDiagnosticsPingResponse]) error {
+	ctx := srv.Context()
+
+	src, err := parseSource(req.GetSource())
+	if err != nil {
+		return err
+	}
+
+	interval, err := parseInterval(req.GetInterval())
+	if err != nil {
+		return err
+	}
+
+	if req.GetSize() > maxPingSize {
+		return status.Errorf(codes.InvalidArgument, "size should be at most %d", maxPingSize)
+	}
+
+	target, err := resolveTarget(ctx, src, req.GetTarget())
+	if err != nil {
+		return err
+	}
+
+	svc.logger.Info("ping", zap.Stringer("target", target), zap.Stringer("source", src))
+
+	sock, err := listenICMP(ctx, src, target.Is6())
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	defer sock.Close() //nolint:errcheck
+
+	if req.GetTtl() > 0 {
+		if err = sock.setTTL(int(req.GetTtl())); err != nil {
+			return status.Errorf(codes.Internal, "error setting TTL: %s", err)
+		}
+	}
+
+	count := req.GetCount()
+	if count == 0 {
+		count = 4
+	}
+
+	size := 56
+	if req.GetSize() > 0 {
+		size = int(req.GetSize())
+	}
+
+	timeout := durationOr(req.GetTimeout(), time.Second)
+
+	return repeat(ctx, count, interval, func(seq uint32) error {
+		resp := &machine.NetworkDiagnosticsPingResponse{
+			Seq: seq,
+		}
+
+		start := time.Now()
+
+		if err := sock.sendEcho(target, int(seq), size); err != nil {
+			resp.Error = err.Error()
+
+			return srv.Send(resp)
+		}
+
+		deadline := start.Add(timeout)
+
+		for {
+			reply, err := sock.recv(deadline)
+			if err != nil {
+				if isTimeout(err) {
+					err = errTimeout
+				}
+
+				resp.Error = err.Error()
+
+				break
+			}
+
+			switch {
+			case reply.echoReply(sock.id, int(seq)):
+				resp.From = reply.from.String()
+				resp.Rtt = durationpb.New(reply.received.Sub(start))
+				resp.Ttl = uint32(reply.ttl)
+			case reply.quotesEcho(sock.id, int(seq)):
+				resp.From = reply.from.String()
+				resp.Error = reply.describe()
+			default:
+				// not a reply to this echo request
+				continue
+			}
+
+			break
+		}
+
+		return srv.Send(resp)
+	})
+}
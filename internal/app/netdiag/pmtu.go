@@ -0,0 +1,195 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package netdiag
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+const (
+	minIPv4MTU = 68
+	minIPv6MTU = 1280
+)
+
+// nextMTU returns the next MTU to probe.
+//
+// The lo is the largest MTU known to pass, hi is the smallest MTU known to fail,
+// and hint is the next-hop MTU reported in the last ICMP error (if any).
+func nextMTU(lo, hi, hint int) int {
+	if hint > lo && hint < hi {
+		return hint
+	}
+
+	return lo + (hi-lo)/2
+}
+
+// linkMTU returns the MTU of the link used to reach the target.
+func linkMTU(ctx context.Context, src source, target netip.Addr) (int, error) {
+	// connecting the UDP socket doesn't send any packets, but picks the route
+	conn, err := src.dialer("udp", 0).DialContext(ctx, "udp", netip.AddrPortFrom(target, 9).String())
+	if err != nil {
+		return 0, err
+	}
+
+	local := conn.LocalAddr().(*net.UDPAddr).AddrPort().Addr().Unmap() //nolint:forcetypeassert
+
+	conn.Close() //nolint:errcheck
+
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		return 0, err
+	}
+
+	for _, iface := range ifaces {
+		addrs, err := iface.Addrs()
+		if err != nil {
+			continue
+		}
+
+		for _, addr := range addrs {
+			prefix, err := netip.ParsePrefix(addr.String())
+			if err == nil && prefix.Addr().WithZone("") == local.WithZone("") {
+				return iface.MTU, nil
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("no link found for the local address %s", local)
+}
+
+// PathMTU implements machine.NetworkDiagnosticsServiceServer.
+//
+//nolint:gocyclo
+func (svc *Service) PathMTU(req *machine.NetworkDiagnosticsPathMTURequest, srv grpc.ServerStreamingServer[machine.NetworkDiagnosticsPathMTUResponse]) error {
+	ctx := srv.Context()
+
+	src, err := parseSource(req.GetSource())
+	if err != nil {
+		return err
+	}
+
+	target, err := resolveTarget(ctx, src, req.GetTarget())
+	if err != nil {
+		return err
+	}
+
+	minMTU, headerLen := minIPv4MTU, ipv4HeaderLen
+	if target.Is6() {
+		minMTU, headerLen = minIPv6MTU, ipv6HeaderLen
+	}
+
+	maxMTU := int(req.GetMaxMtu())
+	if maxMTU == 0 {
+		if maxMTU, err = linkMTU(ctx, src, target); err != nil {
+			return status.Errorf(codes.FailedPrecondition, "error detecting the link MTU: %s", err)
+		}
+
+		// e.g. loopback MTU is 65536
+		maxMTU = min(maxMTU, maxPingSize)
+	}
+
+	if maxMTU < minMTU || maxMTU > maxPingSize {
+		return status.Errorf(codes.InvalidArgument, "max MTU should be in range [%d, %d]", minMTU, maxPingSize)
+	}
+
+	svc.logger.Info("path mtu", zap.Stringer("target", target), zap.Stringer("source", src), zap.Int("max_mtu", maxMTU))
+
+	sock, err := listenICMP(ctx, src, target.Is6())
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	defer sock.Close() //nolint:errcheck
+
+	if err = sock.setDontFragment(); err != nil {
+		return status.Errorf(codes.Internal, "error disabling fragmentation: %s", err)
+	}
+
+	timeout := durationOr(req.GetTimeout(), time.Second)
+
+	// probe the largest MTU first, as it passes in the most common case
+	lo, hi := minMTU-1, maxMTU+1
+	mtu := maxMTU
+
+	for seq := 0; ; seq++ {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+
+		resp := &machine.NetworkDiagnosticsPathMTUResponse{
+			Mtu: uint32(mtu),
+		}
+
+		var hint int
+
+		start := time.Now()
+
+		if err = sock.sendEcho(target, seq, mtu-headerLen-icmpHeaderLen); err != nil {
+			resp.Error = err.Error()
+		} else {
+			for {
+				reply, err := sock.recv(start.Add(timeout))
+				if err != nil {
+					if isTimeout(err) {
+						err = errTimeout
+					}
+
+					resp.Error = err.Error()
+
+					break
+				}
+
+				switch {
+				case reply.echoReply(sock.id, seq):
+					resp.Ok = true
+				case reply.quotesEcho(sock.id, seq):
+					resp.Error = reply.describe()
+					hint = reply.nextHopMTU()
+				default:
+					continue
+				}
+
+				break
+			}
+		}
+
+		if resp.Ok {
+			lo = mtu
+		} else {
+			hi = mtu
+		}
+
+		if err = srv.Send(resp); err != nil {
+			return err
+		}
+
+		if hi-lo <= 1 {
+			break
+		}
+
+		mtu = nextMTU(lo, hi, hint)
+	}
+
+	if lo < minMTU {
+		return srv.Send(&machine.NetworkDiagnosticsPathMTUResponse{
+			Error: "no probe reached the target",
+		})
+	}
+
+	return srv.Send(&machine.NetworkDiagnosticsPathMTUResponse{
+		PathMtu: uint32(lo),
+	})
+}
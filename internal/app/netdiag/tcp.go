@@ -0,0 +1,200 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package netdiag
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"net"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+func validateHostPort(target string) (string, error) {
+	host, _, err := net.SplitHostPort(target)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "invalid target %q: %s", target, err)
+	}
+
+	return host, nil
+}
+
+// TCPConnect implements machine.NetworkDiagnosticsServiceServer.
+func (svc *Service) TCPConnect(req *machine.NetworkDiagnosticsTCPConnectRequest, srv grpc.ServerStreamingServer[machine.NetworkDiagnosticsTCPConnectResponse]) error {
+	ctx := srv.Context()
+
+	src, err := parseSource(req.GetSource())
+	if err != nil {
+		return err
+	}
+
+	if _, err = validateHostPort(req.GetTarget()); err != nil {
+		return err
+	}
+
+	interval, err := parseInterval(req.GetInterval())
+	if err != nil {
+		return err
+	}
+
+	svc.logger.Info("tcp connect", zap.String("target", req.GetTarget()), zap.Stringer("source", src))
+
+	dialer := src.dialer("tcp", durationOr(req.GetTimeout(), 5*time.Second))
+
+	return repeat(ctx, req.GetCount(), interval, func(seq uint32) error {
+		resp := &machine.NetworkDiagnosticsTCPConnectResponse{
+			Seq: seq,
+		}
+
+		start := time.Now()
+
+		conn, err := dialer.DialContext(ctx, "tcp", req.GetTarget())
+		if err != nil {
+			resp.Error = err.Error()
+		} else {
+			resp.Rtt = durationpb.New(time.Since(start))
+			resp.LocalAddress = conn.LocalAddr().String()
+			resp.RemoteAddress = conn.RemoteAddr().String()
+
+			conn.Close() //nolint:errcheck
+		}
+
+		return srv.Send(resp)
+	})
+}
+
+// TLSHandshake implements machine.NetworkDiagnosticsServiceServer.
+func (svc *Service) TLSHandshake(req *machine.NetworkDiagnosticsTLSHandshakeRequest, srv grpc.ServerStreamingServer[machine.NetworkDiagnosticsTLSHandshakeResponse]) error {
+	ctx := srv.Context()
+
+	src, err := parseSource(req.GetSource())
+	if err != nil {
+		return err
+	}
+
+	host, err := validateHostPort(req.GetTarget())
+	if err != nil {
+		return err
+	}
+
+	serverName := req.GetServerName()
+	if serverName == "" {
+		serverName = host
+	}
+
+	svc.logger.Info("tls handshake", zap.String("target", req.GetTarget()), zap.String("server_name", serverName), zap.Stringer("source", src))
+
+	ctx, cancel := context.WithTimeout(ctx, durationOr(req.GetTimeout(), 10*time.Second))
+	defer cancel()
+
+	resp := &machine.NetworkDiagnosticsTLSHandshakeResponse{}
+
+	start := time.Now()
+
+	conn, err := src.dialer("tcp", 0).DialContext(ctx, "tcp", req.GetTarget())
+	if err != nil {
+		resp.Error = err.Error()
+
+		return srv.Send(resp)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	resp.ConnectTime = durationpb.New(time.Since(start))
+	resp.LocalAddress = conn.LocalAddr().String()
+	resp.RemoteAddress = conn.RemoteAddr().String()
+
+	// the chain is verified below, so that the verification error is reported along with the certificates
+	tlsConn := tls.Client(conn, &tls.Config{
+		ServerName:         serverName,
+		NextProtos:         req.GetAlpn(),
+		InsecureSkipVerify: true, //nolint:gosec
+	})
+
+	start = time.Now()
+
+	if err = tlsConn.HandshakeContext(ctx); err != nil {
+		resp.Error = err.Error()
+
+		return srv.Send(resp)
+	}
+
+	resp.HandshakeTime = durationpb.New(time.Since(start))
+
+	state := tlsConn.ConnectionState()
+
+	resp.Version = tls.VersionName(state.Version)
+	resp.CipherSuite = tls.CipherSuiteName(state.CipherSuite)
+	resp.Alpn = state.NegotiatedProtocol
+
+	for _, cert := range state.PeerCertificates {
+		resp.Certificates = append(resp.Certificates, certificate(cert))
+	}
+
+	if err = verifyChain(state.PeerCertificates, serverName); err != nil {
+		resp.VerificationError = err.Error()
+
+		if !req.GetInsecureSkipVerify() {
+			resp.Error = "certificate verification failed"
+		}
+	}
+
+	return srv.Send(resp)
+}
+
+func verifyChain(certs []*x509.Certificate, serverName string) error {
+	if len(certs) == 0 {
+		return errors.New("no certificates presented")
+	}
+
+	intermediates := x509.NewCertPool()
+
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	_, err := certs[0].Verify(x509.VerifyOptions{
+		DNSName:       serverName,
+		Intermediates: intermediates,
+	})
+
+	return err
+}
+
+func certificate(cert *x509.Certificate) *machine.NetworkDiagnosticsCertificate {
+	fingerprint := sha256.Sum256(cert.Raw)
+
+	ips := make([]string, 0, len(cert.IPAddresses))
+
+	for _, ip := range cert.IPAddresses {
+		ips = append(ips, ip.String())
+	}
+
+	return &machine.NetworkDiagnosticsCertificate{
+		Subject:            cert.Subject.String(),
+		Issuer:             cert.Issuer.String(),
+		DnsNames:           cert.DNSNames,
+		IpAddresses:        ips,
+		SerialNumber:       cert.SerialNumber.Text(16),
+		NotBefore:          timestamppb.New(cert.NotBefore),
+		NotAfter:           timestamppb.New(cert.NotAfter),
+		Fingerprint:        hex.EncodeToString(fingerprint[:]),
+		SignatureAlgorithm: cert.SignatureAlgorithm.String(),
+		PublicKeyAlgorithm: cert.PublicKeyAlgorithm.String(),
+		IsCa:               cert.IsCA,
+	}
+}
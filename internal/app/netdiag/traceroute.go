@@ -0,0 +1,253 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package netdiag
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+const (
+	maxHops        = 255
+	maxProbes      = 10
+	probePayloadSz = 32
+)
+
+// udpProber sends UDP probes with the increasing destination port.
+type udpProber struct {
+	conn      net.PacketConn
+	localPort int
+	v6        bool
+}
+
+func listenUDP(ctx context.Context, src source, v6 bool) (*udpProber, error) {
+	network, laddr := "udp4", "0.0.0.0:0"
+
+	if v6 {
+		network, laddr = "udp6", "[::]:0"
+	}
+
+	if src.addr.IsValid() {
+		laddr = netip.AddrPortFrom(src.addr, 0).String()
+	}
+
+	lc := net.ListenConfig{Control: src.control}
+
+	conn, err := lc.ListenPacket(ctx, network, laddr)
+	if err != nil {
+		return nil, fmt.Errorf("error opening UDP socket: %w", err)
+	}
+
+	return &udpProber{
+		conn:      conn,
+		localPort: conn.LocalAddr().(*net.UDPAddr).Port, //nolint:forcetypeassert
+		v6:        v6,
+	}, nil
+}
+
+func (p *udpProber) Close() error {
+	return p.conn.Close()
+}
+
+func (p *udpProber) setTTL(ttl int) error {
+	if p.v6 {
+		return ipv6.NewPacketConn(p.conn).SetHopLimit(ttl)
+	}
+
+	return ipv4.NewPacketConn(p.conn).SetTTL(ttl)
+}
+
+func (p *udpProber) send(dst netip.Addr, port int) error {
+	_, err := p.conn.WriteTo(make([]byte, probePayloadSz), net.UDPAddrFromAddrPort(netip.AddrPortFrom(dst, uint16(port))))
+
+	return err
+}
+
+// Traceroute implements machine.NetworkDiagnosticsServiceServer.
+//
+//nolint:gocyclo,cyclop
+func (svc *Service) Traceroute(req *machine.NetworkDiagnosticsTracerouteRequest, srv grpc.ServerStreamingServer[machine.NetworkDiagnosticsTracerouteResponse]) error {
+	ctx := srv.Context()
+
+	src, err := parseSource(req.GetSource())
+	if err != nil {
+		return err
+	}
+
+	hops := int(req.GetMaxHops())
+	if hops == 0 {
+		hops = 30
+	}
+
+	probes := int(req.GetProbes())
+	if probes == 0 {
+		probes = 3
+	}
+
+	if hops > maxHops || probes > maxProbes {
+		return status.Errorf(codes.InvalidArgument, "max hops should be at most %d, and probes at most %d", maxHops, maxProbes)
+	}
+
+	basePort := int(req.GetPort())
+	if basePort == 0 {
+		basePort = 33434
+	}
+
+	if basePort+hops*probes > 65535 {
+		return status.Error(codes.InvalidArgument, "port range of the probes exceeds 65535")
+	}
+
+	target, err := resolveTarget(ctx, src, req.GetTarget())
+	if err != nil {
+		return err
+	}
+
+	svc.logger.Info("traceroute", zap.Stringer("target", target), zap.Stringer("source", src), zap.Stringer("protocol", req.GetProtocol()))
+
+	// ICMP socket is used to send the ICMP probes, and to receive the replies for both protocols
+	sock, err := listenICMP(ctx, src, target.Is6())
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	defer sock.Close() //nolint:errcheck
+
+	useUDP := req.GetProtocol() == machine.NetworkDiagnosticsTracerouteRequest_UDP
+
+	var udp *udpProber
+
+	if useUDP {
+		udp, err = listenUDP(ctx, src, target.Is6())
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		defer udp.Close() //nolint:errcheck
+	}
+
+	timeout := durationOr(req.GetTimeout(), time.Second)
+
+	for hop := 1; hop <= hops; hop++ {
+		if useUDP {
+			err = udp.setTTL(hop)
+		} else {
+			err = sock.setTTL(hop)
+		}
+
+		if err != nil {
+			return status.Errorf(codes.Internal, "error setting TTL: %s", err)
+		}
+
+		reached := false
+
+		for probe := 1; probe <= probes; probe++ {
+			if err = ctx.Err(); err != nil {
+				return err
+			}
+
+			seq := (hop-1)*probes + probe - 1
+			port := basePort + seq
+
+			resp := &machine.NetworkDiagnosticsTracerouteResponse{
+				Hop:   uint32(hop),
+				Probe: uint32(probe),
+			}
+
+			start := time.Now()
+
+			if useUDP {
+				err = udp.send(target, port)
+			} else {
+				err = sock.sendEcho(target, seq, probePayloadSz)
+			}
+
+			if err != nil {
+				resp.Error = err.Error()
+
+				if err = srv.Send(resp); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			deadline := start.Add(timeout)
+
+			for {
+				reply, err := sock.recv(deadline)
+				if err != nil {
+					if isTimeout(err) {
+						err = errTimeout
+					}
+
+					resp.Error = err.Error()
+
+					break
+				}
+
+				if !useUDP && reply.echoReply(sock.id, seq) {
+					resp.From = reply.from.String()
+					resp.Rtt = durationpb.New(reply.received.Sub(start))
+					resp.Reached = true
+
+					break
+				}
+
+				quoted, ok := reply.quoted()
+				if !ok || quoted.dst != target.WithZone("") {
+					continue
+				}
+
+				if useUDP {
+					if quoted.protocol != protocolUDP || quoted.srcPort != udp.localPort || quoted.dstPort != port {
+						continue
+					}
+				} else if !reply.quotesEcho(sock.id, seq) {
+					continue
+				}
+
+				resp.From = reply.from.String()
+				resp.Rtt = durationpb.New(reply.received.Sub(start))
+				fromTarget := reply.from.WithZone("") == target.WithZone("")
+
+				switch {
+				case reply.timeExceeded():
+				case useUDP && reply.portUnreachable() && fromTarget:
+					resp.Reached = true
+				default:
+					resp.Error = reply.describe()
+					resp.Reached = fromTarget
+				}
+
+				break
+			}
+
+			reached = reached || resp.Reached
+
+			if err = srv.Send(resp); err != nil {
+				return err
+			}
+		}
+
+		if reached {
+			return nil
+		}
+	}
+
+	return nil
+}
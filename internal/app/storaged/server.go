@@ -23,7 +23,7 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
-	"github.com/siderolabs/talos/internal/pkg/partition"
+	"github.com/siderolabs/talos/internal/pkg/secureerase"
 	"github.com/siderolabs/talos/pkg/grpc/middleware/authz"
 	"github.com/siderolabs/talos/pkg/machinery/api/storage"
 	"github.com/siderolabs/talos/pkg/machinery/resources/block"
@@ -144,14 +144,16 @@ func (s *Server) BlockDeviceWipe(ctx context.Context, req *storage.BlockDeviceWi
 
 	// validate the list of devices
 	for _, deviceRequest := range req.GetDevices() {
-		if err := s.validateDeviceForWipe(ctx, deviceRequest.GetDevice(), deviceRequest.GetSkipVolumeCheck(), deviceRequest.GetSkipSecondaryCheck()); err != nil {
+		if err := s.validateDeviceForWipe(
+			ctx, deviceRequest.GetDevice(), deviceRequest.GetMethod(), deviceRequest.GetSkipVolumeCheck(), deviceRequest.GetSkipSecondaryCheck(),
+		); err != nil {
 			return nil, err
 		}
 	}
 
 	// perform the actual wipe
 	for _, deviceRequest := range req.GetDevices() {
-		if err := s.wipeDevice(deviceRequest.GetDevice(), deviceRequest.GetMethod(), deviceRequest.GetDropPartition()); err != nil {
+		if err := s.wipeDevice(ctx, deviceRequest.GetDevice(), deviceRequest.GetMethod(), deviceRequest.GetDropPartition()); err != nil {
 			return nil, err
 		}
 	}
@@ -164,7 +166,13 @@ func (s *Server) BlockDeviceWipe(ctx context.Context, req *storage.BlockDeviceWi
 }
 
 //nolint:gocyclo,cyclop
-func (s *Server) validateDeviceForWipe(ctx context.Context, deviceName string, skipVolumeCheck, skipSecondaryCheck bool) error {
+func (s *Server) validateDeviceForWipe(
+	ctx context.Context, deviceName string, method storage.BlockDeviceWipeDescriptor_Method, skipVolumeCheck, skipSecondaryCheck bool,
+) error {
+	if _, ok := storage.BlockDeviceWipeDescriptor_Method_name[int32(method)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "unsupported wipe method %s", method)
+	}
+
 	// first, resolve the blockdevice and figure out what type it is
 	st := s.Controller.Runtime().State().V1Alpha2().Resources()
 
@@ -189,6 +197,10 @@ func (s *Server) validateDeviceForWipe(ctx context.Context, deviceName string, s
 		return status.Errorf(codes.InvalidArgument, "blockdevice %q is of unsupported type %q", deviceName, deviceType)
 	}
 
+	if deviceType == block.DeviceTypePartition && secureerase.WholeDiskOnly(method) {
+		return status.Errorf(codes.InvalidArgument, "wipe method %s can only be used with whole disks, %q is a partition", method, deviceName)
+	}
+
 	// check the disk (or parent)
 	var disk *block.Disk
 
@@ -288,7 +300,7 @@ func (s *Server) findParentDevice(deviceName string) (string, int, error) {
 // wipeDevice wipes the block device with the given method.
 //
 //nolint:gocyclo,cyclop
-func (s *Server) wipeDevice(deviceName string, method storage.BlockDeviceWipeDescriptor_Method, dropPartition bool) error {
+func (s *Server) wipeDevice(ctx context.Context, deviceName string, method storage.BlockDeviceWipeDescriptor_Method, dropPartition bool) error {
 	parentName, partitionNumber, err := s.findParentDevice(deviceName)
 	if err != nil {
 		return err
@@ -339,24 +351,10 @@ func (s *Server) wipeDevice(deviceName string, method storage.BlockDeviceWipeDes
 		defer bd.Unlock() //nolint:errcheck
 	}
 
-	switch method {
-	case storage.BlockDeviceWipeDescriptor_ZEROES:
-		log.Printf("wiping block device %q with zeroes", deviceName)
-
-		method, err := bd.Wipe()
-		if err != nil {
-			return status.Errorf(codes.Internal, "failed to wipe block device %q: %v", deviceName, err)
-		}
-
-		log.Printf("block device %q wiped with method %q", deviceName, method)
-	case storage.BlockDeviceWipeDescriptor_FAST:
-		log.Printf("wiping block device %q with fast method", deviceName)
+	log.Printf("wiping block device %q with method %s", deviceName, method)
 
-		if err = partition.WipeWithSignatures(bd, deviceName, log.Printf); err != nil {
-			return status.Error(codes.Internal, err.Error())
-		}
-	default:
-		return status.Errorf(codes.InvalidArgument, "unsupported wipe method %s", method)
+	if err = secureerase.Erase(ctx, bd, filepath.Join("/dev", deviceName), method, log.Printf); err != nil {
+		return status.Errorf(codes.Internal, "failed to wipe block device %q: %v", deviceName, err)
 	}
 
 	if dropPartition && parentBd != nil && partitionNumber != 0 {
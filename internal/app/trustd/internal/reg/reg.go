@@ -9,8 +9,13 @@ import (
 	"context"
 	stdx509 "crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/hex"
 	"encoding/pem"
 	"log"
+	"net"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
@@ -22,10 +27,17 @@ import (
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 
+	"github.com/siderolabs/talos/internal/pkg/attestation"
 	securityapi "github.com/siderolabs/talos/pkg/machinery/api/security"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/resources/security"
 )
 
+// disruptionReleaseTimeout bounds the wait for the release of the disruption lock slot to be processed.
+const disruptionReleaseTimeout = 5 * time.Second
+
 // Registrator is the concrete type that implements the factory.Registrator and
 // securityapi.SecurityServiceServer interfaces.
 type Registrator struct {
@@ -67,6 +79,10 @@ func (r *Registrator) Certificate(ctx context.Context, in *securityapi.Certifica
 
 	log.Printf("received CSR signing request from %s: subject %s dns names %s addresses %s", remotePeer.Addr, request.Subject, request.DNSNames, request.IPAddresses)
 
+	if err = r.attest(ctx, osRoot, remotePeer, request, in); err != nil {
+		return nil, err
+	}
+
 	// allow only server auth certificates
 	x509Opts := []x509.Option{
 		x509.KeyUsage(stdx509.KeyUsageDigitalSignature),
@@ -114,3 +130,273 @@ func (r *Registrator) Certificate(ctx context.Context, in *securityapi.Certifica
 
 	return resp, nil
 }
+
+// AttestationChallenge implements the securityapi.SecurityServer interface.
+//
+// This API is called by Talos worker nodes before requesting a certificate if node attestation is enabled.
+func (r *Registrator) AttestationChallenge(ctx context.Context, in *securityapi.AttestationChallengeRequest) (*securityapi.AttestationChallengeResponse, error) {
+	osRoot, err := safe.StateGet[*secrets.OSRoot](ctx, r.Resources, resource.NewMetadata(secrets.NamespaceName, secrets.OSRootType, secrets.OSRootID, resource.VersionUndefined))
+	if err != nil {
+		return nil, err
+	}
+
+	verifier, err := r.verifier(ctx, osRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	if verifier.Policy().Mode == config.NodeAttestationModeDisabled {
+		return nil, status.Error(codes.FailedPrecondition, "node attestation is disabled")
+	}
+
+	resp, err := verifier.Challenge(in.GetKeys())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to issue attestation challenge: %s", err)
+	}
+
+	return resp, nil
+}
+
+// DisruptionLock implements the securityapi.SecurityServer interface.
+//
+// This API is called by Talos worker nodes to request a slot of the cluster-wide disruption lock.
+// The request is recorded, and the lock is coordinated via etcd by the control plane controllers,
+// so the response reflects the state as of the previous call of the node.
+//
+// The address of the caller is stored with the request in etcd, and the node name is bound to it while the node is queued
+// or holds the slot, so that a node can't acquire or release the slot on behalf of another node via any control plane node.
+// The local request is also not replaced by a request from another address.
+// The release is processed synchronously, and the request is removed once the slot is released.
+//
+//nolint:gocyclo
+func (r *Registrator) DisruptionLock(ctx context.Context, in *securityapi.DisruptionLockRequest) (*securityapi.DisruptionLockResponse, error) {
+	if in.GetNode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "node name is required")
+	}
+
+	remotePeer, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "peer not found")
+	}
+
+	address := peerHost(remotePeer)
+
+	if err := r.verifyDisruptionLockNode(ctx, in.GetNode(), address); err != nil {
+		return nil, err
+	}
+
+	requestedAt := in.GetRequestedAt().AsTime()
+
+	if err := safe.StateModify(ctx, r.Resources, runtime.NewDisruptionLockRequest(in.GetNode()), func(res *runtime.DisruptionLockRequest) error {
+		spec := res.TypedSpec()
+
+		*spec = runtime.DisruptionLockRequestSpec{
+			Node:                 in.GetNode(),
+			ControlPlane:         in.GetControlPlane(),
+			Labels:               in.GetLabels(),
+			Operation:            in.GetOperation(),
+			RequestedAt:          requestedAt,
+			RefreshedAt:          time.Now(),
+			Release:              in.GetRelease(),
+			MaxNodes:             int(in.GetLimits().GetMaxNodes()),
+			MaxControlPlaneNodes: int(in.GetLimits().GetMaxControlPlaneNodes()),
+			LockTTL:              in.GetLockTtl().AsDuration(),
+			Address:              address,
+		}
+
+		for key, maxNodes := range in.GetLimits().GetLabels() {
+			spec.LabelLimits = append(spec.LabelLimits, runtime.DisruptionLabelLimit{Key: key, MaxNodes: int(maxNodes)})
+		}
+
+		slices.SortFunc(spec.LabelLimits, func(a, b runtime.DisruptionLabelLimit) int { return strings.Compare(a.Key, b.Key) })
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if in.GetRelease() {
+		return r.releaseDisruptionLock(ctx, in.GetNode(), requestedAt)
+	}
+
+	lockStatus, err := safe.StateGetByID[*runtime.DisruptionLockStatus](ctx, r.Resources, in.GetNode())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return &securityapi.DisruptionLockResponse{Pending: true}, nil
+		}
+
+		return nil, err
+	}
+
+	spec := lockStatus.TypedSpec()
+
+	// the status of the previous request of the node
+	if !spec.RequestedAt.Equal(requestedAt) {
+		return &securityapi.DisruptionLockResponse{Pending: true}, nil
+	}
+
+	return &securityapi.DisruptionLockResponse{
+		Granted:       spec.Granted,
+		QueuePosition: int64(spec.QueuePosition),
+		Holders:       spec.Holders,
+		Error:         spec.Error,
+	}, nil
+}
+
+// verifyDisruptionLockNode checks that the local request of the node was not made from another address.
+//
+// The binding across the control plane nodes is enforced by the lock backend.
+func (r *Registrator) verifyDisruptionLockNode(ctx context.Context, node, address string) error {
+	existing, err := safe.StateGetByID[*runtime.DisruptionLockRequest](ctx, r.Resources, node)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	spec := existing.TypedSpec()
+
+	if spec.Address == "" || spec.Address == address {
+		return nil
+	}
+
+	// the request which was not refreshed within the lock TTL doesn't hold the slot anymore
+	if time.Since(spec.RefreshedAt) > spec.LockTTL {
+		return nil
+	}
+
+	log.Printf("rejecting disruption lock request for node %q from %s: the node is bound to %s", node, address, spec.Address)
+
+	return status.Errorf(codes.PermissionDenied, "node %q is bound to a different address", node)
+}
+
+// releaseDisruptionLock waits for the release to be processed by the control plane, and removes the request.
+func (r *Registrator) releaseDisruptionLock(ctx context.Context, node string, requestedAt time.Time) (*securityapi.DisruptionLockResponse, error) {
+	waitCtx, cancel := context.WithTimeout(ctx, disruptionReleaseTimeout)
+	defer cancel()
+
+	lockStatus, err := safe.StateWatchFor[*runtime.DisruptionLockStatus](
+		waitCtx,
+		r.Resources,
+		runtime.NewDisruptionLockStatus(node).Metadata(),
+		state.WithEventTypes(state.Created, state.Updated),
+		state.WithCondition(func(res resource.Resource) (bool, error) {
+			return res.(*runtime.DisruptionLockStatus).TypedSpec().RequestedAt.Equal(requestedAt), nil
+		}),
+	)
+	if err != nil {
+		if ctx.Err() == nil && waitCtx.Err() != nil {
+			return nil, status.Error(codes.Unavailable, "timed out waiting for the disruption lock slot to be released")
+		}
+
+		return nil, err
+	}
+
+	if err = r.Resources.Destroy(ctx, runtime.NewDisruptionLockRequest(node).Metadata()); err != nil && !state.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	return &securityapi.DisruptionLockResponse{
+		Error: lockStatus.TypedSpec().Error,
+	}, nil
+}
+
+// verifier builds the attestation verifier from the current policy.
+//
+// The challenge key is derived from the OS CA key, so that all control plane nodes can verify each other's challenges.
+func (r *Registrator) verifier(ctx context.Context, osRoot *secrets.OSRoot) (*attestation.Verifier, error) {
+	var policySpec *security.NodeAttestationPolicySpec
+
+	policy, err := safe.StateGetByID[*security.NodeAttestationPolicy](ctx, r.Resources, security.NodeAttestationPolicyID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	if policy != nil {
+		policySpec = policy.TypedSpec()
+	}
+
+	parsedPolicy, err := attestation.NewPolicy(policySpec)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to parse node attestation policy: %s", err)
+	}
+
+	return attestation.NewVerifier(osRoot.TypedSpec().IssuingCA.Key, parsedPolicy)
+}
+
+// attest verifies the node attestation evidence according to the policy and records the result.
+func (r *Registrator) attest(ctx context.Context, osRoot *secrets.OSRoot, remotePeer *peer.Peer, request *stdx509.CertificateRequest, in *securityapi.CertificateRequest) error {
+	verifier, err := r.verifier(ctx, osRoot)
+	if err != nil {
+		return err
+	}
+
+	mode := verifier.Policy().Mode
+
+	if mode == config.NodeAttestationModeDisabled {
+		return nil
+	}
+
+	result, verifyErr := verifier.Verify(in.Csr, in.Attestation)
+	accepted := verifyErr == nil || mode == config.NodeAttestationModeOptional
+
+	if verifyErr != nil {
+		log.Printf("node attestation failed for %s (%s): %s", remotePeer.Addr, request.Subject, verifyErr)
+	}
+
+	// the record is keyed by the address of the caller, as the subject of the CSR is controlled by the node
+	id := peerHost(remotePeer)
+
+	if err = safe.StateModify(ctx, r.Resources, security.NewNodeAttestation(id), func(res *security.NodeAttestation) error {
+		spec := res.TypedSpec()
+
+		*spec = security.NodeAttestationSpec{
+			Address:   id,
+			Subject:   request.Subject.CommonName,
+			Attested:  verifyErr == nil,
+			Accepted:  accepted,
+			Timestamp: time.Now(),
+		}
+
+		if verifyErr != nil {
+			spec.Error = verifyErr.Error()
+		}
+
+		if result != nil {
+			spec.EKFingerprint = result.EKFingerprint
+			spec.EKCertificateSubject = result.EKCertificateSubject
+			spec.AKName = result.AKName
+
+			for _, pcr := range verifier.Policy().PCRs {
+				if value, ok := result.PCRValues[pcr]; ok {
+					spec.PCRValues = append(spec.PCRValues, security.NodeAttestationPCRValueSpec{
+						PCR:    pcr,
+						Values: []string{hex.EncodeToString(value)},
+					})
+				}
+			}
+		}
+
+		return nil
+	}); err != nil {
+		// recording the result is best-effort, it should not block the node from joining
+		log.Printf("failed to record node attestation result for %s: %s", id, err)
+	}
+
+	if !accepted {
+		return status.Errorf(codes.PermissionDenied, "node attestation failed: %s", verifyErr)
+	}
+
+	return nil
+}
+
+func peerHost(remotePeer *peer.Peer) string {
+	host, _, err := net.SplitHostPort(remotePeer.Addr.String())
+	if err != nil {
+		return remotePeer.Addr.String()
+	}
+
+	return host
+}
@@ -12,18 +12,26 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
 	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
 	"github.com/siderolabs/crypto/x509"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/siderolabs/talos/internal/app/trustd/internal/reg"
 	"github.com/siderolabs/talos/pkg/machinery/api/security"
 	gensecrets "github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+	securityres "github.com/siderolabs/talos/pkg/machinery/resources/security"
 	"github.com/siderolabs/talos/pkg/machinery/role"
 )
 
@@ -107,3 +115,158 @@ func TestCertificate(t *testing.T) {
 		})
 	}
 }
+
+func TestCertificateAttestation(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	resources := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	ca, err := gensecrets.NewTalosCA(time.Now())
+	require.NoError(t, err)
+
+	osRoot := secrets.NewOSRoot(secrets.OSRootID)
+	osRoot.TypedSpec().IssuingCA = &x509.PEMEncodedCertificateAndKey{
+		Crt: ca.CrtPEM,
+		Key: ca.KeyPEM,
+	}
+	osRoot.TypedSpec().AcceptedCAs = []*x509.PEMEncodedCertificate{
+		{
+			Crt: ca.CrtPEM,
+		},
+	}
+	require.NoError(t, resources.Create(ctx, osRoot))
+
+	ctx = peer.NewContext(ctx, &peer.Peer{
+		Addr: &net.TCPAddr{
+			IP:   netip.MustParseAddr("10.5.0.4").AsSlice(),
+			Port: 30000,
+		},
+	})
+
+	r := &reg.Registrator{
+		Resources: resources,
+	}
+
+	// attestation is disabled by default
+	_, err = r.AttestationChallenge(ctx, &security.AttestationChallengeRequest{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	serverCSR, _, err := x509.NewEd25519CSRAndIdentity(x509.CommonName("talos-default-worker-1"))
+	require.NoError(t, err)
+
+	policy := securityres.NewNodeAttestationPolicy()
+	policy.TypedSpec().Mode = "required"
+	policy.TypedSpec().PCRs = []int{7}
+	require.NoError(t, resources.Create(ctx, policy))
+
+	_, err = r.AttestationChallenge(ctx, &security.AttestationChallengeRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = r.Certificate(ctx, &security.CertificateRequest{
+		Csr: serverCSR.X509CertificateRequestPEM,
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	attestationResult, err := safe.StateGetByID[*securityres.NodeAttestation](ctx, resources, "10.5.0.4")
+	require.NoError(t, err)
+
+	assert.Equal(t, "10.5.0.4", attestationResult.TypedSpec().Address)
+	assert.Equal(t, "talos-default-worker-1", attestationResult.TypedSpec().Subject)
+	assert.False(t, attestationResult.TypedSpec().Attested)
+	assert.False(t, attestationResult.TypedSpec().Accepted)
+	assert.Equal(t, "no attestation evidence provided", attestationResult.TypedSpec().Error)
+
+	policy.TypedSpec().Mode = "optional"
+	require.NoError(t, resources.Update(ctx, policy))
+
+	_, err = r.Certificate(ctx, &security.CertificateRequest{
+		Csr: serverCSR.X509CertificateRequestPEM,
+	})
+	require.NoError(t, err)
+
+	attestationResult, err = safe.StateGetByID[*securityres.NodeAttestation](ctx, resources, "10.5.0.4")
+	require.NoError(t, err)
+
+	assert.False(t, attestationResult.TypedSpec().Attested)
+	assert.True(t, attestationResult.TypedSpec().Accepted)
+}
+
+func peerContext(ctx context.Context, addr string) context.Context {
+	return peer.NewContext(ctx, &peer.Peer{
+		Addr: &net.TCPAddr{
+			IP:   netip.MustParseAddr(addr).AsSlice(),
+			Port: 30000,
+		},
+	})
+}
+
+func TestDisruptionLock(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	resources := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	r := &reg.Registrator{
+		Resources: resources,
+	}
+
+	workerCtx := peerContext(ctx, "10.5.0.4")
+
+	resp, err := r.DisruptionLock(workerCtx, &security.DisruptionLockRequest{
+		Node:        "talos-default-worker-1",
+		RequestedAt: timestamppb.Now(),
+		LockTtl:     durationpb.New(time.Hour),
+	})
+	require.NoError(t, err)
+
+	assert.True(t, resp.Pending)
+
+	request, err := safe.StateGetByID[*runtimeres.DisruptionLockRequest](ctx, resources, "talos-default-worker-1")
+	require.NoError(t, err)
+
+	assert.Equal(t, "10.5.0.4", request.TypedSpec().Address)
+
+	// another node can't request or release the slot on behalf of the node
+	for _, release := range []bool{false, true} {
+		_, err = r.DisruptionLock(peerContext(ctx, "10.5.0.5"), &security.DisruptionLockRequest{
+			Node:        "talos-default-worker-1",
+			RequestedAt: timestamppb.Now(),
+			Release:     release,
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	}
+
+	// the release is processed by the control plane
+	go func() {
+		released, watchErr := safe.StateWatchFor[*runtimeres.DisruptionLockRequest](
+			ctx,
+			resources,
+			runtimeres.NewDisruptionLockRequest("talos-default-worker-1").Metadata(),
+			state.WithCondition(func(res resource.Resource) (bool, error) {
+				return !resource.IsTombstone(res) && res.(*runtimeres.DisruptionLockRequest).TypedSpec().Release, nil
+			}),
+		)
+		if watchErr != nil {
+			return
+		}
+
+		lockStatus := runtimeres.NewDisruptionLockStatus("talos-default-worker-1")
+		lockStatus.TypedSpec().RequestedAt = released.TypedSpec().RequestedAt
+
+		assert.NoError(t, resources.Create(ctx, lockStatus))
+	}()
+
+	resp, err = r.DisruptionLock(workerCtx, &security.DisruptionLockRequest{
+		Node:        "talos-default-worker-1",
+		RequestedAt: timestamppb.Now(),
+		Release:     true,
+	})
+	require.NoError(t, err)
+
+	assert.Empty(t, resp.Error)
+
+	// the released request is removed
+	_, err = safe.StateGetByID[*runtimeres.DisruptionLockRequest](ctx, resources, "talos-default-worker-1")
+	assert.True(t, state.IsNotFoundError(err))
+}
@@ -23,9 +23,11 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/client"
 	"github.com/siderolabs/talos/pkg/machinery/config/machine"
 	blockcfg "github.com/siderolabs/talos/pkg/machinery/config/types/block"
+	securitycfg "github.com/siderolabs/talos/pkg/machinery/config/types/security"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/block"
 	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/security"
 )
 
 // TrustedBootSuite verifies Talos is securebooted.
@@ -328,6 +330,59 @@ func (suite *TrustedBootSuite) TestEncryptionConfigRotate() {
 	}
 }
 
+// TestNodeAttestation verifies that worker nodes attest with the TPM when requesting certificates from trustd.
+func (suite *TrustedBootSuite) TestNodeAttestation() {
+	worker := suite.RandomDiscoveredNodeInternalIP(machine.TypeWorker)
+	controlPlanes := suite.DiscoverNodeInternalIPsByType(suite.ctx, machine.TypeControlPlane)
+
+	cfgDocument := securitycfg.NewNodeAttestationConfigV1Alpha1()
+	cfgDocument.ConfigMode = "optional"
+
+	nodes := append([]string{worker}, controlPlanes...)
+
+	for _, node := range nodes {
+		suite.PatchMachineConfig(client.WithNode(suite.ctx, node), cfgDocument)
+	}
+
+	defer func() {
+		for _, node := range nodes {
+			suite.RemoveMachineConfigDocuments(client.WithNode(suite.ctx, node), securitycfg.NodeAttestationConfigKind)
+		}
+	}()
+
+	suite.T().Logf("waiting for node %s to attest", worker)
+
+	// the worker requests a new certificate when the attestation policy changes,
+	// the request might be handled by any control plane node
+	suite.Require().EventuallyWithT(func(collect *assert.CollectT) {
+		asrt := assert.New(collect)
+
+		var found bool
+
+		for _, node := range controlPlanes {
+			attestations, err := safe.StateListAll[*security.NodeAttestation](client.WithNode(suite.ctx, node), suite.Client.COSI)
+			if !asrt.NoError(err) {
+				return
+			}
+
+			for attestation := range attestations.All() {
+				if attestation.TypedSpec().Address != worker {
+					continue
+				}
+
+				found = true
+
+				asrt.True(attestation.TypedSpec().Attested, attestation.TypedSpec().Error)
+				asrt.True(attestation.TypedSpec().Accepted)
+				asrt.NotEmpty(attestation.TypedSpec().EKFingerprint)
+				asrt.Len(attestation.TypedSpec().PCRValues, 2)
+			}
+		}
+
+		asrt.True(found, "no attestation found for node %s", worker)
+	}, time.Minute, time.Second)
+}
+
 func init() {
 	allSuites = append(allSuites, &TrustedBootSuite{})
 }
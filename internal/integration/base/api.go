@@ -221,6 +221,24 @@ func (apiSuite *APISuite) AssertClusterHealthy(ctx context.Context) {
 	apiSuite.Require().NoError(check.Wait(ctx, clusterAccess, append(check.DefaultClusterChecks(), check.ExtraClusterChecks()...), check.StderrReporter()))
 }
 
+// AddNetworkFault injects the network fault between the nodes of the cluster.
+//
+// The fault is removed when the test finishes, the test is skipped if the provisioner doesn't support network faults.
+func (apiSuite *APISuite) AddNetworkFault(ctx context.Context, fault provision.NetworkFault) provision.NetworkFault {
+	if apiSuite.Cluster == nil || apiSuite.NetworkFaultProvisioner == nil {
+		apiSuite.T().Skip("network faults are not supported by the provisioner")
+	}
+
+	fault, err := apiSuite.NetworkFaultProvisioner.AddNetworkFault(ctx, apiSuite.Cluster, fault)
+	apiSuite.Require().NoError(err)
+
+	apiSuite.T().Cleanup(func() {
+		apiSuite.Require().NoError(apiSuite.NetworkFaultProvisioner.RemoveNetworkFaults(context.Background(), apiSuite.Cluster, fault.ID))
+	})
+
+	return fault
+}
+
 // ReadBootID reads node boot_id.
 //
 // Context provided might have specific node attached for API call.
@@ -32,6 +32,8 @@ type TalosSuite struct {
 	Cluster provision.Cluster
 	// HTTPProbeProvisioner probes through the external provisioner/fabric network namespace.
 	HTTPProbeProvisioner provision.HTTPProbeProvisioner
+	// NetworkFaultProvisioner injects network faults between the nodes of the provisioned cluster.
+	NetworkFaultProvisioner provision.NetworkFaultProvisioner
 	// TalosConfig is a path to talosconfig
 	TalosConfig string
 	// Version is the (expected) version of Talos tests are running against
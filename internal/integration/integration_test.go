@@ -118,37 +118,39 @@ func TestIntegration(t *testing.T) {
 	provision_test.DefaultSettings.CurrentVersion = expectedVersion
 
 	httpProbeProvisioner, _ := provisioner.(provision.HTTPProbeProvisioner)
+	networkFaultProvisioner, _ := provisioner.(provision.NetworkFaultProvisioner)
 
 	for _, s := range allSuites {
 		if configuredSuite, ok := s.(base.ConfiguredSuite); ok {
 			configuredSuite.SetConfig(base.TalosSuite{
-				Endpoint:               endpoint,
-				K8sEndpoint:            k8sEndpoint,
-				Cluster:                cluster,
-				HTTPProbeProvisioner:   httpProbeProvisioner,
-				TalosConfig:            talosConfig,
-				Version:                expectedVersion,
-				GoVersion:              expectedGoVersion,
-				TalosctlPath:           talosctlPath,
-				KubectlPath:            kubectlPath,
-				HelmPath:               helmPath,
-				KubeStrPath:            kubeStrPath,
-				ExtensionsQEMU:         extensionsQEMU,
-				ExtensionsNvidia:       extensionsNvidia,
-				BGPEnabled:             bgpEnabled,
-				BGPCLOSEnabled:         bgpCLOSEnabled,
-				CiliumBGPEnabled:       ciliumBGPEnabled,
-				TrustedBoot:            trustedBoot,
-				SelinuxEnforcing:       selinuxEnforcing,
-				VerifyUKIBooted:        verifyUKIBooted,
-				TalosImage:             talosImage,
-				CSITestName:            csiTestName,
-				CSITestTimeout:         csiTestTimeout,
-				Airgapped:              airgapped,
-				Virtiofsd:              virtiofsd,
-				Race:                   race,
-				SkipEphemeralPolicy:    skipEphemeralPolicy,
-				DedicatedSystemVolumes: dedicatedSystemVolumes,
+				Endpoint:                endpoint,
+				K8sEndpoint:             k8sEndpoint,
+				Cluster:                 cluster,
+				HTTPProbeProvisioner:    httpProbeProvisioner,
+				NetworkFaultProvisioner: networkFaultProvisioner,
+				TalosConfig:             talosConfig,
+				Version:                 expectedVersion,
+				GoVersion:               expectedGoVersion,
+				TalosctlPath:            talosctlPath,
+				KubectlPath:             kubectlPath,
+				HelmPath:                helmPath,
+				KubeStrPath:             kubeStrPath,
+				ExtensionsQEMU:          extensionsQEMU,
+				ExtensionsNvidia:        extensionsNvidia,
+				BGPEnabled:              bgpEnabled,
+				BGPCLOSEnabled:          bgpCLOSEnabled,
+				CiliumBGPEnabled:        ciliumBGPEnabled,
+				TrustedBoot:             trustedBoot,
+				SelinuxEnforcing:        selinuxEnforcing,
+				VerifyUKIBooted:         verifyUKIBooted,
+				TalosImage:              talosImage,
+				CSITestName:             csiTestName,
+				CSITestTimeout:          csiTestTimeout,
+				Airgapped:               airgapped,
+				Virtiofsd:               virtiofsd,
+				Race:                    race,
+				SkipEphemeralPolicy:     skipEphemeralPolicy,
+				DedicatedSystemVolumes:  dedicatedSystemVolumes,
 			})
 		}
 
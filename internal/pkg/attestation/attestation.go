@@ -0,0 +1,77 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package attestation implements TPM-based attestation of nodes joining the cluster.
+//
+// The flow is the following:
+//
+//  1. The node sends its endorsement key (EK) and attestation key (AK) to trustd via AttestationChallenge.
+//  2. Trustd issues a stateless challenge: a secret derived from the challenge and the keys is encrypted
+//     to the EK with TPM2_MakeCredential semantics, so that it can be recovered only by the TPM holding both keys.
+//  3. The node recovers the secret with TPM2_ActivateCredential and quotes the PCRs with the AK,
+//     using SHA-256(secret || CSR) as qualifying data.
+//  4. Trustd verifies the evidence against the policy before signing the CSR.
+package attestation
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+
+	"github.com/google/go-tpm/tpm2"
+
+	tpm2internal "github.com/siderolabs/talos/internal/pkg/secureboot/tpm2"
+)
+
+// QualifyingData returns the qualifying data for the quote which binds it to the challenge and the CSR.
+func QualifyingData(secret, csr []byte) []byte {
+	h := sha256.New()
+	h.Write(secret)
+	h.Write(csr)
+
+	return h.Sum(nil)
+}
+
+// PCRSelection returns the TPM PCR selection for the SHA-256 bank.
+func PCRSelection(pcrs []int) (tpm2.TPMLPCRSelection, error) {
+	selector, err := tpm2internal.CreateSelector(pcrs)
+	if err != nil {
+		return tpm2.TPMLPCRSelection{}, err
+	}
+
+	return tpm2.TPMLPCRSelection{
+		PCRSelections: []tpm2.TPMSPCRSelection{
+			{
+				Hash:      tpm2.TPMAlgSHA256,
+				PCRSelect: selector,
+			},
+		},
+	}, nil
+}
+
+// PCRDigest calculates the digest of the PCR values as it is done by the TPM in the quote.
+func PCRDigest(values map[int][]byte) []byte {
+	pcrs := make([]int, 0, len(values))
+
+	for pcr := range values {
+		pcrs = append(pcrs, pcr)
+	}
+
+	slices.Sort(pcrs)
+
+	h := sha256.New()
+
+	for _, pcr := range pcrs {
+		h.Write(values[pcr])
+	}
+
+	return h.Sum(nil)
+}
+
+// Fingerprint returns hex-encoded SHA-256 of the data.
+func Fingerprint(data []byte) string {
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,278 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package attestation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/google/go-tpm/tpm2"
+	"github.com/google/go-tpm/tpm2/transport"
+
+	"github.com/siderolabs/talos/internal/pkg/tpm"
+	securityapi "github.com/siderolabs/talos/pkg/machinery/api/security"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// rsaEKCertificateIndex is the NV index of the RSA EK certificate (TCG EK Credential Profile).
+const rsaEKCertificateIndex = 0x01c00002
+
+// nvReadChunkSize is the maximum size of a single NV read operation, conservative value supported by all TPMs.
+const nvReadChunkSize = 512
+
+// akTemplate is the template of the attestation key: restricted ECDSA P-256 signing key.
+var akTemplate = tpm2.TPMTPublic{
+	Type:    tpm2.TPMAlgECC,
+	NameAlg: tpm2.TPMAlgSHA256,
+	ObjectAttributes: tpm2.TPMAObject{
+		FixedTPM:            true,
+		FixedParent:         true,
+		SensitiveDataOrigin: true,
+		UserWithAuth:        true,
+		NoDA:                true,
+		Restricted:          true,
+		SignEncrypt:         true,
+	},
+	Parameters: tpm2.NewTPMUPublicParms(
+		tpm2.TPMAlgECC,
+		&tpm2.TPMSECCParms{
+			Scheme: tpm2.TPMTECCScheme{
+				Scheme: tpm2.TPMAlgECDSA,
+				Details: tpm2.NewTPMUAsymScheme(
+					tpm2.TPMAlgECDSA,
+					&tpm2.TPMSSigSchemeECDSA{
+						HashAlg: tpm2.TPMAlgSHA256,
+					},
+				),
+			},
+			CurveID: tpm2.TPMECCNistP256,
+		},
+	),
+	Unique: tpm2.NewTPMUPublicID(
+		tpm2.TPMAlgECC,
+		&tpm2.TPMSECCPoint{
+			X: tpm2.TPM2BECCParameter{
+				Buffer: make([]byte, 32),
+			},
+			Y: tpm2.TPM2BECCParameter{
+				Buffer: make([]byte, 32),
+			},
+		},
+	),
+}
+
+// ChallengeFunc requests the attestation challenge from the verifier.
+type ChallengeFunc = func(ctx context.Context, keys *securityapi.AttestationKeys) (*securityapi.AttestationChallengeResponse, error)
+
+// Attester produces attestation evidence with the TPM of the node.
+type Attester struct {
+	// PCRs to quote.
+	PCRs []int
+	// PhaseLog provides the PCR 11 value in the phase covered by the signed PCR policy (optional).
+	PhaseLog *PhaseLog
+}
+
+// Attest produces the attestation evidence for the CSR.
+//
+//nolint:gocyclo
+func (a *Attester) Attest(ctx context.Context, csr []byte, challengeFunc ChallengeFunc) (*securityapi.NodeAttestation, error) {
+	t, err := tpm.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open TPM: %w", err)
+	}
+
+	defer t.Close() //nolint:errcheck
+
+	ek, err := tpm2.CreatePrimary{
+		PrimaryHandle: tpm2.TPMRHEndorsement,
+		InPublic:      tpm2.New2B(tpm2.RSAEKTemplate),
+	}.Execute(t)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create endorsement key: %w", err)
+	}
+
+	defer flush(t, ek.ObjectHandle)
+
+	ak, err := tpm2.CreatePrimary{
+		PrimaryHandle: tpm2.TPMRHOwner,
+		InPublic:      tpm2.New2B(akTemplate),
+	}.Execute(t)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create attestation key: %w", err)
+	}
+
+	defer flush(t, ak.ObjectHandle)
+
+	keys := &securityapi.AttestationKeys{
+		EkPublic: tpm2.Marshal(ek.OutPublic),
+		AkPublic: tpm2.Marshal(ak.OutPublic),
+	}
+
+	// EK certificate is optional, as not all TPMs have it provisioned
+	keys.EkCertificate, _ = readNV(t, rsaEKCertificateIndex) //nolint:errcheck
+
+	challenge, err := challengeFunc(ctx, keys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attestation challenge: %w", err)
+	}
+
+	activated, err := tpm2.ActivateCredential{
+		ActivateHandle: tpm2.AuthHandle{
+			Handle: ak.ObjectHandle,
+			Name:   ak.Name,
+			Auth:   tpm2.PasswordAuth(nil),
+		},
+		KeyHandle: tpm2.AuthHandle{
+			Handle: ek.ObjectHandle,
+			Name:   ek.Name,
+			Auth:   tpm2.Policy(tpm2.TPMAlgSHA256, 16, endorsementPolicy),
+		},
+		CredentialBlob: tpm2.TPM2BIDObject{
+			Buffer: challenge.CredentialBlob,
+		},
+		Secret: tpm2.TPM2BEncryptedSecret{
+			Buffer: challenge.EncryptedSecret,
+		},
+	}.Execute(t)
+	if err != nil {
+		return nil, fmt.Errorf("failed to activate credential: %w", err)
+	}
+
+	evidence := &securityapi.NodeAttestation{
+		Keys:      keys,
+		Challenge: challenge.Challenge,
+		Secret:    activated.CertInfo.Buffer,
+	}
+
+	selection, err := PCRSelection(a.PCRs)
+	if err != nil {
+		return nil, err
+	}
+
+	quoted, err := tpm2.Quote{
+		SignHandle: tpm2.AuthHandle{
+			Handle: ak.ObjectHandle,
+			Name:   ak.Name,
+			Auth:   tpm2.PasswordAuth(nil),
+		},
+		QualifyingData: tpm2.TPM2BData{
+			Buffer: QualifyingData(evidence.Secret, csr),
+		},
+		InScheme: tpm2.TPMTSigScheme{
+			Scheme: tpm2.TPMAlgNull,
+		},
+		PCRSelect: selection,
+	}.Execute(t)
+	if err != nil {
+		return nil, fmt.Errorf("failed to quote PCRs: %w", err)
+	}
+
+	evidence.Quote = quoted.Quoted.Bytes()
+	evidence.QuoteSignature = tpm2.Marshal(quoted.Signature)
+
+	// PCR values are read after the quote, if any of them changes in between, the verifier rejects the evidence
+	for _, pcr := range a.PCRs {
+		value, err := readPCR(t, pcr)
+		if err != nil {
+			return nil, err
+		}
+
+		evidence.PcrValues = append(evidence.PcrValues, &securityapi.PCRValue{
+			Index: uint32(pcr),
+			Value: value,
+		})
+	}
+
+	// signed PCR policy is only available when booted with a UKI
+	pcrSignature, err := os.ReadFile(constants.PCRSignatureJSON)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read signed PCR policy: %w", err)
+	}
+
+	evidence.PcrSignature = pcrSignature
+
+	if a.PhaseLog != nil {
+		evidence.SignedPhasePcrValue = a.PhaseLog.SignedPhaseValue()
+	}
+
+	return evidence, nil
+}
+
+func endorsementPolicy(t transport.TPM, handle tpm2.TPMISHPolicy, nonceTPM tpm2.TPM2BNonce) error {
+	_, err := tpm2.PolicySecret{
+		AuthHandle:    tpm2.TPMRHEndorsement,
+		PolicySession: handle,
+		NonceTPM:      nonceTPM,
+	}.Execute(t)
+
+	return err
+}
+
+func readPCR(t transport.TPM, pcr int) ([]byte, error) {
+	selection, err := PCRSelection([]int{pcr})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := tpm2.PCRRead{
+		PCRSelectionIn: selection,
+	}.Execute(t)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read PCR %d: %w", pcr, err)
+	}
+
+	if len(resp.PCRValues.Digests) == 0 {
+		return nil, fmt.Errorf("PCR %d value is not available", pcr)
+	}
+
+	return resp.PCRValues.Digests[0].Buffer, nil
+}
+
+func readNV(t transport.TPM, index tpm2.TPMHandle) ([]byte, error) {
+	nvPublic, err := tpm2.NVReadPublic{
+		NVIndex: index,
+	}.Execute(t)
+	if err != nil {
+		return nil, err
+	}
+
+	contents, err := nvPublic.NVPublic.Contents()
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, 0, contents.DataSize)
+
+	for offset := uint16(0); offset < contents.DataSize; {
+		size := min(contents.DataSize-offset, nvReadChunkSize)
+
+		resp, err := tpm2.NVRead{
+			AuthHandle: tpm2.AuthHandle{
+				Handle: tpm2.TPMRHOwner,
+				Auth:   tpm2.PasswordAuth(nil),
+			},
+			NVIndex: tpm2.NamedHandle{
+				Handle: index,
+				Name:   nvPublic.NVName,
+			},
+			Size:   size,
+			Offset: offset,
+		}.Execute(t)
+		if err != nil {
+			return nil, err
+		}
+
+		data = append(data, resp.Data.Buffer...)
+		offset += size
+	}
+
+	return data, nil
+}
+
+func flush(t transport.TPM, handle tpm2.TPMHandle) {
+	tpm2.FlushContext{FlushHandle: handle}.Execute(t) //nolint:errcheck
+}
@@ -0,0 +1,98 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package attestation
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io/fs"
+	"slices"
+	"sync"
+
+	"github.com/siderolabs/talos/internal/pkg/secureboot"
+	"github.com/siderolabs/talos/internal/pkg/tpm"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// unsignedPhases are the phases extended to PCR 11 after the `enter-machined` phase covered by the signed PCR policy, in order.
+var unsignedPhases = []secureboot.Phase{
+	secureboot.StartTheWorld,
+}
+
+// PhaseLog records the PCR 11 value in the `enter-machined` phase.
+//
+// PCR 11 is extended with the `start-the-world` phase before the node requests its certificates,
+// so the recorded value is attached to the evidence for the verifier to replay the phase extensions.
+type PhaseLog struct {
+	mu    sync.Mutex
+	value []byte
+}
+
+// Record reads the current PCR 11 value, it should be called before PCR 11 is extended past the `enter-machined` phase.
+func (l *PhaseLog) Record() error {
+	t, err := tpm.Open()
+	if err != nil {
+		// nothing to record without the TPM
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to open TPM: %w", err)
+	}
+
+	defer t.Close() //nolint:errcheck
+
+	value, err := readPCR(t, constants.UKIPCR)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.value = value
+
+	return nil
+}
+
+// SignedPhaseValue returns the recorded PCR 11 value, or nil if it was not recorded.
+func (l *PhaseLog) SignedPhaseValue() []byte {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return slices.Clone(l.value)
+}
+
+// ExtendPCR returns the PCR value after extending it with the data, as it is done by the TPM.
+func ExtendPCR(value, data []byte) []byte {
+	digest := sha256.Sum256(data)
+
+	h := sha256.New()
+	h.Write(value)
+	h.Write(digest[:])
+
+	return h.Sum(nil)
+}
+
+// replayPhases checks that the PCR 11 value is reached from the signed phase value by the phase extensions which follow it.
+func replayPhases(signedPhaseValue, value []byte) bool {
+	current := signedPhaseValue
+
+	if bytes.Equal(current, value) {
+		return true
+	}
+
+	for _, phase := range unsignedPhases {
+		current = ExtendPCR(current, []byte(phase))
+
+		if bytes.Equal(current, value) {
+			return true
+		}
+	}
+
+	return false
+}
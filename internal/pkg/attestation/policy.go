@@ -0,0 +1,88 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package attestation
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/security"
+)
+
+// Policy is the parsed node attestation policy.
+type Policy struct {
+	Mode             config.NodeAttestationMode
+	PCRs             []int
+	AllowedPCRValues map[int][][]byte
+	PCRSigningKeys   []*rsa.PublicKey
+	EndorsementCAs   *x509.CertPool
+}
+
+// NewPolicy parses the policy from the resource spec.
+//
+// If the spec is nil, the policy is disabled.
+func NewPolicy(spec *security.NodeAttestationPolicySpec) (*Policy, error) {
+	if spec == nil {
+		return &Policy{Mode: config.NodeAttestationModeDisabled}, nil
+	}
+
+	mode, err := config.ParseNodeAttestationMode(spec.Mode)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &Policy{
+		Mode:             mode,
+		PCRs:             spec.PCRs,
+		AllowedPCRValues: make(map[int][][]byte, len(spec.AllowedPCRValues)),
+	}
+
+	for _, allowed := range spec.AllowedPCRValues {
+		for _, value := range allowed.Values {
+			decoded, err := hex.DecodeString(value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode allowed value for PCR %d: %w", allowed.PCR, err)
+			}
+
+			policy.AllowedPCRValues[allowed.PCR] = append(policy.AllowedPCRValues[allowed.PCR], decoded)
+		}
+	}
+
+	for _, key := range spec.PCRSigningKeys {
+		block, _ := pem.Decode([]byte(key))
+		if block == nil {
+			return nil, errors.New("failed to decode PCR signing public key")
+		}
+
+		pubKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse PCR signing public key: %w", err)
+		}
+
+		rsaKey, ok := pubKey.(*rsa.PublicKey)
+		if !ok {
+			return nil, errors.New("PCR signing public key is not an RSA key")
+		}
+
+		policy.PCRSigningKeys = append(policy.PCRSigningKeys, rsaKey)
+	}
+
+	if len(spec.EndorsementCAs) > 0 {
+		policy.EndorsementCAs = x509.NewCertPool()
+
+		for _, ca := range spec.EndorsementCAs {
+			if !policy.EndorsementCAs.AppendCertsFromPEM([]byte(ca)) {
+				return nil, errors.New("failed to parse endorsement CA certificates")
+			}
+		}
+	}
+
+	return policy, nil
+}
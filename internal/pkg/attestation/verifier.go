@@ -0,0 +1,480 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package attestation
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/hkdf"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/google/go-tpm/tpm2"
+
+	tpm2internal "github.com/siderolabs/talos/internal/pkg/secureboot/tpm2"
+	securityapi "github.com/siderolabs/talos/pkg/machinery/api/security"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+const (
+	// ChallengeTTL is the validity period of the attestation challenge.
+	ChallengeTTL = 5 * time.Minute
+
+	challengeNonceSize = 16
+	challengeSize      = 8 + challengeNonceSize
+)
+
+// Verifier issues attestation challenges and verifies attestation evidence.
+//
+// Verifier is stateless: the challenge secret is derived from the key,
+// so any control plane node sharing the same key can verify the evidence.
+type Verifier struct {
+	key    []byte
+	policy *Policy
+
+	// Now is the current time function, used in tests.
+	Now func() time.Time
+}
+
+// Keys is the parsed set of TPM keys of the node.
+type Keys struct {
+	EKPublic      *tpm2.TPMTPublic
+	EKCertificate *x509.Certificate
+	AKPublic      *tpm2.TPMTPublic
+	AKName        *tpm2.TPM2BName
+
+	ekPublicBytes []byte
+}
+
+// Result is the verification result.
+type Result struct {
+	EKFingerprint        string
+	EKCertificateSubject string
+	AKName               string
+	PCRValues            map[int][]byte
+}
+
+// NewVerifier creates a new verifier.
+//
+// The secret is used to derive the challenge key, it should be shared across control plane nodes.
+func NewVerifier(secret []byte, policy *Policy) (*Verifier, error) {
+	key, err := hkdf.Key(sha256.New, secret, nil, "talos node attestation challenge", sha256.Size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive challenge key: %w", err)
+	}
+
+	return &Verifier{
+		key:    key,
+		policy: policy,
+		Now:    time.Now,
+	}, nil
+}
+
+// Policy returns the attestation policy.
+func (v *Verifier) Policy() *Policy {
+	return v.policy
+}
+
+// Challenge issues a new challenge for the keys.
+func (v *Verifier) Challenge(keys *securityapi.AttestationKeys) (*securityapi.AttestationChallengeResponse, error) {
+	parsedKeys, err := ParseKeys(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	challenge := make([]byte, challengeSize)
+
+	binary.BigEndian.PutUint64(challenge, uint64(v.Now().Add(ChallengeTTL).Unix()))
+
+	if _, err = rand.Read(challenge[8:]); err != nil {
+		return nil, err
+	}
+
+	ek, err := tpm2.ImportEncapsulationKey(parsedKeys.EKPublic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import endorsement key: %w", err)
+	}
+
+	credentialBlob, encryptedSecret, err := tpm2.CreateCredential(rand.Reader, ek, parsedKeys.AKName.Buffer, v.secret(challenge, parsedKeys))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create credential: %w", err)
+	}
+
+	return &securityapi.AttestationChallengeResponse{
+		Challenge:       challenge,
+		CredentialBlob:  credentialBlob,
+		EncryptedSecret: encryptedSecret,
+	}, nil
+}
+
+func (v *Verifier) secret(challenge []byte, keys *Keys) []byte {
+	mac := hmac.New(sha256.New, v.key)
+	mac.Write(challenge)
+	mac.Write(keys.AKName.Buffer)
+	mac.Write(keys.ekPublicBytes)
+
+	return mac.Sum(nil)
+}
+
+// Verify the attestation evidence for the CSR.
+//
+// Result is returned even if the verification fails, as long as the keys could be parsed.
+//
+//nolint:gocyclo,cyclop
+func (v *Verifier) Verify(csr []byte, evidence *securityapi.NodeAttestation) (*Result, error) {
+	if evidence == nil {
+		return nil, errors.New("no attestation evidence provided")
+	}
+
+	keys, err := ParseKeys(evidence.GetKeys())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{
+		AKName: hex.EncodeToString(keys.AKName.Buffer),
+	}
+
+	ekPub, err := tpm2.Pub(*keys.EKPublic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse endorsement key: %w", err)
+	}
+
+	ekPubDER, err := x509.MarshalPKIXPublicKey(ekPub)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal endorsement key: %w", err)
+	}
+
+	result.EKFingerprint = Fingerprint(ekPubDER)
+
+	if keys.EKCertificate != nil {
+		result.EKCertificateSubject = keys.EKCertificate.Subject.String()
+	}
+
+	if err = v.verifyEndorsement(keys, ekPub); err != nil {
+		return result, err
+	}
+
+	if len(evidence.Challenge) != challengeSize {
+		return result, errors.New("invalid challenge")
+	}
+
+	if expires := time.Unix(int64(binary.BigEndian.Uint64(evidence.Challenge)), 0); v.Now().After(expires) {
+		return result, errors.New("challenge expired")
+	}
+
+	if subtle.ConstantTimeCompare(v.secret(evidence.Challenge, keys), evidence.Secret) != 1 {
+		return result, errors.New("credential activation failed: secret mismatch")
+	}
+
+	quoteInfo, err := v.verifyQuote(keys, csr, evidence)
+	if err != nil {
+		return result, err
+	}
+
+	result.PCRValues, err = v.verifyPCRs(quoteInfo, evidence)
+	if err != nil {
+		return result, err
+	}
+
+	if err = v.checkPolicy(result.PCRValues, evidence.PcrSignature, evidence.SignedPhasePcrValue); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+func (v *Verifier) verifyEndorsement(keys *Keys, ekPub crypto.PublicKey) error {
+	if keys.EKCertificate != nil {
+		certPub, ok := keys.EKCertificate.PublicKey.(interface{ Equal(crypto.PublicKey) bool })
+		if !ok || !certPub.Equal(ekPub) {
+			return errors.New("endorsement key doesn't match the certificate")
+		}
+	}
+
+	if v.policy.EndorsementCAs == nil {
+		return nil
+	}
+
+	if keys.EKCertificate == nil {
+		return errors.New("endorsement key certificate is required")
+	}
+
+	if _, err := keys.EKCertificate.Verify(x509.VerifyOptions{
+		Roots:       v.policy.EndorsementCAs,
+		KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+		CurrentTime: v.Now(),
+	}); err != nil {
+		return fmt.Errorf("failed to verify endorsement key certificate: %w", err)
+	}
+
+	return nil
+}
+
+func (v *Verifier) verifyQuote(keys *Keys, csr []byte, evidence *securityapi.NodeAttestation) (*tpm2.TPMSQuoteInfo, error) {
+	attest, err := tpm2.Unmarshal[tpm2.TPMSAttest](evidence.Quote)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse quote: %w", err)
+	}
+
+	if attest.Type != tpm2.TPMSTAttestQuote {
+		return nil, fmt.Errorf("unexpected attestation type %v", attest.Type)
+	}
+
+	if !bytes.Equal(attest.ExtraData.Buffer, QualifyingData(evidence.Secret, csr)) {
+		return nil, errors.New("quote qualifying data mismatch")
+	}
+
+	signature, err := tpm2.Unmarshal[tpm2.TPMTSignature](evidence.QuoteSignature)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse quote signature: %w", err)
+	}
+
+	akPub, err := tpm2.Pub(*keys.AKPublic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse attestation key: %w", err)
+	}
+
+	digest := sha256.Sum256(evidence.Quote)
+
+	if err = verifySignature(akPub, digest[:], signature); err != nil {
+		return nil, fmt.Errorf("failed to verify quote signature: %w", err)
+	}
+
+	return attest.Attested.Quote()
+}
+
+func verifySignature(pub crypto.PublicKey, digest []byte, signature *tpm2.TPMTSignature) error {
+	switch key := pub.(type) {
+	case *rsa.PublicKey:
+		if signature.SigAlg != tpm2.TPMAlgRSASSA {
+			return fmt.Errorf("unexpected signature algorithm %v", signature.SigAlg)
+		}
+
+		sig, err := signature.Signature.RSASSA()
+		if err != nil {
+			return err
+		}
+
+		if sig.Hash != tpm2.TPMAlgSHA256 {
+			return fmt.Errorf("unexpected signature hash algorithm %v", sig.Hash)
+		}
+
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, digest, sig.Sig.Buffer)
+	case *ecdsa.PublicKey:
+		if signature.SigAlg != tpm2.TPMAlgECDSA {
+			return fmt.Errorf("unexpected signature algorithm %v", signature.SigAlg)
+		}
+
+		sig, err := signature.Signature.ECDSA()
+		if err != nil {
+			return err
+		}
+
+		if sig.Hash != tpm2.TPMAlgSHA256 {
+			return fmt.Errorf("unexpected signature hash algorithm %v", sig.Hash)
+		}
+
+		if !ecdsa.Verify(key, digest, new(big.Int).SetBytes(sig.SignatureR.Buffer), new(big.Int).SetBytes(sig.SignatureS.Buffer)) {
+			return errors.New("invalid signature")
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported key type %T", pub)
+	}
+}
+
+func (v *Verifier) verifyPCRs(quoteInfo *tpm2.TPMSQuoteInfo, evidence *securityapi.NodeAttestation) (map[int][]byte, error) {
+	expectedSelection, err := PCRSelection(v.policy.PCRs)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(tpm2.Marshal(quoteInfo.PCRSelect), tpm2.Marshal(expectedSelection)) {
+		return nil, fmt.Errorf("quoted PCR selection doesn't match the policy PCRs %v", v.policy.PCRs)
+	}
+
+	values := make(map[int][]byte, len(evidence.PcrValues))
+
+	for _, pcrValue := range evidence.PcrValues {
+		pcr := int(pcrValue.Index)
+
+		if !slices.Contains(v.policy.PCRs, pcr) {
+			return nil, fmt.Errorf("unexpected PCR %d value", pcr)
+		}
+
+		if len(pcrValue.Value) != sha256.Size {
+			return nil, fmt.Errorf("invalid PCR %d value length %d", pcr, len(pcrValue.Value))
+		}
+
+		values[pcr] = pcrValue.Value
+	}
+
+	for _, pcr := range v.policy.PCRs {
+		if _, ok := values[pcr]; !ok {
+			return nil, fmt.Errorf("missing PCR %d value", pcr)
+		}
+	}
+
+	if !bytes.Equal(PCRDigest(values), quoteInfo.PCRDigest.Buffer) {
+		return nil, errors.New("PCR values don't match the quote digest")
+	}
+
+	return values, nil
+}
+
+func (v *Verifier) checkPolicy(values map[int][]byte, pcrSignature, signedPhaseValue []byte) error {
+	for _, pcr := range v.policy.PCRs {
+		allowed := v.policy.AllowedPCRValues[pcr]
+
+		if slices.ContainsFunc(allowed, func(value []byte) bool { return bytes.Equal(value, values[pcr]) }) {
+			continue
+		}
+
+		if pcr == constants.UKIPCR && len(v.policy.PCRSigningKeys) > 0 {
+			if err := v.checkSignedPolicy(values[pcr], signedPhaseValue, pcrSignature); err != nil {
+				return fmt.Errorf("PCR %d value %x is not allowed: %w", pcr, values[pcr], err)
+			}
+
+			continue
+		}
+
+		if len(allowed) > 0 {
+			return fmt.Errorf("PCR %d value %x is not allowed", pcr, values[pcr])
+		}
+	}
+
+	return nil
+}
+
+// checkSignedPolicy verifies that the PCR 11 value matches a policy signed by one of the trusted keys.
+//
+// The signed policy is generated when the UKI is built, and it matches the PCR 11 value in the `enter-machined` phase.
+// By the time the node requests the certificate, PCR 11 is usually extended with the later phases, so the node provides
+// the value recorded in the `enter-machined` phase, and the verifier replays the phase extensions up to the quoted value.
+func (v *Verifier) checkSignedPolicy(value, signedPhaseValue, pcrSignature []byte) error {
+	if len(pcrSignature) == 0 {
+		return errors.New("no signed PCR policy provided")
+	}
+
+	if len(signedPhaseValue) > 0 {
+		if !replayPhases(signedPhaseValue, value) {
+			return fmt.Errorf("PCR value doesn't match the phase extensions of the signed phase value %x", signedPhaseValue)
+		}
+
+		value = signedPhaseValue
+	}
+
+	var pcrData tpm2internal.PCRData
+
+	if err := json.Unmarshal(pcrSignature, &pcrData); err != nil {
+		return fmt.Errorf("failed to parse signed PCR policy: %w", err)
+	}
+
+	selection, err := PCRSelection([]int{constants.UKIPCR})
+	if err != nil {
+		return err
+	}
+
+	expectedPolicy, err := tpm2internal.CalculatePolicy(value, selection)
+	if err != nil {
+		return err
+	}
+
+	for _, bank := range pcrData.SHA256 {
+		if !slices.Equal(bank.PCRs, []int{constants.UKIPCR}) {
+			continue
+		}
+
+		policy, err := hex.DecodeString(bank.Pol)
+		if err != nil || !bytes.Equal(policy, expectedPolicy) {
+			continue
+		}
+
+		signature, err := base64.StdEncoding.DecodeString(bank.Sig)
+		if err != nil {
+			continue
+		}
+
+		policyHash := sha256.Sum256(policy)
+
+		for _, key := range v.policy.PCRSigningKeys {
+			if rsa.VerifyPKCS1v15(key, crypto.SHA256, policyHash[:], signature) == nil {
+				return nil
+			}
+		}
+	}
+
+	return errors.New("no matching signed PCR policy found")
+}
+
+// ParseKeys parses and validates the TPM keys of the node.
+func ParseKeys(keys *securityapi.AttestationKeys) (*Keys, error) {
+	if keys == nil {
+		return nil, errors.New("no attestation keys provided")
+	}
+
+	ekPublic, err := tpm2.Unmarshal[tpm2.TPM2BPublic](keys.EkPublic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse endorsement key: %w", err)
+	}
+
+	ekContents, err := ekPublic.Contents()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse endorsement key: %w", err)
+	}
+
+	akPublic, err := tpm2.Unmarshal[tpm2.TPM2BPublic](keys.AkPublic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse attestation key: %w", err)
+	}
+
+	akContents, err := akPublic.Contents()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse attestation key: %w", err)
+	}
+
+	attrs := akContents.ObjectAttributes
+
+	if !attrs.FixedTPM || !attrs.FixedParent || !attrs.SensitiveDataOrigin || !attrs.Restricted || !attrs.SignEncrypt || attrs.Decrypt {
+		return nil, errors.New("attestation key is not a restricted signing key resident in the TPM")
+	}
+
+	akName, err := tpm2.ObjectName(akContents)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate attestation key name: %w", err)
+	}
+
+	result := &Keys{
+		EKPublic:      ekContents,
+		AKPublic:      akContents,
+		AKName:        akName,
+		ekPublicBytes: keys.EkPublic,
+	}
+
+	if len(keys.EkCertificate) > 0 {
+		result.EKCertificate, err = x509.ParseCertificate(keys.EkCertificate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse endorsement key certificate: %w", err)
+		}
+	}
+
+	return result, nil
+}
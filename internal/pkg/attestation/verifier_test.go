@@ -0,0 +1,531 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package attestation_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/google/go-tpm/tpm2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/attestation"
+	"github.com/siderolabs/talos/internal/pkg/secureboot"
+	tpm2internal "github.com/siderolabs/talos/internal/pkg/secureboot/tpm2"
+	securityapi "github.com/siderolabs/talos/pkg/machinery/api/security"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/security"
+)
+
+// softTPM emulates the TPM operations required for the attestation in software.
+type softTPM struct {
+	ek *rsa.PrivateKey
+	ak *ecdsa.PrivateKey
+
+	ekPublic tpm2.TPMTPublic
+	akPublic tpm2.TPMTPublic
+
+	ekCertificate []byte
+}
+
+func newSoftTPM(t *testing.T) *softTPM {
+	t.Helper()
+
+	ek, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	ak, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	ekPublic := tpm2.RSAEKTemplate
+	ekPublic.Unique = tpm2.NewTPMUPublicID(tpm2.TPMAlgRSA, &tpm2.TPM2BPublicKeyRSA{Buffer: ek.N.Bytes()})
+
+	akPublic := tpm2.TPMTPublic{
+		Type:    tpm2.TPMAlgECC,
+		NameAlg: tpm2.TPMAlgSHA256,
+		ObjectAttributes: tpm2.TPMAObject{
+			FixedTPM:            true,
+			FixedParent:         true,
+			SensitiveDataOrigin: true,
+			UserWithAuth:        true,
+			NoDA:                true,
+			Restricted:          true,
+			SignEncrypt:         true,
+		},
+		Parameters: tpm2.NewTPMUPublicParms(tpm2.TPMAlgECC, &tpm2.TPMSECCParms{
+			Scheme: tpm2.TPMTECCScheme{
+				Scheme:  tpm2.TPMAlgECDSA,
+				Details: tpm2.NewTPMUAsymScheme(tpm2.TPMAlgECDSA, &tpm2.TPMSSigSchemeECDSA{HashAlg: tpm2.TPMAlgSHA256}),
+			},
+			CurveID: tpm2.TPMECCNistP256,
+		}),
+		Unique: tpm2.NewTPMUPublicID(tpm2.TPMAlgECC, &tpm2.TPMSECCPoint{
+			X: tpm2.TPM2BECCParameter{Buffer: ak.X.FillBytes(make([]byte, 32))}, //nolint:staticcheck
+			Y: tpm2.TPM2BECCParameter{Buffer: ak.Y.FillBytes(make([]byte, 32))}, //nolint:staticcheck
+		}),
+	}
+
+	return &softTPM{
+		ek:       ek,
+		ak:       ak,
+		ekPublic: ekPublic,
+		akPublic: akPublic,
+	}
+}
+
+func (s *softTPM) keys() *securityapi.AttestationKeys {
+	return &securityapi.AttestationKeys{
+		EkPublic:      tpm2.Marshal(tpm2.New2B(s.ekPublic)),
+		EkCertificate: s.ekCertificate,
+		AkPublic:      tpm2.Marshal(tpm2.New2B(s.akPublic)),
+	}
+}
+
+func (s *softTPM) attest(t *testing.T, v *attestation.Verifier, csr []byte, pcrValues map[int][]byte) *securityapi.NodeAttestation {
+	t.Helper()
+
+	challenge, err := v.Challenge(s.keys())
+	require.NoError(t, err)
+
+	keys, err := attestation.ParseKeys(s.keys())
+	require.NoError(t, err)
+
+	// this is what TPM2_ActivateCredential would return
+	secret := v.Secret(challenge.Challenge, keys)
+
+	pcrs := make([]int, 0, len(pcrValues))
+
+	evidence := &securityapi.NodeAttestation{
+		Keys:      s.keys(),
+		Challenge: challenge.Challenge,
+		Secret:    secret,
+	}
+
+	for pcr, value := range pcrValues {
+		pcrs = append(pcrs, pcr)
+
+		evidence.PcrValues = append(evidence.PcrValues, &securityapi.PCRValue{Index: uint32(pcr), Value: value})
+	}
+
+	selection, err := attestation.PCRSelection(pcrs)
+	require.NoError(t, err)
+
+	attest := tpm2.TPMSAttest{
+		Magic:           tpm2.TPMGeneratedValue,
+		Type:            tpm2.TPMSTAttestQuote,
+		QualifiedSigner: *keys.AKName,
+		ExtraData:       tpm2.TPM2BData{Buffer: attestation.QualifyingData(secret, csr)},
+		Attested: tpm2.NewTPMUAttest(tpm2.TPMSTAttestQuote, &tpm2.TPMSQuoteInfo{
+			PCRSelect: selection,
+			PCRDigest: tpm2.TPM2BDigest{Buffer: attestation.PCRDigest(pcrValues)},
+		}),
+	}
+
+	evidence.Quote = tpm2.Marshal(attest)
+
+	digest := sha256.Sum256(evidence.Quote)
+
+	r, sigS, err := ecdsa.Sign(rand.Reader, s.ak, digest[:])
+	require.NoError(t, err)
+
+	evidence.QuoteSignature = tpm2.Marshal(tpm2.TPMTSignature{
+		SigAlg: tpm2.TPMAlgECDSA,
+		Signature: tpm2.NewTPMUSignature(tpm2.TPMAlgECDSA, &tpm2.TPMSSignatureECC{
+			Hash:       tpm2.TPMAlgSHA256,
+			SignatureR: tpm2.TPM2BECCParameter{Buffer: r.Bytes()},
+			SignatureS: tpm2.TPM2BECCParameter{Buffer: sigS.Bytes()},
+		}),
+	})
+
+	return evidence
+}
+
+func pcrValue(b byte) []byte {
+	value := make([]byte, sha256.Size)
+	value[0] = b
+
+	return value
+}
+
+func newVerifier(t *testing.T, spec *security.NodeAttestationPolicySpec) *attestation.Verifier {
+	t.Helper()
+
+	policy, err := attestation.NewPolicy(spec)
+	require.NoError(t, err)
+
+	v, err := attestation.NewVerifier([]byte("secret"), policy)
+	require.NoError(t, err)
+
+	return v
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	csr := []byte("csr")
+	pcrValues := map[int][]byte{
+		7:  pcrValue(7),
+		11: pcrValue(11),
+	}
+
+	spec := &security.NodeAttestationPolicySpec{
+		Mode: string(config.NodeAttestationModeRequired),
+		PCRs: []int{7, 11},
+		AllowedPCRValues: []security.NodeAttestationPCRValueSpec{
+			{
+				PCR:    7,
+				Values: []string{hex.EncodeToString(pcrValue(7))},
+			},
+		},
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		tpm := newSoftTPM(t)
+		v := newVerifier(t, spec)
+
+		result, err := v.Verify(csr, tpm.attest(t, v, csr, pcrValues))
+		require.NoError(t, err)
+
+		assert.Equal(t, pcrValues, result.PCRValues)
+		assert.NotEmpty(t, result.EKFingerprint)
+		assert.NotEmpty(t, result.AKName)
+	})
+
+	t.Run("PCR not allowed", func(t *testing.T) {
+		t.Parallel()
+
+		tpm := newSoftTPM(t)
+		v := newVerifier(t, spec)
+
+		_, err := v.Verify(csr, tpm.attest(t, v, csr, map[int][]byte{7: pcrValue(8), 11: pcrValue(11)}))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "PCR 7 value")
+	})
+
+	t.Run("PCR value tampered", func(t *testing.T) {
+		t.Parallel()
+
+		tpm := newSoftTPM(t)
+		v := newVerifier(t, spec)
+
+		evidence := tpm.attest(t, v, csr, pcrValues)
+		evidence.PcrValues[0].Value = pcrValue(42)
+
+		_, err := v.Verify(csr, evidence)
+		require.Error(t, err)
+	})
+
+	t.Run("different CSR", func(t *testing.T) {
+		t.Parallel()
+
+		tpm := newSoftTPM(t)
+		v := newVerifier(t, spec)
+
+		_, err := v.Verify([]byte("other csr"), tpm.attest(t, v, csr, pcrValues))
+		require.EqualError(t, err, "quote qualifying data mismatch")
+	})
+
+	t.Run("secret mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		tpm := newSoftTPM(t)
+		v := newVerifier(t, spec)
+
+		evidence := tpm.attest(t, v, csr, pcrValues)
+		evidence.Secret = []byte("guess")
+
+		_, err := v.Verify(csr, evidence)
+		require.EqualError(t, err, "credential activation failed: secret mismatch")
+	})
+
+	t.Run("other AK", func(t *testing.T) {
+		t.Parallel()
+
+		tpm := newSoftTPM(t)
+		v := newVerifier(t, spec)
+
+		evidence := tpm.attest(t, v, csr, pcrValues)
+		evidence.Keys = newSoftTPM(t).keys()
+
+		_, err := v.Verify(csr, evidence)
+		require.EqualError(t, err, "credential activation failed: secret mismatch")
+	})
+
+	t.Run("challenge expired", func(t *testing.T) {
+		t.Parallel()
+
+		tpm := newSoftTPM(t)
+		v := newVerifier(t, spec)
+
+		evidence := tpm.attest(t, v, csr, pcrValues)
+
+		v.Now = func() time.Time { return time.Now().Add(attestation.ChallengeTTL + time.Minute) }
+
+		_, err := v.Verify(csr, evidence)
+		require.EqualError(t, err, "challenge expired")
+	})
+
+	t.Run("other control plane", func(t *testing.T) {
+		t.Parallel()
+
+		tpm := newSoftTPM(t)
+		v := newVerifier(t, spec)
+
+		// verifiers sharing the same secret accept each other's challenges
+		_, err := newVerifier(t, spec).Verify(csr, tpm.attest(t, v, csr, pcrValues))
+		require.NoError(t, err)
+	})
+}
+
+func signPCRPolicy(t *testing.T, signingKey *rsa.PrivateKey, value []byte) []byte {
+	t.Helper()
+
+	selection, err := attestation.PCRSelection([]int{11})
+	require.NoError(t, err)
+
+	policy, err := tpm2internal.CalculatePolicy(value, selection)
+	require.NoError(t, err)
+
+	policyHash := sha256.Sum256(policy)
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, signingKey, crypto.SHA256, policyHash[:])
+	require.NoError(t, err)
+
+	pcrSignature, err := json.Marshal(tpm2internal.PCRData{
+		SHA256: []tpm2internal.BankData{
+			{
+				PCRs: []int{11},
+				Pol:  hex.EncodeToString(policy),
+				Sig:  base64.StdEncoding.EncodeToString(signature),
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	return pcrSignature
+}
+
+func newSignedPolicyVerifier(t *testing.T, signingKey *rsa.PrivateKey) *attestation.Verifier {
+	t.Helper()
+
+	signingKeyDER, err := x509.MarshalPKIXPublicKey(signingKey.Public())
+	require.NoError(t, err)
+
+	return newVerifier(t, &security.NodeAttestationPolicySpec{
+		Mode:           string(config.NodeAttestationModeRequired),
+		PCRs:           []int{11},
+		PCRSigningKeys: []string{string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: signingKeyDER}))},
+	})
+}
+
+func TestVerifySignedPolicy(t *testing.T) {
+	t.Parallel()
+
+	csr := []byte("csr")
+	pcrValues := map[int][]byte{
+		11: pcrValue(11),
+	}
+
+	signingKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	pcrSignature := signPCRPolicy(t, signingKey, pcrValues[11])
+
+	v := newSignedPolicyVerifier(t, signingKey)
+
+	tpm := newSoftTPM(t)
+
+	evidence := tpm.attest(t, v, csr, pcrValues)
+
+	_, err = v.Verify(csr, evidence)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no signed PCR policy provided")
+
+	evidence.PcrSignature = pcrSignature
+
+	_, err = v.Verify(csr, evidence)
+	require.NoError(t, err)
+
+	evidence = tpm.attest(t, v, csr, map[int][]byte{11: pcrValue(12)})
+	evidence.PcrSignature = pcrSignature
+
+	_, err = v.Verify(csr, evidence)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no matching signed PCR policy found")
+}
+
+func TestVerifySignedPolicyBootPhases(t *testing.T) {
+	t.Parallel()
+
+	csr := []byte("csr")
+
+	// PCR 11 as measured by the UKI stub and extended with the boot phases
+	enterMachined := make([]byte, sha256.Size)
+
+	for _, data := range [][]byte{[]byte(".linux\x00"), []byte("kernel"), []byte(".initrd\x00"), []byte("initrd")} {
+		enterMachined = attestation.ExtendPCR(enterMachined, data)
+	}
+
+	for _, phaseInfo := range secureboot.OrderedPhases() {
+		enterMachined = attestation.ExtendPCR(enterMachined, []byte(phaseInfo.Phase))
+	}
+
+	startTheWorld := attestation.ExtendPCR(enterMachined, []byte(secureboot.StartTheWorld))
+
+	signingKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	pcrSignature := signPCRPolicy(t, signingKey, enterMachined)
+
+	for _, test := range []struct {
+		name string
+
+		quoted           []byte
+		signedPhaseValue []byte
+
+		expectedError string
+	}{
+		{
+			name: "enter-machined",
+
+			quoted: enterMachined,
+		},
+		{
+			name: "enter-machined with signed phase value",
+
+			quoted:           enterMachined,
+			signedPhaseValue: enterMachined,
+		},
+		{
+			name: "start-the-world",
+
+			quoted:           startTheWorld,
+			signedPhaseValue: enterMachined,
+		},
+		{
+			name: "start-the-world without signed phase value",
+
+			quoted: startTheWorld,
+
+			expectedError: "no matching signed PCR policy found",
+		},
+		{
+			name: "unknown phase",
+
+			quoted:           attestation.ExtendPCR(startTheWorld, []byte("unknown")),
+			signedPhaseValue: enterMachined,
+
+			expectedError: "PCR value doesn't match the phase extensions of the signed phase value",
+		},
+		{
+			name: "signed phase value of other boot",
+
+			quoted:           attestation.ExtendPCR(pcrValue(11), []byte(secureboot.StartTheWorld)),
+			signedPhaseValue: pcrValue(11),
+
+			expectedError: "no matching signed PCR policy found",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			tpm := newSoftTPM(t)
+			v := newSignedPolicyVerifier(t, signingKey)
+
+			evidence := tpm.attest(t, v, csr, map[int][]byte{11: test.quoted})
+			evidence.PcrSignature = pcrSignature
+			evidence.SignedPhasePcrValue = test.signedPhaseValue
+
+			_, err := v.Verify(csr, evidence)
+
+			if test.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVerifyEndorsementCertificate(t *testing.T) {
+	t.Parallel()
+
+	csr := []byte("csr")
+	pcrValues := map[int][]byte{
+		7: pcrValue(7),
+	}
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "TPM Manufacturer CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, caKey.Public(), caKey)
+	require.NoError(t, err)
+
+	ca, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	v := newVerifier(t, &security.NodeAttestationPolicySpec{
+		Mode:           string(config.NodeAttestationModeRequired),
+		PCRs:           []int{7},
+		EndorsementCAs: []string{string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}))},
+	})
+
+	tpm := newSoftTPM(t)
+
+	_, err = v.Verify(csr, tpm.attest(t, v, csr, pcrValues))
+	require.EqualError(t, err, "endorsement key certificate is required")
+
+	tpm.ekCertificate, err = x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "EK"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}, ca, tpm.ek.Public(), caKey)
+	require.NoError(t, err)
+
+	result, err := v.Verify(csr, tpm.attest(t, v, csr, pcrValues))
+	require.NoError(t, err)
+
+	assert.Equal(t, "CN=EK", result.EKCertificateSubject)
+
+	// EK certificate for a different key
+	tpm.ekCertificate, err = x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "EK"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}, ca, caKey.Public(), caKey)
+	require.NoError(t, err)
+
+	_, err = v.Verify(csr, tpm.attest(t, v, csr, pcrValues))
+	require.EqualError(t, err, "endorsement key doesn't match the certificate")
+}
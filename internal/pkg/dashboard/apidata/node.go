@@ -5,9 +5,15 @@
 package apidata
 
 import (
+	"slices"
+	"strings"
+
 	"github.com/siderolabs/gen/xslices"
 
+	"github.com/siderolabs/talos/pkg/kubernetes"
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 )
 
 // Node represents data gathered from a single node.
@@ -22,6 +28,14 @@ type Node struct {
 	DiskStats     *machine.DiskStats
 	Processes     *machine.Process
 	ServiceList   *machine.ServiceList
+	Version       *machine.Version
+	EtcdStatus    *machine.EtcdStatus
+
+	// These fields are fetched from the resource API on each poll.
+	MachineStatus *runtime.MachineStatus
+	KubeletStatus *k8s.KubeletStatus
+	NodeStatus    *k8s.NodeStatus
+	Diagnostics   []*runtime.Diagnostic
 
 	// These fields are calculated as diff with Node data from previous pol.
 	SystemStatDiff  *machine.SystemStat
@@ -98,6 +112,90 @@ func (node *Node) CPUUsageByName(name string) float64 {
 	panic("unknown cpu usage name")
 }
 
+// TalosVersion returns the Talos version tag, or an empty string if not known.
+func (node *Node) TalosVersion() string {
+	return node.Version.GetVersion().GetTag()
+}
+
+// KubernetesVersion returns the Kubernetes version derived from the kubelet image, or an empty string if not known.
+func (node *Node) KubernetesVersion() string {
+	if node.KubeletStatus == nil {
+		return ""
+	}
+
+	version, _ := kubernetes.VersionFromImageRef(node.KubeletStatus.TypedSpec().Image)
+
+	return version
+}
+
+// Pressure returns the pressure conditions reported by the kubelet for the node: none, memory, disk or both.
+//
+// If the Kubernetes node status is not known, an empty string is returned.
+func (node *Node) Pressure() string {
+	if node.NodeStatus == nil {
+		return ""
+	}
+
+	var conditions []string
+
+	if node.NodeStatus.TypedSpec().MemoryPressure {
+		conditions = append(conditions, "memory")
+	}
+
+	if node.NodeStatus.TypedSpec().DiskPressure {
+		conditions = append(conditions, "disk")
+	}
+
+	if len(conditions) == 0 {
+		return "none"
+	}
+
+	return strings.Join(conditions, ",")
+}
+
+// EtcdRole returns the etcd role of the node: leader, follower or learner.
+//
+// If the node doesn't run etcd, an empty string is returned.
+func (node *Node) EtcdRole() string {
+	status := node.EtcdStatus.GetMemberStatus()
+
+	switch {
+	case status == nil:
+		return ""
+	case status.GetIsLearner():
+		return "learner"
+	case status.GetMemberId() == status.GetLeader():
+		return "leader"
+	default:
+		return "follower"
+	}
+}
+
+// ServiceHealth returns the health of the service by ID: healthy, unhealthy or unknown.
+//
+// If the service is not found, an empty string is returned.
+func (node *Node) ServiceHealth(id string) string {
+	services := node.ServiceList.GetServices()
+
+	idx := slices.IndexFunc(services, func(svc *machine.ServiceInfo) bool {
+		return svc.GetId() == id
+	})
+	if idx == -1 {
+		return ""
+	}
+
+	health := services[idx].GetHealth()
+
+	switch {
+	case health.GetUnknown():
+		return "unknown"
+	case health.GetHealthy():
+		return "healthy"
+	default:
+		return "unhealthy"
+	}
+}
+
 // CtxSwitches returns diff of context switches.
 func (node *Node) CtxSwitches() uint64 {
 	if node.SystemStatDiff == nil {
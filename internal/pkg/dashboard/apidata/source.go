@@ -8,16 +8,25 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/internal/pkg/dashboard/utils"
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 	"github.com/siderolabs/talos/pkg/machinery/client/multiplex"
+	machinetype "github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 )
 
 // Source is a data source that gathers information about a Talos node using Talos API.
@@ -32,6 +41,16 @@ type Source struct {
 	ctxCancel context.CancelFunc
 
 	wg sync.WaitGroup
+
+	// clusterOverview is set when the cluster overview screen is active, the data shown only there is gathered only in that case.
+	clusterOverview atomic.Bool
+	// controlPlane caches whether the node is a control plane node, machine type doesn't change once it is set.
+	controlPlane map[string]bool
+}
+
+// SetClusterOverview enables or disables gathering the data for the cluster overview screen.
+func (source *Source) SetClusterOverview(active bool) {
+	source.clusterOverview.Store(active)
 }
 
 // Run the data poll on interval.
@@ -109,13 +128,29 @@ func runGather[t helpers.Message](
 	resultLock *sync.Mutex,
 	gatherFunc func(context.Context) (protoMsg[t], error),
 	setter func(node *Node, value t),
+) error {
+	return runGatherNodes(source, source.Nodes, nodes, resultLock, gatherFunc, setter)
+}
+
+// runGatherNodes gathers the data only from the specified subset of the nodes.
+func runGatherNodes[t helpers.Message](
+	source *Source,
+	nodeNames []string,
+	nodes map[string]*Node,
+	resultLock *sync.Mutex,
+	gatherFunc func(context.Context) (protoMsg[t], error),
+	setter func(node *Node, value t),
 ) error {
 	var (
 		resp protoMsg[t]
 		err  error
 	)
 
-	if len(source.Nodes) == 1 && source.Nodes[0] == "" {
+	if len(nodeNames) == 0 {
+		return nil
+	}
+
+	if len(nodeNames) == 1 && nodeNames[0] == "" {
 		// local gather case, no need to multiplex by node
 		resp, err = gatherFunc(source.ctx)
 		if err != nil {
@@ -129,7 +164,7 @@ func runGather[t helpers.Message](
 		return nil
 	}
 
-	respCh := multiplex.Unary(source.ctx, source.Nodes, func(ctx context.Context) (protoMsg[t], error) {
+	respCh := multiplex.Unary(source.ctx, nodeNames, func(ctx context.Context) (protoMsg[t], error) {
 		return gatherFunc(ctx)
 	})
 
@@ -150,7 +185,48 @@ func runGather[t helpers.Message](
 	return errs
 }
 
-//nolint:gocyclo
+// runResourceGather fetches the resources from each node one by one, as the resource API doesn't support multiplexing.
+func runResourceGather[T any](
+	source *Source,
+	nodes map[string]*Node,
+	resultLock *sync.Mutex,
+	gatherFunc func(context.Context, state.State) (T, error),
+	setter func(node *Node, value T),
+) error {
+	var (
+		eg   errgroup.Group
+		errs error
+	)
+
+	for _, nodeName := range source.Nodes {
+		eg.Go(func() error {
+			value, err := gatherFunc(utils.NodeContext(source.ctx, nodeName), source.COSI)
+
+			resultLock.Lock()
+			defer resultLock.Unlock()
+
+			if err != nil {
+				errs = errors.Join(errs, fmt.Errorf("error gathering resources from node %q: %w", nodeName, err))
+
+				return nil
+			}
+
+			if _, ok := nodes[nodeName]; !ok {
+				nodes[nodeName] = &Node{}
+			}
+
+			setter(nodes[nodeName], value)
+
+			return nil
+		})
+	}
+
+	eg.Wait() //nolint:errcheck
+
+	return errs
+}
+
+//nolint:gocyclo,maintidx
 func (source *Source) gather() *Data {
 	result := &Data{
 		Timestamp: time.Now(),
@@ -261,6 +337,10 @@ func (source *Source) gather() *Data {
 		},
 	}
 
+	if source.clusterOverview.Load() {
+		gatherFuncs = append(gatherFuncs, source.clusterOverviewGatherFuncs(result, &resultLock)...)
+	}
+
 	var eg errgroup.Group
 
 	for _, f := range gatherFuncs {
@@ -274,3 +354,130 @@ func (source *Source) gather() *Data {
 
 	return result
 }
+
+// clusterOverviewGatherFuncs returns the gather functions for the data shown only on the cluster overview screen.
+//
+// The resource API is not multiplexed, so the resources are fetched node by node.
+func (source *Source) clusterOverviewGatherFuncs(result *Data, resultLock *sync.Mutex) []func() error {
+	return []func() error{
+		func() error {
+			return runGather(
+				source, result.Nodes, resultLock,
+				func(ctx context.Context) (protoMsg[*machine.Version], error) {
+					return source.MachineClient.Version(ctx, &emptypb.Empty{})
+				},
+				func(node *Node, value *machine.Version) {
+					node.Version = value
+				},
+			)
+		},
+		func() error {
+			// etcd status is only available on the control plane nodes
+			return runGatherNodes(
+				source, source.controlPlaneNodes(), result.Nodes, resultLock,
+				func(ctx context.Context) (protoMsg[*machine.EtcdStatus], error) {
+					return source.MachineClient.EtcdStatus(ctx, &emptypb.Empty{})
+				},
+				func(node *Node, value *machine.EtcdStatus) {
+					node.EtcdStatus = value
+				},
+			)
+		},
+		func() error {
+			return runResourceGather(
+				source, result.Nodes, resultLock,
+				func(ctx context.Context, st state.State) (*runtime.MachineStatus, error) {
+					return safe.StateGetByID[*runtime.MachineStatus](ctx, st, runtime.MachineStatusID)
+				},
+				func(node *Node, value *runtime.MachineStatus) {
+					node.MachineStatus = value
+				},
+			)
+		},
+		func() error {
+			return runResourceGather(
+				source, result.Nodes, resultLock,
+				func(ctx context.Context, st state.State) (*k8s.KubeletStatus, error) {
+					return safe.StateGetByID[*k8s.KubeletStatus](ctx, st, k8s.KubeletID)
+				},
+				func(node *Node, value *k8s.KubeletStatus) {
+					node.KubeletStatus = value
+				},
+			)
+		},
+		func() error {
+			return runResourceGather(
+				source, result.Nodes, resultLock,
+				func(ctx context.Context, st state.State) (*k8s.NodeStatus, error) {
+					// the node status is reported only for the local Kubernetes node
+					list, err := safe.StateListAll[*k8s.NodeStatus](ctx, st)
+					if err != nil || list.Len() == 0 {
+						return nil, err
+					}
+
+					return list.Get(0), nil
+				},
+				func(node *Node, value *k8s.NodeStatus) {
+					node.NodeStatus = value
+				},
+			)
+		},
+		func() error {
+			return runResourceGather(
+				source, result.Nodes, resultLock,
+				func(ctx context.Context, st state.State) ([]*runtime.Diagnostic, error) {
+					list, err := safe.StateListAll[*runtime.Diagnostic](ctx, st)
+					if err != nil {
+						return nil, err
+					}
+
+					return slices.Collect(list.All()), nil
+				},
+				func(node *Node, value []*runtime.Diagnostic) {
+					node.Diagnostics = value
+				},
+			)
+		},
+	}
+}
+
+// controlPlaneNodes returns the nodes which are known to be control plane nodes.
+//
+// The machine type is fetched for the nodes which are not in the cache yet, the nodes with the machine type
+// which is not known yet are skipped.
+func (source *Source) controlPlaneNodes() []string {
+	if source.controlPlane == nil {
+		source.controlPlane = map[string]bool{}
+	}
+
+	var (
+		eg   errgroup.Group
+		lock sync.Mutex
+	)
+
+	for _, nodeName := range source.Nodes {
+		if _, ok := source.controlPlane[nodeName]; ok {
+			continue
+		}
+
+		eg.Go(func() error {
+			machineType, err := safe.StateGetByID[*config.MachineType](utils.NodeContext(source.ctx, nodeName), source.COSI, config.MachineTypeID)
+			if err != nil || machineType.MachineType() == machinetype.TypeUnknown {
+				return nil
+			}
+
+			lock.Lock()
+			defer lock.Unlock()
+
+			source.controlPlane[nodeName] = machineType.MachineType().IsControlPlane()
+
+			return nil
+		})
+	}
+
+	eg.Wait() //nolint:errcheck
+
+	return slices.DeleteFunc(slices.Clone(source.Nodes), func(nodeName string) bool {
+		return !source.controlPlane[nodeName]
+	})
+}
@@ -0,0 +1,138 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dashboard
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/siderolabs/talos/internal/pkg/dashboard/apidata"
+	"github.com/siderolabs/talos/internal/pkg/dashboard/components"
+)
+
+// ClusterOverviewGrid represents the screen with the overview of all nodes.
+type ClusterOverviewGrid struct {
+	tview.Grid
+
+	app       *tview.Application
+	dashboard *Dashboard
+
+	table        *components.ClusterOverview
+	filterInput  *tview.InputField
+	filterActive bool
+	active       bool
+}
+
+// NewClusterOverviewGrid initializes ClusterOverviewGrid.
+func NewClusterOverviewGrid(dashboard *Dashboard) *ClusterOverviewGrid {
+	widget := &ClusterOverviewGrid{
+		Grid:      *tview.NewGrid(),
+		app:       dashboard.app,
+		dashboard: dashboard,
+		table:     components.NewClusterOverview(dashboard.nodes),
+	}
+
+	widget.table.SetBorder(true).
+		SetTitle(" Cluster (Enter: node details, s: sort column, r: reverse order, /: filter) ")
+	widget.table.SetSelectedFunc(func(int, int) {
+		widget.drillDown()
+	})
+	widget.table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Rune() {
+		case 's':
+			widget.table.CycleSortColumn()
+
+			return nil
+		case 'r':
+			widget.table.ToggleSortOrder()
+
+			return nil
+		case '/':
+			widget.activateFilter()
+
+			return nil
+		}
+
+		return event
+	})
+
+	widget.filterInput = tview.NewInputField()
+	widget.filterInput.SetLabel("filter: ")
+	widget.filterInput.SetLabelColor(tcell.ColorYellow)
+	widget.filterInput.SetFieldBackgroundColor(tcell.ColorDefault)
+	widget.filterInput.SetChangedFunc(func(text string) {
+		widget.table.SetFilter(text)
+	})
+	widget.filterInput.SetDoneFunc(func(key tcell.Key) {
+		// Esc clears the filter; Enter keeps the filtered view
+		widget.deactivateFilter(key == tcell.KeyEscape)
+	})
+
+	widget.SetRows(0).SetColumns(0)
+	widget.AddItem(widget.table, 0, 0, 1, 1, 0, 0, true)
+
+	return widget
+}
+
+// OnAPIDataChange implements the APIDataListener interface.
+func (widget *ClusterOverviewGrid) OnAPIDataChange(node string, data *apidata.Data) {
+	widget.table.OnAPIDataChange(node, data)
+}
+
+// onScreenSelect implements the screenSelectListener interface.
+func (widget *ClusterOverviewGrid) onScreenSelect(active bool) {
+	widget.active = active
+
+	// the data for the overview is only gathered while the screen is shown
+	widget.dashboard.apiDataSource.SetClusterOverview(active)
+
+	if active {
+		widget.app.SetFocus(widget.table)
+	} else {
+		widget.deactivateFilter(false)
+	}
+}
+
+// drillDown switches to the per-node screens for the node in the selected row.
+func (widget *ClusterOverviewGrid) drillDown() {
+	node, ok := widget.table.SelectedNode()
+	if !ok {
+		return
+	}
+
+	widget.dashboard.selectNode(node)
+	widget.dashboard.selectScreen(ScreenSummary)
+}
+
+// activateFilter shows the filter input below the table.
+func (widget *ClusterOverviewGrid) activateFilter() {
+	if widget.filterActive {
+		return
+	}
+
+	widget.filterActive = true
+	widget.SetRows(0, 1)
+	widget.AddItem(widget.filterInput, 1, 0, 1, 1, 0, 0, true)
+	widget.app.SetFocus(widget.filterInput)
+}
+
+// deactivateFilter hides the filter input. If clearText is true, the filter is also cleared.
+func (widget *ClusterOverviewGrid) deactivateFilter(clearText bool) {
+	if clearText {
+		widget.filterInput.SetText("")
+	}
+
+	if !widget.filterActive {
+		return
+	}
+
+	widget.filterActive = false
+	widget.RemoveItem(widget.filterInput)
+	widget.SetRows(0)
+
+	if widget.active {
+		widget.app.SetFocus(widget.table)
+	}
+}
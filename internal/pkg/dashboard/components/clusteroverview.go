@@ -0,0 +1,367 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package components
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/siderolabs/talos/internal/pkg/dashboard/apidata"
+)
+
+// Thresholds for the resource pressure coloring.
+const (
+	pressureWarning  = 0.7
+	pressureCritical = 0.9
+)
+
+// ClusterOverviewRow is a single node row in the cluster overview table.
+type ClusterOverviewRow struct {
+	Node              string
+	Stage             string
+	Ready             string
+	EtcdRole          string
+	Kubelet           string
+	TalosVersion      string
+	KubernetesVersion string
+
+	CPU    float64
+	Memory float64
+	// Pressure lists the memory and disk pressure conditions reported by the kubelet.
+	Pressure string
+
+	Diagnostics int
+}
+
+func newClusterOverviewRow(node string, data *apidata.Node) ClusterOverviewRow {
+	row := ClusterOverviewRow{
+		Node:              node,
+		Stage:             notAvailable,
+		Ready:             notAvailable,
+		EtcdRole:          notAvailable,
+		Kubelet:           notAvailable,
+		TalosVersion:      notAvailable,
+		KubernetesVersion: notAvailable,
+		Pressure:          notAvailable,
+	}
+
+	if data == nil {
+		return row
+	}
+
+	if data.MachineStatus != nil {
+		row.Stage = data.MachineStatus.TypedSpec().Stage.String()
+		row.Ready = strconv.FormatBool(data.MachineStatus.TypedSpec().Status.Ready)
+	}
+
+	row.EtcdRole = valueOrNotAvailable(data.EtcdRole())
+	row.Kubelet = valueOrNotAvailable(data.ServiceHealth("kubelet"))
+	row.TalosVersion = valueOrNotAvailable(data.TalosVersion())
+	row.KubernetesVersion = valueOrNotAvailable(data.KubernetesVersion())
+
+	row.CPU = data.CPUUsageByName("usage")
+	row.Memory = data.MemUsage()
+	row.Pressure = valueOrNotAvailable(data.Pressure())
+
+	row.Diagnostics = len(data.Diagnostics)
+
+	return row
+}
+
+func (row ClusterOverviewRow) matches(filter string) bool {
+	for _, value := range []string{row.Node, row.Stage, row.EtcdRole, row.Kubelet, row.TalosVersion, row.KubernetesVersion} {
+		if strings.Contains(strings.ToLower(value), filter) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// clusterOverviewColumn describes a column of the cluster overview table.
+type clusterOverviewColumn struct {
+	name    string
+	align   int
+	render  func(row ClusterOverviewRow) string
+	compare func(a, b ClusterOverviewRow) int
+}
+
+var clusterOverviewColumns = []clusterOverviewColumn{
+	{
+		name:  "NODE",
+		align: tview.AlignLeft,
+		render: func(row ClusterOverviewRow) string {
+			if row.Node == "" {
+				return "(local)"
+			}
+
+			return row.Node
+		},
+		compare: func(a, b ClusterOverviewRow) int { return cmp.Compare(a.Node, b.Node) },
+	},
+	{
+		name:    "STAGE",
+		align:   tview.AlignLeft,
+		render:  func(row ClusterOverviewRow) string { return row.Stage },
+		compare: func(a, b ClusterOverviewRow) int { return cmp.Compare(a.Stage, b.Stage) },
+	},
+	{
+		name:    "READY",
+		align:   tview.AlignLeft,
+		render:  func(row ClusterOverviewRow) string { return formatStatus(row.Ready) },
+		compare: func(a, b ClusterOverviewRow) int { return cmp.Compare(a.Ready, b.Ready) },
+	},
+	{
+		name:    "CPU",
+		align:   tview.AlignRight,
+		render:  func(row ClusterOverviewRow) string { return formatPressure(row.CPU) },
+		compare: func(a, b ClusterOverviewRow) int { return cmp.Compare(a.CPU, b.CPU) },
+	},
+	{
+		name:    "MEM",
+		align:   tview.AlignRight,
+		render:  func(row ClusterOverviewRow) string { return formatPressure(row.Memory) },
+		compare: func(a, b ClusterOverviewRow) int { return cmp.Compare(a.Memory, b.Memory) },
+	},
+	{
+		name:  "PRESSURE",
+		align: tview.AlignLeft,
+		render: func(row ClusterOverviewRow) string {
+			if row.Pressure == "none" || row.Pressure == notAvailable {
+				return row.Pressure
+			}
+
+			return fmt.Sprintf("[red]%s[-]", row.Pressure)
+		},
+		compare: func(a, b ClusterOverviewRow) int { return cmp.Compare(a.Pressure, b.Pressure) },
+	},
+	{
+		name:    "ETCD",
+		align:   tview.AlignLeft,
+		render:  func(row ClusterOverviewRow) string { return row.EtcdRole },
+		compare: func(a, b ClusterOverviewRow) int { return cmp.Compare(a.EtcdRole, b.EtcdRole) },
+	},
+	{
+		name:    "KUBELET",
+		align:   tview.AlignLeft,
+		render:  func(row ClusterOverviewRow) string { return formatStatus(row.Kubelet) },
+		compare: func(a, b ClusterOverviewRow) int { return cmp.Compare(a.Kubelet, b.Kubelet) },
+	},
+	{
+		name:    "TALOS",
+		align:   tview.AlignLeft,
+		render:  func(row ClusterOverviewRow) string { return row.TalosVersion },
+		compare: func(a, b ClusterOverviewRow) int { return cmp.Compare(a.TalosVersion, b.TalosVersion) },
+	},
+	{
+		name:    "KUBERNETES",
+		align:   tview.AlignLeft,
+		render:  func(row ClusterOverviewRow) string { return row.KubernetesVersion },
+		compare: func(a, b ClusterOverviewRow) int { return cmp.Compare(a.KubernetesVersion, b.KubernetesVersion) },
+	},
+	{
+		name:  "DIAG",
+		align: tview.AlignRight,
+		render: func(row ClusterOverviewRow) string {
+			if row.Diagnostics == 0 {
+				return "0"
+			}
+
+			return fmt.Sprintf("[yellow]%d[-]", row.Diagnostics)
+		},
+		compare: func(a, b ClusterOverviewRow) int { return cmp.Compare(a.Diagnostics, b.Diagnostics) },
+	},
+}
+
+// ClusterOverview represents the table with a row per node of the cluster.
+type ClusterOverview struct {
+	*tview.Table
+
+	nodes []string
+	rows  []ClusterOverviewRow
+
+	sortColumn int
+	sortDesc   bool
+	filter     string
+}
+
+// NewClusterOverview initializes ClusterOverview.
+func NewClusterOverview(nodes []string) *ClusterOverview {
+	widget := &ClusterOverview{
+		Table: tview.NewTable(),
+		nodes: nodes,
+	}
+
+	widget.SetFixed(1, 0)
+	widget.SetBorders(false)
+	widget.SetSelectable(true, false)
+	widget.SetBorderPadding(0, 0, 1, 1)
+	widget.SetSelectedStyle(tcell.StyleDefault.Attributes(tcell.AttrReverse))
+
+	widget.redraw()
+
+	return widget
+}
+
+// OnAPIDataChange implements the APIDataListener interface.
+//
+// The overview shows all nodes at once, so the selected node is ignored.
+func (widget *ClusterOverview) OnAPIDataChange(_ string, data *apidata.Data) {
+	widget.rows = make([]ClusterOverviewRow, 0, len(widget.nodes))
+
+	for _, node := range widget.nodes {
+		widget.rows = append(widget.rows, newClusterOverviewRow(node, data.Nodes[node]))
+	}
+
+	widget.redraw()
+}
+
+// CycleSortColumn switches sorting to the next column.
+func (widget *ClusterOverview) CycleSortColumn() {
+	widget.sortColumn = (widget.sortColumn + 1) % len(clusterOverviewColumns)
+
+	widget.redraw()
+}
+
+// ToggleSortOrder switches between the ascending and descending sort order.
+func (widget *ClusterOverview) ToggleSortOrder() {
+	widget.sortDesc = !widget.sortDesc
+
+	widget.redraw()
+}
+
+// SetFilter shows only the rows containing the filter text (case-insensitive).
+func (widget *ClusterOverview) SetFilter(filter string) {
+	widget.filter = strings.ToLower(filter)
+
+	widget.redraw()
+}
+
+// Rows returns the rows in the order they are displayed, with the filter applied.
+func (widget *ClusterOverview) Rows() []ClusterOverviewRow {
+	rows := make([]ClusterOverviewRow, 0, len(widget.rows))
+
+	for _, row := range widget.rows {
+		if widget.filter == "" || row.matches(widget.filter) {
+			rows = append(rows, row)
+		}
+	}
+
+	compare := clusterOverviewColumns[widget.sortColumn].compare
+
+	slices.SortStableFunc(rows, func(a, b ClusterOverviewRow) int {
+		result := compare(a, b)
+
+		if widget.sortDesc {
+			result = -result
+		}
+
+		if result == 0 {
+			result = cmp.Compare(a.Node, b.Node)
+		}
+
+		return result
+	})
+
+	return rows
+}
+
+// SelectedNode returns the node of the currently selected row.
+func (widget *ClusterOverview) SelectedNode() (string, bool) {
+	row, _ := widget.GetSelection()
+
+	cell := widget.GetCell(row, 0)
+	if cell == nil || cell.Reference == nil {
+		return "", false
+	}
+
+	node, ok := cell.Reference.(string)
+
+	return node, ok
+}
+
+func (widget *ClusterOverview) redraw() {
+	selectedNode, _ := widget.SelectedNode()
+
+	widget.Clear()
+
+	for col, column := range clusterOverviewColumns {
+		name := column.name
+
+		if col == widget.sortColumn {
+			if widget.sortDesc {
+				name += "▼"
+			} else {
+				name += "▲"
+			}
+		}
+
+		widget.SetCell(0, col, &tview.TableCell{
+			Text:          "[::b]" + name,
+			Align:         column.align,
+			NotSelectable: true,
+			Expansion:     1,
+		})
+	}
+
+	rows := widget.Rows()
+
+	if len(rows) == 0 {
+		widget.SetCell(1, 0, &tview.TableCell{Text: noData, NotSelectable: true})
+
+		return
+	}
+
+	selectedRow := 1
+
+	for i, row := range rows {
+		for col, column := range clusterOverviewColumns {
+			cell := &tview.TableCell{
+				Text:      column.render(row),
+				Align:     column.align,
+				Expansion: 1,
+			}
+
+			if col == 0 {
+				cell.Reference = row.Node
+			}
+
+			widget.SetCell(i+1, col, cell)
+		}
+
+		if row.Node == selectedNode {
+			selectedRow = i + 1
+		}
+	}
+
+	widget.Select(selectedRow, 0)
+}
+
+func formatPressure(usage float64) string {
+	text := fmt.Sprintf("%.1f%%", usage*100.0)
+
+	switch {
+	case usage >= pressureCritical:
+		return fmt.Sprintf("[red]%s[-]", text)
+	case usage >= pressureWarning:
+		return fmt.Sprintf("[yellow]%s[-]", text)
+	default:
+		return text
+	}
+}
+
+func valueOrNotAvailable(value string) string {
+	if value == "" {
+		return notAvailable
+	}
+
+	return value
+}
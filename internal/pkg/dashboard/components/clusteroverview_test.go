@@ -0,0 +1,103 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package components_test
+
+import (
+	"testing"
+
+	"github.com/siderolabs/gen/xslices"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/siderolabs/talos/internal/pkg/dashboard/apidata"
+	"github.com/siderolabs/talos/internal/pkg/dashboard/components"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+func TestClusterOverview(t *testing.T) {
+	t.Parallel()
+
+	running := runtime.NewMachineStatus()
+	running.TypedSpec().Stage = runtime.MachineStageRunning
+	running.TypedSpec().Status.Ready = true
+
+	booting := runtime.NewMachineStatus()
+	booting.TypedSpec().Stage = runtime.MachineStageBooting
+
+	nodeStatus := k8s.NewNodeStatus(k8s.NamespaceName, "worker-1")
+	nodeStatus.TypedSpec().DiskPressure = true
+
+	data := &apidata.Data{
+		Nodes: map[string]*apidata.Node{
+			"10.5.0.2": {
+				MachineStatus: running,
+				Version:       &machine.Version{Version: &machine.VersionInfo{Tag: "v1.15.0"}},
+				EtcdStatus:    &machine.EtcdStatus{MemberStatus: &machine.EtcdMemberStatus{MemberId: 1, Leader: 1}},
+				Memory:        &machine.Memory{Meminfo: &machine.MemInfo{Memtotal: 100, Memfree: 80}},
+			},
+			"10.5.0.3": {
+				MachineStatus: booting,
+				Version:       &machine.Version{Version: &machine.VersionInfo{Tag: "v1.14.2"}},
+				Memory:        &machine.Memory{Meminfo: &machine.MemInfo{Memtotal: 100, Memfree: 10}},
+				ServiceList: &machine.ServiceList{
+					Services: []*machine.ServiceInfo{{Id: "kubelet", Health: &machine.ServiceHealth{Healthy: true}}},
+				},
+				NodeStatus:  nodeStatus,
+				Diagnostics: []*runtime.Diagnostic{runtime.NewDiagnostic(runtime.NamespaceName, "address-overlap")},
+			},
+		},
+	}
+
+	widget := components.NewClusterOverview([]string{"10.5.0.2", "10.5.0.3", "10.5.0.4"})
+	widget.OnAPIDataChange("10.5.0.2", data)
+
+	nodes := func() []string {
+		return xslices.Map(widget.Rows(), func(row components.ClusterOverviewRow) string { return row.Node })
+	}
+
+	rows := widget.Rows()
+	assert.Equal(t, []string{"10.5.0.2", "10.5.0.3", "10.5.0.4"}, nodes())
+
+	assert.Equal(t, components.ClusterOverviewRow{
+		Node:              "10.5.0.2",
+		Stage:             "running",
+		Ready:             "true",
+		EtcdRole:          "leader",
+		Kubelet:           "n/a",
+		TalosVersion:      "v1.15.0",
+		KubernetesVersion: "n/a",
+		Memory:            0.2,
+		Pressure:          "n/a",
+	}, rows[0])
+
+	assert.Equal(t, "n/a", rows[1].EtcdRole)
+	assert.Equal(t, "healthy", rows[1].Kubelet)
+	assert.Equal(t, 1, rows[1].Diagnostics)
+	assert.Equal(t, "disk", rows[1].Pressure)
+	assert.Equal(t, "n/a", rows[2].Stage)
+
+	// sort by stage
+	widget.CycleSortColumn()
+	assert.Equal(t, []string{"10.5.0.3", "10.5.0.4", "10.5.0.2"}, nodes())
+
+	widget.ToggleSortOrder()
+	assert.Equal(t, []string{"10.5.0.2", "10.5.0.4", "10.5.0.3"}, nodes())
+
+	widget.SetFilter("V1.14")
+	assert.Equal(t, []string{"10.5.0.3"}, nodes())
+
+	node, ok := widget.SelectedNode()
+	assert.True(t, ok)
+	assert.Equal(t, "10.5.0.3", node)
+
+	widget.SetFilter("")
+	assert.Len(t, widget.Rows(), 3)
+
+	// the selection follows the node
+	node, ok = widget.SelectedNode()
+	assert.True(t, ok)
+	assert.Equal(t, "10.5.0.3", node)
+}
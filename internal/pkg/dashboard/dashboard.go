@@ -52,6 +52,9 @@ const (
 
 	// ScreenResourceExplorer is the resource explorer screen.
 	ScreenResourceExplorer Screen = "Resources"
+
+	// ScreenClusterOverview is the cluster overview screen.
+	ScreenClusterOverview Screen = "Cluster"
 )
 
 // APIDataListener is a listener which is notified when API-sourced data is updated.
@@ -180,13 +183,7 @@ func buildDashboard(ctx context.Context, cli *client.Client, opts ...Option) (*D
 			return
 		}
 
-		for i, n := range dashboard.nodes {
-			if n == node {
-				dashboard.selectNodeByIndex(i)
-
-				break
-			}
-		}
+		dashboard.selectNode(node)
 	}
 
 	dashboard.footer.ScreenClick = func(screenName string) {
@@ -304,6 +301,8 @@ func (d *Dashboard) initScreenConfigs(ctx context.Context, screens []Screen) err
 			return NewConfigURLGrid(ctx, d)
 		case ScreenResourceExplorer:
 			return NewResourceExplorerGrid(ctx, d)
+		case ScreenClusterOverview:
+			return NewClusterOverviewGrid(d)
 		default:
 			return nil
 		}
@@ -325,7 +324,7 @@ func (d *Dashboard) initScreenConfigs(ctx context.Context, screens []Screen) err
 			allowNodeNavigation: true,
 		}
 
-		if screen == ScreenNetworkConfig || screen == ScreenConfigURL {
+		if screen == ScreenNetworkConfig || screen == ScreenConfigURL || screen == ScreenClusterOverview {
 			config.allowNodeNavigation = false
 		}
 
@@ -485,6 +484,12 @@ func (d *Dashboard) selectNodeByIndex(index int) {
 	}
 }
 
+func (d *Dashboard) selectNode(node string) {
+	if index := slices.Index(d.nodes, node); index != -1 {
+		d.selectNodeByIndex(index)
+	}
+}
+
 // processAPIData re-renders the components with new API-sourced data.
 func (d *Dashboard) processAPIData() {
 	if d.data == nil {
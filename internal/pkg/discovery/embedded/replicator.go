@@ -0,0 +1,215 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package embedded
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/siderolabs/discovery-api/api/v1alpha1/server/pb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	replicationQueueSize = 256
+	replicationTimeout   = 10 * time.Second
+)
+
+// Replicator replicates the discovery service updates to the other control plane nodes.
+//
+// Each peer receives the full state when the replication starts (or restarts after a failure),
+// and the updates received from the clients afterwards. The deletes which happened while the peer
+// was unreachable are not replicated, the deleted affiliates expire on the peer.
+type Replicator struct {
+	state     *State
+	logger    *zap.Logger
+	tlsConfig func() *tls.Config
+	peers     map[string]*replicationPeer
+	mu        sync.Mutex
+}
+
+type replicationPeer struct {
+	queue  chan Update
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	resync atomic.Bool
+}
+
+// NewReplicator initializes the replicator.
+//
+// The TLS config should present the client certificate issued by the Talos OS CA, so that the peers accept the replicated updates.
+func NewReplicator(state *State, logger *zap.Logger, tlsConfig func() *tls.Config) *Replicator {
+	return &Replicator{
+		state:     state,
+		logger:    logger,
+		tlsConfig: tlsConfig,
+		peers:     map[string]*replicationPeer{},
+	}
+}
+
+// SetPeers updates the set of the peers (as host:port endpoints).
+func (replicator *Replicator) SetPeers(ctx context.Context, endpoints []string) error {
+	replicator.mu.Lock()
+	defer replicator.mu.Unlock()
+
+	shouldRun := make(map[string]struct{}, len(endpoints))
+
+	for _, endpoint := range endpoints {
+		shouldRun[endpoint] = struct{}{}
+	}
+
+	for endpoint, peer := range replicator.peers {
+		if _, ok := shouldRun[endpoint]; !ok {
+			replicator.logger.Info("stopping discovery replication", zap.String("peer", endpoint))
+
+			peer.stop()
+			delete(replicator.peers, endpoint)
+		}
+	}
+
+	for endpoint := range shouldRun {
+		if _, ok := replicator.peers[endpoint]; ok {
+			continue
+		}
+
+		conn, err := grpc.NewClient(endpoint,
+			grpc.WithTransportCredentials(credentials.NewTLS(replicator.tlsConfig())),
+		)
+		if err != nil {
+			return fmt.Errorf("error creating replication client for %q: %w", endpoint, err)
+		}
+
+		peer := &replicationPeer{
+			queue: make(chan Update, replicationQueueSize),
+		}
+
+		peer.resync.Store(true)
+
+		peerCtx, cancel := context.WithCancel(ctx)
+		peer.cancel = cancel
+
+		replicator.logger.Info("starting discovery replication", zap.String("peer", endpoint))
+
+		peer.wg.Add(1)
+
+		go func() {
+			defer peer.wg.Done()
+			defer conn.Close() //nolint:errcheck
+
+			replicator.run(peerCtx, peer, pb.NewClusterClient(conn), replicator.logger.With(zap.String("peer", endpoint)))
+		}()
+
+		replicator.peers[endpoint] = peer
+	}
+
+	return nil
+}
+
+// Enqueue schedules the update to be replicated to the peers.
+func (replicator *Replicator) Enqueue(update Update) {
+	replicator.mu.Lock()
+	defer replicator.mu.Unlock()
+
+	for _, peer := range replicator.peers {
+		select {
+		case peer.queue <- update:
+		default:
+			// the peer can't keep up, send the full state instead
+			peer.resync.Store(true)
+		}
+	}
+}
+
+// Stop stops the replication to all peers.
+func (replicator *Replicator) Stop() {
+	replicator.mu.Lock()
+	defer replicator.mu.Unlock()
+
+	for endpoint, peer := range replicator.peers {
+		peer.stop()
+		delete(replicator.peers, endpoint)
+	}
+}
+
+func (replicator *Replicator) run(ctx context.Context, peer *replicationPeer, client pb.ClusterClient, logger *zap.Logger) {
+	ctx = metadata.AppendToOutgoingContext(ctx, ReplicaMetadataKey, "true")
+
+	backoff := backoff.NewExponentialBackOff()
+
+	// disable number of retries limit
+	backoff.MaxElapsedTime = 0
+
+	for ctx.Err() == nil {
+		err := replicator.replicate(ctx, peer, client)
+		if err == nil {
+			return
+		}
+
+		peer.resync.Store(true)
+
+		interval := backoff.NextBackOff()
+
+		logger.Debug("discovery replication failed", zap.Error(err), zap.Duration("interval", interval))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+func (replicator *Replicator) replicate(ctx context.Context, peer *replicationPeer, client pb.ClusterClient) error {
+	for {
+		if peer.resync.Swap(false) {
+			for _, update := range replicator.state.Export() {
+				if err := send(ctx, client, update); err != nil {
+					return err
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case update := <-peer.queue:
+			if err := send(ctx, client, update); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func send(ctx context.Context, client pb.ClusterClient, update Update) error {
+	ctx, cancel := context.WithTimeout(ctx, replicationTimeout)
+	defer cancel()
+
+	if update.Deleted {
+		_, err := client.AffiliateDelete(ctx, &pb.AffiliateDeleteRequest{
+			ClusterId:   update.ClusterID,
+			AffiliateId: update.AffiliateID,
+		})
+
+		return err
+	}
+
+	_, err := client.AffiliateUpdate(ctx, pbUpdateRequest(update))
+
+	return err
+}
+
+func (peer *replicationPeer) stop() {
+	peer.cancel()
+
+	peer.wg.Wait()
+}
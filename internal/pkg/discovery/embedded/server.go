@@ -0,0 +1,250 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package embedded
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"sync/atomic"
+
+	"github.com/siderolabs/discovery-api/api/v1alpha1/server/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// ReplicaMetadataKey marks the requests replicated from the other control plane nodes,
+// so that they are not replicated again.
+//
+// The key is only honored if the peer presents a client certificate issued by the Talos OS CA.
+const ReplicaMetadataKey = "x-talos-discovery-replica"
+
+// Server implements the discovery service API.
+type Server struct {
+	pb.UnimplementedClusterServer
+
+	state      *State
+	replicator *Replicator
+	peerRoots  func() *x509.CertPool
+	clusterID  atomic.Pointer[string]
+}
+
+// NewServer initializes the discovery service API server.
+//
+// The updates received from the clients are replicated with the replicator, if set.
+// The peerRoots are used to verify the client certificates of the replicas.
+func NewServer(state *State, replicator *Replicator, peerRoots func() *x509.CertPool) *Server {
+	return &Server{
+		state:      state,
+		replicator: replicator,
+		peerRoots:  peerRoots,
+	}
+}
+
+// SetClusterID sets the ID of the local cluster.
+//
+// The embedded discovery service only serves the local cluster, the requests for any other cluster ID are rejected.
+func (srv *Server) SetClusterID(clusterID string) {
+	srv.clusterID.Store(&clusterID)
+}
+
+func (srv *Server) checkClusterID(clusterID string) error {
+	if clusterID == "" {
+		return status.Error(codes.InvalidArgument, "cluster ID is required")
+	}
+
+	if local := srv.clusterID.Load(); local == nil || *local != clusterID {
+		return status.Error(codes.PermissionDenied, "cluster ID doesn't match the local cluster")
+	}
+
+	return nil
+}
+
+// Hello implements pb.ClusterServer interface.
+func (srv *Server) Hello(_ context.Context, req *pb.HelloRequest) (*pb.HelloResponse, error) {
+	if err := srv.checkClusterID(req.GetClusterId()); err != nil {
+		return nil, err
+	}
+
+	return &pb.HelloResponse{}, nil
+}
+
+// AffiliateUpdate implements pb.ClusterServer interface.
+func (srv *Server) AffiliateUpdate(ctx context.Context, req *pb.AffiliateUpdateRequest) (*pb.AffiliateUpdateResponse, error) {
+	if err := srv.checkClusterID(req.GetClusterId()); err != nil {
+		return nil, err
+	}
+
+	update := Update{
+		ClusterID:   req.GetClusterId(),
+		AffiliateID: req.GetAffiliateId(),
+		Data:        req.GetAffiliateData(),
+		Endpoints:   req.GetAffiliateEndpoints(),
+		TTL:         req.GetTtl().AsDuration(),
+	}
+
+	if err := srv.state.Update(update); err != nil {
+		if errors.Is(err, ErrLimitExceeded) {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	srv.replicate(ctx, update)
+
+	return &pb.AffiliateUpdateResponse{}, nil
+}
+
+// AffiliateDelete implements pb.ClusterServer interface.
+func (srv *Server) AffiliateDelete(ctx context.Context, req *pb.AffiliateDeleteRequest) (*pb.AffiliateDeleteResponse, error) {
+	if err := srv.checkClusterID(req.GetClusterId()); err != nil {
+		return nil, err
+	}
+
+	if req.GetAffiliateId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "affiliate ID is required")
+	}
+
+	srv.state.Delete(req.GetClusterId(), req.GetAffiliateId())
+
+	srv.replicate(ctx, Update{
+		ClusterID:   req.GetClusterId(),
+		AffiliateID: req.GetAffiliateId(),
+		Deleted:     true,
+	})
+
+	return &pb.AffiliateDeleteResponse{}, nil
+}
+
+// List implements pb.ClusterServer interface.
+func (srv *Server) List(_ context.Context, req *pb.ListRequest) (*pb.ListResponse, error) {
+	if err := srv.checkClusterID(req.GetClusterId()); err != nil {
+		return nil, err
+	}
+
+	affiliates := srv.state.List(req.GetClusterId())
+
+	resp := &pb.ListResponse{
+		Affiliates: make([]*pb.Affiliate, 0, len(affiliates)),
+	}
+
+	for _, affiliate := range affiliates {
+		resp.Affiliates = append(resp.Affiliates, pbAffiliate(affiliate))
+	}
+
+	return resp, nil
+}
+
+// Watch implements pb.ClusterServer interface.
+//
+// The first message contains the snapshot of the affiliates, the following messages contain the changes.
+func (srv *Server) Watch(req *pb.WatchRequest, stream pb.Cluster_WatchServer) error {
+	if err := srv.checkClusterID(req.GetClusterId()); err != nil {
+		return err
+	}
+
+	snapshot, watcher := srv.state.Watch(req.GetClusterId())
+	defer watcher.Close()
+
+	resp := &pb.WatchResponse{
+		Affiliates: make([]*pb.Affiliate, 0, len(snapshot)),
+	}
+
+	for _, affiliate := range snapshot {
+		resp.Affiliates = append(resp.Affiliates, pbAffiliate(affiliate))
+	}
+
+	if err := stream.Send(resp); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-watcher.Overflow():
+			return status.Error(codes.Aborted, "watcher fell behind")
+		case event := <-watcher.Events():
+			if err := stream.Send(&pb.WatchResponse{
+				Affiliates: []*pb.Affiliate{pbAffiliate(event.Affiliate)},
+				Deleted:    event.Deleted,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (srv *Server) replicate(ctx context.Context, update Update) {
+	if srv.replicator == nil {
+		return
+	}
+
+	if srv.isReplica(ctx) {
+		return
+	}
+
+	srv.replicator.Enqueue(update)
+}
+
+// isReplica checks whether the request is replicated from another control plane node.
+func (srv *Server) isReplica(ctx context.Context) bool {
+	if md, ok := metadata.FromIncomingContext(ctx); !ok || len(md.Get(ReplicaMetadataKey)) == 0 {
+		return false
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return false
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.PeerCertificates) == 0 || srv.peerRoots == nil {
+		return false
+	}
+
+	// nil roots would fall back to the system roots
+	roots := srv.peerRoots()
+	if roots == nil {
+		return false
+	}
+
+	intermediates := x509.NewCertPool()
+
+	for _, cert := range tlsInfo.State.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	_, err := tlsInfo.State.PeerCertificates[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+
+	return err == nil
+}
+
+func pbAffiliate(affiliate Affiliate) *pb.Affiliate {
+	return &pb.Affiliate{
+		Id:        affiliate.ID,
+		Data:      affiliate.Data,
+		Endpoints: affiliate.Endpoints,
+	}
+}
+
+func pbUpdateRequest(update Update) *pb.AffiliateUpdateRequest {
+	return &pb.AffiliateUpdateRequest{
+		ClusterId:          update.ClusterID,
+		AffiliateId:        update.AffiliateID,
+		AffiliateData:      update.Data,
+		AffiliateEndpoints: update.Endpoints,
+		Ttl:                durationpb.New(update.TTL),
+	}
+}
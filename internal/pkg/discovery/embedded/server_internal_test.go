@@ -0,0 +1,108 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package embedded
+
+import (
+	"context"
+	"crypto/tls"
+	stdx509 "crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/discovery-api/api/v1alpha1/server/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestServerClusterID(t *testing.T) {
+	t.Parallel()
+
+	srv := NewServer(NewState(), nil, nil)
+
+	update := &pb.AffiliateUpdateRequest{
+		ClusterId:   "cluster1",
+		AffiliateId: "af1",
+		Ttl:         durationpb.New(time.Minute),
+	}
+
+	// the local cluster ID is not known yet
+	_, err := srv.AffiliateUpdate(t.Context(), update)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	srv.SetClusterID("cluster1")
+
+	_, err = srv.AffiliateUpdate(t.Context(), update)
+	require.NoError(t, err)
+
+	_, err = srv.AffiliateUpdate(t.Context(), &pb.AffiliateUpdateRequest{
+		ClusterId:   "cluster2",
+		AffiliateId: "af1",
+		Ttl:         durationpb.New(time.Minute),
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = srv.List(t.Context(), &pb.ListRequest{ClusterId: "cluster2"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = srv.Hello(t.Context(), &pb.HelloRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	resp, err := srv.List(t.Context(), &pb.ListRequest{ClusterId: "cluster1"})
+	require.NoError(t, err)
+	assert.Len(t, resp.Affiliates, 1)
+}
+
+func TestServerIsReplica(t *testing.T) {
+	t.Parallel()
+
+	newCert := func(ca *x509.CertificateAuthority) *stdx509.Certificate {
+		keyPair, err := x509.NewKeyPair(ca, x509.CommonName("cp-1"))
+		require.NoError(t, err)
+
+		cert, err := stdx509.ParseCertificate(keyPair.Certificate.Certificate[0])
+		require.NoError(t, err)
+
+		return cert
+	}
+
+	ca, err := x509.NewSelfSignedCertificateAuthority(x509.Organization("talos"))
+	require.NoError(t, err)
+
+	otherCA, err := x509.NewSelfSignedCertificateAuthority(x509.Organization("other"))
+	require.NoError(t, err)
+
+	roots := stdx509.NewCertPool()
+	roots.AddCert(ca.Crt)
+
+	srv := NewServer(NewState(), nil, func() *stdx509.CertPool { return roots })
+
+	replicaCtx := func(cert *stdx509.Certificate, marked bool) context.Context {
+		ctx := t.Context()
+
+		if cert != nil {
+			ctx = peer.NewContext(ctx, &peer.Peer{
+				AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*stdx509.Certificate{cert}}},
+			})
+		}
+
+		if marked {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(ReplicaMetadataKey, "true"))
+		}
+
+		return ctx
+	}
+
+	assert.True(t, srv.isReplica(replicaCtx(newCert(ca), true)))
+	assert.False(t, srv.isReplica(replicaCtx(newCert(ca), false)))
+	assert.False(t, srv.isReplica(replicaCtx(newCert(otherCA), true)))
+	assert.False(t, srv.isReplica(replicaCtx(nil, true)))
+}
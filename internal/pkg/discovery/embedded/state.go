@@ -0,0 +1,375 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package embedded implements the discovery service embedded into the control plane nodes.
+//
+// The discovery service stores the affiliate data encrypted by the discovery clients with the cluster secret,
+// so the data is opaque to the service, and it expires unless refreshed by the clients.
+package embedded
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Discovery service limits.
+const (
+	MaxClusterAffiliates  = 1024
+	MaxAffiliateDataSize  = 2048
+	MaxAffiliateEndpoints = 64
+	MaxEndpointSize       = 1024
+	MaxTTL                = time.Hour
+
+	watcherBufferSize = 64
+)
+
+// ErrLimitExceeded is returned when the update exceeds the discovery service limits.
+var ErrLimitExceeded = errors.New("limit exceeded")
+
+// Affiliate is a snapshot of the affiliate stored in the discovery service.
+type Affiliate struct {
+	Expiration time.Time
+	ID         string
+	Data       []byte
+	Endpoints  [][]byte
+}
+
+// Event is a change of the affiliate.
+type Event struct {
+	Affiliate Affiliate
+	Deleted   bool
+}
+
+// Update is a change of the affiliate as received from the client.
+type Update struct {
+	ClusterID   string
+	AffiliateID string
+	Data        []byte
+	Endpoints   [][]byte
+	TTL         time.Duration
+	Deleted     bool
+}
+
+// State is the in-memory discovery service state.
+type State struct {
+	clusters map[string]*clusterState
+	mu       sync.Mutex
+}
+
+type clusterState struct {
+	affiliates map[string]*affiliate
+	watchers   map[*Watcher]struct{}
+}
+
+type affiliate struct {
+	expiration time.Time
+	data       []byte
+	endpoints  []endpoint
+}
+
+type endpoint struct {
+	expiration time.Time
+	data       []byte
+}
+
+// NewState initializes the discovery service state.
+func NewState() *State {
+	return &State{
+		clusters: map[string]*clusterState{},
+	}
+}
+
+// Update creates or refreshes the affiliate.
+//
+// The affiliate data is replaced if not empty, the endpoints are merged with the existing ones,
+// and the expiration of the affiliate and the endpoints is extended by the TTL.
+func (state *State) Update(update Update) error {
+	if err := validateUpdate(update); err != nil {
+		return err
+	}
+
+	expiration := time.Now().Add(min(update.TTL, MaxTTL))
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	cluster := state.cluster(update.ClusterID)
+
+	aff, exists := cluster.affiliates[update.AffiliateID]
+	if !exists {
+		if len(cluster.affiliates) >= MaxClusterAffiliates {
+			return fmt.Errorf("too many affiliates in the cluster: %w", ErrLimitExceeded)
+		}
+
+		aff = &affiliate{}
+	}
+
+	endpoints := slices.Clone(aff.endpoints)
+
+	for _, data := range update.Endpoints {
+		idx := slices.IndexFunc(endpoints, func(e endpoint) bool { return bytes.Equal(e.data, data) })
+
+		if idx == -1 {
+			endpoints = append(endpoints, endpoint{data: slices.Clone(data)})
+			idx = len(endpoints) - 1
+		}
+
+		endpoints[idx].expiration = maxTime(endpoints[idx].expiration, expiration)
+	}
+
+	if len(endpoints) > MaxAffiliateEndpoints {
+		return fmt.Errorf("too many affiliate endpoints: %w", ErrLimitExceeded)
+	}
+
+	aff.endpoints = endpoints
+	aff.expiration = maxTime(aff.expiration, expiration)
+
+	if len(update.Data) > 0 {
+		aff.data = slices.Clone(update.Data)
+	}
+
+	cluster.affiliates[update.AffiliateID] = aff
+
+	cluster.notify(Event{Affiliate: aff.snapshot(update.AffiliateID)})
+
+	return nil
+}
+
+// Delete removes the affiliate.
+func (state *State) Delete(clusterID, affiliateID string) {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	cluster, ok := state.clusters[clusterID]
+	if !ok {
+		return
+	}
+
+	aff, ok := cluster.affiliates[affiliateID]
+	if !ok {
+		return
+	}
+
+	delete(cluster.affiliates, affiliateID)
+
+	cluster.notify(Event{Affiliate: aff.snapshot(affiliateID), Deleted: true})
+	state.cleanup(clusterID, cluster)
+}
+
+// List returns the affiliates of the cluster sorted by ID.
+func (state *State) List(clusterID string) []Affiliate {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	cluster, ok := state.clusters[clusterID]
+	if !ok {
+		return nil
+	}
+
+	return cluster.list()
+}
+
+// Watch returns the current affiliates of the cluster and subscribes to the changes.
+//
+// The watcher should be closed when no longer needed.
+func (state *State) Watch(clusterID string) ([]Affiliate, *Watcher) {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	cluster := state.cluster(clusterID)
+
+	w := &Watcher{
+		state:     state,
+		clusterID: clusterID,
+		events:    make(chan Event, watcherBufferSize),
+		overflow:  make(chan struct{}),
+	}
+
+	cluster.watchers[w] = struct{}{}
+
+	return cluster.list(), w
+}
+
+// Export returns the updates which recreate the current state with the remaining TTLs.
+func (state *State) Export() []Update {
+	now := time.Now()
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	var updates []Update
+
+	for clusterID, cluster := range state.clusters {
+		for _, aff := range cluster.list() {
+			if !aff.Expiration.After(now) {
+				continue
+			}
+
+			updates = append(updates, Update{
+				ClusterID:   clusterID,
+				AffiliateID: aff.ID,
+				Data:        aff.Data,
+				Endpoints:   aff.Endpoints,
+				TTL:         aff.Expiration.Sub(now),
+			})
+		}
+	}
+
+	return updates
+}
+
+// GarbageCollect removes the expired affiliates and endpoints.
+func (state *State) GarbageCollect(now time.Time) {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	for clusterID, cluster := range state.clusters {
+		for id, aff := range cluster.affiliates {
+			if !aff.expiration.After(now) {
+				delete(cluster.affiliates, id)
+
+				cluster.notify(Event{Affiliate: aff.snapshot(id), Deleted: true})
+
+				continue
+			}
+
+			n := len(aff.endpoints)
+
+			aff.endpoints = slices.DeleteFunc(slices.Clone(aff.endpoints), func(e endpoint) bool { return !e.expiration.After(now) })
+
+			if len(aff.endpoints) != n {
+				cluster.notify(Event{Affiliate: aff.snapshot(id)})
+			}
+		}
+
+		state.cleanup(clusterID, cluster)
+	}
+}
+
+func (state *State) cluster(clusterID string) *clusterState {
+	cluster, ok := state.clusters[clusterID]
+	if !ok {
+		cluster = &clusterState{
+			affiliates: map[string]*affiliate{},
+			watchers:   map[*Watcher]struct{}{},
+		}
+
+		state.clusters[clusterID] = cluster
+	}
+
+	return cluster
+}
+
+func (state *State) cleanup(clusterID string, cluster *clusterState) {
+	if len(cluster.affiliates) == 0 && len(cluster.watchers) == 0 {
+		delete(state.clusters, clusterID)
+	}
+}
+
+func (cluster *clusterState) list() []Affiliate {
+	result := make([]Affiliate, 0, len(cluster.affiliates))
+
+	for id, aff := range cluster.affiliates {
+		result = append(result, aff.snapshot(id))
+	}
+
+	slices.SortFunc(result, func(a, b Affiliate) int { return strings.Compare(a.ID, b.ID) })
+
+	return result
+}
+
+// notify sends the event to the watchers, the watchers which can't keep up are closed.
+func (cluster *clusterState) notify(event Event) {
+	for w := range cluster.watchers {
+		select {
+		case w.events <- event:
+		default:
+			close(w.overflow)
+			delete(cluster.watchers, w)
+		}
+	}
+}
+
+func (aff *affiliate) snapshot(id string) Affiliate {
+	endpoints := make([][]byte, 0, len(aff.endpoints))
+
+	for _, e := range aff.endpoints {
+		endpoints = append(endpoints, e.data)
+	}
+
+	return Affiliate{
+		ID:         id,
+		Data:       aff.data,
+		Endpoints:  endpoints,
+		Expiration: aff.expiration,
+	}
+}
+
+// Watcher receives the changes of the cluster affiliates.
+type Watcher struct {
+	state     *State
+	events    chan Event
+	overflow  chan struct{}
+	clusterID string
+}
+
+// Events returns the channel of the affiliate changes.
+func (w *Watcher) Events() <-chan Event {
+	return w.events
+}
+
+// Overflow returns the channel which is closed if the watcher falls behind and stops receiving the changes.
+func (w *Watcher) Overflow() <-chan struct{} {
+	return w.overflow
+}
+
+// Close stops the watcher.
+func (w *Watcher) Close() {
+	w.state.mu.Lock()
+	defer w.state.mu.Unlock()
+
+	cluster, ok := w.state.clusters[w.clusterID]
+	if !ok {
+		return
+	}
+
+	delete(cluster.watchers, w)
+	w.state.cleanup(w.clusterID, cluster)
+}
+
+func validateUpdate(update Update) error {
+	switch {
+	case update.ClusterID == "":
+		return errors.New("cluster ID is required")
+	case update.AffiliateID == "":
+		return errors.New("affiliate ID is required")
+	case update.TTL <= 0:
+		return errors.New("TTL should be positive")
+	case len(update.Data) > MaxAffiliateDataSize:
+		return fmt.Errorf("affiliate data is too big: %w", ErrLimitExceeded)
+	case len(update.Endpoints) > MaxAffiliateEndpoints:
+		return fmt.Errorf("too many affiliate endpoints: %w", ErrLimitExceeded)
+	}
+
+	for _, e := range update.Endpoints {
+		if len(e) > MaxEndpointSize {
+			return fmt.Errorf("affiliate endpoint is too big: %w", ErrLimitExceeded)
+		}
+	}
+
+	return nil
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,243 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package embedded_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/discovery/embedded"
+)
+
+func TestStateUpdate(t *testing.T) {
+	t.Parallel()
+
+	state := embedded.NewState()
+
+	require.NoError(t, state.Update(embedded.Update{
+		ClusterID:   "cluster1",
+		AffiliateID: "af1",
+		Data:        []byte("data1"),
+		Endpoints:   [][]byte{[]byte("e1")},
+		TTL:         time.Minute,
+	}))
+
+	// endpoints are merged, empty data doesn't overwrite the existing data
+	require.NoError(t, state.Update(embedded.Update{
+		ClusterID:   "cluster1",
+		AffiliateID: "af1",
+		Endpoints:   [][]byte{[]byte("e1"), []byte("e2")},
+		TTL:         time.Minute,
+	}))
+
+	require.NoError(t, state.Update(embedded.Update{
+		ClusterID:   "cluster1",
+		AffiliateID: "af0",
+		Data:        []byte("data0"),
+		TTL:         time.Minute,
+	}))
+
+	require.NoError(t, state.Update(embedded.Update{
+		ClusterID:   "cluster2",
+		AffiliateID: "af1",
+		Data:        []byte("other"),
+		TTL:         time.Minute,
+	}))
+
+	affiliates := state.List("cluster1")
+	require.Len(t, affiliates, 2)
+
+	assert.Equal(t, "af0", affiliates[0].ID)
+	assert.Equal(t, []byte("data0"), affiliates[0].Data)
+	assert.Empty(t, affiliates[0].Endpoints)
+
+	assert.Equal(t, "af1", affiliates[1].ID)
+	assert.Equal(t, []byte("data1"), affiliates[1].Data)
+	assert.Equal(t, [][]byte{[]byte("e1"), []byte("e2")}, affiliates[1].Endpoints)
+
+	assert.Len(t, state.List("cluster2"), 1)
+	assert.Empty(t, state.List("cluster3"))
+
+	state.Delete("cluster1", "af1")
+
+	affiliates = state.List("cluster1")
+	require.Len(t, affiliates, 1)
+	assert.Equal(t, "af0", affiliates[0].ID)
+}
+
+func TestStateValidation(t *testing.T) {
+	t.Parallel()
+
+	state := embedded.NewState()
+
+	for _, update := range []embedded.Update{
+		{AffiliateID: "af1", TTL: time.Minute},
+		{ClusterID: "cluster1", TTL: time.Minute},
+		{ClusterID: "cluster1", AffiliateID: "af1"},
+	} {
+		assert.Error(t, state.Update(update))
+	}
+
+	assert.ErrorIs(t, state.Update(embedded.Update{
+		ClusterID:   "cluster1",
+		AffiliateID: "af1",
+		Data:        bytes.Repeat([]byte{0}, embedded.MaxAffiliateDataSize+1),
+		TTL:         time.Minute,
+	}), embedded.ErrLimitExceeded)
+
+	for i := range embedded.MaxAffiliateEndpoints {
+		require.NoError(t, state.Update(embedded.Update{
+			ClusterID:   "cluster1",
+			AffiliateID: "af1",
+			Endpoints:   [][]byte{{byte(i)}},
+			TTL:         time.Minute,
+		}))
+	}
+
+	assert.ErrorIs(t, state.Update(embedded.Update{
+		ClusterID:   "cluster1",
+		AffiliateID: "af1",
+		Endpoints:   [][]byte{[]byte("one too many")},
+		TTL:         time.Minute,
+	}), embedded.ErrLimitExceeded)
+
+	affiliates := state.List("cluster1")
+	require.Len(t, affiliates, 1)
+	assert.Len(t, affiliates[0].Endpoints, embedded.MaxAffiliateEndpoints)
+}
+
+func TestStateTTL(t *testing.T) {
+	t.Parallel()
+
+	state := embedded.NewState()
+
+	require.NoError(t, state.Update(embedded.Update{
+		ClusterID:   "cluster1",
+		AffiliateID: "af1",
+		Data:        []byte("data1"),
+		Endpoints:   [][]byte{[]byte("e1")},
+		TTL:         time.Minute,
+	}))
+
+	require.NoError(t, state.Update(embedded.Update{
+		ClusterID:   "cluster1",
+		AffiliateID: "af1",
+		Endpoints:   [][]byte{[]byte("e2")},
+		TTL:         10 * time.Minute,
+	}))
+
+	require.NoError(t, state.Update(embedded.Update{
+		ClusterID:   "cluster1",
+		AffiliateID: "af2",
+		Data:        []byte("data2"),
+		TTL:         24 * time.Hour, // capped to MaxTTL
+	}))
+
+	_, watcher := state.Watch("cluster1")
+	defer watcher.Close()
+
+	// e1 expires
+	state.GarbageCollect(time.Now().Add(5 * time.Minute))
+
+	affiliates := state.List("cluster1")
+	require.Len(t, affiliates, 2)
+	assert.Equal(t, [][]byte{[]byte("e2")}, affiliates[0].Endpoints)
+
+	event := <-watcher.Events()
+	assert.False(t, event.Deleted)
+	assert.Equal(t, "af1", event.Affiliate.ID)
+
+	// af1 expires
+	state.GarbageCollect(time.Now().Add(30 * time.Minute))
+
+	affiliates = state.List("cluster1")
+	require.Len(t, affiliates, 1)
+	assert.Equal(t, "af2", affiliates[0].ID)
+
+	event = <-watcher.Events()
+	assert.True(t, event.Deleted)
+	assert.Equal(t, "af1", event.Affiliate.ID)
+
+	updates := state.Export()
+	require.Len(t, updates, 1)
+	assert.Equal(t, "af2", updates[0].AffiliateID)
+	assert.LessOrEqual(t, updates[0].TTL, embedded.MaxTTL)
+
+	// af2 expires
+	state.GarbageCollect(time.Now().Add(embedded.MaxTTL + time.Minute))
+
+	assert.Empty(t, state.List("cluster1"))
+	assert.Empty(t, state.Export())
+}
+
+func TestStateWatch(t *testing.T) {
+	t.Parallel()
+
+	state := embedded.NewState()
+
+	require.NoError(t, state.Update(embedded.Update{
+		ClusterID:   "cluster1",
+		AffiliateID: "af1",
+		Data:        []byte("data1"),
+		TTL:         time.Minute,
+	}))
+
+	snapshot, watcher := state.Watch("cluster1")
+	defer watcher.Close()
+
+	require.Len(t, snapshot, 1)
+	assert.Equal(t, "af1", snapshot[0].ID)
+
+	require.NoError(t, state.Update(embedded.Update{
+		ClusterID:   "cluster1",
+		AffiliateID: "af2",
+		Data:        []byte("data2"),
+		TTL:         time.Minute,
+	}))
+
+	// other clusters are not reported
+	require.NoError(t, state.Update(embedded.Update{
+		ClusterID:   "cluster2",
+		AffiliateID: "af3",
+		Data:        []byte("data3"),
+		TTL:         time.Minute,
+	}))
+
+	state.Delete("cluster1", "af1")
+
+	event := <-watcher.Events()
+	assert.False(t, event.Deleted)
+	assert.Equal(t, "af2", event.Affiliate.ID)
+	assert.Equal(t, []byte("data2"), event.Affiliate.Data)
+
+	event = <-watcher.Events()
+	assert.True(t, event.Deleted)
+	assert.Equal(t, "af1", event.Affiliate.ID)
+
+	select {
+	case event = <-watcher.Events():
+		t.Fatalf("unexpected event %v", event)
+	default:
+	}
+
+	// the watcher which doesn't read the events is closed
+	for range 100 {
+		require.NoError(t, state.Update(embedded.Update{
+			ClusterID:   "cluster1",
+			AffiliateID: "af2",
+			TTL:         time.Minute,
+		}))
+	}
+
+	select {
+	case <-watcher.Overflow():
+	default:
+		t.Fatal("watcher is not closed")
+	}
+}
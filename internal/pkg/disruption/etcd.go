@@ -0,0 +1,186 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package disruption
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/pkg/etcd"
+)
+
+// QueueTTL is the time the queued request is kept unless refreshed by the requesting node.
+const QueueTTL = 2 * time.Minute
+
+const (
+	lockKey = "talos:disruption"
+
+	holdersPrefix = "/talos/disruption/holders/"
+	queuePrefix   = "/talos/disruption/queue/"
+)
+
+// Acquire queues the request for the lock slot and grants it if the limits allow.
+//
+// The request should be repeated until it is granted, each call refreshes the queue entry.
+// The granted slot is held until it is released or the lock TTL expires.
+//
+// The node name is bound to the address of the request while the node is queued or holds the slot,
+// the requests for the same node from another address are rejected.
+//
+// Acquire should be called on a control plane node, as it uses the local etcd member.
+func Acquire(ctx context.Context, logger *zap.Logger, req Request, lockTTL time.Duration) (Status, error) {
+	var status Status
+
+	err := etcd.WithLock(ctx, lockKey, logger, func() error {
+		client, err := etcd.NewLocalClient(ctx)
+		if err != nil {
+			return fmt.Errorf("error creating etcd client: %w", err)
+		}
+
+		defer client.Close() //nolint:errcheck
+
+		holders, err := list(ctx, client, holdersPrefix)
+		if err != nil {
+			return err
+		}
+
+		queue, err := list(ctx, client, queuePrefix)
+		if err != nil {
+			return err
+		}
+
+		if err = VerifyAddress(slices.Concat(holders, queue), req.Node, req.Address); err != nil {
+			return err
+		}
+
+		isNode := func(r Request) bool { return r.Node == req.Node }
+
+		if slices.ContainsFunc(holders, isNode) {
+			status = Status{Granted: true, Holders: HolderNames(holders)}
+
+			if _, err = client.Delete(ctx, queuePrefix+req.Node); err != nil {
+				return fmt.Errorf("error removing queued request: %w", err)
+			}
+
+			return nil
+		}
+
+		// the node keeps its place in the queue while refreshing the request
+		if idx := slices.IndexFunc(queue, isNode); idx >= 0 {
+			req.RequestedAt = queue[idx].RequestedAt
+			queue[idx] = req
+		} else {
+			queue = append(queue, req)
+		}
+
+		if slices.ContainsFunc(Schedule(holders, queue), isNode) {
+			if err = put(ctx, client, holdersPrefix+req.Node, req, lockTTL); err != nil {
+				return err
+			}
+
+			if _, err = client.Delete(ctx, queuePrefix+req.Node); err != nil {
+				return fmt.Errorf("error removing queued request: %w", err)
+			}
+
+			status = Status{Granted: true, Holders: HolderNames(append(holders, req))}
+
+			logger.Info("disruption lock slot granted", zap.String("node", req.Node), zap.String("operation", req.Operation))
+
+			return nil
+		}
+
+		if err = put(ctx, client, queuePrefix+req.Node, req, QueueTTL); err != nil {
+			return err
+		}
+
+		status = Status{QueuePosition: QueuePosition(queue, req.Node), Holders: HolderNames(holders)}
+
+		return nil
+	})
+
+	return status, err
+}
+
+// Release releases the lock slot held by the node (and drops the queued request, if any).
+//
+// The address should match the address of the stored requests of the node.
+func Release(ctx context.Context, logger *zap.Logger, node, address string) error {
+	return etcd.WithLock(ctx, lockKey, logger, func() error {
+		client, err := etcd.NewLocalClient(ctx)
+		if err != nil {
+			return fmt.Errorf("error creating etcd client: %w", err)
+		}
+
+		defer client.Close() //nolint:errcheck
+
+		var requests []Request
+
+		for _, prefix := range []string{holdersPrefix, queuePrefix} {
+			if requests, err = list(ctx, client, prefix); err != nil {
+				return err
+			}
+
+			if err = VerifyAddress(requests, node, address); err != nil {
+				return err
+			}
+		}
+
+		for _, key := range []string{holdersPrefix + node, queuePrefix + node} {
+			if _, err = client.Delete(ctx, key); err != nil {
+				return fmt.Errorf("error deleting %q: %w", key, err)
+			}
+		}
+
+		logger.Info("disruption lock slot released", zap.String("node", node))
+
+		return nil
+	})
+}
+
+func list(ctx context.Context, client *etcd.Client, prefix string) ([]Request, error) {
+	resp, err := client.Get(ctx, prefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, fmt.Errorf("error listing %q: %w", prefix, err)
+	}
+
+	requests := make([]Request, 0, len(resp.Kvs))
+
+	for _, kv := range resp.Kvs {
+		var req Request
+
+		if err = json.Unmarshal(kv.Value, &req); err != nil {
+			return nil, fmt.Errorf("error unmarshaling %q: %w", string(kv.Key), err)
+		}
+
+		requests = append(requests, req)
+	}
+
+	return requests, nil
+}
+
+// put stores the request with a lease, so that the entries of the nodes which went away expire.
+func put(ctx context.Context, client *etcd.Client, key string, req Request, ttl time.Duration) error {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	lease, err := client.Grant(ctx, int64(ttl/time.Second))
+	if err != nil {
+		return fmt.Errorf("error granting lease: %w", err)
+	}
+
+	if _, err = client.Put(ctx, key, string(data), clientv3.WithLease(lease.ID)); err != nil {
+		return fmt.Errorf("error storing %q: %w", key, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package disruption
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// WaitForSlot blocks the disruptive operation until the maintenance policy allows it.
+//
+// If the maintenance policy is not configured, WaitForSlot returns immediately.
+// Otherwise, the operation is queued as a DisruptionRequest, and WaitForSlot returns once DisruptionStatus reports it as granted.
+//
+// The returned function should be called if the operation fails before disrupting the node, so that the lock slot is released.
+func WaitForSlot(ctx context.Context, st state.State, operation string, logger *zap.Logger) (func(), error) {
+	cfg, err := safe.StateGetByID[*config.MachineConfig](ctx, st, config.ActiveID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, fmt.Errorf("error getting machine config: %w", err)
+	}
+
+	if cfg == nil || cfg.Config().MaintenancePolicyConfig() == nil {
+		return func() {}, nil
+	}
+
+	request := runtime.NewDisruptionRequest(operation)
+
+	if err = safe.StateModify(ctx, st, request, func(res *runtime.DisruptionRequest) error {
+		res.TypedSpec().Operation = operation
+		res.TypedSpec().RequestedAt = time.Now()
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("error creating disruption request: %w", err)
+	}
+
+	release := func() {
+		if err := st.Destroy(context.Background(), request.Metadata()); err != nil && !state.IsNotFoundError(err) {
+			logger.Error("error removing disruption request", zap.String("operation", operation), zap.Error(err))
+		}
+	}
+
+	logger.Info("waiting for the maintenance policy to allow the operation", zap.String("operation", operation))
+
+	if _, err = st.WatchFor(ctx, runtime.NewDisruptionStatus().Metadata(), state.WithCondition(func(r resource.Resource) (bool, error) {
+		if resource.IsTombstone(r) {
+			return false, nil
+		}
+
+		status, ok := r.(*runtime.DisruptionStatus)
+		if !ok {
+			return false, fmt.Errorf("unexpected resource type %T", r)
+		}
+
+		return status.TypedSpec().State == runtime.DisruptionStateGranted && slices.Contains(status.TypedSpec().Operations, operation), nil
+	})); err != nil {
+		release()
+
+		return nil, fmt.Errorf("error waiting for the disruption lock slot: %w", err)
+	}
+
+	logger.Info("maintenance policy allows the operation", zap.String("operation", operation))
+
+	return release, nil
+}
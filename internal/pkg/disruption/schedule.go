@@ -0,0 +1,164 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package disruption implements cluster-wide coordination of the disruptive operations (reboots, upgrades).
+package disruption
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Limits on the number of nodes disrupted at the same time.
+//
+// Zero value of a limit means no limit.
+type Limits struct {
+	MaxNodes             int            `json:"maxNodes,omitempty"`
+	MaxControlPlaneNodes int            `json:"maxControlPlaneNodes,omitempty"`
+	Labels               map[string]int `json:"labels,omitempty"`
+}
+
+// Request is a request for the lock slot.
+//
+// Requests are stored as JSON in etcd both while queued and while holding the slot.
+type Request struct {
+	Node         string            `json:"node"`
+	ControlPlane bool              `json:"controlPlane,omitempty"`
+	Labels       map[string]string `json:"labels,omitempty"`
+	Operation    string            `json:"operation,omitempty"`
+	RequestedAt  time.Time         `json:"requestedAt"`
+	Limits       Limits            `json:"limits"`
+	// Address of the worker node the request was received from via trustd.
+	//
+	// The node name is bound to the address while the request is stored, the control plane nodes leave it empty.
+	Address string `json:"address,omitempty"`
+}
+
+// ErrAddressMismatch is returned when the node name is bound to a different address.
+var ErrAddressMismatch = errors.New("node is bound to a different address")
+
+// Status of the lock slot request.
+type Status struct {
+	Granted bool
+	// QueuePosition is 1-based position in the queue of the requests which are not granted yet.
+	QueuePosition int
+	// Holders are the nodes currently holding the lock slots.
+	Holders []string
+}
+
+// Allows checks whether the request can be granted while the holders are disrupted.
+//
+// The limits of the request are applied: the nodes might temporarily disagree on the limits while the policy is being rolled out.
+func Allows(holders []Request, req Request) bool {
+	limits := req.Limits
+
+	if limits.MaxNodes > 0 && len(holders) >= limits.MaxNodes {
+		return false
+	}
+
+	if req.ControlPlane && limits.MaxControlPlaneNodes > 0 {
+		controlPlaneHolders := 0
+
+		for _, holder := range holders {
+			if holder.ControlPlane {
+				controlPlaneHolders++
+			}
+		}
+
+		if controlPlaneHolders >= limits.MaxControlPlaneNodes {
+			return false
+		}
+	}
+
+	for key, maxNodes := range limits.Labels {
+		value, ok := req.Labels[key]
+		if !ok || maxNodes <= 0 {
+			// nodes without the label are not constrained by the label limit
+			continue
+		}
+
+		sameValue := 0
+
+		for _, holder := range holders {
+			if holderValue, ok := holder.Labels[key]; ok && holderValue == value {
+				sameValue++
+			}
+		}
+
+		if sameValue >= maxNodes {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SortQueue orders the queue: first come, first served.
+func SortQueue(queue []Request) {
+	slices.SortFunc(queue, func(a, b Request) int {
+		return cmp.Or(a.RequestedAt.Compare(b.RequestedAt), cmp.Compare(a.Node, b.Node))
+	})
+}
+
+// Schedule returns the queued requests which can be granted given the current holders.
+//
+// The queue is processed in order, and each granted request is counted against the limits of the requests following it,
+// so that a request can't overtake an earlier one which is allowed to proceed.
+// A request which is blocked doesn't block the requests after it, e.g. a node in another zone might proceed.
+func Schedule(holders, queue []Request) []Request {
+	queue = slices.Clone(queue)
+	SortQueue(queue)
+
+	current := slices.Clone(holders)
+
+	var granted []Request
+
+	for _, req := range queue {
+		if slices.ContainsFunc(current, func(holder Request) bool { return holder.Node == req.Node }) {
+			continue
+		}
+
+		if Allows(current, req) {
+			current = append(current, req)
+			granted = append(granted, req)
+		}
+	}
+
+	return granted
+}
+
+// QueuePosition returns the 1-based position of the node in the queue, or 0 if the node is not queued.
+func QueuePosition(queue []Request, node string) int {
+	queue = slices.Clone(queue)
+	SortQueue(queue)
+
+	return slices.IndexFunc(queue, func(req Request) bool { return req.Node == node }) + 1
+}
+
+// HolderNames returns the sorted list of holder node names.
+func HolderNames(holders []Request) []string {
+	names := make([]string, 0, len(holders))
+
+	for _, holder := range holders {
+		names = append(names, holder.Node)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// VerifyAddress checks that the stored requests of the node were made from the same address.
+func VerifyAddress(requests []Request, node, address string) error {
+	for _, req := range requests {
+		if req.Node == node && req.Address != address {
+			return fmt.Errorf("node %q: %w", node, ErrAddressMismatch)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,200 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package disruption_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/siderolabs/gen/xslices"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/siderolabs/talos/internal/pkg/disruption"
+)
+
+func TestSchedule(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 19, 2, 0, 0, 0, time.UTC)
+
+	limits := disruption.Limits{
+		MaxNodes:             2,
+		MaxControlPlaneNodes: 1,
+		Labels:               map[string]int{"topology.kubernetes.io/zone": 1},
+	}
+
+	request := func(node string, controlPlane bool, zone string, offset time.Duration) disruption.Request {
+		req := disruption.Request{
+			Node:         node,
+			ControlPlane: controlPlane,
+			RequestedAt:  now.Add(offset),
+			Limits:       limits,
+		}
+
+		if zone != "" {
+			req.Labels = map[string]string{"topology.kubernetes.io/zone": zone}
+		}
+
+		return req
+	}
+
+	for _, test := range []struct {
+		name string
+
+		holders []disruption.Request
+		queue   []disruption.Request
+
+		expected []string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "first come first served",
+
+			queue: []disruption.Request{
+				request("worker-2", false, "a", time.Second),
+				request("worker-1", false, "a", 0),
+			},
+
+			expected: []string{"worker-1"},
+		},
+		{
+			name: "one per zone",
+
+			queue: []disruption.Request{
+				request("worker-1", false, "a", 0),
+				request("worker-2", false, "a", time.Second),
+				request("worker-3", false, "b", 2*time.Second),
+			},
+
+			expected: []string{"worker-1", "worker-3"},
+		},
+		{
+			name: "zone busy",
+
+			holders: []disruption.Request{
+				request("worker-1", false, "a", 0),
+			},
+			queue: []disruption.Request{
+				request("worker-2", false, "a", time.Second),
+				request("worker-3", false, "b", 2*time.Second),
+			},
+
+			expected: []string{"worker-3"},
+		},
+		{
+			name: "max nodes",
+
+			holders: []disruption.Request{
+				request("worker-1", false, "a", 0),
+			},
+			queue: []disruption.Request{
+				request("worker-2", false, "b", time.Second),
+				request("worker-3", false, "c", 2*time.Second),
+			},
+
+			expected: []string{"worker-2"},
+		},
+		{
+			name: "control plane",
+
+			queue: []disruption.Request{
+				request("cp-1", true, "a", 0),
+				request("cp-2", true, "b", time.Second),
+			},
+
+			expected: []string{"cp-1"},
+		},
+		{
+			name: "control plane limit does not apply to workers",
+
+			holders: []disruption.Request{
+				request("cp-1", true, "a", 0),
+			},
+			queue: []disruption.Request{
+				request("worker-1", false, "b", time.Second),
+			},
+
+			expected: []string{"worker-1"},
+		},
+		{
+			name: "no label",
+
+			holders: []disruption.Request{
+				request("worker-1", false, "", 0),
+			},
+			queue: []disruption.Request{
+				request("worker-2", false, "", time.Second),
+			},
+
+			expected: []string{"worker-2"},
+		},
+		{
+			name: "already holding",
+
+			holders: []disruption.Request{
+				request("worker-1", false, "a", 0),
+			},
+			queue: []disruption.Request{
+				request("worker-1", false, "a", time.Second),
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			granted := disruption.Schedule(test.holders, test.queue)
+
+			assert.Equal(t, test.expected, xslices.Map(granted, func(req disruption.Request) string { return req.Node }))
+		})
+	}
+}
+
+func TestAllowsNoLimits(t *testing.T) {
+	t.Parallel()
+
+	holders := []disruption.Request{
+		{Node: "cp-1", ControlPlane: true},
+		{Node: "cp-2", ControlPlane: true},
+	}
+
+	assert.True(t, disruption.Allows(holders, disruption.Request{Node: "cp-3", ControlPlane: true}))
+	assert.False(t, disruption.Allows(holders, disruption.Request{Node: "cp-3", ControlPlane: true, Limits: disruption.Limits{MaxControlPlaneNodes: 2}}))
+}
+
+func TestQueuePosition(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	queue := []disruption.Request{
+		{Node: "worker-3", RequestedAt: now.Add(time.Second)},
+		{Node: "worker-2", RequestedAt: now},
+		{Node: "worker-1", RequestedAt: now},
+	}
+
+	assert.Equal(t, 1, disruption.QueuePosition(queue, "worker-1"))
+	assert.Equal(t, 2, disruption.QueuePosition(queue, "worker-2"))
+	assert.Equal(t, 3, disruption.QueuePosition(queue, "worker-3"))
+	assert.Equal(t, 0, disruption.QueuePosition(queue, "worker-4"))
+}
+
+func TestVerifyAddress(t *testing.T) {
+	t.Parallel()
+
+	requests := []disruption.Request{
+		{Node: "cp-1"},
+		{Node: "worker-1", Address: "10.5.0.4"},
+	}
+
+	assert.NoError(t, disruption.VerifyAddress(requests, "worker-1", "10.5.0.4"))
+	assert.NoError(t, disruption.VerifyAddress(requests, "worker-2", "10.5.0.5"))
+	assert.NoError(t, disruption.VerifyAddress(requests, "cp-1", ""))
+
+	assert.ErrorIs(t, disruption.VerifyAddress(requests, "worker-1", "10.5.0.5"), disruption.ErrAddressMismatch)
+	assert.ErrorIs(t, disruption.VerifyAddress(requests, "worker-1", ""), disruption.ErrAddressMismatch)
+	assert.ErrorIs(t, disruption.VerifyAddress(requests, "cp-1", "10.5.0.5"), disruption.ErrAddressMismatch)
+}
@@ -0,0 +1,107 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kmsplugin
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+)
+
+// boxInfo is the HKDF info used to derive the box key.
+const boxInfo = "talos kms plugin key box"
+
+// sealBox encrypts the data to the node public key.
+//
+// The format of the box is: ephemeral public key | nonce | ciphertext.
+func sealBox(publicKey *ecdh.PublicKey, data, additionalData []byte) ([]byte, error) {
+	ephemeral, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := boxAEAD(ephemeral, publicKey, ephemeral.PublicKey())
+	if err != nil {
+		return nil, err
+	}
+
+	box := ephemeral.PublicKey().Bytes()
+
+	sealed, err := seal(aead, data, additionalData)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(box, sealed...), nil
+}
+
+// openBox decrypts the data encrypted with sealBox.
+func openBox(privateKey *ecdh.PrivateKey, box, additionalData []byte) ([]byte, error) {
+	keySize := len(privateKey.PublicKey().Bytes())
+
+	if len(box) < keySize {
+		return nil, errors.New("box is too short")
+	}
+
+	ephemeralPublic, err := ecdh.X25519().NewPublicKey(box[:keySize])
+	if err != nil {
+		return nil, fmt.Errorf("error parsing ephemeral public key: %w", err)
+	}
+
+	aead, err := boxAEAD(privateKey, ephemeralPublic, ephemeralPublic)
+	if err != nil {
+		return nil, err
+	}
+
+	return open(aead, box[keySize:], additionalData)
+}
+
+func boxAEAD(privateKey *ecdh.PrivateKey, publicKey, ephemeralPublic *ecdh.PublicKey) (cipher.AEAD, error) {
+	shared, err := privateKey.ECDH(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := hkdf.Key(sha256.New, shared, ephemeralPublic.Bytes(), boxInfo, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	return newAEAD(key)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts the data with a random nonce, the nonce is prepended to the ciphertext.
+func seal(aead cipher.AEAD, data, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(data)+aead.Overhead())
+
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, data, additionalData), nil
+}
+
+// open decrypts the data encrypted with seal.
+func open(aead cipher.AEAD, ciphertext, additionalData []byte) ([]byte, error) {
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+
+	return aead.Open(nil, ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():], additionalData)
+}
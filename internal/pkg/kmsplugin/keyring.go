@@ -0,0 +1,703 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kmsplugin
+
+import (
+	"bytes"
+	"context"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// maxSyncAttempts is the number of attempts to update the state on conflicts.
+const maxSyncAttempts = 10
+
+// Node registration expiration.
+const (
+	// DefaultNodeExpiration is the default period after which the node which didn't sync is removed from the state.
+	DefaultNodeExpiration = 30 * 24 * time.Hour
+
+	// nodeSeenRefreshInterval limits the state updates to refresh the last seen time of the node.
+	nodeSeenRefreshInterval = time.Hour
+)
+
+// ErrNoKey is returned when the keyring doesn't hold any key yet.
+var ErrNoKey = errors.New("no key encryption key available")
+
+// ErrKeyUnrecoverable is returned (wrapped) when the node key can never be unwrapped again,
+// e.g. the TPM PCR values changed since the key was sealed.
+var ErrKeyUnrecoverable = errors.New("node key can't be recovered")
+
+// state is the cluster-wide state of the plugin.
+type state struct {
+	Nodes map[string]*nodeState `json:"nodes,omitempty"`
+	Keys  []*keyState           `json:"keys,omitempty"`
+}
+
+// nodeState is the registration of a control plane node.
+type nodeState struct {
+	Provider   string    `json:"provider"`
+	PublicKey  []byte    `json:"publicKey"`
+	WrappedKey []byte    `json:"wrappedKey"`
+	LastSeen   time.Time `json:"lastSeen,omitzero"`
+
+	// Pending is the replacement node key registered when the node key can't be recovered anymore.
+	//
+	// The pending key replaces the node key once the keys are boxed for it by the other nodes,
+	// the boxes for the previous node key are kept until then.
+	Pending *pendingNodeKey `json:"pending,omitempty"`
+}
+
+// pendingNodeKey is the replacement node key.
+type pendingNodeKey struct {
+	PublicKey  []byte `json:"publicKey"`
+	WrappedKey []byte `json:"wrappedKey"`
+}
+
+// keyState is the key encryption key boxed to each registered node.
+type keyState struct {
+	ID      string            `json:"id"`
+	Created time.Time         `json:"created"`
+	Boxes   map[string][]byte `json:"boxes"`
+
+	// PendingBoxes are the boxes for the pending node keys.
+	PendingBoxes map[string][]byte `json:"pendingBoxes,omitempty"`
+}
+
+// kek is the unboxed key encryption key.
+type kek struct {
+	id      string
+	created time.Time
+	aead    cipher.AEAD
+}
+
+// KeyringConfig configures the Keyring.
+type KeyringConfig struct {
+	Store   Store
+	Wrapper KeyWrapper
+
+	// NodeID is the stable identifier of the node.
+	NodeID string
+	// RotationInterval is the interval after which a new key encryption key is generated.
+	RotationInterval time.Duration
+	// KeyRetention is the period the key encryption key is kept for after it was superseded by a newer key.
+	//
+	// Zero value keeps the keys forever.
+	KeyRetention time.Duration
+	// NodeExpiration is the period after which the node which didn't sync is removed from the state.
+	//
+	// Defaults to DefaultNodeExpiration.
+	NodeExpiration time.Duration
+
+	// Now defaults to time.Now.
+	Now func() time.Time
+}
+
+// KeyringStatus describes the state of the keyring.
+type KeyringStatus struct {
+	Healthy    bool
+	NodeKeyID  string
+	KeyID      string
+	KeyCreated time.Time
+	KeyCount   int
+	LastSync   time.Time
+	Error      error
+}
+
+// Keyring holds the key encryption keys shared by the control plane nodes.
+type Keyring struct {
+	cfg KeyringConfig
+
+	syncMu sync.Mutex
+
+	mu             sync.RWMutex
+	nodeKey        *ecdh.PrivateKey
+	wrappedNodeKey []byte
+	keys           map[string]*kek
+	current        *kek
+	lastSync       time.Time
+	lastErr        error
+}
+
+// NewKeyring creates a new Keyring.
+func NewKeyring(cfg KeyringConfig) *Keyring {
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+
+	if cfg.NodeExpiration == 0 {
+		cfg.NodeExpiration = DefaultNodeExpiration
+	}
+
+	return &Keyring{
+		cfg:  cfg,
+		keys: map[string]*kek{},
+	}
+}
+
+// Sync registers the node, unboxes the key encryption keys, rotates the key if needed,
+// and boxes the keys for the nodes which were registered since the last sync.
+func (k *Keyring) Sync(ctx context.Context) error {
+	k.syncMu.Lock()
+	defer k.syncMu.Unlock()
+
+	err := k.sync(ctx)
+
+	k.mu.Lock()
+	k.lastErr = err
+
+	if err == nil {
+		k.lastSync = k.cfg.Now()
+	}
+	k.mu.Unlock()
+
+	return err
+}
+
+func (k *Keyring) sync(ctx context.Context) error {
+	for range maxSyncAttempts {
+		done, err := k.syncOnce(ctx)
+		if err != nil {
+			return err
+		}
+
+		if done {
+			return nil
+		}
+	}
+
+	return errors.New("failed to update the KMS plugin state: too many conflicts")
+}
+
+//nolint:gocyclo,cyclop
+func (k *Keyring) syncOnce(ctx context.Context) (bool, error) {
+	data, revision, err := k.cfg.Store.Get(ctx)
+	if err != nil {
+		return false, fmt.Errorf("error reading the KMS plugin state: %w", err)
+	}
+
+	var st state
+
+	if data != nil {
+		if err = json.Unmarshal(data, &st); err != nil {
+			return false, fmt.Errorf("error unmarshaling the KMS plugin state: %w", err)
+		}
+	}
+
+	if st.Nodes == nil {
+		st.Nodes = map[string]*nodeState{}
+	}
+
+	now := k.cfg.Now()
+
+	modified, err := k.register(ctx, &st, now)
+	if err != nil {
+		return false, err
+	}
+
+	if k.prune(&st, now) {
+		modified = true
+	}
+
+	k.mu.RLock()
+	nodeKey := k.nodeKey
+	k.mu.RUnlock()
+
+	// while the node key is pending, the keys are read from the pending boxes
+	pending := st.Nodes[k.cfg.NodeID].Pending != nil && bytes.Equal(st.Nodes[k.cfg.NodeID].Pending.PublicKey, nodeKey.PublicKey().Bytes())
+
+	rawKeys := make(map[string][]byte, len(st.Keys))
+
+	for _, key := range st.Keys {
+		boxes := key.Boxes
+		if pending {
+			boxes = key.PendingBoxes
+		}
+
+		box, ok := boxes[k.cfg.NodeID]
+		if !ok {
+			continue
+		}
+
+		raw, err := openBox(nodeKey, box, boxAdditionalData(key.ID, k.cfg.NodeID))
+		if err != nil {
+			return false, fmt.Errorf("error unboxing key %q: %w", key.ID, err)
+		}
+
+		rawKeys[key.ID] = raw
+	}
+
+	// rotate the key only if this node holds the latest key, otherwise wait for the key to be boxed for this node
+	if latest := lastKey(st.Keys); latest == nil || (now.Sub(latest.Created) >= k.cfg.RotationInterval && rawKeys[latest.ID] != nil) {
+		id, raw, err := generateKey()
+		if err != nil {
+			return false, err
+		}
+
+		st.Keys = append(st.Keys, &keyState{
+			ID:      id,
+			Created: now,
+			Boxes:   map[string][]byte{},
+		})
+
+		rawKeys[id] = raw
+		modified = true
+	}
+
+	for _, key := range st.Keys {
+		raw, ok := rawKeys[key.ID]
+		if !ok {
+			continue
+		}
+
+		if key.Boxes == nil {
+			key.Boxes = map[string][]byte{}
+		}
+
+		for nodeID, node := range st.Nodes {
+			boxed, err := boxKey(key.Boxes, key.ID, raw, nodeID, node.PublicKey)
+			if err != nil {
+				return false, err
+			}
+
+			if node.Pending != nil {
+				if key.PendingBoxes == nil {
+					key.PendingBoxes = map[string][]byte{}
+				}
+
+				boxedPending, err := boxKey(key.PendingBoxes, key.ID, raw, nodeID, node.Pending.PublicKey)
+				if err != nil {
+					return false, err
+				}
+
+				boxed = boxed || boxedPending
+			}
+
+			if boxed {
+				modified = true
+			}
+		}
+	}
+
+	if modified {
+		data, err = json.Marshal(&st)
+		if err != nil {
+			return false, err
+		}
+
+		swapped, err := k.cfg.Store.CompareAndSwap(ctx, data, revision)
+		if err != nil {
+			return false, fmt.Errorf("error writing the KMS plugin state: %w", err)
+		}
+
+		if !swapped {
+			return false, nil
+		}
+	}
+
+	keys := make(map[string]*kek, len(rawKeys))
+
+	var current *kek
+
+	for _, key := range st.Keys {
+		raw, ok := rawKeys[key.ID]
+		if !ok {
+			continue
+		}
+
+		aead, err := newAEAD(raw)
+		if err != nil {
+			return false, err
+		}
+
+		keys[key.ID] = &kek{
+			id:      key.ID,
+			created: key.Created,
+			aead:    aead,
+		}
+
+		// keys are ordered by the creation time
+		current = keys[key.ID]
+	}
+
+	k.mu.Lock()
+	k.keys = keys
+	k.current = current
+	k.mu.Unlock()
+
+	return true, nil
+}
+
+// register makes sure the node is registered in the state, and the node key is available.
+//
+// If the node key can't be recovered anymore, a new node key is registered as pending, and it replaces the node key
+// once the other nodes box the keys for it. This is done only if another node still holds the keys, otherwise
+// the error is returned, as the keys would be lost.
+//
+//nolint:gocyclo,cyclop
+func (k *Keyring) register(ctx context.Context, st *state, now time.Time) (bool, error) {
+	k.mu.RLock()
+	nodeKey, wrappedNodeKey := k.nodeKey, k.wrappedNodeKey
+	k.mu.RUnlock()
+
+	node, registered := st.Nodes[k.cfg.NodeID]
+
+	if !registered {
+		if nodeKey == nil {
+			var err error
+
+			if nodeKey, wrappedNodeKey, err = k.newNodeKey(ctx); err != nil {
+				return false, err
+			}
+
+			k.mu.Lock()
+			k.nodeKey, k.wrappedNodeKey = nodeKey, wrappedNodeKey
+			k.mu.Unlock()
+		}
+
+		// drop the boxes left for the previous registration of the node (if any)
+		for _, key := range st.Keys {
+			delete(key.Boxes, k.cfg.NodeID)
+			delete(key.PendingBoxes, k.cfg.NodeID)
+		}
+
+		st.Nodes[k.cfg.NodeID] = &nodeState{
+			Provider:   k.cfg.Wrapper.Provider(),
+			PublicKey:  nodeKey.PublicKey().Bytes(),
+			WrappedKey: wrappedNodeKey,
+			LastSeen:   now,
+		}
+
+		return true, nil
+	}
+
+	if node.Provider != k.cfg.Wrapper.Provider() {
+		return false, fmt.Errorf("node key is protected by the %q provider, but %q is configured", node.Provider, k.cfg.Wrapper.Provider())
+	}
+
+	// the node key held in memory was replaced in the state, recover the registered one
+	if nodeKey != nil && !node.hasKey(nodeKey.PublicKey().Bytes()) {
+		nodeKey = nil
+	}
+
+	modified := false
+
+	if nodeKey == nil {
+		var err error
+
+		nodeKey, wrappedNodeKey, err = k.recoverNodeKey(ctx, node)
+		if err != nil {
+			if !errors.Is(err, ErrKeyUnrecoverable) || !otherNodeHoldsKeys(st, k.cfg.NodeID) {
+				return false, err
+			}
+
+			if nodeKey, wrappedNodeKey, err = k.newNodeKey(ctx); err != nil {
+				return false, err
+			}
+
+			node.Pending = &pendingNodeKey{
+				PublicKey:  nodeKey.PublicKey().Bytes(),
+				WrappedKey: wrappedNodeKey,
+			}
+
+			for _, key := range st.Keys {
+				delete(key.PendingBoxes, k.cfg.NodeID)
+			}
+
+			modified = true
+		}
+
+		k.mu.Lock()
+		k.nodeKey, k.wrappedNodeKey = nodeKey, wrappedNodeKey
+		k.mu.Unlock()
+	}
+
+	switch publicKey := nodeKey.PublicKey().Bytes(); {
+	case node.Pending != nil && bytes.Equal(node.PublicKey, publicKey):
+		// the node key was recovered after all, the pending key is not needed anymore
+		node.Pending = nil
+
+		for _, key := range st.Keys {
+			delete(key.PendingBoxes, k.cfg.NodeID)
+		}
+
+		modified = true
+	case node.Pending != nil && bytes.Equal(node.Pending.PublicKey, publicKey) && pendingKeysBoxed(st, k.cfg.NodeID):
+		// all keys are boxed for the pending key, so it replaces the node key
+		node.PublicKey, node.WrappedKey, node.Pending = node.Pending.PublicKey, node.Pending.WrappedKey, nil
+
+		for _, key := range st.Keys {
+			if key.Boxes == nil {
+				key.Boxes = map[string][]byte{}
+			}
+
+			key.Boxes[k.cfg.NodeID] = key.PendingBoxes[k.cfg.NodeID]
+			delete(key.PendingBoxes, k.cfg.NodeID)
+		}
+
+		modified = true
+	}
+
+	if !modified && now.Sub(node.LastSeen) < nodeSeenRefreshInterval {
+		return false, nil
+	}
+
+	node.LastSeen = now
+
+	return true, nil
+}
+
+// newNodeKey generates and wraps a new node key.
+func (k *Keyring) newNodeKey(ctx context.Context) (*ecdh.PrivateKey, []byte, error) {
+	nodeKey, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wrappedNodeKey, err := k.cfg.Wrapper.Wrap(ctx, nodeKey.Bytes())
+	if err != nil {
+		return nil, nil, fmt.Errorf("error wrapping the node key: %w", err)
+	}
+
+	return nodeKey, wrappedNodeKey, nil
+}
+
+// recoverNodeKey unwraps the registered node key, or the pending node key if the registered one can't be recovered anymore.
+func (k *Keyring) recoverNodeKey(ctx context.Context, node *nodeState) (*ecdh.PrivateKey, []byte, error) {
+	nodeKey, err := k.unwrapNodeKey(ctx, node.PublicKey, node.WrappedKey)
+	if err == nil {
+		return nodeKey, node.WrappedKey, nil
+	}
+
+	if !errors.Is(err, ErrKeyUnrecoverable) || node.Pending == nil {
+		return nil, nil, err
+	}
+
+	nodeKey, err = k.unwrapNodeKey(ctx, node.Pending.PublicKey, node.Pending.WrappedKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return nodeKey, node.Pending.WrappedKey, nil
+}
+
+func (k *Keyring) unwrapNodeKey(ctx context.Context, publicKey, wrappedKey []byte) (*ecdh.PrivateKey, error) {
+	raw, err := k.cfg.Wrapper.Unwrap(ctx, wrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("error unwrapping the node key: %w", err)
+	}
+
+	nodeKey, err := ecdh.X25519().NewPrivateKey(raw)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing the node key: %w: %w", ErrKeyUnrecoverable, err)
+	}
+
+	if !bytes.Equal(publicKey, nodeKey.PublicKey().Bytes()) {
+		return nil, fmt.Errorf("node key doesn't match the registered public key: %w", ErrKeyUnrecoverable)
+	}
+
+	return nodeKey, nil
+}
+
+// hasKey returns true if the public key is the registered or the pending node key.
+func (node *nodeState) hasKey(publicKey []byte) bool {
+	return bytes.Equal(node.PublicKey, publicKey) || (node.Pending != nil && bytes.Equal(node.Pending.PublicKey, publicKey))
+}
+
+// otherNodeHoldsKeys returns true if each key is boxed for another node which is not re-registering its node key.
+func otherNodeHoldsKeys(st *state, nodeID string) bool {
+	for _, key := range st.Keys {
+		held := false
+
+		for otherID, other := range st.Nodes {
+			if _, ok := key.Boxes[otherID]; ok && otherID != nodeID && other.Pending == nil {
+				held = true
+
+				break
+			}
+		}
+
+		if !held {
+			return false
+		}
+	}
+
+	return true
+}
+
+// pendingKeysBoxed returns true if all keys are boxed for the pending node key.
+func pendingKeysBoxed(st *state, nodeID string) bool {
+	for _, key := range st.Keys {
+		if _, ok := key.PendingBoxes[nodeID]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// prune removes the nodes which didn't sync within the expiration period, and the keys which were superseded
+// longer than the retention period ago.
+func (k *Keyring) prune(st *state, now time.Time) bool {
+	modified := false
+
+	for nodeID, node := range st.Nodes {
+		if nodeID == k.cfg.NodeID || node.LastSeen.IsZero() || now.Sub(node.LastSeen) < k.cfg.NodeExpiration {
+			continue
+		}
+
+		delete(st.Nodes, nodeID)
+
+		for _, key := range st.Keys {
+			delete(key.Boxes, nodeID)
+			delete(key.PendingBoxes, nodeID)
+		}
+
+		modified = true
+	}
+
+	if k.cfg.KeyRetention == 0 {
+		return modified
+	}
+
+	// keys are ordered by the creation time, the key is superseded when the next key is created
+	for len(st.Keys) > 1 && now.Sub(st.Keys[1].Created) >= k.cfg.KeyRetention {
+		st.Keys = st.Keys[1:]
+
+		modified = true
+	}
+
+	return modified
+}
+
+// Encrypt encrypts the data with the current key encryption key.
+//
+// Encrypt returns the ciphertext and the ID of the key.
+func (k *Keyring) Encrypt(plaintext []byte) ([]byte, string, error) {
+	k.mu.RLock()
+	current := k.current
+	k.mu.RUnlock()
+
+	if current == nil {
+		return nil, "", ErrNoKey
+	}
+
+	ciphertext, err := seal(current.aead, plaintext, []byte(current.id))
+	if err != nil {
+		return nil, "", err
+	}
+
+	return ciphertext, current.id, nil
+}
+
+// Decrypt decrypts the data encrypted with the key encryption key keyID.
+//
+// If the key is not known, the keyring is synced first, as the key might have been just created by another node.
+func (k *Keyring) Decrypt(ctx context.Context, ciphertext []byte, keyID string) ([]byte, error) {
+	k.mu.RLock()
+	key := k.keys[keyID]
+	k.mu.RUnlock()
+
+	if key == nil {
+		if err := k.Sync(ctx); err != nil {
+			return nil, err
+		}
+
+		k.mu.RLock()
+		key = k.keys[keyID]
+		k.mu.RUnlock()
+	}
+
+	if key == nil {
+		return nil, fmt.Errorf("key %q not found", keyID)
+	}
+
+	return open(key.aead, ciphertext, []byte(keyID))
+}
+
+// Status returns the status of the keyring.
+func (k *Keyring) Status() KeyringStatus {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	status := KeyringStatus{
+		Healthy:  k.current != nil,
+		KeyCount: len(k.keys),
+		LastSync: k.lastSync,
+		Error:    k.lastErr,
+	}
+
+	if k.nodeKey != nil {
+		status.NodeKeyID = keyFingerprint(k.nodeKey.PublicKey().Bytes())
+	}
+
+	if k.current != nil {
+		status.KeyID = k.current.id
+		status.KeyCreated = k.current.created
+	}
+
+	return status
+}
+
+func lastKey(keys []*keyState) *keyState {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	return keys[len(keys)-1]
+}
+
+func generateKey() (string, []byte, error) {
+	id := make([]byte, 8)
+
+	if _, err := rand.Read(id); err != nil {
+		return "", nil, err
+	}
+
+	key := make([]byte, 32)
+
+	if _, err := rand.Read(key); err != nil {
+		return "", nil, err
+	}
+
+	return hex.EncodeToString(id), key, nil
+}
+
+// boxKey boxes the key for the node, unless it is boxed already.
+func boxKey(boxes map[string][]byte, keyID string, raw []byte, nodeID string, nodePublicKey []byte) (bool, error) {
+	if _, ok := boxes[nodeID]; ok {
+		return false, nil
+	}
+
+	publicKey, err := ecdh.X25519().NewPublicKey(nodePublicKey)
+	if err != nil {
+		return false, fmt.Errorf("error parsing public key of node %q: %w", nodeID, err)
+	}
+
+	boxes[nodeID], err = sealBox(publicKey, raw, boxAdditionalData(keyID, nodeID))
+	if err != nil {
+		return false, fmt.Errorf("error boxing key %q for node %q: %w", keyID, nodeID, err)
+	}
+
+	return true, nil
+}
+
+func boxAdditionalData(keyID, nodeID string) []byte {
+	return []byte(keyID + "/" + nodeID)
+}
+
+func keyFingerprint(publicKey []byte) string {
+	sum := sha256.Sum256(publicKey)
+
+	return hex.EncodeToString(sum[:8])
+}
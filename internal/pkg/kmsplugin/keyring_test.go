@@ -0,0 +1,476 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kmsplugin_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/kmsplugin"
+)
+
+type memoryStore struct {
+	mu       sync.Mutex
+	data     []byte
+	revision int64
+}
+
+func (s *memoryStore) Get(context.Context) ([]byte, int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.data), s.revision, nil
+}
+
+func (s *memoryStore) CompareAndSwap(_ context.Context, data []byte, revision int64) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.revision != revision {
+		return false, nil
+	}
+
+	s.data = slices.Clone(data)
+	s.revision++
+
+	return true, nil
+}
+
+// xorWrapper is a fake wrapper which "protects" the key with a static mask.
+type xorWrapper struct {
+	provider string
+	mask     byte
+}
+
+func (w xorWrapper) Provider() string { return w.provider }
+
+func (w xorWrapper) Wrap(_ context.Context, key []byte) ([]byte, error) {
+	return w.xor(key), nil
+}
+
+func (w xorWrapper) Unwrap(_ context.Context, wrapped []byte) ([]byte, error) {
+	return w.xor(wrapped), nil
+}
+
+func (w xorWrapper) xor(data []byte) []byte {
+	out := make([]byte, len(data))
+
+	for i := range data {
+		out[i] = data[i] ^ w.mask
+	}
+
+	return out
+}
+
+// sealedWrapper is a fake wrapper which can't unwrap the keys wrapped before, e.g. after the TPM PCR values changed.
+type sealedWrapper struct {
+	xorWrapper
+
+	wrapped [][]byte
+}
+
+func (w *sealedWrapper) Wrap(ctx context.Context, key []byte) ([]byte, error) {
+	wrapped, err := w.xorWrapper.Wrap(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	w.wrapped = append(w.wrapped, wrapped)
+
+	return wrapped, nil
+}
+
+// Unwrap unwraps only the keys wrapped by this instance.
+func (w *sealedWrapper) Unwrap(ctx context.Context, wrapped []byte) ([]byte, error) {
+	if !slices.ContainsFunc(w.wrapped, func(b []byte) bool { return bytes.Equal(b, wrapped) }) {
+		return nil, fmt.Errorf("policy check failed: %w", kmsplugin.ErrKeyUnrecoverable)
+	}
+
+	return w.xorWrapper.Unwrap(ctx, wrapped)
+}
+
+// failingWrapper is a fake wrapper which fails to unwrap the keys, e.g. when the KMS endpoint is not reachable.
+type failingWrapper struct {
+	xorWrapper
+}
+
+func (w failingWrapper) Unwrap(context.Context, []byte) ([]byte, error) {
+	return nil, errors.New("connection refused")
+}
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+func newKeyring(store kmsplugin.Store, clock *fakeClock, nodeID string) *kmsplugin.Keyring {
+	return newKeyringWithConfig(store, clock, nodeID, kmsplugin.KeyringConfig{})
+}
+
+func newKeyringWithConfig(store kmsplugin.Store, clock *fakeClock, nodeID string, cfg kmsplugin.KeyringConfig) *kmsplugin.Keyring {
+	cfg.Store = store
+	cfg.NodeID = nodeID
+	cfg.RotationInterval = 24 * time.Hour
+	cfg.Now = clock.Now
+
+	if cfg.Wrapper == nil {
+		cfg.Wrapper = xorWrapper{provider: "fake", mask: 0x5a}
+	}
+
+	return kmsplugin.NewKeyring(cfg)
+}
+
+func TestKeyringSharedKey(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	store := &memoryStore{}
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	node1 := newKeyring(store, clock, "node1")
+
+	_, _, err := node1.Encrypt([]byte("secret"))
+	require.ErrorIs(t, err, kmsplugin.ErrNoKey)
+
+	require.NoError(t, node1.Sync(ctx))
+
+	status1 := node1.Status()
+	assert.True(t, status1.Healthy)
+	assert.NotEmpty(t, status1.KeyID)
+	assert.NotEmpty(t, status1.NodeKeyID)
+	assert.Equal(t, 1, status1.KeyCount)
+
+	// node2 joins, it doesn't have the key until node1 boxes it
+	node2 := newKeyring(store, clock, "node2")
+	require.NoError(t, node2.Sync(ctx))
+	assert.False(t, node2.Status().Healthy)
+
+	require.NoError(t, node1.Sync(ctx))
+	require.NoError(t, node2.Sync(ctx))
+
+	status2 := node2.Status()
+	assert.True(t, status2.Healthy)
+	assert.Equal(t, status1.KeyID, status2.KeyID)
+	assert.NotEqual(t, status1.NodeKeyID, status2.NodeKeyID)
+
+	ciphertext, keyID, err := node1.Encrypt([]byte("secret"))
+	require.NoError(t, err)
+	assert.Equal(t, status1.KeyID, keyID)
+
+	plaintext, err := node2.Decrypt(ctx, ciphertext, keyID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("secret"), plaintext)
+
+	// key ID is authenticated
+	_, err = node2.Decrypt(ctx, ciphertext, "0000000000000000")
+	require.Error(t, err)
+}
+
+func TestKeyringRotation(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	store := &memoryStore{}
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	node1 := newKeyring(store, clock, "node1")
+	require.NoError(t, node1.Sync(ctx))
+
+	oldCiphertext, oldKeyID, err := node1.Encrypt([]byte("old"))
+	require.NoError(t, err)
+
+	clock.Advance(time.Hour)
+	require.NoError(t, node1.Sync(ctx))
+	assert.Equal(t, oldKeyID, node1.Status().KeyID)
+
+	clock.Advance(24 * time.Hour)
+	require.NoError(t, node1.Sync(ctx))
+
+	status := node1.Status()
+	assert.NotEqual(t, oldKeyID, status.KeyID)
+	assert.Equal(t, 2, status.KeyCount)
+	assert.Equal(t, clock.Now(), status.KeyCreated)
+
+	// old data is still readable
+	plaintext, err := node1.Decrypt(ctx, oldCiphertext, oldKeyID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("old"), plaintext)
+
+	// a node which is restarted recovers all keys from the store
+	restarted := newKeyring(store, clock, "node1")
+	require.NoError(t, restarted.Sync(ctx))
+	assert.Equal(t, status.KeyID, restarted.Status().KeyID)
+	assert.Equal(t, status.NodeKeyID, restarted.Status().NodeKeyID)
+
+	plaintext, err = restarted.Decrypt(ctx, oldCiphertext, oldKeyID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("old"), plaintext)
+}
+
+func TestKeyringDecryptSyncsUnknownKey(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	store := &memoryStore{}
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	node1 := newKeyring(store, clock, "node1")
+	node2 := newKeyring(store, clock, "node2")
+
+	require.NoError(t, node1.Sync(ctx))
+	require.NoError(t, node2.Sync(ctx))
+	require.NoError(t, node1.Sync(ctx))
+	require.NoError(t, node2.Sync(ctx))
+
+	// node1 rotates the key, node2 hasn't synced yet
+	clock.Advance(25 * time.Hour)
+	require.NoError(t, node1.Sync(ctx))
+
+	ciphertext, keyID, err := node1.Encrypt([]byte("new"))
+	require.NoError(t, err)
+	assert.NotEqual(t, keyID, node2.Status().KeyID)
+
+	plaintext, err := node2.Decrypt(ctx, ciphertext, keyID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("new"), plaintext)
+	assert.Equal(t, keyID, node2.Status().KeyID)
+}
+
+func TestKeyringProviderMismatch(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	store := &memoryStore{}
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	require.NoError(t, newKeyring(store, clock, "node1").Sync(ctx))
+
+	other := kmsplugin.NewKeyring(kmsplugin.KeyringConfig{
+		Store:            store,
+		Wrapper:          xorWrapper{provider: "other", mask: 0x5a},
+		NodeID:           "node1",
+		RotationInterval: 24 * time.Hour,
+		Now:              clock.Now,
+	})
+
+	err := other.Sync(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `node key is protected by the "fake" provider, but "other" is configured`)
+	assert.Equal(t, err, other.Status().Error)
+}
+
+func TestKeyringConcurrentSync(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	store := &memoryStore{}
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	keyrings := []*kmsplugin.Keyring{
+		newKeyring(store, clock, "node1"),
+		newKeyring(store, clock, "node2"),
+		newKeyring(store, clock, "node3"),
+	}
+
+	// register all nodes, box the key for everyone, pick up the boxed key
+	for range 3 {
+		var wg sync.WaitGroup
+
+		for _, keyring := range keyrings {
+			wg.Go(func() {
+				assert.NoError(t, keyring.Sync(ctx))
+			})
+		}
+
+		wg.Wait()
+	}
+
+	// only a single key is generated, and it's shared by all nodes
+	keyID := keyrings[0].Status().KeyID
+
+	for _, keyring := range keyrings {
+		status := keyring.Status()
+
+		assert.True(t, status.Healthy)
+		assert.Equal(t, keyID, status.KeyID)
+		assert.Equal(t, 1, status.KeyCount)
+	}
+}
+
+func TestKeyringReregisterOnUnwrapFailure(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	store := &memoryStore{}
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	node1 := newKeyring(store, clock, "node1")
+	node2 := newKeyring(store, clock, "node2")
+
+	for range 2 {
+		require.NoError(t, node1.Sync(ctx))
+		require.NoError(t, node2.Sync(ctx))
+	}
+
+	ciphertext, keyID, err := node1.Encrypt([]byte("secret"))
+	require.NoError(t, err)
+
+	// node2 is restarted, and its node key can't be unwrapped anymore
+	restarted := newKeyringWithConfig(store, clock, "node2", kmsplugin.KeyringConfig{
+		Wrapper: &sealedWrapper{xorWrapper: xorWrapper{provider: "fake", mask: 0x5a}},
+	})
+	require.NoError(t, restarted.Sync(ctx))
+
+	status := restarted.Status()
+	assert.False(t, status.Healthy)
+	assert.NotEqual(t, node2.Status().NodeKeyID, status.NodeKeyID)
+
+	// the boxes for the previous node key are kept until the keys are boxed for the new node key
+	assert.Contains(t, string(store.data), `"pending"`)
+	assert.Contains(t, string(store.data), `"node2"`)
+
+	// node1 boxes the keys for the new node key
+	require.NoError(t, node1.Sync(ctx))
+	require.NoError(t, restarted.Sync(ctx))
+
+	assert.True(t, restarted.Status().Healthy)
+	assert.NotContains(t, string(store.data), `"pending"`)
+
+	plaintext, err := restarted.Decrypt(ctx, ciphertext, keyID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("secret"), plaintext)
+
+	// the new node key is used after the restart
+	restartedAgain := newKeyring(store, clock, "node2")
+	require.NoError(t, restartedAgain.Sync(ctx))
+	assert.True(t, restartedAgain.Status().Healthy)
+	assert.Equal(t, restarted.Status().NodeKeyID, restartedAgain.Status().NodeKeyID)
+}
+
+func TestKeyringUnwrapFailureSingleNode(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	store := &memoryStore{}
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	require.NoError(t, newKeyring(store, clock, "node1").Sync(ctx))
+
+	stateBefore := slices.Clone(store.data)
+
+	// no other node holds the keys, so the node is not re-registered
+	restarted := newKeyringWithConfig(store, clock, "node1", kmsplugin.KeyringConfig{
+		Wrapper: &sealedWrapper{xorWrapper: xorWrapper{provider: "fake", mask: 0x5a}},
+	})
+
+	err := restarted.Sync(ctx)
+	require.ErrorIs(t, err, kmsplugin.ErrKeyUnrecoverable)
+	assert.False(t, restarted.Status().Healthy)
+	assert.Equal(t, stateBefore, store.data)
+
+	// the node recovers once the key can be unwrapped again
+	recovered := newKeyring(store, clock, "node1")
+	require.NoError(t, recovered.Sync(ctx))
+	assert.True(t, recovered.Status().Healthy)
+}
+
+func TestKeyringTransientUnwrapFailure(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	store := &memoryStore{}
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	node1 := newKeyring(store, clock, "node1")
+	node2 := newKeyring(store, clock, "node2")
+
+	for range 2 {
+		require.NoError(t, node1.Sync(ctx))
+		require.NoError(t, node2.Sync(ctx))
+	}
+
+	stateBefore := slices.Clone(store.data)
+
+	// transient failures are returned, the node is not re-registered
+	restarted := newKeyringWithConfig(store, clock, "node2", kmsplugin.KeyringConfig{
+		Wrapper: failingWrapper{xorWrapper{provider: "fake", mask: 0x5a}},
+	})
+
+	err := restarted.Sync(ctx)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, kmsplugin.ErrKeyUnrecoverable)
+	assert.Equal(t, stateBefore, store.data)
+}
+
+func TestKeyringPrune(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	store := &memoryStore{}
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	cfg := kmsplugin.KeyringConfig{
+		KeyRetention:   48 * time.Hour,
+		NodeExpiration: 72 * time.Hour,
+	}
+
+	node1 := newKeyringWithConfig(store, clock, "node1", cfg)
+	node2 := newKeyringWithConfig(store, clock, "node2", cfg)
+
+	for range 2 {
+		require.NoError(t, node1.Sync(ctx))
+		require.NoError(t, node2.Sync(ctx))
+	}
+
+	oldCiphertext, oldKeyID, err := node1.Encrypt([]byte("old"))
+	require.NoError(t, err)
+
+	// node2 departs, node1 keeps rotating the key
+	for range 3 {
+		clock.Advance(24 * time.Hour)
+		require.NoError(t, node1.Sync(ctx))
+	}
+
+	// the first key was superseded 48h ago, so it is retired
+	status := node1.Status()
+	assert.Equal(t, 3, status.KeyCount)
+
+	_, err = node1.Decrypt(ctx, oldCiphertext, oldKeyID)
+	require.Error(t, err)
+
+	// node2 didn't sync within the expiration period, so it is removed
+	assert.NotContains(t, string(store.data), `"node2"`)
+
+	// node2 is back after the expiration, it is registered again and gets the keys boxed
+	require.NoError(t, node2.Sync(ctx))
+	require.NoError(t, node1.Sync(ctx))
+	require.NoError(t, node2.Sync(ctx))
+
+	assert.True(t, node2.Status().Healthy)
+	assert.Equal(t, node1.Status().KeyID, node2.Status().KeyID)
+}
@@ -0,0 +1,119 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kmsplugin
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"time"
+
+	"github.com/siderolabs/kms-client/api/kms"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/siderolabs/talos/internal/pkg/endpoint"
+	"github.com/siderolabs/talos/pkg/httpdefaults"
+	"github.com/siderolabs/talos/pkg/machinery/client/dialer"
+)
+
+// KMSWrapper seals the node key using the KMS endpoint.
+type KMSWrapper struct {
+	kmsEndpoint string
+	nodeUUID    string
+}
+
+// NewKMSWrapper creates a new KMSWrapper.
+func NewKMSWrapper(kmsEndpoint, nodeUUID string) *KMSWrapper {
+	return &KMSWrapper{
+		kmsEndpoint: kmsEndpoint,
+		nodeUUID:    nodeUUID,
+	}
+}
+
+// Provider implements KeyWrapper interface.
+func (w *KMSWrapper) Provider() string {
+	return "kms"
+}
+
+// Wrap implements KeyWrapper interface.
+func (w *KMSWrapper) Wrap(ctx context.Context, key []byte) ([]byte, error) {
+	var data []byte
+
+	if err := w.withClient(ctx, func(ctx context.Context, client kms.KMSServiceClient) error {
+		resp, err := client.Seal(ctx, &kms.Request{
+			NodeUuid: w.nodeUUID,
+			Data:     key,
+		})
+		if err != nil {
+			return err
+		}
+
+		data = resp.Data
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to seal the key: %w", err)
+	}
+
+	return data, nil
+}
+
+// Unwrap implements KeyWrapper interface.
+func (w *KMSWrapper) Unwrap(ctx context.Context, wrapped []byte) ([]byte, error) {
+	var data []byte
+
+	if err := w.withClient(ctx, func(ctx context.Context, client kms.KMSServiceClient) error {
+		resp, err := client.Unseal(ctx, &kms.Request{
+			NodeUuid: w.nodeUUID,
+			Data:     wrapped,
+		})
+		if err != nil {
+			return err
+		}
+
+		data = resp.Data
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to unseal the key: %w", err)
+	}
+
+	return data, nil
+}
+
+func (w *KMSWrapper) withClient(ctx context.Context, f func(context.Context, kms.KMSServiceClient) error) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	var transportCredentials credentials.TransportCredentials
+
+	endpoint, err := endpoint.Parse(w.kmsEndpoint)
+	if err != nil {
+		return err
+	}
+
+	if endpoint.Insecure {
+		transportCredentials = insecure.NewCredentials()
+	} else {
+		transportCredentials = credentials.NewTLS(&tls.Config{
+			RootCAs: httpdefaults.RootCAs(),
+		})
+	}
+
+	conn, err := grpc.NewClient(
+		endpoint.Host,
+		grpc.WithTransportCredentials(transportCredentials),
+		grpc.WithContextDialer(dialer.DynamicProxyDialerWithTLSConfig(httpdefaults.RootCAsTLSConfig)),
+	)
+	if err != nil {
+		return fmt.Errorf("error dialing KMS endpoint %q: %w", w.kmsEndpoint, err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	return f(ctx, kms.NewKMSServiceClient(conn))
+}
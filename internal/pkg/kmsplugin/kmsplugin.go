@@ -0,0 +1,40 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package kmsplugin implements the Talos-managed Kubernetes KMS v2 plugin.
+//
+// The plugin wraps data encryption keys (DEKs) generated by kube-apiserver with the key encryption key (KEK).
+// KEKs are shared between all control plane nodes via etcd: each KEK is stored encrypted (boxed) to the node key
+// of every registered control plane node.
+// The node key (X25519) is stored in etcd as well, but wrapped with the KMS endpoint or sealed with the TPM,
+// so that only the node itself can use it.
+package kmsplugin
+
+import (
+	"context"
+)
+
+// KeyWrapper protects the node key.
+type KeyWrapper interface {
+	// Provider returns the name of the wrapper (e.g. "tpm").
+	Provider() string
+	// Wrap protects the key.
+	Wrap(ctx context.Context, key []byte) ([]byte, error)
+	// Unwrap recovers the key protected with Wrap.
+	//
+	// If the key can never be recovered (as opposed to a transient failure), the error wraps ErrKeyUnrecoverable.
+	Unwrap(ctx context.Context, wrapped []byte) ([]byte, error)
+}
+
+// Store is a cluster-wide storage for the plugin state.
+type Store interface {
+	// Get returns the stored state and its revision.
+	//
+	// If the state is not stored yet, Get returns nil data and zero revision.
+	Get(ctx context.Context) ([]byte, int64, error)
+	// CompareAndSwap stores the state if the stored revision matches the passed revision.
+	//
+	// CompareAndSwap returns false if the revision doesn't match.
+	CompareAndSwap(ctx context.Context, data []byte, revision int64) (bool, error)
+}
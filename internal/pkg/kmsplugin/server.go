@@ -0,0 +1,91 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kmsplugin
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/internal/pkg/kmsplugin/api"
+)
+
+// APIVersion is the version of the KMS plugin API.
+const APIVersion = "v2"
+
+// HealthzOK is the healthz value reported when the plugin is healthy.
+const HealthzOK = "ok"
+
+// Server implements the KMS v2 plugin API.
+type Server struct {
+	api.UnimplementedKeyManagementServiceServer
+
+	keyring *Keyring
+	logger  *zap.Logger
+}
+
+// NewServer creates a new Server.
+func NewServer(keyring *Keyring, logger *zap.Logger) *Server {
+	return &Server{
+		keyring: keyring,
+		logger:  logger,
+	}
+}
+
+// Status implements api.KeyManagementServiceServer.
+func (srv *Server) Status(context.Context, *api.StatusRequest) (*api.StatusResponse, error) {
+	keyringStatus := srv.keyring.Status()
+
+	healthz := HealthzOK
+
+	switch {
+	case !keyringStatus.Healthy && keyringStatus.Error != nil:
+		healthz = keyringStatus.Error.Error()
+	case !keyringStatus.Healthy:
+		healthz = ErrNoKey.Error()
+	}
+
+	return &api.StatusResponse{
+		Version: APIVersion,
+		Healthz: healthz,
+		KeyId:   keyringStatus.KeyID,
+	}, nil
+}
+
+// Encrypt implements api.KeyManagementServiceServer.
+func (srv *Server) Encrypt(_ context.Context, req *api.EncryptRequest) (*api.EncryptResponse, error) {
+	ciphertext, keyID, err := srv.keyring.Encrypt(req.Plaintext)
+	if err != nil {
+		srv.logger.Warn("failed to encrypt", zap.String("uid", req.Uid), zap.Error(err))
+
+		if errors.Is(err, ErrNoKey) {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &api.EncryptResponse{
+		Ciphertext: ciphertext,
+		KeyId:      keyID,
+	}, nil
+}
+
+// Decrypt implements api.KeyManagementServiceServer.
+func (srv *Server) Decrypt(ctx context.Context, req *api.DecryptRequest) (*api.DecryptResponse, error) {
+	plaintext, err := srv.keyring.Decrypt(ctx, req.Ciphertext, req.KeyId)
+	if err != nil {
+		srv.logger.Warn("failed to decrypt", zap.String("uid", req.Uid), zap.String("key_id", req.KeyId), zap.Error(err))
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &api.DecryptResponse{
+		Plaintext: plaintext,
+	}, nil
+}
@@ -0,0 +1,54 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kmsplugin
+
+import (
+	"context"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// EtcdStore stores the plugin state in etcd under a single key.
+type EtcdStore struct {
+	client *clientv3.Client
+	key    string
+}
+
+// NewEtcdStore creates a new EtcdStore.
+func NewEtcdStore(client *clientv3.Client, key string) *EtcdStore {
+	return &EtcdStore{
+		client: client,
+		key:    key,
+	}
+}
+
+// Get implements Store.
+func (s *EtcdStore) Get(ctx context.Context) ([]byte, int64, error) {
+	resp, err := s.client.Get(ctx, s.key)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if len(resp.Kvs) == 0 {
+		return nil, 0, nil
+	}
+
+	return resp.Kvs[0].Value, resp.Kvs[0].ModRevision, nil
+}
+
+// CompareAndSwap implements Store.
+//
+// Zero revision matches the missing key.
+func (s *EtcdStore) CompareAndSwap(ctx context.Context, data []byte, revision int64) (bool, error) {
+	resp, err := s.client.Txn(ctx).
+		If(clientv3.Compare(clientv3.ModRevision(s.key), "=", revision)).
+		Then(clientv3.OpPut(s.key, string(data))).
+		Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return resp.Succeeded, nil
+}
@@ -0,0 +1,61 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kmsplugin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/siderolabs/talos/internal/pkg/secureboot/tpm2"
+)
+
+// TPMWrapper seals the node key with the TPM.
+//
+// The key is sealed to the current values of the PCRs (without the signed PCR policy),
+// so that it can be unsealed while Talos is running.
+type TPMWrapper struct {
+	pcrs []int
+}
+
+// NewTPMWrapper creates a new TPMWrapper.
+func NewTPMWrapper(pcrs []int) *TPMWrapper {
+	return &TPMWrapper{
+		pcrs: slices.Clone(pcrs),
+	}
+}
+
+// Provider implements KeyWrapper interface.
+func (w *TPMWrapper) Provider() string {
+	return "tpm"
+}
+
+// Wrap implements KeyWrapper interface.
+func (w *TPMWrapper) Wrap(_ context.Context, key []byte) ([]byte, error) {
+	sealed, err := tpm2.SealToPCRs(key, w.pcrs)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(sealed)
+}
+
+// Unwrap implements KeyWrapper interface.
+func (w *TPMWrapper) Unwrap(_ context.Context, wrapped []byte) ([]byte, error) {
+	var sealed tpm2.SealedResponse
+
+	if err := json.Unmarshal(wrapped, &sealed); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrKeyUnrecoverable, err)
+	}
+
+	key, err := tpm2.Unseal(sealed)
+	if err != nil && errors.Is(err, tpm2.ErrSealingPolicyMismatch) {
+		return nil, fmt.Errorf("%w: %w", ErrKeyUnrecoverable, err)
+	}
+
+	return key, err
+}
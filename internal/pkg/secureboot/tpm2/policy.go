@@ -65,14 +65,18 @@ type SealingPolicyDigestInfo struct {
 }
 
 // CalculateSealingPolicyDigest calculates the sealing policy digest for a given public key and PCRs.
+//
+// If the public key is empty, the policy is bound only to the PCR values.
 func CalculateSealingPolicyDigest(t transport.TPM, spInfo SealingPolicyDigestInfo) ([]byte, error) {
 	calculator, err := tpm2.NewPolicyCalculator(tpm2.TPMAlgSHA256)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create policy calculator: %v", err)
 	}
 
-	if err := calculatePolicyAuthorize(calculator, spInfo.PublicKey); err != nil {
-		return nil, fmt.Errorf("failed to calculate policy authorize: %v", err)
+	if spInfo.PublicKey != "" {
+		if err := calculatePolicyAuthorize(calculator, spInfo.PublicKey); err != nil {
+			return nil, fmt.Errorf("failed to calculate policy authorize: %v", err)
+		}
 	}
 
 	if len(spInfo.PCRs) == 0 {
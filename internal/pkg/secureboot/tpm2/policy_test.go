@@ -68,3 +68,34 @@ func TestCalculateSealingPolicyDigestWithPCRs(t *testing.T) {
 
 	require.Equal(t, []byte{0x14, 0xff, 0x86, 0xf8, 0x6, 0x9c, 0xe4, 0xf2, 0xc9, 0x18, 0x32, 0x5e, 0x2e, 0x1b, 0x78, 0x11, 0xcf, 0xc5, 0xe6, 0x27, 0x8d, 0xd1, 0xb, 0x86, 0xa9, 0x4, 0x16, 0xc2, 0x8f, 0xe6, 0x47, 0x6a}, calculated) //nolint:lll
 }
+
+func TestCalculateSealingPolicyDigestWithoutPublicKey(t *testing.T) {
+	t.Parallel()
+
+	pcrValue := []byte{0x9c, 0x9c, 0x10, 0x58, 0x77, 0x9d, 0x2b, 0xf6, 0x30, 0x1b, 0x56, 0x8, 0x5b, 0x26, 0xe9, 0xae, 0x98, 0x62, 0x2e, 0x1f, 0xa7, 0x3e, 0xad, 0xd9, 0x8b, 0x9c, 0xa3, 0xa1, 0x8, 0x29, 0xc1, 0x9c} //nolint:lll
+
+	calculated, err := tpm2internal.CalculateSealingPolicyDigest(nil, tpm2internal.SealingPolicyDigestInfo{
+		PCRs: []int{7},
+		ReadPCRFunc: func(t transport.TPM, pcr int) ([]byte, error) {
+			return pcrValue, nil
+		},
+	})
+
+	require.NoError(t, err)
+
+	pcrSelector, err := tpm2internal.CreateSelector([]int{7})
+	require.NoError(t, err)
+
+	// without the public key, the policy is just the PolicyPCR over the PCR values
+	expected, err := tpm2internal.CalculatePolicy(pcrValue, tpm2.TPMLPCRSelection{
+		PCRSelections: []tpm2.TPMSPCRSelection{
+			{
+				Hash:      tpm2.TPMAlgSHA256,
+				PCRSelect: pcrSelector,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, expected, calculated)
+}
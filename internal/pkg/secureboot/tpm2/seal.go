@@ -15,7 +15,21 @@ import (
 )
 
 // Seal seals the key using TPM2.0.
+//
+// The key is bound to the signed PCR policy of the UKI, and can only be unsealed during the boot phases which are signed.
 func Seal(key []byte, tpmPCRs []int) (*SealedResponse, error) {
+	return seal(key, tpmPCRs, constants.PCRPublicKey)
+}
+
+// SealToPCRs seals the key using TPM2.0 bound only to the current values of the PCRs.
+//
+// Unlike Seal, the key can be unsealed at any point after the boot as long as the PCR values don't change.
+func SealToPCRs(key []byte, tpmPCRs []int) (*SealedResponse, error) {
+	return seal(key, tpmPCRs, "")
+}
+
+//nolint:gocyclo
+func seal(key []byte, tpmPCRs []int, pcrPublicKey string) (*SealedResponse, error) {
 	t, err := tpm.Open()
 	if err != nil {
 		return nil, err
@@ -28,7 +42,7 @@ func Seal(key []byte, tpmPCRs []int) (*SealedResponse, error) {
 	}
 
 	sealingPolicyDigest, err := CalculateSealingPolicyDigest(t, SealingPolicyDigestInfo{
-		PublicKey:   constants.PCRPublicKey,
+		PublicKey:   pcrPublicKey,
 		PCRs:        tpmPCRs,
 		ReadPCRFunc: ReadPCR,
 	})
@@ -117,10 +131,13 @@ func Seal(key []byte, tpmPCRs []int) (*SealedResponse, error) {
 		KeyName:           tpm2.Marshal(createPrimaryResponse.Name),
 		PolicyDigest:      sealingPolicyDigest,
 		PCRs:              tpmPCRs,
-		PubKeyPCRs:        []int{constants.UKIPCR},
 		EncryptionVersion: EncryptionSchemaVersionErrata,
 		Alg:               "sha256",
 	}
 
+	if pcrPublicKey != "" {
+		resp.PubKeyPCRs = []int{constants.UKIPCR}
+	}
+
 	return &resp, nil
 }
@@ -5,6 +5,12 @@
 // Package tpm2 provides TPM2.0 related functionality helpers.
 package tpm2
 
+import "errors"
+
+// ErrSealingPolicyMismatch is returned by Unseal when the blob can't be unsealed in the current TPM state:
+// the PCR values changed since the blob was sealed, or the TPM storage root key is different.
+var ErrSealingPolicyMismatch = errors.New("sealing policy mismatch")
+
 const (
 	// EncryptionSchemaVersionErrata is the errata for the encryption schema version.
 	// Talos versions older than 1.12 locked to PCR 7 and PCR 11 but the luks json header only
@@ -81,7 +81,7 @@ func Unseal(sealed SealedResponse) ([]byte, error) {
 	if !bytes.Equal(createPrimaryResponse.Name.Buffer, srk.Buffer) {
 		// this means the srk name does not match, possibly due to a different TPM or tpm was reset
 		// could also mean the disk was used on a different machine
-		return nil, fmt.Errorf("srk name does not match, expected %x, got %x", srk.Buffer, createPrimaryResponse.Name.Buffer)
+		return nil, fmt.Errorf("%w: srk name does not match, expected %x, got %x", ErrSealingPolicyMismatch, srk.Buffer, createPrimaryResponse.Name.Buffer)
 	}
 
 	load := tpm2.Load{
@@ -115,9 +115,44 @@ func Unseal(sealed SealedResponse) ([]byte, error) {
 
 	defer policyCloseFunc() //nolint:errcheck
 
+	if sealed.EncryptionVersion != "" && len(sealed.PubKeyPCRs) == 0 {
+		// sealed without the signed PCR policy (see SealToPCRs), so only the PCR values are verified
+		if err = validatePCRPolicyDigest(t, policySess.Handle(), sealed.PCRs, sealed.PolicyDigest); err != nil {
+			return nil, fmt.Errorf("failed to validate PCR policy digest for PCRs %v: %w", sealed.PCRs, err)
+		}
+	} else if err = authorizeSignedPCRPolicy(t, policySess.Handle(), sealed); err != nil {
+		return nil, err
+	}
+
+	unsealOp := tpm2.Unseal{
+		ItemHandle: tpm2.AuthHandle{
+			Handle: loadResponse.ObjectHandle,
+			Name:   loadResponse.Name,
+			Auth:   policySess,
+		},
+	}
+
+	unsealResponse, err := unsealOp.Execute(t, tpm2.HMAC(
+		tpm2.TPMAlgSHA256,
+		h.Size(),
+		tpm2.Salted(createPrimaryResponse.ObjectHandle, *outPub),
+		tpm2.AESEncryption(128, tpm2.EncryptOut),
+		tpm2.Bound(loadResponse.ObjectHandle, loadResponse.Name, nil),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to unseal op: %w", err)
+	}
+
+	return unsealResponse.OutData.Buffer, nil
+}
+
+// authorizeSignedPCRPolicy satisfies the PolicyAuthorize part of the sealing policy using the PCR signature of the UKI.
+//
+//nolint:gocyclo
+func authorizeSignedPCRPolicy(t transport.TPM, handle tpm2.TPMHandle, sealed SealedResponse) error {
 	pubKey, err := ParsePCRSigningPubKey(constants.PCRPublicKey)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	loadExternal := tpm2.LoadExternal{
@@ -127,7 +162,7 @@ func Unseal(sealed SealedResponse) ([]byte, error) {
 
 	loadExternalResponse, err := loadExternal.Execute(t)
 	if err != nil {
-		return nil, fmt.Errorf("failed to load external key: %w", err)
+		return fmt.Errorf("failed to load external key: %w", err)
 	}
 
 	defer func() {
@@ -143,10 +178,10 @@ func Unseal(sealed SealedResponse) ([]byte, error) {
 
 	pcrSelector, err := CreateSelector([]int{constants.UKIPCR})
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	policyDigest, err := PolicyPCRDigest(t, policySess.Handle(), tpm2.TPMLPCRSelection{
+	policyDigest, err := PolicyPCRDigest(t, handle, tpm2.TPMLPCRSelection{
 		PCRSelections: []tpm2.TPMSPCRSelection{
 			{
 				Hash:      tpm2.TPMAlgSHA256,
@@ -155,12 +190,12 @@ func Unseal(sealed SealedResponse) ([]byte, error) {
 		},
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve policy digest: %w", err)
+		return fmt.Errorf("failed to retrieve policy digest: %w", err)
 	}
 
 	sigJSON, err := ParsePCRSignature()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	pubKeyFingerprint := sha256.Sum256(x509.MarshalPKCS1PublicKey(pubKey))
@@ -171,14 +206,14 @@ func Unseal(sealed SealedResponse) ([]byte, error) {
 	for _, bank := range sigJSON.SHA256 {
 		digest, decodeErr := hex.DecodeString(bank.Pol)
 		if decodeErr != nil {
-			return nil, decodeErr
+			return decodeErr
 		}
 
 		if bytes.Equal(digest, policyDigest.Buffer) {
 			signature = bank.Sig
 
 			if hex.EncodeToString(pubKeyFingerprint[:]) != bank.PKFP {
-				return nil, errors.New("certificate fingerprint does not match")
+				return errors.New("certificate fingerprint does not match")
 			}
 
 			break
@@ -186,12 +221,12 @@ func Unseal(sealed SealedResponse) ([]byte, error) {
 	}
 
 	if signature == "" {
-		return nil, errors.New("no signatures matching PCR SHA256 digest found in signature JSON")
+		return errors.New("no signatures matching PCR SHA256 digest found in signature JSON")
 	}
 
 	signatureDecoded, err := base64.StdEncoding.DecodeString(signature)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Verify will only verify the RSA part of the RSA+SHA256 signature,
@@ -216,18 +251,18 @@ func Unseal(sealed SealedResponse) ([]byte, error) {
 
 	verifySignatureResponse, err := verifySignature.Execute(t)
 	if err != nil {
-		return nil, fmt.Errorf("failed to verify signature: %w", err)
+		return fmt.Errorf("failed to verify signature: %w", err)
 	}
 
 	policyAuthorize := tpm2.PolicyAuthorize{
-		PolicySession:  policySess.Handle(),
+		PolicySession:  handle,
 		ApprovedPolicy: *policyDigest,
 		KeySign:        loadExternalResponse.Name,
 		CheckTicket:    verifySignatureResponse.Validation,
 	}
 
 	if _, err = policyAuthorize.Execute(t); err != nil {
-		return nil, fmt.Errorf("failed to execute policy authorize: %w", err)
+		return fmt.Errorf("failed to execute policy authorize: %w", err)
 	}
 
 	// this handles the case when talos is upgraded from pre Talos 1.12 and we had PCRs field
@@ -236,39 +271,20 @@ func Unseal(sealed SealedResponse) ([]byte, error) {
 	// since both EncryptionVersion and PubKeyPCRs are introduced for Talos 1.12+ only
 	if sealed.EncryptionVersion == "" && len(sealed.PubKeyPCRs) == 0 {
 		if len(sealed.PCRs) == 1 && sealed.PCRs[0] == constants.UKIPCR {
-			if err := validatePCRPolicyDigest(t, policySess.Handle(), []int{constants.SecureBootStatePCR}, sealed.PolicyDigest); err != nil {
-				return nil, fmt.Errorf("failed to validate PCR policy digest for PCRs %v: %w", []int{constants.SecureBootStatePCR}, err)
+			if err := validatePCRPolicyDigest(t, handle, []int{constants.SecureBootStatePCR}, sealed.PolicyDigest); err != nil {
+				return fmt.Errorf("failed to validate PCR policy digest for PCRs %v: %w", []int{constants.SecureBootStatePCR}, err)
 			}
 		}
 	}
 
 	// Talos 1.12+ sets the EncryptionVersion and PubKeyPCRs to PCR 11 and any other PCR used to PCRs field
 	if sealed.EncryptionVersion != "" && len(sealed.PCRs) > 0 {
-		if err := validatePCRPolicyDigest(t, policySess.Handle(), sealed.PCRs, sealed.PolicyDigest); err != nil {
-			return nil, fmt.Errorf("failed to validate PCR policy digest for PCRs %v: %w", sealed.PCRs, err)
+		if err := validatePCRPolicyDigest(t, handle, sealed.PCRs, sealed.PolicyDigest); err != nil {
+			return fmt.Errorf("failed to validate PCR policy digest for PCRs %v: %w", sealed.PCRs, err)
 		}
 	}
 
-	unsealOp := tpm2.Unseal{
-		ItemHandle: tpm2.AuthHandle{
-			Handle: loadResponse.ObjectHandle,
-			Name:   loadResponse.Name,
-			Auth:   policySess,
-		},
-	}
-
-	unsealResponse, err := unsealOp.Execute(t, tpm2.HMAC(
-		tpm2.TPMAlgSHA256,
-		h.Size(),
-		tpm2.Salted(createPrimaryResponse.ObjectHandle, *outPub),
-		tpm2.AESEncryption(128, tpm2.EncryptOut),
-		tpm2.Bound(loadResponse.ObjectHandle, loadResponse.Name, nil),
-	))
-	if err != nil {
-		return nil, fmt.Errorf("failed to unseal op: %w", err)
-	}
-
-	return unsealResponse.OutData.Buffer, nil
+	return nil
 }
 
 func validatePCRPolicyDigest(t transport.TPM, handle tpm2.TPMHandle, pcrs []int, digest []byte) error {
@@ -290,7 +306,7 @@ func validatePCRPolicyDigest(t transport.TPM, handle tpm2.TPMHandle, pcrs []int,
 	}
 
 	if !bytes.Equal(pcrPolicyDigest.Buffer, digest) {
-		return fmt.Errorf("sealing policy digest does not match, expected %x, got %x", digest, pcrPolicyDigest.Buffer)
+		return fmt.Errorf("%w: sealing policy digest does not match, expected %x, got %x", ErrSealingPolicyMismatch, digest, pcrPolicyDigest.Buffer)
 	}
 
 	return nil
@@ -0,0 +1,232 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secureerase
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+const sgIO = 0x2285
+
+// SG_IO data transfer directions.
+const (
+	sgDxferNone    = -1
+	sgDxferToDev   = -2
+	sgDxferFromDev = -3
+)
+
+// ATA commands.
+const (
+	ataIdentifyDevice          = 0xec
+	ataSecuritySetPassword     = 0xf1
+	ataSecurityUnlock          = 0xf2
+	ataSecurityErasePrepare    = 0xf3
+	ataSecurityEraseUnit       = 0xf4
+	ataSecurityDisablePassword = 0xf6
+)
+
+// ATA PASS-THROUGH protocols.
+const (
+	ataProtocolNonData = 3
+	ataProtocolPIOIn   = 4
+	ataProtocolPIOOut  = 5
+)
+
+const (
+	ataSectorSize     = 512
+	ataCommandTimeout = time.Minute
+
+	// ataEraseDefaultTimeout is used if the device doesn't report the erase time.
+	ataEraseDefaultTimeout = 24 * time.Hour
+)
+
+// ataErasePassword is the temporary user password set for the duration of the erase.
+//
+// The password is cleared by the device when the erase completes, and it is cleared by Talos if the erase fails,
+// but if the erase is interrupted (e.g. power loss), the device stays locked with this password.
+const ataErasePassword = "TalosSecureErase"
+
+// sgIOHdr is struct sg_io_hdr from scsi/sg.h.
+type sgIOHdr struct {
+	InterfaceID    int32
+	DxferDirection int32
+	CmdLen         uint8
+	MxSbLen        uint8
+	IovecCount     uint16
+	DxferLen       uint32
+	Dxferp         unsafe.Pointer
+	Cmdp           unsafe.Pointer
+	Sbp            unsafe.Pointer
+	Timeout        uint32
+	Flags          uint32
+	PackID         int32
+	UsrPtr         unsafe.Pointer
+	Status         uint8
+	MaskedStatus   uint8
+	MsgStatus      uint8
+	SbLenWr        uint8
+	HostStatus     uint16
+	DriverStatus   uint16
+	Resid          int32
+	Duration       uint32
+	Info           uint32
+}
+
+// ataPassThrough issues an ATA command via SCSI ATA PASS-THROUGH (16) command.
+func ataPassThrough(f *os.File, command uint8, protocol uint8, data []byte, timeout time.Duration) error {
+	cdb := make([]byte, 16)
+	cdb[0] = 0x85 // ATA PASS-THROUGH (16)
+	cdb[1] = protocol << 1
+	cdb[14] = command
+
+	direction := int32(sgDxferNone)
+
+	switch protocol {
+	case ataProtocolPIOIn:
+		cdb[2] = 0x0e // T_DIR=1 (from device), BYT_BLOK=1, T_LENGTH=2 (sector count)
+		cdb[6] = uint8(len(data) / ataSectorSize)
+		direction = sgDxferFromDev
+	case ataProtocolPIOOut:
+		cdb[2] = 0x06 // T_DIR=0 (to device), BYT_BLOK=1, T_LENGTH=2 (sector count)
+		cdb[6] = uint8(len(data) / ataSectorSize)
+		direction = sgDxferToDev
+	}
+
+	sense := make([]byte, 32)
+
+	hdr := sgIOHdr{
+		InterfaceID:    'S',
+		DxferDirection: direction,
+		CmdLen:         uint8(len(cdb)),
+		MxSbLen:        uint8(len(sense)),
+		DxferLen:       uint32(len(data)),
+		Cmdp:           unsafe.Pointer(&cdb[0]),
+		Sbp:            unsafe.Pointer(&sense[0]),
+		Timeout:        uint32(timeout.Milliseconds()),
+	}
+
+	if len(data) > 0 {
+		hdr.Dxferp = unsafe.Pointer(&data[0])
+	}
+
+	_, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), sgIO, uintptr(unsafe.Pointer(&hdr)))
+
+	runtime.KeepAlive(data)
+	runtime.KeepAlive(cdb)
+	runtime.KeepAlive(sense)
+
+	if errno != 0 {
+		return fmt.Errorf("command %#02x failed: %w", command, errno)
+	}
+
+	if hdr.Status != 0 || hdr.HostStatus != 0 || hdr.DriverStatus&0x07 != 0 {
+		return fmt.Errorf("command %#02x failed: status %#x, host status %#x, driver status %#x", command, hdr.Status, hdr.HostStatus, hdr.DriverStatus)
+	}
+
+	return nil
+}
+
+// ataSecurityPayload builds the payload of the security commands with the user password.
+func ataSecurityPayload(control uint16) []byte {
+	payload := make([]byte, ataSectorSize)
+
+	binary.LittleEndian.PutUint16(payload[0:2], control)
+	copy(payload[2:34], ataErasePassword)
+
+	return payload
+}
+
+// ataSecureErase performs the ATA Security Erase Unit.
+//
+// The enhanced erase is used if supported by the device.
+//
+//nolint:gocyclo
+func ataSecureErase(f *os.File, log func(string, ...any)) error {
+	identify := make([]byte, ataSectorSize)
+
+	if err := ataPassThrough(f, ataIdentifyDevice, ataProtocolPIOIn, identify, ataCommandTimeout); err != nil {
+		return fmt.Errorf("identify failed (not an ATA device?): %w", err)
+	}
+
+	word := func(n int) uint16 {
+		return binary.LittleEndian.Uint16(identify[2*n : 2*n+2])
+	}
+
+	security := word(128)
+
+	switch {
+	case word(82)&0x2 == 0 || security&0x1 == 0:
+		return errors.New("device doesn't support the security feature set")
+	case security&0x8 != 0:
+		return errors.New("device security is frozen, suspend and resume the machine or re-plug the device to unfreeze it")
+	case security&0x4 != 0:
+		return errors.New("device is locked with a password")
+	case security&0x2 != 0:
+		return errors.New("device security is already enabled with a password")
+	}
+
+	enhanced := security&0x20 != 0
+
+	eraseTime := word(89)
+	if enhanced {
+		eraseTime = word(90)
+	}
+
+	// erase time is reported in 2 minute units, 0 means not reported, 255 means more than 508 minutes;
+	// the timeout is twice the reported erase time
+	timeout := ataEraseDefaultTimeout
+
+	if units := eraseTime & 0xff; units != 0 && units != 0xff {
+		timeout = 2 * time.Duration(units) * 2 * time.Minute
+	}
+
+	log("setting temporary ATA security password")
+
+	if err := ataPassThrough(f, ataSecuritySetPassword, ataProtocolPIOOut, ataSecurityPayload(0), ataCommandTimeout); err != nil {
+		return fmt.Errorf("failed to set security password: %w", err)
+	}
+
+	if err := ataPassThrough(f, ataSecurityErasePrepare, ataProtocolNonData, nil, ataCommandTimeout); err != nil {
+		return ataClearPassword(f, log, fmt.Errorf("security erase prepare failed: %w", err))
+	}
+
+	var control uint16
+
+	if enhanced {
+		control |= 0x2
+	}
+
+	log("starting ATA security erase (enhanced: %v), it might take up to %s", enhanced, timeout)
+
+	if err := ataPassThrough(f, ataSecurityEraseUnit, ataProtocolPIOOut, ataSecurityPayload(control), timeout); err != nil {
+		return ataClearPassword(f, log, fmt.Errorf("security erase failed: %w", err))
+	}
+
+	return nil
+}
+
+// ataClearPassword removes the temporary security password after a failed erase.
+//
+// The original error is returned, annotated with the password if it can't be removed.
+func ataClearPassword(f *os.File, log func(string, ...any), eraseErr error) error {
+	log("clearing temporary ATA security password")
+
+	// the device might be locked after the failed erase, so unlock it first; the unlock fails if it is not locked
+	ataPassThrough(f, ataSecurityUnlock, ataProtocolPIOOut, ataSecurityPayload(0), ataCommandTimeout) //nolint:errcheck
+
+	if err := ataPassThrough(f, ataSecurityDisablePassword, ataProtocolPIOOut, ataSecurityPayload(0), ataCommandTimeout); err != nil {
+		return fmt.Errorf("%w (failed to clear the security password, the device might be locked with password %q: %w)", eraseErr, ataErasePassword, err)
+	}
+
+	return eraseErr
+}
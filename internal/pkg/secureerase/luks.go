@@ -0,0 +1,238 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secureerase
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"golang.org/x/sys/unix"
+)
+
+var luksMagic = []byte{'L', 'U', 'K', 'S', 0xba, 0xbe}
+
+const (
+	luks1SectorSize     = 512
+	luks1NumKeys        = 8
+	luks1KeySlotsOffset = 208
+	luks1KeySlotSize    = 48
+
+	luks2BinaryHeaderSize = 4096
+)
+
+// sysfsBlockPath is the sysfs path to the block devices.
+var sysfsBlockPath = "/sys/class/block"
+
+// luksHeaderArea returns the size of the area at the start of the device which holds
+// the LUKS headers and keyslots.
+//
+// If the device doesn't have a LUKS header, zero is returned.
+func luksHeaderArea(r io.ReaderAt) (int64, error) {
+	hdr := make([]byte, luks2BinaryHeaderSize)
+
+	n, err := r.ReadAt(hdr, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return 0, err
+	}
+
+	hdr = hdr[:n]
+
+	if len(hdr) < luks1KeySlotsOffset+luks1NumKeys*luks1KeySlotSize || !bytes.HasPrefix(hdr, luksMagic) {
+		return 0, nil
+	}
+
+	switch version := binary.BigEndian.Uint16(hdr[6:8]); version {
+	case 1:
+		return luks1HeaderArea(hdr), nil
+	case 2:
+		return luks2HeaderArea(r, hdr)
+	default:
+		return 0, fmt.Errorf("unsupported LUKS version %d", version)
+	}
+}
+
+func luks1HeaderArea(hdr []byte) int64 {
+	keyBytes := int64(binary.BigEndian.Uint32(hdr[108:112]))
+
+	// the payload (encrypted data) follows the header and keyslots
+	area := int64(binary.BigEndian.Uint32(hdr[104:108])) * luks1SectorSize
+
+	// with the detached header, the payload offset is zero, so look at the keyslots as well
+	for i := range luks1NumKeys {
+		slot := hdr[luks1KeySlotsOffset+i*luks1KeySlotSize : luks1KeySlotsOffset+(i+1)*luks1KeySlotSize]
+
+		keyMaterialOffset := int64(binary.BigEndian.Uint32(slot[40:44])) * luks1SectorSize
+		stripes := int64(binary.BigEndian.Uint32(slot[44:48]))
+
+		area = max(area, keyMaterialOffset+keyBytes*stripes)
+	}
+
+	return area
+}
+
+type luks2Metadata struct {
+	Keyslots map[string]struct {
+		Area struct {
+			Offset string `json:"offset"`
+			Size   string `json:"size"`
+		} `json:"area"`
+	} `json:"keyslots"`
+	Config struct {
+		KeyslotsSize string `json:"keyslots_size"`
+	} `json:"config"`
+}
+
+func luks2HeaderArea(r io.ReaderAt, hdr []byte) (int64, error) {
+	hdrSize := int64(binary.BigEndian.Uint64(hdr[8:16]))
+
+	if hdrSize < luks2BinaryHeaderSize || hdrSize > 4<<20 {
+		return 0, fmt.Errorf("invalid LUKS2 header size %d", hdrSize)
+	}
+
+	jsonArea := make([]byte, hdrSize-luks2BinaryHeaderSize)
+
+	if _, err := r.ReadAt(jsonArea, luks2BinaryHeaderSize); err != nil {
+		return 0, fmt.Errorf("failed to read LUKS2 metadata: %w", err)
+	}
+
+	var metadata luks2Metadata
+
+	if err := json.Unmarshal(bytes.TrimRight(jsonArea, "\x00"), &metadata); err != nil {
+		return 0, fmt.Errorf("failed to parse LUKS2 metadata: %w", err)
+	}
+
+	parse := func(s string) int64 {
+		v, _ := strconv.ParseInt(s, 10, 64) //nolint:errcheck
+
+		return v
+	}
+
+	// primary and secondary headers, followed by the keyslots area
+	area := 2*hdrSize + parse(metadata.Config.KeyslotsSize)
+
+	for _, keyslot := range metadata.Keyslots {
+		area = max(area, parse(keyslot.Area.Offset)+parse(keyslot.Area.Size))
+	}
+
+	return area, nil
+}
+
+// destroyLUKSHeader overwrites LUKS headers and keyslots with random data.
+//
+// It returns false if the device doesn't have a LUKS header.
+func destroyLUKSHeader(f *os.File, log func(string, ...any)) (bool, error) {
+	area, err := luksHeaderArea(f)
+	if err != nil {
+		return false, err
+	}
+
+	if area == 0 {
+		return false, nil
+	}
+
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return false, err
+	}
+
+	area = min(area, size)
+
+	log("destroying LUKS headers and keyslots on %q (%d bytes)", f.Name(), area)
+
+	if _, err = f.Seek(0, io.SeekStart); err != nil {
+		return false, err
+	}
+
+	if _, err = io.CopyN(f, rand.Reader, area); err != nil {
+		return false, fmt.Errorf("failed to overwrite LUKS header: %w", err)
+	}
+
+	if err = f.Sync(); err != nil {
+		return false, err
+	}
+
+	// make sure the header is gone
+	check := make([]byte, len(luksMagic))
+
+	if _, err = f.ReadAt(check, 0); err != nil {
+		return false, err
+	}
+
+	if bytes.Equal(check, luksMagic) {
+		return false, errors.New("LUKS header is still present after overwrite")
+	}
+
+	return true, nil
+}
+
+// destroyLUKS destroys LUKS headers and keyslots on the block device and its partitions.
+func destroyLUKS(devPath string, f *os.File, log func(string, ...any)) error {
+	destroyed, err := destroyLUKSHeader(f, log)
+	if err != nil {
+		return fmt.Errorf("%q: %w", devPath, err)
+	}
+
+	partitions, err := listPartitions(devPath)
+	if err != nil {
+		return err
+	}
+
+	for _, partitionPath := range partitions {
+		if err = func() error {
+			pf, err := os.OpenFile(partitionPath, os.O_RDWR|unix.O_CLOEXEC, 0)
+			if err != nil {
+				return err
+			}
+
+			defer pf.Close() //nolint:errcheck
+
+			partitionDestroyed, err := destroyLUKSHeader(pf, log)
+
+			destroyed = destroyed || partitionDestroyed
+
+			return err
+		}(); err != nil {
+			return fmt.Errorf("%q: %w", partitionPath, err)
+		}
+	}
+
+	if !destroyed {
+		return fmt.Errorf("no LUKS encrypted volumes found on %q", devPath)
+	}
+
+	return nil
+}
+
+// listPartitions returns device paths of the partitions of the block device.
+func listPartitions(devPath string) ([]string, error) {
+	name := filepath.Base(devPath)
+
+	entries, err := os.ReadDir(filepath.Join(sysfsBlockPath, name))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var partitions []string
+
+	for _, entry := range entries {
+		if _, err = os.Stat(filepath.Join(sysfsBlockPath, name, entry.Name(), "partition")); err == nil {
+			partitions = append(partitions, filepath.Join(filepath.Dir(devPath), entry.Name()))
+		}
+	}
+
+	return partitions, nil
+}
@@ -0,0 +1,147 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secureerase
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func luks1Header() []byte {
+	hdr := make([]byte, 1<<20)
+
+	copy(hdr, luksMagic)
+	binary.BigEndian.PutUint16(hdr[6:8], 1)
+	binary.BigEndian.PutUint32(hdr[104:108], 4096) // payload offset (sectors)
+	binary.BigEndian.PutUint32(hdr[108:112], 64)   // key bytes
+
+	for i := range luks1NumKeys {
+		slot := hdr[luks1KeySlotsOffset+i*luks1KeySlotSize:]
+
+		binary.BigEndian.PutUint32(slot[40:44], uint32(8+i*512)) // key material offset (sectors)
+		binary.BigEndian.PutUint32(slot[44:48], 4000)            // stripes
+	}
+
+	return hdr
+}
+
+func luks2Header() []byte {
+	const hdrSize = 16384
+
+	hdr := make([]byte, 1<<20)
+
+	copy(hdr, luksMagic)
+	binary.BigEndian.PutUint16(hdr[6:8], 2)
+	binary.BigEndian.PutUint64(hdr[8:16], hdrSize)
+
+	copy(hdr[luks2BinaryHeaderSize:], `{"keyslots":{"0":{"area":{"offset":"32768","size":"258048"}},"1":{"area":{"offset":"290816","size":"258048"}}},"config":{"keyslots_size":"16744448"}}`)
+
+	return hdr
+}
+
+func TestLUKSHeaderArea(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name     string
+		data     []byte
+		expected int64
+	}{
+		{
+			name:     "empty",
+			data:     make([]byte, 8192),
+			expected: 0,
+		},
+		{
+			name:     "short",
+			data:     luksMagic,
+			expected: 0,
+		},
+		{
+			name:     "luks1",
+			data:     luks1Header(),
+			expected: 4096 * luks1SectorSize,
+		},
+		{
+			name:     "luks2",
+			data:     luks2Header(),
+			expected: 2*16384 + 16744448,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			area, err := luksHeaderArea(bytes.NewReader(test.data))
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, area)
+		})
+	}
+}
+
+func TestDestroyLUKSHeader(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name      string
+		data      []byte
+		destroyed bool
+	}{
+		{
+			name: "plain",
+			data: make([]byte, 1<<20),
+		},
+		{
+			name:      "luks1",
+			data:      luks1Header(),
+			destroyed: true,
+		},
+		{
+			name:      "luks2",
+			data:      luks2Header(),
+			destroyed: true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "disk")
+
+			require.NoError(t, os.WriteFile(path, test.data, 0o600))
+
+			f, err := os.OpenFile(path, os.O_RDWR, 0)
+			require.NoError(t, err)
+
+			t.Cleanup(func() { f.Close() }) //nolint:errcheck
+
+			destroyed, err := destroyLUKSHeader(f, t.Logf)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.destroyed, destroyed)
+
+			contents, err := os.ReadFile(path)
+			require.NoError(t, err)
+
+			if !test.destroyed {
+				assert.Equal(t, test.data, contents)
+
+				return
+			}
+
+			// the area is capped by the size of the file
+			assert.Len(t, contents, len(test.data))
+
+			area, err := luksHeaderArea(bytes.NewReader(contents))
+			require.NoError(t, err)
+			assert.Zero(t, area)
+		})
+	}
+}
@@ -0,0 +1,276 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secureerase
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// NVMe ioctls, see linux/nvme_ioctl.h.
+const (
+	nvmeIoctlID       = 0x4e40     // _IO('N', 0x40)
+	nvmeIoctlAdminCmd = 0xc0484e41 // _IOWR('N', 0x41, struct nvme_admin_cmd)
+)
+
+// NVMe admin command opcodes.
+const (
+	nvmeAdminGetLogPage = 0x02
+	nvmeAdminIdentify   = 0x06
+	nvmeAdminFormatNVM  = 0x80
+	nvmeAdminSanitize   = 0x84
+)
+
+const (
+	nvmeIdentifyCNSNamespace       = 0x00
+	nvmeIdentifyCNSController      = 0x01
+	nvmeIdentifyCNSActiveNamespace = 0x02
+
+	nvmeLogSanitizeStatus = 0x81
+
+	nvmeSanitizeActionCryptoErase = 0x04
+	nvmeSecureEraseCrypto         = 0x02
+
+	nvmeNSIDAll = 0xffffffff
+
+	nvmeFormatTimeout       = 10 * time.Minute
+	nvmeSanitizePollTimeout = time.Hour
+	nvmeSanitizePollPeriod  = 5 * time.Second
+)
+
+// Sanitize status (SSTAT) values of the sanitize status log page.
+const (
+	nvmeSanitizeStatusNeverSanitized     = 0
+	nvmeSanitizeStatusCompleted          = 1
+	nvmeSanitizeStatusInProgress         = 2
+	nvmeSanitizeStatusFailed             = 3
+	nvmeSanitizeStatusCompletedNoDealloc = 4
+)
+
+// nvmeAdminCmd is struct nvme_admin_cmd from linux/nvme_ioctl.h.
+type nvmeAdminCmd struct {
+	Opcode      uint8
+	Flags       uint8
+	Rsvd1       uint16
+	NSID        uint32
+	CDW2        uint32
+	CDW3        uint32
+	Metadata    uint64
+	Addr        uint64
+	MetadataLen uint32
+	DataLen     uint32
+	CDW10       uint32
+	CDW11       uint32
+	CDW12       uint32
+	CDW13       uint32
+	CDW14       uint32
+	CDW15       uint32
+	TimeoutMs   uint32
+	Result      uint32
+}
+
+func nvmeAdmin(f *os.File, cmd *nvmeAdminCmd, data []byte) error {
+	if len(data) > 0 {
+		cmd.Addr = uint64(uintptr(unsafe.Pointer(&data[0])))
+		cmd.DataLen = uint32(len(data))
+	}
+
+	status, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), nvmeIoctlAdminCmd, uintptr(unsafe.Pointer(cmd)))
+
+	runtime.KeepAlive(data)
+
+	if errno != 0 {
+		return fmt.Errorf("admin command %#02x failed: %w", cmd.Opcode, errno)
+	}
+
+	if status != 0 {
+		return fmt.Errorf("admin command %#02x failed with status %#x", cmd.Opcode, status)
+	}
+
+	return nil
+}
+
+func nvmeNamespaceID(f *os.File) (uint32, error) {
+	nsid, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), nvmeIoctlID, 0)
+	if errno != 0 {
+		return 0, fmt.Errorf("failed to get namespace ID (not an NVMe device?): %w", errno)
+	}
+
+	return uint32(nsid), nil
+}
+
+func nvmeIdentify(f *os.File, cns, nsid uint32) ([]byte, error) {
+	data := make([]byte, 4096)
+
+	if err := nvmeAdmin(f, &nvmeAdminCmd{
+		Opcode: nvmeAdminIdentify,
+		NSID:   nsid,
+		CDW10:  cns,
+	}, data); err != nil {
+		return nil, fmt.Errorf("identify failed: %w", err)
+	}
+
+	return data, nil
+}
+
+// nvmeActiveNamespaces returns the list of the active namespace IDs of the controller.
+func nvmeActiveNamespaces(f *os.File) ([]uint32, error) {
+	// the list starts with the namespace ID greater than the specified one, so 0 returns all of them
+	data, err := nvmeIdentify(f, nvmeIdentifyCNSActiveNamespace, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var nsids []uint32
+
+	for i := 0; i < len(data); i += 4 {
+		nsid := binary.LittleEndian.Uint32(data[i : i+4])
+		if nsid == 0 {
+			break
+		}
+
+		nsids = append(nsids, nsid)
+	}
+
+	return nsids, nil
+}
+
+// nvmeSanitize performs the NVMe Sanitize operation with the cryptographic erase action.
+//
+// Sanitize affects all namespaces of the controller, so it is rejected if the controller has
+// other active namespaces besides the one being wiped.
+// Sanitize continues in the background after the command completes, so the progress is polled
+// via the sanitize status log page.
+func nvmeSanitize(ctx context.Context, f *os.File, log func(string, ...any)) error {
+	nsid, err := nvmeNamespaceID(f)
+	if err != nil {
+		return err
+	}
+
+	nsids, err := nvmeActiveNamespaces(f)
+	if err != nil {
+		return err
+	}
+
+	for _, other := range nsids {
+		if other != nsid {
+			return fmt.Errorf("controller has other active namespaces %v, sanitize would erase all of them, use NVME_FORMAT instead", nsids)
+		}
+	}
+
+	ctrl, err := nvmeIdentify(f, nvmeIdentifyCNSController, 0)
+	if err != nil {
+		return err
+	}
+
+	// SANICAP, bit 0: Crypto Erase Support
+	if sanicap := binary.LittleEndian.Uint32(ctrl[328:332]); sanicap&0x1 == 0 {
+		return errors.New("controller doesn't support the crypto erase sanitize action")
+	}
+
+	log("starting NVMe sanitize with crypto erase")
+
+	if err = nvmeAdmin(f, &nvmeAdminCmd{
+		Opcode: nvmeAdminSanitize,
+		CDW10:  nvmeSanitizeActionCryptoErase,
+	}, nil); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, nvmeSanitizePollTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(nvmeSanitizePollPeriod)
+	defer ticker.Stop()
+
+	for {
+		logPage := make([]byte, 512)
+
+		if err = nvmeAdmin(f, &nvmeAdminCmd{
+			Opcode: nvmeAdminGetLogPage,
+			NSID:   nvmeNSIDAll,
+			CDW10:  nvmeLogSanitizeStatus | uint32(len(logPage)/4-1)<<16,
+		}, logPage); err != nil {
+			return fmt.Errorf("failed to read sanitize status: %w", err)
+		}
+
+		progress := binary.LittleEndian.Uint16(logPage[0:2])
+		status := binary.LittleEndian.Uint16(logPage[2:4]) & 0x7
+
+		switch status {
+		case nvmeSanitizeStatusCompleted, nvmeSanitizeStatusCompletedNoDealloc:
+			return nil
+		case nvmeSanitizeStatusFailed:
+			return errors.New("sanitize operation failed")
+		case nvmeSanitizeStatusNeverSanitized:
+			return errors.New("sanitize operation was not started")
+		case nvmeSanitizeStatusInProgress:
+			log("NVMe sanitize in progress: %d%%", uint32(progress)*100/65536)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for sanitize to complete: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// nvmeFormat performs NVMe Format NVM with the cryptographic erase secure erase setting.
+//
+// The current LBA format and protection information settings of the namespace are preserved.
+func nvmeFormat(f *os.File, log func(string, ...any)) error {
+	nsid, err := nvmeNamespaceID(f)
+	if err != nil {
+		return err
+	}
+
+	ctrl, err := nvmeIdentify(f, nvmeIdentifyCNSController, 0)
+	if err != nil {
+		return err
+	}
+
+	// OACS, bit 1: Format NVM command support
+	if oacs := binary.LittleEndian.Uint16(ctrl[256:258]); oacs&0x2 == 0 {
+		return errors.New("controller doesn't support the format command")
+	}
+
+	// FNA, bit 2: cryptographic erase support
+	if fna := ctrl[524]; fna&0x4 == 0 {
+		return errors.New("controller doesn't support the cryptographic erase")
+	}
+
+	ns, err := nvmeIdentify(f, nvmeIdentifyCNSNamespace, nsid)
+	if err != nil {
+		return err
+	}
+
+	flbas := uint32(ns[26])
+	dps := uint32(ns[29])
+
+	cdw10 := flbas&0xf | // LBA format (lower bits)
+		(flbas>>4)&0x1<<4 | // metadata settings
+		dps&0x7<<5 | // protection information
+		(dps>>3)&0x1<<8 | // protection information location
+		nvmeSecureEraseCrypto<<9 | // secure erase settings
+		(flbas>>5)&0x3<<12 // LBA format (upper bits)
+
+	log("starting NVMe format of namespace %d with crypto erase", nsid)
+
+	return nvmeAdmin(f, &nvmeAdminCmd{
+		Opcode:    nvmeAdminFormatNVM,
+		NSID:      nsid,
+		CDW10:     cdw10,
+		TimeoutMs: uint32(nvmeFormatTimeout.Milliseconds()),
+	}, nil)
+}
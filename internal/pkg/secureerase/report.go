@@ -0,0 +1,153 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secureerase
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	stdx509 "crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/siderolabs/crypto/x509"
+)
+
+// Erase results.
+const (
+	ResultSuccess = "success"
+	ResultFailed  = "failed"
+)
+
+// Report is the erase report.
+type Report struct {
+	Hostname     string         `json:"hostname"`
+	SystemUUID   string         `json:"systemUUID,omitempty"`
+	SystemSerial string         `json:"systemSerial,omitempty"`
+	Timestamp    time.Time      `json:"timestamp"`
+	Devices      []DeviceReport `json:"devices"`
+}
+
+// DeviceReport is the erase report of a single device.
+type DeviceReport struct {
+	Device   string    `json:"device"`
+	Model    string    `json:"model,omitempty"`
+	Serial   string    `json:"serial,omitempty"`
+	WWID     string    `json:"wwid,omitempty"`
+	Size     uint64    `json:"size,omitempty"`
+	Method   string    `json:"method"`
+	Result   string    `json:"result"`
+	Error    string    `json:"error,omitempty"`
+	Started  time.Time `json:"started"`
+	Finished time.Time `json:"finished"`
+}
+
+// SignedReport is the erase report signed with the key of the node.
+type SignedReport struct {
+	// Report is the JSON-encoded [Report], the signature covers the exact bytes of it.
+	Report string `json:"report"`
+	// Signature of the report.
+	Signature []byte `json:"signature"`
+	// Certificate (PEM-encoded) of the key which signed the report.
+	Certificate string `json:"certificate"`
+}
+
+// Sign encodes and signs the report with the certificate and key.
+func Sign(report *Report, certAndKey *x509.PEMEncodedCertificateAndKey) (*SignedReport, error) {
+	encoded, err := json.Marshal(report)
+	if err != nil {
+		return nil, err
+	}
+
+	keyPair, err := tls.X509KeyPair(certAndKey.Crt, certAndKey.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load signing key: %w", err)
+	}
+
+	signer, ok := keyPair.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("unsupported signing key")
+	}
+
+	var signature []byte
+
+	switch signer.(type) {
+	case ed25519.PrivateKey:
+		signature, err = signer.Sign(rand.Reader, encoded, crypto.Hash(0))
+	case *ecdsa.PrivateKey, *rsa.PrivateKey:
+		digest := sha256.Sum256(encoded)
+
+		signature, err = signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	default:
+		return nil, fmt.Errorf("unsupported signing key type %T", signer)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign the report: %w", err)
+	}
+
+	return &SignedReport{
+		Report:      string(encoded),
+		Signature:   signature,
+		Certificate: string(certAndKey.Crt),
+	}, nil
+}
+
+// Verify verifies the signature of the report and returns the decoded report.
+//
+// If roots are set, the certificate is verified against them as well.
+func (signed *SignedReport) Verify(roots *stdx509.CertPool) (*Report, error) {
+	block, _ := pem.Decode([]byte(signed.Certificate))
+	if block == nil {
+		return nil, errors.New("failed to decode the certificate")
+	}
+
+	cert, err := stdx509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the certificate: %w", err)
+	}
+
+	if roots != nil {
+		if _, err = cert.Verify(stdx509.VerifyOptions{
+			Roots:       roots,
+			CurrentTime: cert.NotBefore,
+			KeyUsages:   []stdx509.ExtKeyUsage{stdx509.ExtKeyUsageAny},
+		}); err != nil {
+			return nil, fmt.Errorf("failed to verify the certificate: %w", err)
+		}
+	}
+
+	var algorithm stdx509.SignatureAlgorithm
+
+	switch cert.PublicKeyAlgorithm { //nolint:exhaustive
+	case stdx509.Ed25519:
+		algorithm = stdx509.PureEd25519
+	case stdx509.ECDSA:
+		algorithm = stdx509.ECDSAWithSHA256
+	case stdx509.RSA:
+		algorithm = stdx509.SHA256WithRSA
+	default:
+		return nil, fmt.Errorf("unsupported public key algorithm %s", cert.PublicKeyAlgorithm)
+	}
+
+	if err = cert.CheckSignature(algorithm, []byte(signed.Report), signed.Signature); err != nil {
+		return nil, fmt.Errorf("invalid report signature: %w", err)
+	}
+
+	var report Report
+
+	if err = json.Unmarshal([]byte(signed.Report), &report); err != nil {
+		return nil, fmt.Errorf("failed to decode the report: %w", err)
+	}
+
+	return &report, nil
+}
@@ -0,0 +1,93 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secureerase_test
+
+import (
+	stdx509 "crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/crypto/x509"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/secureerase"
+)
+
+func TestSignVerify(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		opts []x509.Option
+	}{
+		{
+			name: "ed25519",
+		},
+		{
+			name: "ecdsa",
+			opts: []x509.Option{x509.ECDSA(true)},
+		},
+		{
+			name: "rsa",
+			opts: []x509.Option{x509.RSA(true), x509.Bits(2048)},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ca, err := x509.NewSelfSignedCertificateAuthority(append([]x509.Option{x509.Organization("talos")}, test.opts...)...)
+			require.NoError(t, err)
+
+			keyPair, err := x509.NewKeyPair(ca, x509.CommonName("node"))
+			require.NoError(t, err)
+
+			timestamp := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+			report := &secureerase.Report{
+				Hostname:  "node",
+				Timestamp: timestamp,
+				Devices: []secureerase.DeviceReport{
+					{
+						Device:   "/dev/nvme0n1",
+						Serial:   "S1234",
+						Method:   "NVME_SANITIZE",
+						Result:   secureerase.ResultSuccess,
+						Started:  timestamp.Add(-time.Minute),
+						Finished: timestamp,
+					},
+				},
+			}
+
+			signed, err := secureerase.Sign(report, x509.NewCertificateAndKeyFromKeyPair(keyPair))
+			require.NoError(t, err)
+
+			roots := stdx509.NewCertPool()
+			require.True(t, roots.AppendCertsFromPEM(ca.CrtPEM))
+
+			verified, err := signed.Verify(roots)
+			require.NoError(t, err)
+
+			assert.Equal(t, report, verified)
+
+			// tampered report
+			tampered := *signed
+			tampered.Report = tampered.Report[:len(tampered.Report)-1] + " }"
+
+			_, err = tampered.Verify(nil)
+			require.ErrorContains(t, err, "invalid report signature")
+
+			// untrusted certificate
+			otherCA, err := x509.NewSelfSignedCertificateAuthority(x509.Organization("other"))
+			require.NoError(t, err)
+
+			otherRoots := stdx509.NewCertPool()
+			require.True(t, otherRoots.AppendCertsFromPEM(otherCA.CrtPEM))
+
+			_, err = signed.Verify(otherRoots)
+			require.ErrorContains(t, err, "failed to verify the certificate")
+		})
+	}
+}
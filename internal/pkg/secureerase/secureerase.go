@@ -0,0 +1,71 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package secureerase implements secure erase of the block devices and the erase report.
+package secureerase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/siderolabs/go-blockdevice/v2/block"
+
+	"github.com/siderolabs/talos/internal/pkg/partition"
+	"github.com/siderolabs/talos/pkg/machinery/api/storage"
+)
+
+// WholeDiskOnly returns true if the method can only be applied to a whole disk.
+func WholeDiskOnly(method storage.BlockDeviceWipeDescriptor_Method) bool {
+	switch method { //nolint:exhaustive
+	case storage.BlockDeviceWipeDescriptor_NVME_SANITIZE,
+		storage.BlockDeviceWipeDescriptor_NVME_FORMAT,
+		storage.BlockDeviceWipeDescriptor_ATA_SECURE_ERASE:
+		return true
+	default:
+		return false
+	}
+}
+
+// Erase wipes the block device with the specified method.
+//
+// The block device should be opened for writing and locked.
+// After the secure erase, the filesystem signatures are wiped as well, so that the kernel and Talos
+// don't pick up any stale information about the device.
+func Erase(ctx context.Context, bd *block.Device, devPath string, method storage.BlockDeviceWipeDescriptor_Method, log func(string, ...any)) error {
+	switch method {
+	case storage.BlockDeviceWipeDescriptor_FAST:
+		return partition.WipeWithSignatures(bd, devPath, log)
+	case storage.BlockDeviceWipeDescriptor_ZEROES:
+		wipeMethod, err := bd.Wipe()
+		if err != nil {
+			return err
+		}
+
+		log("block device %q wiped with method %q", devPath, wipeMethod)
+
+		return nil
+	case storage.BlockDeviceWipeDescriptor_NVME_SANITIZE:
+		if err := nvmeSanitize(ctx, bd.File(), log); err != nil {
+			return fmt.Errorf("NVMe sanitize failed: %w", err)
+		}
+	case storage.BlockDeviceWipeDescriptor_NVME_FORMAT:
+		if err := nvmeFormat(bd.File(), log); err != nil {
+			return fmt.Errorf("NVMe format failed: %w", err)
+		}
+	case storage.BlockDeviceWipeDescriptor_ATA_SECURE_ERASE:
+		if err := ataSecureErase(bd.File(), log); err != nil {
+			return fmt.Errorf("ATA secure erase failed: %w", err)
+		}
+	case storage.BlockDeviceWipeDescriptor_LUKS_DESTROY:
+		if err := destroyLUKS(devPath, bd.File(), log); err != nil {
+			return fmt.Errorf("LUKS destroy failed: %w", err)
+		}
+	default:
+		return fmt.Errorf("unsupported wipe method %s", method)
+	}
+
+	log("block device %q erased with method %s", devPath, method)
+
+	return partition.WipeWithSignatures(bd, devPath, log)
+}
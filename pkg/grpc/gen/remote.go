@@ -15,6 +15,8 @@ import (
 	"github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/go-retry/retry"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/siderolabs/talos/pkg/grpc/middleware/auth/basic"
 	securityapi "github.com/siderolabs/talos/pkg/machinery/api/security"
@@ -24,10 +26,18 @@ import (
 
 var remoteGeneratorPprof = pprof.NewProfile("pkg/grpc/gen.RemoteGenerator")
 
+// ChallengeFunc requests the node attestation challenge from trustd.
+type ChallengeFunc = func(ctx context.Context, keys *securityapi.AttestationKeys) (*securityapi.AttestationChallengeResponse, error)
+
+// AttestFunc produces the node attestation evidence for the CSR.
+type AttestFunc func(ctx context.Context, csr []byte, challengeFunc ChallengeFunc) (*securityapi.NodeAttestation, error)
+
 // RemoteGenerator represents the OS identity generator.
 type RemoteGenerator struct {
 	conn   *grpc.ClientConn
 	client securityapi.SecurityServiceClient
+
+	attest AttestFunc
 }
 
 // NewRemoteGenerator initializes a RemoteGenerator with a preconfigured grpc.ClientConn.
@@ -53,6 +63,13 @@ func NewRemoteGenerator(token string, endpoints []string, acceptedCAs []*x509.PE
 	return g, nil
 }
 
+// WithAttestation enables node attestation: the evidence is attached to each certificate request.
+func (g *RemoteGenerator) WithAttestation(attest AttestFunc) *RemoteGenerator {
+	g.attest = attest
+
+	return g
+}
+
 // Identity creates an identity certificate via the security API.
 func (g *RemoteGenerator) Identity(csr *x509.CertificateSigningRequest) (ca, crt []byte, err error) {
 	return g.IdentityContext(context.Background(), csr)
@@ -67,16 +84,35 @@ func (g *RemoteGenerator) IdentityContext(ctx context.Context, csr *x509.Certifi
 	ctx, cancel := context.WithTimeout(ctx, time.Minute)
 	defer cancel()
 
+	attemptTimeout := 10 * time.Second
+
+	if g.attest != nil {
+		// TPM operations might take a while
+		attemptTimeout = 30 * time.Second
+	}
+
 	if err = retry.Exponential(
 		time.Minute,
-		retry.WithAttemptTimeout(10*time.Second),
+		retry.WithAttemptTimeout(attemptTimeout),
 		retry.WithUnits(time.Second),
 		retry.WithJitter(100*time.Millisecond),
 	).RetryWithContext(ctx, func(ctx context.Context) error {
 		var resp *securityapi.CertificateResponse
 
+		if g.attest != nil && req.Attestation == nil {
+			req.Attestation, err = g.attest(ctx, req.Csr, g.challenge)
+			if err != nil {
+				return retry.ExpectedError(err)
+			}
+		}
+
 		resp, err = g.client.Certificate(ctx, req)
 		if err != nil {
+			if status.Code(err) == codes.PermissionDenied {
+				// attestation evidence was rejected (e.g. the challenge expired), produce fresh evidence on next attempt
+				req.Attestation = nil
+			}
+
 			return retry.ExpectedError(err)
 		}
 
@@ -91,6 +127,12 @@ func (g *RemoteGenerator) IdentityContext(ctx context.Context, csr *x509.Certifi
 	return ca, crt, nil
 }
 
+func (g *RemoteGenerator) challenge(ctx context.Context, keys *securityapi.AttestationKeys) (*securityapi.AttestationChallengeResponse, error) {
+	return g.client.AttestationChallenge(ctx, &securityapi.AttestationChallengeRequest{
+		Keys: keys,
+	})
+}
+
 // Close closes the gRPC client connection.
 func (g *RemoteGenerator) Close() error {
 	remoteGeneratorPprof.Remove(g)
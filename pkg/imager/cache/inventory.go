@@ -0,0 +1,181 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cache
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Image describes an image stored in the cache.
+type Image struct {
+	// Repository is the image repository, e.g. registry.k8s.io/pause.
+	Repository string
+	// Tags of the image stored in the cache.
+	Tags []string
+	// Digest of the image manifest (or the index), e.g. sha256:...
+	Digest string
+}
+
+// Reference returns the image reference.
+func (img Image) Reference() string {
+	if len(img.Tags) > 0 {
+		return img.Repository + ":" + img.Tags[0] + "@" + img.Digest
+	}
+
+	return img.Repository + "@" + img.Digest
+}
+
+var portRewriteRe = regexp.MustCompile(`_(\d+)_$`)
+
+// Inventory lists images stored in the unpacked (flat) cache at path.
+//
+// Platform-specific manifests of multi-platform images and cosign signatures are not listed separately.
+//
+//nolint:gocyclo
+func Inventory(path string) ([]Image, error) {
+	root := filepath.Join(path, manifestsDir)
+
+	repositories := map[string]struct{}{}
+
+	if err := filepath.WalkDir(root, func(p string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() && (d.Name() == "digest" || d.Name() == "reference") {
+			repositories[filepath.Dir(p)] = struct{}{}
+
+			return filepath.SkipDir
+		}
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("walking image cache: %w", err)
+	}
+
+	var result []Image
+
+	for repoDir := range repositories {
+		repository, err := filepath.Rel(root, repoDir)
+		if err != nil {
+			return nil, err
+		}
+
+		repository = filepath.ToSlash(repository)
+
+		// undo the registry name rewrite done in rewriteRegistry
+		registry, repo, _ := strings.Cut(repository, "/")
+		repository = portRewriteRe.ReplaceAllString(registry, ":$1") + "/" + repo
+
+		images, err := inventoryRepository(repoDir)
+		if err != nil {
+			return nil, fmt.Errorf("listing %q: %w", repository, err)
+		}
+
+		for _, img := range images {
+			img.Repository = repository
+
+			result = append(result, img)
+		}
+	}
+
+	slices.SortFunc(result, func(a, b Image) int {
+		return cmp.Or(cmp.Compare(a.Repository, b.Repository), cmp.Compare(a.Digest, b.Digest))
+	})
+
+	return result, nil
+}
+
+//nolint:gocyclo
+func inventoryRepository(repoDir string) ([]Image, error) {
+	manifests := map[string][]byte{}
+
+	digestEntries, err := os.ReadDir(filepath.Join(repoDir, "digest"))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	for _, entry := range digestEntries {
+		contents, err := os.ReadFile(filepath.Join(repoDir, "digest", entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		manifests[strings.Replace(entry.Name(), "sha256-", "sha256:", 1)] = contents
+	}
+
+	tags := map[string][]string{}
+	excluded := map[string]struct{}{}
+
+	referenceEntries, err := os.ReadDir(filepath.Join(repoDir, "reference"))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	for _, entry := range referenceEntries {
+		contents, err := os.ReadFile(filepath.Join(repoDir, "reference", entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		digest := fmt.Sprintf("sha256:%x", sha256.Sum256(contents))
+
+		if _, ok := manifests[digest]; !ok {
+			manifests[digest] = contents
+		}
+
+		// cosign signatures are stored as sha256-<digest>[.sig] tags
+		if strings.HasPrefix(entry.Name(), "sha256-") {
+			excluded[digest] = struct{}{}
+
+			continue
+		}
+
+		tags[digest] = append(tags[digest], entry.Name())
+	}
+
+	// exclude child manifests of the indexes
+	for _, contents := range manifests {
+		var index struct {
+			Manifests []struct {
+				Digest string `json:"digest"`
+			} `json:"manifests"`
+		}
+
+		if err := json.Unmarshal(contents, &index); err != nil {
+			continue
+		}
+
+		for _, m := range index.Manifests {
+			excluded[m.Digest] = struct{}{}
+		}
+	}
+
+	var images []Image
+
+	for digest := range manifests {
+		if _, ok := excluded[digest]; ok {
+			continue
+		}
+
+		imageTags := tags[digest]
+		slices.Sort(imageTags)
+
+		images = append(images, Image{
+			Tags:   imageTags,
+			Digest: digest,
+		})
+	}
+
+	return images, nil
+}
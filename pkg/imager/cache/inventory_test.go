@@ -0,0 +1,76 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cache_test
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/imager/cache"
+)
+
+func writeManifest(t *testing.T, dir, name, contents string) string {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+
+	digest := fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(contents)))
+
+	if name == "" {
+		name = fmt.Sprintf("sha256-%x", sha256.Sum256([]byte(contents)))
+	}
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(contents), 0o644))
+
+	return digest
+}
+
+func TestInventory(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+
+	pauseDir := filepath.Join(root, "manifests", "registry.k8s.io", "pause")
+
+	childManifest := `{"schemaVersion":2,"config":{}}`
+	childDigest := writeManifest(t, filepath.Join(pauseDir, "digest"), "", childManifest)
+
+	index := fmt.Sprintf(`{"schemaVersion":2,"manifests":[{"digest":%q}]}`, childDigest)
+	indexDigest := writeManifest(t, filepath.Join(pauseDir, "digest"), "", index)
+	writeManifest(t, filepath.Join(pauseDir, "reference"), "3.10", index)
+
+	// cosign signature
+	signature := `{"schemaVersion":2,"layers":[]}`
+	writeManifest(t, filepath.Join(pauseDir, "digest"), "", signature)
+	writeManifest(t, filepath.Join(pauseDir, "reference"), "sha256-abcd.sig", signature)
+
+	// image pulled by digest from a registry with a port
+	localDir := filepath.Join(root, "manifests", "registry.local_5000_", "org", "app")
+	appDigest := writeManifest(t, filepath.Join(localDir, "digest"), "", `{"schemaVersion":2,"config":{"digest":"app"}}`)
+
+	images, err := cache.Inventory(root)
+	require.NoError(t, err)
+
+	assert.Equal(t, []cache.Image{
+		{
+			Repository: "registry.k8s.io/pause",
+			Tags:       []string{"3.10"},
+			Digest:     indexDigest,
+		},
+		{
+			Repository: "registry.local:5000/org/app",
+			Digest:     appDigest,
+		},
+	}, images)
+
+	assert.Equal(t, "registry.k8s.io/pause:3.10@"+indexDigest, images[0].Reference())
+	assert.Equal(t, "registry.local:5000/org/app@"+appDigest, images[1].Reference())
+}
@@ -51,6 +51,9 @@ type Imager struct {
 	sdBootPath string
 	ukiPath    string
 
+	// imageCachePath is the path to the unpacked image cache, if the output embeds one.
+	imageCachePath string
+
 	// xattrsMap is used to store paths and their corresponding SELinux xattr values during extraction of extensions.
 	xattrsMap map[string]string
 }
@@ -184,20 +187,30 @@ func (i *Imager) Execute(ctx context.Context, outputPath string, report *reporte
 	switch i.prof.Output.OutFormat {
 	case profile.OutFormatRaw:
 		// do nothing
-		return outputAssetPath, nil
 	case profile.OutFormatXZ:
-		return i.postProcessXz(ctx, outputAssetPath, report)
+		outputAssetPath, err = i.postProcessXz(ctx, outputAssetPath, report)
 	case profile.OutFormatGZ:
-		return i.postProcessGz(ctx, outputAssetPath, report)
+		outputAssetPath, err = i.postProcessGz(ctx, outputAssetPath, report)
 	case profile.OutFormatZSTD:
-		return i.postProcessZstd(ctx, outputAssetPath, report)
+		outputAssetPath, err = i.postProcessZstd(ctx, outputAssetPath, report)
 	case profile.OutFormatTar:
-		return i.postProcessTar(ctx, outputAssetPath, report)
+		outputAssetPath, err = i.postProcessTar(ctx, outputAssetPath, report)
 	case profile.OutFormatUnknown:
 		fallthrough
 	default:
 		return "", xerrors.NewTagged[InvalidInputTag](fmt.Errorf("unknown output format: %s", i.prof.Output.OutFormat))
 	}
+
+	if err != nil {
+		return "", err
+	}
+
+	// 7. Generate the SBOM for the output.
+	if err = i.outSBOM(ctx, outputAssetPath, report); err != nil {
+		return "", err
+	}
+
+	return outputAssetPath, nil
 }
 
 func (i *Imager) handleOverlay(ctx context.Context, report *reporter.Reporter) error {
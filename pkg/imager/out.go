@@ -105,6 +105,8 @@ func (i *Imager) outISO(ctx context.Context, path string, report *reporter.Repor
 		if err := i.prof.Input.ImageCache.Extract(ctx, filepath.Join(scratchSpace, "imagecache"), i.prof.Arch, printf, nil); err != nil {
 			return xerrors.NewTaggedf[DependencyTag]("%w", err)
 		}
+
+		i.imageCachePath = filepath.Join(scratchSpace, "imagecache")
 	}
 
 	var generator iso.Generator
@@ -422,6 +424,7 @@ func (i *Imager) buildImage(ctx context.Context, path string, printf func(string
 		}
 
 		opts.ImageCachePath = imageCacheDir
+		i.imageCachePath = imageCacheDir
 
 		imageCacheSize, err := calculateDirectorySizeWithOverhead(imageCacheDir, 20)
 		if err != nil {
@@ -235,6 +235,18 @@ var Default = map[string]Profile{
 			},
 		},
 	},
+	"generic": {
+		Platform:   "generic",
+		SecureBoot: new(false),
+		Output: Output{
+			Kind:      OutKindImage,
+			OutFormat: OutFormatZSTD,
+			ImageOptions: &ImageOptions{
+				DiskSize:   DefaultRAWDiskSize,
+				DiskFormat: DiskFormatRaw,
+			},
+		},
+	},
 	"hcloud": {
 		Platform:   "hcloud",
 		SecureBoot: new(false),
@@ -48,6 +48,8 @@ type Input struct {
 	SDStub FileAsset `yaml:"sdStub,omitempty"`
 	// SDBoot is a sd-boot file (only for SecureBoot).
 	SDBoot FileAsset `yaml:"sdBoot,omitempty"`
+	// SBOM is the Talos SBOM (SPDX) describing the kernel and initramfs contents.
+	SBOM FileAsset `yaml:"sbom,omitempty"`
 	// Base installer image to mutate.
 	BaseInstaller ContainerAsset `yaml:"baseInstaller,omitempty"`
 	// ImageCache is an image cache to inject into the asset.
@@ -225,6 +227,10 @@ func (i *Input) FillDefaults(arch, version string, secureboot bool) {
 		i.SDBoot.Path = fmt.Sprintf(constants.SDBootAssetPath, arch)
 	}
 
+	if i.SBOM == zeroFileAsset {
+		i.SBOM.Path = fmt.Sprintf(constants.SBOMAssetPath, arch)
+	}
+
 	if secureboot {
 		if i.SecureBoot == nil {
 			i.SecureBoot = &SecureBootAssets{}
@@ -306,6 +312,51 @@ func (c *ContainerAsset) Pull(ctx context.Context, arch string, printf func(stri
 	return img, nil
 }
 
+// Digest returns the digest of the container asset.
+//
+// For the tarball assets, the digest of the tarball is returned, otherwise the image manifest digest.
+func (c *ContainerAsset) Digest(ctx context.Context, arch string, printf func(string, ...any)) (string, error) {
+	if c.TarballPath != "" {
+		f, err := os.Open(c.TarballPath)
+		if err != nil {
+			return "", err
+		}
+
+		defer f.Close() //nolint:errcheck
+
+		hash, _, err := v1.SHA256(f)
+		if err != nil {
+			return "", err
+		}
+
+		return hash.String(), nil
+	}
+
+	img, err := c.Pull(ctx, arch, printf)
+	if err != nil {
+		return "", err
+	}
+
+	hash, err := img.Digest()
+	if err != nil {
+		return "", err
+	}
+
+	return hash.String(), nil
+}
+
+// Reference returns a human-readable reference to the container asset.
+func (c *ContainerAsset) Reference() string {
+	switch {
+	case c.TarballPath != "":
+		return c.TarballPath
+	case c.OCIPath != "":
+		return c.OCIPath
+	default:
+		return c.ImageRef
+	}
+}
+
 func (c *ContainerAsset) pullFromOCI(arch string) (v1.Image, error) {
 	ociLayout, err := layout.FromPath(c.OCIPath)
 	if err != nil {
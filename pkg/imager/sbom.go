@@ -0,0 +1,165 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package imager
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/siderolabs/gen/xerrors"
+
+	"github.com/siderolabs/talos/internal/pkg/extensions"
+	"github.com/siderolabs/talos/pkg/imager/cache"
+	"github.com/siderolabs/talos/pkg/imager/profile"
+	"github.com/siderolabs/talos/pkg/imager/sbom"
+	"github.com/siderolabs/talos/pkg/imager/utils"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/version"
+	"github.com/siderolabs/talos/pkg/reporter"
+)
+
+// outSBOM writes the SPDX SBOM next to the output asset.
+//
+// The SBOM covers the Talos components (as declared by the Talos SBOM input asset),
+// system extensions, image cache contents and the digests of all input assets used to build the output.
+//
+//nolint:gocyclo,cyclop
+func (i *Imager) outSBOM(ctx context.Context, outputAssetPath string, report *reporter.Reporter) error {
+	printf := progressPrintf(report, reporter.Update{Message: "generating SBOM...", Status: reporter.StatusRunning})
+
+	timestamp, ok, err := utils.SourceDateEpoch()
+	if err != nil {
+		return xerrors.NewTaggedf[InvalidInputTag]("failed to get SOURCE_DATE_EPOCH: %w", err)
+	}
+
+	if !ok {
+		timestamp = time.Now().Unix()
+	}
+
+	builder := sbom.Builder{
+		Creator: fmt.Sprintf("%s-imager-%s", version.Name, version.Tag),
+		Created: time.Unix(timestamp, 0),
+	}
+
+	// Talos components and the kernel
+	if err = builder.AddComponent(sbom.Component{
+		Name:      i.prof.Name,
+		Version:   i.prof.Version,
+		Supplier:  "Organization: Sidero Labs, Inc.",
+		SPDXPaths: []string{i.prof.Input.SBOM.Path},
+		// the SBOM would be silently incomplete without the Talos SBOM
+		SPDXRequired: true,
+	}); err != nil {
+		return xerrors.NewTaggedf[IOTag]("%w", err)
+	}
+
+	// system extensions
+	extensionsList, err := extensions.List(filepath.Join(i.tempDir, "extensions"))
+	if err != nil {
+		return xerrors.NewTaggedf[IOTag]("failed to list extensions: %w", err)
+	}
+
+	for _, ext := range extensionsList {
+		component := sbom.Component{
+			Name:        ext.Manifest.Metadata.Name,
+			Version:     ext.Manifest.Metadata.Version,
+			Description: ext.Manifest.Metadata.Description,
+			SPDXPaths:   []string{filepath.Join(ext.RootfsPath(), constants.ExtensionSPDXPath)},
+		}
+
+		if ext.Manifest.Metadata.Author != "" {
+			component.Supplier = "Organization: " + ext.Manifest.Metadata.Author
+		}
+
+		if err = builder.AddComponent(component); err != nil {
+			return xerrors.NewTaggedf[IOTag]("%w", err)
+		}
+	}
+
+	// image cache contents
+	if i.imageCachePath != "" {
+		images, inventoryErr := cache.Inventory(i.imageCachePath)
+		if inventoryErr != nil {
+			return xerrors.NewTaggedf[IOTag]("failed to list image cache contents: %w", inventoryErr)
+		}
+
+		for _, img := range images {
+			builder.AddCachedImage(img.Reference(), img.Digest)
+		}
+	}
+
+	// input assets
+	fileInputs := []struct {
+		name  string
+		asset profile.FileAsset
+		used  bool
+	}{
+		{"kernel", i.prof.Input.Kernel, i.prof.Output.Kind != profile.OutKindCmdline && i.prof.Output.Kind != profile.OutKindInitramfs},
+		{"initramfs", i.prof.Input.Initramfs, i.prof.Output.Kind != profile.OutKindCmdline && i.prof.Output.Kind != profile.OutKindKernel},
+		{"sd-stub", i.prof.Input.SDStub, i.ukiPath != ""},
+		{"sd-boot", i.prof.Input.SDBoot, i.ukiPath != ""},
+	}
+
+	for _, input := range fileInputs {
+		if !input.used {
+			continue
+		}
+
+		if err = builder.AddInputFile(input.name, input.asset.Path); err != nil {
+			return xerrors.NewTaggedf[IOTag]("%w", err)
+		}
+	}
+
+	var zeroContainerAsset profile.ContainerAsset
+
+	type containerInput struct {
+		name  string
+		asset profile.ContainerAsset
+	}
+
+	containerInputs := []containerInput{
+		{"image-cache", i.prof.Input.ImageCache},
+		{"overlay-installer", i.prof.Input.OverlayInstaller},
+	}
+
+	if i.prof.Output.Kind == profile.OutKindInstaller {
+		containerInputs = append(containerInputs, containerInput{"base-installer", i.prof.Input.BaseInstaller})
+	}
+
+	if i.prof.Overlay != nil {
+		containerInputs = append(containerInputs, containerInput{"overlay", i.prof.Overlay.Image})
+	}
+
+	for j, ext := range i.prof.Input.SystemExtensions {
+		containerInputs = append(containerInputs, containerInput{fmt.Sprintf("system-extension-%d", j), ext})
+	}
+
+	for _, input := range containerInputs {
+		if input.asset == zeroContainerAsset {
+			continue
+		}
+
+		digest, digestErr := input.asset.Digest(ctx, i.prof.Arch, printf)
+		if digestErr != nil {
+			return xerrors.NewTaggedf[DependencyTag]("failed to get digest of %s: %w", input.name, digestErr)
+		}
+
+		builder.AddInputImage(input.name, input.asset.Reference(), digest)
+	}
+
+	sbomPath, err := builder.Write(outputAssetPath)
+	if err != nil {
+		return xerrors.NewTaggedf[IOTag]("failed to write SBOM: %w", err)
+	}
+
+	report.Report(reporter.Update{
+		Message: fmt.Sprintf("SBOM path: %s", sbomPath),
+		Status:  reporter.StatusSucceeded,
+	})
+
+	return nil
+}
@@ -0,0 +1,329 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package sbom generates SPDX software bill of materials for the imager outputs.
+package sbom
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ArtifactSPDXID is the SPDX ID of the package describing the imager output itself.
+const ArtifactSPDXID = "SPDXRef-Artifact"
+
+// Extension is the file name extension of the generated SBOMs.
+const Extension = ".spdx.json"
+
+// Component describes a component embedded into the imager output.
+type Component struct {
+	Name        string
+	Version     string
+	Supplier    string
+	Description string
+
+	// SPDXPaths lists SPDX JSON documents (or directories with *.spdx.json files) describing the component contents.
+	//
+	// Paths which don't exist are ignored, unless SPDXRequired is set.
+	SPDXPaths []string
+	// SPDXRequired makes missing SPDX paths an error.
+	SPDXRequired bool
+}
+
+// Builder collects the components and the input assets of an imager output.
+type Builder struct {
+	// Creator is recorded as the creator tool of the document.
+	Creator string
+	// Created is the document creation timestamp.
+	Created time.Time
+
+	packages      []Package
+	relationships []Relationship
+	ids           map[string]struct{}
+}
+
+// AddComponent records a component contained in the output along with the packages declared in its SPDX documents.
+func (b *Builder) AddComponent(component Component) error {
+	componentID := b.allocateID("Component-" + component.Name)
+
+	b.addPackage(Package{
+		SPDXID:           componentID,
+		Name:             component.Name,
+		VersionInfo:      component.Version,
+		Supplier:         component.Supplier,
+		Description:      component.Description,
+		DownloadLocation: NoAssertion,
+	}, ArtifactSPDXID, RelationshipContains)
+
+	seen := map[string]struct{}{}
+
+	for _, path := range component.SPDXPaths {
+		docs, err := readSPDX(path, component.SPDXRequired)
+		if err != nil {
+			return fmt.Errorf("error reading SBOM for %q: %w", component.Name, err)
+		}
+
+		for _, doc := range docs {
+			for _, pkg := range doc.Packages {
+				key := pkg.Name + "@" + pkg.VersionInfo
+
+				if _, duplicate := seen[key]; duplicate {
+					continue
+				}
+
+				seen[key] = struct{}{}
+
+				pkg.SPDXID = b.allocateID(strings.TrimPrefix(componentID, "SPDXRef-") + "-" + strings.TrimPrefix(pkg.SPDXID, "SPDXRef-"))
+
+				if pkg.DownloadLocation == "" {
+					pkg.DownloadLocation = NoAssertion
+				}
+
+				b.addPackage(pkg, componentID, RelationshipContains)
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddInputFile records a file input asset along with its digest.
+func (b *Builder) AddInputFile(name, path string) error {
+	digest, err := fileDigest(path)
+	if err != nil {
+		return fmt.Errorf("error calculating digest of %q: %w", name, err)
+	}
+
+	b.addPackage(Package{
+		SPDXID:                b.allocateID("Input-" + name),
+		Name:                  name,
+		DownloadLocation:      NoAssertion,
+		Checksums:             []Checksum{{Algorithm: ChecksumSHA256, ChecksumValue: digest}},
+		Comment:               "input asset " + path,
+		PrimaryPackagePurpose: "FILE",
+	}, ArtifactSPDXID, RelationshipGeneratedFrom)
+
+	return nil
+}
+
+// AddInputImage records a container image input asset along with its manifest digest.
+func (b *Builder) AddInputImage(name, ref, digest string) {
+	pkg := imagePackage(b.allocateID("Input-"+name), name, ref, digest)
+	pkg.Comment = "input asset " + ref
+
+	b.addPackage(pkg, ArtifactSPDXID, RelationshipGeneratedFrom)
+}
+
+// AddCachedImage records a container image embedded into the output image cache.
+func (b *Builder) AddCachedImage(ref, digest string) {
+	pkg := imagePackage(b.allocateID("ImageCache-"+ref), ref, ref, digest)
+	pkg.Comment = "image cache contents"
+
+	b.addPackage(pkg, ArtifactSPDXID, RelationshipContains)
+}
+
+// Build the SPDX document describing the artifact at path.
+func (b *Builder) Build(artifactPath string) (*Document, error) {
+	digest, err := fileDigest(artifactPath)
+	if err != nil {
+		return nil, fmt.Errorf("error calculating artifact digest: %w", err)
+	}
+
+	name := filepath.Base(artifactPath)
+
+	doc := &Document{
+		SPDXVersion:       SPDXVersion,
+		DataLicense:       DataLicense,
+		SPDXID:            DocumentSPDXID,
+		Name:              name,
+		DocumentNamespace: "https://siderolabs.com/spdx/imager/" + name + "-" + digest,
+		CreationInfo: CreationInfo{
+			Created:  b.Created.UTC().Format(time.RFC3339),
+			Creators: []string{"Tool: " + b.Creator},
+		},
+		Packages: append([]Package{
+			{
+				SPDXID:           ArtifactSPDXID,
+				Name:             name,
+				DownloadLocation: NoAssertion,
+				Checksums:        []Checksum{{Algorithm: ChecksumSHA256, ChecksumValue: digest}},
+			},
+		}, b.packages...),
+		Relationships: append([]Relationship{
+			{
+				SPDXElementID:      DocumentSPDXID,
+				RelationshipType:   RelationshipDescribes,
+				RelatedSPDXElement: ArtifactSPDXID,
+			},
+		}, b.relationships...),
+	}
+
+	return doc, nil
+}
+
+// Write the SPDX document describing the artifact at path next to it, and return the SBOM path.
+func (b *Builder) Write(artifactPath string) (string, error) {
+	doc, err := b.Build(artifactPath)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	sbomPath := artifactPath + Extension
+
+	return sbomPath, os.WriteFile(sbomPath, append(data, '\n'), 0o644)
+}
+
+func (b *Builder) addPackage(pkg Package, parentID, relationship string) {
+	b.packages = append(b.packages, pkg)
+	b.relationships = append(b.relationships, Relationship{
+		SPDXElementID:      parentID,
+		RelationshipType:   relationship,
+		RelatedSPDXElement: pkg.SPDXID,
+	})
+}
+
+// allocateID returns a unique SPDX ID derived from name.
+func (b *Builder) allocateID(name string) string {
+	if b.ids == nil {
+		b.ids = map[string]struct{}{}
+	}
+
+	base := "SPDXRef-" + strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '-':
+			return r
+		default:
+			return '-'
+		}
+	}, name)
+
+	id := base
+
+	for i := 1; ; i++ {
+		if _, exists := b.ids[id]; !exists {
+			break
+		}
+
+		id = base + "-" + strconv.Itoa(i)
+	}
+
+	b.ids[id] = struct{}{}
+
+	return id
+}
+
+func imagePackage(id, name, ref, digest string) Package {
+	pkg := Package{
+		SPDXID:                id,
+		Name:                  name,
+		DownloadLocation:      NoAssertion,
+		PrimaryPackagePurpose: "CONTAINER",
+	}
+
+	if algo, value, ok := strings.Cut(digest, ":"); ok && algo == "sha256" {
+		pkg.Checksums = []Checksum{{Algorithm: ChecksumSHA256, ChecksumValue: value}}
+		pkg.ExternalRefs = []ExternalRef{
+			{
+				ReferenceCategory: CategoryPackage,
+				ReferenceType:     RefTypePURL,
+				ReferenceLocator:  imagePURL(ref, digest),
+			},
+		}
+	}
+
+	return pkg
+}
+
+// imagePURL builds an OCI package URL for the image reference.
+func imagePURL(ref, digest string) string {
+	repository, _, _ := strings.Cut(ref, "@")
+
+	var tag string
+
+	if idx := strings.LastIndex(repository, ":"); idx > strings.LastIndex(repository, "/") {
+		repository, tag = repository[:idx], repository[idx+1:]
+	}
+
+	purl := "pkg:oci/" + repository[strings.LastIndex(repository, "/")+1:] + "@" + strings.ReplaceAll(digest, ":", "%3A")
+
+	if repository != "" && !strings.HasPrefix(repository, "/") {
+		purl += "?repository_url=" + repository
+
+		if tag != "" {
+			purl += "&tag=" + tag
+		}
+	}
+
+	return purl
+}
+
+func readSPDX(path string, required bool) ([]Document, error) {
+	st, err := os.Stat(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) && !required {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	paths := []string{path}
+
+	if st.IsDir() {
+		if paths, err = filepath.Glob(filepath.Join(path, "*"+Extension)); err != nil {
+			return nil, err
+		}
+	}
+
+	docs := make([]Document, 0, len(paths))
+
+	for _, p := range paths {
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return nil, err
+		}
+
+		var doc Document
+
+		if err = json.Unmarshal(data, &doc); err != nil {
+			return nil, fmt.Errorf("error parsing %q: %w", p, err)
+		}
+
+		docs = append(docs, doc)
+	}
+
+	return docs, nil
+}
+
+func fileDigest(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	h := sha256.New()
+
+	if _, err = io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
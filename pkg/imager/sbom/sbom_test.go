@@ -0,0 +1,161 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package sbom_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/imager/sbom"
+)
+
+func writeSPDX(t *testing.T, path string, packages ...sbom.Package) {
+	t.Helper()
+
+	data, err := json.Marshal(sbom.Document{SPDXVersion: sbom.SPDXVersion, Packages: packages})
+	require.NoError(t, err)
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+}
+
+func TestBuilder(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	writeSPDX(t, filepath.Join(dir, "talos.spdx.json"),
+		sbom.Package{SPDXID: "SPDXRef-Package-kernel", Name: "kernel", VersionInfo: "6.18.1", LicenseDeclared: "GPL-2.0-only"},
+		sbom.Package{SPDXID: "SPDXRef-Package-containerd", Name: "containerd", VersionInfo: "2.1.0"},
+	)
+
+	writeSPDX(t, filepath.Join(dir, "ext", "gvisor.spdx.json"),
+		sbom.Package{SPDXID: "SPDXRef-Package-gvisor", Name: "gvisor", VersionInfo: "20250101.0"},
+	)
+	writeSPDX(t, filepath.Join(dir, "ext", "gvisor-dup.spdx.json"),
+		sbom.Package{SPDXID: "SPDXRef-Package-gvisor", Name: "gvisor", VersionInfo: "20250101.0"},
+	)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "vmlinuz"), []byte("kernel"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "metal-amd64.iso"), []byte("iso"), 0o644))
+
+	builder := sbom.Builder{
+		Creator: "Talos-imager-v1.15.0",
+		Created: time.Unix(1700000000, 0),
+	}
+
+	require.NoError(t, builder.AddComponent(sbom.Component{
+		Name:         "Talos",
+		Version:      "v1.15.0",
+		SPDXPaths:    []string{filepath.Join(dir, "talos.spdx.json")},
+		SPDXRequired: true,
+	}))
+
+	require.NoError(t, builder.AddComponent(sbom.Component{
+		Name:      "gvisor",
+		Version:   "20250101.0",
+		Supplier:  "Organization: Sidero Labs, Inc.",
+		SPDXPaths: []string{filepath.Join(dir, "ext"), filepath.Join(dir, "missing")},
+	}))
+
+	require.NoError(t, builder.AddInputFile("kernel", filepath.Join(dir, "vmlinuz")))
+
+	builder.AddInputImage("system-extension-0", "ghcr.io/siderolabs/gvisor:20250101.0", "sha256:0123456789abcdef")
+	builder.AddCachedImage("registry.k8s.io/pause:3.10@sha256:fedcba", "sha256:fedcba")
+
+	sbomPath, err := builder.Write(filepath.Join(dir, "metal-amd64.iso"))
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "metal-amd64.iso.spdx.json"), sbomPath)
+
+	data, err := os.ReadFile(sbomPath)
+	require.NoError(t, err)
+
+	var doc sbom.Document
+
+	require.NoError(t, json.Unmarshal(data, &doc))
+
+	assert.Equal(t, sbom.SPDXVersion, doc.SPDXVersion)
+	assert.Equal(t, "metal-amd64.iso", doc.Name)
+	assert.Equal(t, "2023-11-14T22:13:20Z", doc.CreationInfo.Created)
+	assert.Equal(t, []string{"Tool: Talos-imager-v1.15.0"}, doc.CreationInfo.Creators)
+
+	packages := map[string]sbom.Package{}
+
+	for _, pkg := range doc.Packages {
+		_, duplicate := packages[pkg.SPDXID]
+		require.False(t, duplicate, "duplicate SPDX ID %q", pkg.SPDXID)
+
+		packages[pkg.SPDXID] = pkg
+	}
+
+	require.Len(t, packages, 9)
+
+	assert.Equal(t, []sbom.Checksum{
+		{
+			Algorithm:     sbom.ChecksumSHA256,
+			ChecksumValue: "e0e4548df88a35d5854d052281c5deedad16f286f82cb2c23f2f9dea494834ac", // sha256("iso")
+		},
+	}, packages[sbom.ArtifactSPDXID].Checksums)
+
+	assert.Equal(t, "6.18.1", packages["SPDXRef-Component-Talos-Package-kernel"].VersionInfo)
+	assert.Equal(t, "GPL-2.0-only", packages["SPDXRef-Component-Talos-Package-kernel"].LicenseDeclared)
+	assert.Equal(t, sbom.NoAssertion, packages["SPDXRef-Component-Talos-Package-kernel"].DownloadLocation)
+
+	// packages declared in multiple SPDX documents of the component are recorded once
+	assert.Equal(t, "gvisor", packages["SPDXRef-Component-gvisor-Package-gvisor"].Name)
+
+	assert.Equal(t, []sbom.ExternalRef{
+		{
+			ReferenceCategory: sbom.CategoryPackage,
+			ReferenceType:     sbom.RefTypePURL,
+			ReferenceLocator:  "pkg:oci/gvisor@sha256%3A0123456789abcdef?repository_url=ghcr.io/siderolabs/gvisor&tag=20250101.0",
+		},
+	}, packages["SPDXRef-Input-system-extension-0"].ExternalRefs)
+
+	assert.Contains(t, doc.Relationships, sbom.Relationship{
+		SPDXElementID:      sbom.DocumentSPDXID,
+		RelationshipType:   sbom.RelationshipDescribes,
+		RelatedSPDXElement: sbom.ArtifactSPDXID,
+	})
+	assert.Contains(t, doc.Relationships, sbom.Relationship{
+		SPDXElementID:      "SPDXRef-Component-Talos",
+		RelationshipType:   sbom.RelationshipContains,
+		RelatedSPDXElement: "SPDXRef-Component-Talos-Package-containerd",
+	})
+	assert.Contains(t, doc.Relationships, sbom.Relationship{
+		SPDXElementID:      sbom.ArtifactSPDXID,
+		RelationshipType:   sbom.RelationshipGeneratedFrom,
+		RelatedSPDXElement: "SPDXRef-Input-kernel",
+	})
+	assert.Contains(t, doc.Relationships, sbom.Relationship{
+		SPDXElementID:      sbom.ArtifactSPDXID,
+		RelationshipType:   sbom.RelationshipContains,
+		RelatedSPDXElement: "SPDXRef-ImageCache-registry.k8s.io-pause-3.10-sha256-fedcba",
+	})
+}
+
+func TestBuilderMissingRequiredSPDX(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	var builder sbom.Builder
+
+	err := builder.AddComponent(sbom.Component{
+		Name:         "Talos",
+		Version:      "v1.15.0",
+		SPDXPaths:    []string{filepath.Join(dir, "talos.spdx.json")},
+		SPDXRequired: true,
+	})
+	require.Error(t, err)
+
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -0,0 +1,80 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package sbom
+
+// SPDX 2.3 JSON document structure.
+//
+// Only the subset of fields produced by the imager (and consumed from the Talos and extension SBOMs) is modeled.
+
+// SPDX constants.
+const (
+	SPDXVersion     = "SPDX-2.3"
+	DataLicense     = "CC0-1.0"
+	DocumentSPDXID  = "SPDXRef-DOCUMENT"
+	NoAssertion     = "NOASSERTION"
+	ChecksumSHA256  = "SHA256"
+	CategoryPackage = "PACKAGE-MANAGER"
+	RefTypePURL     = "purl"
+
+	RelationshipDescribes     = "DESCRIBES"
+	RelationshipContains      = "CONTAINS"
+	RelationshipGeneratedFrom = "GENERATED_FROM"
+)
+
+// Document is a SPDX document.
+type Document struct {
+	SPDXVersion       string         `json:"spdxVersion"`
+	DataLicense       string         `json:"dataLicense"`
+	SPDXID            string         `json:"SPDXID"`
+	Name              string         `json:"name"`
+	DocumentNamespace string         `json:"documentNamespace"`
+	CreationInfo      CreationInfo   `json:"creationInfo"`
+	Packages          []Package      `json:"packages"`
+	Relationships     []Relationship `json:"relationships"`
+}
+
+// CreationInfo describes when and how the document was created.
+type CreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+// Package is a SPDX package.
+type Package struct {
+	SPDXID                string        `json:"SPDXID"`
+	Name                  string        `json:"name"`
+	VersionInfo           string        `json:"versionInfo,omitempty"`
+	Supplier              string        `json:"supplier,omitempty"`
+	DownloadLocation      string        `json:"downloadLocation"`
+	FilesAnalyzed         bool          `json:"filesAnalyzed"`
+	Checksums             []Checksum    `json:"checksums,omitempty"`
+	LicenseConcluded      string        `json:"licenseConcluded,omitempty"`
+	LicenseDeclared       string        `json:"licenseDeclared,omitempty"`
+	CopyrightText         string        `json:"copyrightText,omitempty"`
+	Description           string        `json:"description,omitempty"`
+	Comment               string        `json:"comment,omitempty"`
+	ExternalRefs          []ExternalRef `json:"externalRefs,omitempty"`
+	PrimaryPackagePurpose string        `json:"primaryPackagePurpose,omitempty"`
+}
+
+// Checksum is a SPDX package checksum.
+type Checksum struct {
+	Algorithm     string `json:"algorithm"`
+	ChecksumValue string `json:"checksumValue"`
+}
+
+// ExternalRef is a SPDX package external reference.
+type ExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+// Relationship is a SPDX relationship between two elements.
+type Relationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
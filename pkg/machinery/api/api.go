@@ -48,6 +48,7 @@ func TalosAPIdAllAPIs() []protoreflect.FileDescriptor {
 		machine.File_machine_lifecycle_proto,
 		machine.File_machine_lvm_proto,
 		machine.File_machine_md_proto,
+		machine.File_machine_netdiag_proto,
 	)
 }
 
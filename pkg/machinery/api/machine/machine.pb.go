@@ -19,6 +19,7 @@ import (
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 
 	common "github.com/siderolabs/talos/pkg/machinery/api/common"
+	storage "github.com/siderolabs/talos/pkg/machinery/api/storage"
 )
 
 const (
@@ -846,8 +847,12 @@ type ApplyConfigurationRequest struct {
 	Mode           ApplyConfigurationRequest_Mode `protobuf:"varint,4,opt,name=mode,proto3,enum=machine.ApplyConfigurationRequest_Mode" json:"mode,omitempty"`
 	DryRun         bool                           `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	TryModeTimeout *durationpb.Duration           `protobuf:"bytes,6,opt,name=try_mode_timeout,json=tryModeTimeout,proto3" json:"try_mode_timeout,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Detached signature of the configuration data (raw or base64-encoded).
+	//
+	// The signature is required if the machine has a configuration trust policy.
+	Signature     []byte `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ApplyConfigurationRequest) Reset() {
@@ -908,6 +913,13 @@ func (x *ApplyConfigurationRequest) GetTryModeTimeout() *durationpb.Duration {
 	return nil
 }
 
+func (x *ApplyConfigurationRequest) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
 // ApplyConfigurationResponse describes the response to a configuration request.
 type ApplyConfiguration struct {
 	state    protoimpl.MessageState `protogen:"open.v1"`
@@ -1987,9 +1999,19 @@ type ResetRequest struct {
 	// UserDisksToWipe lists specific connected block devices to be reset (wiped).
 	UserDisksToWipe []string `protobuf:"bytes,4,rep,name=user_disks_to_wipe,json=userDisksToWipe,proto3" json:"user_disks_to_wipe,omitempty"`
 	// WipeMode defines which devices should be wiped.
-	Mode          ResetRequest_WipeMode `protobuf:"varint,5,opt,name=mode,proto3,enum=machine.ResetRequest_WipeMode" json:"mode,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Mode ResetRequest_WipeMode `protobuf:"varint,5,opt,name=mode,proto3,enum=machine.ResetRequest_WipeMode" json:"mode,omitempty"`
+	// WipeMethod defines how the devices are wiped.
+	//
+	// Methods other than FAST and ZEROES are only supported when wiping whole disks.
+	WipeMethod storage.BlockDeviceWipeDescriptor_Method `protobuf:"varint,6,opt,name=wipe_method,json=wipeMethod,proto3,enum=storage.BlockDeviceWipeDescriptor_Method" json:"wipe_method,omitempty"`
+	// EraseReportTimeout enables the signed erase report.
+	//
+	// If set, the node publishes the EraseReport resource after wiping the disks, and keeps the API
+	// running for the specified duration before powering off (or rebooting), so that the report can be fetched.
+	// With the erase report enabled, wipe failures are recorded in the report instead of aborting the reset.
+	EraseReportTimeout *durationpb.Duration `protobuf:"bytes,7,opt,name=erase_report_timeout,json=eraseReportTimeout,proto3" json:"erase_report_timeout,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *ResetRequest) Reset() {
@@ -2057,6 +2079,20 @@ func (x *ResetRequest) GetMode() ResetRequest_WipeMode {
 	return ResetRequest_ALL
 }
 
+func (x *ResetRequest) GetWipeMethod() storage.BlockDeviceWipeDescriptor_Method {
+	if x != nil {
+		return x.WipeMethod
+	}
+	return storage.BlockDeviceWipeDescriptor_Method(0)
+}
+
+func (x *ResetRequest) GetEraseReportTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.EraseReportTimeout
+	}
+	return nil
+}
+
 // The reset message containing the restart status.
 type Reset struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -11632,12 +11668,13 @@ var File_machine_machine_proto protoreflect.FileDescriptor
 
 const file_machine_machine_proto_rawDesc = "" +
 	"\n" +
-	"\x15machine/machine.proto\x12\amachine\x1a\x13common/common.proto\x1a\x19google/protobuf/any.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x98\x02\n" +
+	"\x15machine/machine.proto\x12\amachine\x1a\x13common/common.proto\x1a\x19google/protobuf/any.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x15storage/storage.proto\"\xb6\x02\n" +
 	"\x19ApplyConfigurationRequest\x12\x12\n" +
 	"\x04data\x18\x01 \x01(\fR\x04data\x12;\n" +
 	"\x04mode\x18\x04 \x01(\x0e2'.machine.ApplyConfigurationRequest.ModeR\x04mode\x12\x17\n" +
 	"\adry_run\x18\x05 \x01(\bR\x06dryRun\x12C\n" +
-	"\x10try_mode_timeout\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x0etryModeTimeout\"L\n" +
+	"\x10try_mode_timeout\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x0etryModeTimeout\x12\x1c\n" +
+	"\tsignature\x18\a \x01(\fR\tsignature\"L\n" +
 	"\x04Mode\x12\x16\n" +
 	"\x06REBOOT\x10\x00\x1a\n" +
 	"\xea\xbb-\x04v2.0\b\x01\x12\b\n" +
@@ -11751,13 +11788,16 @@ const file_machine_machine_proto_rawDesc = "" +
 	"\bactor_id\x18\x04 \x01(\tR\aactorId\">\n" +
 	"\x12ResetPartitionSpec\x12\x14\n" +
 	"\x05label\x18\x01 \x01(\tR\x05label\x12\x12\n" +
-	"\x04wipe\x18\x02 \x01(\bR\x04wipe\"\xb1\x02\n" +
+	"\x04wipe\x18\x02 \x01(\bR\x04wipe\"\xca\x03\n" +
 	"\fResetRequest\x12\x1a\n" +
 	"\bgraceful\x18\x01 \x01(\bR\bgraceful\x12\x16\n" +
 	"\x06reboot\x18\x02 \x01(\bR\x06reboot\x12V\n" +
 	"\x19system_partitions_to_wipe\x18\x03 \x03(\v2\x1b.machine.ResetPartitionSpecR\x16systemPartitionsToWipe\x12+\n" +
 	"\x12user_disks_to_wipe\x18\x04 \x03(\tR\x0fuserDisksToWipe\x122\n" +
-	"\x04mode\x18\x05 \x01(\x0e2\x1e.machine.ResetRequest.WipeModeR\x04mode\"4\n" +
+	"\x04mode\x18\x05 \x01(\x0e2\x1e.machine.ResetRequest.WipeModeR\x04mode\x12J\n" +
+	"\vwipe_method\x18\x06 \x01(\x0e2).storage.BlockDeviceWipeDescriptor.MethodR\n" +
+	"wipeMethod\x12K\n" +
+	"\x14erase_report_timeout\x18\a \x01(\v2\x19.google.protobuf.DurationR\x12eraseReportTimeout\"4\n" +
 	"\bWipeMode\x12\a\n" +
 	"\x03ALL\x10\x00\x12\x0f\n" +
 	"\vSYSTEM_DISK\x10\x01\x12\x0e\n" +
@@ -12778,11 +12818,12 @@ var file_machine_machine_proto_goTypes = []any{
 	(*common.Metadata)(nil),                                 // 194: common.Metadata
 	(*common.Error)(nil),                                    // 195: common.Error
 	(*anypb.Any)(nil),                                       // 196: google.protobuf.Any
-	(*timestamppb.Timestamp)(nil),                           // 197: google.protobuf.Timestamp
-	(common.ContainerDriver)(0),                             // 198: common.ContainerDriver
-	(common.ContainerdNamespace)(0),                         // 199: common.ContainerdNamespace
-	(*emptypb.Empty)(nil),                                   // 200: google.protobuf.Empty
-	(*common.Data)(nil),                                     // 201: common.Data
+	(storage.BlockDeviceWipeDescriptor_Method)(0),           // 197: storage.BlockDeviceWipeDescriptor.Method
+	(*timestamppb.Timestamp)(nil),                           // 198: google.protobuf.Timestamp
+	(common.ContainerDriver)(0),                             // 199: common.ContainerDriver
+	(common.ContainerdNamespace)(0),                         // 200: common.ContainerdNamespace
+	(*emptypb.Empty)(nil),                                   // 201: google.protobuf.Empty
+	(*common.Data)(nil),                                     // 202: common.Data
 }
 var file_machine_machine_proto_depIdxs = []int32{
 	0,   // 0: machine.ApplyConfigurationRequest.mode:type_name -> machine.ApplyConfigurationRequest.Mode
@@ -12807,265 +12848,267 @@ var file_machine_machine_proto_depIdxs = []int32{
 	196, // 19: machine.Event.data:type_name -> google.protobuf.Any
 	35,  // 20: machine.ResetRequest.system_partitions_to_wipe:type_name -> machine.ResetPartitionSpec
 	7,   // 21: machine.ResetRequest.mode:type_name -> machine.ResetRequest.WipeMode
-	194, // 22: machine.Reset.metadata:type_name -> common.Metadata
-	37,  // 23: machine.ResetResponse.messages:type_name -> machine.Reset
-	194, // 24: machine.Shutdown.metadata:type_name -> common.Metadata
-	39,  // 25: machine.ShutdownResponse.messages:type_name -> machine.Shutdown
-	8,   // 26: machine.UpgradeRequest.reboot_mode:type_name -> machine.UpgradeRequest.RebootMode
-	194, // 27: machine.Upgrade.metadata:type_name -> common.Metadata
-	43,  // 28: machine.UpgradeResponse.messages:type_name -> machine.Upgrade
-	194, // 29: machine.ServiceList.metadata:type_name -> common.Metadata
-	47,  // 30: machine.ServiceList.services:type_name -> machine.ServiceInfo
-	45,  // 31: machine.ServiceListResponse.messages:type_name -> machine.ServiceList
-	48,  // 32: machine.ServiceInfo.events:type_name -> machine.ServiceEvents
-	50,  // 33: machine.ServiceInfo.health:type_name -> machine.ServiceHealth
-	49,  // 34: machine.ServiceEvents.events:type_name -> machine.ServiceEvent
-	197, // 35: machine.ServiceEvent.ts:type_name -> google.protobuf.Timestamp
-	197, // 36: machine.ServiceHealth.last_change:type_name -> google.protobuf.Timestamp
-	194, // 37: machine.ServiceStart.metadata:type_name -> common.Metadata
-	52,  // 38: machine.ServiceStartResponse.messages:type_name -> machine.ServiceStart
-	194, // 39: machine.ServiceStop.metadata:type_name -> common.Metadata
-	55,  // 40: machine.ServiceStopResponse.messages:type_name -> machine.ServiceStop
-	194, // 41: machine.ServiceRestart.metadata:type_name -> common.Metadata
-	58,  // 42: machine.ServiceRestartResponse.messages:type_name -> machine.ServiceRestart
-	9,   // 43: machine.ListRequest.types:type_name -> machine.ListRequest.Type
-	194, // 44: machine.FileInfo.metadata:type_name -> common.Metadata
-	64,  // 45: machine.FileInfo.xattrs:type_name -> machine.Xattr
-	194, // 46: machine.DiskUsageInfo.metadata:type_name -> common.Metadata
-	194, // 47: machine.Mounts.metadata:type_name -> common.Metadata
-	68,  // 48: machine.Mounts.stats:type_name -> machine.MountStat
-	66,  // 49: machine.MountsResponse.messages:type_name -> machine.Mounts
-	194, // 50: machine.Version.metadata:type_name -> common.Metadata
-	71,  // 51: machine.Version.version:type_name -> machine.VersionInfo
-	72,  // 52: machine.Version.platform:type_name -> machine.PlatformInfo
-	73,  // 53: machine.Version.features:type_name -> machine.FeaturesInfo
-	69,  // 54: machine.VersionResponse.messages:type_name -> machine.Version
-	198, // 55: machine.LogsRequest.driver:type_name -> common.ContainerDriver
-	194, // 56: machine.LogsContainer.metadata:type_name -> common.Metadata
-	76,  // 57: machine.LogsContainersResponse.messages:type_name -> machine.LogsContainer
-	194, // 58: machine.Rollback.metadata:type_name -> common.Metadata
-	79,  // 59: machine.RollbackResponse.messages:type_name -> machine.Rollback
-	198, // 60: machine.ContainersRequest.driver:type_name -> common.ContainerDriver
-	194, // 61: machine.Container.metadata:type_name -> common.Metadata
-	82,  // 62: machine.Container.containers:type_name -> machine.ContainerInfo
-	83,  // 63: machine.ContainersResponse.messages:type_name -> machine.Container
-	87,  // 64: machine.ProcessesResponse.messages:type_name -> machine.Process
-	194, // 65: machine.Process.metadata:type_name -> common.Metadata
-	88,  // 66: machine.Process.processes:type_name -> machine.ProcessInfo
-	198, // 67: machine.RestartRequest.driver:type_name -> common.ContainerDriver
-	194, // 68: machine.Restart.metadata:type_name -> common.Metadata
-	90,  // 69: machine.RestartResponse.messages:type_name -> machine.Restart
-	198, // 70: machine.StatsRequest.driver:type_name -> common.ContainerDriver
-	194, // 71: machine.Stats.metadata:type_name -> common.Metadata
-	95,  // 72: machine.Stats.stats:type_name -> machine.Stat
-	93,  // 73: machine.StatsResponse.messages:type_name -> machine.Stats
-	194, // 74: machine.Memory.metadata:type_name -> common.Metadata
-	98,  // 75: machine.Memory.meminfo:type_name -> machine.MemInfo
-	96,  // 76: machine.MemoryResponse.messages:type_name -> machine.Memory
-	100, // 77: machine.HostnameResponse.messages:type_name -> machine.Hostname
-	194, // 78: machine.Hostname.metadata:type_name -> common.Metadata
-	102, // 79: machine.LoadAvgResponse.messages:type_name -> machine.LoadAvg
-	194, // 80: machine.LoadAvg.metadata:type_name -> common.Metadata
-	104, // 81: machine.SystemStatResponse.messages:type_name -> machine.SystemStat
-	194, // 82: machine.SystemStat.metadata:type_name -> common.Metadata
-	105, // 83: machine.SystemStat.cpu_total:type_name -> machine.CPUStat
-	105, // 84: machine.SystemStat.cpu:type_name -> machine.CPUStat
-	106, // 85: machine.SystemStat.soft_irq:type_name -> machine.SoftIRQStat
-	108, // 86: machine.CPUFreqStatsResponse.messages:type_name -> machine.CPUsFreqStats
-	194, // 87: machine.CPUsFreqStats.metadata:type_name -> common.Metadata
-	109, // 88: machine.CPUsFreqStats.cpu_freq_stats:type_name -> machine.CPUFreqStats
-	111, // 89: machine.CPUInfoResponse.messages:type_name -> machine.CPUsInfo
-	194, // 90: machine.CPUsInfo.metadata:type_name -> common.Metadata
-	112, // 91: machine.CPUsInfo.cpu_info:type_name -> machine.CPUInfo
-	114, // 92: machine.NetworkDeviceStatsResponse.messages:type_name -> machine.NetworkDeviceStats
-	194, // 93: machine.NetworkDeviceStats.metadata:type_name -> common.Metadata
-	115, // 94: machine.NetworkDeviceStats.total:type_name -> machine.NetDev
-	115, // 95: machine.NetworkDeviceStats.devices:type_name -> machine.NetDev
-	117, // 96: machine.DiskStatsResponse.messages:type_name -> machine.DiskStats
-	194, // 97: machine.DiskStats.metadata:type_name -> common.Metadata
-	118, // 98: machine.DiskStats.total:type_name -> machine.DiskStat
-	118, // 99: machine.DiskStats.devices:type_name -> machine.DiskStat
-	194, // 100: machine.EtcdLeaveCluster.metadata:type_name -> common.Metadata
-	120, // 101: machine.EtcdLeaveClusterResponse.messages:type_name -> machine.EtcdLeaveCluster
-	194, // 102: machine.EtcdRemoveMember.metadata:type_name -> common.Metadata
-	123, // 103: machine.EtcdRemoveMemberResponse.messages:type_name -> machine.EtcdRemoveMember
-	194, // 104: machine.EtcdRemoveMemberByID.metadata:type_name -> common.Metadata
-	126, // 105: machine.EtcdRemoveMemberByIDResponse.messages:type_name -> machine.EtcdRemoveMemberByID
-	194, // 106: machine.EtcdForfeitLeadership.metadata:type_name -> common.Metadata
-	129, // 107: machine.EtcdForfeitLeadershipResponse.messages:type_name -> machine.EtcdForfeitLeadership
-	194, // 108: machine.EtcdMembers.metadata:type_name -> common.Metadata
-	132, // 109: machine.EtcdMembers.members:type_name -> machine.EtcdMember
-	133, // 110: machine.EtcdMemberListResponse.messages:type_name -> machine.EtcdMembers
-	194, // 111: machine.EtcdRecover.metadata:type_name -> common.Metadata
-	136, // 112: machine.EtcdRecoverResponse.messages:type_name -> machine.EtcdRecover
-	139, // 113: machine.EtcdAlarmListResponse.messages:type_name -> machine.EtcdAlarm
-	194, // 114: machine.EtcdAlarm.metadata:type_name -> common.Metadata
-	140, // 115: machine.EtcdAlarm.member_alarms:type_name -> machine.EtcdMemberAlarm
-	10,  // 116: machine.EtcdMemberAlarm.alarm:type_name -> machine.EtcdMemberAlarm.AlarmType
-	142, // 117: machine.EtcdAlarmDisarmResponse.messages:type_name -> machine.EtcdAlarmDisarm
-	194, // 118: machine.EtcdAlarmDisarm.metadata:type_name -> common.Metadata
-	140, // 119: machine.EtcdAlarmDisarm.member_alarms:type_name -> machine.EtcdMemberAlarm
-	144, // 120: machine.EtcdDefragmentResponse.messages:type_name -> machine.EtcdDefragment
-	194, // 121: machine.EtcdDefragment.metadata:type_name -> common.Metadata
-	146, // 122: machine.EtcdStatusResponse.messages:type_name -> machine.EtcdStatus
-	194, // 123: machine.EtcdStatus.metadata:type_name -> common.Metadata
-	147, // 124: machine.EtcdStatus.member_status:type_name -> machine.EtcdMemberStatus
-	150, // 125: machine.EtcdDowngradeValidateResponse.messages:type_name -> machine.EtcdDowngradeValidate
-	194, // 126: machine.EtcdDowngradeValidate.metadata:type_name -> common.Metadata
-	156, // 127: machine.EtcdDowngradeValidate.cluster_downgrade:type_name -> machine.EtcdClusterDowngrade
-	153, // 128: machine.EtcdDowngradeEnableResponse.messages:type_name -> machine.EtcdDowngradeEnable
-	194, // 129: machine.EtcdDowngradeEnable.metadata:type_name -> common.Metadata
-	156, // 130: machine.EtcdDowngradeEnable.cluster_downgrade:type_name -> machine.EtcdClusterDowngrade
-	155, // 131: machine.EtcdDowngradeCancelResponse.messages:type_name -> machine.EtcdDowngradeCancel
-	194, // 132: machine.EtcdDowngradeCancel.metadata:type_name -> common.Metadata
-	156, // 133: machine.EtcdDowngradeCancel.cluster_downgrade:type_name -> machine.EtcdClusterDowngrade
-	158, // 134: machine.NetworkDeviceConfig.dhcp_options:type_name -> machine.DHCPOptionsConfig
-	157, // 135: machine.NetworkDeviceConfig.routes:type_name -> machine.RouteConfig
-	159, // 136: machine.NetworkConfig.interfaces:type_name -> machine.NetworkDeviceConfig
-	11,  // 137: machine.MachineConfig.type:type_name -> machine.MachineConfig.MachineType
-	161, // 138: machine.MachineConfig.install_config:type_name -> machine.InstallConfig
-	160, // 139: machine.MachineConfig.network_config:type_name -> machine.NetworkConfig
-	164, // 140: machine.ClusterNetworkConfig.cni_config:type_name -> machine.CNIConfig
-	163, // 141: machine.ClusterConfig.control_plane:type_name -> machine.ControlPlaneConfig
-	165, // 142: machine.ClusterConfig.cluster_network:type_name -> machine.ClusterNetworkConfig
-	193, // 143: machine.GenerateClientConfigurationRequest.crt_ttl:type_name -> google.protobuf.Duration
-	194, // 144: machine.GenerateClientConfiguration.metadata:type_name -> common.Metadata
-	168, // 145: machine.GenerateClientConfigurationResponse.messages:type_name -> machine.GenerateClientConfiguration
-	171, // 146: machine.PacketCaptureRequest.bpf_filter:type_name -> machine.BPFInstruction
-	12,  // 147: machine.NetstatRequest.filter:type_name -> machine.NetstatRequest.Filter
-	189, // 148: machine.NetstatRequest.feature:type_name -> machine.NetstatRequest.Feature
-	190, // 149: machine.NetstatRequest.l4proto:type_name -> machine.NetstatRequest.L4proto
-	191, // 150: machine.NetstatRequest.netns:type_name -> machine.NetstatRequest.NetNS
-	13,  // 151: machine.ConnectRecord.state:type_name -> machine.ConnectRecord.State
-	14,  // 152: machine.ConnectRecord.tr:type_name -> machine.ConnectRecord.TimerActive
-	192, // 153: machine.ConnectRecord.process:type_name -> machine.ConnectRecord.Process
-	194, // 154: machine.Netstat.metadata:type_name -> common.Metadata
-	173, // 155: machine.Netstat.connectrecord:type_name -> machine.ConnectRecord
-	174, // 156: machine.NetstatResponse.messages:type_name -> machine.Netstat
-	194, // 157: machine.MetaWrite.metadata:type_name -> common.Metadata
-	177, // 158: machine.MetaWriteResponse.messages:type_name -> machine.MetaWrite
-	194, // 159: machine.MetaDelete.metadata:type_name -> common.Metadata
-	180, // 160: machine.MetaDeleteResponse.messages:type_name -> machine.MetaDelete
-	199, // 161: machine.ImageListRequest.namespace:type_name -> common.ContainerdNamespace
-	194, // 162: machine.ImageListResponse.metadata:type_name -> common.Metadata
-	197, // 163: machine.ImageListResponse.created_at:type_name -> google.protobuf.Timestamp
-	199, // 164: machine.ImagePullRequest.namespace:type_name -> common.ContainerdNamespace
-	194, // 165: machine.ImagePull.metadata:type_name -> common.Metadata
-	185, // 166: machine.ImagePullResponse.messages:type_name -> machine.ImagePull
-	188, // 167: machine.MachineStatusEvent.MachineStatus.unmet_conditions:type_name -> machine.MachineStatusEvent.MachineStatus.UnmetCondition
-	15,  // 168: machine.MachineService.ApplyConfiguration:input_type -> machine.ApplyConfigurationRequest
-	21,  // 169: machine.MachineService.Bootstrap:input_type -> machine.BootstrapRequest
-	81,  // 170: machine.MachineService.Containers:input_type -> machine.ContainersRequest
-	60,  // 171: machine.MachineService.Copy:input_type -> machine.CopyRequest
-	200, // 172: machine.MachineService.CPUFreqStats:input_type -> google.protobuf.Empty
-	200, // 173: machine.MachineService.CPUInfo:input_type -> google.protobuf.Empty
-	200, // 174: machine.MachineService.DiskStats:input_type -> google.protobuf.Empty
-	85,  // 175: machine.MachineService.Dmesg:input_type -> machine.DmesgRequest
-	33,  // 176: machine.MachineService.Events:input_type -> machine.EventsRequest
-	131, // 177: machine.MachineService.EtcdMemberList:input_type -> machine.EtcdMemberListRequest
-	125, // 178: machine.MachineService.EtcdRemoveMemberByID:input_type -> machine.EtcdRemoveMemberByIDRequest
-	119, // 179: machine.MachineService.EtcdLeaveCluster:input_type -> machine.EtcdLeaveClusterRequest
-	128, // 180: machine.MachineService.EtcdForfeitLeadership:input_type -> machine.EtcdForfeitLeadershipRequest
-	201, // 181: machine.MachineService.EtcdRecover:input_type -> common.Data
-	135, // 182: machine.MachineService.EtcdSnapshot:input_type -> machine.EtcdSnapshotRequest
-	200, // 183: machine.MachineService.EtcdAlarmList:input_type -> google.protobuf.Empty
-	200, // 184: machine.MachineService.EtcdAlarmDisarm:input_type -> google.protobuf.Empty
-	200, // 185: machine.MachineService.EtcdDefragment:input_type -> google.protobuf.Empty
-	200, // 186: machine.MachineService.EtcdStatus:input_type -> google.protobuf.Empty
-	148, // 187: machine.MachineService.EtcdDowngradeValidate:input_type -> machine.EtcdDowngradeValidateRequest
-	151, // 188: machine.MachineService.EtcdDowngradeEnable:input_type -> machine.EtcdDowngradeEnableRequest
-	200, // 189: machine.MachineService.EtcdDowngradeCancel:input_type -> google.protobuf.Empty
-	200, // 190: machine.MachineService.Hostname:input_type -> google.protobuf.Empty
-	200, // 191: machine.MachineService.Kubeconfig:input_type -> google.protobuf.Empty
-	61,  // 192: machine.MachineService.List:input_type -> machine.ListRequest
-	62,  // 193: machine.MachineService.DiskUsage:input_type -> machine.DiskUsageRequest
-	200, // 194: machine.MachineService.LoadAvg:input_type -> google.protobuf.Empty
-	74,  // 195: machine.MachineService.Logs:input_type -> machine.LogsRequest
-	200, // 196: machine.MachineService.LogsContainers:input_type -> google.protobuf.Empty
-	200, // 197: machine.MachineService.Memory:input_type -> google.protobuf.Empty
-	200, // 198: machine.MachineService.Mounts:input_type -> google.protobuf.Empty
-	200, // 199: machine.MachineService.NetworkDeviceStats:input_type -> google.protobuf.Empty
-	200, // 200: machine.MachineService.Processes:input_type -> google.protobuf.Empty
-	75,  // 201: machine.MachineService.Read:input_type -> machine.ReadRequest
-	18,  // 202: machine.MachineService.Reboot:input_type -> machine.RebootRequest
-	89,  // 203: machine.MachineService.Restart:input_type -> machine.RestartRequest
-	78,  // 204: machine.MachineService.Rollback:input_type -> machine.RollbackRequest
-	36,  // 205: machine.MachineService.Reset:input_type -> machine.ResetRequest
-	200, // 206: machine.MachineService.ServiceList:input_type -> google.protobuf.Empty
-	57,  // 207: machine.MachineService.ServiceRestart:input_type -> machine.ServiceRestartRequest
-	51,  // 208: machine.MachineService.ServiceStart:input_type -> machine.ServiceStartRequest
-	54,  // 209: machine.MachineService.ServiceStop:input_type -> machine.ServiceStopRequest
-	40,  // 210: machine.MachineService.Shutdown:input_type -> machine.ShutdownRequest
-	92,  // 211: machine.MachineService.Stats:input_type -> machine.StatsRequest
-	200, // 212: machine.MachineService.SystemStat:input_type -> google.protobuf.Empty
-	42,  // 213: machine.MachineService.Upgrade:input_type -> machine.UpgradeRequest
-	200, // 214: machine.MachineService.Version:input_type -> google.protobuf.Empty
-	167, // 215: machine.MachineService.GenerateClientConfiguration:input_type -> machine.GenerateClientConfigurationRequest
-	170, // 216: machine.MachineService.PacketCapture:input_type -> machine.PacketCaptureRequest
-	172, // 217: machine.MachineService.Netstat:input_type -> machine.NetstatRequest
-	176, // 218: machine.MachineService.MetaWrite:input_type -> machine.MetaWriteRequest
-	179, // 219: machine.MachineService.MetaDelete:input_type -> machine.MetaDeleteRequest
-	182, // 220: machine.MachineService.ImageList:input_type -> machine.ImageListRequest
-	184, // 221: machine.MachineService.ImagePull:input_type -> machine.ImagePullRequest
-	17,  // 222: machine.MachineService.ApplyConfiguration:output_type -> machine.ApplyConfigurationResponse
-	23,  // 223: machine.MachineService.Bootstrap:output_type -> machine.BootstrapResponse
-	84,  // 224: machine.MachineService.Containers:output_type -> machine.ContainersResponse
-	201, // 225: machine.MachineService.Copy:output_type -> common.Data
-	107, // 226: machine.MachineService.CPUFreqStats:output_type -> machine.CPUFreqStatsResponse
-	110, // 227: machine.MachineService.CPUInfo:output_type -> machine.CPUInfoResponse
-	116, // 228: machine.MachineService.DiskStats:output_type -> machine.DiskStatsResponse
-	201, // 229: machine.MachineService.Dmesg:output_type -> common.Data
-	34,  // 230: machine.MachineService.Events:output_type -> machine.Event
-	134, // 231: machine.MachineService.EtcdMemberList:output_type -> machine.EtcdMemberListResponse
-	127, // 232: machine.MachineService.EtcdRemoveMemberByID:output_type -> machine.EtcdRemoveMemberByIDResponse
-	121, // 233: machine.MachineService.EtcdLeaveCluster:output_type -> machine.EtcdLeaveClusterResponse
-	130, // 234: machine.MachineService.EtcdForfeitLeadership:output_type -> machine.EtcdForfeitLeadershipResponse
-	137, // 235: machine.MachineService.EtcdRecover:output_type -> machine.EtcdRecoverResponse
-	201, // 236: machine.MachineService.EtcdSnapshot:output_type -> common.Data
-	138, // 237: machine.MachineService.EtcdAlarmList:output_type -> machine.EtcdAlarmListResponse
-	141, // 238: machine.MachineService.EtcdAlarmDisarm:output_type -> machine.EtcdAlarmDisarmResponse
-	143, // 239: machine.MachineService.EtcdDefragment:output_type -> machine.EtcdDefragmentResponse
-	145, // 240: machine.MachineService.EtcdStatus:output_type -> machine.EtcdStatusResponse
-	149, // 241: machine.MachineService.EtcdDowngradeValidate:output_type -> machine.EtcdDowngradeValidateResponse
-	152, // 242: machine.MachineService.EtcdDowngradeEnable:output_type -> machine.EtcdDowngradeEnableResponse
-	154, // 243: machine.MachineService.EtcdDowngradeCancel:output_type -> machine.EtcdDowngradeCancelResponse
-	99,  // 244: machine.MachineService.Hostname:output_type -> machine.HostnameResponse
-	201, // 245: machine.MachineService.Kubeconfig:output_type -> common.Data
-	63,  // 246: machine.MachineService.List:output_type -> machine.FileInfo
-	65,  // 247: machine.MachineService.DiskUsage:output_type -> machine.DiskUsageInfo
-	101, // 248: machine.MachineService.LoadAvg:output_type -> machine.LoadAvgResponse
-	201, // 249: machine.MachineService.Logs:output_type -> common.Data
-	77,  // 250: machine.MachineService.LogsContainers:output_type -> machine.LogsContainersResponse
-	97,  // 251: machine.MachineService.Memory:output_type -> machine.MemoryResponse
-	67,  // 252: machine.MachineService.Mounts:output_type -> machine.MountsResponse
-	113, // 253: machine.MachineService.NetworkDeviceStats:output_type -> machine.NetworkDeviceStatsResponse
-	86,  // 254: machine.MachineService.Processes:output_type -> machine.ProcessesResponse
-	201, // 255: machine.MachineService.Read:output_type -> common.Data
-	20,  // 256: machine.MachineService.Reboot:output_type -> machine.RebootResponse
-	91,  // 257: machine.MachineService.Restart:output_type -> machine.RestartResponse
-	80,  // 258: machine.MachineService.Rollback:output_type -> machine.RollbackResponse
-	38,  // 259: machine.MachineService.Reset:output_type -> machine.ResetResponse
-	46,  // 260: machine.MachineService.ServiceList:output_type -> machine.ServiceListResponse
-	59,  // 261: machine.MachineService.ServiceRestart:output_type -> machine.ServiceRestartResponse
-	53,  // 262: machine.MachineService.ServiceStart:output_type -> machine.ServiceStartResponse
-	56,  // 263: machine.MachineService.ServiceStop:output_type -> machine.ServiceStopResponse
-	41,  // 264: machine.MachineService.Shutdown:output_type -> machine.ShutdownResponse
-	94,  // 265: machine.MachineService.Stats:output_type -> machine.StatsResponse
-	103, // 266: machine.MachineService.SystemStat:output_type -> machine.SystemStatResponse
-	44,  // 267: machine.MachineService.Upgrade:output_type -> machine.UpgradeResponse
-	70,  // 268: machine.MachineService.Version:output_type -> machine.VersionResponse
-	169, // 269: machine.MachineService.GenerateClientConfiguration:output_type -> machine.GenerateClientConfigurationResponse
-	201, // 270: machine.MachineService.PacketCapture:output_type -> common.Data
-	175, // 271: machine.MachineService.Netstat:output_type -> machine.NetstatResponse
-	178, // 272: machine.MachineService.MetaWrite:output_type -> machine.MetaWriteResponse
-	181, // 273: machine.MachineService.MetaDelete:output_type -> machine.MetaDeleteResponse
-	183, // 274: machine.MachineService.ImageList:output_type -> machine.ImageListResponse
-	186, // 275: machine.MachineService.ImagePull:output_type -> machine.ImagePullResponse
-	222, // [222:276] is the sub-list for method output_type
-	168, // [168:222] is the sub-list for method input_type
-	168, // [168:168] is the sub-list for extension type_name
-	168, // [168:168] is the sub-list for extension extendee
-	0,   // [0:168] is the sub-list for field type_name
+	197, // 22: machine.ResetRequest.wipe_method:type_name -> storage.BlockDeviceWipeDescriptor.Method
+	193, // 23: machine.ResetRequest.erase_report_timeout:type_name -> google.protobuf.Duration
+	194, // 24: machine.Reset.metadata:type_name -> common.Metadata
+	37,  // 25: machine.ResetResponse.messages:type_name -> machine.Reset
+	194, // 26: machine.Shutdown.metadata:type_name -> common.Metadata
+	39,  // 27: machine.ShutdownResponse.messages:type_name -> machine.Shutdown
+	8,   // 28: machine.UpgradeRequest.reboot_mode:type_name -> machine.UpgradeRequest.RebootMode
+	194, // 29: machine.Upgrade.metadata:type_name -> common.Metadata
+	43,  // 30: machine.UpgradeResponse.messages:type_name -> machine.Upgrade
+	194, // 31: machine.ServiceList.metadata:type_name -> common.Metadata
+	47,  // 32: machine.ServiceList.services:type_name -> machine.ServiceInfo
+	45,  // 33: machine.ServiceListResponse.messages:type_name -> machine.ServiceList
+	48,  // 34: machine.ServiceInfo.events:type_name -> machine.ServiceEvents
+	50,  // 35: machine.ServiceInfo.health:type_name -> machine.ServiceHealth
+	49,  // 36: machine.ServiceEvents.events:type_name -> machine.ServiceEvent
+	198, // 37: machine.ServiceEvent.ts:type_name -> google.protobuf.Timestamp
+	198, // 38: machine.ServiceHealth.last_change:type_name -> google.protobuf.Timestamp
+	194, // 39: machine.ServiceStart.metadata:type_name -> common.Metadata
+	52,  // 40: machine.ServiceStartResponse.messages:type_name -> machine.ServiceStart
+	194, // 41: machine.ServiceStop.metadata:type_name -> common.Metadata
+	55,  // 42: machine.ServiceStopResponse.messages:type_name -> machine.ServiceStop
+	194, // 43: machine.ServiceRestart.metadata:type_name -> common.Metadata
+	58,  // 44: machine.ServiceRestartResponse.messages:type_name -> machine.ServiceRestart
+	9,   // 45: machine.ListRequest.types:type_name -> machine.ListRequest.Type
+	194, // 46: machine.FileInfo.metadata:type_name -> common.Metadata
+	64,  // 47: machine.FileInfo.xattrs:type_name -> machine.Xattr
+	194, // 48: machine.DiskUsageInfo.metadata:type_name -> common.Metadata
+	194, // 49: machine.Mounts.metadata:type_name -> common.Metadata
+	68,  // 50: machine.Mounts.stats:type_name -> machine.MountStat
+	66,  // 51: machine.MountsResponse.messages:type_name -> machine.Mounts
+	194, // 52: machine.Version.metadata:type_name -> common.Metadata
+	71,  // 53: machine.Version.version:type_name -> machine.VersionInfo
+	72,  // 54: machine.Version.platform:type_name -> machine.PlatformInfo
+	73,  // 55: machine.Version.features:type_name -> machine.FeaturesInfo
+	69,  // 56: machine.VersionResponse.messages:type_name -> machine.Version
+	199, // 57: machine.LogsRequest.driver:type_name -> common.ContainerDriver
+	194, // 58: machine.LogsContainer.metadata:type_name -> common.Metadata
+	76,  // 59: machine.LogsContainersResponse.messages:type_name -> machine.LogsContainer
+	194, // 60: machine.Rollback.metadata:type_name -> common.Metadata
+	79,  // 61: machine.RollbackResponse.messages:type_name -> machine.Rollback
+	199, // 62: machine.ContainersRequest.driver:type_name -> common.ContainerDriver
+	194, // 63: machine.Container.metadata:type_name -> common.Metadata
+	82,  // 64: machine.Container.containers:type_name -> machine.ContainerInfo
+	83,  // 65: machine.ContainersResponse.messages:type_name -> machine.Container
+	87,  // 66: machine.ProcessesResponse.messages:type_name -> machine.Process
+	194, // 67: machine.Process.metadata:type_name -> common.Metadata
+	88,  // 68: machine.Process.processes:type_name -> machine.ProcessInfo
+	199, // 69: machine.RestartRequest.driver:type_name -> common.ContainerDriver
+	194, // 70: machine.Restart.metadata:type_name -> common.Metadata
+	90,  // 71: machine.RestartResponse.messages:type_name -> machine.Restart
+	199, // 72: machine.StatsRequest.driver:type_name -> common.ContainerDriver
+	194, // 73: machine.Stats.metadata:type_name -> common.Metadata
+	95,  // 74: machine.Stats.stats:type_name -> machine.Stat
+	93,  // 75: machine.StatsResponse.messages:type_name -> machine.Stats
+	194, // 76: machine.Memory.metadata:type_name -> common.Metadata
+	98,  // 77: machine.Memory.meminfo:type_name -> machine.MemInfo
+	96,  // 78: machine.MemoryResponse.messages:type_name -> machine.Memory
+	100, // 79: machine.HostnameResponse.messages:type_name -> machine.Hostname
+	194, // 80: machine.Hostname.metadata:type_name -> common.Metadata
+	102, // 81: machine.LoadAvgResponse.messages:type_name -> machine.LoadAvg
+	194, // 82: machine.LoadAvg.metadata:type_name -> common.Metadata
+	104, // 83: machine.SystemStatResponse.messages:type_name -> machine.SystemStat
+	194, // 84: machine.SystemStat.metadata:type_name -> common.Metadata
+	105, // 85: machine.SystemStat.cpu_total:type_name -> machine.CPUStat
+	105, // 86: machine.SystemStat.cpu:type_name -> machine.CPUStat
+	106, // 87: machine.SystemStat.soft_irq:type_name -> machine.SoftIRQStat
+	108, // 88: machine.CPUFreqStatsResponse.messages:type_name -> machine.CPUsFreqStats
+	194, // 89: machine.CPUsFreqStats.metadata:type_name -> common.Metadata
+	109, // 90: machine.CPUsFreqStats.cpu_freq_stats:type_name -> machine.CPUFreqStats
+	111, // 91: machine.CPUInfoResponse.messages:type_name -> machine.CPUsInfo
+	194, // 92: machine.CPUsInfo.metadata:type_name -> common.Metadata
+	112, // 93: machine.CPUsInfo.cpu_info:type_name -> machine.CPUInfo
+	114, // 94: machine.NetworkDeviceStatsResponse.messages:type_name -> machine.NetworkDeviceStats
+	194, // 95: machine.NetworkDeviceStats.metadata:type_name -> common.Metadata
+	115, // 96: machine.NetworkDeviceStats.total:type_name -> machine.NetDev
+	115, // 97: machine.NetworkDeviceStats.devices:type_name -> machine.NetDev
+	117, // 98: machine.DiskStatsResponse.messages:type_name -> machine.DiskStats
+	194, // 99: machine.DiskStats.metadata:type_name -> common.Metadata
+	118, // 100: machine.DiskStats.total:type_name -> machine.DiskStat
+	118, // 101: machine.DiskStats.devices:type_name -> machine.DiskStat
+	194, // 102: machine.EtcdLeaveCluster.metadata:type_name -> common.Metadata
+	120, // 103: machine.EtcdLeaveClusterResponse.messages:type_name -> machine.EtcdLeaveCluster
+	194, // 104: machine.EtcdRemoveMember.metadata:type_name -> common.Metadata
+	123, // 105: machine.EtcdRemoveMemberResponse.messages:type_name -> machine.EtcdRemoveMember
+	194, // 106: machine.EtcdRemoveMemberByID.metadata:type_name -> common.Metadata
+	126, // 107: machine.EtcdRemoveMemberByIDResponse.messages:type_name -> machine.EtcdRemoveMemberByID
+	194, // 108: machine.EtcdForfeitLeadership.metadata:type_name -> common.Metadata
+	129, // 109: machine.EtcdForfeitLeadershipResponse.messages:type_name -> machine.EtcdForfeitLeadership
+	194, // 110: machine.EtcdMembers.metadata:type_name -> common.Metadata
+	132, // 111: machine.EtcdMembers.members:type_name -> machine.EtcdMember
+	133, // 112: machine.EtcdMemberListResponse.messages:type_name -> machine.EtcdMembers
+	194, // 113: machine.EtcdRecover.metadata:type_name -> common.Metadata
+	136, // 114: machine.EtcdRecoverResponse.messages:type_name -> machine.EtcdRecover
+	139, // 115: machine.EtcdAlarmListResponse.messages:type_name -> machine.EtcdAlarm
+	194, // 116: machine.EtcdAlarm.metadata:type_name -> common.Metadata
+	140, // 117: machine.EtcdAlarm.member_alarms:type_name -> machine.EtcdMemberAlarm
+	10,  // 118: machine.EtcdMemberAlarm.alarm:type_name -> machine.EtcdMemberAlarm.AlarmType
+	142, // 119: machine.EtcdAlarmDisarmResponse.messages:type_name -> machine.EtcdAlarmDisarm
+	194, // 120: machine.EtcdAlarmDisarm.metadata:type_name -> common.Metadata
+	140, // 121: machine.EtcdAlarmDisarm.member_alarms:type_name -> machine.EtcdMemberAlarm
+	144, // 122: machine.EtcdDefragmentResponse.messages:type_name -> machine.EtcdDefragment
+	194, // 123: machine.EtcdDefragment.metadata:type_name -> common.Metadata
+	146, // 124: machine.EtcdStatusResponse.messages:type_name -> machine.EtcdStatus
+	194, // 125: machine.EtcdStatus.metadata:type_name -> common.Metadata
+	147, // 126: machine.EtcdStatus.member_status:type_name -> machine.EtcdMemberStatus
+	150, // 127: machine.EtcdDowngradeValidateResponse.messages:type_name -> machine.EtcdDowngradeValidate
+	194, // 128: machine.EtcdDowngradeValidate.metadata:type_name -> common.Metadata
+	156, // 129: machine.EtcdDowngradeValidate.cluster_downgrade:type_name -> machine.EtcdClusterDowngrade
+	153, // 130: machine.EtcdDowngradeEnableResponse.messages:type_name -> machine.EtcdDowngradeEnable
+	194, // 131: machine.EtcdDowngradeEnable.metadata:type_name -> common.Metadata
+	156, // 132: machine.EtcdDowngradeEnable.cluster_downgrade:type_name -> machine.EtcdClusterDowngrade
+	155, // 133: machine.EtcdDowngradeCancelResponse.messages:type_name -> machine.EtcdDowngradeCancel
+	194, // 134: machine.EtcdDowngradeCancel.metadata:type_name -> common.Metadata
+	156, // 135: machine.EtcdDowngradeCancel.cluster_downgrade:type_name -> machine.EtcdClusterDowngrade
+	158, // 136: machine.NetworkDeviceConfig.dhcp_options:type_name -> machine.DHCPOptionsConfig
+	157, // 137: machine.NetworkDeviceConfig.routes:type_name -> machine.RouteConfig
+	159, // 138: machine.NetworkConfig.interfaces:type_name -> machine.NetworkDeviceConfig
+	11,  // 139: machine.MachineConfig.type:type_name -> machine.MachineConfig.MachineType
+	161, // 140: machine.MachineConfig.install_config:type_name -> machine.InstallConfig
+	160, // 141: machine.MachineConfig.network_config:type_name -> machine.NetworkConfig
+	164, // 142: machine.ClusterNetworkConfig.cni_config:type_name -> machine.CNIConfig
+	163, // 143: machine.ClusterConfig.control_plane:type_name -> machine.ControlPlaneConfig
+	165, // 144: machine.ClusterConfig.cluster_network:type_name -> machine.ClusterNetworkConfig
+	193, // 145: machine.GenerateClientConfigurationRequest.crt_ttl:type_name -> google.protobuf.Duration
+	194, // 146: machine.GenerateClientConfiguration.metadata:type_name -> common.Metadata
+	168, // 147: machine.GenerateClientConfigurationResponse.messages:type_name -> machine.GenerateClientConfiguration
+	171, // 148: machine.PacketCaptureRequest.bpf_filter:type_name -> machine.BPFInstruction
+	12,  // 149: machine.NetstatRequest.filter:type_name -> machine.NetstatRequest.Filter
+	189, // 150: machine.NetstatRequest.feature:type_name -> machine.NetstatRequest.Feature
+	190, // 151: machine.NetstatRequest.l4proto:type_name -> machine.NetstatRequest.L4proto
+	191, // 152: machine.NetstatRequest.netns:type_name -> machine.NetstatRequest.NetNS
+	13,  // 153: machine.ConnectRecord.state:type_name -> machine.ConnectRecord.State
+	14,  // 154: machine.ConnectRecord.tr:type_name -> machine.ConnectRecord.TimerActive
+	192, // 155: machine.ConnectRecord.process:type_name -> machine.ConnectRecord.Process
+	194, // 156: machine.Netstat.metadata:type_name -> common.Metadata
+	173, // 157: machine.Netstat.connectrecord:type_name -> machine.ConnectRecord
+	174, // 158: machine.NetstatResponse.messages:type_name -> machine.Netstat
+	194, // 159: machine.MetaWrite.metadata:type_name -> common.Metadata
+	177, // 160: machine.MetaWriteResponse.messages:type_name -> machine.MetaWrite
+	194, // 161: machine.MetaDelete.metadata:type_name -> common.Metadata
+	180, // 162: machine.MetaDeleteResponse.messages:type_name -> machine.MetaDelete
+	200, // 163: machine.ImageListRequest.namespace:type_name -> common.ContainerdNamespace
+	194, // 164: machine.ImageListResponse.metadata:type_name -> common.Metadata
+	198, // 165: machine.ImageListResponse.created_at:type_name -> google.protobuf.Timestamp
+	200, // 166: machine.ImagePullRequest.namespace:type_name -> common.ContainerdNamespace
+	194, // 167: machine.ImagePull.metadata:type_name -> common.Metadata
+	185, // 168: machine.ImagePullResponse.messages:type_name -> machine.ImagePull
+	188, // 169: machine.MachineStatusEvent.MachineStatus.unmet_conditions:type_name -> machine.MachineStatusEvent.MachineStatus.UnmetCondition
+	15,  // 170: machine.MachineService.ApplyConfiguration:input_type -> machine.ApplyConfigurationRequest
+	21,  // 171: machine.MachineService.Bootstrap:input_type -> machine.BootstrapRequest
+	81,  // 172: machine.MachineService.Containers:input_type -> machine.ContainersRequest
+	60,  // 173: machine.MachineService.Copy:input_type -> machine.CopyRequest
+	201, // 174: machine.MachineService.CPUFreqStats:input_type -> google.protobuf.Empty
+	201, // 175: machine.MachineService.CPUInfo:input_type -> google.protobuf.Empty
+	201, // 176: machine.MachineService.DiskStats:input_type -> google.protobuf.Empty
+	85,  // 177: machine.MachineService.Dmesg:input_type -> machine.DmesgRequest
+	33,  // 178: machine.MachineService.Events:input_type -> machine.EventsRequest
+	131, // 179: machine.MachineService.EtcdMemberList:input_type -> machine.EtcdMemberListRequest
+	125, // 180: machine.MachineService.EtcdRemoveMemberByID:input_type -> machine.EtcdRemoveMemberByIDRequest
+	119, // 181: machine.MachineService.EtcdLeaveCluster:input_type -> machine.EtcdLeaveClusterRequest
+	128, // 182: machine.MachineService.EtcdForfeitLeadership:input_type -> machine.EtcdForfeitLeadershipRequest
+	202, // 183: machine.MachineService.EtcdRecover:input_type -> common.Data
+	135, // 184: machine.MachineService.EtcdSnapshot:input_type -> machine.EtcdSnapshotRequest
+	201, // 185: machine.MachineService.EtcdAlarmList:input_type -> google.protobuf.Empty
+	201, // 186: machine.MachineService.EtcdAlarmDisarm:input_type -> google.protobuf.Empty
+	201, // 187: machine.MachineService.EtcdDefragment:input_type -> google.protobuf.Empty
+	201, // 188: machine.MachineService.EtcdStatus:input_type -> google.protobuf.Empty
+	148, // 189: machine.MachineService.EtcdDowngradeValidate:input_type -> machine.EtcdDowngradeValidateRequest
+	151, // 190: machine.MachineService.EtcdDowngradeEnable:input_type -> machine.EtcdDowngradeEnableRequest
+	201, // 191: machine.MachineService.EtcdDowngradeCancel:input_type -> google.protobuf.Empty
+	201, // 192: machine.MachineService.Hostname:input_type -> google.protobuf.Empty
+	201, // 193: machine.MachineService.Kubeconfig:input_type -> google.protobuf.Empty
+	61,  // 194: machine.MachineService.List:input_type -> machine.ListRequest
+	62,  // 195: machine.MachineService.DiskUsage:input_type -> machine.DiskUsageRequest
+	201, // 196: machine.MachineService.LoadAvg:input_type -> google.protobuf.Empty
+	74,  // 197: machine.MachineService.Logs:input_type -> machine.LogsRequest
+	201, // 198: machine.MachineService.LogsContainers:input_type -> google.protobuf.Empty
+	201, // 199: machine.MachineService.Memory:input_type -> google.protobuf.Empty
+	201, // 200: machine.MachineService.Mounts:input_type -> google.protobuf.Empty
+	201, // 201: machine.MachineService.NetworkDeviceStats:input_type -> google.protobuf.Empty
+	201, // 202: machine.MachineService.Processes:input_type -> google.protobuf.Empty
+	75,  // 203: machine.MachineService.Read:input_type -> machine.ReadRequest
+	18,  // 204: machine.MachineService.Reboot:input_type -> machine.RebootRequest
+	89,  // 205: machine.MachineService.Restart:input_type -> machine.RestartRequest
+	78,  // 206: machine.MachineService.Rollback:input_type -> machine.RollbackRequest
+	36,  // 207: machine.MachineService.Reset:input_type -> machine.ResetRequest
+	201, // 208: machine.MachineService.ServiceList:input_type -> google.protobuf.Empty
+	57,  // 209: machine.MachineService.ServiceRestart:input_type -> machine.ServiceRestartRequest
+	51,  // 210: machine.MachineService.ServiceStart:input_type -> machine.ServiceStartRequest
+	54,  // 211: machine.MachineService.ServiceStop:input_type -> machine.ServiceStopRequest
+	40,  // 212: machine.MachineService.Shutdown:input_type -> machine.ShutdownRequest
+	92,  // 213: machine.MachineService.Stats:input_type -> machine.StatsRequest
+	201, // 214: machine.MachineService.SystemStat:input_type -> google.protobuf.Empty
+	42,  // 215: machine.MachineService.Upgrade:input_type -> machine.UpgradeRequest
+	201, // 216: machine.MachineService.Version:input_type -> google.protobuf.Empty
+	167, // 217: machine.MachineService.GenerateClientConfiguration:input_type -> machine.GenerateClientConfigurationRequest
+	170, // 218: machine.MachineService.PacketCapture:input_type -> machine.PacketCaptureRequest
+	172, // 219: machine.MachineService.Netstat:input_type -> machine.NetstatRequest
+	176, // 220: machine.MachineService.MetaWrite:input_type -> machine.MetaWriteRequest
+	179, // 221: machine.MachineService.MetaDelete:input_type -> machine.MetaDeleteRequest
+	182, // 222: machine.MachineService.ImageList:input_type -> machine.ImageListRequest
+	184, // 223: machine.MachineService.ImagePull:input_type -> machine.ImagePullRequest
+	17,  // 224: machine.MachineService.ApplyConfiguration:output_type -> machine.ApplyConfigurationResponse
+	23,  // 225: machine.MachineService.Bootstrap:output_type -> machine.BootstrapResponse
+	84,  // 226: machine.MachineService.Containers:output_type -> machine.ContainersResponse
+	202, // 227: machine.MachineService.Copy:output_type -> common.Data
+	107, // 228: machine.MachineService.CPUFreqStats:output_type -> machine.CPUFreqStatsResponse
+	110, // 229: machine.MachineService.CPUInfo:output_type -> machine.CPUInfoResponse
+	116, // 230: machine.MachineService.DiskStats:output_type -> machine.DiskStatsResponse
+	202, // 231: machine.MachineService.Dmesg:output_type -> common.Data
+	34,  // 232: machine.MachineService.Events:output_type -> machine.Event
+	134, // 233: machine.MachineService.EtcdMemberList:output_type -> machine.EtcdMemberListResponse
+	127, // 234: machine.MachineService.EtcdRemoveMemberByID:output_type -> machine.EtcdRemoveMemberByIDResponse
+	121, // 235: machine.MachineService.EtcdLeaveCluster:output_type -> machine.EtcdLeaveClusterResponse
+	130, // 236: machine.MachineService.EtcdForfeitLeadership:output_type -> machine.EtcdForfeitLeadershipResponse
+	137, // 237: machine.MachineService.EtcdRecover:output_type -> machine.EtcdRecoverResponse
+	202, // 238: machine.MachineService.EtcdSnapshot:output_type -> common.Data
+	138, // 239: machine.MachineService.EtcdAlarmList:output_type -> machine.EtcdAlarmListResponse
+	141, // 240: machine.MachineService.EtcdAlarmDisarm:output_type -> machine.EtcdAlarmDisarmResponse
+	143, // 241: machine.MachineService.EtcdDefragment:output_type -> machine.EtcdDefragmentResponse
+	145, // 242: machine.MachineService.EtcdStatus:output_type -> machine.EtcdStatusResponse
+	149, // 243: machine.MachineService.EtcdDowngradeValidate:output_type -> machine.EtcdDowngradeValidateResponse
+	152, // 244: machine.MachineService.EtcdDowngradeEnable:output_type -> machine.EtcdDowngradeEnableResponse
+	154, // 245: machine.MachineService.EtcdDowngradeCancel:output_type -> machine.EtcdDowngradeCancelResponse
+	99,  // 246: machine.MachineService.Hostname:output_type -> machine.HostnameResponse
+	202, // 247: machine.MachineService.Kubeconfig:output_type -> common.Data
+	63,  // 248: machine.MachineService.List:output_type -> machine.FileInfo
+	65,  // 249: machine.MachineService.DiskUsage:output_type -> machine.DiskUsageInfo
+	101, // 250: machine.MachineService.LoadAvg:output_type -> machine.LoadAvgResponse
+	202, // 251: machine.MachineService.Logs:output_type -> common.Data
+	77,  // 252: machine.MachineService.LogsContainers:output_type -> machine.LogsContainersResponse
+	97,  // 253: machine.MachineService.Memory:output_type -> machine.MemoryResponse
+	67,  // 254: machine.MachineService.Mounts:output_type -> machine.MountsResponse
+	113, // 255: machine.MachineService.NetworkDeviceStats:output_type -> machine.NetworkDeviceStatsResponse
+	86,  // 256: machine.MachineService.Processes:output_type -> machine.ProcessesResponse
+	202, // 257: machine.MachineService.Read:output_type -> common.Data
+	20,  // 258: machine.MachineService.Reboot:output_type -> machine.RebootResponse
+	91,  // 259: machine.MachineService.Restart:output_type -> machine.RestartResponse
+	80,  // 260: machine.MachineService.Rollback:output_type -> machine.RollbackResponse
+	38,  // 261: machine.MachineService.Reset:output_type -> machine.ResetResponse
+	46,  // 262: machine.MachineService.ServiceList:output_type -> machine.ServiceListResponse
+	59,  // 263: machine.MachineService.ServiceRestart:output_type -> machine.ServiceRestartResponse
+	53,  // 264: machine.MachineService.ServiceStart:output_type -> machine.ServiceStartResponse
+	56,  // 265: machine.MachineService.ServiceStop:output_type -> machine.ServiceStopResponse
+	41,  // 266: machine.MachineService.Shutdown:output_type -> machine.ShutdownResponse
+	94,  // 267: machine.MachineService.Stats:output_type -> machine.StatsResponse
+	103, // 268: machine.MachineService.SystemStat:output_type -> machine.SystemStatResponse
+	44,  // 269: machine.MachineService.Upgrade:output_type -> machine.UpgradeResponse
+	70,  // 270: machine.MachineService.Version:output_type -> machine.VersionResponse
+	169, // 271: machine.MachineService.GenerateClientConfiguration:output_type -> machine.GenerateClientConfigurationResponse
+	202, // 272: machine.MachineService.PacketCapture:output_type -> common.Data
+	175, // 273: machine.MachineService.Netstat:output_type -> machine.NetstatResponse
+	178, // 274: machine.MachineService.MetaWrite:output_type -> machine.MetaWriteResponse
+	181, // 275: machine.MachineService.MetaDelete:output_type -> machine.MetaDeleteResponse
+	183, // 276: machine.MachineService.ImageList:output_type -> machine.ImageListResponse
+	186, // 277: machine.MachineService.ImagePull:output_type -> machine.ImagePullResponse
+	224, // [224:278] is the sub-list for method output_type
+	170, // [170:224] is the sub-list for method input_type
+	170, // [170:170] is the sub-list for extension type_name
+	170, // [170:170] is the sub-list for extension extendee
+	0,   // [0:170] is the sub-list for field type_name
 }
 
 func init() { file_machine_machine_proto_init() }
@@ -547,6 +547,7 @@ type ServiceEndpoint struct {
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Endpoint      string                 `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
 	Insecure      bool                   `protobuf:"varint,3,opt,name=insecure,proto3" json:"insecure,omitempty"`
+	CaCerts       []byte                 `protobuf:"bytes,4,opt,name=ca_certs,json=caCerts,proto3" json:"ca_certs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -602,6 +603,13 @@ func (x *ServiceEndpoint) GetInsecure() bool {
 	return false
 }
 
+func (x *ServiceEndpoint) GetCaCerts() []byte {
+	if x != nil {
+		return x.CaCerts
+	}
+	return nil
+}
+
 var File_resource_definitions_cluster_cluster_proto protoreflect.FileDescriptor
 
 const file_resource_definitions_cluster_cluster_proto_rawDesc = "" +
@@ -648,11 +656,12 @@ const file_resource_definitions_cluster_cluster_proto_rawDesc = "" +
 	"\bhostname\x18\x03 \x01(\tR\bhostname\x12P\n" +
 	"\fmachine_type\x18\x04 \x01(\x0e2-.talos.resource.definitions.enums.MachineTypeR\vmachineType\x12)\n" +
 	"\x10operating_system\x18\x05 \x01(\tR\x0foperatingSystem\x12U\n" +
-	"\rcontrol_plane\x18\x06 \x01(\v20.talos.resource.definitions.cluster.ControlPlaneR\fcontrolPlane\"]\n" +
+	"\rcontrol_plane\x18\x06 \x01(\v20.talos.resource.definitions.cluster.ControlPlaneR\fcontrolPlane\"x\n" +
 	"\x0fServiceEndpoint\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
 	"\bendpoint\x18\x02 \x01(\tR\bendpoint\x12\x1a\n" +
-	"\binsecure\x18\x03 \x01(\bR\binsecureBx\n" +
+	"\binsecure\x18\x03 \x01(\bR\binsecure\x12\x19\n" +
+	"\bca_certs\x18\x04 \x01(\fR\acaCertsBx\n" +
 	"*dev.talos.api.resource.definitions.clusterZJgithub.com/siderolabs/talos/pkg/machinery/api/resource/definitions/clusterb\x06proto3"
 
 var (
@@ -621,6 +621,13 @@ func (m *ServiceEndpoint) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if len(m.CaCerts) > 0 {
+		i -= len(m.CaCerts)
+		copy(dAtA[i:], m.CaCerts)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.CaCerts)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Insecure {
 		i--
 		if m.Insecure {
@@ -900,6 +907,10 @@ func (m *ServiceEndpoint) SizeVT() (n int) {
 	if m.Insecure {
 		n += 2
 	}
+	l = len(m.CaCerts)
+	if l > 0 {
+		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -2357,6 +2368,40 @@ func (m *ServiceEndpoint) UnmarshalVT(dAtA []byte) error {
 				}
 			}
 			m.Insecure = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CaCerts", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CaCerts = append(m.CaCerts[:0], dAtA[iNdEx:postIndex]...)
+			if m.CaCerts == nil {
+				m.CaCerts = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
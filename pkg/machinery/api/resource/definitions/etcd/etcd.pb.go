@@ -13,6 +13,8 @@ import (
 
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 
 	common "github.com/siderolabs/talos/pkg/machinery/api/common"
 )
@@ -154,6 +156,184 @@ func (x *ConfigSpec) GetExtraArgs() map[string]*ArgValues {
 	return nil
 }
 
+// MaintenanceEvent describes a single maintenance operation on the etcd member.
+type MaintenanceEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     string                 `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	Duration      *durationpb.Duration   `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	DbSizeBefore  int64                  `protobuf:"varint,4,opt,name=db_size_before,json=dbSizeBefore,proto3" json:"db_size_before,omitempty"`
+	DbSizeAfter   int64                  `protobuf:"varint,5,opt,name=db_size_after,json=dbSizeAfter,proto3" json:"db_size_after,omitempty"`
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaintenanceEvent) Reset() {
+	*x = MaintenanceEvent{}
+	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceEvent) ProtoMessage() {}
+
+func (x *MaintenanceEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceEvent.ProtoReflect.Descriptor instead.
+func (*MaintenanceEvent) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_etcd_etcd_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MaintenanceEvent) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *MaintenanceEvent) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *MaintenanceEvent) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *MaintenanceEvent) GetDbSizeBefore() int64 {
+	if x != nil {
+		return x.DbSizeBefore
+	}
+	return 0
+}
+
+func (x *MaintenanceEvent) GetDbSizeAfter() int64 {
+	if x != nil {
+		return x.DbSizeAfter
+	}
+	return 0
+}
+
+func (x *MaintenanceEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// MaintenanceStatusSpec describes the state of the local etcd member database.
+type MaintenanceStatusSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Leader        bool                   `protobuf:"varint,2,opt,name=leader,proto3" json:"leader,omitempty"`
+	DbSize        int64                  `protobuf:"varint,3,opt,name=db_size,json=dbSize,proto3" json:"db_size,omitempty"`
+	DbSizeInUse   int64                  `protobuf:"varint,4,opt,name=db_size_in_use,json=dbSizeInUse,proto3" json:"db_size_in_use,omitempty"`
+	DbSizeQuota   int64                  `protobuf:"varint,5,opt,name=db_size_quota,json=dbSizeQuota,proto3" json:"db_size_quota,omitempty"`
+	LastCheckTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_check_time,json=lastCheckTime,proto3" json:"last_check_time,omitempty"`
+	History       []*MaintenanceEvent    `protobuf:"bytes,7,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaintenanceStatusSpec) Reset() {
+	*x = MaintenanceStatusSpec{}
+	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceStatusSpec) ProtoMessage() {}
+
+func (x *MaintenanceStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceStatusSpec.ProtoReflect.Descriptor instead.
+func (*MaintenanceStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_etcd_etcd_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MaintenanceStatusSpec) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *MaintenanceStatusSpec) GetLeader() bool {
+	if x != nil {
+		return x.Leader
+	}
+	return false
+}
+
+func (x *MaintenanceStatusSpec) GetDbSize() int64 {
+	if x != nil {
+		return x.DbSize
+	}
+	return 0
+}
+
+func (x *MaintenanceStatusSpec) GetDbSizeInUse() int64 {
+	if x != nil {
+		return x.DbSizeInUse
+	}
+	return 0
+}
+
+func (x *MaintenanceStatusSpec) GetDbSizeQuota() int64 {
+	if x != nil {
+		return x.DbSizeQuota
+	}
+	return 0
+}
+
+func (x *MaintenanceStatusSpec) GetLastCheckTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastCheckTime
+	}
+	return nil
+}
+
+func (x *MaintenanceStatusSpec) GetHistory() []*MaintenanceEvent {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
 // MemberSpec holds information about an etcd member.
 type MemberSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -164,7 +344,7 @@ type MemberSpec struct {
 
 func (x *MemberSpec) Reset() {
 	*x = MemberSpec{}
-	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[2]
+	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -176,7 +356,7 @@ func (x *MemberSpec) String() string {
 func (*MemberSpec) ProtoMessage() {}
 
 func (x *MemberSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[2]
+	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -189,7 +369,7 @@ func (x *MemberSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemberSpec.ProtoReflect.Descriptor instead.
 func (*MemberSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_etcd_etcd_proto_rawDescGZIP(), []int{2}
+	return file_resource_definitions_etcd_etcd_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *MemberSpec) GetMemberId() string {
@@ -210,7 +390,7 @@ type PKIStatusSpec struct {
 
 func (x *PKIStatusSpec) Reset() {
 	*x = PKIStatusSpec{}
-	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[3]
+	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -222,7 +402,7 @@ func (x *PKIStatusSpec) String() string {
 func (*PKIStatusSpec) ProtoMessage() {}
 
 func (x *PKIStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[3]
+	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -235,7 +415,7 @@ func (x *PKIStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PKIStatusSpec.ProtoReflect.Descriptor instead.
 func (*PKIStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_etcd_etcd_proto_rawDescGZIP(), []int{3}
+	return file_resource_definitions_etcd_etcd_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *PKIStatusSpec) GetReady() bool {
@@ -267,7 +447,7 @@ type SpecSpec struct {
 
 func (x *SpecSpec) Reset() {
 	*x = SpecSpec{}
-	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[4]
+	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -279,7 +459,7 @@ func (x *SpecSpec) String() string {
 func (*SpecSpec) ProtoMessage() {}
 
 func (x *SpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[4]
+	mi := &file_resource_definitions_etcd_etcd_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -292,7 +472,7 @@ func (x *SpecSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SpecSpec.ProtoReflect.Descriptor instead.
 func (*SpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_etcd_etcd_proto_rawDescGZIP(), []int{4}
+	return file_resource_definitions_etcd_etcd_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SpecSpec) GetName() string {
@@ -341,7 +521,7 @@ var File_resource_definitions_etcd_etcd_proto protoreflect.FileDescriptor
 
 const file_resource_definitions_etcd_etcd_proto_rawDesc = "" +
 	"\n" +
-	"$resource/definitions/etcd/etcd.proto\x12\x1ftalos.resource.definitions.etcd\x1a\x13common/common.proto\"#\n" +
+	"$resource/definitions/etcd/etcd.proto\x12\x1ftalos.resource.definitions.etcd\x1a\x13common/common.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"#\n" +
 	"\tArgValues\x12\x16\n" +
 	"\x06values\x18\x01 \x03(\tR\x06values\"\xc3\x03\n" +
 	"\n" +
@@ -355,7 +535,23 @@ const file_resource_definitions_etcd_etcd_proto_rawDesc = "" +
 	"extra_args\x18\a \x03(\v2:.talos.resource.definitions.etcd.ConfigSpec.ExtraArgsEntryR\textraArgs\x1ah\n" +
 	"\x0eExtraArgsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12@\n" +
-	"\x05value\x18\x02 \x01(\v2*.talos.resource.definitions.etcd.ArgValuesR\x05value:\x028\x01\")\n" +
+	"\x05value\x18\x02 \x01(\v2*.talos.resource.definitions.etcd.ArgValuesR\x05value:\x028\x01\"\x82\x02\n" +
+	"\x10MaintenanceEvent\x12\x1c\n" +
+	"\toperation\x18\x01 \x01(\tR\toperation\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bduration\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12$\n" +
+	"\x0edb_size_before\x18\x04 \x01(\x03R\fdbSizeBefore\x12\"\n" +
+	"\rdb_size_after\x18\x05 \x01(\x03R\vdbSizeAfter\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"\xbf\x02\n" +
+	"\x15MaintenanceStatusSpec\x12\x1b\n" +
+	"\tmember_id\x18\x01 \x01(\tR\bmemberId\x12\x16\n" +
+	"\x06leader\x18\x02 \x01(\bR\x06leader\x12\x17\n" +
+	"\adb_size\x18\x03 \x01(\x03R\x06dbSize\x12#\n" +
+	"\x0edb_size_in_use\x18\x04 \x01(\x03R\vdbSizeInUse\x12\"\n" +
+	"\rdb_size_quota\x18\x05 \x01(\x03R\vdbSizeQuota\x12B\n" +
+	"\x0flast_check_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rlastCheckTime\x12K\n" +
+	"\ahistory\x18\a \x03(\v21.talos.resource.definitions.etcd.MaintenanceEventR\ahistory\")\n" +
 	"\n" +
 	"MemberSpec\x12\x1b\n" +
 	"\tmember_id\x18\x01 \x01(\tR\bmemberId\"?\n" +
@@ -387,30 +583,38 @@ func file_resource_definitions_etcd_etcd_proto_rawDescGZIP() []byte {
 	return file_resource_definitions_etcd_etcd_proto_rawDescData
 }
 
-var file_resource_definitions_etcd_etcd_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_resource_definitions_etcd_etcd_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_resource_definitions_etcd_etcd_proto_goTypes = []any{
-	(*ArgValues)(nil),     // 0: talos.resource.definitions.etcd.ArgValues
-	(*ConfigSpec)(nil),    // 1: talos.resource.definitions.etcd.ConfigSpec
-	(*MemberSpec)(nil),    // 2: talos.resource.definitions.etcd.MemberSpec
-	(*PKIStatusSpec)(nil), // 3: talos.resource.definitions.etcd.PKIStatusSpec
-	(*SpecSpec)(nil),      // 4: talos.resource.definitions.etcd.SpecSpec
-	nil,                   // 5: talos.resource.definitions.etcd.ConfigSpec.ExtraArgsEntry
-	nil,                   // 6: talos.resource.definitions.etcd.SpecSpec.ExtraArgsEntry
-	(*common.NetIP)(nil),  // 7: common.NetIP
+	(*ArgValues)(nil),             // 0: talos.resource.definitions.etcd.ArgValues
+	(*ConfigSpec)(nil),            // 1: talos.resource.definitions.etcd.ConfigSpec
+	(*MaintenanceEvent)(nil),      // 2: talos.resource.definitions.etcd.MaintenanceEvent
+	(*MaintenanceStatusSpec)(nil), // 3: talos.resource.definitions.etcd.MaintenanceStatusSpec
+	(*MemberSpec)(nil),            // 4: talos.resource.definitions.etcd.MemberSpec
+	(*PKIStatusSpec)(nil),         // 5: talos.resource.definitions.etcd.PKIStatusSpec
+	(*SpecSpec)(nil),              // 6: talos.resource.definitions.etcd.SpecSpec
+	nil,                           // 7: talos.resource.definitions.etcd.ConfigSpec.ExtraArgsEntry
+	nil,                           // 8: talos.resource.definitions.etcd.SpecSpec.ExtraArgsEntry
+	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 10: google.protobuf.Duration
+	(*common.NetIP)(nil),          // 11: common.NetIP
 }
 var file_resource_definitions_etcd_etcd_proto_depIdxs = []int32{
-	5, // 0: talos.resource.definitions.etcd.ConfigSpec.extra_args:type_name -> talos.resource.definitions.etcd.ConfigSpec.ExtraArgsEntry
-	7, // 1: talos.resource.definitions.etcd.SpecSpec.advertised_addresses:type_name -> common.NetIP
-	7, // 2: talos.resource.definitions.etcd.SpecSpec.listen_peer_addresses:type_name -> common.NetIP
-	7, // 3: talos.resource.definitions.etcd.SpecSpec.listen_client_addresses:type_name -> common.NetIP
-	6, // 4: talos.resource.definitions.etcd.SpecSpec.extra_args:type_name -> talos.resource.definitions.etcd.SpecSpec.ExtraArgsEntry
-	0, // 5: talos.resource.definitions.etcd.ConfigSpec.ExtraArgsEntry.value:type_name -> talos.resource.definitions.etcd.ArgValues
-	0, // 6: talos.resource.definitions.etcd.SpecSpec.ExtraArgsEntry.value:type_name -> talos.resource.definitions.etcd.ArgValues
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	7,  // 0: talos.resource.definitions.etcd.ConfigSpec.extra_args:type_name -> talos.resource.definitions.etcd.ConfigSpec.ExtraArgsEntry
+	9,  // 1: talos.resource.definitions.etcd.MaintenanceEvent.start_time:type_name -> google.protobuf.Timestamp
+	10, // 2: talos.resource.definitions.etcd.MaintenanceEvent.duration:type_name -> google.protobuf.Duration
+	9,  // 3: talos.resource.definitions.etcd.MaintenanceStatusSpec.last_check_time:type_name -> google.protobuf.Timestamp
+	2,  // 4: talos.resource.definitions.etcd.MaintenanceStatusSpec.history:type_name -> talos.resource.definitions.etcd.MaintenanceEvent
+	11, // 5: talos.resource.definitions.etcd.SpecSpec.advertised_addresses:type_name -> common.NetIP
+	11, // 6: talos.resource.definitions.etcd.SpecSpec.listen_peer_addresses:type_name -> common.NetIP
+	11, // 7: talos.resource.definitions.etcd.SpecSpec.listen_client_addresses:type_name -> common.NetIP
+	8,  // 8: talos.resource.definitions.etcd.SpecSpec.extra_args:type_name -> talos.resource.definitions.etcd.SpecSpec.ExtraArgsEntry
+	0,  // 9: talos.resource.definitions.etcd.ConfigSpec.ExtraArgsEntry.value:type_name -> talos.resource.definitions.etcd.ArgValues
+	0,  // 10: talos.resource.definitions.etcd.SpecSpec.ExtraArgsEntry.value:type_name -> talos.resource.definitions.etcd.ArgValues
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_resource_definitions_etcd_etcd_proto_init() }
@@ -424,7 +628,7 @@ func file_resource_definitions_etcd_etcd_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resource_definitions_etcd_etcd_proto_rawDesc), len(file_resource_definitions_etcd_etcd_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -654,6 +654,306 @@ func (x *ProcessorSpec) GetThreadCount() uint32 {
 	return 0
 }
 
+// SRIOVConfigSpec describes SR-IOV configuration of a physical function.
+type SRIOVConfigSpec struct {
+	state            protoimpl.MessageState      `protogen:"open.v1"`
+	PhysicalFunction string                      `protobuf:"bytes,1,opt,name=physical_function,json=physicalFunction,proto3" json:"physical_function,omitempty"`
+	LinkName         string                      `protobuf:"bytes,2,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	NumVFs           uint32                      `protobuf:"varint,3,opt,name=num_v_fs,json=numVFs,proto3" json:"num_v_fs,omitempty"`
+	Driver           string                      `protobuf:"bytes,4,opt,name=driver,proto3" json:"driver,omitempty"`
+	VFs              []*SRIOVVirtualFunctionSpec `protobuf:"bytes,5,rep,name=v_fs,json=vFs,proto3" json:"v_fs,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SRIOVConfigSpec) Reset() {
+	*x = SRIOVConfigSpec{}
+	mi := &file_resource_definitions_hardware_hardware_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SRIOVConfigSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRIOVConfigSpec) ProtoMessage() {}
+
+func (x *SRIOVConfigSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_hardware_hardware_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRIOVConfigSpec.ProtoReflect.Descriptor instead.
+func (*SRIOVConfigSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_hardware_hardware_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SRIOVConfigSpec) GetPhysicalFunction() string {
+	if x != nil {
+		return x.PhysicalFunction
+	}
+	return ""
+}
+
+func (x *SRIOVConfigSpec) GetLinkName() string {
+	if x != nil {
+		return x.LinkName
+	}
+	return ""
+}
+
+func (x *SRIOVConfigSpec) GetNumVFs() uint32 {
+	if x != nil {
+		return x.NumVFs
+	}
+	return 0
+}
+
+func (x *SRIOVConfigSpec) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *SRIOVConfigSpec) GetVFs() []*SRIOVVirtualFunctionSpec {
+	if x != nil {
+		return x.VFs
+	}
+	return nil
+}
+
+// SRIOVStatusSpec describes SR-IOV status of a physical function.
+type SRIOVStatusSpec struct {
+	state            protoimpl.MessageState        `protogen:"open.v1"`
+	PhysicalFunction string                        `protobuf:"bytes,1,opt,name=physical_function,json=physicalFunction,proto3" json:"physical_function,omitempty"`
+	LinkName         string                        `protobuf:"bytes,2,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	TotalVFs         uint32                        `protobuf:"varint,3,opt,name=total_v_fs,json=totalVFs,proto3" json:"total_v_fs,omitempty"`
+	NumVFs           uint32                        `protobuf:"varint,4,opt,name=num_v_fs,json=numVFs,proto3" json:"num_v_fs,omitempty"`
+	VFs              []*SRIOVVirtualFunctionStatus `protobuf:"bytes,5,rep,name=v_fs,json=vFs,proto3" json:"v_fs,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SRIOVStatusSpec) Reset() {
+	*x = SRIOVStatusSpec{}
+	mi := &file_resource_definitions_hardware_hardware_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SRIOVStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRIOVStatusSpec) ProtoMessage() {}
+
+func (x *SRIOVStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_hardware_hardware_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRIOVStatusSpec.ProtoReflect.Descriptor instead.
+func (*SRIOVStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_hardware_hardware_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SRIOVStatusSpec) GetPhysicalFunction() string {
+	if x != nil {
+		return x.PhysicalFunction
+	}
+	return ""
+}
+
+func (x *SRIOVStatusSpec) GetLinkName() string {
+	if x != nil {
+		return x.LinkName
+	}
+	return ""
+}
+
+func (x *SRIOVStatusSpec) GetTotalVFs() uint32 {
+	if x != nil {
+		return x.TotalVFs
+	}
+	return 0
+}
+
+func (x *SRIOVStatusSpec) GetNumVFs() uint32 {
+	if x != nil {
+		return x.NumVFs
+	}
+	return 0
+}
+
+func (x *SRIOVStatusSpec) GetVFs() []*SRIOVVirtualFunctionStatus {
+	if x != nil {
+		return x.VFs
+	}
+	return nil
+}
+
+// SRIOVVirtualFunctionSpec describes configuration of a virtual function.
+type SRIOVVirtualFunctionSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         uint32                 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	HardwareAddr  []byte                 `protobuf:"bytes,2,opt,name=hardware_addr,json=hardwareAddr,proto3" json:"hardware_addr,omitempty"`
+	Vlanid        uint32                 `protobuf:"varint,3,opt,name=vlanid,proto3" json:"vlanid,omitempty"`
+	Trust         bool                   `protobuf:"varint,4,opt,name=trust,proto3" json:"trust,omitempty"`
+	SpoofCheck    bool                   `protobuf:"varint,5,opt,name=spoof_check,json=spoofCheck,proto3" json:"spoof_check,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SRIOVVirtualFunctionSpec) Reset() {
+	*x = SRIOVVirtualFunctionSpec{}
+	mi := &file_resource_definitions_hardware_hardware_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SRIOVVirtualFunctionSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRIOVVirtualFunctionSpec) ProtoMessage() {}
+
+func (x *SRIOVVirtualFunctionSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_hardware_hardware_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRIOVVirtualFunctionSpec.ProtoReflect.Descriptor instead.
+func (*SRIOVVirtualFunctionSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_hardware_hardware_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SRIOVVirtualFunctionSpec) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SRIOVVirtualFunctionSpec) GetHardwareAddr() []byte {
+	if x != nil {
+		return x.HardwareAddr
+	}
+	return nil
+}
+
+func (x *SRIOVVirtualFunctionSpec) GetVlanid() uint32 {
+	if x != nil {
+		return x.Vlanid
+	}
+	return 0
+}
+
+func (x *SRIOVVirtualFunctionSpec) GetTrust() bool {
+	if x != nil {
+		return x.Trust
+	}
+	return false
+}
+
+func (x *SRIOVVirtualFunctionSpec) GetSpoofCheck() bool {
+	if x != nil {
+		return x.SpoofCheck
+	}
+	return false
+}
+
+// SRIOVVirtualFunctionStatus describes status of a virtual function.
+type SRIOVVirtualFunctionStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         uint32                 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	PciAddress    string                 `protobuf:"bytes,2,opt,name=pci_address,json=pciAddress,proto3" json:"pci_address,omitempty"`
+	LinkName      string                 `protobuf:"bytes,3,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	Driver        string                 `protobuf:"bytes,4,opt,name=driver,proto3" json:"driver,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SRIOVVirtualFunctionStatus) Reset() {
+	*x = SRIOVVirtualFunctionStatus{}
+	mi := &file_resource_definitions_hardware_hardware_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SRIOVVirtualFunctionStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRIOVVirtualFunctionStatus) ProtoMessage() {}
+
+func (x *SRIOVVirtualFunctionStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_hardware_hardware_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRIOVVirtualFunctionStatus.ProtoReflect.Descriptor instead.
+func (*SRIOVVirtualFunctionStatus) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_hardware_hardware_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SRIOVVirtualFunctionStatus) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SRIOVVirtualFunctionStatus) GetPciAddress() string {
+	if x != nil {
+		return x.PciAddress
+	}
+	return ""
+}
+
+func (x *SRIOVVirtualFunctionStatus) GetLinkName() string {
+	if x != nil {
+		return x.LinkName
+	}
+	return ""
+}
+
+func (x *SRIOVVirtualFunctionStatus) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
 // SystemInformationSpec represents the system information obtained from smbios.
 type SystemInformationSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -671,7 +971,7 @@ type SystemInformationSpec struct {
 
 func (x *SystemInformationSpec) Reset() {
 	*x = SystemInformationSpec{}
-	mi := &file_resource_definitions_hardware_hardware_proto_msgTypes[6]
+	mi := &file_resource_definitions_hardware_hardware_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -683,7 +983,7 @@ func (x *SystemInformationSpec) String() string {
 func (*SystemInformationSpec) ProtoMessage() {}
 
 func (x *SystemInformationSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_hardware_hardware_proto_msgTypes[6]
+	mi := &file_resource_definitions_hardware_hardware_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -696,7 +996,7 @@ func (x *SystemInformationSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemInformationSpec.ProtoReflect.Descriptor instead.
 func (*SystemInformationSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_hardware_hardware_proto_rawDescGZIP(), []int{6}
+	return file_resource_definitions_hardware_hardware_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *SystemInformationSpec) GetManufacturer() string {
@@ -824,7 +1124,33 @@ const file_resource_definitions_hardware_hardware_proto_rawDesc = "" +
 	"core_count\x18\n" +
 	" \x01(\rR\tcoreCount\x12!\n" +
 	"\fcore_enabled\x18\v \x01(\rR\vcoreEnabled\x12!\n" +
-	"\fthread_count\x18\f \x01(\rR\vthreadCount\"\x95\x02\n" +
+	"\fthread_count\x18\f \x01(\rR\vthreadCount\"\xdf\x01\n" +
+	"\x0fSRIOVConfigSpec\x12+\n" +
+	"\x11physical_function\x18\x01 \x01(\tR\x10physicalFunction\x12\x1b\n" +
+	"\tlink_name\x18\x02 \x01(\tR\blinkName\x12\x18\n" +
+	"\bnum_v_fs\x18\x03 \x01(\rR\x06numVFs\x12\x16\n" +
+	"\x06driver\x18\x04 \x01(\tR\x06driver\x12P\n" +
+	"\x04v_fs\x18\x05 \x03(\v2=.talos.resource.definitions.hardware.SRIOVVirtualFunctionSpecR\x03vFs\"\xe7\x01\n" +
+	"\x0fSRIOVStatusSpec\x12+\n" +
+	"\x11physical_function\x18\x01 \x01(\tR\x10physicalFunction\x12\x1b\n" +
+	"\tlink_name\x18\x02 \x01(\tR\blinkName\x12\x1c\n" +
+	"\n" +
+	"total_v_fs\x18\x03 \x01(\rR\btotalVFs\x12\x18\n" +
+	"\bnum_v_fs\x18\x04 \x01(\rR\x06numVFs\x12R\n" +
+	"\x04v_fs\x18\x05 \x03(\v2?.talos.resource.definitions.hardware.SRIOVVirtualFunctionStatusR\x03vFs\"\xa4\x01\n" +
+	"\x18SRIOVVirtualFunctionSpec\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\rR\x05index\x12#\n" +
+	"\rhardware_addr\x18\x02 \x01(\fR\fhardwareAddr\x12\x16\n" +
+	"\x06vlanid\x18\x03 \x01(\rR\x06vlanid\x12\x14\n" +
+	"\x05trust\x18\x04 \x01(\bR\x05trust\x12\x1f\n" +
+	"\vspoof_check\x18\x05 \x01(\bR\n" +
+	"spoofCheck\"\x88\x01\n" +
+	"\x1aSRIOVVirtualFunctionStatus\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\rR\x05index\x12\x1f\n" +
+	"\vpci_address\x18\x02 \x01(\tR\n" +
+	"pciAddress\x12\x1b\n" +
+	"\tlink_name\x18\x03 \x01(\tR\blinkName\x12\x16\n" +
+	"\x06driver\x18\x04 \x01(\tR\x06driver\"\x95\x02\n" +
 	"\x15SystemInformationSpec\x12\"\n" +
 	"\fmanufacturer\x18\x01 \x01(\tR\fmanufacturer\x12!\n" +
 	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12\x18\n" +
@@ -850,22 +1176,28 @@ func file_resource_definitions_hardware_hardware_proto_rawDescGZIP() []byte {
 	return file_resource_definitions_hardware_hardware_proto_rawDescData
 }
 
-var file_resource_definitions_hardware_hardware_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_resource_definitions_hardware_hardware_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_resource_definitions_hardware_hardware_proto_goTypes = []any{
-	(*CPUCoreSpec)(nil),               // 0: talos.resource.definitions.hardware.CPUCoreSpec
-	(*MemoryModuleSpec)(nil),          // 1: talos.resource.definitions.hardware.MemoryModuleSpec
-	(*PCIDeviceSpec)(nil),             // 2: talos.resource.definitions.hardware.PCIDeviceSpec
-	(*PCIDriverRebindConfigSpec)(nil), // 3: talos.resource.definitions.hardware.PCIDriverRebindConfigSpec
-	(*PCIDriverRebindStatusSpec)(nil), // 4: talos.resource.definitions.hardware.PCIDriverRebindStatusSpec
-	(*ProcessorSpec)(nil),             // 5: talos.resource.definitions.hardware.ProcessorSpec
-	(*SystemInformationSpec)(nil),     // 6: talos.resource.definitions.hardware.SystemInformationSpec
+	(*CPUCoreSpec)(nil),                // 0: talos.resource.definitions.hardware.CPUCoreSpec
+	(*MemoryModuleSpec)(nil),           // 1: talos.resource.definitions.hardware.MemoryModuleSpec
+	(*PCIDeviceSpec)(nil),              // 2: talos.resource.definitions.hardware.PCIDeviceSpec
+	(*PCIDriverRebindConfigSpec)(nil),  // 3: talos.resource.definitions.hardware.PCIDriverRebindConfigSpec
+	(*PCIDriverRebindStatusSpec)(nil),  // 4: talos.resource.definitions.hardware.PCIDriverRebindStatusSpec
+	(*ProcessorSpec)(nil),              // 5: talos.resource.definitions.hardware.ProcessorSpec
+	(*SRIOVConfigSpec)(nil),            // 6: talos.resource.definitions.hardware.SRIOVConfigSpec
+	(*SRIOVStatusSpec)(nil),            // 7: talos.resource.definitions.hardware.SRIOVStatusSpec
+	(*SRIOVVirtualFunctionSpec)(nil),   // 8: talos.resource.definitions.hardware.SRIOVVirtualFunctionSpec
+	(*SRIOVVirtualFunctionStatus)(nil), // 9: talos.resource.definitions.hardware.SRIOVVirtualFunctionStatus
+	(*SystemInformationSpec)(nil),      // 10: talos.resource.definitions.hardware.SystemInformationSpec
 }
 var file_resource_definitions_hardware_hardware_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	8, // 0: talos.resource.definitions.hardware.SRIOVConfigSpec.v_fs:type_name -> talos.resource.definitions.hardware.SRIOVVirtualFunctionSpec
+	9, // 1: talos.resource.definitions.hardware.SRIOVStatusSpec.v_fs:type_name -> talos.resource.definitions.hardware.SRIOVVirtualFunctionStatus
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_resource_definitions_hardware_hardware_proto_init() }
@@ -879,7 +1211,7 @@ func file_resource_definitions_hardware_hardware_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resource_definitions_hardware_hardware_proto_rawDesc), len(file_resource_definitions_hardware_hardware_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -14,6 +14,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 
 	common "github.com/siderolabs/talos/pkg/machinery/api/common"
 	enums "github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/enums"
@@ -185,6 +186,99 @@ func (x *BootedEntrySpec) GetBootedEntry() string {
 	return ""
 }
 
+// ConfigSourceStatusSpec describes the sync status of the remote configuration source.
+type ConfigSourceStatusSpec struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Url             string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Mode            string                 `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	Synced          bool                   `protobuf:"varint,3,opt,name=synced,proto3" json:"synced,omitempty"`
+	Revision        string                 `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
+	LastAppliedTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_applied_time,json=lastAppliedTime,proto3" json:"last_applied_time,omitempty"`
+	LastSyncTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_sync_time,json=lastSyncTime,proto3" json:"last_sync_time,omitempty"`
+	Error           string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ConfigSourceStatusSpec) Reset() {
+	*x = ConfigSourceStatusSpec{}
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigSourceStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigSourceStatusSpec) ProtoMessage() {}
+
+func (x *ConfigSourceStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigSourceStatusSpec.ProtoReflect.Descriptor instead.
+func (*ConfigSourceStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ConfigSourceStatusSpec) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ConfigSourceStatusSpec) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ConfigSourceStatusSpec) GetSynced() bool {
+	if x != nil {
+		return x.Synced
+	}
+	return false
+}
+
+func (x *ConfigSourceStatusSpec) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+func (x *ConfigSourceStatusSpec) GetLastAppliedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAppliedTime
+	}
+	return nil
+}
+
+func (x *ConfigSourceStatusSpec) GetLastSyncTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSyncTime
+	}
+	return nil
+}
+
+func (x *ConfigSourceStatusSpec) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 // DevicesStatusSpec is the spec for devices status.
 type DevicesStatusSpec struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -196,7 +290,7 @@ type DevicesStatusSpec struct {
 
 func (x *DevicesStatusSpec) Reset() {
 	*x = DevicesStatusSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[3]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -208,7 +302,7 @@ func (x *DevicesStatusSpec) String() string {
 func (*DevicesStatusSpec) ProtoMessage() {}
 
 func (x *DevicesStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[3]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -221,7 +315,7 @@ func (x *DevicesStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DevicesStatusSpec.ProtoReflect.Descriptor instead.
 func (*DevicesStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{3}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *DevicesStatusSpec) GetReady() bool {
@@ -244,7 +338,7 @@ type DiagnosticSpec struct {
 
 func (x *DiagnosticSpec) Reset() {
 	*x = DiagnosticSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[4]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -256,7 +350,7 @@ func (x *DiagnosticSpec) String() string {
 func (*DiagnosticSpec) ProtoMessage() {}
 
 func (x *DiagnosticSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[4]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -269,7 +363,7 @@ func (x *DiagnosticSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiagnosticSpec.ProtoReflect.Descriptor instead.
 func (*DiagnosticSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{4}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *DiagnosticSpec) GetMessage() string {
@@ -286,6 +380,407 @@ func (x *DiagnosticSpec) GetDetails() []string {
 	return nil
 }
 
+// DisruptionLabelLimit describes the limit on the number of nodes with the same label value disrupted at the same time.
+type DisruptionLabelLimit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	MaxNodes      int64                  `protobuf:"varint,2,opt,name=max_nodes,json=maxNodes,proto3" json:"max_nodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisruptionLabelLimit) Reset() {
+	*x = DisruptionLabelLimit{}
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisruptionLabelLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisruptionLabelLimit) ProtoMessage() {}
+
+func (x *DisruptionLabelLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisruptionLabelLimit.ProtoReflect.Descriptor instead.
+func (*DisruptionLabelLimit) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DisruptionLabelLimit) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DisruptionLabelLimit) GetMaxNodes() int64 {
+	if x != nil {
+		return x.MaxNodes
+	}
+	return 0
+}
+
+// DisruptionLockRequestSpec describes the spec of DisruptionLockRequest.
+type DisruptionLockRequestSpec struct {
+	state                protoimpl.MessageState  `protogen:"open.v1"`
+	Node                 string                  `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	ControlPlane         bool                    `protobuf:"varint,2,opt,name=control_plane,json=controlPlane,proto3" json:"control_plane,omitempty"`
+	Labels               map[string]string       `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Operation            string                  `protobuf:"bytes,4,opt,name=operation,proto3" json:"operation,omitempty"`
+	RequestedAt          *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	RefreshedAt          *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=refreshed_at,json=refreshedAt,proto3" json:"refreshed_at,omitempty"`
+	Release              bool                    `protobuf:"varint,7,opt,name=release,proto3" json:"release,omitempty"`
+	MaxNodes             int64                   `protobuf:"varint,8,opt,name=max_nodes,json=maxNodes,proto3" json:"max_nodes,omitempty"`
+	MaxControlPlaneNodes int64                   `protobuf:"varint,9,opt,name=max_control_plane_nodes,json=maxControlPlaneNodes,proto3" json:"max_control_plane_nodes,omitempty"`
+	LabelLimits          []*DisruptionLabelLimit `protobuf:"bytes,10,rep,name=label_limits,json=labelLimits,proto3" json:"label_limits,omitempty"`
+	LockTtl              *durationpb.Duration    `protobuf:"bytes,11,opt,name=lock_ttl,json=lockTtl,proto3" json:"lock_ttl,omitempty"`
+	Address              string                  `protobuf:"bytes,12,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DisruptionLockRequestSpec) Reset() {
+	*x = DisruptionLockRequestSpec{}
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisruptionLockRequestSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisruptionLockRequestSpec) ProtoMessage() {}
+
+func (x *DisruptionLockRequestSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisruptionLockRequestSpec.ProtoReflect.Descriptor instead.
+func (*DisruptionLockRequestSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DisruptionLockRequestSpec) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *DisruptionLockRequestSpec) GetControlPlane() bool {
+	if x != nil {
+		return x.ControlPlane
+	}
+	return false
+}
+
+func (x *DisruptionLockRequestSpec) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *DisruptionLockRequestSpec) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *DisruptionLockRequestSpec) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+func (x *DisruptionLockRequestSpec) GetRefreshedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefreshedAt
+	}
+	return nil
+}
+
+func (x *DisruptionLockRequestSpec) GetRelease() bool {
+	if x != nil {
+		return x.Release
+	}
+	return false
+}
+
+func (x *DisruptionLockRequestSpec) GetMaxNodes() int64 {
+	if x != nil {
+		return x.MaxNodes
+	}
+	return 0
+}
+
+func (x *DisruptionLockRequestSpec) GetMaxControlPlaneNodes() int64 {
+	if x != nil {
+		return x.MaxControlPlaneNodes
+	}
+	return 0
+}
+
+func (x *DisruptionLockRequestSpec) GetLabelLimits() []*DisruptionLabelLimit {
+	if x != nil {
+		return x.LabelLimits
+	}
+	return nil
+}
+
+func (x *DisruptionLockRequestSpec) GetLockTtl() *durationpb.Duration {
+	if x != nil {
+		return x.LockTtl
+	}
+	return nil
+}
+
+func (x *DisruptionLockRequestSpec) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// DisruptionLockStatusSpec describes the result of processing the DisruptionLockRequest.
+type DisruptionLockStatusSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestedAt   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	Granted       bool                   `protobuf:"varint,2,opt,name=granted,proto3" json:"granted,omitempty"`
+	QueuePosition int64                  `protobuf:"varint,3,opt,name=queue_position,json=queuePosition,proto3" json:"queue_position,omitempty"`
+	Holders       []string               `protobuf:"bytes,4,rep,name=holders,proto3" json:"holders,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisruptionLockStatusSpec) Reset() {
+	*x = DisruptionLockStatusSpec{}
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisruptionLockStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisruptionLockStatusSpec) ProtoMessage() {}
+
+func (x *DisruptionLockStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisruptionLockStatusSpec.ProtoReflect.Descriptor instead.
+func (*DisruptionLockStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DisruptionLockStatusSpec) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+func (x *DisruptionLockStatusSpec) GetGranted() bool {
+	if x != nil {
+		return x.Granted
+	}
+	return false
+}
+
+func (x *DisruptionLockStatusSpec) GetQueuePosition() int64 {
+	if x != nil {
+		return x.QueuePosition
+	}
+	return 0
+}
+
+func (x *DisruptionLockStatusSpec) GetHolders() []string {
+	if x != nil {
+		return x.Holders
+	}
+	return nil
+}
+
+func (x *DisruptionLockStatusSpec) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// DisruptionRequestSpec describes the spec of DisruptionRequest.
+type DisruptionRequestSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     string                 `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	RequestedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisruptionRequestSpec) Reset() {
+	*x = DisruptionRequestSpec{}
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisruptionRequestSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisruptionRequestSpec) ProtoMessage() {}
+
+func (x *DisruptionRequestSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisruptionRequestSpec.ProtoReflect.Descriptor instead.
+func (*DisruptionRequestSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DisruptionRequestSpec) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *DisruptionRequestSpec) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+// DisruptionStatusSpec describes the state of the disruptive operations of the node.
+type DisruptionStatusSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         string                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Operations    []string               `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations,omitempty"`
+	QueuePosition int64                  `protobuf:"varint,3,opt,name=queue_position,json=queuePosition,proto3" json:"queue_position,omitempty"`
+	NextWindow    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=next_window,json=nextWindow,proto3" json:"next_window,omitempty"`
+	Holders       []string               `protobuf:"bytes,5,rep,name=holders,proto3" json:"holders,omitempty"`
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisruptionStatusSpec) Reset() {
+	*x = DisruptionStatusSpec{}
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisruptionStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisruptionStatusSpec) ProtoMessage() {}
+
+func (x *DisruptionStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisruptionStatusSpec.ProtoReflect.Descriptor instead.
+func (*DisruptionStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DisruptionStatusSpec) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *DisruptionStatusSpec) GetOperations() []string {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *DisruptionStatusSpec) GetQueuePosition() int64 {
+	if x != nil {
+		return x.QueuePosition
+	}
+	return 0
+}
+
+func (x *DisruptionStatusSpec) GetNextWindow() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextWindow
+	}
+	return nil
+}
+
+func (x *DisruptionStatusSpec) GetHolders() []string {
+	if x != nil {
+		return x.Holders
+	}
+	return nil
+}
+
+func (x *DisruptionStatusSpec) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 // EnvironmentSpec describes the specification of Environment resource.
 type EnvironmentSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -296,7 +791,7 @@ type EnvironmentSpec struct {
 
 func (x *EnvironmentSpec) Reset() {
 	*x = EnvironmentSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[5]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -308,7 +803,7 @@ func (x *EnvironmentSpec) String() string {
 func (*EnvironmentSpec) ProtoMessage() {}
 
 func (x *EnvironmentSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[5]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -321,7 +816,7 @@ func (x *EnvironmentSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnvironmentSpec.ProtoReflect.Descriptor instead.
 func (*EnvironmentSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{5}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *EnvironmentSpec) GetVariables() []string {
@@ -331,6 +826,67 @@ func (x *EnvironmentSpec) GetVariables() []string {
 	return nil
 }
 
+// EraseReportSpec is the signed report of the disk erase performed on reset.
+type EraseReportSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        string                 `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	Signature     []byte                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Certificate   string                 `protobuf:"bytes,3,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EraseReportSpec) Reset() {
+	*x = EraseReportSpec{}
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseReportSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseReportSpec) ProtoMessage() {}
+
+func (x *EraseReportSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseReportSpec.ProtoReflect.Descriptor instead.
+func (*EraseReportSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EraseReportSpec) GetReport() string {
+	if x != nil {
+		return x.Report
+	}
+	return ""
+}
+
+func (x *EraseReportSpec) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *EraseReportSpec) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
 // EventSinkConfigSpec describes configuration of Talos event log streaming.
 type EventSinkConfigSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -341,7 +897,7 @@ type EventSinkConfigSpec struct {
 
 func (x *EventSinkConfigSpec) Reset() {
 	*x = EventSinkConfigSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[6]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -353,7 +909,7 @@ func (x *EventSinkConfigSpec) String() string {
 func (*EventSinkConfigSpec) ProtoMessage() {}
 
 func (x *EventSinkConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[6]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -366,7 +922,7 @@ func (x *EventSinkConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EventSinkConfigSpec.ProtoReflect.Descriptor instead.
 func (*EventSinkConfigSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{6}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *EventSinkConfigSpec) GetEndpoint() string {
@@ -387,7 +943,7 @@ type ExtensionServiceConfigFile struct {
 
 func (x *ExtensionServiceConfigFile) Reset() {
 	*x = ExtensionServiceConfigFile{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[7]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -399,7 +955,7 @@ func (x *ExtensionServiceConfigFile) String() string {
 func (*ExtensionServiceConfigFile) ProtoMessage() {}
 
 func (x *ExtensionServiceConfigFile) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[7]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -412,7 +968,7 @@ func (x *ExtensionServiceConfigFile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtensionServiceConfigFile.ProtoReflect.Descriptor instead.
 func (*ExtensionServiceConfigFile) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{7}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ExtensionServiceConfigFile) GetContent() string {
@@ -431,16 +987,18 @@ func (x *ExtensionServiceConfigFile) GetMountPath() string {
 
 // ExtensionServiceConfigSpec describes status of rendered extensions service config files.
 type ExtensionServiceConfigSpec struct {
-	state         protoimpl.MessageState        `protogen:"open.v1"`
-	Files         []*ExtensionServiceConfigFile `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
-	Environment   []string                      `protobuf:"bytes,2,rep,name=environment,proto3" json:"environment,omitempty"`
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Files         []*ExtensionServiceConfigFile  `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	Environment   []string                       `protobuf:"bytes,2,rep,name=environment,proto3" json:"environment,omitempty"`
+	Resources     *ExtensionServiceResourcesSpec `protobuf:"bytes,3,opt,name=resources,proto3" json:"resources,omitempty"`
+	Security      *ExtensionServiceSecuritySpec  `protobuf:"bytes,4,opt,name=security,proto3" json:"security,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ExtensionServiceConfigSpec) Reset() {
 	*x = ExtensionServiceConfigSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[8]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -452,7 +1010,7 @@ func (x *ExtensionServiceConfigSpec) String() string {
 func (*ExtensionServiceConfigSpec) ProtoMessage() {}
 
 func (x *ExtensionServiceConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[8]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -465,7 +1023,7 @@ func (x *ExtensionServiceConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtensionServiceConfigSpec.ProtoReflect.Descriptor instead.
 func (*ExtensionServiceConfigSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{8}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ExtensionServiceConfigSpec) GetFiles() []*ExtensionServiceConfigFile {
@@ -482,6 +1040,166 @@ func (x *ExtensionServiceConfigSpec) GetEnvironment() []string {
 	return nil
 }
 
+func (x *ExtensionServiceConfigSpec) GetResources() *ExtensionServiceResourcesSpec {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *ExtensionServiceConfigSpec) GetSecurity() *ExtensionServiceSecuritySpec {
+	if x != nil {
+		return x.Security
+	}
+	return nil
+}
+
+// ExtensionServiceResourcesSpec describes resource limits overrides for the extension service.
+type ExtensionServiceResourcesSpec struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CpuWeight        uint64                 `protobuf:"varint,1,opt,name=cpu_weight,json=cpuWeight,proto3" json:"cpu_weight,omitempty"`
+	CpuMaxMillicores uint64                 `protobuf:"varint,2,opt,name=cpu_max_millicores,json=cpuMaxMillicores,proto3" json:"cpu_max_millicores,omitempty"`
+	MemoryMax        uint64                 `protobuf:"varint,3,opt,name=memory_max,json=memoryMax,proto3" json:"memory_max,omitempty"`
+	MemoryHigh       uint64                 `protobuf:"varint,4,opt,name=memory_high,json=memoryHigh,proto3" json:"memory_high,omitempty"`
+	IoWeight         uint64                 `protobuf:"varint,5,opt,name=io_weight,json=ioWeight,proto3" json:"io_weight,omitempty"`
+	OomScoreAdj      int32                  `protobuf:"varint,6,opt,name=oom_score_adj,json=oomScoreAdj,proto3" json:"oom_score_adj,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ExtensionServiceResourcesSpec) Reset() {
+	*x = ExtensionServiceResourcesSpec{}
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtensionServiceResourcesSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtensionServiceResourcesSpec) ProtoMessage() {}
+
+func (x *ExtensionServiceResourcesSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtensionServiceResourcesSpec.ProtoReflect.Descriptor instead.
+func (*ExtensionServiceResourcesSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ExtensionServiceResourcesSpec) GetCpuWeight() uint64 {
+	if x != nil {
+		return x.CpuWeight
+	}
+	return 0
+}
+
+func (x *ExtensionServiceResourcesSpec) GetCpuMaxMillicores() uint64 {
+	if x != nil {
+		return x.CpuMaxMillicores
+	}
+	return 0
+}
+
+func (x *ExtensionServiceResourcesSpec) GetMemoryMax() uint64 {
+	if x != nil {
+		return x.MemoryMax
+	}
+	return 0
+}
+
+func (x *ExtensionServiceResourcesSpec) GetMemoryHigh() uint64 {
+	if x != nil {
+		return x.MemoryHigh
+	}
+	return 0
+}
+
+func (x *ExtensionServiceResourcesSpec) GetIoWeight() uint64 {
+	if x != nil {
+		return x.IoWeight
+	}
+	return 0
+}
+
+func (x *ExtensionServiceResourcesSpec) GetOomScoreAdj() int32 {
+	if x != nil {
+		return x.OomScoreAdj
+	}
+	return 0
+}
+
+// ExtensionServiceSecuritySpec describes security overrides for the extension service.
+type ExtensionServiceSecuritySpec struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DropCapabilities []string               `protobuf:"bytes,1,rep,name=drop_capabilities,json=dropCapabilities,proto3" json:"drop_capabilities,omitempty"`
+	SeccompProfile   string                 `protobuf:"bytes,2,opt,name=seccomp_profile,json=seccompProfile,proto3" json:"seccomp_profile,omitempty"`
+	ReadonlyRootfs   bool                   `protobuf:"varint,3,opt,name=readonly_rootfs,json=readonlyRootfs,proto3" json:"readonly_rootfs,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ExtensionServiceSecuritySpec) Reset() {
+	*x = ExtensionServiceSecuritySpec{}
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtensionServiceSecuritySpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtensionServiceSecuritySpec) ProtoMessage() {}
+
+func (x *ExtensionServiceSecuritySpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtensionServiceSecuritySpec.ProtoReflect.Descriptor instead.
+func (*ExtensionServiceSecuritySpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ExtensionServiceSecuritySpec) GetDropCapabilities() []string {
+	if x != nil {
+		return x.DropCapabilities
+	}
+	return nil
+}
+
+func (x *ExtensionServiceSecuritySpec) GetSeccompProfile() string {
+	if x != nil {
+		return x.SeccompProfile
+	}
+	return ""
+}
+
+func (x *ExtensionServiceSecuritySpec) GetReadonlyRootfs() bool {
+	if x != nil {
+		return x.ReadonlyRootfs
+	}
+	return false
+}
+
 // ExtensionServiceConfigStatusSpec describes status of rendered extensions service config files.
 type ExtensionServiceConfigStatusSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -492,7 +1210,7 @@ type ExtensionServiceConfigStatusSpec struct {
 
 func (x *ExtensionServiceConfigStatusSpec) Reset() {
 	*x = ExtensionServiceConfigStatusSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[9]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -504,7 +1222,7 @@ func (x *ExtensionServiceConfigStatusSpec) String() string {
 func (*ExtensionServiceConfigStatusSpec) ProtoMessage() {}
 
 func (x *ExtensionServiceConfigStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[9]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -517,7 +1235,7 @@ func (x *ExtensionServiceConfigStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtensionServiceConfigStatusSpec.ProtoReflect.Descriptor instead.
 func (*ExtensionServiceConfigStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{9}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ExtensionServiceConfigStatusSpec) GetSpecVersion() string {
@@ -539,7 +1257,7 @@ type ImageFactorySchematicSpec struct {
 
 func (x *ImageFactorySchematicSpec) Reset() {
 	*x = ImageFactorySchematicSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[10]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -551,7 +1269,7 @@ func (x *ImageFactorySchematicSpec) String() string {
 func (*ImageFactorySchematicSpec) ProtoMessage() {}
 
 func (x *ImageFactorySchematicSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[10]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -564,7 +1282,7 @@ func (x *ImageFactorySchematicSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImageFactorySchematicSpec.ProtoReflect.Descriptor instead.
 func (*ImageFactorySchematicSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{10}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ImageFactorySchematicSpec) GetSchematicId() string {
@@ -598,7 +1316,7 @@ type KernelCmdlineSpec struct {
 
 func (x *KernelCmdlineSpec) Reset() {
 	*x = KernelCmdlineSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[11]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -610,7 +1328,7 @@ func (x *KernelCmdlineSpec) String() string {
 func (*KernelCmdlineSpec) ProtoMessage() {}
 
 func (x *KernelCmdlineSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[11]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -623,7 +1341,7 @@ func (x *KernelCmdlineSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KernelCmdlineSpec.ProtoReflect.Descriptor instead.
 func (*KernelCmdlineSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{11}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *KernelCmdlineSpec) GetCmdline() string {
@@ -644,7 +1362,7 @@ type KernelModuleSpecSpec struct {
 
 func (x *KernelModuleSpecSpec) Reset() {
 	*x = KernelModuleSpecSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[12]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -656,7 +1374,7 @@ func (x *KernelModuleSpecSpec) String() string {
 func (*KernelModuleSpecSpec) ProtoMessage() {}
 
 func (x *KernelModuleSpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[12]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -669,7 +1387,7 @@ func (x *KernelModuleSpecSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KernelModuleSpecSpec.ProtoReflect.Descriptor instead.
 func (*KernelModuleSpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{12}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *KernelModuleSpecSpec) GetName() string {
@@ -707,7 +1425,7 @@ type KernelModuleStatusSpec struct {
 
 func (x *KernelModuleStatusSpec) Reset() {
 	*x = KernelModuleStatusSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[13]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -719,7 +1437,7 @@ func (x *KernelModuleStatusSpec) String() string {
 func (*KernelModuleStatusSpec) ProtoMessage() {}
 
 func (x *KernelModuleStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[13]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -732,7 +1450,7 @@ func (x *KernelModuleStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KernelModuleStatusSpec.ProtoReflect.Descriptor instead.
 func (*KernelModuleStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{13}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *KernelModuleStatusSpec) GetType() enums.RuntimeKernelModuleType {
@@ -788,7 +1506,7 @@ type KernelParamSpecSpec struct {
 
 func (x *KernelParamSpecSpec) Reset() {
 	*x = KernelParamSpecSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[14]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -800,7 +1518,7 @@ func (x *KernelParamSpecSpec) String() string {
 func (*KernelParamSpecSpec) ProtoMessage() {}
 
 func (x *KernelParamSpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[14]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -813,7 +1531,7 @@ func (x *KernelParamSpecSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KernelParamSpecSpec.ProtoReflect.Descriptor instead.
 func (*KernelParamSpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{14}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *KernelParamSpecSpec) GetValue() string {
@@ -842,7 +1560,7 @@ type KernelParamStatusSpec struct {
 
 func (x *KernelParamStatusSpec) Reset() {
 	*x = KernelParamStatusSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[15]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -854,7 +1572,7 @@ func (x *KernelParamStatusSpec) String() string {
 func (*KernelParamStatusSpec) ProtoMessage() {}
 
 func (x *KernelParamStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[15]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -867,7 +1585,7 @@ func (x *KernelParamStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KernelParamStatusSpec.ProtoReflect.Descriptor instead.
 func (*KernelParamStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{15}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *KernelParamStatusSpec) GetCurrent() string {
@@ -901,7 +1619,7 @@ type KmsgLogConfigSpec struct {
 
 func (x *KmsgLogConfigSpec) Reset() {
 	*x = KmsgLogConfigSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[16]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -913,7 +1631,7 @@ func (x *KmsgLogConfigSpec) String() string {
 func (*KmsgLogConfigSpec) ProtoMessage() {}
 
 func (x *KmsgLogConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[16]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -926,7 +1644,7 @@ func (x *KmsgLogConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KmsgLogConfigSpec.ProtoReflect.Descriptor instead.
 func (*KmsgLogConfigSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{16}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *KmsgLogConfigSpec) GetDestinations() []*common.URL {
@@ -954,7 +1672,7 @@ type LoadedKernelModuleSpec struct {
 
 func (x *LoadedKernelModuleSpec) Reset() {
 	*x = LoadedKernelModuleSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[17]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -966,7 +1684,7 @@ func (x *LoadedKernelModuleSpec) String() string {
 func (*LoadedKernelModuleSpec) ProtoMessage() {}
 
 func (x *LoadedKernelModuleSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[17]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -979,7 +1697,7 @@ func (x *LoadedKernelModuleSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoadedKernelModuleSpec.ProtoReflect.Descriptor instead.
 func (*LoadedKernelModuleSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{17}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *LoadedKernelModuleSpec) GetSize() int64 {
@@ -1028,7 +1746,7 @@ type MachineStatusSpec struct {
 
 func (x *MachineStatusSpec) Reset() {
 	*x = MachineStatusSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[18]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1040,7 +1758,7 @@ func (x *MachineStatusSpec) String() string {
 func (*MachineStatusSpec) ProtoMessage() {}
 
 func (x *MachineStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[18]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1053,7 +1771,7 @@ func (x *MachineStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineStatusSpec.ProtoReflect.Descriptor instead.
 func (*MachineStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{18}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *MachineStatusSpec) GetStage() enums.RuntimeMachineStage {
@@ -1081,7 +1799,7 @@ type MachineStatusStatus struct {
 
 func (x *MachineStatusStatus) Reset() {
 	*x = MachineStatusStatus{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[19]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1093,7 +1811,7 @@ func (x *MachineStatusStatus) String() string {
 func (*MachineStatusStatus) ProtoMessage() {}
 
 func (x *MachineStatusStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[19]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1106,7 +1824,7 @@ func (x *MachineStatusStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineStatusStatus.ProtoReflect.Descriptor instead.
 func (*MachineStatusStatus) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{19}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *MachineStatusStatus) GetReady() bool {
@@ -1134,7 +1852,7 @@ type MaintenanceServiceConfigSpec struct {
 
 func (x *MaintenanceServiceConfigSpec) Reset() {
 	*x = MaintenanceServiceConfigSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[20]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1146,7 +1864,7 @@ func (x *MaintenanceServiceConfigSpec) String() string {
 func (*MaintenanceServiceConfigSpec) ProtoMessage() {}
 
 func (x *MaintenanceServiceConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[20]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1159,7 +1877,7 @@ func (x *MaintenanceServiceConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MaintenanceServiceConfigSpec.ProtoReflect.Descriptor instead.
 func (*MaintenanceServiceConfigSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{20}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *MaintenanceServiceConfigSpec) GetListenAddress() string {
@@ -1186,7 +1904,7 @@ type MetaKeySpec struct {
 
 func (x *MetaKeySpec) Reset() {
 	*x = MetaKeySpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[21]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1198,7 +1916,7 @@ func (x *MetaKeySpec) String() string {
 func (*MetaKeySpec) ProtoMessage() {}
 
 func (x *MetaKeySpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[21]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1211,7 +1929,7 @@ func (x *MetaKeySpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MetaKeySpec.ProtoReflect.Descriptor instead.
 func (*MetaKeySpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{21}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *MetaKeySpec) GetValue() string {
@@ -1231,7 +1949,7 @@ type MetaLoadedSpec struct {
 
 func (x *MetaLoadedSpec) Reset() {
 	*x = MetaLoadedSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[22]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1243,7 +1961,7 @@ func (x *MetaLoadedSpec) String() string {
 func (*MetaLoadedSpec) ProtoMessage() {}
 
 func (x *MetaLoadedSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[22]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1256,7 +1974,7 @@ func (x *MetaLoadedSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MetaLoadedSpec.ProtoReflect.Descriptor instead.
 func (*MetaLoadedSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{22}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *MetaLoadedSpec) GetDone() bool {
@@ -1281,7 +1999,7 @@ type MountStatusSpec struct {
 
 func (x *MountStatusSpec) Reset() {
 	*x = MountStatusSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[23]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1293,7 +2011,7 @@ func (x *MountStatusSpec) String() string {
 func (*MountStatusSpec) ProtoMessage() {}
 
 func (x *MountStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[23]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1306,7 +2024,7 @@ func (x *MountStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MountStatusSpec.ProtoReflect.Descriptor instead.
 func (*MountStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{23}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *MountStatusSpec) GetSource() string {
@@ -1363,7 +2081,7 @@ type OOMActionSpec struct {
 
 func (x *OOMActionSpec) Reset() {
 	*x = OOMActionSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[24]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1375,7 +2093,7 @@ func (x *OOMActionSpec) String() string {
 func (*OOMActionSpec) ProtoMessage() {}
 
 func (x *OOMActionSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[24]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1388,7 +2106,7 @@ func (x *OOMActionSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OOMActionSpec.ProtoReflect.Descriptor instead.
 func (*OOMActionSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{24}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *OOMActionSpec) GetTriggerContext() string {
@@ -1432,7 +2150,7 @@ type PlatformMetadataSpec struct {
 
 func (x *PlatformMetadataSpec) Reset() {
 	*x = PlatformMetadataSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[25]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1444,7 +2162,7 @@ func (x *PlatformMetadataSpec) String() string {
 func (*PlatformMetadataSpec) ProtoMessage() {}
 
 func (x *PlatformMetadataSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[25]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1457,7 +2175,7 @@ func (x *PlatformMetadataSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlatformMetadataSpec.ProtoReflect.Descriptor instead.
 func (*PlatformMetadataSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{25}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *PlatformMetadataSpec) GetPlatform() string {
@@ -1552,7 +2270,7 @@ type SBOMItemSpec struct {
 
 func (x *SBOMItemSpec) Reset() {
 	*x = SBOMItemSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[26]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1564,7 +2282,7 @@ func (x *SBOMItemSpec) String() string {
 func (*SBOMItemSpec) ProtoMessage() {}
 
 func (x *SBOMItemSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[26]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1577,7 +2295,7 @@ func (x *SBOMItemSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SBOMItemSpec.ProtoReflect.Descriptor instead.
 func (*SBOMItemSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{26}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *SBOMItemSpec) GetName() string {
@@ -1638,7 +2356,7 @@ type SecurityStateSpec struct {
 
 func (x *SecurityStateSpec) Reset() {
 	*x = SecurityStateSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[27]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1650,7 +2368,7 @@ func (x *SecurityStateSpec) String() string {
 func (*SecurityStateSpec) ProtoMessage() {}
 
 func (x *SecurityStateSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[27]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1663,7 +2381,7 @@ func (x *SecurityStateSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SecurityStateSpec.ProtoReflect.Descriptor instead.
 func (*SecurityStateSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{27}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *SecurityStateSpec) GetSecureBoot() bool {
@@ -1728,7 +2446,7 @@ type ServicePIDSpec struct {
 
 func (x *ServicePIDSpec) Reset() {
 	*x = ServicePIDSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[28]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1740,7 +2458,7 @@ func (x *ServicePIDSpec) String() string {
 func (*ServicePIDSpec) ProtoMessage() {}
 
 func (x *ServicePIDSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[28]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1753,7 +2471,7 @@ func (x *ServicePIDSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServicePIDSpec.ProtoReflect.Descriptor instead.
 func (*ServicePIDSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{28}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ServicePIDSpec) GetPid() int32 {
@@ -1782,7 +2500,7 @@ type UnattendedInstallStatusSpec struct {
 
 func (x *UnattendedInstallStatusSpec) Reset() {
 	*x = UnattendedInstallStatusSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[29]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1794,7 +2512,7 @@ func (x *UnattendedInstallStatusSpec) String() string {
 func (*UnattendedInstallStatusSpec) ProtoMessage() {}
 
 func (x *UnattendedInstallStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[29]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1807,7 +2525,7 @@ func (x *UnattendedInstallStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnattendedInstallStatusSpec.ProtoReflect.Descriptor instead.
 func (*UnattendedInstallStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{29}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *UnattendedInstallStatusSpec) GetImage() string {
@@ -1841,7 +2559,7 @@ type UniqueMachineTokenSpec struct {
 
 func (x *UniqueMachineTokenSpec) Reset() {
 	*x = UniqueMachineTokenSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[30]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1853,7 +2571,7 @@ func (x *UniqueMachineTokenSpec) String() string {
 func (*UniqueMachineTokenSpec) ProtoMessage() {}
 
 func (x *UniqueMachineTokenSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[30]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1866,7 +2584,7 @@ func (x *UniqueMachineTokenSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UniqueMachineTokenSpec.ProtoReflect.Descriptor instead.
 func (*UniqueMachineTokenSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{30}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *UniqueMachineTokenSpec) GetToken() string {
@@ -1887,7 +2605,7 @@ type UnmetCondition struct {
 
 func (x *UnmetCondition) Reset() {
 	*x = UnmetCondition{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[31]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1899,7 +2617,7 @@ func (x *UnmetCondition) String() string {
 func (*UnmetCondition) ProtoMessage() {}
 
 func (x *UnmetCondition) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[31]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1912,7 +2630,7 @@ func (x *UnmetCondition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnmetCondition.ProtoReflect.Descriptor instead.
 func (*UnmetCondition) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{31}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *UnmetCondition) GetName() string {
@@ -1940,7 +2658,7 @@ type VersionSpec struct {
 
 func (x *VersionSpec) Reset() {
 	*x = VersionSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[32]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1952,7 +2670,7 @@ func (x *VersionSpec) String() string {
 func (*VersionSpec) ProtoMessage() {}
 
 func (x *VersionSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[32]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1965,7 +2683,7 @@ func (x *VersionSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VersionSpec.ProtoReflect.Descriptor instead.
 func (*VersionSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{32}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *VersionSpec) GetVersion() string {
@@ -1993,7 +2711,7 @@ type WatchdogTimerConfigSpec struct {
 
 func (x *WatchdogTimerConfigSpec) Reset() {
 	*x = WatchdogTimerConfigSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[33]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2005,7 +2723,7 @@ func (x *WatchdogTimerConfigSpec) String() string {
 func (*WatchdogTimerConfigSpec) ProtoMessage() {}
 
 func (x *WatchdogTimerConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[33]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2018,7 +2736,7 @@ func (x *WatchdogTimerConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchdogTimerConfigSpec.ProtoReflect.Descriptor instead.
 func (*WatchdogTimerConfigSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{33}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *WatchdogTimerConfigSpec) GetDevice() string {
@@ -2047,7 +2765,7 @@ type WatchdogTimerStatusSpec struct {
 
 func (x *WatchdogTimerStatusSpec) Reset() {
 	*x = WatchdogTimerStatusSpec{}
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[34]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2059,7 +2777,7 @@ func (x *WatchdogTimerStatusSpec) String() string {
 func (*WatchdogTimerStatusSpec) ProtoMessage() {}
 
 func (x *WatchdogTimerStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[34]
+	mi := &file_resource_definitions_runtime_runtime_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2072,7 +2790,7 @@ func (x *WatchdogTimerStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchdogTimerStatusSpec.ProtoReflect.Descriptor instead.
 func (*WatchdogTimerStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{34}
+	return file_resource_definitions_runtime_runtime_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *WatchdogTimerStatusSpec) GetDevice() string {
@@ -2100,7 +2818,7 @@ var File_resource_definitions_runtime_runtime_proto protoreflect.FileDescriptor
 
 const file_resource_definitions_runtime_runtime_proto_rawDesc = "" +
 	"\n" +
-	"*resource/definitions/runtime/runtime.proto\x12\"talos.resource.definitions.runtime\x1a\x13common/common.proto\x1a\x1egoogle/protobuf/duration.proto\x1a&resource/definitions/enums/enums.proto\"\xdc\x01\n" +
+	"*resource/definitions/runtime/runtime.proto\x12\"talos.resource.definitions.runtime\x1a\x13common/common.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a&resource/definitions/enums/enums.proto\"\xdc\x01\n" +
 	"\x14APIServiceConfigSpec\x12%\n" +
 	"\x0elisten_address\x18\x01 \x01(\tR\rlistenAddress\x122\n" +
 	"\x15node_routing_disabled\x18\x02 \x01(\bR\x13nodeRoutingDisabled\x12,\n" +
@@ -2110,23 +2828,90 @@ const file_resource_definitions_runtime_runtime_proto_rawDesc = "" +
 	"BootIDSpec\x12\x17\n" +
 	"\aboot_id\x18\x01 \x01(\tR\x06bootId\"4\n" +
 	"\x0fBootedEntrySpec\x12!\n" +
-	"\fbooted_entry\x18\x01 \x01(\tR\vbootedEntry\")\n" +
+	"\fbooted_entry\x18\x01 \x01(\tR\vbootedEntry\"\x92\x02\n" +
+	"\x16ConfigSourceStatusSpec\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\x12\x16\n" +
+	"\x06synced\x18\x03 \x01(\bR\x06synced\x12\x1a\n" +
+	"\brevision\x18\x04 \x01(\tR\brevision\x12F\n" +
+	"\x11last_applied_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0flastAppliedTime\x12@\n" +
+	"\x0elast_sync_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\flastSyncTime\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\")\n" +
 	"\x11DevicesStatusSpec\x12\x14\n" +
 	"\x05ready\x18\x01 \x01(\bR\x05ready\"D\n" +
 	"\x0eDiagnosticSpec\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
-	"\adetails\x18\x02 \x03(\tR\adetails\"/\n" +
+	"\adetails\x18\x02 \x03(\tR\adetails\"E\n" +
+	"\x14DisruptionLabelLimit\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1b\n" +
+	"\tmax_nodes\x18\x02 \x01(\x03R\bmaxNodes\"\xa9\x05\n" +
+	"\x19DisruptionLockRequestSpec\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\x12#\n" +
+	"\rcontrol_plane\x18\x02 \x01(\bR\fcontrolPlane\x12a\n" +
+	"\x06labels\x18\x03 \x03(\v2I.talos.resource.definitions.runtime.DisruptionLockRequestSpec.LabelsEntryR\x06labels\x12\x1c\n" +
+	"\toperation\x18\x04 \x01(\tR\toperation\x12=\n" +
+	"\frequested_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\x12=\n" +
+	"\frefreshed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vrefreshedAt\x12\x18\n" +
+	"\arelease\x18\a \x01(\bR\arelease\x12\x1b\n" +
+	"\tmax_nodes\x18\b \x01(\x03R\bmaxNodes\x125\n" +
+	"\x17max_control_plane_nodes\x18\t \x01(\x03R\x14maxControlPlaneNodes\x12[\n" +
+	"\flabel_limits\x18\n" +
+	" \x03(\v28.talos.resource.definitions.runtime.DisruptionLabelLimitR\vlabelLimits\x124\n" +
+	"\block_ttl\x18\v \x01(\v2\x19.google.protobuf.DurationR\alockTtl\x12\x18\n" +
+	"\aaddress\x18\f \x01(\tR\aaddress\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xca\x01\n" +
+	"\x18DisruptionLockStatusSpec\x12=\n" +
+	"\frequested_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\x12\x18\n" +
+	"\agranted\x18\x02 \x01(\bR\agranted\x12%\n" +
+	"\x0equeue_position\x18\x03 \x01(\x03R\rqueuePosition\x12\x18\n" +
+	"\aholders\x18\x04 \x03(\tR\aholders\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"t\n" +
+	"\x15DisruptionRequestSpec\x12\x1c\n" +
+	"\toperation\x18\x01 \x01(\tR\toperation\x12=\n" +
+	"\frequested_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\"\xe0\x01\n" +
+	"\x14DisruptionStatusSpec\x12\x14\n" +
+	"\x05state\x18\x01 \x01(\tR\x05state\x12\x1e\n" +
+	"\n" +
+	"operations\x18\x02 \x03(\tR\n" +
+	"operations\x12%\n" +
+	"\x0equeue_position\x18\x03 \x01(\x03R\rqueuePosition\x12;\n" +
+	"\vnext_window\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"nextWindow\x12\x18\n" +
+	"\aholders\x18\x05 \x03(\tR\aholders\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"/\n" +
 	"\x0fEnvironmentSpec\x12\x1c\n" +
-	"\tvariables\x18\x01 \x03(\tR\tvariables\"1\n" +
+	"\tvariables\x18\x01 \x03(\tR\tvariables\"i\n" +
+	"\x0fEraseReportSpec\x12\x16\n" +
+	"\x06report\x18\x01 \x01(\tR\x06report\x12\x1c\n" +
+	"\tsignature\x18\x02 \x01(\fR\tsignature\x12 \n" +
+	"\vcertificate\x18\x03 \x01(\tR\vcertificate\"1\n" +
 	"\x13EventSinkConfigSpec\x12\x1a\n" +
 	"\bendpoint\x18\x01 \x01(\tR\bendpoint\"U\n" +
 	"\x1aExtensionServiceConfigFile\x12\x18\n" +
 	"\acontent\x18\x01 \x01(\tR\acontent\x12\x1d\n" +
 	"\n" +
-	"mount_path\x18\x02 \x01(\tR\tmountPath\"\x94\x01\n" +
+	"mount_path\x18\x02 \x01(\tR\tmountPath\"\xd3\x02\n" +
 	"\x1aExtensionServiceConfigSpec\x12T\n" +
 	"\x05files\x18\x01 \x03(\v2>.talos.resource.definitions.runtime.ExtensionServiceConfigFileR\x05files\x12 \n" +
-	"\venvironment\x18\x02 \x03(\tR\venvironment\"E\n" +
+	"\venvironment\x18\x02 \x03(\tR\venvironment\x12_\n" +
+	"\tresources\x18\x03 \x01(\v2A.talos.resource.definitions.runtime.ExtensionServiceResourcesSpecR\tresources\x12\\\n" +
+	"\bsecurity\x18\x04 \x01(\v2@.talos.resource.definitions.runtime.ExtensionServiceSecuritySpecR\bsecurity\"\xed\x01\n" +
+	"\x1dExtensionServiceResourcesSpec\x12\x1d\n" +
+	"\n" +
+	"cpu_weight\x18\x01 \x01(\x04R\tcpuWeight\x12,\n" +
+	"\x12cpu_max_millicores\x18\x02 \x01(\x04R\x10cpuMaxMillicores\x12\x1d\n" +
+	"\n" +
+	"memory_max\x18\x03 \x01(\x04R\tmemoryMax\x12\x1f\n" +
+	"\vmemory_high\x18\x04 \x01(\x04R\n" +
+	"memoryHigh\x12\x1b\n" +
+	"\tio_weight\x18\x05 \x01(\x04R\bioWeight\x12\"\n" +
+	"\room_score_adj\x18\x06 \x01(\x05R\voomScoreAdj\"\x9d\x01\n" +
+	"\x1cExtensionServiceSecuritySpec\x12+\n" +
+	"\x11drop_capabilities\x18\x01 \x03(\tR\x10dropCapabilities\x12'\n" +
+	"\x0fseccomp_profile\x18\x02 \x01(\tR\x0eseccompProfile\x12'\n" +
+	"\x0freadonly_rootfs\x18\x03 \x01(\bR\x0ereadonlyRootfs\"E\n" +
 	" ExtensionServiceConfigStatusSpec\x12!\n" +
 	"\fspec_version\x18\x01 \x01(\tR\vspecVersion\"n\n" +
 	"\x19ImageFactorySchematicSpec\x12!\n" +
@@ -2257,75 +3042,98 @@ func file_resource_definitions_runtime_runtime_proto_rawDescGZIP() []byte {
 	return file_resource_definitions_runtime_runtime_proto_rawDescData
 }
 
-var file_resource_definitions_runtime_runtime_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_resource_definitions_runtime_runtime_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_resource_definitions_runtime_runtime_proto_goTypes = []any{
 	(*APIServiceConfigSpec)(nil),             // 0: talos.resource.definitions.runtime.APIServiceConfigSpec
 	(*BootIDSpec)(nil),                       // 1: talos.resource.definitions.runtime.BootIDSpec
 	(*BootedEntrySpec)(nil),                  // 2: talos.resource.definitions.runtime.BootedEntrySpec
-	(*DevicesStatusSpec)(nil),                // 3: talos.resource.definitions.runtime.DevicesStatusSpec
-	(*DiagnosticSpec)(nil),                   // 4: talos.resource.definitions.runtime.DiagnosticSpec
-	(*EnvironmentSpec)(nil),                  // 5: talos.resource.definitions.runtime.EnvironmentSpec
-	(*EventSinkConfigSpec)(nil),              // 6: talos.resource.definitions.runtime.EventSinkConfigSpec
-	(*ExtensionServiceConfigFile)(nil),       // 7: talos.resource.definitions.runtime.ExtensionServiceConfigFile
-	(*ExtensionServiceConfigSpec)(nil),       // 8: talos.resource.definitions.runtime.ExtensionServiceConfigSpec
-	(*ExtensionServiceConfigStatusSpec)(nil), // 9: talos.resource.definitions.runtime.ExtensionServiceConfigStatusSpec
-	(*ImageFactorySchematicSpec)(nil),        // 10: talos.resource.definitions.runtime.ImageFactorySchematicSpec
-	(*KernelCmdlineSpec)(nil),                // 11: talos.resource.definitions.runtime.KernelCmdlineSpec
-	(*KernelModuleSpecSpec)(nil),             // 12: talos.resource.definitions.runtime.KernelModuleSpecSpec
-	(*KernelModuleStatusSpec)(nil),           // 13: talos.resource.definitions.runtime.KernelModuleStatusSpec
-	(*KernelParamSpecSpec)(nil),              // 14: talos.resource.definitions.runtime.KernelParamSpecSpec
-	(*KernelParamStatusSpec)(nil),            // 15: talos.resource.definitions.runtime.KernelParamStatusSpec
-	(*KmsgLogConfigSpec)(nil),                // 16: talos.resource.definitions.runtime.KmsgLogConfigSpec
-	(*LoadedKernelModuleSpec)(nil),           // 17: talos.resource.definitions.runtime.LoadedKernelModuleSpec
-	(*MachineStatusSpec)(nil),                // 18: talos.resource.definitions.runtime.MachineStatusSpec
-	(*MachineStatusStatus)(nil),              // 19: talos.resource.definitions.runtime.MachineStatusStatus
-	(*MaintenanceServiceConfigSpec)(nil),     // 20: talos.resource.definitions.runtime.MaintenanceServiceConfigSpec
-	(*MetaKeySpec)(nil),                      // 21: talos.resource.definitions.runtime.MetaKeySpec
-	(*MetaLoadedSpec)(nil),                   // 22: talos.resource.definitions.runtime.MetaLoadedSpec
-	(*MountStatusSpec)(nil),                  // 23: talos.resource.definitions.runtime.MountStatusSpec
-	(*OOMActionSpec)(nil),                    // 24: talos.resource.definitions.runtime.OOMActionSpec
-	(*PlatformMetadataSpec)(nil),             // 25: talos.resource.definitions.runtime.PlatformMetadataSpec
-	(*SBOMItemSpec)(nil),                     // 26: talos.resource.definitions.runtime.SBOMItemSpec
-	(*SecurityStateSpec)(nil),                // 27: talos.resource.definitions.runtime.SecurityStateSpec
-	(*ServicePIDSpec)(nil),                   // 28: talos.resource.definitions.runtime.ServicePIDSpec
-	(*UnattendedInstallStatusSpec)(nil),      // 29: talos.resource.definitions.runtime.UnattendedInstallStatusSpec
-	(*UniqueMachineTokenSpec)(nil),           // 30: talos.resource.definitions.runtime.UniqueMachineTokenSpec
-	(*UnmetCondition)(nil),                   // 31: talos.resource.definitions.runtime.UnmetCondition
-	(*VersionSpec)(nil),                      // 32: talos.resource.definitions.runtime.VersionSpec
-	(*WatchdogTimerConfigSpec)(nil),          // 33: talos.resource.definitions.runtime.WatchdogTimerConfigSpec
-	(*WatchdogTimerStatusSpec)(nil),          // 34: talos.resource.definitions.runtime.WatchdogTimerStatusSpec
-	nil,                                      // 35: talos.resource.definitions.runtime.PlatformMetadataSpec.TagsEntry
-	(enums.RuntimeKernelModuleType)(0),       // 36: talos.resource.definitions.enums.RuntimeKernelModuleType
-	(enums.RuntimeKernelModuleState)(0),      // 37: talos.resource.definitions.enums.RuntimeKernelModuleState
-	(*common.URL)(nil),                       // 38: common.URL
-	(enums.RuntimeMachineStage)(0),           // 39: talos.resource.definitions.enums.RuntimeMachineStage
-	(*common.NetIP)(nil),                     // 40: common.NetIP
-	(enums.RuntimeSELinuxState)(0),           // 41: talos.resource.definitions.enums.RuntimeSELinuxState
-	(enums.RuntimeFIPSState)(0),              // 42: talos.resource.definitions.enums.RuntimeFIPSState
-	(enums.RuntimeUnattendedInstallPhase)(0), // 43: talos.resource.definitions.enums.RuntimeUnattendedInstallPhase
-	(*durationpb.Duration)(nil),              // 44: google.protobuf.Duration
+	(*ConfigSourceStatusSpec)(nil),           // 3: talos.resource.definitions.runtime.ConfigSourceStatusSpec
+	(*DevicesStatusSpec)(nil),                // 4: talos.resource.definitions.runtime.DevicesStatusSpec
+	(*DiagnosticSpec)(nil),                   // 5: talos.resource.definitions.runtime.DiagnosticSpec
+	(*DisruptionLabelLimit)(nil),             // 6: talos.resource.definitions.runtime.DisruptionLabelLimit
+	(*DisruptionLockRequestSpec)(nil),        // 7: talos.resource.definitions.runtime.DisruptionLockRequestSpec
+	(*DisruptionLockStatusSpec)(nil),         // 8: talos.resource.definitions.runtime.DisruptionLockStatusSpec
+	(*DisruptionRequestSpec)(nil),            // 9: talos.resource.definitions.runtime.DisruptionRequestSpec
+	(*DisruptionStatusSpec)(nil),             // 10: talos.resource.definitions.runtime.DisruptionStatusSpec
+	(*EnvironmentSpec)(nil),                  // 11: talos.resource.definitions.runtime.EnvironmentSpec
+	(*EraseReportSpec)(nil),                  // 12: talos.resource.definitions.runtime.EraseReportSpec
+	(*EventSinkConfigSpec)(nil),              // 13: talos.resource.definitions.runtime.EventSinkConfigSpec
+	(*ExtensionServiceConfigFile)(nil),       // 14: talos.resource.definitions.runtime.ExtensionServiceConfigFile
+	(*ExtensionServiceConfigSpec)(nil),       // 15: talos.resource.definitions.runtime.ExtensionServiceConfigSpec
+	(*ExtensionServiceResourcesSpec)(nil),    // 16: talos.resource.definitions.runtime.ExtensionServiceResourcesSpec
+	(*ExtensionServiceSecuritySpec)(nil),     // 17: talos.resource.definitions.runtime.ExtensionServiceSecuritySpec
+	(*ExtensionServiceConfigStatusSpec)(nil), // 18: talos.resource.definitions.runtime.ExtensionServiceConfigStatusSpec
+	(*ImageFactorySchematicSpec)(nil),        // 19: talos.resource.definitions.runtime.ImageFactorySchematicSpec
+	(*KernelCmdlineSpec)(nil),                // 20: talos.resource.definitions.runtime.KernelCmdlineSpec
+	(*KernelModuleSpecSpec)(nil),             // 21: talos.resource.definitions.runtime.KernelModuleSpecSpec
+	(*KernelModuleStatusSpec)(nil),           // 22: talos.resource.definitions.runtime.KernelModuleStatusSpec
+	(*KernelParamSpecSpec)(nil),              // 23: talos.resource.definitions.runtime.KernelParamSpecSpec
+	(*KernelParamStatusSpec)(nil),            // 24: talos.resource.definitions.runtime.KernelParamStatusSpec
+	(*KmsgLogConfigSpec)(nil),                // 25: talos.resource.definitions.runtime.KmsgLogConfigSpec
+	(*LoadedKernelModuleSpec)(nil),           // 26: talos.resource.definitions.runtime.LoadedKernelModuleSpec
+	(*MachineStatusSpec)(nil),                // 27: talos.resource.definitions.runtime.MachineStatusSpec
+	(*MachineStatusStatus)(nil),              // 28: talos.resource.definitions.runtime.MachineStatusStatus
+	(*MaintenanceServiceConfigSpec)(nil),     // 29: talos.resource.definitions.runtime.MaintenanceServiceConfigSpec
+	(*MetaKeySpec)(nil),                      // 30: talos.resource.definitions.runtime.MetaKeySpec
+	(*MetaLoadedSpec)(nil),                   // 31: talos.resource.definitions.runtime.MetaLoadedSpec
+	(*MountStatusSpec)(nil),                  // 32: talos.resource.definitions.runtime.MountStatusSpec
+	(*OOMActionSpec)(nil),                    // 33: talos.resource.definitions.runtime.OOMActionSpec
+	(*PlatformMetadataSpec)(nil),             // 34: talos.resource.definitions.runtime.PlatformMetadataSpec
+	(*SBOMItemSpec)(nil),                     // 35: talos.resource.definitions.runtime.SBOMItemSpec
+	(*SecurityStateSpec)(nil),                // 36: talos.resource.definitions.runtime.SecurityStateSpec
+	(*ServicePIDSpec)(nil),                   // 37: talos.resource.definitions.runtime.ServicePIDSpec
+	(*UnattendedInstallStatusSpec)(nil),      // 38: talos.resource.definitions.runtime.UnattendedInstallStatusSpec
+	(*UniqueMachineTokenSpec)(nil),           // 39: talos.resource.definitions.runtime.UniqueMachineTokenSpec
+	(*UnmetCondition)(nil),                   // 40: talos.resource.definitions.runtime.UnmetCondition
+	(*VersionSpec)(nil),                      // 41: talos.resource.definitions.runtime.VersionSpec
+	(*WatchdogTimerConfigSpec)(nil),          // 42: talos.resource.definitions.runtime.WatchdogTimerConfigSpec
+	(*WatchdogTimerStatusSpec)(nil),          // 43: talos.resource.definitions.runtime.WatchdogTimerStatusSpec
+	nil,                                      // 44: talos.resource.definitions.runtime.DisruptionLockRequestSpec.LabelsEntry
+	nil,                                      // 45: talos.resource.definitions.runtime.PlatformMetadataSpec.TagsEntry
+	(*timestamppb.Timestamp)(nil),            // 46: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),              // 47: google.protobuf.Duration
+	(enums.RuntimeKernelModuleType)(0),       // 48: talos.resource.definitions.enums.RuntimeKernelModuleType
+	(enums.RuntimeKernelModuleState)(0),      // 49: talos.resource.definitions.enums.RuntimeKernelModuleState
+	(*common.URL)(nil),                       // 50: common.URL
+	(enums.RuntimeMachineStage)(0),           // 51: talos.resource.definitions.enums.RuntimeMachineStage
+	(*common.NetIP)(nil),                     // 52: common.NetIP
+	(enums.RuntimeSELinuxState)(0),           // 53: talos.resource.definitions.enums.RuntimeSELinuxState
+	(enums.RuntimeFIPSState)(0),              // 54: talos.resource.definitions.enums.RuntimeFIPSState
+	(enums.RuntimeUnattendedInstallPhase)(0), // 55: talos.resource.definitions.enums.RuntimeUnattendedInstallPhase
 }
 var file_resource_definitions_runtime_runtime_proto_depIdxs = []int32{
-	7,  // 0: talos.resource.definitions.runtime.ExtensionServiceConfigSpec.files:type_name -> talos.resource.definitions.runtime.ExtensionServiceConfigFile
-	36, // 1: talos.resource.definitions.runtime.KernelModuleStatusSpec.type:type_name -> talos.resource.definitions.enums.RuntimeKernelModuleType
-	37, // 2: talos.resource.definitions.runtime.KernelModuleStatusSpec.state:type_name -> talos.resource.definitions.enums.RuntimeKernelModuleState
-	38, // 3: talos.resource.definitions.runtime.KmsgLogConfigSpec.destinations:type_name -> common.URL
-	39, // 4: talos.resource.definitions.runtime.MachineStatusSpec.stage:type_name -> talos.resource.definitions.enums.RuntimeMachineStage
-	19, // 5: talos.resource.definitions.runtime.MachineStatusSpec.status:type_name -> talos.resource.definitions.runtime.MachineStatusStatus
-	31, // 6: talos.resource.definitions.runtime.MachineStatusStatus.unmet_conditions:type_name -> talos.resource.definitions.runtime.UnmetCondition
-	40, // 7: talos.resource.definitions.runtime.MaintenanceServiceConfigSpec.reachable_addresses:type_name -> common.NetIP
-	35, // 8: talos.resource.definitions.runtime.PlatformMetadataSpec.tags:type_name -> talos.resource.definitions.runtime.PlatformMetadataSpec.TagsEntry
-	41, // 9: talos.resource.definitions.runtime.SecurityStateSpec.se_linux_state:type_name -> talos.resource.definitions.enums.RuntimeSELinuxState
-	42, // 10: talos.resource.definitions.runtime.SecurityStateSpec.fips_state:type_name -> talos.resource.definitions.enums.RuntimeFIPSState
-	43, // 11: talos.resource.definitions.runtime.UnattendedInstallStatusSpec.phase:type_name -> talos.resource.definitions.enums.RuntimeUnattendedInstallPhase
-	44, // 12: talos.resource.definitions.runtime.WatchdogTimerConfigSpec.timeout:type_name -> google.protobuf.Duration
-	44, // 13: talos.resource.definitions.runtime.WatchdogTimerStatusSpec.timeout:type_name -> google.protobuf.Duration
-	44, // 14: talos.resource.definitions.runtime.WatchdogTimerStatusSpec.feed_interval:type_name -> google.protobuf.Duration
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	46, // 0: talos.resource.definitions.runtime.ConfigSourceStatusSpec.last_applied_time:type_name -> google.protobuf.Timestamp
+	46, // 1: talos.resource.definitions.runtime.ConfigSourceStatusSpec.last_sync_time:type_name -> google.protobuf.Timestamp
+	44, // 2: talos.resource.definitions.runtime.DisruptionLockRequestSpec.labels:type_name -> talos.resource.definitions.runtime.DisruptionLockRequestSpec.LabelsEntry
+	46, // 3: talos.resource.definitions.runtime.DisruptionLockRequestSpec.requested_at:type_name -> google.protobuf.Timestamp
+	46, // 4: talos.resource.definitions.runtime.DisruptionLockRequestSpec.refreshed_at:type_name -> google.protobuf.Timestamp
+	6,  // 5: talos.resource.definitions.runtime.DisruptionLockRequestSpec.label_limits:type_name -> talos.resource.definitions.runtime.DisruptionLabelLimit
+	47, // 6: talos.resource.definitions.runtime.DisruptionLockRequestSpec.lock_ttl:type_name -> google.protobuf.Duration
+	46, // 7: talos.resource.definitions.runtime.DisruptionLockStatusSpec.requested_at:type_name -> google.protobuf.Timestamp
+	46, // 8: talos.resource.definitions.runtime.DisruptionRequestSpec.requested_at:type_name -> google.protobuf.Timestamp
+	46, // 9: talos.resource.definitions.runtime.DisruptionStatusSpec.next_window:type_name -> google.protobuf.Timestamp
+	14, // 10: talos.resource.definitions.runtime.ExtensionServiceConfigSpec.files:type_name -> talos.resource.definitions.runtime.ExtensionServiceConfigFile
+	16, // 11: talos.resource.definitions.runtime.ExtensionServiceConfigSpec.resources:type_name -> talos.resource.definitions.runtime.ExtensionServiceResourcesSpec
+	17, // 12: talos.resource.definitions.runtime.ExtensionServiceConfigSpec.security:type_name -> talos.resource.definitions.runtime.ExtensionServiceSecuritySpec
+	48, // 13: talos.resource.definitions.runtime.KernelModuleStatusSpec.type:type_name -> talos.resource.definitions.enums.RuntimeKernelModuleType
+	49, // 14: talos.resource.definitions.runtime.KernelModuleStatusSpec.state:type_name -> talos.resource.definitions.enums.RuntimeKernelModuleState
+	50, // 15: talos.resource.definitions.runtime.KmsgLogConfigSpec.destinations:type_name -> common.URL
+	51, // 16: talos.resource.definitions.runtime.MachineStatusSpec.stage:type_name -> talos.resource.definitions.enums.RuntimeMachineStage
+	28, // 17: talos.resource.definitions.runtime.MachineStatusSpec.status:type_name -> talos.resource.definitions.runtime.MachineStatusStatus
+	40, // 18: talos.resource.definitions.runtime.MachineStatusStatus.unmet_conditions:type_name -> talos.resource.definitions.runtime.UnmetCondition
+	52, // 19: talos.resource.definitions.runtime.MaintenanceServiceConfigSpec.reachable_addresses:type_name -> common.NetIP
+	45, // 20: talos.resource.definitions.runtime.PlatformMetadataSpec.tags:type_name -> talos.resource.definitions.runtime.PlatformMetadataSpec.TagsEntry
+	53, // 21: talos.resource.definitions.runtime.SecurityStateSpec.se_linux_state:type_name -> talos.resource.definitions.enums.RuntimeSELinuxState
+	54, // 22: talos.resource.definitions.runtime.SecurityStateSpec.fips_state:type_name -> talos.resource.definitions.enums.RuntimeFIPSState
+	55, // 23: talos.resource.definitions.runtime.UnattendedInstallStatusSpec.phase:type_name -> talos.resource.definitions.enums.RuntimeUnattendedInstallPhase
+	47, // 24: talos.resource.definitions.runtime.WatchdogTimerConfigSpec.timeout:type_name -> google.protobuf.Duration
+	47, // 25: talos.resource.definitions.runtime.WatchdogTimerStatusSpec.timeout:type_name -> google.protobuf.Duration
+	47, // 26: talos.resource.definitions.runtime.WatchdogTimerStatusSpec.feed_interval:type_name -> google.protobuf.Duration
+	27, // [27:27] is the sub-list for method output_type
+	27, // [27:27] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_resource_definitions_runtime_runtime_proto_init() }
@@ -2339,7 +3147,7 @@ func file_resource_definitions_runtime_runtime_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resource_definitions_runtime_runtime_proto_rawDesc), len(file_resource_definitions_runtime_runtime_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   36,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
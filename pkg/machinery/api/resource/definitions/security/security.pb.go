@@ -215,6 +215,270 @@ func (x *ImageVerificationRuleSpec) GetPublicKeyVerifier() *ImagePublicKeyVerifi
 	return nil
 }
 
+// NodeAttestationPCRValueSpec describes (allowed or quoted) values of a PCR.
+type NodeAttestationPCRValueSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// PCR is the PCR index.
+	Pcr int64 `protobuf:"varint,1,opt,name=pcr,proto3" json:"pcr,omitempty"`
+	// Values is the list of hex-encoded PCR values.
+	Values        []string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeAttestationPCRValueSpec) Reset() {
+	*x = NodeAttestationPCRValueSpec{}
+	mi := &file_resource_definitions_security_security_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeAttestationPCRValueSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeAttestationPCRValueSpec) ProtoMessage() {}
+
+func (x *NodeAttestationPCRValueSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_security_security_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeAttestationPCRValueSpec.ProtoReflect.Descriptor instead.
+func (*NodeAttestationPCRValueSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_security_security_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *NodeAttestationPCRValueSpec) GetPcr() int64 {
+	if x != nil {
+		return x.Pcr
+	}
+	return 0
+}
+
+func (x *NodeAttestationPCRValueSpec) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// NodeAttestationPolicySpec describes the TPM-based node attestation policy.
+type NodeAttestationPolicySpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Mode is the attestation mode (disabled, optional, required).
+	Mode string `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	// PCRs is the list of PCRs to be quoted.
+	PcRs []int64 `protobuf:"varint,2,rep,packed,name=pc_rs,json=pcRs,proto3" json:"pc_rs,omitempty"`
+	// AllowedPCRValues is the list of allowed values per PCR.
+	AllowedPcrValues []*NodeAttestationPCRValueSpec `protobuf:"bytes,3,rep,name=allowed_pcr_values,json=allowedPcrValues,proto3" json:"allowed_pcr_values,omitempty"`
+	// PCRSigningKeys is the list of PEM-encoded public keys accepted for signed PCR policies.
+	PcrSigningKeys []string `protobuf:"bytes,4,rep,name=pcr_signing_keys,json=pcrSigningKeys,proto3" json:"pcr_signing_keys,omitempty"`
+	// EndorsementCAs is the list of PEM-encoded CA certificates to verify EK certificates.
+	EndorsementCAs []string `protobuf:"bytes,5,rep,name=endorsement_c_as,json=endorsementCAs,proto3" json:"endorsement_c_as,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NodeAttestationPolicySpec) Reset() {
+	*x = NodeAttestationPolicySpec{}
+	mi := &file_resource_definitions_security_security_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeAttestationPolicySpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeAttestationPolicySpec) ProtoMessage() {}
+
+func (x *NodeAttestationPolicySpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_security_security_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeAttestationPolicySpec.ProtoReflect.Descriptor instead.
+func (*NodeAttestationPolicySpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_security_security_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NodeAttestationPolicySpec) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *NodeAttestationPolicySpec) GetPcRs() []int64 {
+	if x != nil {
+		return x.PcRs
+	}
+	return nil
+}
+
+func (x *NodeAttestationPolicySpec) GetAllowedPcrValues() []*NodeAttestationPCRValueSpec {
+	if x != nil {
+		return x.AllowedPcrValues
+	}
+	return nil
+}
+
+func (x *NodeAttestationPolicySpec) GetPcrSigningKeys() []string {
+	if x != nil {
+		return x.PcrSigningKeys
+	}
+	return nil
+}
+
+func (x *NodeAttestationPolicySpec) GetEndorsementCAs() []string {
+	if x != nil {
+		return x.EndorsementCAs
+	}
+	return nil
+}
+
+// NodeAttestationSpec describes the result of the TPM-based attestation of a node.
+type NodeAttestationSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Address is the address the request came from.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Attested is true if the node provided valid TPM attestation evidence matching the policy.
+	Attested bool `protobuf:"varint,2,opt,name=attested,proto3" json:"attested,omitempty"`
+	// Accepted is true if the certificate was issued to the node.
+	Accepted bool `protobuf:"varint,3,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// Error is the attestation failure reason.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// EKFingerprint is the SHA-256 fingerprint of the TPM endorsement key (public part).
+	EkFingerprint string `protobuf:"bytes,5,opt,name=ek_fingerprint,json=ekFingerprint,proto3" json:"ek_fingerprint,omitempty"`
+	// EKCertificateSubject is the subject of the TPM endorsement key certificate.
+	EkCertificateSubject string `protobuf:"bytes,6,opt,name=ek_certificate_subject,json=ekCertificateSubject,proto3" json:"ek_certificate_subject,omitempty"`
+	// AKName is the TPM name of the attestation key.
+	AkName string `protobuf:"bytes,7,opt,name=ak_name,json=akName,proto3" json:"ak_name,omitempty"`
+	// PCRValues is the list of quoted PCR values.
+	PcrValues []*NodeAttestationPCRValueSpec `protobuf:"bytes,8,rep,name=pcr_values,json=pcrValues,proto3" json:"pcr_values,omitempty"`
+	// Timestamp is the time of the attestation.
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Subject is the subject common name from the certificate request (as claimed by the node).
+	Subject       string `protobuf:"bytes,10,opt,name=subject,proto3" json:"subject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeAttestationSpec) Reset() {
+	*x = NodeAttestationSpec{}
+	mi := &file_resource_definitions_security_security_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeAttestationSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeAttestationSpec) ProtoMessage() {}
+
+func (x *NodeAttestationSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_security_security_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeAttestationSpec.ProtoReflect.Descriptor instead.
+func (*NodeAttestationSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_security_security_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *NodeAttestationSpec) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *NodeAttestationSpec) GetAttested() bool {
+	if x != nil {
+		return x.Attested
+	}
+	return false
+}
+
+func (x *NodeAttestationSpec) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *NodeAttestationSpec) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *NodeAttestationSpec) GetEkFingerprint() string {
+	if x != nil {
+		return x.EkFingerprint
+	}
+	return ""
+}
+
+func (x *NodeAttestationSpec) GetEkCertificateSubject() string {
+	if x != nil {
+		return x.EkCertificateSubject
+	}
+	return ""
+}
+
+func (x *NodeAttestationSpec) GetAkName() string {
+	if x != nil {
+		return x.AkName
+	}
+	return ""
+}
+
+func (x *NodeAttestationSpec) GetPcrValues() []*NodeAttestationPCRValueSpec {
+	if x != nil {
+		return x.PcrValues
+	}
+	return nil
+}
+
+func (x *NodeAttestationSpec) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *NodeAttestationSpec) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
 // TUFTrustedRootSpec represents a sigstore's TUF trusted root information.
 type TUFTrustedRootSpec struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -228,7 +492,7 @@ type TUFTrustedRootSpec struct {
 
 func (x *TUFTrustedRootSpec) Reset() {
 	*x = TUFTrustedRootSpec{}
-	mi := &file_resource_definitions_security_security_proto_msgTypes[3]
+	mi := &file_resource_definitions_security_security_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -240,7 +504,7 @@ func (x *TUFTrustedRootSpec) String() string {
 func (*TUFTrustedRootSpec) ProtoMessage() {}
 
 func (x *TUFTrustedRootSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_security_security_proto_msgTypes[3]
+	mi := &file_resource_definitions_security_security_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -253,7 +517,7 @@ func (x *TUFTrustedRootSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TUFTrustedRootSpec.ProtoReflect.Descriptor instead.
 func (*TUFTrustedRootSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_security_security_proto_rawDescGZIP(), []int{3}
+	return file_resource_definitions_security_security_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *TUFTrustedRootSpec) GetLastRefreshTime() *timestamppb.Timestamp {
@@ -286,7 +550,29 @@ const file_resource_definitions_security_security_proto_rawDesc = "" +
 	"\x04skip\x18\x03 \x01(\bR\x04skip\x12\x12\n" +
 	"\x04deny\x18\x04 \x01(\bR\x04deny\x12h\n" +
 	"\x10keyless_verifier\x18\x05 \x01(\v2=.talos.resource.definitions.security.ImageKeylessVerifierSpecR\x0fkeylessVerifier\x12o\n" +
-	"\x13public_key_verifier\x18\x06 \x01(\v2?.talos.resource.definitions.security.ImagePublicKeyVerifierSpecR\x11publicKeyVerifier\"y\n" +
+	"\x13public_key_verifier\x18\x06 \x01(\v2?.talos.resource.definitions.security.ImagePublicKeyVerifierSpecR\x11publicKeyVerifier\"G\n" +
+	"\x1bNodeAttestationPCRValueSpec\x12\x10\n" +
+	"\x03pcr\x18\x01 \x01(\x03R\x03pcr\x12\x16\n" +
+	"\x06values\x18\x02 \x03(\tR\x06values\"\x88\x02\n" +
+	"\x19NodeAttestationPolicySpec\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\x12\x13\n" +
+	"\x05pc_rs\x18\x02 \x03(\x03R\x04pcRs\x12n\n" +
+	"\x12allowed_pcr_values\x18\x03 \x03(\v2@.talos.resource.definitions.security.NodeAttestationPCRValueSpecR\x10allowedPcrValues\x12(\n" +
+	"\x10pcr_signing_keys\x18\x04 \x03(\tR\x0epcrSigningKeys\x12(\n" +
+	"\x10endorsement_c_as\x18\x05 \x03(\tR\x0eendorsementCAs\"\xa8\x03\n" +
+	"\x13NodeAttestationSpec\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x1a\n" +
+	"\battested\x18\x02 \x01(\bR\battested\x12\x1a\n" +
+	"\baccepted\x18\x03 \x01(\bR\baccepted\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12%\n" +
+	"\x0eek_fingerprint\x18\x05 \x01(\tR\rekFingerprint\x124\n" +
+	"\x16ek_certificate_subject\x18\x06 \x01(\tR\x14ekCertificateSubject\x12\x17\n" +
+	"\aak_name\x18\a \x01(\tR\x06akName\x12_\n" +
+	"\n" +
+	"pcr_values\x18\b \x03(\v2@.talos.resource.definitions.security.NodeAttestationPCRValueSpecR\tpcrValues\x128\n" +
+	"\ttimestamp\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x18\n" +
+	"\asubject\x18\n" +
+	" \x01(\tR\asubject\"y\n" +
 	"\x12TUFTrustedRootSpec\x12F\n" +
 	"\x11last_refresh_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x0flastRefreshTime\x12\x1b\n" +
 	"\tjson_data\x18\x02 \x01(\tR\bjsonDataBz\n" +
@@ -304,23 +590,29 @@ func file_resource_definitions_security_security_proto_rawDescGZIP() []byte {
 	return file_resource_definitions_security_security_proto_rawDescData
 }
 
-var file_resource_definitions_security_security_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_resource_definitions_security_security_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_resource_definitions_security_security_proto_goTypes = []any{
-	(*ImageKeylessVerifierSpec)(nil),   // 0: talos.resource.definitions.security.ImageKeylessVerifierSpec
-	(*ImagePublicKeyVerifierSpec)(nil), // 1: talos.resource.definitions.security.ImagePublicKeyVerifierSpec
-	(*ImageVerificationRuleSpec)(nil),  // 2: talos.resource.definitions.security.ImageVerificationRuleSpec
-	(*TUFTrustedRootSpec)(nil),         // 3: talos.resource.definitions.security.TUFTrustedRootSpec
-	(*timestamppb.Timestamp)(nil),      // 4: google.protobuf.Timestamp
+	(*ImageKeylessVerifierSpec)(nil),    // 0: talos.resource.definitions.security.ImageKeylessVerifierSpec
+	(*ImagePublicKeyVerifierSpec)(nil),  // 1: talos.resource.definitions.security.ImagePublicKeyVerifierSpec
+	(*ImageVerificationRuleSpec)(nil),   // 2: talos.resource.definitions.security.ImageVerificationRuleSpec
+	(*NodeAttestationPCRValueSpec)(nil), // 3: talos.resource.definitions.security.NodeAttestationPCRValueSpec
+	(*NodeAttestationPolicySpec)(nil),   // 4: talos.resource.definitions.security.NodeAttestationPolicySpec
+	(*NodeAttestationSpec)(nil),         // 5: talos.resource.definitions.security.NodeAttestationSpec
+	(*TUFTrustedRootSpec)(nil),          // 6: talos.resource.definitions.security.TUFTrustedRootSpec
+	(*timestamppb.Timestamp)(nil),       // 7: google.protobuf.Timestamp
 }
 var file_resource_definitions_security_security_proto_depIdxs = []int32{
 	0, // 0: talos.resource.definitions.security.ImageVerificationRuleSpec.keyless_verifier:type_name -> talos.resource.definitions.security.ImageKeylessVerifierSpec
 	1, // 1: talos.resource.definitions.security.ImageVerificationRuleSpec.public_key_verifier:type_name -> talos.resource.definitions.security.ImagePublicKeyVerifierSpec
-	4, // 2: talos.resource.definitions.security.TUFTrustedRootSpec.last_refresh_time:type_name -> google.protobuf.Timestamp
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	3, // 2: talos.resource.definitions.security.NodeAttestationPolicySpec.allowed_pcr_values:type_name -> talos.resource.definitions.security.NodeAttestationPCRValueSpec
+	3, // 3: talos.resource.definitions.security.NodeAttestationSpec.pcr_values:type_name -> talos.resource.definitions.security.NodeAttestationPCRValueSpec
+	7, // 4: talos.resource.definitions.security.NodeAttestationSpec.timestamp:type_name -> google.protobuf.Timestamp
+	7, // 5: talos.resource.definitions.security.TUFTrustedRootSpec.last_refresh_time:type_name -> google.protobuf.Timestamp
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_resource_definitions_security_security_proto_init() }
@@ -334,7 +626,7 @@ func file_resource_definitions_security_security_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resource_definitions_security_security_proto_rawDesc), len(file_resource_definitions_security_security_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
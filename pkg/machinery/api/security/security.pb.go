@@ -13,6 +13,8 @@ import (
 
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -26,7 +28,9 @@ const (
 type CertificateRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Certificate Signing Request in PEM format.
-	Csr           []byte `protobuf:"bytes,1,opt,name=csr,proto3" json:"csr,omitempty"`
+	Csr []byte `protobuf:"bytes,1,opt,name=csr,proto3" json:"csr,omitempty"`
+	// TPM attestation evidence of the node (optional).
+	Attestation   *NodeAttestation `protobuf:"bytes,2,opt,name=attestation,proto3" json:"attestation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -68,6 +72,13 @@ func (x *CertificateRequest) GetCsr() []byte {
 	return nil
 }
 
+func (x *CertificateRequest) GetAttestation() *NodeAttestation {
+	if x != nil {
+		return x.Attestation
+	}
+	return nil
+}
+
 // The response message containing signed certificate.
 type CertificateResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -123,18 +134,652 @@ func (x *CertificateResponse) GetCrt() []byte {
 	return nil
 }
 
+// TPM keys of the node used for the attestation.
+type AttestationKeys struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Endorsement key public area (TPM2B_PUBLIC).
+	EkPublic []byte `protobuf:"bytes,1,opt,name=ek_public,json=ekPublic,proto3" json:"ek_public,omitempty"`
+	// Endorsement key certificate in DER format (optional).
+	EkCertificate []byte `protobuf:"bytes,2,opt,name=ek_certificate,json=ekCertificate,proto3" json:"ek_certificate,omitempty"`
+	// Attestation key public area (TPM2B_PUBLIC).
+	AkPublic      []byte `protobuf:"bytes,3,opt,name=ak_public,json=akPublic,proto3" json:"ak_public,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttestationKeys) Reset() {
+	*x = AttestationKeys{}
+	mi := &file_security_security_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttestationKeys) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttestationKeys) ProtoMessage() {}
+
+func (x *AttestationKeys) ProtoReflect() protoreflect.Message {
+	mi := &file_security_security_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttestationKeys.ProtoReflect.Descriptor instead.
+func (*AttestationKeys) Descriptor() ([]byte, []int) {
+	return file_security_security_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AttestationKeys) GetEkPublic() []byte {
+	if x != nil {
+		return x.EkPublic
+	}
+	return nil
+}
+
+func (x *AttestationKeys) GetEkCertificate() []byte {
+	if x != nil {
+		return x.EkCertificate
+	}
+	return nil
+}
+
+func (x *AttestationKeys) GetAkPublic() []byte {
+	if x != nil {
+		return x.AkPublic
+	}
+	return nil
+}
+
+// The request message to start the attestation.
+type AttestationChallengeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          *AttestationKeys       `protobuf:"bytes,1,opt,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttestationChallengeRequest) Reset() {
+	*x = AttestationChallengeRequest{}
+	mi := &file_security_security_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttestationChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttestationChallengeRequest) ProtoMessage() {}
+
+func (x *AttestationChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_security_security_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttestationChallengeRequest.ProtoReflect.Descriptor instead.
+func (*AttestationChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_security_security_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AttestationChallengeRequest) GetKeys() *AttestationKeys {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// The response message containing the credential to be activated with the TPM.
+type AttestationChallengeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Opaque challenge to be sent back with the attestation evidence.
+	Challenge []byte `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	// Credential blob (TPM2B_ID_OBJECT).
+	CredentialBlob []byte `protobuf:"bytes,2,opt,name=credential_blob,json=credentialBlob,proto3" json:"credential_blob,omitempty"`
+	// Encrypted seed (TPM2B_ENCRYPTED_SECRET).
+	EncryptedSecret []byte `protobuf:"bytes,3,opt,name=encrypted_secret,json=encryptedSecret,proto3" json:"encrypted_secret,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AttestationChallengeResponse) Reset() {
+	*x = AttestationChallengeResponse{}
+	mi := &file_security_security_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttestationChallengeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttestationChallengeResponse) ProtoMessage() {}
+
+func (x *AttestationChallengeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_security_security_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttestationChallengeResponse.ProtoReflect.Descriptor instead.
+func (*AttestationChallengeResponse) Descriptor() ([]byte, []int) {
+	return file_security_security_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AttestationChallengeResponse) GetChallenge() []byte {
+	if x != nil {
+		return x.Challenge
+	}
+	return nil
+}
+
+func (x *AttestationChallengeResponse) GetCredentialBlob() []byte {
+	if x != nil {
+		return x.CredentialBlob
+	}
+	return nil
+}
+
+func (x *AttestationChallengeResponse) GetEncryptedSecret() []byte {
+	if x != nil {
+		return x.EncryptedSecret
+	}
+	return nil
+}
+
+// PCR value in the SHA-256 bank.
+type PCRValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         uint32                 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Value         []byte                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PCRValue) Reset() {
+	*x = PCRValue{}
+	mi := &file_security_security_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PCRValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PCRValue) ProtoMessage() {}
+
+func (x *PCRValue) ProtoReflect() protoreflect.Message {
+	mi := &file_security_security_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PCRValue.ProtoReflect.Descriptor instead.
+func (*PCRValue) Descriptor() ([]byte, []int) {
+	return file_security_security_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PCRValue) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *PCRValue) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// TPM attestation evidence of the node.
+type NodeAttestation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Keys  *AttestationKeys       `protobuf:"bytes,1,opt,name=keys,proto3" json:"keys,omitempty"`
+	// Challenge as returned by AttestationChallenge.
+	Challenge []byte `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	// Secret recovered with TPM2_ActivateCredential.
+	Secret []byte `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Quote (TPMS_ATTEST) over the PCRs, qualifying data is SHA-256 of the secret and the CSR.
+	Quote []byte `protobuf:"bytes,4,opt,name=quote,proto3" json:"quote,omitempty"`
+	// Quote signature (TPMT_SIGNATURE) made with the attestation key.
+	QuoteSignature []byte `protobuf:"bytes,5,opt,name=quote_signature,json=quoteSignature,proto3" json:"quote_signature,omitempty"`
+	// Quoted PCR values.
+	PcrValues []*PCRValue `protobuf:"bytes,6,rep,name=pcr_values,json=pcrValues,proto3" json:"pcr_values,omitempty"`
+	// Signed PCR policy (JSON) of the booted UKI (optional).
+	PcrSignature []byte `protobuf:"bytes,7,opt,name=pcr_signature,json=pcrSignature,proto3" json:"pcr_signature,omitempty"`
+	// PCR 11 value at the enter-machined phase covered by the signed PCR policy (optional).
+	SignedPhasePcrValue []byte `protobuf:"bytes,8,opt,name=signed_phase_pcr_value,json=signedPhasePcrValue,proto3" json:"signed_phase_pcr_value,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *NodeAttestation) Reset() {
+	*x = NodeAttestation{}
+	mi := &file_security_security_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeAttestation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeAttestation) ProtoMessage() {}
+
+func (x *NodeAttestation) ProtoReflect() protoreflect.Message {
+	mi := &file_security_security_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeAttestation.ProtoReflect.Descriptor instead.
+func (*NodeAttestation) Descriptor() ([]byte, []int) {
+	return file_security_security_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *NodeAttestation) GetKeys() *AttestationKeys {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *NodeAttestation) GetChallenge() []byte {
+	if x != nil {
+		return x.Challenge
+	}
+	return nil
+}
+
+func (x *NodeAttestation) GetSecret() []byte {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *NodeAttestation) GetQuote() []byte {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+func (x *NodeAttestation) GetQuoteSignature() []byte {
+	if x != nil {
+		return x.QuoteSignature
+	}
+	return nil
+}
+
+func (x *NodeAttestation) GetPcrValues() []*PCRValue {
+	if x != nil {
+		return x.PcrValues
+	}
+	return nil
+}
+
+func (x *NodeAttestation) GetPcrSignature() []byte {
+	if x != nil {
+		return x.PcrSignature
+	}
+	return nil
+}
+
+func (x *NodeAttestation) GetSignedPhasePcrValue() []byte {
+	if x != nil {
+		return x.SignedPhasePcrValue
+	}
+	return nil
+}
+
+// Limits on the number of nodes disrupted at the same time.
+type DisruptionLimits struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	MaxNodes             int64                  `protobuf:"varint,1,opt,name=max_nodes,json=maxNodes,proto3" json:"max_nodes,omitempty"`
+	MaxControlPlaneNodes int64                  `protobuf:"varint,2,opt,name=max_control_plane_nodes,json=maxControlPlaneNodes,proto3" json:"max_control_plane_nodes,omitempty"`
+	// Limits per node label key.
+	Labels        map[string]int64 `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisruptionLimits) Reset() {
+	*x = DisruptionLimits{}
+	mi := &file_security_security_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisruptionLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisruptionLimits) ProtoMessage() {}
+
+func (x *DisruptionLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_security_security_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisruptionLimits.ProtoReflect.Descriptor instead.
+func (*DisruptionLimits) Descriptor() ([]byte, []int) {
+	return file_security_security_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DisruptionLimits) GetMaxNodes() int64 {
+	if x != nil {
+		return x.MaxNodes
+	}
+	return 0
+}
+
+func (x *DisruptionLimits) GetMaxControlPlaneNodes() int64 {
+	if x != nil {
+		return x.MaxControlPlaneNodes
+	}
+	return 0
+}
+
+func (x *DisruptionLimits) GetLabels() map[string]int64 {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+// The request message to acquire (or release) the cluster-wide disruption lock slot.
+type DisruptionLockRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the requesting node.
+	Node         string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	ControlPlane bool   `protobuf:"varint,2,opt,name=control_plane,json=controlPlane,proto3" json:"control_plane,omitempty"`
+	// Labels of the requesting node.
+	Labels map[string]string `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Disruptive operation the slot is requested for.
+	Operation string `protobuf:"bytes,4,opt,name=operation,proto3" json:"operation,omitempty"`
+	// Time of the request, identifies the request while it is being refreshed.
+	RequestedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	// Release the slot held by the node.
+	Release bool              `protobuf:"varint,6,opt,name=release,proto3" json:"release,omitempty"`
+	Limits  *DisruptionLimits `protobuf:"bytes,7,opt,name=limits,proto3" json:"limits,omitempty"`
+	// Time the slot is held unless released.
+	LockTtl       *durationpb.Duration `protobuf:"bytes,8,opt,name=lock_ttl,json=lockTtl,proto3" json:"lock_ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisruptionLockRequest) Reset() {
+	*x = DisruptionLockRequest{}
+	mi := &file_security_security_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisruptionLockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisruptionLockRequest) ProtoMessage() {}
+
+func (x *DisruptionLockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_security_security_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisruptionLockRequest.ProtoReflect.Descriptor instead.
+func (*DisruptionLockRequest) Descriptor() ([]byte, []int) {
+	return file_security_security_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DisruptionLockRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *DisruptionLockRequest) GetControlPlane() bool {
+	if x != nil {
+		return x.ControlPlane
+	}
+	return false
+}
+
+func (x *DisruptionLockRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *DisruptionLockRequest) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *DisruptionLockRequest) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+func (x *DisruptionLockRequest) GetRelease() bool {
+	if x != nil {
+		return x.Release
+	}
+	return false
+}
+
+func (x *DisruptionLockRequest) GetLimits() *DisruptionLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *DisruptionLockRequest) GetLockTtl() *durationpb.Duration {
+	if x != nil {
+		return x.LockTtl
+	}
+	return nil
+}
+
+// The response message with the state of the disruption lock request.
+type DisruptionLockResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The request is not processed yet.
+	Pending bool `protobuf:"varint,1,opt,name=pending,proto3" json:"pending,omitempty"`
+	Granted bool `protobuf:"varint,2,opt,name=granted,proto3" json:"granted,omitempty"`
+	// Position of the node in the cluster-wide queue (1-based).
+	QueuePosition int64 `protobuf:"varint,3,opt,name=queue_position,json=queuePosition,proto3" json:"queue_position,omitempty"`
+	// Nodes holding the lock slots.
+	Holders       []string `protobuf:"bytes,4,rep,name=holders,proto3" json:"holders,omitempty"`
+	Error         string   `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisruptionLockResponse) Reset() {
+	*x = DisruptionLockResponse{}
+	mi := &file_security_security_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisruptionLockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisruptionLockResponse) ProtoMessage() {}
+
+func (x *DisruptionLockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_security_security_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisruptionLockResponse.ProtoReflect.Descriptor instead.
+func (*DisruptionLockResponse) Descriptor() ([]byte, []int) {
+	return file_security_security_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DisruptionLockResponse) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+func (x *DisruptionLockResponse) GetGranted() bool {
+	if x != nil {
+		return x.Granted
+	}
+	return false
+}
+
+func (x *DisruptionLockResponse) GetQueuePosition() int64 {
+	if x != nil {
+		return x.QueuePosition
+	}
+	return 0
+}
+
+func (x *DisruptionLockResponse) GetHolders() []string {
+	if x != nil {
+		return x.Holders
+	}
+	return nil
+}
+
+func (x *DisruptionLockResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 var File_security_security_proto protoreflect.FileDescriptor
 
 const file_security_security_proto_rawDesc = "" +
 	"\n" +
-	"\x17security/security.proto\x12\vsecurityapi\"&\n" +
+	"\x17security/security.proto\x12\vsecurityapi\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"f\n" +
 	"\x12CertificateRequest\x12\x10\n" +
-	"\x03csr\x18\x01 \x01(\fR\x03csr\"7\n" +
+	"\x03csr\x18\x01 \x01(\fR\x03csr\x12>\n" +
+	"\vattestation\x18\x02 \x01(\v2\x1c.securityapi.NodeAttestationR\vattestation\"7\n" +
 	"\x13CertificateResponse\x12\x0e\n" +
 	"\x02ca\x18\x01 \x01(\fR\x02ca\x12\x10\n" +
-	"\x03crt\x18\x02 \x01(\fR\x03crt2c\n" +
+	"\x03crt\x18\x02 \x01(\fR\x03crt\"r\n" +
+	"\x0fAttestationKeys\x12\x1b\n" +
+	"\tek_public\x18\x01 \x01(\fR\bekPublic\x12%\n" +
+	"\x0eek_certificate\x18\x02 \x01(\fR\rekCertificate\x12\x1b\n" +
+	"\tak_public\x18\x03 \x01(\fR\bakPublic\"O\n" +
+	"\x1bAttestationChallengeRequest\x120\n" +
+	"\x04keys\x18\x01 \x01(\v2\x1c.securityapi.AttestationKeysR\x04keys\"\x90\x01\n" +
+	"\x1cAttestationChallengeResponse\x12\x1c\n" +
+	"\tchallenge\x18\x01 \x01(\fR\tchallenge\x12'\n" +
+	"\x0fcredential_blob\x18\x02 \x01(\fR\x0ecredentialBlob\x12)\n" +
+	"\x10encrypted_secret\x18\x03 \x01(\fR\x0fencryptedSecret\"6\n" +
+	"\bPCRValue\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\rR\x05index\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value\"\xc8\x02\n" +
+	"\x0fNodeAttestation\x120\n" +
+	"\x04keys\x18\x01 \x01(\v2\x1c.securityapi.AttestationKeysR\x04keys\x12\x1c\n" +
+	"\tchallenge\x18\x02 \x01(\fR\tchallenge\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\fR\x06secret\x12\x14\n" +
+	"\x05quote\x18\x04 \x01(\fR\x05quote\x12'\n" +
+	"\x0fquote_signature\x18\x05 \x01(\fR\x0equoteSignature\x124\n" +
+	"\n" +
+	"pcr_values\x18\x06 \x03(\v2\x15.securityapi.PCRValueR\tpcrValues\x12#\n" +
+	"\rpcr_signature\x18\a \x01(\fR\fpcrSignature\x123\n" +
+	"\x16signed_phase_pcr_value\x18\b \x01(\fR\x13signedPhasePcrValue\"\xe4\x01\n" +
+	"\x10DisruptionLimits\x12\x1b\n" +
+	"\tmax_nodes\x18\x01 \x01(\x03R\bmaxNodes\x125\n" +
+	"\x17max_control_plane_nodes\x18\x02 \x01(\x03R\x14maxControlPlaneNodes\x12A\n" +
+	"\x06labels\x18\x03 \x03(\v2).securityapi.DisruptionLimits.LabelsEntryR\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\xb7\x03\n" +
+	"\x15DisruptionLockRequest\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\x12#\n" +
+	"\rcontrol_plane\x18\x02 \x01(\bR\fcontrolPlane\x12F\n" +
+	"\x06labels\x18\x03 \x03(\v2..securityapi.DisruptionLockRequest.LabelsEntryR\x06labels\x12\x1c\n" +
+	"\toperation\x18\x04 \x01(\tR\toperation\x12=\n" +
+	"\frequested_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\x12\x18\n" +
+	"\arelease\x18\x06 \x01(\bR\arelease\x125\n" +
+	"\x06limits\x18\a \x01(\v2\x1d.securityapi.DisruptionLimitsR\x06limits\x124\n" +
+	"\block_ttl\x18\b \x01(\v2\x19.google.protobuf.DurationR\alockTtl\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa3\x01\n" +
+	"\x16DisruptionLockResponse\x12\x18\n" +
+	"\apending\x18\x01 \x01(\bR\apending\x12\x18\n" +
+	"\agranted\x18\x02 \x01(\bR\agranted\x12%\n" +
+	"\x0equeue_position\x18\x03 \x01(\x03R\rqueuePosition\x12\x18\n" +
+	"\aholders\x18\x04 \x03(\tR\aholders\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error2\xab\x02\n" +
 	"\x0fSecurityService\x12P\n" +
-	"\vCertificate\x12\x1f.securityapi.CertificateRequest\x1a .securityapi.CertificateResponseBP\n" +
+	"\vCertificate\x12\x1f.securityapi.CertificateRequest\x1a .securityapi.CertificateResponse\x12k\n" +
+	"\x14AttestationChallenge\x12(.securityapi.AttestationChallengeRequest\x1a).securityapi.AttestationChallengeResponse\x12Y\n" +
+	"\x0eDisruptionLock\x12\".securityapi.DisruptionLockRequest\x1a#.securityapi.DisruptionLockResponseBP\n" +
 	"\x16dev.talos.api.securityZ6github.com/siderolabs/talos/pkg/machinery/api/securityb\x06proto3"
 
 var (
@@ -149,19 +794,44 @@ func file_security_security_proto_rawDescGZIP() []byte {
 	return file_security_security_proto_rawDescData
 }
 
-var file_security_security_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_security_security_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_security_security_proto_goTypes = []any{
-	(*CertificateRequest)(nil),  // 0: securityapi.CertificateRequest
-	(*CertificateResponse)(nil), // 1: securityapi.CertificateResponse
+	(*CertificateRequest)(nil),           // 0: securityapi.CertificateRequest
+	(*CertificateResponse)(nil),          // 1: securityapi.CertificateResponse
+	(*AttestationKeys)(nil),              // 2: securityapi.AttestationKeys
+	(*AttestationChallengeRequest)(nil),  // 3: securityapi.AttestationChallengeRequest
+	(*AttestationChallengeResponse)(nil), // 4: securityapi.AttestationChallengeResponse
+	(*PCRValue)(nil),                     // 5: securityapi.PCRValue
+	(*NodeAttestation)(nil),              // 6: securityapi.NodeAttestation
+	(*DisruptionLimits)(nil),             // 7: securityapi.DisruptionLimits
+	(*DisruptionLockRequest)(nil),        // 8: securityapi.DisruptionLockRequest
+	(*DisruptionLockResponse)(nil),       // 9: securityapi.DisruptionLockResponse
+	nil,                                  // 10: securityapi.DisruptionLimits.LabelsEntry
+	nil,                                  // 11: securityapi.DisruptionLockRequest.LabelsEntry
+	(*timestamppb.Timestamp)(nil),        // 12: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),          // 13: google.protobuf.Duration
 }
 var file_security_security_proto_depIdxs = []int32{
-	0, // 0: securityapi.SecurityService.Certificate:input_type -> securityapi.CertificateRequest
-	1, // 1: securityapi.SecurityService.Certificate:output_type -> securityapi.CertificateResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	6,  // 0: securityapi.CertificateRequest.attestation:type_name -> securityapi.NodeAttestation
+	2,  // 1: securityapi.AttestationChallengeRequest.keys:type_name -> securityapi.AttestationKeys
+	2,  // 2: securityapi.NodeAttestation.keys:type_name -> securityapi.AttestationKeys
+	5,  // 3: securityapi.NodeAttestation.pcr_values:type_name -> securityapi.PCRValue
+	10, // 4: securityapi.DisruptionLimits.labels:type_name -> securityapi.DisruptionLimits.LabelsEntry
+	11, // 5: securityapi.DisruptionLockRequest.labels:type_name -> securityapi.DisruptionLockRequest.LabelsEntry
+	12, // 6: securityapi.DisruptionLockRequest.requested_at:type_name -> google.protobuf.Timestamp
+	7,  // 7: securityapi.DisruptionLockRequest.limits:type_name -> securityapi.DisruptionLimits
+	13, // 8: securityapi.DisruptionLockRequest.lock_ttl:type_name -> google.protobuf.Duration
+	0,  // 9: securityapi.SecurityService.Certificate:input_type -> securityapi.CertificateRequest
+	3,  // 10: securityapi.SecurityService.AttestationChallenge:input_type -> securityapi.AttestationChallengeRequest
+	8,  // 11: securityapi.SecurityService.DisruptionLock:input_type -> securityapi.DisruptionLockRequest
+	1,  // 12: securityapi.SecurityService.Certificate:output_type -> securityapi.CertificateResponse
+	4,  // 13: securityapi.SecurityService.AttestationChallenge:output_type -> securityapi.AttestationChallengeResponse
+	9,  // 14: securityapi.SecurityService.DisruptionLock:output_type -> securityapi.DisruptionLockResponse
+	12, // [12:15] is the sub-list for method output_type
+	9,  // [9:12] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_security_security_proto_init() }
@@ -175,7 +845,7 @@ func file_security_security_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_security_security_proto_rawDesc), len(file_security_security_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,7 +20,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SecurityService_Certificate_FullMethodName = "/securityapi.SecurityService/Certificate"
+	SecurityService_Certificate_FullMethodName          = "/securityapi.SecurityService/Certificate"
+	SecurityService_AttestationChallenge_FullMethodName = "/securityapi.SecurityService/AttestationChallenge"
+	SecurityService_DisruptionLock_FullMethodName       = "/securityapi.SecurityService/DisruptionLock"
 )
 
 // SecurityServiceClient is the client API for SecurityService service.
@@ -30,6 +32,8 @@ const (
 // The security service definition.
 type SecurityServiceClient interface {
 	Certificate(ctx context.Context, in *CertificateRequest, opts ...grpc.CallOption) (*CertificateResponse, error)
+	AttestationChallenge(ctx context.Context, in *AttestationChallengeRequest, opts ...grpc.CallOption) (*AttestationChallengeResponse, error)
+	DisruptionLock(ctx context.Context, in *DisruptionLockRequest, opts ...grpc.CallOption) (*DisruptionLockResponse, error)
 }
 
 type securityServiceClient struct {
@@ -50,6 +54,26 @@ func (c *securityServiceClient) Certificate(ctx context.Context, in *Certificate
 	return out, nil
 }
 
+func (c *securityServiceClient) AttestationChallenge(ctx context.Context, in *AttestationChallengeRequest, opts ...grpc.CallOption) (*AttestationChallengeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttestationChallengeResponse)
+	err := c.cc.Invoke(ctx, SecurityService_AttestationChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityServiceClient) DisruptionLock(ctx context.Context, in *DisruptionLockRequest, opts ...grpc.CallOption) (*DisruptionLockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisruptionLockResponse)
+	err := c.cc.Invoke(ctx, SecurityService_DisruptionLock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SecurityServiceServer is the server API for SecurityService service.
 // All implementations must embed UnimplementedSecurityServiceServer
 // for forward compatibility.
@@ -57,6 +81,8 @@ func (c *securityServiceClient) Certificate(ctx context.Context, in *Certificate
 // The security service definition.
 type SecurityServiceServer interface {
 	Certificate(context.Context, *CertificateRequest) (*CertificateResponse, error)
+	AttestationChallenge(context.Context, *AttestationChallengeRequest) (*AttestationChallengeResponse, error)
+	DisruptionLock(context.Context, *DisruptionLockRequest) (*DisruptionLockResponse, error)
 	mustEmbedUnimplementedSecurityServiceServer()
 }
 
@@ -70,6 +96,12 @@ type UnimplementedSecurityServiceServer struct{}
 func (UnimplementedSecurityServiceServer) Certificate(context.Context, *CertificateRequest) (*CertificateResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Certificate not implemented")
 }
+func (UnimplementedSecurityServiceServer) AttestationChallenge(context.Context, *AttestationChallengeRequest) (*AttestationChallengeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AttestationChallenge not implemented")
+}
+func (UnimplementedSecurityServiceServer) DisruptionLock(context.Context, *DisruptionLockRequest) (*DisruptionLockResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DisruptionLock not implemented")
+}
 func (UnimplementedSecurityServiceServer) mustEmbedUnimplementedSecurityServiceServer() {}
 func (UnimplementedSecurityServiceServer) testEmbeddedByValue()                         {}
 
@@ -109,6 +141,42 @@ func _SecurityService_Certificate_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SecurityService_AttestationChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestationChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityServiceServer).AttestationChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityService_AttestationChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityServiceServer).AttestationChallenge(ctx, req.(*AttestationChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityService_DisruptionLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisruptionLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityServiceServer).DisruptionLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityService_DisruptionLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityServiceServer).DisruptionLock(ctx, req.(*DisruptionLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SecurityService_ServiceDesc is the grpc.ServiceDesc for SecurityService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -120,6 +188,14 @@ var SecurityService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Certificate",
 			Handler:    _SecurityService_Certificate_Handler,
 		},
+		{
+			MethodName: "AttestationChallenge",
+			Handler:    _SecurityService_AttestationChallenge_Handler,
+		},
+		{
+			MethodName: "DisruptionLock",
+			Handler:    _SecurityService_DisruptionLock_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "security/security.proto",
@@ -90,6 +90,14 @@ const (
 	BlockDeviceWipeDescriptor_FAST BlockDeviceWipeDescriptor_Method = 0
 	// Zeroes wipe - wipe by overwriting with zeroes (might be slow depending on the disk size and available hardware features).
 	BlockDeviceWipeDescriptor_ZEROES BlockDeviceWipeDescriptor_Method = 1
+	// NVMe Sanitize with the cryptographic erase action (whole disks only, the controller should have no other active namespaces).
+	BlockDeviceWipeDescriptor_NVME_SANITIZE BlockDeviceWipeDescriptor_Method = 2
+	// NVMe Format with the cryptographic erase secure erase setting (whole disks only).
+	BlockDeviceWipeDescriptor_NVME_FORMAT BlockDeviceWipeDescriptor_Method = 3
+	// ATA Security Erase Unit, enhanced erase is used if supported by the device (whole disks only).
+	BlockDeviceWipeDescriptor_ATA_SECURE_ERASE BlockDeviceWipeDescriptor_Method = 4
+	// Destroy LUKS headers and keyslots of the encrypted volumes on the device (and its partitions).
+	BlockDeviceWipeDescriptor_LUKS_DESTROY BlockDeviceWipeDescriptor_Method = 5
 )
 
 // Enum value maps for BlockDeviceWipeDescriptor_Method.
@@ -97,10 +105,18 @@ var (
 	BlockDeviceWipeDescriptor_Method_name = map[int32]string{
 		0: "FAST",
 		1: "ZEROES",
+		2: "NVME_SANITIZE",
+		3: "NVME_FORMAT",
+		4: "ATA_SECURE_ERASE",
+		5: "LUKS_DESTROY",
 	}
 	BlockDeviceWipeDescriptor_Method_value = map[string]int32{
-		"FAST":   0,
-		"ZEROES": 1,
+		"FAST":             0,
+		"ZEROES":           1,
+		"NVME_SANITIZE":    2,
+		"NVME_FORMAT":      3,
+		"ATA_SECURE_ERASE": 4,
+		"LUKS_DESTROY":     5,
 	}
 )
 
@@ -637,17 +653,21 @@ const file_storage_storage_proto_rawDesc = "" +
 	"\rDisksResponse\x12*\n" +
 	"\bmessages\x18\x01 \x03(\v2\x0e.storage.DisksR\bmessages\"V\n" +
 	"\x16BlockDeviceWipeRequest\x12<\n" +
-	"\adevices\x18\x01 \x03(\v2\".storage.BlockDeviceWipeDescriptorR\adevices\"\x9b\x02\n" +
+	"\adevices\x18\x01 \x03(\v2\".storage.BlockDeviceWipeDescriptorR\adevices\"\xe7\x02\n" +
 	"\x19BlockDeviceWipeDescriptor\x12\x16\n" +
 	"\x06device\x18\x01 \x01(\tR\x06device\x12A\n" +
 	"\x06method\x18\x02 \x01(\x0e2).storage.BlockDeviceWipeDescriptor.MethodR\x06method\x12*\n" +
 	"\x11skip_volume_check\x18\x03 \x01(\bR\x0fskipVolumeCheck\x120\n" +
 	"\x14skip_secondary_check\x18\x05 \x01(\bR\x12skipSecondaryCheck\x12%\n" +
-	"\x0edrop_partition\x18\x04 \x01(\bR\rdropPartition\"\x1e\n" +
+	"\x0edrop_partition\x18\x04 \x01(\bR\rdropPartition\"j\n" +
 	"\x06Method\x12\b\n" +
 	"\x04FAST\x10\x00\x12\n" +
 	"\n" +
-	"\x06ZEROES\x10\x01\"O\n" +
+	"\x06ZEROES\x10\x01\x12\x11\n" +
+	"\rNVME_SANITIZE\x10\x02\x12\x0f\n" +
+	"\vNVME_FORMAT\x10\x03\x12\x14\n" +
+	"\x10ATA_SECURE_ERASE\x10\x04\x12\x10\n" +
+	"\fLUKS_DESTROY\x10\x05\"O\n" +
 	"\x17BlockDeviceWipeResponse\x124\n" +
 	"\bmessages\x18\x01 \x03(\v2\x18.storage.BlockDeviceWipeR\bmessages\"?\n" +
 	"\x0fBlockDeviceWipe\x12,\n" +
@@ -18,6 +18,7 @@ import (
 	"github.com/siderolabs/gen/xslices"
 
 	"github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/block"
+	"github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/hardware"
 	"github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/network"
 	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 )
@@ -245,6 +246,81 @@ var LinkLocator = sync.OnceValue(func() *cel.Env {
 	return env
 })
 
+// PCIDeviceLocator is a PCI device locator CEL environment.
+var PCIDeviceLocator = sync.OnceValue(func() *cel.Env {
+	var pciDeviceSpec hardware.PCIDeviceSpec
+
+	env, err := cel.NewEnv(
+		slices.Concat(
+			[]cel.EnvOption{
+				cel.Types(&pciDeviceSpec),
+				cel.Variable("pci_device", cel.ObjectType(string(pciDeviceSpec.ProtoReflect().Descriptor().FullName()))),
+				cel.Variable("pci_address", types.StringType),
+				cel.Function(
+					"glob", // glob(pattern, string) -> bool
+					cel.Overload(
+						"glob_string_string", []*cel.Type{cel.StringType, cel.StringType}, cel.BoolType,
+						cel.BinaryBinding(func(arg1, arg2 ref.Val) ref.Val {
+							return types.Bool(glob.Glob(string(arg1.(types.String)), string(arg2.(types.String))))
+						}),
+					),
+				),
+			},
+		)...,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return env
+})
+
+// MachineConfig is a machine config CEL environment.
+//
+// The expression is evaluated either for each machine config document, or for each field of the documents:
+// doc is the document ID (e.g. "v1alpha1" or "LinkConfig/eth0"), machineType is the machine type of the config,
+// and spec is the document contents.
+// For the fields, path is the dotted field path, and value is the field value; for the documents, path is empty,
+// and value is the document contents.
+var MachineConfig = sync.OnceValue(func() *cel.Env {
+	env, err := cel.NewEnv(
+		cel.Variable("doc", types.StringType),
+		cel.Variable("machineType", types.StringType),
+		cel.Variable("spec", types.NewMapType(types.StringType, types.DynType)),
+		cel.Variable("path", types.StringType),
+		cel.Variable("value", types.DynType),
+		cel.Function(
+			"glob", // glob(pattern, string) -> bool
+			cel.Overload(
+				"glob_string_string", []*cel.Type{cel.StringType, cel.StringType}, cel.BoolType,
+				cel.BinaryBinding(func(arg1, arg2 ref.Val) ref.Val {
+					return types.Bool(glob.Glob(string(arg1.(types.String)), string(arg2.(types.String))))
+				}),
+			),
+		),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return env
+})
+
+// Resource is a COSI resource CEL environment.
+//
+// The expression is evaluated for each resource: metadata and spec are the resource metadata and spec as in the YAML output.
+var Resource = sync.OnceValue(func() *cel.Env {
+	env, err := cel.NewEnv(
+		cel.Variable("metadata", types.NewMapType(types.StringType, types.DynType)),
+		cel.Variable("spec", types.DynType),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return env
+})
+
 type unitMultiplier struct {
 	unit       string
 	multiplier uint64
@@ -156,3 +156,96 @@ func TestLinkLocator(t *testing.T) {
 		})
 	}
 }
+
+func TestPCIDeviceLocator(t *testing.T) {
+	t.Parallel()
+
+	env := celenv.PCIDeviceLocator()
+
+	for _, test := range []struct {
+		name       string
+		expression string
+	}{
+		{
+			name:       "by vendor and product",
+			expression: `pci_device.vendor_id == "0x8086" && pci_device.product_id == "0x1593"`,
+		},
+		{
+			name:       "by driver",
+			expression: `pci_device.driver == "ice"`,
+		},
+		{
+			name:       "by address",
+			expression: `glob("0000:04:00.*", pci_address)`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := cel.ParseBooleanExpression(test.expression, env)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMachineConfig(t *testing.T) {
+	t.Parallel()
+
+	env := celenv.MachineConfig()
+
+	for _, test := range []struct {
+		name       string
+		expression string
+	}{
+		{
+			name:       "by path",
+			expression: `doc == "v1alpha1" && path.startsWith("machine.network.interfaces.")`,
+		},
+		{
+			name:       "by value",
+			expression: `type(value) == string && value.startsWith("10.5.")`,
+		},
+		{
+			name:       "by spec",
+			expression: `doc == "v1alpha1" && has(spec.debug) && spec.debug == true`,
+		},
+		{
+			name:       "by machine type",
+			expression: `machineType == "controlplane" && glob("LinkConfig/*", doc) && !has(spec.mtu)`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := cel.ParseBooleanExpression(test.expression, env)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestResource(t *testing.T) {
+	t.Parallel()
+
+	env := celenv.Resource()
+
+	for _, test := range []struct {
+		name       string
+		expression string
+	}{
+		{
+			name:       "by spec",
+			expression: `spec.operationalState == "down" && spec.type != "loopback"`,
+		},
+		{
+			name:       "by metadata",
+			expression: `metadata.id.startsWith("eth") && "talos.dev/managed" in metadata.labels`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := cel.ParseBooleanExpression(test.expression, env)
+			require.NoError(t, err)
+		})
+	}
+}
@@ -39,17 +39,18 @@ type Client struct {
 	options *Options
 	conn    *grpcConnectionWrapper
 
-	MachineClient        machineapi.MachineServiceClient
-	TimeClient           timeapi.TimeServiceClient
-	ClusterClient        clusterapi.ClusterServiceClient
-	StorageClient        storageapi.StorageServiceClient
-	MachineStorageClient machineapi.StorageServiceClient
-	LVMClient            machineapi.LVMServiceClient
-	MDClient             machineapi.MDServiceClient
-	InspectClient        inspectapi.InspectServiceClient
-	ImageClient          machineapi.ImageServiceClient
-	DebugClient          machineapi.DebugServiceClient
-	LifecycleClient      machineapi.LifecycleServiceClient
+	MachineClient            machineapi.MachineServiceClient
+	TimeClient               timeapi.TimeServiceClient
+	ClusterClient            clusterapi.ClusterServiceClient
+	StorageClient            storageapi.StorageServiceClient
+	MachineStorageClient     machineapi.StorageServiceClient
+	LVMClient                machineapi.LVMServiceClient
+	MDClient                 machineapi.MDServiceClient
+	InspectClient            inspectapi.InspectServiceClient
+	ImageClient              machineapi.ImageServiceClient
+	DebugClient              machineapi.DebugServiceClient
+	LifecycleClient          machineapi.LifecycleServiceClient
+	NetworkDiagnosticsClient machineapi.NetworkDiagnosticsServiceClient
 
 	COSI state.State
 
@@ -181,6 +182,7 @@ func New(_ context.Context, opts ...OptionFunc) (c *Client, err error) {
 	c.ImageClient = machineapi.NewImageServiceClient(c.conn)
 	c.DebugClient = machineapi.NewDebugServiceClient(c.conn)
 	c.LifecycleClient = machineapi.NewLifecycleServiceClient(c.conn)
+	c.NetworkDiagnosticsClient = machineapi.NewNetworkDiagnosticsServiceClient(c.conn)
 
 	c.Inspect = &InspectClient{c.InspectClient}
 	c.COSI = state.WrapCore(client.NewAdapter(cosiv1alpha1.NewStateClient(c.conn)))
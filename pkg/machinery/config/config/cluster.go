@@ -32,11 +32,29 @@ type DiscoveryIdentityConfig interface {
 	ClusterSecret() string
 }
 
+// EtcdMaintenanceConfig defines the automatic etcd maintenance policy.
+type EtcdMaintenanceConfig interface {
+	// DefragmentationEnabled reports whether automatic defragmentation is enabled.
+	DefragmentationEnabled() bool
+	// DefragmentationThreshold is the fraction of the unused database space which triggers defragmentation.
+	DefragmentationThreshold() float64
+	// QuotaWarningThreshold is the fraction of the database quota which raises a warning.
+	QuotaWarningThreshold() float64
+	// CheckInterval is the interval between etcd database checks.
+	CheckInterval() time.Duration
+	// InMaintenanceWindow reports whether the maintenance is allowed at the given time.
+	InMaintenanceWindow(t time.Time) bool
+}
+
 // Etcd defines the requirements for a config that pertains to etcd related
 // options.
 type Etcd interface {
 	Image() string
 	CA() *x509.PEMEncodedCertificateAndKey
+	// AcceptedCAs returns the list of CA certificates that etcd trusts.
+	//
+	// If the CA is not nil, the returned list will include the CA certificate as the first element.
+	AcceptedCAs() []*x509.PEMEncodedCertificate
 	ExtraArgs() map[string][]string
 	AdvertisedSubnets() []string
 	ListenSubnets() []string
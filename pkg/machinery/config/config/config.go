@@ -35,10 +35,13 @@ type Config interface { //nolint:interfacebloat
 	NetworkBlackholeRouteConfigs() []NetworkBlackholeRouteConfig
 	NetworkRoutingRuleConfigs() []NetworkRoutingRuleConfig
 	NetworkBGPInstanceConfigs() []NetworkBGPInstanceConfig
+	NetworkDot1XConfigs() []NetworkDot1XConfig
 
 	// - cluster
 	DiscoveryServiceConfigs() []DiscoveryServiceConfig
 	DiscoveryIdentityConfig() DiscoveryIdentityConfig
+	EmbeddedDiscoveryConfig() EmbeddedDiscoveryConfig
+	EtcdMaintenanceConfig() EtcdMaintenanceConfig
 
 	// - k8s:
 	K8sAPIServerCAConfig() K8sAPIServerCAConfig
@@ -48,6 +51,7 @@ type Config interface { //nolint:interfacebloat
 	K8sAuthenticationConfig() K8sAuthenticationConfig
 	K8sAuthorizerConfigs() []K8sAuthorizerConfig
 	K8sEtcdEncryptionConfig() K8sEtcdEncryptionConfig
+	K8sKMSConfig() K8sKMSConfig
 	K8sAPIServerConfig() K8sAPIServerConfig
 	K8sControllerManagerConfig() K8sControllerManagerConfig
 	K8sSchedulerConfig() K8sSchedulerConfig
@@ -99,11 +103,15 @@ type Config interface { //nolint:interfacebloat
 	UdevRulesConfig() UdevConfig
 	TrustedRoots() TrustedRootsConfig
 	PCIDriverRebindConfig() PCIDriverRebindConfig
+	SRIOVConfigs() []SRIOVConfig
 	OOMConfig() OOMConfig
 	ImageVerificationConfig() ImageVerificationConfig
+	NodeAttestationConfig() NodeAttestationConfig
 	SysctlConfig() map[string]string
 	SysfsConfig() map[string]string
 	KernelModuleConfigs() []KernelModuleConfig
 	UnattendedInstallConfig() UnattendedInstallConfig
 	SecurityProfileConfig() SecurityProfileConfig
+	ConfigSourceConfig() ConfigSourceConfig
+	MaintenancePolicyConfig() MaintenancePolicyConfig
 }
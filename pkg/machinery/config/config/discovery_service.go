@@ -14,3 +14,15 @@ type DiscoveryServiceConfig interface {
 	// Endpoint returns the endpoint of the discovery service.
 	Endpoint() *url.URL
 }
+
+// EmbeddedDiscoveryConfig defines the configuration for the embedded discovery service
+// run by the control plane nodes.
+type EmbeddedDiscoveryConfig interface {
+	// Port returns the port of the embedded discovery service.
+	Port() int
+
+	// Endpoints returns the addresses of the control plane nodes running the embedded discovery service.
+	//
+	// If empty, the host of the cluster control plane endpoint should be used.
+	Endpoints() []string
+}
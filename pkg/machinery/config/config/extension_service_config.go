@@ -4,11 +4,15 @@
 
 package config
 
+import "github.com/siderolabs/gen/optional"
+
 // ExtensionServiceConfig is a config for extension services.
 type ExtensionServiceConfig interface {
 	Name() string
 	ConfigFiles() []ExtensionServiceConfigFile
 	Environment() []string
+	Resources() ExtensionServiceResourcesConfig
+	Security() ExtensionServiceSecurityConfig
 }
 
 // ExtensionServiceConfigFile is a config file for extension services.
@@ -16,3 +20,22 @@ type ExtensionServiceConfigFile interface {
 	Content() string
 	MountPath() string
 }
+
+// ExtensionServiceResourcesConfig overrides the resource limits of the extension service.
+//
+// Zero values keep the limits defined by the extension.
+type ExtensionServiceResourcesConfig interface {
+	CPUWeight() uint64
+	CPUMaxMillicores() uint64
+	MemoryMax() uint64
+	MemoryHigh() uint64
+	IOWeight() uint64
+	OOMScoreAdj() optional.Optional[int]
+}
+
+// ExtensionServiceSecurityConfig tightens the sandbox of the extension service.
+type ExtensionServiceSecurityConfig interface {
+	DropCapabilities() []string
+	SeccompProfile() string
+	ReadonlyRootfs() bool
+}
@@ -7,6 +7,7 @@ package config
 import (
 	"net/netip"
 	"net/url"
+	"time"
 
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/siderolabs/crypto/x509"
@@ -97,6 +98,20 @@ type K8sEtcdEncryptionConfig interface {
 	EtcdEncryptionConfig() map[string]any
 }
 
+// K8sKMSConfig defines the configuration of the Talos-managed KMS plugin for kube-apiserver.
+type K8sKMSConfig interface {
+	// KMSEndpoint returns the KMS endpoint protecting the node key (if set).
+	KMSEndpoint() string
+	// TPMEnabled returns true if the node key is protected by the TPM.
+	TPMEnabled() bool
+	// TPMPCRs returns the list of PCRs the node key is sealed to.
+	TPMPCRs() []int
+	// KeyRotationInterval returns the interval after which a new key encryption key is generated.
+	KeyRotationInterval() time.Duration
+	// KeyRetention returns the period the key encryption key is kept for after it is superseded (zero means forever).
+	KeyRetention() time.Duration
+}
+
 // K8sNetworkConfig defines Kubernetes network configuration options.
 type K8sNetworkConfig interface {
 	PodCIDRs() []netip.Prefix
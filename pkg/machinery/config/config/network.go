@@ -8,6 +8,7 @@ import (
 	"net/netip"
 	"time"
 
+	"github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/gen/optional"
 
 	"github.com/siderolabs/talos/pkg/machinery/cel"
@@ -232,6 +233,21 @@ type NetworkDHCPv6Config interface {
 	IgnoreHostname() optional.Optional[bool]
 	ClientIdentifier() nethelpers.ClientIdentifier
 	DUIDRaw() optional.Optional[nethelpers.HardwareAddr]
+	PrefixDelegation() optional.Optional[NetworkDHCPv6PrefixDelegationConfig]
+}
+
+// NetworkDHCPv6PrefixDelegationConfig defines DHCPv6 prefix delegation (IA_PD) settings.
+type NetworkDHCPv6PrefixDelegationConfig interface {
+	PrefixLength() optional.Optional[uint8]
+	Downstream() []NetworkDHCPv6PrefixDelegationDownstreamConfig
+}
+
+// NetworkDHCPv6PrefixDelegationDownstreamConfig defines a subnet of the delegated prefix assigned to a downstream link.
+type NetworkDHCPv6PrefixDelegationDownstreamConfig interface {
+	Link() string
+	SubnetID() uint32
+	SubnetLength() uint8
+	RouterAdvertisements() bool
 }
 
 // NetworkVirtualIPConfig defines a common virtual IP configuration.
@@ -244,10 +260,17 @@ type NetworkVirtualIPConfig interface {
 }
 
 // NetworkLayer2VIPConfig defines a Layer 2 VIP configuration.
-//
-//nolint:iface
 type NetworkLayer2VIPConfig interface {
 	NetworkVirtualIPConfig
+	VRRP() optional.Optional[NetworkVRRPConfig]
+}
+
+// NetworkVRRPConfig defines VRRP election settings of the Layer 2 VIP.
+type NetworkVRRPConfig interface {
+	VirtualRouterID() uint8
+	Priority() uint8
+	Preempt() bool
+	AdvertisementInterval() time.Duration
 }
 
 // NetworkHCloudVIPConfig defines a Hetzner Cloud VIP configuration.
@@ -489,3 +512,27 @@ type NetworkBGPBFD interface {
 	// DetectMultiplier is the BFD detection multiplier; zero means use the implementation default.
 	DetectMultiplier() uint8
 }
+
+// NetworkDot1XConfig defines IEEE 802.1X port authentication of a link.
+type NetworkDot1XConfig interface {
+	NamedDocument
+	Identity() string
+	AnonymousIdentity() string
+	CA() []byte
+	ServerName() string
+	TLS() optional.Optional[NetworkDot1XTLSConfig]
+	PEAP() optional.Optional[NetworkDot1XPEAPConfig]
+}
+
+// NetworkDot1XTLSConfig defines EAP-TLS settings.
+//
+// Exactly one of ClientIdentity and ClientIssuer is set.
+type NetworkDot1XTLSConfig interface {
+	ClientIdentity() *x509.PEMEncodedCertificateAndKey
+	ClientIssuer() *x509.PEMEncodedCertificateAndKey
+}
+
+// NetworkDot1XPEAPConfig defines PEAP (EAP-MSCHAPv2) settings.
+type NetworkDot1XPEAPConfig interface {
+	Password() string
+}
@@ -95,6 +95,43 @@ type WatchdogTimerConfig interface {
 	Timeout() time.Duration
 }
 
+// ConfigSourceApplyMode describes how the machine configuration pulled from the remote source is applied.
+type ConfigSourceApplyMode string
+
+// ConfigSourceApplyMode values.
+const (
+	ConfigSourceApplyModeNoReboot ConfigSourceApplyMode = "no-reboot"
+	ConfigSourceApplyModeReboot   ConfigSourceApplyMode = "reboot"
+	ConfigSourceApplyModeStaged   ConfigSourceApplyMode = "staged"
+)
+
+// ConfigSourceConfig defines the interface to access the remote machine configuration source.
+type ConfigSourceConfig interface {
+	URL() *url.URL
+	SignatureURL() *url.URL
+	Interval() time.Duration
+	ApplyMode() ConfigSourceApplyMode
+	TrustedKeys() []string
+}
+
+// MaintenancePolicyConfig defines the interface to access the disruptive operations policy.
+type MaintenancePolicyConfig interface {
+	// InMaintenanceWindow reports whether the disruptive operations are allowed at the given time.
+	InMaintenanceWindow(t time.Time) bool
+	// NextMaintenanceWindow returns the start of the next maintenance window after the given time.
+	//
+	// Zero time is returned if there are no windows configured.
+	NextMaintenanceWindow(t time.Time) time.Time
+	// MaxNodes returns the number of nodes in the cluster which might be disrupted at the same time.
+	MaxNodes() int
+	// MaxControlPlaneNodes returns the number of control plane nodes which might be disrupted at the same time.
+	MaxControlPlaneNodes() int
+	// LabelLimits returns the number of nodes with the same label value which might be disrupted at the same time.
+	LabelLimits() map[string]int
+	// LockTTL returns the time the lock slot is held after the disruptive operation starts, unless released.
+	LockTTL() time.Duration
+}
+
 // SecurityProfileConfig defines the interface to access Talos node security profile configuration.
 type SecurityProfileConfig interface {
 	SecurityProfileConfigSignal()
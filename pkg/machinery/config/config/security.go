@@ -4,6 +4,8 @@
 
 package config
 
+import "fmt"
+
 // TrustedRootsConfig defines the interface to access trusted roots configuration.
 type TrustedRootsConfig interface {
 	ExtraTrustedRootCertificates() []string
@@ -57,3 +59,37 @@ type ImagePublicKeyVerifier interface {
 	// Certificate returns a public certificate in PEM format accepted for image signature verification.
 	Certificate() string
 }
+
+// NodeAttestationMode is the mode of node attestation.
+type NodeAttestationMode string
+
+// NodeAttestationMode values.
+const (
+	NodeAttestationModeDisabled NodeAttestationMode = "disabled"
+	NodeAttestationModeOptional NodeAttestationMode = "optional"
+	NodeAttestationModeRequired NodeAttestationMode = "required"
+)
+
+// ParseNodeAttestationMode parses the node attestation mode from a string.
+func ParseNodeAttestationMode(s string) (NodeAttestationMode, error) {
+	switch mode := NodeAttestationMode(s); mode {
+	case NodeAttestationModeDisabled, NodeAttestationModeOptional, NodeAttestationModeRequired:
+		return mode, nil
+	default:
+		return NodeAttestationModeDisabled, fmt.Errorf("invalid node attestation mode %q", s)
+	}
+}
+
+// NodeAttestationConfig defines the interface to access TPM-based node attestation configuration.
+type NodeAttestationConfig interface {
+	// Mode returns the node attestation mode.
+	Mode() NodeAttestationMode
+	// PCRs returns the list of PCRs to be quoted.
+	PCRs() []int
+	// AllowedPCRs returns the allowed values (hex-encoded) for each PCR.
+	AllowedPCRs() map[int][]string
+	// PCRSigningKeys returns the PEM-encoded public keys accepted for signed PCR policies.
+	PCRSigningKeys() []string
+	// EndorsementCAs returns the PEM-encoded CA certificates to verify EK certificates.
+	EndorsementCAs() []string
+}
@@ -0,0 +1,33 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"github.com/siderolabs/gen/optional"
+
+	"github.com/siderolabs/talos/pkg/machinery/cel"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// SRIOVConfig defines the interface to access SR-IOV configuration of physical functions.
+type SRIOVConfig interface {
+	NamedDocument
+	// PhysicalFunctionLink is the link name (or alias) of the physical function.
+	PhysicalFunctionLink() optional.Optional[string]
+	// PhysicalFunctionSelector selects the physical functions by PCI device.
+	PhysicalFunctionSelector() optional.Optional[cel.Expression]
+	NumVFs() int
+	VFDriver() string
+	VFs() []SRIOVVirtualFunctionConfig
+}
+
+// SRIOVVirtualFunctionConfig defines the interface to access SR-IOV virtual function configuration.
+type SRIOVVirtualFunctionConfig interface {
+	Index() int
+	HardwareAddress() optional.Optional[nethelpers.HardwareAddr]
+	VLANID() uint16
+	Trust() bool
+	SpoofCheck() bool
+}
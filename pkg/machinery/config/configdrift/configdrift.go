@@ -0,0 +1,351 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package configdrift detects the configuration drift between the machine configs of the nodes.
+//
+// The configs are normalized first: the fields which are expected to differ between the nodes
+// (e.g. hostname or static addresses) are removed using the ignore list and an optional CEL expression,
+// and the secrets are redacted, so that they never show up in the report.
+package configdrift
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"go.yaml.in/yaml/v4"
+
+	"github.com/siderolabs/talos/pkg/machinery/cel"
+	"github.com/siderolabs/talos/pkg/machinery/cel/celenv"
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/documentid"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/textdiff"
+)
+
+// DefaultIgnore is the list of the fields which are expected to differ between the nodes.
+var DefaultIgnore = []string{
+	"v1alpha1:machine.network.hostname",
+	"v1alpha1:machine.network.interfaces.*.addresses",
+	"HostnameConfig",
+	"LinkConfig/*:addresses",
+}
+
+// Normalizer removes the ignored fields from the machine configs.
+type Normalizer struct {
+	filter *cel.Expression
+	ignore []ignorePattern
+}
+
+// ignorePattern is parsed from '<document>[:<path>]'.
+//
+// The document is a glob matched against the document ID, path is the dotted field path where '*' matches any single element.
+// The pattern matches the field with all its children, or the whole document if the path is empty.
+type ignorePattern struct {
+	doc  string
+	path []string
+}
+
+// match checks the document and the field path of the same length as the pattern path.
+func (p ignorePattern) match(doc string, fieldPath []string) bool {
+	if ok, _ := path.Match(p.doc, doc); !ok { //nolint:errcheck
+		return false
+	}
+
+	for i, elem := range p.path {
+		if elem != "*" && elem != fieldPath[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// NewNormalizer creates a new Normalizer.
+//
+// Each ignore pattern is '<document>[:<path>]', e.g. 'v1alpha1:machine.network.hostname' or 'LinkConfig/*:addresses'.
+// The filter is an optional CEL expression (see celenv.MachineConfig), the fields it matches are ignored as well.
+func NewNormalizer(ignore []string, filter string) (*Normalizer, error) {
+	n := &Normalizer{}
+
+	for _, pattern := range ignore {
+		doc, fieldPath, _ := strings.Cut(pattern, ":")
+
+		if _, err := path.Match(doc, ""); doc == "" || err != nil {
+			return nil, fmt.Errorf("invalid ignore pattern %q", pattern)
+		}
+
+		p := ignorePattern{doc: doc}
+
+		if fieldPath != "" {
+			p.path = strings.Split(fieldPath, ".")
+		}
+
+		n.ignore = append(n.ignore, p)
+	}
+
+	if filter != "" {
+		expr, err := cel.ParseBooleanExpression(filter, celenv.MachineConfig())
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter expression: %w", err)
+		}
+
+		n.filter = &expr
+	}
+
+	return n, nil
+}
+
+// Config is a normalized machine config.
+type Config struct {
+	// fields is the flattened config: field ID -> value.
+	fields map[string]string
+
+	// MachineType is the machine type of the config, init is reported as controlplane.
+	MachineType string
+	// YAML is the normalized config, with the documents sorted by ID.
+	YAML string
+}
+
+// Normalize redacts the secrets and removes the ignored fields from the config.
+func (n *Normalizer) Normalize(cfg config.Provider) (*Config, error) {
+	cfg = cfg.RedactSecrets(constants.Redacted)
+
+	normalized := &Config{
+		MachineType: "unknown",
+		fields:      map[string]string{},
+	}
+
+	if cfg.Machine() != nil {
+		normalized.MachineType = cfg.Machine().Type().String()
+
+		if cfg.Machine().Type().IsControlPlane() {
+			normalized.MachineType = "controlplane"
+		}
+	}
+
+	type document struct {
+		node *yaml.Node
+		id   string
+	}
+
+	documents := make([]document, 0, len(cfg.Documents()))
+
+	for _, doc := range cfg.Documents() {
+		dc := documentContext{
+			id:          documentid.Extract(doc).String(),
+			machineType: normalized.MachineType,
+		}
+
+		if n.ignored(dc, nil, nil) {
+			continue
+		}
+
+		out, err := encoder.NewEncoder(doc, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+		if err != nil {
+			return nil, fmt.Errorf("error encoding %s: %w", dc.id, err)
+		}
+
+		var node yaml.Node
+
+		if err = yaml.Unmarshal(out, &node); err != nil {
+			return nil, fmt.Errorf("error decoding %s: %w", dc.id, err)
+		}
+
+		root := &node
+		if root.Kind == yaml.DocumentNode && len(root.Content) == 1 {
+			root = root.Content[0]
+		}
+
+		if err = root.Decode(&dc.spec); err != nil {
+			return nil, fmt.Errorf("error decoding %s: %w", dc.id, err)
+		}
+
+		if err = n.normalize(dc, nil, root, normalized.fields); err != nil {
+			return nil, err
+		}
+
+		documents = append(documents, document{id: dc.id, node: root})
+	}
+
+	slices.SortStableFunc(documents, func(a, b document) int { return cmp.Compare(a.id, b.id) })
+
+	var buf bytes.Buffer
+
+	for i, doc := range documents {
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+
+		enc := yaml.NewEncoder(&buf)
+		enc.SetIndent(4)
+
+		if err := enc.Encode(doc.node); err != nil {
+			return nil, err
+		}
+
+		if err := enc.Close(); err != nil {
+			return nil, err
+		}
+	}
+
+	normalized.YAML = buf.String()
+
+	return normalized, nil
+}
+
+// documentContext describes the document being normalized.
+type documentContext struct {
+	spec        map[string]any
+	id          string
+	machineType string
+}
+
+// normalize removes the ignored children of the node, and records the remaining leaf fields.
+func (n *Normalizer) normalize(doc documentContext, fieldPath []string, node *yaml.Node, fields map[string]string) error {
+	switch node.Kind { //nolint:exhaustive
+	case yaml.MappingNode:
+		content := make([]*yaml.Node, 0, len(node.Content))
+
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			childPath := append(slices.Clone(fieldPath), key.Value)
+
+			if n.ignored(doc, childPath, value) {
+				continue
+			}
+
+			if err := n.normalize(doc, childPath, value, fields); err != nil {
+				return err
+			}
+
+			content = append(content, key, value)
+		}
+
+		node.Content = content
+
+		if len(content) == 0 {
+			fields[fieldID(doc.id, fieldPath)] = "{}"
+		}
+	case yaml.SequenceNode:
+		content := make([]*yaml.Node, 0, len(node.Content))
+
+		for i, value := range node.Content {
+			childPath := append(slices.Clone(fieldPath), strconv.Itoa(i))
+
+			if n.ignored(doc, childPath, value) {
+				continue
+			}
+
+			if err := n.normalize(doc, childPath, value, fields); err != nil {
+				return err
+			}
+
+			content = append(content, value)
+		}
+
+		node.Content = content
+
+		if len(content) == 0 {
+			fields[fieldID(doc.id, fieldPath)] = "[]"
+		}
+	default:
+		fields[fieldID(doc.id, fieldPath)] = node.Value
+	}
+
+	return nil
+}
+
+// ignored checks whether the field (or the whole document if the path is empty) is ignored.
+func (n *Normalizer) ignored(doc documentContext, fieldPath []string, value *yaml.Node) bool {
+	// the fields are checked top-down and the ignored subtrees are dropped, so only the exact depth is matched
+	for _, p := range n.ignore {
+		if len(p.path) == len(fieldPath) && p.match(doc.id, fieldPath) {
+			return true
+		}
+	}
+
+	if n.filter == nil || len(fieldPath) == 0 {
+		return false
+	}
+
+	var v any
+
+	if value != nil {
+		if err := value.Decode(&v); err != nil {
+			return false
+		}
+	}
+
+	ignore, err := n.filter.EvalBool(celenv.MachineConfig(), map[string]any{
+		"doc":         doc.id,
+		"machineType": doc.machineType,
+		"spec":        doc.spec,
+		"path":        strings.Join(fieldPath, "."),
+		"value":       v,
+	})
+
+	return err == nil && ignore
+}
+
+func fieldID(doc string, fieldPath []string) string {
+	if len(fieldPath) == 0 {
+		return doc
+	}
+
+	return doc + ":" + strings.Join(fieldPath, ".")
+}
+
+// Drift is the difference between the config of the node and the reference config.
+type Drift struct {
+	// Node is the node name.
+	Node string `json:"node"`
+	// Against is the reference the node config is compared to: another node or the desired config.
+	Against string `json:"against"`
+	// Fields are the IDs of the changed fields: '<document>:<path>'.
+	Fields []string `json:"fields"`
+	// Diff is the unified diff from the reference config to the node config.
+	Diff string `json:"diff"`
+}
+
+// Compare compares the config of the node against the reference config.
+//
+// If there's no drift, the returned Drift has no fields.
+func Compare(node string, actual *Config, against string, reference *Config) (Drift, error) {
+	drift := Drift{
+		Node:    node,
+		Against: against,
+	}
+
+	for _, id := range slices.Sorted(maps.Keys(actual.fields)) {
+		if v, ok := reference.fields[id]; !ok || v != actual.fields[id] {
+			drift.Fields = append(drift.Fields, id)
+		}
+	}
+
+	for _, id := range slices.Sorted(maps.Keys(reference.fields)) {
+		if _, ok := actual.fields[id]; !ok {
+			drift.Fields = append(drift.Fields, id)
+		}
+	}
+
+	slices.Sort(drift.Fields)
+
+	var err error
+
+	drift.Diff, err = textdiff.DiffWithCustomPaths(reference.YAML, actual.YAML, against, node)
+
+	return drift, err
+}
+
+// Drifted returns true if the node config is different from the reference config.
+func (drift Drift) Drifted() bool {
+	return len(drift.Fields) > 0
+}
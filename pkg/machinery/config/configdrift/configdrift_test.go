@@ -0,0 +1,132 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package configdrift_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configdrift"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+)
+
+const configTemplate = `version: v1alpha1
+machine:
+    type: %s
+    token: abcdef.0123456789abcdef
+    network:
+        hostname: %s
+    sysctls:
+%s
+cluster:
+    controlPlane:
+        endpoint: https://10.5.0.1:6443
+---
+apiVersion: v1alpha1
+kind: LinkConfig
+name: eth0
+up: true
+mtu: 1500
+addresses:
+    - address: %s/24
+`
+
+func normalize(t *testing.T, normalizer *configdrift.Normalizer, machineType, hostname, sysctls, address string) *configdrift.Config {
+	t.Helper()
+
+	cfg, err := configloader.NewFromBytes(fmt.Appendf(nil, configTemplate, machineType, hostname, sysctls, address))
+	require.NoError(t, err)
+
+	normalized, err := normalizer.Normalize(cfg)
+	require.NoError(t, err)
+
+	return normalized
+}
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	normalizer, err := configdrift.NewNormalizer(configdrift.DefaultIgnore, "")
+	require.NoError(t, err)
+
+	a := normalize(t, normalizer, "worker", "worker-1", "        net.core.somaxconn: \"65535\"", "10.5.0.3")
+	b := normalize(t, normalizer, "worker", "worker-2", "        net.core.somaxconn: \"65535\"", "10.5.0.4")
+
+	assert.Equal(t, "worker", a.MachineType)
+	assert.Equal(t, a.YAML, b.YAML)
+	assert.NotContains(t, a.YAML, "hostname")
+	assert.NotContains(t, a.YAML, "10.5.0.3")
+	assert.Contains(t, a.YAML, "mtu: 1500")
+	assert.NotContains(t, a.YAML, "abcdef.0123456789abcdef")
+
+	drift, err := configdrift.Compare("worker-2", b, "worker-1", a)
+	require.NoError(t, err)
+	assert.False(t, drift.Drifted())
+
+	cp := normalize(t, normalizer, "init", "cp-1", "        net.core.somaxconn: \"65535\"", "10.5.0.2")
+	assert.Equal(t, "controlplane", cp.MachineType)
+}
+
+func TestNormalizeFilter(t *testing.T) {
+	t.Parallel()
+
+	normalizer, err := configdrift.NewNormalizer(nil, `path.startsWith("machine.sysctls.") || (glob("LinkConfig/*", doc) && path == "addresses")`)
+	require.NoError(t, err)
+
+	a := normalize(t, normalizer, "worker", "worker-1", "        net.core.somaxconn: \"65535\"", "10.5.0.3")
+	b := normalize(t, normalizer, "worker", "worker-1", "        vm.max_map_count: \"262144\"", "10.5.0.4")
+
+	assert.Equal(t, a.YAML, b.YAML)
+	assert.Contains(t, a.YAML, "hostname: worker-1")
+
+	_, err = configdrift.NewNormalizer(nil, `path + 1`)
+	require.Error(t, err)
+
+	_, err = configdrift.NewNormalizer([]string{"[:machine"}, "")
+	require.Error(t, err)
+}
+
+func TestGroupOutliers(t *testing.T) {
+	t.Parallel()
+
+	normalizer, err := configdrift.NewNormalizer(configdrift.DefaultIgnore, "")
+	require.NoError(t, err)
+
+	sysctls := "        net.core.somaxconn: \"65535\""
+
+	nodes := []configdrift.Node{
+		{Name: "worker-3", Config: normalize(t, normalizer, "worker", "worker-3", sysctls+"\n        vm.max_map_count: \"262144\"", "10.5.0.5")},
+		{Name: "worker-1", Config: normalize(t, normalizer, "worker", "worker-1", sysctls, "10.5.0.3")},
+		{Name: "worker-2", Config: normalize(t, normalizer, "worker", "worker-2", sysctls, "10.5.0.4")},
+		{Name: "cp-1", Config: normalize(t, normalizer, "controlplane", "cp-1", sysctls, "10.5.0.2")},
+	}
+
+	groups, err := configdrift.GroupOutliers(nodes)
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+
+	assert.Equal(t, "controlplane", groups[0].MachineType)
+	assert.Equal(t, []string{"cp-1"}, groups[0].Nodes)
+	assert.Empty(t, groups[0].Outliers)
+
+	assert.Equal(t, "worker", groups[1].MachineType)
+	assert.Equal(t, []string{"worker-1", "worker-2", "worker-3"}, groups[1].Nodes)
+	assert.Equal(t, "worker-1", groups[1].Reference)
+	require.Len(t, groups[1].Outliers, 1)
+
+	outlier := groups[1].Outliers[0]
+
+	assert.Equal(t, "worker-3", outlier.Node)
+	assert.Equal(t, "worker-1", outlier.Against)
+	assert.Equal(t, []string{"v1alpha1:machine.sysctls.vm.max_map_count"}, outlier.Fields)
+	assert.Contains(t, outlier.Diff, "--- worker-1\n+++ worker-3\n")
+	assert.Contains(t, outlier.Diff, "+        vm.max_map_count: \"262144\"")
+
+	report := configdrift.Report{Groups: groups}
+	assert.True(t, report.Drifted())
+}
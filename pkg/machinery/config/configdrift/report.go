@@ -0,0 +1,103 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package configdrift
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Node is the normalized config of a node.
+type Node struct {
+	Config *Config
+	Name   string
+}
+
+// Group is the set of nodes with the same machine type.
+type Group struct {
+	// MachineType is the machine type of the nodes.
+	MachineType string `json:"machineType"`
+	// Nodes are all nodes of the group.
+	Nodes []string `json:"nodes"`
+	// Reference is the node with the most common config in the group.
+	Reference string `json:"reference"`
+	// Outliers are the nodes with the config different from the reference.
+	Outliers []Drift `json:"outliers"`
+}
+
+// Report is the configuration drift report.
+type Report struct {
+	// Groups of the nodes by machine type.
+	Groups []Group `json:"groups"`
+	// Desired is the drift of the node configs from the desired configs.
+	Desired []Drift `json:"desired,omitempty"`
+}
+
+// Drifted returns true if any drift was found.
+func (r *Report) Drifted() bool {
+	for _, group := range r.Groups {
+		if len(group.Outliers) > 0 {
+			return true
+		}
+	}
+
+	return len(r.Desired) > 0
+}
+
+// GroupOutliers groups the nodes by machine type, and finds the outliers in each group.
+//
+// The most common config in the group is used as the reference, on a tie the config of the first node (by name) wins.
+func GroupOutliers(nodes []Node) ([]Group, error) {
+	nodes = slices.SortedFunc(slices.Values(nodes), func(a, b Node) int { return cmp.Compare(a.Name, b.Name) })
+
+	byType := map[string][]Node{}
+
+	for _, node := range nodes {
+		byType[node.Config.MachineType] = append(byType[node.Config.MachineType], node)
+	}
+
+	groups := make([]Group, 0, len(byType))
+
+	for machineType, members := range byType {
+		counts := map[string]int{}
+
+		for _, node := range members {
+			counts[node.Config.YAML]++
+		}
+
+		reference := members[0]
+
+		for _, node := range members[1:] {
+			if counts[node.Config.YAML] > counts[reference.Config.YAML] {
+				reference = node
+			}
+		}
+
+		group := Group{
+			MachineType: machineType,
+			Reference:   reference.Name,
+			Outliers:    []Drift{},
+		}
+
+		for _, node := range members {
+			group.Nodes = append(group.Nodes, node.Name)
+
+			drift, err := Compare(node.Name, node.Config, reference.Name, reference.Config)
+			if err != nil {
+				return nil, err
+			}
+
+			if drift.Drifted() {
+				group.Outliers = append(group.Outliers, drift)
+			}
+		}
+
+		groups = append(groups, group)
+	}
+
+	slices.SortFunc(groups, func(a, b Group) int { return cmp.Compare(a.MachineType, b.MachineType) })
+
+	return groups, nil
+}
@@ -0,0 +1,320 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package configlint checks the machine configs against the best practices.
+//
+// Unlike the validation, the linter reports the configuration which is valid, but most likely a mistake,
+// e.g. a VIP on a single control plane node, or KubeSpan without the discovery service.
+// The rules are either built in (see BuiltinRules), or written by the user in CEL (see LoadRules).
+package configlint
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+
+	"go.yaml.in/yaml/v4"
+
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/documentid"
+)
+
+// Severity of the finding.
+type Severity int
+
+// Severity values.
+const (
+	SeverityInfo Severity = iota
+	SeverityWarning
+	SeverityError
+)
+
+var severityNames = []string{"info", "warning", "error"}
+
+// ParseSeverity parses the severity name.
+func ParseSeverity(s string) (Severity, error) {
+	idx := slices.Index(severityNames, s)
+	if idx < 0 {
+		return 0, fmt.Errorf("unknown severity %q, expected one of %s", s, strings.Join(severityNames, ", "))
+	}
+
+	return Severity(idx), nil
+}
+
+// String implements fmt.Stringer.
+func (s Severity) String() string {
+	if s < 0 || int(s) >= len(severityNames) {
+		return fmt.Sprintf("Severity(%d)", int(s))
+	}
+
+	return severityNames[s]
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Severity) UnmarshalText(text []byte) error {
+	var err error
+
+	*s, err = ParseSeverity(string(text))
+
+	return err
+}
+
+// IgnoreAnnotation is the comment which suppresses the rules for the document it is placed in:
+//
+//	# talos:lint-ignore <rule-id>[,<rule-id>...]
+const IgnoreAnnotation = "talos:lint-ignore"
+
+var ignoreAnnotationRe = regexp.MustCompile(`^#\s*` + regexp.QuoteMeta(IgnoreAnnotation) + `\s+(.+)$`)
+
+// Config is a machine config to lint.
+type Config struct {
+	// Provider is the parsed machine config.
+	Provider config.Provider
+	// Path is the config file path (or the node name), used in the findings.
+	Path string
+
+	// ignored is the list of the suppressed rules by the document ID.
+	ignored map[string][]string
+	// lines is the line of the document start by the document ID.
+	lines map[string]int
+}
+
+// NewConfig parses the machine config, and the suppression annotations in the config comments.
+func NewConfig(path string, data []byte) (*Config, error) {
+	provider, err := configloader.NewFromBytes(data)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &Config{
+		Provider: provider,
+		Path:     path,
+		ignored:  map[string][]string{},
+		lines:    map[string]int{},
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+
+	for {
+		var node yaml.Node
+
+		if err = dec.Decode(&node); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return nil, err
+		}
+
+		if len(node.Content) != 1 || node.Content[0].Kind != yaml.MappingNode {
+			continue
+		}
+
+		id := yamlDocumentID(node.Content[0])
+		cfg.lines[id] = node.Content[0].Line
+
+		walkComments(&node, func(comment string) {
+			for line := range strings.Lines(comment) {
+				if m := ignoreAnnotationRe.FindStringSubmatch(strings.TrimSpace(line)); m != nil {
+					for rule := range strings.SplitSeq(m[1], ",") {
+						cfg.ignored[id] = append(cfg.ignored[id], strings.TrimSpace(rule))
+					}
+				}
+			}
+		})
+	}
+
+	return cfg, nil
+}
+
+// Ignored returns true if the rule is suppressed for the document.
+//
+// If the document is empty, the rule is suppressed if any document of the config suppresses it.
+func (cfg *Config) Ignored(rule, document string) bool {
+	if document != "" {
+		return slices.Contains(cfg.ignored[document], rule)
+	}
+
+	for _, rules := range cfg.ignored {
+		if slices.Contains(rules, rule) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func yamlDocumentID(node *yaml.Node) string {
+	var kind, name string
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		switch node.Content[i].Value {
+		case documentid.ManifestVersionKey:
+			return "v1alpha1"
+		case documentid.ManifestKindKey:
+			kind = node.Content[i+1].Value
+		case documentid.ManifestNameKey:
+			name = node.Content[i+1].Value
+		}
+	}
+
+	if name != "" {
+		return kind + "/" + name
+	}
+
+	return kind
+}
+
+func walkComments(node *yaml.Node, f func(string)) {
+	for _, comment := range []string{node.HeadComment, node.LineComment, node.FootComment} {
+		if comment != "" {
+			f(comment)
+		}
+	}
+
+	for _, child := range node.Content {
+		walkComments(child, f)
+	}
+}
+
+// Issue is reported by the rule check.
+type Issue struct {
+	// Config is the config with the issue.
+	Config *Config
+	// Document is the ID of the document with the issue, empty if the issue is not specific to a document.
+	Document string
+	// Message describes the issue.
+	Message string
+}
+
+// Rule is a lint rule.
+type Rule struct {
+	// Check returns the issues found in the configs.
+	Check func(configs []*Config) []Issue
+
+	// ID is the unique rule ID, used in the findings and annotations.
+	ID string
+	// Description is a short description of the rule.
+	Description string
+	// Severity is the severity of the findings of the rule.
+	Severity Severity
+	// Cluster rules check the configs of all nodes of the cluster together, so they only run when the configs are linted as a cluster.
+	Cluster bool
+	// OptIn rules are only run when explicitly enabled.
+	OptIn bool
+}
+
+// Finding is a single lint result.
+type Finding struct {
+	// Rule is the ID of the rule.
+	Rule string `json:"rule"`
+	// Severity of the finding.
+	Severity Severity `json:"severity"`
+	// File is the path of the config.
+	File string `json:"file"`
+	// Document is the ID of the document, empty if the finding is not specific to a document.
+	Document string `json:"document,omitempty"`
+	// Line is the line of the document start (1-based), zero if unknown.
+	Line int `json:"line,omitempty"`
+	// Message describes the finding.
+	Message string `json:"message"`
+}
+
+// Options for Lint.
+type Options struct {
+	// Cluster enables the rules which check the configs of all nodes together.
+	Cluster bool
+	// MinSeverity is the minimum severity of the reported findings.
+	MinSeverity Severity
+	// Disabled is the list of the rules which are not run.
+	Disabled []string
+	// Enabled is the list of the opt-in rules which are run.
+	Enabled []string
+}
+
+// Option is a Lint option.
+type Option func(opts *Options)
+
+// WithCluster lints the configs as the configs of all nodes of a single cluster.
+func WithCluster() Option {
+	return func(opts *Options) {
+		opts.Cluster = true
+	}
+}
+
+// WithMinSeverity skips the findings with the severity lower than specified.
+func WithMinSeverity(severity Severity) Option {
+	return func(opts *Options) {
+		opts.MinSeverity = severity
+	}
+}
+
+// WithDisabled disables the rules.
+func WithDisabled(rules ...string) Option {
+	return func(opts *Options) {
+		opts.Disabled = append(opts.Disabled, rules...)
+	}
+}
+
+// WithEnabled enables the opt-in rules.
+func WithEnabled(rules ...string) Option {
+	return func(opts *Options) {
+		opts.Enabled = append(opts.Enabled, rules...)
+	}
+}
+
+// Lint runs the rules against the configs.
+//
+// The findings are sorted by file, line and rule.
+func Lint(configs []*Config, rules []Rule, opts ...Option) []Finding {
+	var options Options
+
+	for _, o := range opts {
+		o(&options)
+	}
+
+	findings := []Finding{}
+
+	for _, rule := range rules {
+		if rule.Severity < options.MinSeverity || slices.Contains(options.Disabled, rule.ID) || (rule.Cluster && !options.Cluster) {
+			continue
+		}
+
+		if rule.OptIn && !slices.Contains(options.Enabled, rule.ID) {
+			continue
+		}
+
+		for _, issue := range rule.Check(configs) {
+			if issue.Config.Ignored(rule.ID, issue.Document) {
+				continue
+			}
+
+			findings = append(findings, Finding{
+				Rule:     rule.ID,
+				Severity: rule.Severity,
+				File:     issue.Config.Path,
+				Document: issue.Document,
+				Line:     issue.Config.lines[issue.Document],
+				Message:  issue.Message,
+			})
+		}
+	}
+
+	slices.SortStableFunc(findings, func(a, b Finding) int {
+		return cmp.Or(cmp.Compare(a.File, b.File), cmp.Compare(a.Line, b.Line), cmp.Compare(a.Rule, b.Rule))
+	})
+
+	return findings
+}
@@ -0,0 +1,268 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package configlint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/siderolabs/gen/xslices"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configlint"
+)
+
+const controlPlaneConfig = `version: v1alpha1
+machine:
+    type: controlplane
+    token: abcdef.0123456789abcdef
+    install:
+        disk: /dev/sda # talos:lint-ignore install-disk-selector
+    network:
+        interfaces:
+            - interface: eth0
+              vip:
+                ip: 172.20.0.10
+        kubespan:
+            enabled: true
+    registries:
+        mirrors:
+            '*':
+                endpoints:
+                    - https://registry.local
+                skipFallback: true
+            docker.io:
+                endpoints:
+                    - https://registry.local
+cluster:
+    id: cluster-id
+    secret: cluster-secret
+    token: 123456.0123456789abcdef
+    controlPlane:
+        endpoint: https://172.20.0.10:6443
+    clusterName: test
+    etcd:
+        advertisedSubnets:
+            - 203.0.113.0/24
+            - 10.0.0.0/8
+`
+
+const workerConfig = `version: v1alpha1
+machine:
+    type: worker
+    token: abcdef.0123456789abcdef
+    install:
+        disk: /dev/sda
+cluster:
+    id: cluster-id
+    secret: cluster-secret
+    token: %s
+    controlPlane:
+        endpoint: https://172.20.0.10:6443
+    clusterName: test
+---
+apiVersion: v1alpha1
+kind: HostnameConfig
+hostname: worker
+auto: off
+`
+
+func newConfig(t *testing.T, path, data string) *configlint.Config {
+	t.Helper()
+
+	cfg, err := configlint.NewConfig(path, []byte(data))
+	require.NoError(t, err)
+
+	return cfg
+}
+
+func ruleIDs(findings []configlint.Finding) []string {
+	return xslices.Map(findings, func(f configlint.Finding) string { return f.File + ":" + f.Rule })
+}
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	cp := newConfig(t, "cp.yaml", controlPlaneConfig)
+
+	findings := configlint.Lint([]*configlint.Config{cp}, configlint.BuiltinRules())
+
+	assert.Equal(t, []string{
+		"cp.yaml:etcd-public-subnet",
+		"cp.yaml:kubespan-discovery",
+		"cp.yaml:registry-mirror-skip-fallback",
+	}, ruleIDs(findings))
+
+	assert.Equal(t, configlint.Finding{
+		Rule:     "etcd-public-subnet",
+		Severity: configlint.SeverityWarning,
+		File:     "cp.yaml",
+		Document: "v1alpha1",
+		Line:     1,
+		Message:  "etcd advertised subnet 203.0.113.0/24 is public",
+	}, findings[0])
+
+	assert.Equal(t, `registry mirror for "docker.io" falls back to the upstream registry`, findings[2].Message)
+
+	findings = configlint.Lint([]*configlint.Config{cp}, configlint.BuiltinRules(),
+		configlint.WithCluster(),
+		configlint.WithMinSeverity(configlint.SeverityError),
+		configlint.WithDisabled("kubespan-discovery"),
+	)
+
+	assert.Empty(t, findings)
+
+	findings = configlint.Lint([]*configlint.Config{cp}, configlint.BuiltinRules(), configlint.WithCluster(), configlint.WithDisabled("kubespan-discovery"))
+
+	assert.Equal(t, []string{
+		"cp.yaml:etcd-public-subnet",
+		"cp.yaml:registry-mirror-skip-fallback",
+		"cp.yaml:single-controlplane-vip",
+	}, ruleIDs(findings))
+}
+
+func TestLintOptIn(t *testing.T) {
+	t.Parallel()
+
+	worker := newConfig(t, "worker.yaml", strings.Replace(workerConfig, "%s", "123456.0123456789abcdef", 1))
+
+	assert.Empty(t, configlint.Lint([]*configlint.Config{worker}, configlint.BuiltinRules()))
+
+	findings := configlint.Lint([]*configlint.Config{worker}, configlint.BuiltinRules(), configlint.WithEnabled("install-disk-selector"))
+
+	assert.Equal(t, []string{"worker.yaml:install-disk-selector"}, ruleIDs(findings))
+	assert.Equal(t, "install disk is set to /dev/sda without the install disk selector", findings[0].Message)
+}
+
+func TestLintCluster(t *testing.T) {
+	t.Parallel()
+
+	configs := []*configlint.Config{
+		newConfig(t, "cp.yaml", controlPlaneConfig),
+		newConfig(t, "worker-1.yaml", strings.Replace(workerConfig, "%s", "123456.0123456789abcdef", 1)),
+		newConfig(t, "worker-2.yaml", strings.Replace(workerConfig, "%s", "654321.0123456789abcdef", 1)),
+	}
+
+	findings := configlint.Lint(configs, configlint.BuiltinRules(),
+		configlint.WithCluster(),
+		configlint.WithMinSeverity(configlint.SeverityError),
+		configlint.WithDisabled("kubespan-discovery"),
+	)
+
+	assert.Equal(t, []string{
+		"worker-1.yaml:duplicate-hostname",
+		"worker-2.yaml:cluster-mismatch",
+		"worker-2.yaml:duplicate-hostname",
+	}, ruleIDs(findings))
+
+	assert.Equal(t, "HostnameConfig", findings[0].Document)
+	assert.Equal(t, 15, findings[0].Line)
+	assert.Equal(t, "join token differs from cp.yaml", findings[1].Message)
+}
+
+func TestUserRules(t *testing.T) {
+	t.Parallel()
+
+	rules, err := configlint.LoadRules(strings.NewReader(`rules:
+  - id: worker-hostname
+    description: Worker hostnames should be set by DHCP.
+    severity: error
+    match: machineType == "worker" && doc == "HostnameConfig" && has(spec.hostname)
+    message: static hostname on a worker
+  - id: invalid-conversion
+    match: doc == "v1alpha1" && spec.machine.type == "worker" && int(spec.machine.token) > 0
+    message: never reported
+  - id: missing-field
+    match: spec.machine.sysctls.foo == "bar"
+    message: never reported
+`), configlint.BuiltinRules())
+	require.NoError(t, err)
+	require.Len(t, rules, 3)
+
+	configs := []*configlint.Config{
+		newConfig(t, "cp.yaml", controlPlaneConfig),
+		newConfig(t, "worker.yaml", strings.Replace(workerConfig, "%s", "123456.0123456789abcdef", 1)+"# talos:lint-ignore other-rule\n"),
+	}
+
+	findings := configlint.Lint(configs, rules)
+
+	// evaluation errors other than missing fields are reported
+	assert.Equal(t, []configlint.Finding{
+		{
+			Rule:     "invalid-conversion",
+			Severity: configlint.SeverityWarning,
+			File:     "worker.yaml",
+			Document: "v1alpha1",
+			Line:     1,
+			Message:  "failed to evaluate the rule: type conversion error from 'string' to 'int'",
+		},
+		{
+			Rule:     "worker-hostname",
+			Severity: configlint.SeverityError,
+			File:     "worker.yaml",
+			Document: "HostnameConfig",
+			Line:     15,
+			Message:  "static hostname on a worker",
+		},
+	}, findings)
+
+	_, err = configlint.LoadRules(strings.NewReader("rules:\n  - id: broken\n    match: spec +\n    message: broken\n"), nil)
+	require.Error(t, err)
+
+	_, err = configlint.LoadRules(strings.NewReader("rules:\n  - id: broken\n    severity: fatal\n    match: 'true'\n    message: broken\n"), nil)
+	require.Error(t, err)
+
+	_, err = configlint.LoadRules(strings.NewReader("rules:\n  - id: worker-hostname\n    match: 'true'\n    message: duplicate\n"), rules)
+	require.ErrorContains(t, err, `duplicate rule ID "worker-hostname"`)
+}
+
+func TestSARIF(t *testing.T) {
+	t.Parallel()
+
+	rules := configlint.BuiltinRules()
+	findings := configlint.Lint([]*configlint.Config{newConfig(t, "cp.yaml", controlPlaneConfig)}, rules)
+
+	var buf bytes.Buffer
+
+	require.NoError(t, configlint.WriteSARIF(&buf, "v1.15.0", rules, findings))
+
+	var log struct {
+		Version string `json:"version"`
+		Runs    []struct {
+			Tool struct {
+				Driver struct {
+					Rules []struct {
+						ID string `json:"id"`
+					} `json:"rules"`
+				} `json:"driver"`
+			} `json:"tool"`
+			Results []struct {
+				RuleID    string `json:"ruleId"`
+				Level     string `json:"level"`
+				Locations []struct {
+					PhysicalLocation struct {
+						ArtifactLocation struct {
+							URI string `json:"uri"`
+						} `json:"artifactLocation"`
+					} `json:"physicalLocation"`
+				} `json:"locations"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &log))
+
+	assert.Equal(t, "2.1.0", log.Version)
+	require.Len(t, log.Runs, 1)
+	assert.Len(t, log.Runs[0].Tool.Driver.Rules, len(rules))
+	require.Len(t, log.Runs[0].Results, len(findings))
+	assert.Equal(t, "etcd-public-subnet", log.Runs[0].Results[0].RuleID)
+	assert.Equal(t, "warning", log.Runs[0].Results[0].Level)
+	assert.Equal(t, "cp.yaml", log.Runs[0].Results[0].Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	assert.Equal(t, "error", log.Runs[0].Results[1].Level)
+}
@@ -0,0 +1,321 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package configlint
+
+import (
+	"fmt"
+	"maps"
+	"net/netip"
+	"slices"
+	"strings"
+
+	configconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/documentid"
+)
+
+// BuiltinRules returns the built-in lint rules.
+func BuiltinRules() []Rule {
+	return []Rule{
+		{
+			ID:          "single-controlplane-vip",
+			Description: "A VIP on a single control plane node doesn't add any availability, but delays the API server access on the node reboot.",
+			Severity:    SeverityWarning,
+			Cluster:     true,
+			Check:       checkSingleControlPlaneVIP,
+		},
+		{
+			ID:          "etcd-public-subnet",
+			Description: "etcd should not be advertised on a public subnet.",
+			Severity:    SeverityWarning,
+			Check:       checkEtcdPublicSubnet,
+		},
+		{
+			ID:          "install-disk-selector",
+			Description: "The install disk should be selected with the install disk selector, as the device paths are not stable on the hardware with several disks.",
+			Severity:    SeverityInfo,
+			OptIn:       true,
+			Check:       checkInstallDiskSelector,
+		},
+		{
+			ID:          "kubespan-discovery",
+			Description: "KubeSpan requires the cluster discovery to find the peers.",
+			Severity:    SeverityError,
+			Check:       checkKubeSpanDiscovery,
+		},
+		{
+			ID:          "registry-mirror-skip-fallback",
+			Description: "In the air-gapped mode (all registries are mirrored), the registry mirrors should skip the fallback to the upstream registry.",
+			Severity:    SeverityWarning,
+			Check:       checkRegistryMirrorSkipFallback,
+		},
+		{
+			ID:          "cluster-mismatch",
+			Description: "All nodes of the cluster should have the same cluster name, endpoint, identity and join token.",
+			Severity:    SeverityError,
+			Cluster:     true,
+			Check:       checkClusterMismatch,
+		},
+		{
+			ID:          "duplicate-hostname",
+			Description: "The static hostnames of the cluster nodes should be unique.",
+			Severity:    SeverityError,
+			Cluster:     true,
+			Check:       checkDuplicateHostname,
+		},
+	}
+}
+
+// documentOf returns the ID of the document implementing the config interface, the legacy v1alpha1 document parts are reported as v1alpha1.
+func documentOf(v any) string {
+	if doc, ok := v.(configconfig.Document); ok {
+		return documentid.Extract(doc).String()
+	}
+
+	return "v1alpha1"
+}
+
+func isControlPlane(cfg *Config) bool {
+	return cfg.Provider.Machine() != nil && cfg.Provider.Machine().Type().IsControlPlane()
+}
+
+func checkSingleControlPlaneVIP(configs []*Config) []Issue {
+	controlPlanes := slices.DeleteFunc(slices.Clone(configs), func(cfg *Config) bool { return !isControlPlane(cfg) })
+	if len(controlPlanes) != 1 {
+		return nil
+	}
+
+	cfg := controlPlanes[0]
+
+	for _, device := range cfg.Provider.Machine().Network().Devices() {
+		if device.VIPConfig() != nil && device.VIPConfig().IP() != "" {
+			return []Issue{{
+				Config:   cfg,
+				Document: "v1alpha1",
+				Message:  fmt.Sprintf("VIP %s is configured on the only control plane node", device.VIPConfig().IP()),
+			}}
+		}
+	}
+
+	if vips := cfg.Provider.NetworkVirtualIPConfigs(); len(vips) > 0 {
+		return []Issue{{
+			Config:   cfg,
+			Document: documentOf(vips[0]),
+			Message:  fmt.Sprintf("VIP %s is configured on the only control plane node", vips[0].VIP()),
+		}}
+	}
+
+	return nil
+}
+
+func checkEtcdPublicSubnet(configs []*Config) []Issue {
+	var issues []Issue
+
+	for _, cfg := range configs {
+		if !isControlPlane(cfg) || cfg.Provider.Cluster() == nil {
+			continue
+		}
+
+		for _, subnet := range cfg.Provider.Cluster().Etcd().AdvertisedSubnets() {
+			if strings.HasPrefix(subnet, "!") {
+				continue
+			}
+
+			prefix, err := netip.ParsePrefix(subnet)
+			if err != nil {
+				addr, err := netip.ParseAddr(subnet)
+				if err != nil {
+					continue
+				}
+
+				prefix = netip.PrefixFrom(addr, addr.BitLen())
+			}
+
+			addr := prefix.Masked().Addr()
+
+			if addr.IsPrivate() || addr.IsLoopback() || addr.IsLinkLocalUnicast() || (prefix.Bits() > 0 && addr.IsUnspecified()) {
+				continue
+			}
+
+			issues = append(issues, Issue{
+				Config:   cfg,
+				Document: "v1alpha1",
+				Message:  fmt.Sprintf("etcd advertised subnet %s is public", subnet),
+			})
+		}
+	}
+
+	return issues
+}
+
+func checkInstallDiskSelector(configs []*Config) []Issue {
+	var issues []Issue
+
+	for _, cfg := range configs {
+		if cfg.Provider.Machine() == nil {
+			continue
+		}
+
+		install := cfg.Provider.Machine().Install()
+
+		if expr, _ := install.DiskMatchExpression(); install.Disk() == "" || expr != nil { //nolint:errcheck
+			continue
+		}
+
+		issues = append(issues, Issue{
+			Config:   cfg,
+			Document: "v1alpha1",
+			Message:  fmt.Sprintf("install disk is set to %s without the install disk selector", install.Disk()),
+		})
+	}
+
+	return issues
+}
+
+func checkKubeSpanDiscovery(configs []*Config) []Issue {
+	var issues []Issue
+
+	for _, cfg := range configs {
+		kubespan := cfg.Provider.NetworkKubeSpanConfig()
+		if kubespan == nil || !kubespan.Enabled() {
+			continue
+		}
+
+		if len(cfg.Provider.DiscoveryServiceConfigs()) > 0 {
+			continue
+		}
+
+		if cluster := cfg.Provider.Cluster(); cluster != nil && cluster.Discovery().Enabled() && cluster.Discovery().Registries().Kubernetes().Enabled() {
+			continue
+		}
+
+		issues = append(issues, Issue{
+			Config:   cfg,
+			Document: documentOf(kubespan),
+			Message:  "KubeSpan is enabled, but the cluster discovery is disabled",
+		})
+	}
+
+	return issues
+}
+
+func checkRegistryMirrorSkipFallback(configs []*Config) []Issue {
+	var issues []Issue
+
+	for _, cfg := range configs {
+		mirrors := cfg.Provider.RegistryMirrorConfigs()
+
+		// the catch-all mirror means that all images are pulled through the mirrors
+		if _, airGapped := mirrors["*"]; !airGapped {
+			continue
+		}
+
+		for _, registry := range slices.Sorted(maps.Keys(mirrors)) {
+			if mirrors[registry].SkipFallback() {
+				continue
+			}
+
+			issues = append(issues, Issue{
+				Config:   cfg,
+				Document: documentOf(mirrors[registry]),
+				Message:  fmt.Sprintf("registry mirror for %q falls back to the upstream registry", registry),
+			})
+		}
+	}
+
+	return issues
+}
+
+// clusterSettings returns the settings which should be the same across the cluster nodes.
+func clusterSettings(cfg *Config) map[string]string {
+	settings := map[string]string{}
+
+	if cluster := cfg.Provider.K8sClusterConfig(); cluster != nil {
+		settings["cluster name"] = cluster.ClusterName()
+
+		if cluster.ClusterEndpoint() != nil {
+			settings["cluster endpoint"] = cluster.ClusterEndpoint().String()
+		}
+	}
+
+	if identity := cfg.Provider.DiscoveryIdentityConfig(); identity != nil {
+		settings["cluster ID"] = identity.ClusterID()
+		settings["cluster secret"] = identity.ClusterSecret()
+	}
+
+	if cluster := cfg.Provider.Cluster(); cluster != nil && cluster.Token().ID() != "" {
+		settings["join token"] = cluster.Token().ID() + "." + cluster.Token().Secret()
+	}
+
+	return settings
+}
+
+func checkClusterMismatch(configs []*Config) []Issue {
+	var (
+		issues    []Issue
+		reference *Config
+		expected  map[string]string
+	)
+
+	for _, cfg := range configs {
+		settings := clusterSettings(cfg)
+		if len(settings) == 0 {
+			continue
+		}
+
+		if reference == nil {
+			reference, expected = cfg, settings
+
+			continue
+		}
+
+		for _, setting := range slices.Sorted(maps.Keys(settings)) {
+			if want, ok := expected[setting]; ok && want != settings[setting] {
+				issues = append(issues, Issue{
+					Config:   cfg,
+					Document: "v1alpha1",
+					Message:  fmt.Sprintf("%s differs from %s", setting, reference.Path),
+				})
+			}
+		}
+	}
+
+	return issues
+}
+
+func checkDuplicateHostname(configs []*Config) []Issue {
+	type hostname struct {
+		cfg      *Config
+		document string
+	}
+
+	byHostname := map[string][]hostname{}
+
+	for _, cfg := range configs {
+		hostnameConfig := cfg.Provider.NetworkHostnameConfig()
+		if hostnameConfig == nil || hostnameConfig.Hostname() == "" {
+			continue
+		}
+
+		byHostname[hostnameConfig.Hostname()] = append(byHostname[hostnameConfig.Hostname()], hostname{cfg: cfg, document: documentOf(hostnameConfig)})
+	}
+
+	var issues []Issue
+
+	for _, name := range slices.Sorted(maps.Keys(byHostname)) {
+		if len(byHostname[name]) < 2 {
+			continue
+		}
+
+		for _, h := range byHostname[name] {
+			issues = append(issues, Issue{
+				Config:   h.cfg,
+				Document: h.document,
+				Message:  fmt.Sprintf("hostname %q is used by %d nodes", name, len(byHostname[name])),
+			})
+		}
+	}
+
+	return issues
+}
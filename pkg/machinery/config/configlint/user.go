@@ -0,0 +1,169 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package configlint
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"go.yaml.in/yaml/v4"
+
+	"github.com/siderolabs/talos/pkg/machinery/cel"
+	"github.com/siderolabs/talos/pkg/machinery/cel/celenv"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/documentid"
+)
+
+// userRules is the user rules file.
+//
+//	rules:
+//	  - id: no-debug
+//	    description: Debug mode should be disabled in production.
+//	    severity: warning
+//	    match: doc == "v1alpha1" && has(spec.debug) && spec.debug
+//	    message: debug mode is enabled
+type userRules struct {
+	Rules []struct {
+		ID          string `yaml:"id"`
+		Description string `yaml:"description"`
+		Severity    string `yaml:"severity"`
+		Match       string `yaml:"match"`
+		Message     string `yaml:"message"`
+	} `yaml:"rules"`
+}
+
+// LoadRules loads the user rules written in CEL.
+//
+// The match expression is evaluated for each document of each config (see celenv.MachineConfig),
+// the documents which match are reported with the rule message.
+// The expressions which fail to evaluate on a document because of a missing field don't match,
+// other evaluation errors are reported as the findings of the rule.
+// The severity defaults to warning, and the description defaults to the message.
+//
+// The rule IDs should be unique, and should not clash with the existing rules (e.g. the builtin rules).
+func LoadRules(r io.Reader, existing []Rule) ([]Rule, error) {
+	var file userRules
+
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+
+	if err := dec.Decode(&file); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("error decoding rules: %w", err)
+	}
+
+	rules := make([]Rule, 0, len(file.Rules))
+
+	for _, spec := range file.Rules {
+		if spec.ID == "" || spec.Match == "" || spec.Message == "" {
+			return nil, errors.New("rule id, match and message are required")
+		}
+
+		if slices.ContainsFunc(existing, func(rule Rule) bool { return rule.ID == spec.ID }) ||
+			slices.ContainsFunc(rules, func(rule Rule) bool { return rule.ID == spec.ID }) {
+			return nil, fmt.Errorf("duplicate rule ID %q", spec.ID)
+		}
+
+		severity := SeverityWarning
+
+		if spec.Severity != "" {
+			var err error
+
+			if severity, err = ParseSeverity(spec.Severity); err != nil {
+				return nil, fmt.Errorf("rule %q: %w", spec.ID, err)
+			}
+		}
+
+		expr, err := cel.ParseBooleanExpression(spec.Match, celenv.MachineConfig())
+		if err != nil {
+			return nil, fmt.Errorf("rule %q: %w", spec.ID, err)
+		}
+
+		if spec.Description == "" {
+			spec.Description = spec.Message
+		}
+
+		rules = append(rules, Rule{
+			ID:          spec.ID,
+			Description: spec.Description,
+			Severity:    severity,
+			Check:       matchDocuments(expr, spec.Message),
+		})
+	}
+
+	return rules, nil
+}
+
+func matchDocuments(expr cel.Expression, message string) func([]*Config) []Issue {
+	return func(configs []*Config) []Issue {
+		var issues []Issue
+
+		for _, cfg := range configs {
+			machineType := "unknown"
+
+			if cfg.Provider.Machine() != nil {
+				machineType = cfg.Provider.Machine().Type().String()
+
+				if cfg.Provider.Machine().Type().IsControlPlane() {
+					machineType = "controlplane"
+				}
+			}
+
+			for _, doc := range cfg.Provider.Documents() {
+				out, err := encoder.NewEncoder(doc, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+				if err != nil {
+					continue
+				}
+
+				var spec map[string]any
+
+				if err = yaml.Unmarshal(out, &spec); err != nil {
+					continue
+				}
+
+				id := documentid.Extract(doc).String()
+
+				matched, err := expr.EvalBool(celenv.MachineConfig(), map[string]any{
+					"doc":         id,
+					"machineType": machineType,
+					"spec":        spec,
+					"path":        "",
+					"value":       spec,
+				})
+
+				switch {
+				case err != nil && isMissingFieldError(err):
+				case err != nil:
+					issues = append(issues, Issue{
+						Config:   cfg,
+						Document: id,
+						Message:  fmt.Sprintf("failed to evaluate the rule: %s", err),
+					})
+				case matched:
+					issues = append(issues, Issue{
+						Config:   cfg,
+						Document: id,
+						Message:  message,
+					})
+				}
+			}
+		}
+
+		return issues
+	}
+}
+
+// isMissingFieldError checks whether the CEL evaluation error is caused by a field missing in the document.
+//
+// The CEL runtime doesn't export the resolution errors, so they are matched by the message.
+func isMissingFieldError(err error) bool {
+	msg := err.Error()
+
+	return strings.HasPrefix(msg, "no such key") ||
+		strings.HasPrefix(msg, "no such attribute") ||
+		strings.HasPrefix(msg, "index out of bounds")
+}
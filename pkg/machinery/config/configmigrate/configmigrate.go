@@ -0,0 +1,231 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package configmigrate converts the deprecated v1alpha1 config sections into the equivalent config documents.
+//
+// Each migrated section is verified by comparing the effective configuration (as seen by Talos
+// through the config interfaces) before and after the migration.
+package configmigrate
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"go.yaml.in/yaml/v4"
+
+	coreconfig "github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+// Result of the migration.
+type Result struct {
+	// Config is the migrated config.
+	Config coreconfig.Provider
+	// Sections are the legacy sections found in the config.
+	Sections []Section
+}
+
+// Migrated returns true if any section was migrated.
+func (r *Result) Migrated() bool {
+	for _, section := range r.Sections {
+		if section.Skipped == "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Verify returns an error if any migrated section changes the effective configuration.
+func (r *Result) Verify() error {
+	var errs []error
+
+	for _, section := range r.Sections {
+		if !section.Identical() {
+			errs = append(errs, fmt.Errorf("%s: effective configuration changed", section.Path))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Section describes the migration of a legacy v1alpha1 section.
+type Section struct {
+	// Path of the legacy section, e.g. 'machine.network.nameservers'.
+	Path string
+	// Documents replacing the section, e.g. 'ResolverConfig' or 'StaticHostConfig/10.5.0.2'.
+	Documents []string
+	// Skipped is the reason the section was kept as is.
+	Skipped string
+	// Before and After are the effective configuration covered by the section in YAML.
+	Before, After string
+}
+
+// Identical returns true if the effective configuration covered by the section is the same after the migration.
+func (s Section) Identical() bool {
+	return s.Before == s.After
+}
+
+// change is a section along with the function returning the effective configuration it covers.
+type change struct {
+	Section
+
+	effective func(config.Config) any
+}
+
+// state is the config being migrated.
+type state struct {
+	legacy    *v1alpha1.Config
+	documents []config.Document
+}
+
+// has returns true if the config already has a document of the given kind.
+func (s *state) has(kind string) bool {
+	for _, doc := range s.documents {
+		if doc.Kind() == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasNamed returns true if the config already has a named document of the given kind.
+func (s *state) hasNamed(kind, name string) bool {
+	for _, doc := range s.documents {
+		if named, ok := doc.(config.NamedDocument); ok && doc.Kind() == kind && named.Name() == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// runDefaultDHCPOperators mirrors the container logic: default DHCP stops once any link or DHCP document is present.
+func (s *state) runDefaultDHCPOperators() bool {
+	for _, doc := range s.documents {
+		switch doc.(type) {
+		case config.NetworkCommonLinkConfig, config.NetworkDHCPConfig:
+			return false
+		}
+	}
+
+	return true
+}
+
+// add appends the document and returns its name for the report.
+func (s *state) add(doc config.Document) string {
+	s.documents = append(s.documents, doc)
+
+	if named, ok := doc.(config.NamedDocument); ok {
+		return doc.Kind() + "/" + named.Name()
+	}
+
+	return doc.Kind()
+}
+
+// migration converts a legacy section into the documents, and returns the changes made.
+type migration func(s *state) ([]change, error)
+
+var migrations = []migration{
+	migrateHostname,
+	migrateResolvers,
+	migrateStaticHosts,
+	migrateTimeSync,
+	migrateKubeSpan,
+	migrateLinks,
+	migrateRegistries,
+	migrateKubelet,
+}
+
+// Migrate converts the deprecated v1alpha1 sections of the config into the equivalent documents.
+//
+// Sections which can't be converted without changing the effective configuration are kept as is,
+// and reported as skipped.
+func Migrate(cfg coreconfig.Provider) (*Result, error) {
+	if cfg.RawV1Alpha1() == nil {
+		return &Result{Config: cfg}, nil
+	}
+
+	s := &state{
+		legacy: cfg.RawV1Alpha1().DeepCopy(),
+	}
+
+	for _, doc := range cfg.Documents() {
+		if _, ok := doc.(*v1alpha1.Config); !ok {
+			s.documents = append(s.documents, doc.Clone())
+		}
+	}
+
+	var changes []change
+
+	for _, m := range migrations {
+		migrated, err := m(s)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, migrated...)
+	}
+
+	cleanupLegacy(s.legacy)
+
+	migrated, err := container.New(append([]config.Document{s.legacy}, s.documents...)...)
+	if err != nil {
+		return nil, fmt.Errorf("error building migrated config: %w", err)
+	}
+
+	result := &Result{
+		Config:   migrated,
+		Sections: make([]Section, 0, len(changes)),
+	}
+
+	for _, ch := range changes {
+		if ch.Before, err = marshalEffective(ch.effective(cfg)); err != nil {
+			return nil, err
+		}
+
+		if ch.After, err = marshalEffective(ch.effective(migrated)); err != nil {
+			return nil, err
+		}
+
+		result.Sections = append(result.Sections, ch.Section)
+	}
+
+	return result, nil
+}
+
+func marshalEffective(v any) (string, error) {
+	if v == nil || (reflect.ValueOf(v).Kind() == reflect.Map && reflect.ValueOf(v).Len() == 0) {
+		return "", nil
+	}
+
+	out, err := yaml.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling effective configuration: %w", err)
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
+
+// cleanupLegacy removes the sections left empty after the migration.
+//
+//nolint:staticcheck // migrating deprecated fields
+func cleanupLegacy(legacy *v1alpha1.Config) {
+	if legacy.MachineConfig == nil {
+		return
+	}
+
+	if legacy.MachineConfig.MachineNetwork != nil && reflect.ValueOf(*legacy.MachineConfig.MachineNetwork).IsZero() {
+		legacy.MachineConfig.MachineNetwork = nil
+	}
+
+	if legacy.MachineConfig.MachineFeatures != nil && reflect.ValueOf(*legacy.MachineConfig.MachineFeatures).IsZero() {
+		legacy.MachineConfig.MachineFeatures = nil
+	}
+}
@@ -0,0 +1,168 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package configmigrate_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/configmigrate"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+)
+
+//go:embed testdata/legacy.yaml
+var legacyConfig []byte
+
+func TestMigrate(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := configloader.NewFromBytes(legacyConfig)
+	require.NoError(t, err)
+
+	result, err := configmigrate.Migrate(cfg)
+	require.NoError(t, err)
+
+	assert.True(t, result.Migrated())
+	require.NoError(t, result.Verify())
+
+	type section struct {
+		path      string
+		documents []string
+		skipped   string
+	}
+
+	var sections []section
+
+	for _, s := range result.Sections {
+		assert.True(t, s.Identical(), "section %s:\nbefore:\n%s\nafter:\n%s", s.Path, s.Before, s.After)
+
+		sections = append(sections, section{path: s.Path, documents: s.Documents, skipped: s.Skipped})
+	}
+
+	assert.Equal(t, []section{
+		{path: "machine.network.hostname, machine.features.stableHostname", documents: []string{"HostnameConfig"}},
+		{
+			path:      "machine.network.nameservers, machine.network.searchDomains, machine.features.hostDNS",
+			documents: []string{"ResolverConfig"},
+		},
+		{path: "machine.network.extraHostEntries", documents: []string{"StaticHostConfig/10.5.0.2", "StaticHostConfig/10.5.0.3"}},
+		{path: "machine.time", documents: []string{"TimeSyncConfig"}},
+		{path: "machine.network.kubespan", documents: []string{"KubeSpanConfig"}},
+		{path: "machine.network.interfaces[eth0]", documents: []string{"LinkConfig/eth0"}},
+		{path: "machine.network.interfaces[eth1]", skipped: "only the links with static addressing are migrated, found: dhcp"},
+		{path: "machine.registries.mirrors[docker.io]", documents: []string{"RegistryMirrorConfig/docker.io"}},
+		{
+			path:      "machine.registries.config[mirror.local:5000]",
+			documents: []string{"RegistryAuthConfig/mirror.local:5000", "RegistryTLSConfig/mirror.local:5000"},
+		},
+		{path: "machine.kubelet", documents: []string{"KubeletConfig"}},
+	}, sections)
+
+	// the migrated config should survive the round-trip
+	out, err := result.Config.EncodeBytes(encoder.WithComments(encoder.CommentsDisabled))
+	require.NoError(t, err)
+
+	reloaded, err := configloader.NewFromBytes(out)
+	require.NoError(t, err)
+
+	legacy := reloaded.RawV1Alpha1()
+	require.NotNil(t, legacy)
+
+	assert.Nil(t, legacy.MachineConfig.MachineKubelet)                      //nolint:staticcheck
+	assert.Nil(t, legacy.MachineConfig.MachineTime)                         //nolint:staticcheck
+	assert.Nil(t, legacy.MachineConfig.MachineFeatures)                     //nolint:staticcheck
+	assert.Empty(t, legacy.MachineConfig.MachineRegistries)                 //nolint:staticcheck
+	assert.Len(t, legacy.MachineConfig.MachineNetwork.NetworkInterfaces, 1) //nolint:staticcheck
+
+	// migrating again is a no-op
+	result, err = configmigrate.Migrate(reloaded)
+	require.NoError(t, err)
+
+	assert.False(t, result.Migrated())
+}
+
+func TestMigrateSkipped(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name     string
+		config   string
+		expected configmigrate.Section
+	}{
+		{
+			name: "default DHCP",
+			config: `version: v1alpha1
+machine:
+  type: worker
+  network:
+    interfaces:
+      - interface: eth0
+        addresses:
+          - 10.5.0.10/24
+`,
+			expected: configmigrate.Section{
+				Path:    "machine.network.interfaces[eth0]",
+				Skipped: "migrating would disable the default DHCP on the links which are not configured explicitly",
+			},
+		},
+		{
+			name: "kubelet node IP",
+			config: `version: v1alpha1
+machine:
+  type: worker
+  kubelet:
+    image: ghcr.io/siderolabs/kubelet:v1.34.0
+    nodeIP:
+      validSubnets:
+        - 10.0.0.0/8
+`,
+			expected: configmigrate.Section{
+				Path:    "machine.kubelet",
+				Skipped: "KubeletConfig doesn't support: nodeIP, disableManifestsDirectory: false",
+			},
+		},
+		{
+			name: "unrelated document",
+			config: `version: v1alpha1
+machine:
+  type: worker
+  time:
+    servers:
+      - time.cloudflare.com
+---
+apiVersion: v1alpha1
+kind: HostnameConfig
+hostname: worker-1
+`,
+			expected: configmigrate.Section{
+				Path:      "machine.time",
+				Documents: []string{"TimeSyncConfig"},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg, err := configloader.NewFromBytes([]byte(test.config))
+			require.NoError(t, err)
+
+			result, err := configmigrate.Migrate(cfg)
+			require.NoError(t, err)
+
+			require.Len(t, result.Sections, 1)
+
+			section := result.Sections[0]
+			assert.True(t, section.Identical())
+
+			section.Before, section.After = "", ""
+
+			assert.Equal(t, test.expected, section)
+		})
+	}
+}
@@ -0,0 +1,219 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package configmigrate
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/cri"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+)
+
+//nolint:gocyclo
+func migrateRegistries(s *state) ([]change, error) {
+	if s.legacy.MachineConfig == nil {
+		return nil, nil
+	}
+
+	registries := &s.legacy.MachineConfig.MachineRegistries
+
+	var changes []change
+
+	for _, name := range slices.Sorted(maps.Keys(registries.RegistryMirrors)) {
+		path := fmt.Sprintf("machine.registries.mirrors[%s]", name)
+		effective := func(cfg config.Config) any { return effectiveRegistryMirror(cfg, name) }
+
+		if s.hasNamed(cri.RegistryMirrorConfig, name) {
+			changes = append(changes, skipExisting(path, cri.RegistryMirrorConfig+"/"+name, effective)...)
+
+			continue
+		}
+
+		mirror := registries.RegistryMirrors[name]
+		doc := cri.NewRegistryMirrorConfigV1Alpha1(name)
+		doc.RegistrySkipFallback = mirror.MirrorSkipFallback
+
+		for _, endpoint := range mirror.MirrorEndpoints {
+			u, err := url.Parse(endpoint)
+			if err != nil {
+				return nil, fmt.Errorf("%s: invalid endpoint %q: %w", path, endpoint, err)
+			}
+
+			doc.RegistryEndpoints = append(doc.RegistryEndpoints, cri.RegistryEndpoint{
+				EndpointURL:          meta.URL{URL: u},
+				EndpointOverridePath: mirror.MirrorOverridePath,
+			})
+		}
+
+		delete(registries.RegistryMirrors, name)
+
+		changes = append(changes, change{
+			Section: Section{
+				Path:      path,
+				Documents: []string{s.add(doc)},
+			},
+			effective: effective,
+		})
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(registries.RegistryConfig)) {
+		path := fmt.Sprintf("machine.registries.config[%s]", name)
+		effective := func(cfg config.Config) any { return effectiveRegistryConfig(cfg, name) }
+
+		registry := registries.RegistryConfig[name]
+
+		switch {
+		case registry.RegistryAuth != nil && s.hasNamed(cri.RegistryAuthConfig, name):
+			changes = append(changes, skipExisting(path, cri.RegistryAuthConfig+"/"+name, effective)...)
+
+			continue
+		case registry.RegistryTLS != nil && s.hasNamed(cri.RegistryTLSConfig, name):
+			changes = append(changes, skipExisting(path, cri.RegistryTLSConfig+"/"+name, effective)...)
+
+			continue
+		}
+
+		var documents []string
+
+		if auth := registry.RegistryAuth; auth != nil {
+			doc := cri.NewRegistryAuthConfigV1Alpha1(name)
+			doc.RegistryUsername = auth.RegistryUsername
+			doc.RegistryPassword = auth.RegistryPassword
+			doc.RegistryAuth = auth.RegistryAuth
+			doc.RegistryIdentityToken = auth.RegistryIdentityToken
+
+			documents = append(documents, s.add(doc))
+		}
+
+		if tls := registry.RegistryTLS; tls != nil {
+			doc := cri.NewRegistryTLSConfigV1Alpha1(name)
+			doc.TLSCA = string(tls.TLSCA)
+			doc.TLSInsecureSkipVerify = tls.TLSInsecureSkipVerify
+
+			if identity := tls.TLSClientIdentity; identity != nil {
+				doc.TLSClientIdentity = &meta.CertificateAndKey{
+					Cert: string(identity.Crt),
+					Key:  string(identity.Key),
+				}
+			}
+
+			documents = append(documents, s.add(doc))
+		}
+
+		delete(registries.RegistryConfig, name)
+
+		changes = append(changes, change{
+			Section: Section{
+				Path:      path,
+				Documents: documents,
+			},
+			effective: effective,
+		})
+	}
+
+	if len(registries.RegistryMirrors) == 0 {
+		registries.RegistryMirrors = nil
+	}
+
+	if len(registries.RegistryConfig) == 0 {
+		registries.RegistryConfig = nil
+	}
+
+	return changes, nil
+}
+
+type effectiveRegistryEndpoint struct {
+	URL          string `yaml:"url"`
+	OverridePath bool   `yaml:"overridePath,omitempty"`
+}
+
+type effectiveRegistryMirrorConfig struct {
+	Endpoints    []effectiveRegistryEndpoint `yaml:"endpoints,omitempty"`
+	SkipFallback bool                        `yaml:"skipFallback,omitempty"`
+}
+
+func effectiveRegistryMirror(cfg config.Config, name string) any {
+	mirror, ok := cfg.RegistryMirrorConfigs()[name]
+	if !ok {
+		return nil
+	}
+
+	result := effectiveRegistryMirrorConfig{
+		SkipFallback: mirror.SkipFallback(),
+	}
+
+	for _, endpoint := range mirror.Endpoints() {
+		result.Endpoints = append(result.Endpoints, effectiveRegistryEndpoint{
+			URL:          endpoint.Endpoint(),
+			OverridePath: endpoint.OverridePath(),
+		})
+	}
+
+	return result
+}
+
+type effectiveRegistryAuth struct {
+	Username      string `yaml:"username,omitempty"`
+	Password      string `yaml:"password,omitempty"`
+	Auth          string `yaml:"auth,omitempty"`
+	IdentityToken string `yaml:"identityToken,omitempty"`
+}
+
+type effectiveRegistryTLS struct {
+	ClientCert         string `yaml:"clientCert,omitempty"`
+	ClientKey          string `yaml:"clientKey,omitempty"`
+	CA                 string `yaml:"ca,omitempty"`
+	InsecureSkipVerify bool   `yaml:"insecureSkipVerify,omitempty"`
+}
+
+type effectiveRegistryConfigSpec struct {
+	Auth *effectiveRegistryAuth `yaml:"auth,omitempty"`
+	TLS  *effectiveRegistryTLS  `yaml:"tls,omitempty"`
+}
+
+func effectiveRegistryConfig(cfg config.Config, name string) any {
+	var result effectiveRegistryConfigSpec
+
+	if auth, ok := cfg.RegistryAuthConfigs()[name]; ok {
+		result.Auth = &effectiveRegistryAuth{
+			Username:      auth.Username(),
+			Password:      fingerprint(auth.Password()),
+			Auth:          fingerprint(auth.Auth()),
+			IdentityToken: fingerprint(auth.IdentityToken()),
+		}
+	}
+
+	if tls, ok := cfg.RegistryTLSConfigs()[name]; ok {
+		result.TLS = &effectiveRegistryTLS{
+			CA:                 fingerprint(string(tls.CA())),
+			InsecureSkipVerify: tls.InsecureSkipVerify(),
+		}
+
+		if identity := tls.ClientIdentity(); identity != nil {
+			result.TLS.ClientCert = fingerprint(string(identity.Crt))
+			result.TLS.ClientKey = fingerprint(string(identity.Key))
+		}
+	}
+
+	if result.Auth == nil && result.TLS == nil {
+		return nil
+	}
+
+	return result
+}
+
+// fingerprint hides the secret values in the report, while still allowing to compare them.
+func fingerprint(value string) string {
+	if value == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(value)))[:len("sha256:")+16]
+}
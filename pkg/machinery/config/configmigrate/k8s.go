@@ -0,0 +1,118 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package configmigrate
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/siderolabs/go-pointer"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+)
+
+//nolint:staticcheck // migrating deprecated fields
+func migrateKubelet(s *state) ([]change, error) {
+	if s.legacy.MachineConfig == nil || s.legacy.MachineConfig.MachineKubelet == nil {
+		return nil, nil
+	}
+
+	const path = "machine.kubelet"
+
+	if s.has(k8s.KubeletConfig) {
+		return skipExisting(path, k8s.KubeletConfig, effectiveKubelet), nil
+	}
+
+	kubelet := s.legacy.MachineConfig.MachineKubelet
+
+	// KubeletConfig conflicts with any machine.kubelet section, so the section is migrated as a whole
+	var unsupported []string
+
+	for _, field := range []struct {
+		name string
+		set  bool
+	}{
+		{"extraMounts", len(kubelet.KubeletExtraMounts) > 0},
+		{"credentialProviderConfig", len(kubelet.KubeletCredentialProviderConfig.Object) > 0},
+		{"nodeIP", kubelet.KubeletNodeIP != nil},
+		{"registerWithFQDN", kubelet.KubeletRegisterWithFQDN != nil},
+		{"skipNodeRegistration", kubelet.KubeletSkipNodeRegistration != nil},
+		{"disableManifestsDirectory: false", !pointer.SafeDeref(kubelet.KubeletDisableManifestsDirectory)},
+	} {
+		if field.set {
+			unsupported = append(unsupported, field.name)
+		}
+	}
+
+	if len(unsupported) > 0 {
+		return []change{
+			{
+				Section: Section{
+					Path:    path,
+					Skipped: fmt.Sprintf("KubeletConfig doesn't support: %s", strings.Join(unsupported, ", ")),
+				},
+				effective: effectiveKubelet,
+			},
+		}, nil
+	}
+
+	doc := k8s.NewKubeletConfigV1Alpha1()
+	doc.KubeletImage = kubelet.Image()
+	doc.KubeletClusterDNS = slices.Clone(kubelet.KubeletClusterDNS)
+	doc.KubeletArgs = kubelet.KubeletExtraArgs
+	doc.KubeletConfig = meta.Unstructured{Object: kubelet.KubeletExtraConfig.Object}
+	doc.KubeletDefaultRuntimeSeccompProfileEnabled = kubelet.KubeletDefaultRuntimeSeccompProfileEnabled
+
+	s.legacy.MachineConfig.MachineKubelet = nil
+
+	return []change{
+		{
+			Section: Section{
+				Path:      path,
+				Documents: []string{s.add(doc)},
+			},
+			effective: effectiveKubelet,
+		},
+	}, nil
+}
+
+type effectiveKubeletConfig struct {
+	Image                               string              `yaml:"image"`
+	ClusterDNS                          []string            `yaml:"clusterDNS,omitempty"`
+	ExtraArgs                           map[string][]string `yaml:"extraArgs,omitempty"`
+	ExtraMounts                         []string            `yaml:"extraMounts,omitempty"`
+	ExtraConfig                         map[string]any      `yaml:"extraConfig,omitempty"`
+	DefaultRuntimeSeccompProfileEnabled bool                `yaml:"defaultRuntimeSeccompProfileEnabled"`
+	DisableManifestsDirectory           bool                `yaml:"disableManifestsDirectory"`
+}
+
+func effectiveKubelet(cfg config.Config) any {
+	c := cfg.K8sKubeletConfig()
+	if c == nil {
+		return nil
+	}
+
+	result := effectiveKubeletConfig{
+		Image:                               c.Image(),
+		ClusterDNS:                          c.ClusterDNS(),
+		ExtraArgs:                           c.ExtraArgs(),
+		ExtraConfig:                         c.ExtraConfig(),
+		DefaultRuntimeSeccompProfileEnabled: c.DefaultRuntimeSeccompProfileEnabled(),
+		DisableManifestsDirectory:           c.DisableManifestsDirectory(),
+	}
+
+	for _, mount := range c.ExtraMounts() {
+		result.ExtraMounts = append(result.ExtraMounts, mount.Destination)
+	}
+
+	if len(result.ExtraArgs) == 0 {
+		result.ExtraArgs = nil
+	}
+
+	return result
+}
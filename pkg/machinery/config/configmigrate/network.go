@@ -0,0 +1,694 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package configmigrate
+
+import (
+	"cmp"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/siderolabs/go-pointer"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// legacyNetwork returns the legacy network config if it is set.
+//
+//nolint:staticcheck // migrating deprecated fields
+func legacyNetwork(legacy *v1alpha1.Config) *v1alpha1.NetworkConfig {
+	if legacy.MachineConfig == nil {
+		return nil
+	}
+
+	return legacy.MachineConfig.MachineNetwork
+}
+
+// skipExisting returns a change which skips the section as the document already exists.
+func skipExisting(path, kind string, effective func(config.Config) any) []change {
+	return []change{
+		{
+			Section: Section{
+				Path:    path,
+				Skipped: fmt.Sprintf("%s document already exists", kind),
+			},
+			effective: effective,
+		},
+	}
+}
+
+func migrateHostname(s *state) ([]change, error) {
+	net := legacyNetwork(s.legacy)
+
+	var paths []string
+
+	if net != nil && net.NetworkHostname != "" { //nolint:staticcheck // migrating deprecated fields
+		paths = append(paths, "machine.network.hostname")
+	}
+
+	features := s.legacy.MachineConfig.MachineFeatures
+	if features != nil && features.StableHostname != nil {
+		paths = append(paths, "machine.features.stableHostname")
+	}
+
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	path := strings.Join(paths, ", ")
+
+	if s.has(network.HostnameKind) {
+		return skipExisting(path, network.HostnameKind, effectiveHostname), nil
+	}
+
+	doc := network.NewHostnameConfigV1Alpha1()
+
+	switch {
+	case s.legacy.Hostname() != "":
+		doc.ConfigHostname = s.legacy.Hostname()
+	case s.legacy.AutoHostname() == nethelpers.AutoHostnameKindStable:
+		doc.ConfigAuto = new(nethelpers.AutoHostnameKindStable)
+	}
+
+	if net != nil {
+		net.NetworkHostname = "" //nolint:staticcheck // migrating deprecated fields
+	}
+
+	if features != nil {
+		features.StableHostname = nil
+	}
+
+	if doc.ConfigHostname == "" && doc.ConfigAuto == nil {
+		// stableHostname: false is the default behavior
+		return []change{{Section: Section{Path: path}, effective: effectiveHostname}}, nil
+	}
+
+	return []change{
+		{
+			Section: Section{
+				Path:      path,
+				Documents: []string{s.add(doc)},
+			},
+			effective: effectiveHostname,
+		},
+	}, nil
+}
+
+func effectiveHostname(cfg config.Config) any {
+	c := cfg.NetworkHostnameConfig()
+	if c == nil {
+		return nil
+	}
+
+	if c.Hostname() != "" {
+		return map[string]string{"hostname": c.Hostname()}
+	}
+
+	return map[string]string{"auto": c.AutoHostname().String()}
+}
+
+//nolint:gocyclo,staticcheck // migrating deprecated fields
+func migrateResolvers(s *state) ([]change, error) {
+	net := legacyNetwork(s.legacy)
+	features := s.legacy.MachineConfig.MachineFeatures
+
+	var paths []string
+
+	if net != nil && net.NameServers != nil {
+		paths = append(paths, "machine.network.nameservers")
+	}
+
+	if net != nil && net.Searches != nil {
+		paths = append(paths, "machine.network.searchDomains")
+	}
+
+	if net != nil && net.NetworkDisableSearchDomain != nil {
+		paths = append(paths, "machine.network.disableSearchDomain")
+	}
+
+	// ResolverConfig hostDNS is only used when enabled, otherwise the legacy section still applies
+	migrateHostDNS := features != nil && features.HostDNSSupport != nil && features.HostDNSSupport.HostDNSEnabled()
+	if migrateHostDNS {
+		paths = append(paths, "machine.features.hostDNS")
+	}
+
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	path := strings.Join(paths, ", ")
+
+	if s.has(network.ResolverKind) {
+		return skipExisting(path, network.ResolverKind, effectiveResolvers), nil
+	}
+
+	doc := network.NewResolverConfigV1Alpha1()
+
+	for _, resolver := range s.legacy.Resolvers() {
+		doc.ResolverNameservers = append(doc.ResolverNameservers, network.NameserverConfig{
+			Address: meta.Addr{Addr: resolver.Addr},
+		})
+	}
+
+	if net != nil {
+		doc.ResolverSearchDomains.SearchDomains = slices.Clone(net.Searches)
+
+		if pointer.SafeDeref(net.NetworkDisableSearchDomain) {
+			doc.ResolverSearchDomains.SearchDisableDefault = new(true)
+		}
+
+		net.NameServers = nil
+		net.Searches = nil
+		net.NetworkDisableSearchDomain = nil
+	}
+
+	if migrateHostDNS {
+		hostDNS := features.HostDNSSupport
+
+		doc.ResolverHostDNS = network.HostDNSConfig{
+			HostDNSEnabled:              hostDNS.HostDNSConfigEnabled,
+			HostDNSForwardKubeDNSToHost: hostDNS.HostDNSForwardKubeDNSToHost,
+			HostDNSResolveMemberNames:   hostDNS.HostDNSResolveMemberNames,
+		}
+
+		features.HostDNSSupport = nil
+	}
+
+	return []change{
+		{
+			Section: Section{
+				Path:      path,
+				Documents: []string{s.add(doc)},
+			},
+			effective: effectiveResolvers,
+		},
+	}, nil
+}
+
+type effectiveResolverConfig struct {
+	Nameservers          []string `yaml:"nameservers,omitempty"`
+	SearchDomains        []string `yaml:"searchDomains,omitempty"`
+	DisableSearchDomain  bool     `yaml:"disableSearchDomain,omitempty"`
+	HostDNS              bool     `yaml:"hostDNS,omitempty"`
+	ForwardKubeDNSToHost bool     `yaml:"forwardKubeDNSToHost,omitempty"`
+	ResolveMemberNames   bool     `yaml:"resolveMemberNames,omitempty"`
+}
+
+func effectiveResolvers(cfg config.Config) any {
+	var result effectiveResolverConfig
+
+	if c := cfg.NetworkResolverConfig(); c != nil {
+		for _, resolver := range c.Resolvers() {
+			ns := resolver.Addr.String()
+
+			if resolver.Protocol != nethelpers.DNSProtocolDefault {
+				ns = fmt.Sprintf("%s://%s (%s)", resolver.Protocol, ns, resolver.TLSServerName)
+			}
+
+			result.Nameservers = append(result.Nameservers, ns)
+		}
+
+		result.SearchDomains = c.SearchDomains().ValueOrZero()
+		result.DisableSearchDomain = c.DisableSearchDomain()
+	}
+
+	if c := cfg.NetworkHostDNSConfig(); c != nil {
+		result.HostDNS = c.HostDNSEnabled()
+		result.ForwardKubeDNSToHost = c.ForwardKubeDNSToHost()
+		result.ResolveMemberNames = c.ResolveMemberNames()
+	}
+
+	return result
+}
+
+func migrateStaticHosts(s *state) ([]change, error) {
+	net := legacyNetwork(s.legacy)
+	if net == nil || len(net.ExtraHostEntries) == 0 { //nolint:staticcheck // migrating deprecated fields
+		return nil, nil
+	}
+
+	const path = "machine.network.extraHostEntries"
+
+	// StaticHostConfig documents are named by IP, so the legacy entries for the same IP are merged
+	var (
+		docs  []*network.StaticHostConfigV1Alpha1
+		names []string
+	)
+
+	for _, host := range s.legacy.NetworkStaticHostConfig() {
+		idx := slices.IndexFunc(docs, func(doc *network.StaticHostConfigV1Alpha1) bool { return doc.MetaName == host.IP() })
+		if idx == -1 {
+			docs = append(docs, network.NewStaticHostConfigV1Alpha1(host.IP()))
+			idx = len(docs) - 1
+		}
+
+		docs[idx].Hostnames = append(docs[idx].Hostnames, host.Aliases()...)
+	}
+
+	for _, doc := range docs {
+		if s.hasNamed(network.StaticHostKind, doc.MetaName) {
+			return skipExisting(path, network.StaticHostKind+"/"+doc.MetaName, effectiveStaticHosts), nil
+		}
+	}
+
+	for _, doc := range docs {
+		names = append(names, s.add(doc))
+	}
+
+	net.ExtraHostEntries = nil //nolint:staticcheck // migrating deprecated fields
+
+	return []change{
+		{
+			Section: Section{
+				Path:      path,
+				Documents: names,
+			},
+			effective: effectiveStaticHosts,
+		},
+	}, nil
+}
+
+func effectiveStaticHosts(cfg config.Config) any {
+	result := map[string][]string{}
+
+	for _, host := range cfg.NetworkStaticHostConfig() {
+		result[host.IP()] = append(result[host.IP()], host.Aliases()...)
+	}
+
+	return result
+}
+
+//nolint:staticcheck // migrating deprecated fields
+func migrateTimeSync(s *state) ([]change, error) {
+	if s.legacy.MachineConfig == nil || s.legacy.MachineConfig.MachineTime == nil {
+		return nil, nil
+	}
+
+	const path = "machine.time"
+
+	if s.has(network.TimeSyncKind) {
+		return skipExisting(path, network.TimeSyncKind, effectiveTimeSync), nil
+	}
+
+	legacyTime := s.legacy.MachineConfig.MachineTime
+
+	doc := network.NewTimeSyncConfigV1Alpha1()
+	doc.TimeBootTimeout = legacyTime.TimeBootTimeout
+
+	if legacyTime.Disabled() {
+		doc.TimeEnabled = new(false)
+	}
+
+	if len(legacyTime.TimeServers) > 0 {
+		doc.TimeNTP = &network.NTPConfig{
+			Servers: slices.Clone(legacyTime.TimeServers),
+		}
+	}
+
+	s.legacy.MachineConfig.MachineTime = nil
+
+	return []change{
+		{
+			Section: Section{
+				Path:      path,
+				Documents: []string{s.add(doc)},
+			},
+			effective: effectiveTimeSync,
+		},
+	}, nil
+}
+
+type effectiveTimeSyncConfig struct {
+	Disabled    bool          `yaml:"disabled,omitempty"`
+	Servers     []string      `yaml:"servers,omitempty"`
+	BootTimeout time.Duration `yaml:"bootTimeout,omitempty"`
+	UseNTS      bool          `yaml:"useNTS,omitempty"`
+}
+
+func effectiveTimeSync(cfg config.Config) any {
+	c := cfg.NetworkTimeSyncConfig()
+	if c == nil {
+		return nil
+	}
+
+	return effectiveTimeSyncConfig{
+		Disabled:    c.Disabled(),
+		Servers:     c.Servers(),
+		BootTimeout: c.BootTimeout(),
+		UseNTS:      c.UseNTS(),
+	}
+}
+
+func migrateKubeSpan(s *state) ([]change, error) {
+	net := legacyNetwork(s.legacy)
+	if net == nil || net.NetworkKubeSpan == nil { //nolint:staticcheck // migrating deprecated fields
+		return nil, nil
+	}
+
+	const path = "machine.network.kubespan"
+
+	if s.has(network.KubeSpanKind) {
+		return skipExisting(path, network.KubeSpanKind, effectiveKubeSpan), nil
+	}
+
+	legacyKubeSpan := net.NetworkKubeSpan //nolint:staticcheck // migrating deprecated fields
+
+	doc := network.NewKubeSpanV1Alpha1()
+	doc.ConfigEnabled = legacyKubeSpan.KubeSpanEnabled
+	doc.ConfigAdvertiseKubernetesNetworks = legacyKubeSpan.KubeSpanAdvertiseKubernetesNetworks
+	doc.ConfigAllowDownPeerBypass = legacyKubeSpan.KubeSpanAllowDownPeerBypass
+	doc.ConfigHarvestExtraEndpoints = legacyKubeSpan.KubeSpanHarvestExtraEndpoints
+	doc.ConfigMTU = legacyKubeSpan.KubeSpanMTU
+
+	if filters := legacyKubeSpan.KubeSpanFilters; filters != nil {
+		doc.ConfigFilters = &network.KubeSpanFiltersConfig{
+			ConfigEndpoints: slices.Clone(filters.KubeSpanFiltersEndpoints),
+		}
+
+		for _, prefix := range filters.ExcludeAdvertisedNetworks() {
+			doc.ConfigFilters.ConfigExcludeAdvertisedNetworks = append(doc.ConfigFilters.ConfigExcludeAdvertisedNetworks, meta.Prefix{Prefix: prefix})
+		}
+	}
+
+	net.NetworkKubeSpan = nil //nolint:staticcheck // migrating deprecated fields
+
+	return []change{
+		{
+			Section: Section{
+				Path:      path,
+				Documents: []string{s.add(doc)},
+			},
+			effective: effectiveKubeSpan,
+		},
+	}, nil
+}
+
+type effectiveKubeSpanConfig struct {
+	Enabled                     bool           `yaml:"enabled"`
+	ForceRouting                bool           `yaml:"forceRouting"`
+	AdvertiseKubernetesNetworks bool           `yaml:"advertiseKubernetesNetworks"`
+	HarvestExtraEndpoints       bool           `yaml:"harvestExtraEndpoints"`
+	MTU                         uint32         `yaml:"mtu"`
+	Endpoints                   []string       `yaml:"endpoints,omitempty"`
+	ExcludeAdvertisedNetworks   []netip.Prefix `yaml:"excludeAdvertisedNetworks,omitempty"`
+}
+
+func effectiveKubeSpan(cfg config.Config) any {
+	c := cfg.NetworkKubeSpanConfig()
+	if c == nil {
+		return nil
+	}
+
+	result := effectiveKubeSpanConfig{
+		Enabled:                     c.Enabled(),
+		ForceRouting:                c.ForceRouting(),
+		AdvertiseKubernetesNetworks: c.AdvertiseKubernetesNetworks(),
+		HarvestExtraEndpoints:       c.HarvestExtraEndpoints(),
+		MTU:                         c.MTU(),
+	}
+
+	if filters := c.Filters(); filters != nil {
+		result.Endpoints = filters.Endpoints()
+		result.ExcludeAdvertisedNetworks = filters.ExcludeAdvertisedNetworks()
+	}
+
+	return result
+}
+
+//nolint:staticcheck // migrating deprecated fields
+func migrateLinks(s *state) ([]change, error) {
+	net := legacyNetwork(s.legacy)
+	if net == nil || len(net.NetworkInterfaces) == 0 {
+		return nil, nil
+	}
+
+	var (
+		changes []change
+		kept    v1alpha1.NetworkDeviceList
+	)
+
+	// adding the first link document stops the default DHCP on the links which are not configured explicitly
+	defaultDHCPStops := s.runDefaultDHCPOperators()
+
+	for _, device := range net.NetworkInterfaces {
+		name := device.DeviceInterface
+		path := fmt.Sprintf("machine.network.interfaces[%s]", cmp.Or(name, "?"))
+		effective := func(cfg config.Config) any { return effectiveLink(cfg, name) }
+
+		reason := staticDeviceSkipReason(device)
+
+		switch {
+		case reason != "":
+		case s.hasNamed(network.LinkKind, name):
+			reason = fmt.Sprintf("%s/%s document already exists", network.LinkKind, name)
+		case defaultDHCPStops:
+			reason = "migrating would disable the default DHCP on the links which are not configured explicitly"
+		}
+
+		if reason != "" {
+			kept = append(kept, device)
+			changes = append(changes, change{Section: Section{Path: path, Skipped: reason}, effective: effective})
+
+			continue
+		}
+
+		doc, err := linkConfig(device)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		changes = append(changes, change{
+			Section: Section{
+				Path:      path,
+				Documents: []string{s.add(doc)},
+			},
+			effective: effective,
+		})
+	}
+
+	net.NetworkInterfaces = kept
+
+	return changes, nil
+}
+
+// staticDeviceSkipReason returns the reason the device can't be migrated to LinkConfig.
+//
+// Only the physical links with static addressing are migrated.
+func staticDeviceSkipReason(device *v1alpha1.Device) string {
+	var unsupported []string
+
+	for _, field := range []struct {
+		name string
+		set  bool
+	}{
+		{"deviceSelector", device.DeviceSelector != nil},
+		{"dhcp", pointer.SafeDeref(device.DeviceDHCP)},
+		{"dhcpOptions", device.DeviceDHCPOptions != nil},
+		{"ignore", pointer.SafeDeref(device.DeviceIgnore)},
+		{"dummy", pointer.SafeDeref(device.DeviceDummy)},
+		{"bond", device.DeviceBond != nil},
+		{"bridge", device.DeviceBridge != nil},
+		{"bridgePort", device.DeviceBridgePort != nil},
+		{"vlans", len(device.DeviceVlans) > 0},
+		{"wireguard", device.DeviceWireguardConfig != nil},
+		{"vip", device.DeviceVIPConfig != nil},
+	} {
+		if field.set {
+			unsupported = append(unsupported, field.name)
+		}
+	}
+
+	switch {
+	case device.DeviceInterface == "":
+		return "only the links selected by the interface name are migrated"
+	case len(unsupported) > 0:
+		return fmt.Sprintf("only the links with static addressing are migrated, found: %s", strings.Join(unsupported, ", "))
+	default:
+		return ""
+	}
+}
+
+func linkConfig(device *v1alpha1.Device) (*network.LinkConfigV1Alpha1, error) {
+	doc := network.NewLinkConfigV1Alpha1(device.DeviceInterface)
+	doc.LinkMTU = uint32(device.MTU())
+
+	for _, address := range device.Addresses() {
+		prefix, err := parseIPOrIPPrefix(address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address %q: %w", address, err)
+		}
+
+		doc.LinkAddresses = append(doc.LinkAddresses, network.AddressConfig{AddressAddress: prefix})
+	}
+
+	for _, route := range device.DeviceRoutes {
+		routeConfig := network.RouteConfig{
+			RouteMetric: route.Metric(),
+			RouteMTU:    route.MTU(),
+		}
+
+		if destination, err := parseRouteDestination(route.Network()); err != nil {
+			return nil, err
+		} else if destination.IsValid() {
+			routeConfig.RouteDestination = meta.Prefix{Prefix: destination}
+		}
+
+		for _, addr := range []struct {
+			value string
+			field *meta.Addr
+		}{
+			{route.Gateway(), &routeConfig.RouteGateway},
+			{route.Source(), &routeConfig.RouteSource},
+		} {
+			if addr.value == "" {
+				continue
+			}
+
+			parsed, err := netip.ParseAddr(addr.value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid route address %q: %w", addr.value, err)
+			}
+
+			*addr.field = meta.Addr{Addr: parsed}
+		}
+
+		doc.LinkRoutes = append(doc.LinkRoutes, routeConfig)
+	}
+
+	return doc, nil
+}
+
+// parseIPOrIPPrefix parses the legacy address, which might be given without the prefix length.
+func parseIPOrIPPrefix(address string) (netip.Prefix, error) {
+	if strings.IndexByte(address, '/') >= 0 {
+		return netip.ParsePrefix(address)
+	}
+
+	ip, err := netip.ParseAddr(address)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	return netip.PrefixFrom(ip, ip.BitLen()), nil
+}
+
+// parseRouteDestination parses the legacy route network, the default route is returned as an invalid prefix.
+func parseRouteDestination(destination string) (netip.Prefix, error) {
+	if destination == "" {
+		return netip.Prefix{}, nil
+	}
+
+	prefix, err := netip.ParsePrefix(destination)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid route network %q: %w", destination, err)
+	}
+
+	if prefix.Bits() == 0 {
+		return netip.Prefix{}, nil
+	}
+
+	return prefix, nil
+}
+
+type effectiveLinkConfig struct {
+	MTU       uint32   `yaml:"mtu,omitempty"`
+	Addresses []string `yaml:"addresses,omitempty"`
+	Routes    []string `yaml:"routes,omitempty"`
+	// DefaultDHCP is the default DHCP on the links which are not configured explicitly.
+	DefaultDHCP bool `yaml:"defaultDHCP"`
+}
+
+// effectiveLink returns the static configuration of the link either from the legacy device or LinkConfig document.
+//
+//nolint:gocyclo
+func effectiveLink(cfg config.Config, name string) any {
+	result := effectiveLinkConfig{
+		DefaultDHCP: cfg.RunDefaultDHCPOperators(),
+	}
+
+	route := func(destination netip.Prefix, gateway, source netip.Addr, metric, mtu uint32) string {
+		r := "default"
+		if destination.IsValid() {
+			r = destination.String()
+		}
+
+		if gateway.IsValid() {
+			r += " via " + gateway.String()
+		}
+
+		if source.IsValid() {
+			r += " src " + source.String()
+		}
+
+		if metric != 0 {
+			r += fmt.Sprintf(" metric %d", metric)
+		}
+
+		if mtu != 0 {
+			r += fmt.Sprintf(" mtu %d", mtu)
+		}
+
+		return r
+	}
+
+	if cfg.Machine() != nil {
+		for _, device := range cfg.Machine().Network().Devices() {
+			if device.Interface() != name || staticDeviceSkipReason(device.(*v1alpha1.Device)) != "" {
+				continue
+			}
+
+			result.MTU = uint32(device.MTU())
+
+			for _, address := range device.Addresses() {
+				prefix, err := parseIPOrIPPrefix(address)
+				if err != nil {
+					result.Addresses = append(result.Addresses, address)
+
+					continue
+				}
+
+				result.Addresses = append(result.Addresses, prefix.String())
+			}
+
+			for _, r := range device.Routes() {
+				destination, _ := parseRouteDestination(r.Network()) //nolint:errcheck
+				gateway, _ := netip.ParseAddr(r.Gateway())           //nolint:errcheck
+				source, _ := netip.ParseAddr(r.Source())             //nolint:errcheck
+
+				result.Routes = append(result.Routes, route(destination, gateway, source, r.Metric(), r.MTU()))
+			}
+		}
+	}
+
+	for _, link := range cfg.NetworkCommonLinkConfigs() {
+		if link.Name() != name {
+			continue
+		}
+
+		result.MTU = link.MTU().ValueOrZero()
+
+		for _, address := range link.Addresses() {
+			result.Addresses = append(result.Addresses, address.Address().String())
+		}
+
+		for _, r := range link.Routes() {
+			result.Routes = append(result.Routes, route(
+				r.Destination().ValueOrZero(),
+				r.Gateway().ValueOrZero(),
+				r.Source().ValueOrZero(),
+				r.Metric().ValueOrZero(),
+				r.MTU().ValueOrZero(),
+			))
+		}
+	}
+
+	return result
+}
@@ -70,43 +70,37 @@ func LoadPatch(in []byte) (Patch, error) {
 	return p, nil
 }
 
-// LoadPatches loads the JSON patch either from value literal or from a file if the patch starts with '@'.
+// ReadPatch returns the contents of the patch either from value literal or from a file if the patch starts with '@'.
 //
 // It also tries to guess if the filename was given without '@' prefix.
+func ReadPatch(patchString string) ([]byte, error) {
+	switch {
+	case strings.HasPrefix(patchString, "@"):
+		return os.ReadFile(patchString[1:])
+	case !strings.ContainsAny(patchString, "\n ") &&
+		!strings.HasPrefix(patchString, "[") &&
+		!strings.HasPrefix(patchString, "{"):
+		// any valid patch supplied inline should contain either '\n' or space, or start with '[' or '{'
+		// so if none of this is true, assume it's a filename, but without '@' prefix
+		return os.ReadFile(patchString)
+	default:
+		return []byte(patchString), nil
+	}
+}
+
+// LoadPatches loads the JSON patch either from value literal or from a file if the patch starts with '@'.
 //
-//nolint:gocyclo
+// It also tries to guess if the filename was given without '@' prefix.
 func LoadPatches(in []string) ([]Patch, error) {
 	var result []Patch
 
 	for _, patchString := range in {
-		var (
-			p        Patch
-			contents []byte
-			err      error
-		)
-
-		switch {
-		case strings.HasPrefix(patchString, "@"):
-			filename := patchString[1:]
-
-			contents, err = os.ReadFile(filename)
-			if err != nil {
-				return result, err
-			}
-		case !strings.ContainsAny(patchString, "\n ") &&
-			!strings.HasPrefix(patchString, "[") &&
-			!strings.HasPrefix(patchString, "{"):
-			// any valid patch supplied inline should contain either '\n' or space, or start with '[' or '{'
-			// so if none of this is true, assume it's a filename, but without '@' prefix
-			contents, err = os.ReadFile(patchString)
-			if err != nil {
-				return result, err
-			}
-		default:
-			contents = []byte(patchString)
+		contents, err := ReadPatch(patchString)
+		if err != nil {
+			return result, err
 		}
 
-		p, err = LoadPatch(contents)
+		p, err := LoadPatch(contents)
 		if err != nil {
 			return result, err
 		}
@@ -381,6 +381,36 @@ func (container *Container) UnattendedInstallConfig() config.UnattendedInstallCo
 	return matching[0]
 }
 
+// ConfigSourceConfig implements config.Config interface.
+func (container *Container) ConfigSourceConfig() config.ConfigSourceConfig {
+	matching := findMatchingDocs[config.ConfigSourceConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
+// MaintenancePolicyConfig implements config.Config interface.
+func (container *Container) MaintenancePolicyConfig() config.MaintenancePolicyConfig {
+	matching := findMatchingDocs[config.MaintenancePolicyConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
+// EtcdMaintenanceConfig implements config.Config interface.
+func (container *Container) EtcdMaintenanceConfig() config.EtcdMaintenanceConfig {
+	matching := findMatchingDocs[config.EtcdMaintenanceConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
 // NetworkRules implements config.Config interface.
 func (container *Container) NetworkRules() config.NetworkRuleConfig {
 	return config.WrapNetworkRuleConfigList(findMatchingDocs[config.NetworkRuleConfigSignal](container.documents)...)
@@ -438,11 +468,26 @@ func (container *Container) DiscoveryIdentityConfig() config.DiscoveryIdentityCo
 	return nil
 }
 
+// EmbeddedDiscoveryConfig implements config.Config interface.
+func (container *Container) EmbeddedDiscoveryConfig() config.EmbeddedDiscoveryConfig {
+	matching := findMatchingDocs[config.EmbeddedDiscoveryConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
 // PCIDriverRebindConfig implements config.Config interface.
 func (container *Container) PCIDriverRebindConfig() config.PCIDriverRebindConfig {
 	return config.WrapPCIDriverRebindConfig(findMatchingDocs[config.PCIDriverRebindConfig](container.documents)...)
 }
 
+// SRIOVConfigs implements config.Config interface.
+func (container *Container) SRIOVConfigs() []config.SRIOVConfig {
+	return findMatchingDocs[config.SRIOVConfig](container.documents)
+}
+
 // EthernetConfigs implements config.Config interface.
 func (container *Container) EthernetConfigs() []config.EthernetConfig {
 	return findMatchingDocs[config.EthernetConfig](container.documents)
@@ -648,6 +693,11 @@ func (container *Container) NetworkBGPInstanceConfigs() []config.NetworkBGPInsta
 	return findMatchingDocs[config.NetworkBGPInstanceConfig](container.documents)
 }
 
+// NetworkDot1XConfigs implements config.Config interface.
+func (container *Container) NetworkDot1XConfigs() []config.NetworkDot1XConfig {
+	return findMatchingDocs[config.NetworkDot1XConfig](container.documents)
+}
+
 // NetworkDHCPv4Configs implements config.Config interface.
 func (container *Container) NetworkDHCPv4Configs() []config.NetworkDHCPv4Config {
 	return findMatchingDocs[config.NetworkDHCPv4Config](container.documents)
@@ -778,6 +828,16 @@ func (container *Container) K8sEtcdEncryptionConfig() config.K8sEtcdEncryptionCo
 	return matching[0]
 }
 
+// K8sKMSConfig implements config.Config interface.
+func (container *Container) K8sKMSConfig() config.K8sKMSConfig {
+	matching := findMatchingDocs[config.K8sKMSConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
 // K8sAPIServerConfig implements config.Config interface.
 func (container *Container) K8sAPIServerConfig() config.K8sAPIServerConfig {
 	matching := findMatchingDocs[config.K8sAPIServerConfig](container.documents)
@@ -1106,6 +1166,16 @@ func (container *Container) ImageVerificationConfig() config.ImageVerificationCo
 	return docs[0]
 }
 
+// NodeAttestationConfig implements config.Config interface.
+func (container *Container) NodeAttestationConfig() config.NodeAttestationConfig {
+	docs := findMatchingDocs[config.NodeAttestationConfig](container.documents)
+	if len(docs) == 0 {
+		return nil
+	}
+
+	return docs[0]
+}
+
 // Bytes returns source YAML representation (if available) or does default encoding.
 func (container *Container) Bytes() ([]byte, error) {
 	if !container.readonly {
@@ -0,0 +1,98 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package rotatepatcher
+
+import (
+	"slices"
+
+	"github.com/siderolabs/crypto/x509"
+
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+// K8sAggregatorAddAcceptedCA adds the specified accepted aggregator CA to the machine configuration.
+//
+// The aggregator CA is only present in the controlplane machine configuration, other machines are not modified.
+func K8sAggregatorAddAcceptedCA(caCrt []byte) PatcherFunc {
+	return patchAggregatorCAConfig(func(caCfg *k8s.KubeAggregatorCAConfigV1Alpha1) error {
+		if slices.Contains(caCfg.AggregatorAcceptedCAs, string(caCrt)) {
+			return nil
+		}
+
+		caCfg.AggregatorAcceptedCAs = append(caCfg.AggregatorAcceptedCAs, string(caCrt))
+
+		return nil
+	})
+}
+
+// K8sAggregatorDeleteAcceptedCA deletes the specified accepted aggregator CA from the machine configuration.
+func K8sAggregatorDeleteAcceptedCA(caCrt []byte) PatcherFunc {
+	return patchAggregatorCAConfig(func(caCfg *k8s.KubeAggregatorCAConfigV1Alpha1) error {
+		caCfg.AggregatorAcceptedCAs = slices.DeleteFunc(caCfg.AggregatorAcceptedCAs, func(ca string) bool {
+			return ca == string(caCrt)
+		})
+
+		return nil
+	})
+}
+
+// K8sAggregatorSetCA sets the specified aggregator CA to the machine configuration.
+func K8sAggregatorSetCA(newCA *x509.PEMEncodedCertificateAndKey) PatcherFunc {
+	return patchAggregatorCAConfig(func(caCfg *k8s.KubeAggregatorCAConfigV1Alpha1) error {
+		caCfg.AggregatorIssuingCA = &meta.CertificateAndKey{
+			Cert: string(newCA.Crt),
+			Key:  string(newCA.Key),
+		}
+
+		return nil
+	})
+}
+
+func patchAggregatorCAConfig(patcher func(*k8s.KubeAggregatorCAConfigV1Alpha1) error) PatcherFunc {
+	return func(in config.Provider) (config.Provider, error) {
+		in, err := migrateAggregatorCAConfig(in)
+		if err != nil {
+			return nil, err
+		}
+
+		return container.PatchDocument(in, patcher)
+	}
+}
+
+// migrateAggregatorCAConfig moves the legacy .cluster.aggregatorCA to the KubeAggregatorCAConfig document.
+//
+// The legacy field doesn't support accepted CAs, so the rotation requires the multi-doc configuration.
+func migrateAggregatorCAConfig(in config.Provider) (config.Provider, error) {
+	if in.Has(k8s.KubeAggregatorCAConfig) {
+		return in, nil
+	}
+
+	legacy := in.RawV1Alpha1()
+
+	if legacy == nil || legacy.ClusterConfig == nil || legacy.ClusterConfig.ClusterAggregatorCA == nil { //nolint:staticcheck // legacy config
+		return in, nil
+	}
+
+	caCfg := k8s.NewKubeAggregatorCAConfigV1Alpha1()
+	caCfg.AggregatorIssuingCA = &meta.CertificateAndKey{
+		Cert: string(legacy.ClusterConfig.ClusterAggregatorCA.Crt), //nolint:staticcheck // legacy config
+		Key:  string(legacy.ClusterConfig.ClusterAggregatorCA.Key), //nolint:staticcheck // legacy config
+	}
+
+	patched, err := in.PatchV1Alpha1(func(cfg *v1alpha1.Config) error {
+		cfg.ClusterConfig.ClusterAggregatorCA = nil //nolint:staticcheck // legacy config
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return container.New(append(patched.Documents(), caCfg)...)
+}
@@ -0,0 +1,91 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package rotatepatcher_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/siderolabs/crypto/x509"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate/rotatepatcher"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+)
+
+// TestK8sAggregatorCARotateComposed mirrors the aggregator CA rotation performed by pkg/rotate/pki/aggregator.
+//
+// Talos 1.13 stores the aggregator CA in the legacy .cluster.aggregatorCA field, which doesn't support
+// accepted CAs, so the patchers migrate it to the KubeAggregatorCAConfig document.
+func TestK8sAggregatorCARotateComposed(t *testing.T) {
+	t.Parallel()
+
+	bundle, err := secrets.NewBundle(secrets.NewFixedClock(time.Now()), config.TalosVersion1_14)
+	require.NoError(t, err)
+
+	currentCA := bundle.Certs.K8sAggregator
+
+	newCAAuthority, err := x509.NewSelfSignedCertificateAuthority()
+	require.NoError(t, err)
+
+	newCA := &x509.PEMEncodedCertificateAndKey{
+		Crt: newCAAuthority.CrtPEM,
+		Key: newCAAuthority.KeyPEM,
+	}
+
+	for _, versionContract := range []*config.VersionContract{
+		config.TalosVersion1_13,
+		config.TalosVersion1_14,
+	} {
+		t.Run(versionContract.String(), func(t *testing.T) {
+			t.Parallel()
+
+			in, err := generate.NewInput(
+				"test", "https://127.0.0.1:6443", "1.34.0",
+				generate.WithSecretsBundle(bundle),
+				generate.WithVersionContract(versionContract),
+			)
+			require.NoError(t, err)
+
+			controlplaneCfg, err := in.Config(machine.TypeControlPlane)
+			require.NoError(t, err)
+
+			// addNewCAAccepted
+			cfg, err := rotatepatcher.K8sAggregatorAddAcceptedCA(newCA.Crt)(controlplaneCfg)
+			require.NoError(t, err)
+
+			require.NotNil(t, cfg.K8sAggregatorCAConfig())
+			assert.Equal(t, currentCA, cfg.K8sAggregatorCAConfig().IssuingCA())
+			assert.Equal(t, []*x509.PEMEncodedCertificate{{Crt: currentCA.Crt}, {Crt: newCA.Crt}}, cfg.K8sAggregatorCAConfig().AcceptedCAs())
+
+			// swapCAs
+			cfg, err = rotatepatcher.K8sAggregatorAddAcceptedCA(currentCA.Crt)(cfg)
+			require.NoError(t, err)
+
+			cfg, err = rotatepatcher.K8sAggregatorDeleteAcceptedCA(newCA.Crt)(cfg)
+			require.NoError(t, err)
+
+			cfg, err = rotatepatcher.K8sAggregatorSetCA(newCA)(cfg)
+			require.NoError(t, err)
+
+			assert.Equal(t, newCA, cfg.K8sAggregatorCAConfig().IssuingCA())
+			assert.Equal(t, []*x509.PEMEncodedCertificate{{Crt: newCA.Crt}, {Crt: currentCA.Crt}}, cfg.K8sAggregatorCAConfig().AcceptedCAs())
+
+			// dropOldCA
+			cfg, err = rotatepatcher.K8sAggregatorDeleteAcceptedCA(currentCA.Crt)(cfg)
+			require.NoError(t, err)
+
+			assert.Equal(t, newCA, cfg.K8sAggregatorCAConfig().IssuingCA())
+			assert.Equal(t, []*x509.PEMEncodedCertificate{{Crt: newCA.Crt}}, cfg.K8sAggregatorCAConfig().AcceptedCAs())
+
+			// the legacy field is migrated to the multi-doc configuration
+			assert.Nil(t, cfg.RawV1Alpha1().ClusterConfig.ClusterAggregatorCA) //nolint:staticcheck // legacy config
+		})
+	}
+}
@@ -0,0 +1,64 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package rotatepatcher
+
+import (
+	"bytes"
+	"slices"
+
+	"github.com/siderolabs/crypto/x509"
+
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+// EtcdAddAcceptedCA adds the specified accepted etcd CA to the machine configuration.
+//
+// The etcd CA is only present in the controlplane machine configuration, other machines are not modified.
+func EtcdAddAcceptedCA(caCrt []byte) PatcherFunc {
+	return patchEtcdConfig(func(etcdCfg *v1alpha1.EtcdConfig) {
+		if slices.ContainsFunc(etcdCfg.EtcdAcceptedCAs, func(ca *x509.PEMEncodedCertificate) bool {
+			return bytes.Equal(ca.Crt, caCrt)
+		}) {
+			return
+		}
+
+		etcdCfg.EtcdAcceptedCAs = append(etcdCfg.EtcdAcceptedCAs, &x509.PEMEncodedCertificate{
+			Crt: caCrt,
+		})
+	})
+}
+
+// EtcdDeleteAcceptedCA deletes the specified accepted etcd CA from the machine configuration.
+func EtcdDeleteAcceptedCA(caCrt []byte) PatcherFunc {
+	return patchEtcdConfig(func(etcdCfg *v1alpha1.EtcdConfig) {
+		etcdCfg.EtcdAcceptedCAs = slices.DeleteFunc(etcdCfg.EtcdAcceptedCAs, func(ca *x509.PEMEncodedCertificate) bool {
+			return bytes.Equal(ca.Crt, caCrt)
+		})
+	})
+}
+
+// EtcdSetCA sets the specified etcd CA to the machine configuration.
+func EtcdSetCA(newCA *x509.PEMEncodedCertificateAndKey) PatcherFunc {
+	return patchEtcdConfig(func(etcdCfg *v1alpha1.EtcdConfig) {
+		etcdCfg.RootCA = newCA
+	})
+}
+
+func patchEtcdConfig(patcher func(*v1alpha1.EtcdConfig)) PatcherFunc {
+	return func(in config.Provider) (config.Provider, error) {
+		legacy := in.RawV1Alpha1()
+
+		if legacy == nil || legacy.ClusterConfig == nil || legacy.ClusterConfig.EtcdConfig == nil || legacy.ClusterConfig.EtcdConfig.RootCA == nil {
+			return in, nil
+		}
+
+		return in.PatchV1Alpha1(func(cfg *v1alpha1.Config) error {
+			patcher(cfg.ClusterConfig.EtcdConfig)
+
+			return nil
+		})
+	}
+}
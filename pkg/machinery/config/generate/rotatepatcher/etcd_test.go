@@ -0,0 +1,87 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package rotatepatcher_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/siderolabs/crypto/x509"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate/rotatepatcher"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+)
+
+// TestEtcdCARotateComposed mirrors the phases of the etcd CA rotation performed by pkg/rotate/pki/etcd.
+func TestEtcdCARotateComposed(t *testing.T) {
+	t.Parallel()
+
+	bundle, err := secrets.NewBundle(secrets.NewFixedClock(time.Now()), config.TalosVersion1_14)
+	require.NoError(t, err)
+
+	currentCA := bundle.Certs.Etcd
+
+	newCAAuthority, err := x509.NewSelfSignedCertificateAuthority()
+	require.NoError(t, err)
+
+	newCA := &x509.PEMEncodedCertificateAndKey{
+		Crt: newCAAuthority.CrtPEM,
+		Key: newCAAuthority.KeyPEM,
+	}
+
+	in, err := generate.NewInput(
+		"test", "https://127.0.0.1:6443", "1.34.0",
+		generate.WithSecretsBundle(bundle),
+	)
+	require.NoError(t, err)
+
+	controlplaneCfg, err := in.Config(machine.TypeControlPlane)
+	require.NoError(t, err)
+
+	// addNewCAAccepted
+	cfg, err := rotatepatcher.EtcdAddAcceptedCA(newCA.Crt)(controlplaneCfg)
+	require.NoError(t, err)
+
+	// adding the same CA twice is a no-op
+	cfg, err = rotatepatcher.EtcdAddAcceptedCA(newCA.Crt)(cfg)
+	require.NoError(t, err)
+
+	assert.Equal(t, currentCA, cfg.Cluster().Etcd().CA())
+	assert.Equal(t, []*x509.PEMEncodedCertificate{{Crt: currentCA.Crt}, {Crt: newCA.Crt}}, cfg.Cluster().Etcd().AcceptedCAs())
+
+	// swapCAs
+	cfg, err = rotatepatcher.EtcdAddAcceptedCA(currentCA.Crt)(cfg)
+	require.NoError(t, err)
+
+	cfg, err = rotatepatcher.EtcdDeleteAcceptedCA(newCA.Crt)(cfg)
+	require.NoError(t, err)
+
+	cfg, err = rotatepatcher.EtcdSetCA(newCA)(cfg)
+	require.NoError(t, err)
+
+	assert.Equal(t, newCA, cfg.Cluster().Etcd().CA())
+	assert.Equal(t, []*x509.PEMEncodedCertificate{{Crt: newCA.Crt}, {Crt: currentCA.Crt}}, cfg.Cluster().Etcd().AcceptedCAs())
+
+	// dropOldCA
+	cfg, err = rotatepatcher.EtcdDeleteAcceptedCA(currentCA.Crt)(cfg)
+	require.NoError(t, err)
+
+	assert.Equal(t, newCA, cfg.Cluster().Etcd().CA())
+	assert.Equal(t, []*x509.PEMEncodedCertificate{{Crt: newCA.Crt}}, cfg.Cluster().Etcd().AcceptedCAs())
+
+	// workers don't have the etcd CA, so they are not modified
+	workerCfg, err := in.Config(machine.TypeWorker)
+	require.NoError(t, err)
+
+	patched, err := rotatepatcher.EtcdSetCA(newCA)(workerCfg)
+	require.NoError(t, err)
+
+	assert.Same(t, workerCfg, patched)
+}
@@ -0,0 +1,96 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package rotatepatcher
+
+import (
+	"slices"
+
+	"github.com/siderolabs/crypto/x509"
+
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+// K8sServiceAccountAddAcceptedKey adds the specified accepted service account public key to the machine configuration.
+//
+// The service account key is only present in the controlplane machine configuration, other machines are not modified.
+func K8sServiceAccountAddAcceptedKey(publicKey []byte) PatcherFunc {
+	return patchServiceAccountConfig(func(saCfg *k8s.KubeServiceAccountConfigV1Alpha1) error {
+		if slices.Contains(saCfg.ServiceAccepted.PublicKeys, string(publicKey)) {
+			return nil
+		}
+
+		saCfg.ServiceAccepted.PublicKeys = append(saCfg.ServiceAccepted.PublicKeys, string(publicKey))
+
+		return nil
+	})
+}
+
+// K8sServiceAccountDeleteAcceptedKey deletes the specified accepted service account public key from the machine configuration.
+func K8sServiceAccountDeleteAcceptedKey(publicKey []byte) PatcherFunc {
+	return patchServiceAccountConfig(func(saCfg *k8s.KubeServiceAccountConfigV1Alpha1) error {
+		saCfg.ServiceAccepted.PublicKeys = slices.DeleteFunc(saCfg.ServiceAccepted.PublicKeys, func(key string) bool {
+			return key == string(publicKey)
+		})
+
+		return nil
+	})
+}
+
+// K8sServiceAccountSetKey sets the specified service account signing key to the machine configuration.
+func K8sServiceAccountSetKey(newKey *x509.PEMEncodedKey) PatcherFunc {
+	return patchServiceAccountConfig(func(saCfg *k8s.KubeServiceAccountConfigV1Alpha1) error {
+		saCfg.ServiceIssuer.PrivateKey = string(newKey.Key)
+
+		return nil
+	})
+}
+
+func patchServiceAccountConfig(patcher func(*k8s.KubeServiceAccountConfigV1Alpha1) error) PatcherFunc {
+	return func(in config.Provider) (config.Provider, error) {
+		in, err := migrateServiceAccountConfig(in)
+		if err != nil {
+			return nil, err
+		}
+
+		return container.PatchDocument(in, patcher)
+	}
+}
+
+// migrateServiceAccountConfig moves the legacy .cluster.serviceAccount to the KubeServiceAccountConfig document.
+//
+// The legacy field doesn't support accepted keys, so the rotation requires the multi-doc configuration.
+func migrateServiceAccountConfig(in config.Provider) (config.Provider, error) {
+	if in.Has(k8s.KubeServiceAccountConfig) {
+		return in, nil
+	}
+
+	legacy := in.RawV1Alpha1()
+
+	if legacy == nil || legacy.ClusterConfig == nil || legacy.ClusterConfig.ClusterServiceAccount == nil { //nolint:staticcheck // legacy config
+		return in, nil
+	}
+
+	// the legacy config uses the controlplane endpoint as the issuer URL
+	saCfg := k8s.NewKubeServiceAccountConfigV1Alpha1()
+	saCfg.ServiceIssuer = k8s.IssuerServiceAccountConfig{
+		PrivateKey: string(legacy.ClusterConfig.ClusterServiceAccount.Key), //nolint:staticcheck // legacy config
+		IssuerURL:  meta.URL{URL: legacy.ClusterConfig.Endpoint()},
+	}
+
+	patched, err := in.PatchV1Alpha1(func(cfg *v1alpha1.Config) error {
+		cfg.ClusterConfig.ClusterServiceAccount = nil //nolint:staticcheck // legacy config
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return container.New(append(patched.Documents(), saCfg)...)
+}
@@ -0,0 +1,99 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package rotatepatcher_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/siderolabs/crypto/x509"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate/rotatepatcher"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+)
+
+// TestK8sServiceAccountRotateComposed mirrors the service account key rotation performed by pkg/rotate/pki/serviceaccount.
+//
+// Talos 1.13 stores the service account key in the legacy .cluster.serviceAccount field, which doesn't support
+// accepted keys, so the patchers migrate it to the KubeServiceAccountConfig document.
+func TestK8sServiceAccountRotateComposed(t *testing.T) {
+	t.Parallel()
+
+	bundle, err := secrets.NewBundle(secrets.NewFixedClock(time.Now()), config.TalosVersion1_14)
+	require.NoError(t, err)
+
+	currentKey := bundle.Certs.K8sServiceAccount
+
+	newKey, err := x509.NewECDSAKey()
+	require.NoError(t, err)
+
+	newKeyPEM := &x509.PEMEncodedKey{Key: newKey.KeyPEM}
+
+	publicKeyOf := func(key *x509.PEMEncodedKey) []byte {
+		k, err := key.GetKey()
+		require.NoError(t, err)
+
+		return k.GetPublicKeyPEM()
+	}
+
+	currentPublicKey := publicKeyOf(currentKey)
+	newPublicKey := publicKeyOf(newKeyPEM)
+
+	for _, versionContract := range []*config.VersionContract{
+		config.TalosVersion1_13,
+		config.TalosVersion1_14,
+	} {
+		t.Run(versionContract.String(), func(t *testing.T) {
+			t.Parallel()
+
+			in, err := generate.NewInput(
+				"test", "https://127.0.0.1:6443", "1.34.0",
+				generate.WithSecretsBundle(bundle),
+				generate.WithVersionContract(versionContract),
+			)
+			require.NoError(t, err)
+
+			controlplaneCfg, err := in.Config(machine.TypeControlPlane)
+			require.NoError(t, err)
+
+			// addNewKeyAccepted
+			cfg, err := rotatepatcher.K8sServiceAccountAddAcceptedKey(newPublicKey)(controlplaneCfg)
+			require.NoError(t, err)
+
+			require.NotNil(t, cfg.K8sServiceAccountConfig())
+			assert.Equal(t, currentKey, cfg.K8sServiceAccountConfig().IssuingKey())
+			assert.Equal(t, []*x509.PEMEncodedKey{{Key: currentPublicKey}, {Key: newPublicKey}}, cfg.K8sServiceAccountConfig().AcceptedKeys())
+			assert.Equal(t, "https://127.0.0.1:6443", cfg.K8sServiceAccountConfig().IssuerURL())
+
+			// swapKeys
+			cfg, err = rotatepatcher.K8sServiceAccountAddAcceptedKey(currentPublicKey)(cfg)
+			require.NoError(t, err)
+
+			cfg, err = rotatepatcher.K8sServiceAccountDeleteAcceptedKey(newPublicKey)(cfg)
+			require.NoError(t, err)
+
+			cfg, err = rotatepatcher.K8sServiceAccountSetKey(newKeyPEM)(cfg)
+			require.NoError(t, err)
+
+			assert.Equal(t, newKeyPEM, cfg.K8sServiceAccountConfig().IssuingKey())
+			assert.Equal(t, []*x509.PEMEncodedKey{{Key: newPublicKey}, {Key: currentPublicKey}}, cfg.K8sServiceAccountConfig().AcceptedKeys())
+
+			// dropOldKey
+			cfg, err = rotatepatcher.K8sServiceAccountDeleteAcceptedKey(currentPublicKey)(cfg)
+			require.NoError(t, err)
+
+			assert.Equal(t, newKeyPEM, cfg.K8sServiceAccountConfig().IssuingKey())
+			assert.Equal(t, []*x509.PEMEncodedKey{{Key: newPublicKey}}, cfg.K8sServiceAccountConfig().AcceptedKeys())
+
+			// the legacy field is migrated to the multi-doc configuration
+			assert.Nil(t, cfg.RawV1Alpha1().ClusterConfig.ClusterServiceAccount) //nolint:staticcheck // legacy config
+		})
+	}
+}
@@ -29,6 +29,15 @@ type DocumentID struct {
 	Name       string
 }
 
+// String returns the document ID as used in the reports: 'Kind' or 'Kind/name', 'v1alpha1' for the legacy document.
+func (id DocumentID) String() string {
+	if id.Name != "" {
+		return id.Kind + "/" + id.Name
+	}
+
+	return id.Kind
+}
+
 // Meta returns a map representation of the DocumentID suitable for use as metadata in configuration documents.
 func (id DocumentID) Meta() map[string]any {
 	meta := map[string]any{}
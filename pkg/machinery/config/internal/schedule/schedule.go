@@ -0,0 +1,234 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package schedule implements the cron schedules of the maintenance windows.
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed five-field cron expression: minute, hour, day of month, month, day of week.
+type Schedule struct {
+	minute, hour, dom, month, dow uint64
+
+	domStar, dowStar bool
+}
+
+// scheduleSearchLimit limits the search for the next schedule match, so that impossible schedules (e.g. February 30th) terminate.
+const scheduleSearchLimit = 5 // years
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var (
+	cronMonthNames = []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}
+	cronDayNames   = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+)
+
+// Parse parses the cron expression.
+//
+// The fields support `*`, single values, ranges (`1-5`), lists (`1,3,5`) and steps (`*/15`, `0-30/10`);
+// months and days of week might be specified with three-letter names (`jan`, `mon`).
+func Parse(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+
+	if macro, ok := cronMacros[strings.ToLower(spec)]; ok {
+		spec = macro
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return Schedule{}, fmt.Errorf("expected 5 fields, got %d", len(fields))
+	}
+
+	var (
+		schedule Schedule
+		err      error
+	)
+
+	if schedule.minute, _, err = parseCronField(fields[0], 0, 59, nil, 0); err != nil {
+		return Schedule{}, fmt.Errorf("minute: %w", err)
+	}
+
+	if schedule.hour, _, err = parseCronField(fields[1], 0, 23, nil, 0); err != nil {
+		return Schedule{}, fmt.Errorf("hour: %w", err)
+	}
+
+	if schedule.dom, schedule.domStar, err = parseCronField(fields[2], 1, 31, nil, 0); err != nil {
+		return Schedule{}, fmt.Errorf("day of month: %w", err)
+	}
+
+	if schedule.month, _, err = parseCronField(fields[3], 1, 12, cronMonthNames, 1); err != nil {
+		return Schedule{}, fmt.Errorf("month: %w", err)
+	}
+
+	if schedule.dow, schedule.dowStar, err = parseCronField(fields[4], 0, 7, cronDayNames, 0); err != nil {
+		return Schedule{}, fmt.Errorf("day of week: %w", err)
+	}
+
+	// both 0 and 7 are Sunday
+	if schedule.dow&(1<<7) != 0 {
+		schedule.dow |= 1
+	}
+
+	return schedule, nil
+}
+
+//nolint:gocyclo
+func parseCronField(field string, minValue, maxValue int, names []string, namesOffset int) (bits uint64, star bool, err error) {
+	parseValue := func(s string) (int, error) {
+		for i, name := range names {
+			if strings.EqualFold(s, name) {
+				return i + namesOffset, nil
+			}
+		}
+
+		v, err := strconv.Atoi(s)
+		if err != nil {
+			return 0, fmt.Errorf("invalid value %q", s)
+		}
+
+		if v < minValue || v > maxValue {
+			return 0, fmt.Errorf("value %d is out of range [%d, %d]", v, minValue, maxValue)
+		}
+
+		return v, nil
+	}
+
+	for part := range strings.SplitSeq(field, ",") {
+		rangeSpec, stepSpec, hasStep := strings.Cut(part, "/")
+
+		step := 1
+
+		if hasStep {
+			step, err = strconv.Atoi(stepSpec)
+			if err != nil || step <= 0 {
+				return 0, false, fmt.Errorf("invalid step %q", stepSpec)
+			}
+		}
+
+		var start, end int
+
+		switch {
+		case rangeSpec == "*":
+			start, end = minValue, maxValue
+			star = star || !hasStep
+		case strings.Contains(rangeSpec, "-"):
+			startSpec, endSpec, _ := strings.Cut(rangeSpec, "-")
+
+			if start, err = parseValue(startSpec); err != nil {
+				return 0, false, err
+			}
+
+			if end, err = parseValue(endSpec); err != nil {
+				return 0, false, err
+			}
+
+			if end < start {
+				return 0, false, fmt.Errorf("invalid range %q", rangeSpec)
+			}
+		case rangeSpec == "":
+			return 0, false, errors.New("empty value")
+		default:
+			if start, err = parseValue(rangeSpec); err != nil {
+				return 0, false, err
+			}
+
+			end = start
+
+			if hasStep {
+				end = maxValue
+			}
+		}
+
+		for v := start; v <= end; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+
+	return bits, star, nil
+}
+
+// dayMatches follows the cron convention: if both day of month and day of week are restricted,
+// the day matches if either of them matches.
+func (schedule Schedule) dayMatches(t time.Time) bool {
+	domMatch := schedule.dom&(1<<uint(t.Day())) != 0
+	dowMatch := schedule.dow&(1<<uint(t.Weekday())) != 0
+
+	if schedule.domStar || schedule.dowStar {
+		return domMatch && dowMatch
+	}
+
+	return domMatch || dowMatch
+}
+
+// Next returns the first time matching the schedule strictly after the given time (in the time location).
+//
+// Zero time is returned if the schedule never matches.
+func (schedule Schedule) Next(after time.Time) time.Time {
+	loc := after.Location()
+
+	t := time.Date(after.Year(), after.Month(), after.Day(), after.Hour(), after.Minute(), 0, 0, loc).Add(time.Minute)
+	limit := t.AddDate(scheduleSearchLimit, 0, 0)
+
+	for t.Before(limit) {
+		switch {
+		case schedule.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		case !schedule.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		case schedule.hour&(1<<uint(t.Hour())) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+		case schedule.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+// Window is a recurring window which opens on the schedule and stays open for the duration.
+type Window struct {
+	Schedule Schedule
+	Duration time.Duration
+	// Location is the time zone the schedule is evaluated in.
+	Location *time.Location
+}
+
+// Contains returns true if the window is open at the given time.
+func (window Window) Contains(t time.Time) bool {
+	// the window is open if it started within the last window duration
+	start := window.Schedule.Next(t.Add(-window.Duration).In(window.location()))
+
+	return !start.IsZero() && !start.After(t)
+}
+
+// Next returns the start of the next window strictly after the given time.
+//
+// Zero time is returned if the schedule never matches.
+func (window Window) Next(t time.Time) time.Time {
+	return window.Schedule.Next(t.In(window.location()))
+}
+
+func (window Window) location() *time.Location {
+	if window.Location == nil {
+		return time.UTC
+	}
+
+	return window.Location
+}
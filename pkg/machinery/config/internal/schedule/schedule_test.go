@@ -0,0 +1,81 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package schedule_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/schedule"
+)
+
+func TestNext(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		spec     string
+		after    string
+		expected string
+	}{
+		{"*/15 * * * *", "2026-03-01T10:07:00Z", "2026-03-01T10:15:00Z"},
+		{"0 2 * * sat,sun", "2026-03-02T00:00:00Z", "2026-03-07T02:00:00Z"},
+		{"@monthly", "2026-03-01T00:00:00Z", "2026-04-01T00:00:00Z"},
+		{"0 0 30 feb *", "2026-03-01T00:00:00Z", ""},
+	} {
+		t.Run(test.spec, func(t *testing.T) {
+			t.Parallel()
+
+			sched, err := schedule.Parse(test.spec)
+			require.NoError(t, err)
+
+			after, err := time.Parse(time.RFC3339, test.after)
+			require.NoError(t, err)
+
+			next := sched.Next(after)
+
+			if test.expected == "" {
+				assert.True(t, next.IsZero())
+
+				return
+			}
+
+			assert.Equal(t, test.expected, next.Format(time.RFC3339))
+		})
+	}
+}
+
+func TestWindowContains(t *testing.T) {
+	t.Parallel()
+
+	sched, err := schedule.Parse("30 22 * * *")
+	require.NoError(t, err)
+
+	window := schedule.Window{
+		Schedule: sched,
+		Duration: 4 * time.Hour,
+	}
+
+	for _, test := range []struct {
+		time     string
+		expected bool
+	}{
+		{"2026-03-01T22:29:59Z", false},
+		{"2026-03-01T22:30:00Z", true},
+		{"2026-03-02T02:29:59Z", true},
+		{"2026-03-02T02:30:00Z", false},
+	} {
+		t.Run(test.time, func(t *testing.T) {
+			t.Parallel()
+
+			ts, err := time.Parse(time.RFC3339, test.time)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, window.Contains(ts))
+		})
+	}
+}
@@ -0,0 +1,256 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package inventory
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/netip"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// Funcs returns the functions available in the templated patches (in addition to text/template builtins like 'index'):
+//
+//   - default DEFAULT VALUE: VALUE if it is set and not empty, DEFAULT otherwise;
+//   - required MESSAGE VALUE: VALUE, or fails with MESSAGE if it is not set or empty;
+//   - add A B, sub A B, mul A B: integer math, numbers might be given as strings;
+//   - lower S, upper S, trim S, replace OLD NEW S, split SEP S, join SEP LIST, quote S: string helpers;
+//   - cidrhost PREFIX N: N-th address of the PREFIX, negative N counts from the end ('cidrhost "10.0.0.0/24" -2' is 10.0.0.254);
+//   - cidrsubnet PREFIX NEWBITS NETNUM: NETNUM-th subnet of the PREFIX extended by NEWBITS;
+//   - cidrnetmask PREFIX: netmask of the IPv4 PREFIX in dotted form;
+//   - cidrprefixlen PREFIX: length of the PREFIX;
+//   - ipaddr PREFIX: the address of the PREFIX without the length ('ipaddr "10.0.0.5/24"' is 10.0.0.5).
+func Funcs() template.FuncMap {
+	return template.FuncMap{
+		"default":  defaultValue,
+		"required": required,
+
+		"add": func(a, b any) (int, error) { return intOp(a, b, func(x, y int) int { return x + y }) },
+		"sub": func(a, b any) (int, error) { return intOp(a, b, func(x, y int) int { return x - y }) },
+		"mul": func(a, b any) (int, error) { return intOp(a, b, func(x, y int) int { return x * y }) },
+
+		"lower":   strings.ToLower,
+		"upper":   strings.ToUpper,
+		"trim":    strings.TrimSpace,
+		"replace": func(old, replacement, s string) string { return strings.ReplaceAll(s, old, replacement) },
+		"split":   func(sep, s string) []string { return strings.Split(s, sep) },
+		"join":    join,
+		"quote":   strconv.Quote,
+
+		"cidrhost":      cidrHost,
+		"cidrsubnet":    cidrSubnet,
+		"cidrnetmask":   cidrNetmask,
+		"cidrprefixlen": cidrPrefixLen,
+		"ipaddr":        ipAddr,
+	}
+}
+
+func isEmpty(value any) bool {
+	if value == nil {
+		return true
+	}
+
+	v := reflect.ValueOf(value)
+
+	switch v.Kind() { //nolint:exhaustive
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		return v.Len() == 0
+	case reflect.Pointer, reflect.Interface:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
+
+func defaultValue(def, value any) any {
+	if isEmpty(value) {
+		return def
+	}
+
+	return value
+}
+
+func required(msg string, value any) (any, error) {
+	if isEmpty(value) {
+		return nil, errors.New(msg)
+	}
+
+	return value, nil
+}
+
+func toInt(value any) (int, error) {
+	switch v := value.(type) {
+	case int:
+		return v, nil
+	case int64:
+		return int(v), nil
+	case uint64:
+		return int(v), nil
+	case float64:
+		if v != float64(int(v)) {
+			return 0, fmt.Errorf("%v is not an integer", v)
+		}
+
+		return int(v), nil
+	case string:
+		return strconv.Atoi(strings.TrimSpace(v))
+	default:
+		return 0, fmt.Errorf("%v (%T) is not an integer", value, value)
+	}
+}
+
+func intOp(a, b any, op func(x, y int) int) (int, error) {
+	x, err := toInt(a)
+	if err != nil {
+		return 0, err
+	}
+
+	y, err := toInt(b)
+	if err != nil {
+		return 0, err
+	}
+
+	return op(x, y), nil
+}
+
+func join(sep string, list any) (string, error) {
+	v := reflect.ValueOf(list)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return "", fmt.Errorf("join: %v (%T) is not a list", list, list)
+	}
+
+	parts := make([]string, 0, v.Len())
+
+	for i := range v.Len() {
+		parts = append(parts, fmt.Sprint(v.Index(i).Interface()))
+	}
+
+	return strings.Join(parts, sep), nil
+}
+
+func parsePrefix(prefix any) (netip.Prefix, error) {
+	s, ok := prefix.(string)
+	if !ok {
+		return netip.Prefix{}, fmt.Errorf("%v (%T) is not a CIDR prefix", prefix, prefix)
+	}
+
+	return netip.ParsePrefix(strings.TrimSpace(s))
+}
+
+// offsetAddr returns the address of the prefix with the given offset, which should fit in the host bits.
+func offsetAddr(prefix netip.Prefix, offset *big.Int) (netip.Addr, error) {
+	hostBits := prefix.Addr().BitLen() - prefix.Bits()
+	size := new(big.Int).Lsh(big.NewInt(1), uint(hostBits))
+
+	if offset.Sign() < 0 || offset.Cmp(size) >= 0 {
+		return netip.Addr{}, fmt.Errorf("offset %s doesn't fit in %s", offset, prefix)
+	}
+
+	base := new(big.Int).SetBytes(prefix.Masked().Addr().AsSlice())
+	base.Add(base, offset)
+
+	buf := make([]byte, prefix.Addr().BitLen()/8)
+	base.FillBytes(buf)
+
+	addr, _ := netip.AddrFromSlice(buf)
+
+	return addr, nil
+}
+
+func cidrHost(prefix, num any) (string, error) {
+	p, err := parsePrefix(prefix)
+	if err != nil {
+		return "", fmt.Errorf("cidrhost: %w", err)
+	}
+
+	n, err := toInt(num)
+	if err != nil {
+		return "", fmt.Errorf("cidrhost: %w", err)
+	}
+
+	offset := big.NewInt(int64(n))
+
+	if n < 0 {
+		offset.Add(offset, new(big.Int).Lsh(big.NewInt(1), uint(p.Addr().BitLen()-p.Bits())))
+	}
+
+	addr, err := offsetAddr(p, offset)
+	if err != nil {
+		return "", fmt.Errorf("cidrhost: %w", err)
+	}
+
+	return addr.String(), nil
+}
+
+func cidrSubnet(prefix, newBits, netNum any) (string, error) {
+	p, err := parsePrefix(prefix)
+	if err != nil {
+		return "", fmt.Errorf("cidrsubnet: %w", err)
+	}
+
+	extra, err := toInt(newBits)
+	if err != nil {
+		return "", fmt.Errorf("cidrsubnet: %w", err)
+	}
+
+	n, err := toInt(netNum)
+	if err != nil {
+		return "", fmt.Errorf("cidrsubnet: %w", err)
+	}
+
+	bits := p.Bits() + extra
+	if extra < 0 || bits > p.Addr().BitLen() {
+		return "", fmt.Errorf("cidrsubnet: can't extend %s by %d bits", p, extra)
+	}
+
+	if n < 0 || big.NewInt(int64(n)).BitLen() > extra {
+		return "", fmt.Errorf("cidrsubnet: subnet number %d doesn't fit in %d bits", n, extra)
+	}
+
+	offset := new(big.Int).Lsh(big.NewInt(int64(n)), uint(p.Addr().BitLen()-bits))
+
+	addr, err := offsetAddr(p, offset)
+	if err != nil {
+		return "", fmt.Errorf("cidrsubnet: %w", err)
+	}
+
+	return netip.PrefixFrom(addr, bits).String(), nil
+}
+
+func cidrNetmask(prefix any) (string, error) {
+	p, err := parsePrefix(prefix)
+	if err != nil {
+		return "", fmt.Errorf("cidrnetmask: %w", err)
+	}
+
+	if !p.Addr().Is4() {
+		return "", fmt.Errorf("cidrnetmask: %s is not an IPv4 prefix", p)
+	}
+
+	return net.IP(net.CIDRMask(p.Bits(), 32)).String(), nil
+}
+
+func cidrPrefixLen(prefix any) (int, error) {
+	p, err := parsePrefix(prefix)
+	if err != nil {
+		return 0, fmt.Errorf("cidrprefixlen: %w", err)
+	}
+
+	return p.Bits(), nil
+}
+
+func ipAddr(prefix any) (string, error) {
+	p, err := parsePrefix(prefix)
+	if err != nil {
+		return "", fmt.Errorf("ipaddr: %w", err)
+	}
+
+	return p.Addr().String(), nil
+}
@@ -0,0 +1,73 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package inventory_test
+
+import (
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/inventory"
+)
+
+func TestFuncs(t *testing.T) {
+	t.Parallel()
+
+	data := map[string]any{
+		"subnet": "10.5.0.0/24",
+		"v6":     "fd00:10::/64",
+		"num":    "3",
+		"empty":  "",
+		"list":   []any{"a", "b"},
+	}
+
+	for _, test := range []struct {
+		template    string
+		expected    string
+		expectedErr string
+	}{
+		{template: `{{ cidrhost .subnet 10 }}`, expected: "10.5.0.10"},
+		{template: `{{ cidrhost .subnet .num }}`, expected: "10.5.0.3"},
+		{template: `{{ cidrhost .subnet -2 }}`, expected: "10.5.0.254"},
+		{template: `{{ cidrhost .v6 "17" }}`, expected: "fd00:10::11"},
+		{template: `{{ cidrhost .subnet 256 }}`, expectedErr: "offset 256 doesn't fit in 10.5.0.0/24"},
+		{template: `{{ cidrsubnet "10.0.0.0/16" 8 5 }}`, expected: "10.0.5.0/24"},
+		{template: `{{ cidrsubnet .v6 16 1 }}`, expected: "fd00:10:0:0:1::/80"},
+		{template: `{{ cidrsubnet "10.0.0.0/16" 2 4 }}`, expectedErr: "subnet number 4 doesn't fit in 2 bits"},
+		{template: `{{ cidrnetmask "10.0.0.0/20" }}`, expected: "255.255.240.0"},
+		{template: `{{ cidrnetmask .v6 }}`, expectedErr: "is not an IPv4 prefix"},
+		{template: `{{ cidrprefixlen .subnet }}`, expected: "24"},
+		{template: `{{ ipaddr "10.0.0.5/24" }}`, expected: "10.0.0.5"},
+		{template: `{{ add .num 2 }} {{ sub 10 .num }} {{ mul .num .num }}`, expected: "5 7 9"},
+		{template: `{{ add .num "x" }}`, expectedErr: `invalid syntax`},
+		{template: `{{ default "x" .missing }} {{ default "x" .empty }} {{ default "x" .num }}`, expected: "x x 3"},
+		{template: `{{ required "missing is required" .missing }}`, expectedErr: "missing is required"},
+		{template: `{{ join "," .list }} {{ index .list 1 }} {{ index (split "/" .subnet) 1 }}`, expected: "a,b b 24"},
+		{template: `{{ upper "a" }}{{ lower "B" }}{{ trim " c " }}{{ replace "." "-" "d.e" }}{{ quote "f" }}`, expected: `Abcd-e"f"`},
+	} {
+		t.Run(test.template, func(t *testing.T) {
+			t.Parallel()
+
+			tmpl, err := template.New("test").Funcs(inventory.Funcs()).Parse(test.template)
+			require.NoError(t, err)
+
+			var sb strings.Builder
+
+			err = tmpl.Execute(&sb, data)
+
+			if test.expectedErr != "" {
+				assert.ErrorContains(t, err, test.expectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, sb.String())
+		})
+	}
+}
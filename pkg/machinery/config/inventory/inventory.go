@@ -0,0 +1,269 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package inventory implements rendering of templated machine config patches for each node of an inventory.
+//
+// An inventory is a list of nodes with arbitrary variables, loaded either from YAML:
+//
+//	vars:                       # shared by all nodes, optional
+//	  subnet: 10.5.0.0/24
+//	nodes:
+//	  - name: cp-1              # required, unique
+//	    type: controlplane      # controlplane or worker (default)
+//	    disk: /dev/nvme0n1      # any other key is a node variable
+//
+// or from CSV, where the header row names the variables and each following row is a node:
+//
+//	name,type,disk
+//	cp-1,controlplane,/dev/nvme0n1
+package inventory
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.yaml.in/yaml/v4"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+)
+
+// Reserved variable names.
+const (
+	// VarName is the name of the node.
+	VarName = "name"
+	// VarType is the type of the node: controlplane or worker.
+	VarType = "type"
+	// VarIndex is the zero-based index of the node in the inventory.
+	VarIndex = "index"
+)
+
+// Inventory is a list of nodes with the variables used to render templated patches.
+type Inventory struct {
+	// Vars are shared by all nodes, node variables take precedence.
+	Vars map[string]any `yaml:"vars,omitempty"`
+	// Nodes of the inventory.
+	Nodes []Node `yaml:"nodes"`
+}
+
+// Node of the inventory.
+type Node struct {
+	Name string
+	Type machine.Type
+	Vars map[string]any
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+//
+// Node is a flat map: 'name' and 'type' keys are extracted, and all other keys are node variables.
+func (n *Node) UnmarshalYAML(value *yaml.Node) error {
+	var vars map[string]any
+
+	if err := value.Decode(&vars); err != nil {
+		return err
+	}
+
+	return n.fromVars(vars)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (n Node) MarshalYAML() (any, error) {
+	vars := make(map[string]any, len(n.Vars)+2)
+	maps.Copy(vars, n.Vars)
+
+	vars[VarName] = n.Name
+	vars[VarType] = n.Type.String()
+
+	return vars, nil
+}
+
+func (n *Node) fromVars(vars map[string]any) error {
+	name, ok := vars[VarName].(string)
+	if _, present := vars[VarName]; present && !ok {
+		return fmt.Errorf("node %q should be a string, got %v", VarName, vars[VarName])
+	}
+
+	typ, ok := vars[VarType].(string)
+	if _, present := vars[VarType]; present && !ok {
+		return fmt.Errorf("node %q: %q should be a string", name, VarType)
+	}
+
+	nodeType, err := parseType(typ)
+	if err != nil {
+		return fmt.Errorf("node %q: %w", name, err)
+	}
+
+	delete(vars, VarName)
+	delete(vars, VarType)
+
+	*n = Node{
+		Name: name,
+		Type: nodeType,
+		Vars: vars,
+	}
+
+	return nil
+}
+
+func parseType(s string) (machine.Type, error) {
+	t, err := machine.ParseType(s)
+	if err != nil {
+		return t, err
+	}
+
+	if t != machine.TypeControlPlane && t != machine.TypeWorker {
+		return t, fmt.Errorf("invalid node type %q, should be %s or %s", s, machine.TypeControlPlane, machine.TypeWorker)
+	}
+
+	return t, nil
+}
+
+// Load the inventory from a YAML or CSV (.csv extension) file.
+func Load(path string) (*Inventory, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var inv *Inventory
+
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		inv, err = ParseCSV(data)
+	} else {
+		inv, err = ParseYAML(data)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error loading inventory %q: %w", path, err)
+	}
+
+	return inv, nil
+}
+
+// ParseYAML parses and validates the inventory in YAML format.
+func ParseYAML(data []byte) (*Inventory, error) {
+	var inv Inventory
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+
+	if err := dec.Decode(&inv); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	if err := inv.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &inv, nil
+}
+
+// ParseCSV parses and validates the inventory in CSV format.
+//
+// The first row is the header with the variable names, the 'name' column is required.
+// Empty cells leave the variable unset for the node.
+func ParseCSV(data []byte) (*Inventory, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.TrimLeadingSpace = true
+
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, errors.New("missing header row")
+	}
+
+	header := records[0]
+
+	for i := range header {
+		header[i] = strings.TrimSpace(header[i])
+	}
+
+	var inv Inventory
+
+	for _, record := range records[1:] {
+		vars := make(map[string]any, len(header))
+
+		for i, value := range record {
+			if value != "" {
+				vars[header[i]] = value
+			}
+		}
+
+		var node Node
+
+		if err = node.fromVars(vars); err != nil {
+			return nil, fmt.Errorf("line %d: %w", len(inv.Nodes)+2, err)
+		}
+
+		inv.Nodes = append(inv.Nodes, node)
+	}
+
+	if err = inv.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &inv, nil
+}
+
+// Validate the inventory.
+func (inv *Inventory) Validate() error {
+	if len(inv.Nodes) == 0 {
+		return errors.New("inventory has no nodes")
+	}
+
+	var errs []error
+
+	for _, reserved := range []string{VarName, VarType, VarIndex} {
+		if _, ok := inv.Vars[reserved]; ok {
+			errs = append(errs, fmt.Errorf("variable %q is reserved", reserved))
+		}
+	}
+
+	names := make(map[string]struct{}, len(inv.Nodes))
+
+	for i, node := range inv.Nodes {
+		switch {
+		case node.Name == "":
+			errs = append(errs, fmt.Errorf("node %d: name is required", i))
+		case strings.ContainsAny(node.Name, `/\`):
+			errs = append(errs, fmt.Errorf("node %q: name should not contain path separators", node.Name))
+		}
+
+		if _, ok := names[node.Name]; ok {
+			errs = append(errs, fmt.Errorf("node %q: duplicate name", node.Name))
+		}
+
+		names[node.Name] = struct{}{}
+
+		if _, ok := node.Vars[VarIndex]; ok {
+			errs = append(errs, fmt.Errorf("node %q: variable %q is reserved", node.Name, VarIndex))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// NodeVars returns the variables of the node with the given index: shared variables, node variables and reserved variables.
+func (inv *Inventory) NodeVars(index int) map[string]any {
+	node := inv.Nodes[index]
+	vars := make(map[string]any, len(inv.Vars)+len(node.Vars)+3)
+
+	maps.Copy(vars, inv.Vars)
+	maps.Copy(vars, node.Vars)
+
+	vars[VarName] = node.Name
+	vars[VarType] = node.Type.String()
+	vars[VarIndex] = index
+
+	return vars
+}
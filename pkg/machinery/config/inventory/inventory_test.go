@@ -0,0 +1,237 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package inventory_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configpatcher"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/inventory"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+const inventoryYAML = `vars:
+  subnet: 10.5.0.0/24
+nodes:
+  - name: cp-1
+    type: controlplane
+    disk: /dev/nvme0n1
+  - name: worker-1
+    labels:
+      zone: a
+`
+
+const inventoryCSV = `name,type,disk
+cp-1,controlplane,/dev/nvme0n1
+worker-1,,
+`
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	inv, err := inventory.ParseYAML([]byte(inventoryYAML))
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]any{"subnet": "10.5.0.0/24"}, inv.Vars)
+	assert.Equal(t, []inventory.Node{
+		{Name: "cp-1", Type: machine.TypeControlPlane, Vars: map[string]any{"disk": "/dev/nvme0n1"}},
+		{Name: "worker-1", Type: machine.TypeWorker, Vars: map[string]any{"labels": map[string]any{"zone": "a"}}},
+	}, inv.Nodes)
+
+	assert.Equal(t, map[string]any{
+		"name":   "worker-1",
+		"type":   "worker",
+		"index":  1,
+		"subnet": "10.5.0.0/24",
+		"labels": map[string]any{"zone": "a"},
+	}, inv.NodeVars(1))
+
+	inv, err = inventory.ParseCSV([]byte(inventoryCSV))
+	require.NoError(t, err)
+
+	assert.Equal(t, []inventory.Node{
+		{Name: "cp-1", Type: machine.TypeControlPlane, Vars: map[string]any{"disk": "/dev/nvme0n1"}},
+		{Name: "worker-1", Type: machine.TypeWorker, Vars: map[string]any{}},
+	}, inv.Nodes)
+}
+
+func TestParseInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name        string
+		yaml        string
+		expectedErr string
+	}{
+		{
+			name:        "no nodes",
+			yaml:        "vars: {}\n",
+			expectedErr: "inventory has no nodes",
+		},
+		{
+			name:        "unknown field",
+			yaml:        "node: []\n",
+			expectedErr: "field node not found",
+		},
+		{
+			name:        "duplicate",
+			yaml:        "nodes:\n  - name: a\n  - name: a\n",
+			expectedErr: `node "a": duplicate name`,
+		},
+		{
+			name:        "missing name",
+			yaml:        "nodes:\n  - disk: /dev/sda\n",
+			expectedErr: "node 0: name is required",
+		},
+		{
+			name:        "invalid type",
+			yaml:        "nodes:\n  - name: a\n    type: init\n",
+			expectedErr: `node "a": invalid node type "init"`,
+		},
+		{
+			name:        "reserved",
+			yaml:        "vars:\n  index: 1\nnodes:\n  - name: a\n",
+			expectedErr: `variable "index" is reserved`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := inventory.ParseYAML([]byte(test.yaml))
+			require.Error(t, err)
+			assert.ErrorContains(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	inv, err := inventory.ParseYAML([]byte(inventoryYAML))
+	require.NoError(t, err)
+
+	patchPath := filepath.Join(t.TempDir(), "patch.yaml")
+
+	require.NoError(t, os.WriteFile(patchPath, []byte(`machine:
+  network:
+    hostname: {{ .name }}
+    interfaces:
+      - interface: eth0
+        addresses:
+          - {{ cidrhost .subnet (add .index 10) }}/{{ cidrprefixlen .subnet }}
+  install:
+    disk: {{ default "/dev/sda" .disk }}
+  nodeLabels:
+    {{- range $k, $v := .labels }}
+    {{ $k }}: {{ $v }}
+    {{- end }}
+    node.example.com/role: {{ $.type }}
+`), 0o644))
+
+	renderer, err := inventory.NewRenderer(inv, []string{"@" + patchPath})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"disk", "index", "labels", "name", "subnet", "type"}, renderer.Vars())
+
+	for i, expected := range []struct {
+		hostname string
+		address  string
+		disk     string
+		labels   map[string]string
+		report   inventory.Report
+	}{
+		{
+			hostname: "cp-1",
+			address:  "10.5.0.10/24",
+			disk:     "/dev/nvme0n1",
+			labels:   map[string]string{"node.example.com/role": "controlplane"},
+			report: inventory.Report{
+				Node: "cp-1",
+				Used: map[string]any{
+					"disk": "/dev/nvme0n1", "index": 0, "name": "cp-1", "subnet": "10.5.0.0/24", "type": "controlplane",
+				},
+				Unset: []string{"labels"},
+			},
+		},
+		{
+			hostname: "worker-1",
+			address:  "10.5.0.11/24",
+			disk:     "/dev/sda",
+			labels:   map[string]string{"node.example.com/role": "worker", "zone": "a"},
+			report: inventory.Report{
+				Node: "worker-1",
+				Used: map[string]any{
+					"index": 1, "labels": map[string]any{"zone": "a"}, "name": "worker-1", "subnet": "10.5.0.0/24", "type": "worker",
+				},
+				Unset: []string{"disk"},
+			},
+		},
+	} {
+		patches, report, err := renderer.Render(i)
+		require.NoError(t, err)
+
+		assert.Equal(t, expected.report, report)
+
+		cfg, err := configpatcher.Apply(configpatcher.WithConfig(baseConfig(t)), patches)
+		require.NoError(t, err)
+
+		out, err := cfg.Config()
+		require.NoError(t, err)
+
+		assert.Equal(t, expected.hostname, out.RawV1Alpha1().MachineConfig.MachineNetwork.NetworkHostname)                               //nolint:staticcheck
+		assert.Equal(t, []string{expected.address}, out.RawV1Alpha1().MachineConfig.MachineNetwork.NetworkInterfaces[0].DeviceAddresses) //nolint:staticcheck
+		assert.Equal(t, expected.disk, out.RawV1Alpha1().MachineConfig.MachineInstall.InstallDisk)
+		assert.Equal(t, expected.labels, map[string]string(out.RawV1Alpha1().MachineConfig.MachineNodeLabels))
+	}
+}
+
+func TestRenderErrors(t *testing.T) {
+	t.Parallel()
+
+	inv, err := inventory.ParseYAML([]byte(inventoryYAML))
+	require.NoError(t, err)
+
+	renderer, err := inventory.NewRenderer(inv, []string{"machine:\n  install:\n    disk: {{ .disk }}\n"})
+	require.NoError(t, err)
+
+	_, _, err = renderer.Render(0)
+	require.NoError(t, err)
+
+	_, report, err := renderer.Render(1)
+	assert.EqualError(t, err, `node "worker-1": template "patch 0": variables are not set: disk`)
+	assert.Equal(t, []string{"disk"}, report.Unset)
+	assert.Equal(t, []string{"labels"}, report.Unused)
+
+	renderer, err = inventory.NewRenderer(inv, []string{`machine:
+  install:
+    disk: {{ required "disk is required" .disk }}
+`})
+	require.NoError(t, err)
+
+	_, _, err = renderer.Render(1)
+	assert.ErrorContains(t, err, "disk is required")
+}
+
+func baseConfig(t *testing.T) *container.Container {
+	t.Helper()
+
+	cfg, err := container.New(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineType: "worker",
+		},
+		ClusterConfig: &v1alpha1.ClusterConfig{},
+	})
+	require.NoError(t, err)
+
+	return cfg
+}
@@ -0,0 +1,191 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package inventory
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configpatcher"
+)
+
+// noValue is what text/template renders for the missing map keys.
+const noValue = "<no value>"
+
+// Renderer renders the templated patches for the nodes of the inventory.
+//
+// Patches are Go templates executed with the node variables as the data, e.g. '{{ .disk }}',
+// see Funcs for the list of the available functions.
+type Renderer struct {
+	inventory *Inventory
+	templates []*template.Template
+	vars      []string
+}
+
+// Report describes the variables used to render the patches for a node.
+type Report struct {
+	// Node name.
+	Node string
+	// Used are the variables referenced by the patches with their values.
+	Used map[string]any
+	// Unset are the variables referenced by the patches which are not set for the node.
+	Unset []string
+	// Unused are the node variables which are not referenced by any patch.
+	Unused []string
+}
+
+// NewRenderer parses the patches (inline or '@file') as templates.
+func NewRenderer(inv *Inventory, patches []string) (*Renderer, error) {
+	r := &Renderer{
+		inventory: inv,
+	}
+
+	referenced := map[string]struct{}{}
+
+	for i, patch := range patches {
+		contents, err := configpatcher.ReadPatch(patch)
+		if err != nil {
+			return nil, err
+		}
+
+		name := fmt.Sprintf("patch %d", i)
+		if strings.HasPrefix(patch, "@") {
+			name = patch[1:]
+		}
+
+		tmpl, err := template.New(name).Funcs(Funcs()).Parse(string(contents))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, t := range tmpl.Templates() {
+			if t.Tree != nil {
+				collectVars(t.Tree.Root, true, referenced)
+			}
+		}
+
+		r.templates = append(r.templates, tmpl)
+	}
+
+	r.vars = slices.Sorted(maps.Keys(referenced))
+
+	return r, nil
+}
+
+// Vars returns the sorted list of the variables referenced by the patches.
+//
+// Only the variables accessed on the top-level data ('.var' outside of 'range' and 'with', or '$.var') are detected.
+func (r *Renderer) Vars() []string {
+	return r.vars
+}
+
+// Render the patches for the node with the given index.
+func (r *Renderer) Render(index int) ([]configpatcher.Patch, Report, error) {
+	node := r.inventory.Nodes[index]
+	vars := r.inventory.NodeVars(index)
+
+	report := Report{
+		Node: node.Name,
+		Used: map[string]any{},
+	}
+
+	for _, name := range r.vars {
+		if value, ok := vars[name]; ok {
+			report.Used[name] = value
+		} else {
+			report.Unset = append(report.Unset, name)
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(node.Vars)) {
+		if !slices.Contains(r.vars, name) {
+			report.Unused = append(report.Unused, name)
+		}
+	}
+
+	patches := make([]configpatcher.Patch, 0, len(r.templates))
+
+	for _, tmpl := range r.templates {
+		var buf bytes.Buffer
+
+		if err := tmpl.Execute(&buf, vars); err != nil {
+			return nil, report, fmt.Errorf("node %q: %w", node.Name, err)
+		}
+
+		if bytes.Contains(buf.Bytes(), []byte(noValue)) {
+			return nil, report, fmt.Errorf("node %q: template %q: variables are not set: %s", node.Name, tmpl.Name(), strings.Join(report.Unset, ", "))
+		}
+
+		patch, err := configpatcher.LoadPatch(buf.Bytes())
+		if err != nil {
+			return nil, report, fmt.Errorf("node %q: template %q: %w", node.Name, tmpl.Name(), err)
+		}
+
+		patches = append(patches, patch)
+	}
+
+	return patches, report, nil
+}
+
+// collectVars collects the names of the variables accessed on the top-level data.
+//
+// The 'range' and 'with' change the data in their body, so only '$.var' is collected in there (dot is false).
+//
+//nolint:gocyclo
+func collectVars(node parse.Node, dot bool, vars map[string]struct{}) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+
+		for _, child := range n.Nodes {
+			collectVars(child, dot, vars)
+		}
+	case *parse.ActionNode:
+		collectVars(n.Pipe, dot, vars)
+	case *parse.IfNode:
+		collectVars(n.Pipe, dot, vars)
+		collectVars(n.List, dot, vars)
+		collectVars(n.ElseList, dot, vars)
+	case *parse.RangeNode:
+		collectVars(n.Pipe, dot, vars)
+		collectVars(n.List, false, vars)
+		collectVars(n.ElseList, dot, vars)
+	case *parse.WithNode:
+		collectVars(n.Pipe, dot, vars)
+		collectVars(n.List, false, vars)
+		collectVars(n.ElseList, dot, vars)
+	case *parse.TemplateNode:
+		collectVars(n.Pipe, dot, vars)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+
+		for _, cmd := range n.Cmds {
+			collectVars(cmd, dot, vars)
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			collectVars(arg, dot, vars)
+		}
+	case *parse.ChainNode:
+		collectVars(n.Node, dot, vars)
+	case *parse.FieldNode:
+		if dot {
+			vars[n.Ident[0]] = struct{}{}
+		}
+	case *parse.VariableNode:
+		if len(n.Ident) > 1 && n.Ident[0] == "$" {
+			vars[n.Ident[1]] = struct{}{}
+		}
+	}
+}
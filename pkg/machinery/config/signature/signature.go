@@ -0,0 +1,200 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package signature implements detached signatures of the machine configuration.
+//
+// The signature is calculated over the raw bytes of the machine configuration:
+//
+//   - Ed25519 keys sign the data directly;
+//   - ECDSA keys produce an ASN.1 signature of the SHA-256 digest of the data;
+//   - RSA keys produce a PKCS #1 v1.5 (or PSS) signature of the SHA-256 digest of the data.
+//
+// This matches the output of `openssl dgst -sha256 -sign` (ECDSA, RSA) and `openssl pkeyutl -sign -rawin` (Ed25519).
+// Signatures might be stored either raw or base64-encoded.
+package signature
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+// ErrVerificationFailed is returned when the signature doesn't match any of the trusted keys.
+var ErrVerificationFailed = errors.New("signature verification failed")
+
+// ParsePublicKeys parses PEM-encoded public keys (or certificates).
+func ParsePublicKeys(data []byte) ([]crypto.PublicKey, error) {
+	var keys []crypto.PublicKey
+
+	for {
+		var block *pem.Block
+
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+
+		switch block.Type {
+		case "PUBLIC KEY":
+			key, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse public key: %w", err)
+			}
+
+			keys = append(keys, key)
+		case "RSA PUBLIC KEY":
+			key, err := x509.ParsePKCS1PublicKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse RSA public key: %w", err)
+			}
+
+			keys = append(keys, key)
+		case "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse certificate: %w", err)
+			}
+
+			keys = append(keys, cert.PublicKey)
+		default:
+			return nil, fmt.Errorf("unsupported PEM block type %q", block.Type)
+		}
+	}
+
+	if len(bytes.TrimSpace(data)) > 0 {
+		return nil, errors.New("trailing data after PEM blocks")
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("no public keys found")
+	}
+
+	for _, key := range keys {
+		switch key.(type) {
+		case ed25519.PublicKey, *ecdsa.PublicKey, *rsa.PublicKey:
+		default:
+			return nil, fmt.Errorf("unsupported public key type %T", key)
+		}
+	}
+
+	return keys, nil
+}
+
+// ParsePrivateKey parses PEM-encoded private key to be used for signing.
+func ParsePrivateKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	var (
+		key any
+		err error
+	)
+
+	switch block.Type {
+	case "PRIVATE KEY", "ED25519 PRIVATE KEY": // Talos encodes Ed25519 keys as PKCS #8 with a custom block type
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q", block.Type)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	switch k := key.(type) {
+	case ed25519.PrivateKey:
+		return k, nil
+	case *ecdsa.PrivateKey:
+		return k, nil
+	case *rsa.PrivateKey:
+		return k, nil
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+}
+
+// Decode the signature which might be either raw or base64-encoded.
+func Decode(signature []byte) []byte {
+	trimmed := bytes.TrimSpace(signature)
+
+	decoded := make([]byte, base64.StdEncoding.DecodedLen(len(trimmed)))
+
+	n, err := base64.StdEncoding.Decode(decoded, trimmed)
+	if err != nil || n == 0 {
+		return signature
+	}
+
+	return decoded[:n]
+}
+
+// Verify the detached signature of the data against the trusted keys.
+//
+// Verification succeeds if the signature matches any of the keys.
+func Verify(data, signature []byte, keys []crypto.PublicKey) error {
+	signature = Decode(signature)
+	digest := sha256.Sum256(data)
+
+	for _, key := range keys {
+		switch k := key.(type) {
+		case ed25519.PublicKey:
+			if ed25519.Verify(k, data, signature) {
+				return nil
+			}
+		case *ecdsa.PublicKey:
+			if ecdsa.VerifyASN1(k, digest[:], signature) {
+				return nil
+			}
+		case *rsa.PublicKey:
+			if rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], signature) == nil {
+				return nil
+			}
+
+			if rsa.VerifyPSS(k, crypto.SHA256, digest[:], signature, nil) == nil {
+				return nil
+			}
+		}
+	}
+
+	return ErrVerificationFailed
+}
+
+// Sign the data with the signer, returning the base64-encoded detached signature.
+func Sign(data []byte, signer crypto.Signer) ([]byte, error) {
+	var (
+		signature []byte
+		err       error
+	)
+
+	switch signer.Public().(type) {
+	case ed25519.PublicKey:
+		signature, err = signer.Sign(rand.Reader, data, crypto.Hash(0))
+	case *ecdsa.PublicKey, *rsa.PublicKey:
+		digest := sha256.Sum256(data)
+
+		signature, err = signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	default:
+		return nil, fmt.Errorf("unsupported signer key type %T", signer.Public())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(base64.StdEncoding.EncodeToString(signature) + "\n"), nil
+}
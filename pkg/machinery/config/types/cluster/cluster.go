@@ -5,5 +5,5 @@
 // Package cluster provides cluster configuration documents.
 package cluster
 
-//go:generate go tool github.com/siderolabs/talos/tools/docgen -output cluster_doc.go cluster.go discovery_service.go discovery_identity.go
-//go:generate go tool github.com/siderolabs/deep-copy -type DiscoveryServiceConfigV1Alpha1 -type DiscoveryIdentityConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go .
+//go:generate go tool github.com/siderolabs/talos/tools/docgen -output cluster_doc.go cluster.go discovery_service.go discovery_identity.go etcd_maintenance.go embedded_discovery.go
+//go:generate go tool github.com/siderolabs/deep-copy -type DiscoveryServiceConfigV1Alpha1 -type DiscoveryIdentityConfigV1Alpha1 -type EtcdMaintenanceConfigV1Alpha1 -type EmbeddedDiscoveryConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go .
@@ -76,6 +76,152 @@ func (DiscoveryIdentityConfigV1Alpha1) Doc() *encoder.Doc {
 	return doc
 }
 
+func (EtcdMaintenanceConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EtcdMaintenanceConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EtcdMaintenanceConfig configures automatic etcd maintenance on the control plane nodes." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EtcdMaintenanceConfig configures automatic etcd maintenance on the control plane nodes.",
+		Fields: []encoder.Doc{
+			{
+				Type:   "Meta",
+				Inline: true,
+			},
+			{
+				Name:        "defragmentation",
+				Type:        "EtcdDefragmentationConfig",
+				Note:        "",
+				Description: "Automatic defragmentation settings.\n\nMembers are defragmented one at a time (cluster-wide), and the leader is defragmented\nonly after all followers are below the threshold.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Automatic defragmentation settings." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "quotaWarningThreshold",
+				Type:        "int",
+				Note:        "",
+				Description: "The percentage of the database quota which raises a diagnostic warning.\n\nDefault value is 80.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The percentage of the database quota which raises a diagnostic warning." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "checkInterval",
+				Type:        "Duration",
+				Note:        "",
+				Description: "The interval between etcd database checks.\n\nDefault value is 5 minutes, minimum value is 1 minute.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The interval between etcd database checks." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "window",
+				Type:        "EtcdMaintenanceWindow",
+				Note:        "",
+				Description: "The daily maintenance window for the defragmentation.\n\nIf not set, the defragmentation might run at any time.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The daily maintenance window for the defragmentation." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleEtcdMaintenanceConfigV1Alpha1())
+
+	return doc
+}
+
+func (EtcdDefragmentationConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EtcdDefragmentationConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EtcdDefragmentationConfig describes automatic etcd defragmentation." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EtcdDefragmentationConfig describes automatic etcd defragmentation.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "EtcdMaintenanceConfigV1Alpha1",
+				FieldName: "defragmentation",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "enabled",
+				Type:        "bool",
+				Note:        "",
+				Description: "Enable automatic defragmentation.\n\nDefaults to true.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Enable automatic defragmentation." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "threshold",
+				Type:        "int",
+				Note:        "",
+				Description: "The percentage of the unused space in the database (size vs. size in use)\nwhich triggers the defragmentation.\n\nDefault value is 50.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The percentage of the unused space in the database (size vs. size in use)" /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (EtcdMaintenanceWindow) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EtcdMaintenanceWindow",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EtcdMaintenanceWindow describes a daily maintenance window." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EtcdMaintenanceWindow describes a daily maintenance window.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "EtcdMaintenanceConfigV1Alpha1",
+				FieldName: "window",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "start",
+				Type:        "string",
+				Note:        "",
+				Description: "The start time of the window in UTC (HH:MM).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The start time of the window in UTC (HH:MM)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "duration",
+				Type:        "Duration",
+				Note:        "",
+				Description: "The duration of the window.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The duration of the window." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", "02:00")
+
+	return doc
+}
+
+func (EmbeddedDiscoveryConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EmbeddedDiscoveryConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EmbeddedDiscoveryConfig enables the discovery service embedded into the control plane nodes." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EmbeddedDiscoveryConfig enables the discovery service embedded into the control plane nodes.",
+		Fields: []encoder.Doc{
+			{
+				Type:   "Meta",
+				Inline: true,
+			},
+			{
+				Name:        "port",
+				Type:        "int",
+				Note:        "",
+				Description: "The port of the embedded discovery service.\n\nThe control plane nodes run the discovery service on this port and replicate the (encrypted) affiliate data\nbetween each other, and all nodes of the cluster (with this document in the machine configuration)\nuse the control plane nodes as the discovery service endpoints.\n\nDefault value is 50002.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The port of the embedded discovery service." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "endpoints",
+				Type:        "[]string",
+				Note:        "",
+				Description: "The addresses of the control plane nodes used as the discovery service endpoints.\n\nEach address is either a host or a host:port.\n\nIf not set, the host of the cluster control plane endpoint is used,\nand the control plane nodes behind it replicate the data between each other.\n\nThe embedded discovery service uses the Talos API certificate, the hosts of the endpoints are added\nto the certificate SANs of the control plane nodes.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The addresses of the control plane nodes used as the discovery service endpoints." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleEmbeddedDiscoveryConfigV1Alpha1())
+
+	doc.Fields[2].AddExample("", []string{"172.20.0.2", "172.20.0.3", "172.20.0.4"})
+
+	return doc
+}
+
 // GetFileDoc returns documentation for the file cluster_doc.go.
 func GetFileDoc() *encoder.FileDoc {
 	return &encoder.FileDoc{
@@ -84,6 +230,10 @@ func GetFileDoc() *encoder.FileDoc {
 		Structs: []*encoder.Doc{
 			DiscoveryServiceConfigV1Alpha1{}.Doc(),
 			DiscoveryIdentityConfigV1Alpha1{}.Doc(),
+			EtcdMaintenanceConfigV1Alpha1{}.Doc(),
+			EtcdDefragmentationConfig{}.Doc(),
+			EtcdMaintenanceWindow{}.Doc(),
+			EmbeddedDiscoveryConfigV1Alpha1{}.Doc(),
 		},
 	}
 }
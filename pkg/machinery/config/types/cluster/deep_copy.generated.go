@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type DiscoveryServiceConfigV1Alpha1 -type DiscoveryIdentityConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type DiscoveryServiceConfigV1Alpha1 -type DiscoveryIdentityConfigV1Alpha1 -type EtcdMaintenanceConfigV1Alpha1 -type EmbeddedDiscoveryConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package cluster
 
@@ -29,3 +29,27 @@ func (o *DiscoveryIdentityConfigV1Alpha1) DeepCopy() *DiscoveryIdentityConfigV1A
 	var cp DiscoveryIdentityConfigV1Alpha1 = *o
 	return &cp
 }
+
+// DeepCopy generates a deep copy of *EtcdMaintenanceConfigV1Alpha1.
+func (o *EtcdMaintenanceConfigV1Alpha1) DeepCopy() *EtcdMaintenanceConfigV1Alpha1 {
+	var cp EtcdMaintenanceConfigV1Alpha1 = *o
+	if o.Defragmentation.DefragmentationEnabled != nil {
+		cp.Defragmentation.DefragmentationEnabled = new(bool)
+		*cp.Defragmentation.DefragmentationEnabled = *o.Defragmentation.DefragmentationEnabled
+	}
+	if o.MaintenanceWindow != nil {
+		cp.MaintenanceWindow = new(EtcdMaintenanceWindow)
+		*cp.MaintenanceWindow = *o.MaintenanceWindow
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *EmbeddedDiscoveryConfigV1Alpha1.
+func (o *EmbeddedDiscoveryConfigV1Alpha1) DeepCopy() *EmbeddedDiscoveryConfigV1Alpha1 {
+	var cp EmbeddedDiscoveryConfigV1Alpha1 = *o
+	if o.ServiceEndpoints != nil {
+		cp.ServiceEndpoints = make([]string, len(o.ServiceEndpoints))
+		copy(cp.ServiceEndpoints, o.ServiceEndpoints)
+	}
+	return &cp
+}
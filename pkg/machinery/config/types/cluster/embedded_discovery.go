@@ -0,0 +1,191 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// EmbeddedDiscoveryKind is an embedded discovery service config document kind.
+const EmbeddedDiscoveryKind = "EmbeddedDiscoveryConfig"
+
+func init() {
+	registry.Register(EmbeddedDiscoveryKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &EmbeddedDiscoveryConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.EmbeddedDiscoveryConfig = &EmbeddedDiscoveryConfigV1Alpha1{}
+	_ config.Validator               = &EmbeddedDiscoveryConfigV1Alpha1{}
+)
+
+// EmbeddedDiscoveryConfigV1Alpha1 enables the discovery service embedded into the control plane nodes.
+//
+//	examples:
+//	  - value: exampleEmbeddedDiscoveryConfigV1Alpha1()
+//	alias: EmbeddedDiscoveryConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/EmbeddedDiscoveryConfig
+type EmbeddedDiscoveryConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     The port of the embedded discovery service.
+	//
+	//     The control plane nodes run the discovery service on this port and replicate the (encrypted) affiliate data
+	//     between each other, and all nodes of the cluster (with this document in the machine configuration)
+	//     use the control plane nodes as the discovery service endpoints.
+	//
+	//     Default value is 50002.
+	ServicePort int `yaml:"port,omitempty"`
+	//   description: |
+	//     The addresses of the control plane nodes used as the discovery service endpoints.
+	//
+	//     Each address is either a host or a host:port.
+	//
+	//     If not set, the host of the cluster control plane endpoint is used,
+	//     and the control plane nodes behind it replicate the data between each other.
+	//
+	//     The embedded discovery service uses the Talos API certificate, the hosts of the endpoints are added
+	//     to the certificate SANs of the control plane nodes.
+	//   examples:
+	//     - value: >
+	//        []string{"172.20.0.2", "172.20.0.3", "172.20.0.4"}
+	ServiceEndpoints []string `yaml:"endpoints,omitempty"`
+}
+
+// NewEmbeddedDiscoveryConfigV1Alpha1 creates a new embedded discovery service config document.
+func NewEmbeddedDiscoveryConfigV1Alpha1() *EmbeddedDiscoveryConfigV1Alpha1 {
+	return &EmbeddedDiscoveryConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       EmbeddedDiscoveryKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleEmbeddedDiscoveryConfigV1Alpha1() *EmbeddedDiscoveryConfigV1Alpha1 {
+	cfg := NewEmbeddedDiscoveryConfigV1Alpha1()
+	cfg.ServiceEndpoints = []string{"172.20.0.2", "172.20.0.3", "172.20.0.4"}
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *EmbeddedDiscoveryConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Port implements config.EmbeddedDiscoveryConfig interface.
+func (s *EmbeddedDiscoveryConfigV1Alpha1) Port() int {
+	if s.ServicePort == 0 {
+		return constants.EmbeddedDiscoveryPort
+	}
+
+	return s.ServicePort
+}
+
+// Endpoints implements config.EmbeddedDiscoveryConfig interface.
+func (s *EmbeddedDiscoveryConfigV1Alpha1) Endpoints() []string {
+	return s.ServiceEndpoints
+}
+
+// Validate implements config.Validator interface.
+func (s *EmbeddedDiscoveryConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	if s.ServicePort < 0 || s.ServicePort > 65535 {
+		errs = errors.Join(errs, fmt.Errorf("port: must be between 1 and 65535, got %d", s.ServicePort))
+	}
+
+	for _, endpoint := range s.ServiceEndpoints {
+		if _, err := EmbeddedDiscoveryEndpoint(endpoint, s.Port()); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("endpoints: %w", err))
+		}
+	}
+
+	return nil, errs
+}
+
+// EmbeddedDiscoveryEndpoints returns the normalized (host:port) embedded discovery service endpoints.
+//
+// If the endpoints are not set, the host of the cluster control plane endpoint is used.
+// If the embedded discovery service is not enabled, nil is returned.
+func EmbeddedDiscoveryEndpoints(cfg config.Config) ([]string, error) {
+	embeddedCfg := cfg.EmbeddedDiscoveryConfig()
+	if embeddedCfg == nil {
+		return nil, nil
+	}
+
+	endpoints := embeddedCfg.Endpoints()
+
+	if len(endpoints) == 0 {
+		if cfg.K8sClusterConfig() == nil || cfg.K8sClusterConfig().ClusterEndpoint() == nil {
+			return nil, errors.New("embedded discovery service requires either endpoints or the cluster control plane endpoint")
+		}
+
+		endpoints = []string{cfg.K8sClusterConfig().ClusterEndpoint().Hostname()}
+	}
+
+	result := make([]string, 0, len(endpoints))
+
+	for _, endpoint := range endpoints {
+		normalizedEndpoint, err := EmbeddedDiscoveryEndpoint(endpoint, embeddedCfg.Port())
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, normalizedEndpoint)
+	}
+
+	return result, nil
+}
+
+// EmbeddedDiscoveryEndpoint normalizes the embedded discovery service endpoint into a host:port address.
+func EmbeddedDiscoveryEndpoint(endpoint string, defaultPort int) (string, error) {
+	if endpoint == "" {
+		return "", errors.New("endpoint is empty")
+	}
+
+	host, port, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		// no port, or an IPv6 address without brackets
+		if _, addrErr := netip.ParseAddr(endpoint); strings.Contains(endpoint, ":") && addrErr != nil {
+			return "", fmt.Errorf("invalid endpoint %q: %w", endpoint, err)
+		}
+
+		host, port = endpoint, strconv.Itoa(defaultPort)
+	}
+
+	if host == "" {
+		return "", fmt.Errorf("invalid endpoint %q: host is required", endpoint)
+	}
+
+	if p, err := strconv.Atoi(port); err != nil || p < 1 || p > 65535 {
+		return "", fmt.Errorf("invalid endpoint %q: invalid port %q", endpoint, port)
+	}
+
+	return net.JoinHostPort(host, port), nil
+}
@@ -0,0 +1,136 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster_test
+
+import (
+	_ "embed"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/cluster"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+//go:embed testdata/embeddeddiscoveryconfig.yaml
+var expectedEmbeddedDiscoveryConfigDocument []byte
+
+func TestEmbeddedDiscoveryConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := cluster.NewEmbeddedDiscoveryConfigV1Alpha1()
+	cfg.ServicePort = 3000
+	cfg.ServiceEndpoints = []string{"172.20.0.2", "cp.example.com:3001"}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedEmbeddedDiscoveryConfigDocument, marshaled)
+}
+
+func TestEmbeddedDiscoveryConfigDefaults(t *testing.T) {
+	t.Parallel()
+
+	cfg := cluster.NewEmbeddedDiscoveryConfigV1Alpha1()
+
+	assert.Equal(t, constants.EmbeddedDiscoveryPort, cfg.Port())
+	assert.Empty(t, cfg.Endpoints())
+}
+
+func TestEmbeddedDiscoveryEndpoint(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		endpoint string
+		expected string
+	}{
+		{"172.20.0.2", "172.20.0.2:50002"},
+		{"172.20.0.2:3000", "172.20.0.2:3000"},
+		{"cp.example.com", "cp.example.com:50002"},
+		{"2001:db8::1", "[2001:db8::1]:50002"},
+		{"[2001:db8::1]:3000", "[2001:db8::1]:3000"},
+	} {
+		actual, err := cluster.EmbeddedDiscoveryEndpoint(test.endpoint, constants.EmbeddedDiscoveryPort)
+		require.NoError(t, err, test.endpoint)
+
+		assert.Equal(t, test.expected, actual)
+	}
+
+	for _, endpoint := range []string{"", ":3000", "cp.example.com:0", "cp.example.com:http", "a:b:c"} {
+		_, err := cluster.EmbeddedDiscoveryEndpoint(endpoint, constants.EmbeddedDiscoveryPort)
+		assert.Error(t, err, endpoint)
+	}
+}
+
+func TestEmbeddedDiscoveryConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	cfg := cluster.NewEmbeddedDiscoveryConfigV1Alpha1()
+	cfg.ServiceEndpoints = []string{"172.20.0.2"}
+
+	_, err := cfg.Validate(nil)
+	require.NoError(t, err)
+
+	cfg.ServicePort = 70000
+	cfg.ServiceEndpoints = []string{"172.20.0.2", ""}
+
+	_, err = cfg.Validate(nil)
+	assert.ErrorContains(t, err, "port: must be between 1 and 65535, got 70000")
+	assert.ErrorContains(t, err, "endpoints: endpoint is empty")
+}
+
+func TestEmbeddedDiscoveryEndpoints(t *testing.T) {
+	t.Parallel()
+
+	clusterCfg := &v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			ControlPlane: &v1alpha1.ControlPlaneConfig{
+				Endpoint: &v1alpha1.Endpoint{
+					URL: &url.URL{Scheme: "https", Host: "cp.example.com:6443"},
+				},
+			},
+		},
+	}
+
+	cfg, err := container.New(clusterCfg)
+	require.NoError(t, err)
+
+	endpoints, err := cluster.EmbeddedDiscoveryEndpoints(cfg)
+	require.NoError(t, err)
+	assert.Nil(t, endpoints)
+
+	embeddedCfg := cluster.NewEmbeddedDiscoveryConfigV1Alpha1()
+
+	cfg, err = container.New(clusterCfg, embeddedCfg)
+	require.NoError(t, err)
+
+	endpoints, err = cluster.EmbeddedDiscoveryEndpoints(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cp.example.com:50002"}, endpoints)
+
+	embeddedCfg.ServicePort = 3000
+	embeddedCfg.ServiceEndpoints = []string{"172.20.0.2", "172.20.0.3:3001"}
+
+	cfg, err = container.New(clusterCfg, embeddedCfg)
+	require.NoError(t, err)
+
+	endpoints, err = cluster.EmbeddedDiscoveryEndpoints(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"172.20.0.2:3000", "172.20.0.3:3001"}, endpoints)
+
+	cfg, err = container.New(cluster.NewEmbeddedDiscoveryConfigV1Alpha1())
+	require.NoError(t, err)
+
+	_, err = cluster.EmbeddedDiscoveryEndpoints(cfg)
+	assert.ErrorContains(t, err, "embedded discovery service requires either endpoints or the cluster control plane endpoint")
+}
@@ -0,0 +1,243 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/schedule"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// EtcdMaintenanceKind is an etcd maintenance config document kind.
+const EtcdMaintenanceKind = "EtcdMaintenanceConfig"
+
+func init() {
+	registry.Register(EtcdMaintenanceKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &EtcdMaintenanceConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.EtcdMaintenanceConfig = &EtcdMaintenanceConfigV1Alpha1{}
+	_ config.Validator             = &EtcdMaintenanceConfigV1Alpha1{}
+)
+
+// Etcd maintenance defaults.
+const (
+	DefaultEtcdDefragmentationThreshold = 50
+	DefaultEtcdQuotaWarningThreshold    = 80
+
+	MinEtcdMaintenanceCheckInterval     = time.Minute
+	DefaultEtcdMaintenanceCheckInterval = 5 * time.Minute
+)
+
+// EtcdMaintenanceConfigV1Alpha1 configures automatic etcd maintenance on the control plane nodes.
+//
+//	examples:
+//	  - value: exampleEtcdMaintenanceConfigV1Alpha1()
+//	alias: EtcdMaintenanceConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/EtcdMaintenanceConfig
+type EtcdMaintenanceConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     Automatic defragmentation settings.
+	//
+	//     Members are defragmented one at a time (cluster-wide), and the leader is defragmented
+	//     only after all followers are below the threshold.
+	Defragmentation EtcdDefragmentationConfig `yaml:"defragmentation,omitempty"`
+	//   description: |
+	//     The percentage of the database quota which raises a diagnostic warning.
+	//
+	//     Default value is 80.
+	QuotaWarningThresholdPercent int `yaml:"quotaWarningThreshold,omitempty"`
+	//   description: |
+	//     The interval between etcd database checks.
+	//
+	//     Default value is 5 minutes, minimum value is 1 minute.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	MaintenanceCheckInterval time.Duration `yaml:"checkInterval,omitempty"`
+	//   description: |
+	//     The daily maintenance window for the defragmentation.
+	//
+	//     If not set, the defragmentation might run at any time.
+	MaintenanceWindow *EtcdMaintenanceWindow `yaml:"window,omitempty"`
+}
+
+// EtcdDefragmentationConfig describes automatic etcd defragmentation.
+type EtcdDefragmentationConfig struct {
+	//   description: |
+	//     Enable automatic defragmentation.
+	//
+	//     Defaults to true.
+	DefragmentationEnabled *bool `yaml:"enabled,omitempty"`
+	//   description: |
+	//     The percentage of the unused space in the database (size vs. size in use)
+	//     which triggers the defragmentation.
+	//
+	//     Default value is 50.
+	DefragmentationThreshold int `yaml:"threshold,omitempty"`
+}
+
+// EtcdMaintenanceWindow describes a daily maintenance window.
+type EtcdMaintenanceWindow struct {
+	//   description: |
+	//     The start time of the window in UTC (HH:MM).
+	//   examples:
+	//     - value: >
+	//        "02:00"
+	//   schema:
+	//     type: string
+	//     pattern: ^([01]\d|2[0-3]):[0-5]\d$
+	WindowStart string `yaml:"start"`
+	//   description: |
+	//     The duration of the window.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	WindowDuration time.Duration `yaml:"duration"`
+}
+
+// NewEtcdMaintenanceConfigV1Alpha1 creates a new etcd maintenance config document.
+func NewEtcdMaintenanceConfigV1Alpha1() *EtcdMaintenanceConfigV1Alpha1 {
+	return &EtcdMaintenanceConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       EtcdMaintenanceKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleEtcdMaintenanceConfigV1Alpha1() *EtcdMaintenanceConfigV1Alpha1 {
+	cfg := NewEtcdMaintenanceConfigV1Alpha1()
+	cfg.Defragmentation.DefragmentationThreshold = 40
+	cfg.QuotaWarningThresholdPercent = 75
+	cfg.MaintenanceWindow = &EtcdMaintenanceWindow{
+		WindowStart:    "02:00",
+		WindowDuration: 4 * time.Hour,
+	}
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *EtcdMaintenanceConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// DefragmentationEnabled implements config.EtcdMaintenanceConfig interface.
+func (s *EtcdMaintenanceConfigV1Alpha1) DefragmentationEnabled() bool {
+	if s.Defragmentation.DefragmentationEnabled == nil {
+		return true
+	}
+
+	return *s.Defragmentation.DefragmentationEnabled
+}
+
+// DefragmentationThreshold implements config.EtcdMaintenanceConfig interface.
+func (s *EtcdMaintenanceConfigV1Alpha1) DefragmentationThreshold() float64 {
+	if s.Defragmentation.DefragmentationThreshold == 0 {
+		return DefaultEtcdDefragmentationThreshold / 100.0
+	}
+
+	return float64(s.Defragmentation.DefragmentationThreshold) / 100.0
+}
+
+// QuotaWarningThreshold implements config.EtcdMaintenanceConfig interface.
+func (s *EtcdMaintenanceConfigV1Alpha1) QuotaWarningThreshold() float64 {
+	if s.QuotaWarningThresholdPercent == 0 {
+		return DefaultEtcdQuotaWarningThreshold / 100.0
+	}
+
+	return float64(s.QuotaWarningThresholdPercent) / 100.0
+}
+
+// CheckInterval implements config.EtcdMaintenanceConfig interface.
+func (s *EtcdMaintenanceConfigV1Alpha1) CheckInterval() time.Duration {
+	if s.MaintenanceCheckInterval == 0 {
+		return DefaultEtcdMaintenanceCheckInterval
+	}
+
+	return s.MaintenanceCheckInterval
+}
+
+// InMaintenanceWindow implements config.EtcdMaintenanceConfig interface.
+func (s *EtcdMaintenanceConfigV1Alpha1) InMaintenanceWindow(t time.Time) bool {
+	if s.MaintenanceWindow == nil {
+		return true
+	}
+
+	window, err := s.MaintenanceWindow.parse()
+	if err != nil {
+		return false
+	}
+
+	return window.Contains(t)
+}
+
+// Validate implements config.Validator interface.
+func (s *EtcdMaintenanceConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	if s.Defragmentation.DefragmentationThreshold < 0 || s.Defragmentation.DefragmentationThreshold > 100 {
+		errs = errors.Join(errs, fmt.Errorf("defragmentation.threshold: must be between 1 and 100, got %d", s.Defragmentation.DefragmentationThreshold))
+	}
+
+	if s.QuotaWarningThresholdPercent < 0 || s.QuotaWarningThresholdPercent > 100 {
+		errs = errors.Join(errs, fmt.Errorf("quotaWarningThreshold: must be between 1 and 100, got %d", s.QuotaWarningThresholdPercent))
+	}
+
+	if s.MaintenanceCheckInterval != 0 && s.MaintenanceCheckInterval < MinEtcdMaintenanceCheckInterval {
+		errs = errors.Join(errs, fmt.Errorf("checkInterval: minimum value is %s", MinEtcdMaintenanceCheckInterval))
+	}
+
+	if s.MaintenanceWindow != nil {
+		if _, err := s.MaintenanceWindow.parse(); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("window.start: invalid time %q, expected HH:MM", s.MaintenanceWindow.WindowStart))
+		}
+
+		if s.MaintenanceWindow.WindowDuration <= 0 || s.MaintenanceWindow.WindowDuration > 24*time.Hour {
+			errs = errors.Join(errs, errors.New("window.duration: must be between 0 and 24h"))
+		}
+	}
+
+	return nil, errs
+}
+
+// parse converts the window into a daily schedule in UTC.
+func (window *EtcdMaintenanceWindow) parse() (schedule.Window, error) {
+	start, err := time.Parse("15:04", window.WindowStart)
+	if err != nil {
+		return schedule.Window{}, err
+	}
+
+	sched, err := schedule.Parse(fmt.Sprintf("%d %d * * *", start.Minute(), start.Hour()))
+	if err != nil {
+		return schedule.Window{}, err
+	}
+
+	return schedule.Window{
+		Schedule: sched,
+		Duration: window.WindowDuration,
+		Location: time.UTC,
+	}, nil
+}
@@ -0,0 +1,131 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster_test
+
+import (
+	_ "embed"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/cluster"
+)
+
+//go:embed testdata/etcdmaintenanceconfig.yaml
+var expectedEtcdMaintenanceConfigDocument []byte
+
+func TestEtcdMaintenanceConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := cluster.NewEtcdMaintenanceConfigV1Alpha1()
+	cfg.Defragmentation.DefragmentationThreshold = 40
+	cfg.QuotaWarningThresholdPercent = 75
+	cfg.MaintenanceCheckInterval = 10 * time.Minute
+	cfg.MaintenanceWindow = &cluster.EtcdMaintenanceWindow{
+		WindowStart:    "22:30",
+		WindowDuration: 4 * time.Hour,
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedEtcdMaintenanceConfigDocument, marshaled)
+}
+
+func TestEtcdMaintenanceConfigDefaults(t *testing.T) {
+	t.Parallel()
+
+	cfg := cluster.NewEtcdMaintenanceConfigV1Alpha1()
+
+	assert.True(t, cfg.DefragmentationEnabled())
+	assert.InDelta(t, 0.5, cfg.DefragmentationThreshold(), 1e-9)
+	assert.InDelta(t, 0.8, cfg.QuotaWarningThreshold(), 1e-9)
+	assert.Equal(t, cluster.DefaultEtcdMaintenanceCheckInterval, cfg.CheckInterval())
+	assert.True(t, cfg.InMaintenanceWindow(time.Now()))
+}
+
+func TestEtcdMaintenanceConfigWindow(t *testing.T) {
+	t.Parallel()
+
+	cfg := cluster.NewEtcdMaintenanceConfigV1Alpha1()
+	cfg.MaintenanceWindow = &cluster.EtcdMaintenanceWindow{
+		WindowStart:    "22:30",
+		WindowDuration: 4 * time.Hour,
+	}
+
+	for _, test := range []struct {
+		time     string
+		expected bool
+	}{
+		{"2026-03-01T22:29:59Z", false},
+		{"2026-03-01T22:30:00Z", true},
+		{"2026-03-01T23:59:00Z", true},
+		{"2026-03-02T02:29:59Z", true},
+		{"2026-03-02T02:30:00Z", false},
+		{"2026-03-02T12:00:00Z", false},
+		{"2026-03-02T01:00:00+02:00", true},
+	} {
+		t.Run(test.time, func(t *testing.T) {
+			t.Parallel()
+
+			ts, err := time.Parse(time.RFC3339, test.time)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, cfg.InMaintenanceWindow(ts))
+		})
+	}
+}
+
+func TestEtcdMaintenanceConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *cluster.EtcdMaintenanceConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  cluster.NewEtcdMaintenanceConfigV1Alpha1,
+		},
+		{
+			name: "invalid",
+			cfg: func() *cluster.EtcdMaintenanceConfigV1Alpha1 {
+				cfg := cluster.NewEtcdMaintenanceConfigV1Alpha1()
+				cfg.Defragmentation.DefragmentationThreshold = 101
+				cfg.QuotaWarningThresholdPercent = -1
+				cfg.MaintenanceCheckInterval = time.Second
+				cfg.MaintenanceWindow = &cluster.EtcdMaintenanceWindow{
+					WindowStart: "25:00",
+				}
+
+				return cfg
+			},
+
+			expectedError: "defragmentation.threshold: must be between 1 and 100, got 101\nquotaWarningThreshold: must be between 1 and 100, got -1\n" +
+				"checkInterval: minimum value is 1m0s\nwindow.start: invalid time \"25:00\", expected HH:MM\nwindow.duration: must be between 0 and 24h",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, err := test.cfg().Validate(validationMode{})
+
+			assert.Empty(t, warnings)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
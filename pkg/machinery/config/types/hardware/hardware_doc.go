@@ -8,6 +8,7 @@ package hardware
 
 import (
 	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 )
 
 func (PCIDriverRebindConfigV1Alpha1) Doc() *encoder.Doc {
@@ -42,6 +43,175 @@ func (PCIDriverRebindConfigV1Alpha1) Doc() *encoder.Doc {
 	return doc
 }
 
+func (SRIOVConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "SRIOVConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "SRIOVConfig configures SR-IOV virtual functions of PCI physical functions." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "SRIOVConfig configures SR-IOV virtual functions of PCI physical functions.\nThe physical functions are selected either by the link name, or with a CEL expression over PCI devices.\nVirtual functions bound to a network driver appear as links, and virtual functions of each physical function\nare reported in the `SRIOVStatus` resource.\n",
+		Fields: []encoder.Doc{
+			{
+				Type:   "Meta",
+				Inline: true,
+			},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the config document.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the config document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "physicalFunction",
+				Type:        "SRIOVPhysicalFunctionConfig",
+				Note:        "",
+				Description: "Physical functions to create virtual functions on.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Physical functions to create virtual functions on." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "numVFs",
+				Type:        "int",
+				Note:        "",
+				Description: "Number of virtual functions to create on each physical function.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of virtual functions to create on each physical function." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "driver",
+				Type:        "string",
+				Note:        "",
+				Description: "Driver to bind the virtual functions to (e.g. `vfio-pci`).\n\nIf not set, the default driver of the virtual function is used.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Driver to bind the virtual functions to (e.g. `vfio-pci`)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "vfs",
+				Type:        "[]SRIOVVirtualFunctionConfig",
+				Note:        "",
+				Description: "Configuration of the individual virtual functions.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Configuration of the individual virtual functions." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleSRIOVConfigV1Alpha1Link())
+
+	doc.AddExample("", exampleSRIOVConfigV1Alpha1Selector())
+
+	return doc
+}
+
+func (SRIOVPhysicalFunctionConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "SRIOVPhysicalFunctionConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "SRIOVPhysicalFunctionConfig selects the physical functions." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "SRIOVPhysicalFunctionConfig selects the physical functions.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "SRIOVConfigV1Alpha1",
+				FieldName: "physicalFunction",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "link",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the link of the physical function.\n\nLink aliases can be used as well.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the link of the physical function." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "pciDeviceSelector",
+				Type:        "PCIDeviceSelector",
+				Note:        "",
+				Description: "Selector to match the PCI devices of the physical functions.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Selector to match the PCI devices of the physical functions." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (PCIDeviceSelector) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "PCIDeviceSelector",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "PCIDeviceSelector selects PCI devices." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "PCIDeviceSelector selects PCI devices.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "SRIOVPhysicalFunctionConfig",
+				FieldName: "pciDeviceSelector",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "match",
+				Type:        "Expression",
+				Note:        "",
+				Description: "The Common Expression Language (CEL) expression to match the PCI device.\n\nThe PCI device is available as `pci_device`, and its PCI address as `pci_address`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The Common Expression Language (CEL) expression to match the PCI device." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("match PCI devices by vendor and product", examplePCIDeviceSelector())
+
+	return doc
+}
+
+func (SRIOVVirtualFunctionConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "SRIOVVirtualFunctionConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "SRIOVVirtualFunctionConfig configures a virtual function." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "SRIOVVirtualFunctionConfig configures a virtual function.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "SRIOVConfigV1Alpha1",
+				FieldName: "vfs",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "index",
+				Type:        "int",
+				Note:        "",
+				Description: "Index of the virtual function (starting from 0).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Index of the virtual function (starting from 0)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "hardwareAddr",
+				Type:        "HardwareAddr",
+				Note:        "",
+				Description: "Hardware (MAC) address of the virtual function.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Hardware (MAC) address of the virtual function." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "vlanID",
+				Type:        "uint16",
+				Note:        "",
+				Description: "VLAN ID to tag the traffic of the virtual function with (transparent to the virtual function).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "VLAN ID to tag the traffic of the virtual function with (transparent to the virtual function)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "trust",
+				Type:        "bool",
+				Note:        "",
+				Description: "Allow the virtual function to change its MAC address and enable promiscuous mode.\n\nDefaults to false.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Allow the virtual function to change its MAC address and enable promiscuous mode." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "spoofCheck",
+				Type:        "bool",
+				Note:        "",
+				Description: "Drop the traffic from the virtual function with a spoofed source MAC address.\n\nDefaults to true.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Drop the traffic from the virtual function with a spoofed source MAC address." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[1].AddExample("", nethelpers.HardwareAddr{0x2e, 0x3c, 0x4d, 0x5e, 0x6f, 0x70})
+
+	return doc
+}
+
 // GetFileDoc returns documentation for the file hardware_doc.go.
 func GetFileDoc() *encoder.FileDoc {
 	return &encoder.FileDoc{
@@ -49,6 +219,10 @@ func GetFileDoc() *encoder.FileDoc {
 		Description: "Package hardware provides hardware related config documents.\n",
 		Structs: []*encoder.Doc{
 			PCIDriverRebindConfigV1Alpha1{}.Doc(),
+			SRIOVConfigV1Alpha1{}.Doc(),
+			SRIOVPhysicalFunctionConfig{}.Doc(),
+			PCIDeviceSelector{}.Doc(),
+			SRIOVVirtualFunctionConfig{}.Doc(),
 		},
 	}
 }
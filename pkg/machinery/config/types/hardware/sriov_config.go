@@ -0,0 +1,310 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardware
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/siderolabs/gen/optional"
+
+	"github.com/siderolabs/talos/pkg/machinery/cel"
+	"github.com/siderolabs/talos/pkg/machinery/cel/celenv"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+//docgen:jsonschema
+
+// SRIOVConfig defines the SRIOVConfig configuration name.
+const SRIOVConfig = "SRIOVConfig"
+
+func init() {
+	registry.Register(SRIOVConfig, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &SRIOVConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.SRIOVConfig                = &SRIOVConfigV1Alpha1{}
+	_ config.SRIOVVirtualFunctionConfig = &SRIOVVirtualFunctionConfig{}
+	_ config.NamedDocument              = &SRIOVConfigV1Alpha1{}
+	_ config.Validator                  = &SRIOVConfigV1Alpha1{}
+)
+
+// maxVLANID is the maximum VLAN ID which can be assigned to the virtual function.
+const maxVLANID = 4094
+
+// SRIOVConfigV1Alpha1 configures SR-IOV virtual functions of PCI physical functions.
+//
+//	description: |
+//	  The physical functions are selected either by the link name, or with a CEL expression over PCI devices.
+//	  Virtual functions bound to a network driver appear as links, and virtual functions of each physical function
+//	  are reported in the `SRIOVStatus` resource.
+//	examples:
+//	  - value: exampleSRIOVConfigV1Alpha1Link()
+//	  - value: exampleSRIOVConfigV1Alpha1Selector()
+//	alias: SRIOVConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/SRIOVConfig
+type SRIOVConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     Name of the config document.
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     Physical functions to create virtual functions on.
+	//   schemaRequired: true
+	PhysicalFunction SRIOVPhysicalFunctionConfig `yaml:"physicalFunction"`
+	//   description: |
+	//     Number of virtual functions to create on each physical function.
+	//   schemaRequired: true
+	SRIOVNumVFs int `yaml:"numVFs"`
+	//   description: |
+	//     Driver to bind the virtual functions to (e.g. `vfio-pci`).
+	//
+	//     If not set, the default driver of the virtual function is used.
+	SRIOVDriver string `yaml:"driver,omitempty"`
+	//   description: |
+	//     Configuration of the individual virtual functions.
+	SRIOVVFs []SRIOVVirtualFunctionConfig `yaml:"vfs,omitempty"`
+}
+
+// SRIOVPhysicalFunctionConfig selects the physical functions.
+type SRIOVPhysicalFunctionConfig struct {
+	//   description: |
+	//     Name of the link of the physical function.
+	//
+	//     Link aliases can be used as well.
+	PFLink string `yaml:"link,omitempty"`
+	//   description: |
+	//     Selector to match the PCI devices of the physical functions.
+	PFSelector PCIDeviceSelector `yaml:"pciDeviceSelector,omitempty"`
+}
+
+// PCIDeviceSelector selects PCI devices.
+type PCIDeviceSelector struct {
+	//   description: |
+	//     The Common Expression Language (CEL) expression to match the PCI device.
+	//
+	//     The PCI device is available as `pci_device`, and its PCI address as `pci_address`.
+	//   schema:
+	//     type: string
+	//   examples:
+	//    - value: >
+	//        examplePCIDeviceSelector()
+	//      name: match PCI devices by vendor and product
+	Match cel.Expression `yaml:"match,omitempty"`
+}
+
+// SRIOVVirtualFunctionConfig configures a virtual function.
+type SRIOVVirtualFunctionConfig struct {
+	//   description: |
+	//     Index of the virtual function (starting from 0).
+	//   schemaRequired: true
+	VFIndex int `yaml:"index"`
+	//   description: |
+	//     Hardware (MAC) address of the virtual function.
+	//
+	//   examples:
+	//    - value: >
+	//       nethelpers.HardwareAddr{0x2e, 0x3c, 0x4d, 0x5e, 0x6f, 0x70}
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9a-f:]+$
+	VFHardwareAddress nethelpers.HardwareAddr `yaml:"hardwareAddr,omitempty"`
+	//   description: |
+	//     VLAN ID to tag the traffic of the virtual function with (transparent to the virtual function).
+	VFVLANID uint16 `yaml:"vlanID,omitempty"`
+	//   description: |
+	//     Allow the virtual function to change its MAC address and enable promiscuous mode.
+	//
+	//     Defaults to false.
+	VFTrust *bool `yaml:"trust,omitempty"`
+	//   description: |
+	//     Drop the traffic from the virtual function with a spoofed source MAC address.
+	//
+	//     Defaults to true.
+	VFSpoofCheck *bool `yaml:"spoofCheck,omitempty"`
+}
+
+// NewSRIOVConfigV1Alpha1 creates a new SRIOVConfig config document.
+func NewSRIOVConfigV1Alpha1(name string) *SRIOVConfigV1Alpha1 {
+	return &SRIOVConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       SRIOVConfig,
+			MetaAPIVersion: "v1alpha1",
+		},
+		MetaName: name,
+	}
+}
+
+func exampleSRIOVConfigV1Alpha1Link() *SRIOVConfigV1Alpha1 {
+	cfg := NewSRIOVConfigV1Alpha1("enp4s0f0-vfs")
+	cfg.PhysicalFunction.PFLink = "enp4s0f0"
+	cfg.SRIOVNumVFs = 4
+	cfg.SRIOVVFs = []SRIOVVirtualFunctionConfig{
+		{
+			VFIndex:           0,
+			VFHardwareAddress: nethelpers.HardwareAddr{0x2e, 0x3c, 0x4d, 0x5e, 0x6f, 0x70},
+			VFVLANID:          100,
+			VFTrust:           new(true),
+		},
+	}
+
+	return cfg
+}
+
+func exampleSRIOVConfigV1Alpha1Selector() *SRIOVConfigV1Alpha1 {
+	cfg := NewSRIOVConfigV1Alpha1("vfio")
+	cfg.PhysicalFunction.PFSelector.Match = examplePCIDeviceSelector()
+	cfg.SRIOVNumVFs = 8
+	cfg.SRIOVDriver = "vfio-pci"
+
+	return cfg
+}
+
+func examplePCIDeviceSelector() cel.Expression {
+	return cel.MustExpression(cel.ParseBooleanExpression(`pci_device.vendor_id == "0x8086" && pci_device.product_id == "0x1593"`, celenv.PCIDeviceLocator()))
+}
+
+// Clone implements config.Document interface.
+func (s *SRIOVConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Name implements config.NamedDocument interface.
+func (s *SRIOVConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Validate implements config.Validator interface.
+//
+//nolint:gocyclo
+func (s *SRIOVConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	if s.MetaName == "" {
+		errs = errors.Join(errs, errors.New("name is required"))
+	}
+
+	switch {
+	case s.PhysicalFunction.PFLink == "" && s.PhysicalFunction.PFSelector.Match.IsZero():
+		errs = errors.Join(errs, errors.New("physicalFunction: one of link or pciDeviceSelector is required"))
+	case s.PhysicalFunction.PFLink != "" && !s.PhysicalFunction.PFSelector.Match.IsZero():
+		errs = errors.Join(errs, errors.New("physicalFunction: link and pciDeviceSelector are mutually exclusive"))
+	case !s.PhysicalFunction.PFSelector.Match.IsZero():
+		if err := s.PhysicalFunction.PFSelector.Match.ParseBool(celenv.PCIDeviceLocator()); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("physicalFunction: pciDeviceSelector is invalid: %w", err))
+		}
+	}
+
+	if s.SRIOVNumVFs <= 0 {
+		errs = errors.Join(errs, errors.New("numVFs must be positive"))
+	}
+
+	seen := map[int]struct{}{}
+
+	for _, vf := range s.SRIOVVFs {
+		if vf.VFIndex < 0 || vf.VFIndex >= s.SRIOVNumVFs {
+			errs = errors.Join(errs, fmt.Errorf("vfs: index %d is out of range [0, %d)", vf.VFIndex, s.SRIOVNumVFs))
+		}
+
+		if _, duplicate := seen[vf.VFIndex]; duplicate {
+			errs = errors.Join(errs, fmt.Errorf("vfs: duplicate index %d", vf.VFIndex))
+		}
+
+		seen[vf.VFIndex] = struct{}{}
+
+		if len(vf.VFHardwareAddress) > 0 && (len(vf.VFHardwareAddress) != 6 || vf.VFHardwareAddress[0]&1 == 1) {
+			errs = errors.Join(errs, fmt.Errorf("vfs: index %d: hardwareAddr must be a unicast MAC address", vf.VFIndex))
+		}
+
+		if vf.VFVLANID > maxVLANID {
+			errs = errors.Join(errs, fmt.Errorf("vfs: index %d: vlanID must be in range [0, %d]", vf.VFIndex, maxVLANID))
+		}
+	}
+
+	return nil, errs
+}
+
+// PhysicalFunctionLink implements config.SRIOVConfig interface.
+func (s *SRIOVConfigV1Alpha1) PhysicalFunctionLink() optional.Optional[string] {
+	if s.PhysicalFunction.PFLink == "" {
+		return optional.None[string]()
+	}
+
+	return optional.Some(s.PhysicalFunction.PFLink)
+}
+
+// PhysicalFunctionSelector implements config.SRIOVConfig interface.
+func (s *SRIOVConfigV1Alpha1) PhysicalFunctionSelector() optional.Optional[cel.Expression] {
+	if s.PhysicalFunction.PFSelector.Match.IsZero() {
+		return optional.None[cel.Expression]()
+	}
+
+	return optional.Some(s.PhysicalFunction.PFSelector.Match)
+}
+
+// NumVFs implements config.SRIOVConfig interface.
+func (s *SRIOVConfigV1Alpha1) NumVFs() int {
+	return s.SRIOVNumVFs
+}
+
+// VFDriver implements config.SRIOVConfig interface.
+func (s *SRIOVConfigV1Alpha1) VFDriver() string {
+	return s.SRIOVDriver
+}
+
+// VFs implements config.SRIOVConfig interface.
+func (s *SRIOVConfigV1Alpha1) VFs() []config.SRIOVVirtualFunctionConfig {
+	result := make([]config.SRIOVVirtualFunctionConfig, 0, len(s.SRIOVVFs))
+
+	for i := range s.SRIOVVFs {
+		result = append(result, &s.SRIOVVFs[i])
+	}
+
+	return result
+}
+
+// Index implements config.SRIOVVirtualFunctionConfig interface.
+func (s *SRIOVVirtualFunctionConfig) Index() int {
+	return s.VFIndex
+}
+
+// HardwareAddress implements config.SRIOVVirtualFunctionConfig interface.
+func (s *SRIOVVirtualFunctionConfig) HardwareAddress() optional.Optional[nethelpers.HardwareAddr] {
+	if len(s.VFHardwareAddress) == 0 {
+		return optional.None[nethelpers.HardwareAddr]()
+	}
+
+	return optional.Some(s.VFHardwareAddress)
+}
+
+// VLANID implements config.SRIOVVirtualFunctionConfig interface.
+func (s *SRIOVVirtualFunctionConfig) VLANID() uint16 {
+	return s.VFVLANID
+}
+
+// Trust implements config.SRIOVVirtualFunctionConfig interface.
+func (s *SRIOVVirtualFunctionConfig) Trust() bool {
+	return s.VFTrust != nil && *s.VFTrust
+}
+
+// SpoofCheck implements config.SRIOVVirtualFunctionConfig interface.
+func (s *SRIOVVirtualFunctionConfig) SpoofCheck() bool {
+	return s.VFSpoofCheck == nil || *s.VFSpoofCheck
+}
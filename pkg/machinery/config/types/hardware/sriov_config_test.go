@@ -0,0 +1,220 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardware_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/hardware"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+//go:embed testdata/sriovconfig.yaml
+var expectedSRIOVConfigDocument []byte
+
+func TestSRIOVConfigMarshal(t *testing.T) {
+	t.Parallel()
+
+	cfg := hardware.NewSRIOVConfigV1Alpha1("enp4s0f0-vfs")
+	cfg.PhysicalFunction.PFLink = "enp4s0f0"
+	cfg.SRIOVNumVFs = 4
+	cfg.SRIOVDriver = "vfio-pci"
+	cfg.SRIOVVFs = []hardware.SRIOVVirtualFunctionConfig{
+		{
+			VFIndex:           1,
+			VFHardwareAddress: nethelpers.HardwareAddr{0x2e, 0x3c, 0x4d, 0x5e, 0x6f, 0x70},
+			VFVLANID:          100,
+			VFTrust:           new(true),
+			VFSpoofCheck:      new(false),
+		},
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, string(expectedSRIOVConfigDocument), string(marshaled))
+}
+
+func TestSRIOVConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedSRIOVConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &hardware.SRIOVConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       hardware.SRIOVConfig,
+		},
+		MetaName: "enp4s0f0-vfs",
+		PhysicalFunction: hardware.SRIOVPhysicalFunctionConfig{
+			PFLink: "enp4s0f0",
+		},
+		SRIOVNumVFs: 4,
+		SRIOVDriver: "vfio-pci",
+		SRIOVVFs: []hardware.SRIOVVirtualFunctionConfig{
+			{
+				VFIndex:           1,
+				VFHardwareAddress: nethelpers.HardwareAddr{0x2e, 0x3c, 0x4d, 0x5e, 0x6f, 0x70},
+				VFVLANID:          100,
+				VFTrust:           new(true),
+				VFSpoofCheck:      new(false),
+			},
+		},
+	}, docs[0])
+
+	cfg := docs[0].(*hardware.SRIOVConfigV1Alpha1)
+	vfs := cfg.VFs()
+	require.Len(t, vfs, 1)
+
+	assert.True(t, vfs[0].Trust())
+	assert.False(t, vfs[0].SpoofCheck())
+}
+
+func TestSRIOVConfigDefaults(t *testing.T) {
+	t.Parallel()
+
+	vf := &hardware.SRIOVVirtualFunctionConfig{}
+
+	assert.False(t, vf.Trust())
+	assert.True(t, vf.SpoofCheck())
+	assert.False(t, vf.HardwareAddress().IsPresent())
+}
+
+func TestSRIOVConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *hardware.SRIOVConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg: func() *hardware.SRIOVConfigV1Alpha1 {
+				return hardware.NewSRIOVConfigV1Alpha1("")
+			},
+
+			expectedError: "name is required\nphysicalFunction: one of link or pciDeviceSelector is required\nnumVFs must be positive",
+		},
+		{
+			name: "both selectors",
+			cfg: func() *hardware.SRIOVConfigV1Alpha1 {
+				c := hardware.NewSRIOVConfigV1Alpha1("vfs")
+				c.PhysicalFunction.PFLink = "eth0"
+				require.NoError(t, c.PhysicalFunction.PFSelector.Match.UnmarshalText([]byte(`pci_device.driver == "ice"`)))
+				c.SRIOVNumVFs = 2
+
+				return c
+			},
+
+			expectedError: "physicalFunction: link and pciDeviceSelector are mutually exclusive",
+		},
+		{
+			name: "invalid selector",
+			cfg: func() *hardware.SRIOVConfigV1Alpha1 {
+				c := hardware.NewSRIOVConfigV1Alpha1("vfs")
+				require.NoError(t, c.PhysicalFunction.PFSelector.Match.UnmarshalText([]byte(`link.driver == "ice"`)))
+				c.SRIOVNumVFs = 2
+
+				return c
+			},
+
+			expectedError: "physicalFunction: pciDeviceSelector is invalid: ERROR: <input>:1:1: undeclared reference to 'link' (in container '')\n | link.driver == \"ice\"\n | ^",
+		},
+		{
+			name: "invalid VFs",
+			cfg: func() *hardware.SRIOVConfigV1Alpha1 {
+				c := hardware.NewSRIOVConfigV1Alpha1("vfs")
+				c.PhysicalFunction.PFLink = "eth0"
+				c.SRIOVNumVFs = 2
+				c.SRIOVVFs = []hardware.SRIOVVirtualFunctionConfig{
+					{
+						VFIndex:           0,
+						VFHardwareAddress: nethelpers.HardwareAddr{0x01, 0x00, 0x5e, 0x00, 0x00, 0x01},
+					},
+					{
+						VFIndex:  0,
+						VFVLANID: 4095,
+					},
+					{
+						VFIndex: 2,
+					},
+				}
+
+				return c
+			},
+
+			expectedError: "vfs: index 0: hardwareAddr must be a unicast MAC address\nvfs: duplicate index 0\nvfs: index 0: vlanID must be in range [0, 4094]\nvfs: index 2 is out of range [0, 2)",
+		},
+		{
+			name: "valid link",
+			cfg: func() *hardware.SRIOVConfigV1Alpha1 {
+				c := hardware.NewSRIOVConfigV1Alpha1("vfs")
+				c.PhysicalFunction.PFLink = "eth0"
+				c.SRIOVNumVFs = 2
+				c.SRIOVVFs = []hardware.SRIOVVirtualFunctionConfig{
+					{
+						VFIndex:           1,
+						VFHardwareAddress: nethelpers.HardwareAddr{0x2e, 0x3c, 0x4d, 0x5e, 0x6f, 0x70},
+						VFVLANID:          100,
+					},
+				}
+
+				return c
+			},
+		},
+		{
+			name: "valid selector",
+			cfg: func() *hardware.SRIOVConfigV1Alpha1 {
+				c := hardware.NewSRIOVConfigV1Alpha1("vfs")
+				require.NoError(t, c.PhysicalFunction.PFSelector.Match.UnmarshalText([]byte(`pci_device.driver == "ice"`)))
+				c.SRIOVNumVFs = 8
+				c.SRIOVDriver = "vfio-pci"
+
+				return c
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+type validationMode struct{}
+
+func (validationMode) String() string {
+	return ""
+}
+
+func (validationMode) RequiresInstall() bool {
+	return false
+}
+
+func (validationMode) InContainer() bool {
+	return false
+}
@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type KubeAdmissionControlConfigV1Alpha1 -type KubeAggregatorCAConfigV1Alpha1 -type KubeAPIServerCAConfigV1Alpha1 -type KubeAPIServerConfigV1Alpha1 -type KubeAuditPolicyConfigV1Alpha1 -type KubeAuthenticationConfigV1Alpha1 -type KubeAuthorizerConfigV1Alpha1 -type KubeClusterConfigV1Alpha1 -type KubeControllerManagerConfigV1Alpha1 -type KubeCoreDNSConfigV1Alpha1 -type KubeEtcdEncryptionConfigV1Alpha1 -type KubeExternalManifestConfigV1Alpha1 -type KubeFlannelCNIConfigV1Alpha1 -type KubeInlineManifestConfigV1Alpha1 -type KubePrismConfigV1Alpha1 -type KubeletConfigV1Alpha1 -type KubeNetworkConfigV1Alpha1 -type KubeNodeConfigV1Alpha1 -type KubeProxyConfigV1Alpha1 -type KubeSchedulerConfigV1Alpha1 -type KubeServiceAccountConfigV1Alpha1 -type KubeCredentialProviderConfigV1Alpha1 -type KubeStaticPodConfigV1Alpha1 -type KubeTalosAPIAccessConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type KubeAdmissionControlConfigV1Alpha1 -type KubeAggregatorCAConfigV1Alpha1 -type KubeAPIServerCAConfigV1Alpha1 -type KubeAPIServerConfigV1Alpha1 -type KubeAuditPolicyConfigV1Alpha1 -type KubeAuthenticationConfigV1Alpha1 -type KubeAuthorizerConfigV1Alpha1 -type KubeClusterConfigV1Alpha1 -type KubeControllerManagerConfigV1Alpha1 -type KubeCoreDNSConfigV1Alpha1 -type KubeEtcdEncryptionConfigV1Alpha1 -type KubeExternalManifestConfigV1Alpha1 -type KubeFlannelCNIConfigV1Alpha1 -type KubeInlineManifestConfigV1Alpha1 -type KubeKMSConfigV1Alpha1 -type KubePrismConfigV1Alpha1 -type KubeletConfigV1Alpha1 -type KubeNetworkConfigV1Alpha1 -type KubeNodeConfigV1Alpha1 -type KubeProxyConfigV1Alpha1 -type KubeSchedulerConfigV1Alpha1 -type KubeServiceAccountConfigV1Alpha1 -type KubeCredentialProviderConfigV1Alpha1 -type KubeStaticPodConfigV1Alpha1 -type KubeTalosAPIAccessConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package k8s
 
@@ -234,6 +234,24 @@ func (o *KubeInlineManifestConfigV1Alpha1) DeepCopy() *KubeInlineManifestConfigV
 	return &cp
 }
 
+// DeepCopy generates a deep copy of *KubeKMSConfigV1Alpha1.
+func (o *KubeKMSConfigV1Alpha1) DeepCopy() *KubeKMSConfigV1Alpha1 {
+	var cp KubeKMSConfigV1Alpha1 = *o
+	if o.KMS != nil {
+		cp.KMS = new(KubeKMSEndpointConfig)
+		*cp.KMS = *o.KMS
+	}
+	if o.TPM != nil {
+		cp.TPM = new(KubeKMSTPMConfig)
+		*cp.TPM = *o.TPM
+		if o.TPM.TPMPCRs != nil {
+			cp.TPM.TPMPCRs = make([]int, len(o.TPM.TPMPCRs))
+			copy(cp.TPM.TPMPCRs, o.TPM.TPMPCRs)
+		}
+	}
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *KubePrismConfigV1Alpha1.
 func (o *KubePrismConfigV1Alpha1) DeepCopy() *KubePrismConfigV1Alpha1 {
 	var cp KubePrismConfigV1Alpha1 = *o
@@ -631,6 +631,104 @@ func (KubeInlineManifestConfigV1Alpha1) Doc() *encoder.Doc {
 	return doc
 }
 
+func (KubeKMSConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "KubeKMSConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "KubeKMSConfig enables the Talos-managed KMS v2 plugin for kube-apiserver encryption of secret data at rest." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "KubeKMSConfig enables the Talos-managed KMS v2 plugin for kube-apiserver encryption of secret data at rest.\nThe plugin runs on every control plane node, and the data encryption keys (DEKs) generated by kube-apiserver\nare wrapped with the key encryption key (KEK) shared by all control plane nodes.\nThe KEK is stored in etcd, encrypted for each control plane node with the node key,\nwhich is in turn protected either by the KMS endpoint or by the TPM.\n\nThe plugin is added as the first provider for `secrets` to the kube-apiserver encryption configuration,\nso the providers from the `KubeEtcdEncryptionConfig` document (or the legacy secretbox/aescbc secrets) are still used\nto decrypt the existing data.\n",
+		Fields: []encoder.Doc{
+			{
+				Type:   "Meta",
+				Inline: true,
+			},
+			{
+				Name:        "kms",
+				Type:        "KubeKMSEndpointConfig",
+				Note:        "",
+				Description: "Protect the node key with the KMS endpoint.\n\nThe endpoint is expected to implement the same API as the KMS endpoint used for the disk encryption.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Protect the node key with the KMS endpoint." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "tpm",
+				Type:        "KubeKMSTPMConfig",
+				Note:        "",
+				Description: "Protect the node key with the TPM.\n\nThe node key is sealed to the current values of the PCRs (PCR 7 by default),\nso it can be unsealed as long as the Secure Boot state doesn't change.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Protect the node key with the TPM." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "keyRotationInterval",
+				Type:        "Duration",
+				Note:        "",
+				Description: "The interval after which a new key encryption key is generated.\n\nOld keys are kept to decrypt the existing data.\nDefault value is 720h (30 days), minimum value is 1h.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The interval after which a new key encryption key is generated." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "keyRetention",
+				Type:        "Duration",
+				Note:        "",
+				Description: "The period the key encryption key is kept for after it is superseded by a newer key.\n\nThe data encrypted with the removed keys can't be decrypted anymore, so the existing secrets\nshould be re-encrypted within the retention period.\nBy default, old keys are kept forever.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The period the key encryption key is kept for after it is superseded by a newer key." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleKubeKMSConfigV1Alpha1())
+
+	return doc
+}
+
+func (KubeKMSEndpointConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "KubeKMSEndpointConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "KubeKMSEndpointConfig describes the KMS endpoint protecting the node key." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "KubeKMSEndpointConfig describes the KMS endpoint protecting the node key.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "KubeKMSConfigV1Alpha1",
+				FieldName: "kms",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "endpoint",
+				Type:        "string",
+				Note:        "",
+				Description: "KMS endpoint to Seal/Unseal the node key.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "KMS endpoint to Seal/Unseal the node key." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", "https://192.168.88.21:4443")
+
+	return doc
+}
+
+func (KubeKMSTPMConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "KubeKMSTPMConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "KubeKMSTPMConfig describes the TPM protecting the node key." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "KubeKMSTPMConfig describes the TPM protecting the node key.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "KubeKMSConfigV1Alpha1",
+				FieldName: "tpm",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "pcrs",
+				Type:        "[]int",
+				Note:        "",
+				Description: "List of PCRs to bind the node key to.\n\nIf not set, defaults to PCR 7.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of PCRs to bind the node key to." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
 func (KubeletConfigV1Alpha1) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "KubeletConfig",
@@ -1185,6 +1283,9 @@ func GetFileDoc() *encoder.FileDoc {
 			KubeExternalManifestConfigV1Alpha1{}.Doc(),
 			KubeFlannelCNIConfigV1Alpha1{}.Doc(),
 			KubeInlineManifestConfigV1Alpha1{}.Doc(),
+			KubeKMSConfigV1Alpha1{}.Doc(),
+			KubeKMSEndpointConfig{}.Doc(),
+			KubeKMSTPMConfig{}.Doc(),
 			KubeletConfigV1Alpha1{}.Doc(),
 			KubePrismConfigV1Alpha1{}.Doc(),
 			KubeNetworkConfigV1Alpha1{}.Doc(),
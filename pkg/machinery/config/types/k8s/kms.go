@@ -0,0 +1,224 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+//docgen:jsonschema
+
+// KubeKMSConfig defines the KubeKMSConfig configuration name.
+const KubeKMSConfig = "KubeKMSConfig"
+
+func init() {
+	registry.Register(KubeKMSConfig, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &KubeKMSConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.K8sKMSConfig              = &KubeKMSConfigV1Alpha1{}
+	_ config.Validator                 = &KubeKMSConfigV1Alpha1{}
+	_ container.ControlplaneOnlyConfig = &KubeKMSConfigV1Alpha1{}
+)
+
+// KMS plugin defaults.
+const (
+	DefaultKMSKeyRotationInterval = 30 * 24 * time.Hour
+	MinKMSKeyRotationInterval     = time.Hour
+)
+
+// KubeKMSConfigV1Alpha1 enables the Talos-managed KMS v2 plugin for kube-apiserver encryption of secret data at rest.
+//
+//	description: |
+//	  The plugin runs on every control plane node, and the data encryption keys (DEKs) generated by kube-apiserver
+//	  are wrapped with the key encryption key (KEK) shared by all control plane nodes.
+//	  The KEK is stored in etcd, encrypted for each control plane node with the node key,
+//	  which is in turn protected either by the KMS endpoint or by the TPM.
+//
+//	  The plugin is added as the first provider for `secrets` to the kube-apiserver encryption configuration,
+//	  so the providers from the `KubeEtcdEncryptionConfig` document (or the legacy secretbox/aescbc secrets) are still used
+//	  to decrypt the existing data.
+//	examples:
+//	  - value: exampleKubeKMSConfigV1Alpha1()
+//	alias: KubeKMSConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/KubeKMSConfig
+type KubeKMSConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     Protect the node key with the KMS endpoint.
+	//
+	//     The endpoint is expected to implement the same API as the KMS endpoint used for the disk encryption.
+	KMS *KubeKMSEndpointConfig `yaml:"kms,omitempty"`
+	//   description: |
+	//     Protect the node key with the TPM.
+	//
+	//     The node key is sealed to the current values of the PCRs (PCR 7 by default),
+	//     so it can be unsealed as long as the Secure Boot state doesn't change.
+	TPM *KubeKMSTPMConfig `yaml:"tpm,omitempty"`
+	//   description: |
+	//     The interval after which a new key encryption key is generated.
+	//
+	//     Old keys are kept to decrypt the existing data.
+	//     Default value is 720h (30 days), minimum value is 1h.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	KeyRotationIntervalConfig time.Duration `yaml:"keyRotationInterval,omitempty"`
+	//   description: |
+	//     The period the key encryption key is kept for after it is superseded by a newer key.
+	//
+	//     The data encrypted with the removed keys can't be decrypted anymore, so the existing secrets
+	//     should be re-encrypted within the retention period.
+	//     By default, old keys are kept forever.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	KeyRetentionConfig time.Duration `yaml:"keyRetention,omitempty"`
+}
+
+// KubeKMSEndpointConfig describes the KMS endpoint protecting the node key.
+type KubeKMSEndpointConfig struct {
+	//   description: |
+	//     KMS endpoint to Seal/Unseal the node key.
+	//   examples:
+	//     - value: >
+	//        "https://192.168.88.21:4443"
+	KMSEndpoint string `yaml:"endpoint"`
+}
+
+// KubeKMSTPMConfig describes the TPM protecting the node key.
+type KubeKMSTPMConfig struct {
+	//   description: |
+	//     List of PCRs to bind the node key to.
+	//
+	//     If not set, defaults to PCR 7.
+	TPMPCRs []int `yaml:"pcrs,omitempty"`
+}
+
+// NewKubeKMSConfigV1Alpha1 creates a new KubeKMSConfig config document.
+func NewKubeKMSConfigV1Alpha1() *KubeKMSConfigV1Alpha1 {
+	return &KubeKMSConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       KubeKMSConfig,
+		},
+	}
+}
+
+func exampleKubeKMSConfigV1Alpha1() *KubeKMSConfigV1Alpha1 {
+	cfg := NewKubeKMSConfigV1Alpha1()
+	cfg.TPM = &KubeKMSTPMConfig{
+		TPMPCRs: []int{constants.SecureBootStatePCR},
+	}
+	cfg.KeyRotationIntervalConfig = 7 * 24 * time.Hour
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *KubeKMSConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Validate implements config.Validator interface.
+//
+//nolint:gocyclo
+func (s *KubeKMSConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	switch {
+	case s.KMS == nil && s.TPM == nil:
+		errs = errors.Join(errs, errors.New("either kms or tpm should be configured"))
+	case s.KMS != nil && s.TPM != nil:
+		errs = errors.Join(errs, errors.New("only one of kms or tpm can be configured"))
+	}
+
+	if s.KMS != nil {
+		if s.KMS.KMSEndpoint == "" {
+			errs = errors.Join(errs, errors.New("kms endpoint is required"))
+		} else if _, err := url.Parse(s.KMS.KMSEndpoint); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("invalid kms endpoint: %w", err))
+		}
+	}
+
+	if s.TPM != nil {
+		for _, pcr := range s.TPM.TPMPCRs {
+			if pcr < 0 || pcr > 23 {
+				errs = errors.Join(errs, fmt.Errorf("invalid tpm pcr %d", pcr))
+			}
+		}
+	}
+
+	if s.KeyRotationIntervalConfig != 0 && s.KeyRotationIntervalConfig < MinKMSKeyRotationInterval {
+		errs = errors.Join(errs, fmt.Errorf("key rotation interval should be at least %s", MinKMSKeyRotationInterval))
+	}
+
+	if s.KeyRetentionConfig < 0 || (s.KeyRetentionConfig != 0 && s.KeyRetentionConfig < s.KeyRotationInterval()) {
+		errs = errors.Join(errs, fmt.Errorf("key retention should be at least the key rotation interval %s", s.KeyRotationInterval()))
+	}
+
+	return nil, errs
+}
+
+// ControlplaneOnlyDocument implements container.ControlplaneOnlyConfig interface.
+func (s *KubeKMSConfigV1Alpha1) ControlplaneOnlyDocument() {}
+
+// KMSEndpoint implements config.K8sKMSConfig interface.
+func (s *KubeKMSConfigV1Alpha1) KMSEndpoint() string {
+	if s.KMS == nil {
+		return ""
+	}
+
+	return s.KMS.KMSEndpoint
+}
+
+// TPMEnabled implements config.K8sKMSConfig interface.
+func (s *KubeKMSConfigV1Alpha1) TPMEnabled() bool {
+	return s.TPM != nil
+}
+
+// TPMPCRs implements config.K8sKMSConfig interface.
+func (s *KubeKMSConfigV1Alpha1) TPMPCRs() []int {
+	if s.TPM == nil || len(s.TPM.TPMPCRs) == 0 {
+		return []int{constants.SecureBootStatePCR}
+	}
+
+	return slices.Clone(s.TPM.TPMPCRs)
+}
+
+// KeyRotationInterval implements config.K8sKMSConfig interface.
+func (s *KubeKMSConfigV1Alpha1) KeyRotationInterval() time.Duration {
+	if s.KeyRotationIntervalConfig == 0 {
+		return DefaultKMSKeyRotationInterval
+	}
+
+	return s.KeyRotationIntervalConfig
+}
+
+// KeyRetention implements config.K8sKMSConfig interface.
+func (s *KubeKMSConfigV1Alpha1) KeyRetention() time.Duration {
+	return s.KeyRetentionConfig
+}
@@ -0,0 +1,175 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	_ "embed"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+)
+
+//go:embed testdata/kmsconfig.yaml
+var expectedKubeKMSConfigDocument []byte
+
+func TestKubeKMSConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := k8s.NewKubeKMSConfigV1Alpha1()
+	cfg.KMS = &k8s.KubeKMSEndpointConfig{
+		KMSEndpoint: "https://192.168.88.21:4443",
+	}
+	cfg.KeyRotationIntervalConfig = 7 * 24 * time.Hour
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedKubeKMSConfigDocument, marshaled)
+}
+
+func TestKubeKMSConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedKubeKMSConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &k8s.KubeKMSConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       k8s.KubeKMSConfig,
+		},
+		KMS: &k8s.KubeKMSEndpointConfig{
+			KMSEndpoint: "https://192.168.88.21:4443",
+		},
+		KeyRotationIntervalConfig: 7 * 24 * time.Hour,
+	}, docs[0])
+
+	kmsConfig := provider.K8sKMSConfig()
+	require.NotNil(t, kmsConfig)
+
+	assert.Equal(t, "https://192.168.88.21:4443", kmsConfig.KMSEndpoint())
+	assert.False(t, kmsConfig.TPMEnabled())
+	assert.Equal(t, 7*24*time.Hour, kmsConfig.KeyRotationInterval())
+}
+
+func TestKubeKMSConfigDefaults(t *testing.T) {
+	t.Parallel()
+
+	cfg := k8s.NewKubeKMSConfigV1Alpha1()
+	cfg.TPM = &k8s.KubeKMSTPMConfig{}
+
+	assert.True(t, cfg.TPMEnabled())
+	assert.Empty(t, cfg.KMSEndpoint())
+	assert.Equal(t, []int{7}, cfg.TPMPCRs())
+	assert.Equal(t, k8s.DefaultKMSKeyRotationInterval, cfg.KeyRotationInterval())
+	assert.Zero(t, cfg.KeyRetention())
+}
+
+func TestKubeKMSConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *k8s.KubeKMSConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  k8s.NewKubeKMSConfigV1Alpha1,
+
+			expectedError: "either kms or tpm should be configured",
+		},
+		{
+			name: "both",
+			cfg: func() *k8s.KubeKMSConfigV1Alpha1 {
+				cfg := k8s.NewKubeKMSConfigV1Alpha1()
+				cfg.KMS = &k8s.KubeKMSEndpointConfig{
+					KMSEndpoint: "https://192.168.88.21:4443",
+				}
+				cfg.TPM = &k8s.KubeKMSTPMConfig{}
+
+				return cfg
+			},
+
+			expectedError: "only one of kms or tpm can be configured",
+		},
+		{
+			name: "invalid",
+			cfg: func() *k8s.KubeKMSConfigV1Alpha1 {
+				cfg := k8s.NewKubeKMSConfigV1Alpha1()
+				cfg.KMS = &k8s.KubeKMSEndpointConfig{}
+				cfg.KeyRotationIntervalConfig = time.Minute
+
+				return cfg
+			},
+
+			expectedError: "kms endpoint is required\nkey rotation interval should be at least 1h0m0s",
+		},
+		{
+			name: "short retention",
+			cfg: func() *k8s.KubeKMSConfigV1Alpha1 {
+				cfg := k8s.NewKubeKMSConfigV1Alpha1()
+				cfg.TPM = &k8s.KubeKMSTPMConfig{}
+				cfg.KeyRotationIntervalConfig = 7 * 24 * time.Hour
+				cfg.KeyRetentionConfig = 24 * time.Hour
+
+				return cfg
+			},
+
+			expectedError: "key retention should be at least the key rotation interval 168h0m0s",
+		},
+		{
+			name: "invalid pcr",
+			cfg: func() *k8s.KubeKMSConfigV1Alpha1 {
+				cfg := k8s.NewKubeKMSConfigV1Alpha1()
+				cfg.TPM = &k8s.KubeKMSTPMConfig{
+					TPMPCRs: []int{7, 42},
+				}
+
+				return cfg
+			},
+
+			expectedError: "invalid tpm pcr 42",
+		},
+		{
+			name: "valid tpm",
+			cfg: func() *k8s.KubeKMSConfigV1Alpha1 {
+				cfg := k8s.NewKubeKMSConfigV1Alpha1()
+				cfg.TPM = &k8s.KubeKMSTPMConfig{
+					TPMPCRs: []int{7},
+				}
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, err := test.cfg().Validate(validationMode{})
+
+			assert.Empty(t, warnings)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type BGPInstanceConfigV1Alpha1 -type BlackholeRouteConfigV1Alpha1 -type BondConfigV1Alpha1 -type BridgeConfigV1Alpha1 -type VRFConfigV1Alpha1 -type DefaultActionConfigV1Alpha1 -type DHCPv4ConfigV1Alpha1 -type DHCPv6ConfigV1Alpha1 -type DummyLinkConfigV1Alpha1 -type EthernetConfigV1Alpha1 -type HCloudVIPConfigV1Alpha1 -type HTTPProbeConfigV1Alpha1 -type HostnameConfigV1Alpha1 -type KubeSpanConfigV1Alpha1 -type KubespanEndpointsConfigV1Alpha1 -type Layer2VIPConfigV1Alpha1 -type LinkConfigV1Alpha1 -type LinkAliasConfigV1Alpha1 -type ResolverConfigV1Alpha1 -type RoutingRuleConfigV1Alpha1 -type RuleConfigV1Alpha1 -type StaticHostConfigV1Alpha1 -type TCPProbeConfigV1Alpha1 -type TimeSyncConfigV1Alpha1 -type VethConfigV1Alpha1 -type VLANConfigV1Alpha1 -type WireguardConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type BGPInstanceConfigV1Alpha1 -type BlackholeRouteConfigV1Alpha1 -type BondConfigV1Alpha1 -type BridgeConfigV1Alpha1 -type VRFConfigV1Alpha1 -type DefaultActionConfigV1Alpha1 -type DHCPv4ConfigV1Alpha1 -type DHCPv6ConfigV1Alpha1 -type Dot1XConfigV1Alpha1 -type DummyLinkConfigV1Alpha1 -type EthernetConfigV1Alpha1 -type HCloudVIPConfigV1Alpha1 -type HTTPProbeConfigV1Alpha1 -type HostnameConfigV1Alpha1 -type KubeSpanConfigV1Alpha1 -type KubespanEndpointsConfigV1Alpha1 -type Layer2VIPConfigV1Alpha1 -type LinkConfigV1Alpha1 -type LinkAliasConfigV1Alpha1 -type ResolverConfigV1Alpha1 -type RoutingRuleConfigV1Alpha1 -type RuleConfigV1Alpha1 -type StaticHostConfigV1Alpha1 -type TCPProbeConfigV1Alpha1 -type TimeSyncConfigV1Alpha1 -type VethConfigV1Alpha1 -type VLANConfigV1Alpha1 -type WireguardConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package network
 
@@ -329,6 +329,36 @@ func (o *DHCPv6ConfigV1Alpha1) DeepCopy() *DHCPv6ConfigV1Alpha1 {
 		cp.ConfigDUIDRaw = make([]byte, len(o.ConfigDUIDRaw))
 		copy(cp.ConfigDUIDRaw, o.ConfigDUIDRaw)
 	}
+	if o.PrefixDelegationConfig != nil {
+		cp.PrefixDelegationConfig = new(DHCPv6PrefixDelegationConfig)
+		*cp.PrefixDelegationConfig = *o.PrefixDelegationConfig
+		if o.PrefixDelegationConfig.PDDownstream != nil {
+			cp.PrefixDelegationConfig.PDDownstream = make([]DHCPv6PrefixDelegationDownstream, len(o.PrefixDelegationConfig.PDDownstream))
+			copy(cp.PrefixDelegationConfig.PDDownstream, o.PrefixDelegationConfig.PDDownstream)
+		}
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *Dot1XConfigV1Alpha1.
+func (o *Dot1XConfigV1Alpha1) DeepCopy() *Dot1XConfigV1Alpha1 {
+	var cp Dot1XConfigV1Alpha1 = *o
+	if o.Dot1XTLS != nil {
+		cp.Dot1XTLS = new(Dot1XTLSConfig)
+		*cp.Dot1XTLS = *o.Dot1XTLS
+		if o.Dot1XTLS.TLSClientIdentity != nil {
+			cp.Dot1XTLS.TLSClientIdentity = new(meta.CertificateAndKey)
+			*cp.Dot1XTLS.TLSClientIdentity = *o.Dot1XTLS.TLSClientIdentity
+		}
+		if o.Dot1XTLS.TLSClientIssuer != nil {
+			cp.Dot1XTLS.TLSClientIssuer = new(meta.CertificateAndKey)
+			*cp.Dot1XTLS.TLSClientIssuer = *o.Dot1XTLS.TLSClientIssuer
+		}
+	}
+	if o.Dot1XPEAP != nil {
+		cp.Dot1XPEAP = new(Dot1XPEAPConfig)
+		*cp.Dot1XPEAP = *o.Dot1XPEAP
+	}
 	return &cp
 }
 
@@ -525,6 +555,14 @@ func (o *KubespanEndpointsConfigV1Alpha1) DeepCopy() *KubespanEndpointsConfigV1A
 // DeepCopy generates a deep copy of *Layer2VIPConfigV1Alpha1.
 func (o *Layer2VIPConfigV1Alpha1) DeepCopy() *Layer2VIPConfigV1Alpha1 {
 	var cp Layer2VIPConfigV1Alpha1 = *o
+	if o.VRRPConfig != nil {
+		cp.VRRPConfig = new(Layer2VIPVRRPConfig)
+		*cp.VRRPConfig = *o.VRRPConfig
+		if o.VRRPConfig.VRRPPreempt != nil {
+			cp.VRRPConfig.VRRPPreempt = new(bool)
+			*cp.VRRPConfig.VRRPPreempt = *o.VRRPConfig.VRRPPreempt
+		}
+	}
 	return &cp
 }
 
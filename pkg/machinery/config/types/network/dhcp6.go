@@ -9,8 +9,10 @@ package network
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/go-pointer"
 
 	"github.com/siderolabs/talos/pkg/machinery/config/config"
@@ -88,8 +90,63 @@ type DHCPv6ConfigV1Alpha1 struct {
 	//     type: string
 	//     pattern: ^([0-9a-fA-F]{2}(:[0-9a-fA-F]{2})+)$
 	ConfigDUIDRaw nethelpers.HardwareAddr `yaml:"duidRaw,omitempty"`
+	//   description: |
+	//     Request a delegated prefix (IA_PD) from the DHCPv6 server.
+	//
+	//     Subnets of the delegated prefix can be assigned to downstream links (e.g. bridges used by local VMs and pods).
+	//     Downstream addresses are updated each time the delegation is renewed.
+	//   examples:
+	//    - value: examplePrefixDelegationConfig()
+	PrefixDelegationConfig *DHCPv6PrefixDelegationConfig `yaml:"prefixDelegation,omitempty"`
+}
+
+// DHCPv6PrefixDelegationConfig configures DHCPv6 prefix delegation.
+type DHCPv6PrefixDelegationConfig struct {
+	//   description: |
+	//     Prefix length to request from the DHCPv6 server (1-128).
+	//
+	//     The value is a hint, the server might delegate a prefix of a different length.
+	//     If not set, the server picks the prefix length.
+	//   examples:
+	//    - value: >
+	//       uint8(56)
+	PDPrefixLength uint8 `yaml:"prefixLength,omitempty"`
+	//   description: |
+	//     List of downstream links which get a subnet of the delegated prefix.
+	PDDownstream []DHCPv6PrefixDelegationDownstream `yaml:"downstream,omitempty"`
 }
 
+// DHCPv6PrefixDelegationDownstream assigns a subnet of the delegated prefix to a link.
+type DHCPv6PrefixDelegationDownstream struct {
+	//   description: |
+	//     Name of the downstream link.
+	//   examples:
+	//    - value: >
+	//       "br0"
+	//   schemaRequired: true
+	DownstreamLink string `yaml:"link"`
+	//   description: |
+	//     Index of the subnet within the delegated prefix.
+	//
+	//     For example, with the delegated prefix `2001:db8:0:100::/56` and the subnet length of 64,
+	//     the subnet ID 1 is `2001:db8:0:101::/64`.
+	//     Defaults to 0.
+	DownstreamSubnetID uint32 `yaml:"subnetID,omitempty"`
+	//   description: |
+	//     Length of the subnet assigned to the link.
+	//     Defaults to 64.
+	DownstreamSubnetLength uint8 `yaml:"subnetLength,omitempty"`
+	//   description: |
+	//     Send router advertisements for the subnet on the link.
+	//
+	//     Router advertisements announce the node as the default router and allow hosts
+	//     attached to the link to configure addresses using SLAAC.
+	DownstreamRouterAdvertisements bool `yaml:"routerAdvertisements,omitempty"`
+}
+
+// DefaultPrefixDelegationSubnetLength is the default length of the subnet assigned to a downstream link.
+const DefaultPrefixDelegationSubnetLength = 64
+
 // NewDHCPv6ConfigV1Alpha1 creates a new DHCPv6Config config document.
 func NewDHCPv6ConfigV1Alpha1(name string) *DHCPv6ConfigV1Alpha1 {
 	return &DHCPv6ConfigV1Alpha1{
@@ -107,6 +164,19 @@ func exampleDHCPv6ConfigV1Alpha1() *DHCPv6ConfigV1Alpha1 {
 	return cfg
 }
 
+func examplePrefixDelegationConfig() *DHCPv6PrefixDelegationConfig {
+	return &DHCPv6PrefixDelegationConfig{
+		PDPrefixLength: 56,
+		PDDownstream: []DHCPv6PrefixDelegationDownstream{
+			{
+				DownstreamLink:                 "br0",
+				DownstreamSubnetID:             1,
+				DownstreamRouterAdvertisements: true,
+			},
+		},
+	}
+}
+
 // Clone implements config.Document interface.
 func (s *DHCPv6ConfigV1Alpha1) Clone() config.Document {
 	return s.DeepCopy()
@@ -159,6 +229,55 @@ func (s *DHCPv6ConfigV1Alpha1) DUIDRaw() optional.Optional[nethelpers.HardwareAd
 	return optional.Some(s.ConfigDUIDRaw)
 }
 
+// PrefixDelegation returns the prefix delegation config.
+func (s *DHCPv6ConfigV1Alpha1) PrefixDelegation() optional.Optional[config.NetworkDHCPv6PrefixDelegationConfig] {
+	if s.PrefixDelegationConfig == nil {
+		return optional.None[config.NetworkDHCPv6PrefixDelegationConfig]()
+	}
+
+	return optional.Some[config.NetworkDHCPv6PrefixDelegationConfig](s.PrefixDelegationConfig)
+}
+
+// PrefixLength implements config.NetworkDHCPv6PrefixDelegationConfig interface.
+func (pd *DHCPv6PrefixDelegationConfig) PrefixLength() optional.Optional[uint8] {
+	if pd.PDPrefixLength == 0 {
+		return optional.None[uint8]()
+	}
+
+	return optional.Some(pd.PDPrefixLength)
+}
+
+// Downstream implements config.NetworkDHCPv6PrefixDelegationConfig interface.
+func (pd *DHCPv6PrefixDelegationConfig) Downstream() []config.NetworkDHCPv6PrefixDelegationDownstreamConfig {
+	return xslices.Map(pd.PDDownstream, func(d DHCPv6PrefixDelegationDownstream) config.NetworkDHCPv6PrefixDelegationDownstreamConfig {
+		return d
+	})
+}
+
+// Link implements config.NetworkDHCPv6PrefixDelegationDownstreamConfig interface.
+func (d DHCPv6PrefixDelegationDownstream) Link() string {
+	return d.DownstreamLink
+}
+
+// SubnetID implements config.NetworkDHCPv6PrefixDelegationDownstreamConfig interface.
+func (d DHCPv6PrefixDelegationDownstream) SubnetID() uint32 {
+	return d.DownstreamSubnetID
+}
+
+// SubnetLength implements config.NetworkDHCPv6PrefixDelegationDownstreamConfig interface.
+func (d DHCPv6PrefixDelegationDownstream) SubnetLength() uint8 {
+	if d.DownstreamSubnetLength == 0 {
+		return DefaultPrefixDelegationSubnetLength
+	}
+
+	return d.DownstreamSubnetLength
+}
+
+// RouterAdvertisements implements config.NetworkDHCPv6PrefixDelegationDownstreamConfig interface.
+func (d DHCPv6PrefixDelegationDownstream) RouterAdvertisements() bool {
+	return d.DownstreamRouterAdvertisements
+}
+
 // Validate implements config.Validator interface.
 func (s *DHCPv6ConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
 	var (
@@ -178,5 +297,53 @@ func (s *DHCPv6ConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Op
 		errs = errors.Join(errs, errors.New("duidRaw must be set if clientIdentifier is 'duid'"))
 	}
 
+	if s.PrefixDelegationConfig != nil {
+		errs = errors.Join(errs, s.PrefixDelegationConfig.validate())
+	}
+
 	return warnings, errs
 }
+
+func (pd *DHCPv6PrefixDelegationConfig) validate() error {
+	var errs error
+
+	if pd.PDPrefixLength > 128 {
+		errs = errors.Join(errs, errors.New("prefixDelegation: prefixLength must be in range 1-128"))
+	}
+
+	links := map[string]struct{}{}
+
+	for i, downstream := range pd.PDDownstream {
+		if downstream.DownstreamLink == "" {
+			errs = errors.Join(errs, fmt.Errorf("prefixDelegation: downstream[%d]: link must be specified", i))
+		} else if _, ok := links[downstream.DownstreamLink]; ok {
+			errs = errors.Join(errs, fmt.Errorf("prefixDelegation: downstream[%d]: duplicate link %q", i, downstream.DownstreamLink))
+		}
+
+		links[downstream.DownstreamLink] = struct{}{}
+
+		subnetLength := downstream.SubnetLength()
+
+		if subnetLength > 128 {
+			errs = errors.Join(errs, fmt.Errorf("prefixDelegation: downstream[%d]: subnetLength must be in range 1-128", i))
+
+			continue
+		}
+
+		if pd.PDPrefixLength == 0 || pd.PDPrefixLength > 128 {
+			continue
+		}
+
+		if subnetLength < pd.PDPrefixLength {
+			errs = errors.Join(errs, fmt.Errorf("prefixDelegation: downstream[%d]: subnetLength %d is shorter than prefixLength %d", i, subnetLength, pd.PDPrefixLength))
+
+			continue
+		}
+
+		if bits := subnetLength - pd.PDPrefixLength; bits < 32 && downstream.DownstreamSubnetID >= 1<<bits {
+			errs = errors.Join(errs, fmt.Errorf("prefixDelegation: downstream[%d]: subnetID %d doesn't fit into %d subnet bits", i, downstream.DownstreamSubnetID, bits))
+		}
+	}
+
+	return errs
+}
@@ -94,6 +94,60 @@ func TestDHCPv6ConfigValidate(t *testing.T) {
 			},
 			expectedError: "duidRaw can only be set if clientIdentifier is 'duid'",
 		},
+		{
+			name: "valid prefix delegation",
+			cfg: func() *network.DHCPv6ConfigV1Alpha1 {
+				c := network.NewDHCPv6ConfigV1Alpha1("enp0s3")
+				c.PrefixDelegationConfig = &network.DHCPv6PrefixDelegationConfig{
+					PDPrefixLength: 56,
+					PDDownstream: []network.DHCPv6PrefixDelegationDownstream{
+						{
+							DownstreamLink:                 "br0",
+							DownstreamRouterAdvertisements: true,
+						},
+						{
+							DownstreamLink:     "br1",
+							DownstreamSubnetID: 255,
+						},
+					},
+				}
+
+				return c
+			},
+		},
+		{
+			name: "invalid prefix delegation",
+			cfg: func() *network.DHCPv6ConfigV1Alpha1 {
+				c := network.NewDHCPv6ConfigV1Alpha1("enp0s3")
+				c.PrefixDelegationConfig = &network.DHCPv6PrefixDelegationConfig{
+					PDPrefixLength: 60,
+					PDDownstream: []network.DHCPv6PrefixDelegationDownstream{
+						{
+							DownstreamSubnetID: 1,
+						},
+						{
+							DownstreamLink:     "br0",
+							DownstreamSubnetID: 16,
+						},
+						{
+							DownstreamLink:         "br0",
+							DownstreamSubnetLength: 48,
+						},
+						{
+							DownstreamLink:         "br1",
+							DownstreamSubnetLength: 129,
+						},
+					},
+				}
+
+				return c
+			},
+			expectedError: "prefixDelegation: downstream[0]: link must be specified\n" +
+				"prefixDelegation: downstream[1]: subnetID 16 doesn't fit into 4 subnet bits\n" +
+				"prefixDelegation: downstream[2]: duplicate link \"br0\"\n" +
+				"prefixDelegation: downstream[2]: subnetLength 48 is shorter than prefixLength 60\n" +
+				"prefixDelegation: downstream[3]: subnetLength must be in range 1-128",
+		},
 		{
 			name: "empty",
 			cfg: func() *network.DHCPv6ConfigV1Alpha1 {
@@ -118,3 +172,42 @@ func TestDHCPv6ConfigValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestDHCPv6ConfigPrefixDelegation(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewDHCPv6ConfigV1Alpha1("enp0s3")
+
+	assert.False(t, cfg.PrefixDelegation().IsPresent())
+
+	cfg.PrefixDelegationConfig = &network.DHCPv6PrefixDelegationConfig{
+		PDDownstream: []network.DHCPv6PrefixDelegationDownstream{
+			{
+				DownstreamLink:     "br0",
+				DownstreamSubnetID: 2,
+			},
+			{
+				DownstreamLink:                 "br1",
+				DownstreamSubnetLength:         80,
+				DownstreamRouterAdvertisements: true,
+			},
+		},
+	}
+
+	pd, ok := cfg.PrefixDelegation().Get()
+	require.True(t, ok)
+
+	assert.False(t, pd.PrefixLength().IsPresent())
+
+	downstream := pd.Downstream()
+	require.Len(t, downstream, 2)
+
+	assert.Equal(t, "br0", downstream[0].Link())
+	assert.EqualValues(t, 2, downstream[0].SubnetID())
+	assert.EqualValues(t, network.DefaultPrefixDelegationSubnetLength, downstream[0].SubnetLength())
+	assert.False(t, downstream[0].RouterAdvertisements())
+
+	assert.Equal(t, "br1", downstream[1].Link())
+	assert.EqualValues(t, 80, downstream[1].SubnetLength())
+	assert.True(t, downstream[1].RouterAdvertisements())
+}
@@ -0,0 +1,203 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/siderolabs/crypto/x509"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/dot1xconfig.yaml
+var expectedDot1XConfigDocument []byte
+
+//go:embed testdata/dot1xconfig_redacted.yaml
+var expectedDot1XConfigRedactedDocument []byte
+
+const testDot1XCA = "-----BEGIN CERTIFICATE-----\nMIID...IDAQAB\n-----END CERTIFICATE-----\n"
+
+func TestDot1XConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewDot1XConfigV1Alpha1("enp0s2")
+	cfg.Dot1XIdentity = `EXAMPLE\node1`
+	cfg.Dot1XAnonymousIdentity = "anonymous"
+	cfg.Dot1XCA = testDot1XCA
+	cfg.Dot1XServerName = "radius.example.com"
+	cfg.Dot1XPEAP = &network.Dot1XPEAPConfig{
+		PEAPPassword: "secret",
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedDot1XConfigDocument, marshaled)
+}
+
+func TestDot1XConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedDot1XConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &network.Dot1XConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       network.Dot1XKind,
+		},
+		MetaName:               "enp0s2",
+		Dot1XIdentity:          `EXAMPLE\node1`,
+		Dot1XAnonymousIdentity: "anonymous",
+		Dot1XCA:                testDot1XCA,
+		Dot1XServerName:        "radius.example.com",
+		Dot1XPEAP: &network.Dot1XPEAPConfig{
+			PEAPPassword: "secret",
+		},
+	}, docs[0])
+}
+
+func TestDot1XConfigRedact(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedDot1XConfigDocument)
+	require.NoError(t, err)
+
+	redacted, err := provider.RedactSecrets("REDACTED").EncodeBytes(encoder.WithComments(encoder.CommentsDisabled))
+	require.NoError(t, err)
+
+	t.Log(string(redacted))
+
+	assert.Equal(t, expectedDot1XConfigRedactedDocument, redacted)
+}
+
+func TestDot1XConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	ca, err := x509.NewSelfSignedCertificateAuthority(x509.ECDSA(true))
+	require.NoError(t, err)
+
+	issuer := &meta.CertificateAndKey{
+		Cert: string(ca.CrtPEM),
+		Key:  string(ca.KeyPEM),
+	}
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.Dot1XConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg: func() *network.Dot1XConfigV1Alpha1 {
+				return network.NewDot1XConfigV1Alpha1("")
+			},
+
+			expectedError: "name is required\nidentity is required\nca is required\none of tls or peap is required",
+		},
+		{
+			name: "invalid CA",
+			cfg: func() *network.Dot1XConfigV1Alpha1 {
+				c := network.NewDot1XConfigV1Alpha1("eth0")
+				c.Dot1XIdentity = "node1"
+				c.Dot1XCA = testDot1XCA
+				c.Dot1XPEAP = &network.Dot1XPEAPConfig{}
+
+				return c
+			},
+
+			expectedError: "ca must be a valid PEM-encoded certificate\npeap: password is required",
+		},
+		{
+			name: "both methods",
+			cfg: func() *network.Dot1XConfigV1Alpha1 {
+				c := network.NewDot1XConfigV1Alpha1("eth0")
+				c.Dot1XIdentity = "node1"
+				c.Dot1XCA = string(ca.CrtPEM)
+				c.Dot1XTLS = &network.Dot1XTLSConfig{TLSClientIssuer: issuer}
+				c.Dot1XPEAP = &network.Dot1XPEAPConfig{PEAPPassword: "secret"}
+
+				return c
+			},
+
+			expectedError: "tls and peap are mutually exclusive",
+		},
+		{
+			name: "TLS without client certificate",
+			cfg: func() *network.Dot1XConfigV1Alpha1 {
+				c := network.NewDot1XConfigV1Alpha1("eth0")
+				c.Dot1XIdentity = "node1"
+				c.Dot1XCA = string(ca.CrtPEM)
+				c.Dot1XTLS = &network.Dot1XTLSConfig{}
+
+				return c
+			},
+
+			expectedError: "tls: one of clientIdentity or clientIssuer is required",
+		},
+		{
+			name: "TLS invalid client certificate",
+			cfg: func() *network.Dot1XConfigV1Alpha1 {
+				c := network.NewDot1XConfigV1Alpha1("eth0")
+				c.Dot1XIdentity = "node1"
+				c.Dot1XCA = string(ca.CrtPEM)
+				c.Dot1XTLS = &network.Dot1XTLSConfig{
+					TLSClientIdentity: &meta.CertificateAndKey{Cert: string(ca.CrtPEM)},
+				}
+
+				return c
+			},
+
+			expectedError: "tls: client identity: key is required",
+		},
+		{
+			name: "valid TLS",
+			cfg: func() *network.Dot1XConfigV1Alpha1 {
+				c := network.NewDot1XConfigV1Alpha1("eth0")
+				c.Dot1XIdentity = "node1"
+				c.Dot1XCA = string(ca.CrtPEM)
+				c.Dot1XTLS = &network.Dot1XTLSConfig{TLSClientIssuer: issuer}
+
+				return c
+			},
+		},
+		{
+			name: "valid PEAP",
+			cfg: func() *network.Dot1XConfigV1Alpha1 {
+				c := network.NewDot1XConfigV1Alpha1("eth0")
+				c.Dot1XIdentity = "node1"
+				c.Dot1XCA = string(ca.CrtPEM)
+				c.Dot1XPEAP = &network.Dot1XPEAPConfig{PEAPPassword: "secret"}
+
+				return c
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -10,6 +10,9 @@ import (
 	"errors"
 	"fmt"
 	"net/netip"
+	"time"
+
+	"github.com/siderolabs/gen/optional"
 
 	"github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
@@ -42,11 +45,15 @@ var (
 // Layer2VIPConfigV1Alpha1 is a config document to configure virtual IP using Layer 2 (Ethernet) advertisement.
 //
 //	description: |
-//	 Virtual IP configuration should be used only on controlplane nodes to provide virtual IP for Kubernetes API server.
-//	 Any other use cases are not supported and may lead to unexpected behavior.
-//	 Virtual IP will be announced from only one node at a time using gratuitous ARP announcements for IPv4.
+//	 By default, the node which announces the virtual IP is elected using etcd, so the virtual IP can be used only on controlplane nodes
+//	 to provide virtual IP for Kubernetes API server.
+//	 With the `vrrp` section, the node is elected using VRRPv3 instead, which doesn't require etcd, so the virtual IP can be used
+//	 on any node (e.g. for ingress on worker nodes).
+//	 Virtual IP will be announced from only one node at a time using gratuitous ARP announcements for IPv4, and unsolicited
+//	 neighbor advertisements for IPv6.
 //	examples:
 //	  - value: exampleLayer2VIPConfigV1Alpha1()
+//	  - value: exampleLayer2VIPConfigV1Alpha1VRRP()
 //	alias: Layer2VIPConfig
 //	schemaRoot: true
 //	schemaMeta: v1alpha1/Layer2VIPConfig
@@ -69,8 +76,50 @@ type Layer2VIPConfigV1Alpha1 struct {
 	//     Selector must match exactly one link, otherwise an error is returned.
 	//     If multiple selectors match the same link, the first one is used.
 	LinkName string `yaml:"link"`
+	//   description: |
+	//     Elect the node which announces the virtual IP using VRRPv3 instead of etcd.
+	//
+	//     All nodes sharing the virtual IP should use the same virtual router ID, and the virtual router ID
+	//     should be unique on the link.
+	VRRPConfig *Layer2VIPVRRPConfig `yaml:"vrrp,omitempty"`
+}
+
+// Layer2VIPVRRPConfig configures VRRPv3 election of the virtual IP.
+type Layer2VIPVRRPConfig struct {
+	//   description: |
+	//     Virtual router ID (1-255).
+	//   examples:
+	//    - value: >
+	//       uint8(51)
+	//   schemaRequired: true
+	VRRPVirtualRouterID uint8 `yaml:"virtualRouterID"`
+	//   description: |
+	//     Priority of the node (1-254), the node with the highest priority announces the virtual IP.
+	//     Defaults to 100.
+	//   examples:
+	//    - value: >
+	//       uint8(150)
+	VRRPPriority uint8 `yaml:"priority,omitempty"`
+	//   description: |
+	//     Whether a node with higher priority takes over the virtual IP from a node with lower priority.
+	//     Defaults to true.
+	VRRPPreempt *bool `yaml:"preempt,omitempty"`
+	//   description: |
+	//     Interval between VRRP advertisements (10ms-40.95s, in 10ms steps).
+	//     The virtual IP fails over after approximately three advertisement intervals.
+	//     Defaults to 1s.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\.\d+|\d+)([nuµm]?s|m|h))|0)+$
+	VRRPAdvertisementInterval time.Duration `yaml:"advertisementInterval,omitempty"`
 }
 
+// VRRP defaults.
+const (
+	DefaultVRRPPriority              = 100
+	DefaultVRRPAdvertisementInterval = time.Second
+)
+
 // NewLayer2VIPConfigV1Alpha1 creates a new Layer2VIPConfig config document.
 func NewLayer2VIPConfigV1Alpha1(name string) *Layer2VIPConfigV1Alpha1 {
 	return &Layer2VIPConfigV1Alpha1{
@@ -89,6 +138,17 @@ func exampleLayer2VIPConfigV1Alpha1() *Layer2VIPConfigV1Alpha1 {
 	return cfg
 }
 
+func exampleLayer2VIPConfigV1Alpha1VRRP() *Layer2VIPConfigV1Alpha1 {
+	cfg := NewLayer2VIPConfigV1Alpha1("192.168.100.50")
+	cfg.LinkName = "enp0s2"
+	cfg.VRRPConfig = &Layer2VIPVRRPConfig{
+		VRRPVirtualRouterID: 51,
+		VRRPPriority:        150,
+	}
+
+	return cfg
+}
+
 // Clone implements config.Document interface.
 func (s *Layer2VIPConfigV1Alpha1) Clone() config.Document {
 	return s.DeepCopy()
@@ -116,6 +176,21 @@ func (s *Layer2VIPConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation
 		errs = errors.Join(errs, errors.New("link must be specified"))
 	}
 
+	if s.VRRPConfig != nil {
+		if s.VRRPConfig.VRRPVirtualRouterID == 0 {
+			errs = errors.Join(errs, errors.New("vrrp: virtualRouterID must be specified"))
+		}
+
+		if s.VRRPConfig.VRRPPriority == 255 {
+			errs = errors.Join(errs, errors.New("vrrp: priority must be in range 1-254"))
+		}
+
+		if interval := s.VRRPConfig.VRRPAdvertisementInterval; interval != 0 &&
+			(interval < 10*time.Millisecond || interval > 4095*10*time.Millisecond || interval%(10*time.Millisecond) != 0) {
+			errs = errors.Join(errs, errors.New("vrrp: advertisementInterval must be in range 10ms-40.95s, in 10ms steps"))
+		}
+	}
+
 	return warnings, errs
 }
 
@@ -131,6 +206,47 @@ func (s *Layer2VIPConfigV1Alpha1) VIP() netip.Addr {
 	return addr
 }
 
+// VRRP implements config.NetworkLayer2VIPConfig interface.
+func (s *Layer2VIPConfigV1Alpha1) VRRP() optional.Optional[config.NetworkVRRPConfig] {
+	if s.VRRPConfig == nil {
+		return optional.None[config.NetworkVRRPConfig]()
+	}
+
+	return optional.Some[config.NetworkVRRPConfig](s.VRRPConfig)
+}
+
+// VirtualRouterID implements config.NetworkVRRPConfig interface.
+func (v *Layer2VIPVRRPConfig) VirtualRouterID() uint8 {
+	return v.VRRPVirtualRouterID
+}
+
+// Priority implements config.NetworkVRRPConfig interface.
+func (v *Layer2VIPVRRPConfig) Priority() uint8 {
+	if v.VRRPPriority == 0 {
+		return DefaultVRRPPriority
+	}
+
+	return v.VRRPPriority
+}
+
+// Preempt implements config.NetworkVRRPConfig interface.
+func (v *Layer2VIPVRRPConfig) Preempt() bool {
+	if v.VRRPPreempt == nil {
+		return true
+	}
+
+	return *v.VRRPPreempt
+}
+
+// AdvertisementInterval implements config.NetworkVRRPConfig interface.
+func (v *Layer2VIPVRRPConfig) AdvertisementInterval() time.Duration {
+	if v.VRRPAdvertisementInterval == 0 {
+		return DefaultVRRPAdvertisementInterval
+	}
+
+	return v.VRRPAdvertisementInterval
+}
+
 // ConflictsWithKinds implements config.ConflictingDocument interface.
 func (s *Layer2VIPConfigV1Alpha1) ConflictsWithKinds() []string {
 	return []string{HCloudVIPKind}
@@ -7,7 +7,9 @@ package network_test
 import (
 	_ "embed"
 	"testing"
+	"time"
 
+	"github.com/siderolabs/go-pointer"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -98,6 +100,51 @@ func TestLayer2VIPValidate(t *testing.T) {
 				c := network.NewLayer2VIPConfigV1Alpha1("fd00::1")
 				c.LinkName = "net45"
 
+				return c
+			},
+		},
+		{
+			name: "invalid VRRP",
+			cfg: func() *network.Layer2VIPConfigV1Alpha1 {
+				c := network.NewLayer2VIPConfigV1Alpha1("1.1.1.1")
+				c.LinkName = "net0"
+				c.VRRPConfig = &network.Layer2VIPVRRPConfig{
+					VRRPPriority:              255,
+					VRRPAdvertisementInterval: 15 * time.Millisecond,
+				}
+
+				return c
+			},
+
+			expectedError: "vrrp: virtualRouterID must be specified\nvrrp: priority must be in range 1-254\nvrrp: advertisementInterval must be in range 10ms-40.95s, in 10ms steps",
+		},
+		{
+			name: "VRRP interval too long",
+			cfg: func() *network.Layer2VIPConfigV1Alpha1 {
+				c := network.NewLayer2VIPConfigV1Alpha1("1.1.1.1")
+				c.LinkName = "net0"
+				c.VRRPConfig = &network.Layer2VIPVRRPConfig{
+					VRRPVirtualRouterID:       1,
+					VRRPAdvertisementInterval: time.Minute,
+				}
+
+				return c
+			},
+
+			expectedError: "vrrp: advertisementInterval must be in range 10ms-40.95s, in 10ms steps",
+		},
+		{
+			name: "valid VRRP",
+			cfg: func() *network.Layer2VIPConfigV1Alpha1 {
+				c := network.NewLayer2VIPConfigV1Alpha1("fd00::1")
+				c.LinkName = "net45"
+				c.VRRPConfig = &network.Layer2VIPVRRPConfig{
+					VRRPVirtualRouterID:       51,
+					VRRPPriority:              150,
+					VRRPPreempt:               pointer.To(false),
+					VRRPAdvertisementInterval: 100 * time.Millisecond,
+				}
+
 				return c
 			},
 		},
@@ -117,3 +164,35 @@ func TestLayer2VIPValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestLayer2VIPConfigVRRP(t *testing.T) {
+	t.Parallel()
+
+	c := network.NewLayer2VIPConfigV1Alpha1("1.2.3.4")
+	c.LinkName = "net0"
+
+	assert.False(t, c.VRRP().IsPresent())
+
+	c.VRRPConfig = &network.Layer2VIPVRRPConfig{
+		VRRPVirtualRouterID: 51,
+	}
+
+	vrrp, ok := c.VRRP().Get()
+	require.True(t, ok)
+
+	assert.EqualValues(t, 51, vrrp.VirtualRouterID())
+	assert.EqualValues(t, network.DefaultVRRPPriority, vrrp.Priority())
+	assert.True(t, vrrp.Preempt())
+	assert.Equal(t, network.DefaultVRRPAdvertisementInterval, vrrp.AdvertisementInterval())
+
+	c.VRRPConfig.VRRPPriority = 200
+	c.VRRPConfig.VRRPPreempt = pointer.To(false)
+	c.VRRPConfig.VRRPAdvertisementInterval = 200 * time.Millisecond
+
+	vrrp, ok = c.VRRP().Get()
+	require.True(t, ok)
+
+	assert.EqualValues(t, 200, vrrp.Priority())
+	assert.False(t, vrrp.Preempt())
+	assert.Equal(t, 200*time.Millisecond, vrrp.AdvertisementInterval())
+}
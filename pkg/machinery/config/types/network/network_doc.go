@@ -920,6 +920,13 @@ func (DHCPv6ConfigV1Alpha1) Doc() *encoder.Doc {
 				Description: "Raw value of the DUID to use as client identifier.\n\nThis field is only used if 'clientIdentifier' is set to 'duid'.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Raw value of the DUID to use as client identifier." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "prefixDelegation",
+				Type:        "DHCPv6PrefixDelegationConfig",
+				Note:        "",
+				Description: "Request a delegated prefix (IA_PD) from the DHCPv6 server.\n\nSubnets of the delegated prefix can be assigned to downstream links (e.g. bridges used by local VMs and pods).\nDownstream addresses are updated each time the delegation is renewed.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Request a delegated prefix (IA_PD) from the DHCPv6 server." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -927,6 +934,217 @@ func (DHCPv6ConfigV1Alpha1) Doc() *encoder.Doc {
 
 	doc.Fields[1].AddExample("", "enp0s2")
 	doc.Fields[5].AddExample("", "00:01:00:01:23:45:67:89:ab:cd:ef:01:23:45")
+	doc.Fields[6].AddExample("", examplePrefixDelegationConfig())
+
+	return doc
+}
+
+func (DHCPv6PrefixDelegationConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "DHCPv6PrefixDelegationConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "DHCPv6PrefixDelegationConfig configures DHCPv6 prefix delegation." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "DHCPv6PrefixDelegationConfig configures DHCPv6 prefix delegation.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "DHCPv6ConfigV1Alpha1",
+				FieldName: "prefixDelegation",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "prefixLength",
+				Type:        "uint8",
+				Note:        "",
+				Description: "Prefix length to request from the DHCPv6 server (1-128).\n\nThe value is a hint, the server might delegate a prefix of a different length.\nIf not set, the server picks the prefix length.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Prefix length to request from the DHCPv6 server (1-128)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "downstream",
+				Type:        "[]DHCPv6PrefixDelegationDownstream",
+				Note:        "",
+				Description: "List of downstream links which get a subnet of the delegated prefix.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of downstream links which get a subnet of the delegated prefix." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", examplePrefixDelegationConfig())
+
+	doc.Fields[0].AddExample("", uint8(56))
+
+	return doc
+}
+
+func (DHCPv6PrefixDelegationDownstream) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "DHCPv6PrefixDelegationDownstream",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "DHCPv6PrefixDelegationDownstream assigns a subnet of the delegated prefix to a link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "DHCPv6PrefixDelegationDownstream assigns a subnet of the delegated prefix to a link.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "DHCPv6PrefixDelegationConfig",
+				FieldName: "downstream",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "link",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the downstream link.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the downstream link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "subnetID",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Index of the subnet within the delegated prefix.\n\nFor example, with the delegated prefix `2001:db8:0:100::/56` and the subnet length of 64,\nthe subnet ID 1 is `2001:db8:0:101::/64`.\nDefaults to 0.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Index of the subnet within the delegated prefix." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "subnetLength",
+				Type:        "uint8",
+				Note:        "",
+				Description: "Length of the subnet assigned to the link.\nDefaults to 64.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Length of the subnet assigned to the link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "routerAdvertisements",
+				Type:        "bool",
+				Note:        "",
+				Description: "Send router advertisements for the subnet on the link.\n\nRouter advertisements announce the node as the default router and allow hosts\nattached to the link to configure addresses using SLAAC.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Send router advertisements for the subnet on the link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", "br0")
+
+	return doc
+}
+
+func (Dot1XConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "Dot1XConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "Dot1XConfig is a config document to configure IEEE 802.1X port authentication of a link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "Dot1XConfig is a config document to configure IEEE 802.1X port authentication of a link.\nTalos runs the 802.1X supplicant on the link, and network configuration operators (e.g. DHCP)\non the link are started only after the link is authorized by the switch.\nIf the switch doesn't respond to the supplicant, the port is assumed not to be controlled.\n",
+		Fields: []encoder.Doc{
+			{
+				Type:   "Meta",
+				Inline: true,
+			},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the link (interface) to authenticate.\n\nLink aliases can be used as well.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the link (interface) to authenticate." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "identity",
+				Type:        "string",
+				Note:        "",
+				Description: "Identity (user name) of the supplicant.\n\nFor EAP-TLS with the generated client certificate, the identity is used as the certificate common name.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Identity (user name) of the supplicant." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "anonymousIdentity",
+				Type:        "string",
+				Note:        "",
+				Description: "Identity sent in the clear before the TLS tunnel is established.\n\nIf not set, the identity is used.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Identity sent in the clear before the TLS tunnel is established." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "ca",
+				Type:        "string",
+				Note:        "",
+				Description: "PEM-encoded CA certificate(s) to verify the authentication server certificate.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "PEM-encoded CA certificate(s) to verify the authentication server certificate." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "serverName",
+				Type:        "string",
+				Note:        "",
+				Description: "Expected name of the authentication server (in the server certificate).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Expected name of the authentication server (in the server certificate)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "tls",
+				Type:        "Dot1XTLSConfig",
+				Note:        "",
+				Description: "EAP-TLS authentication settings.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "EAP-TLS authentication settings." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "peap",
+				Type:        "Dot1XPEAPConfig",
+				Note:        "",
+				Description: "PEAP (EAP-MSCHAPv2) authentication settings.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "PEAP (EAP-MSCHAPv2) authentication settings." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleDot1XConfigV1Alpha1TLS())
+
+	doc.AddExample("", exampleDot1XConfigV1Alpha1PEAP())
+
+	return doc
+}
+
+func (Dot1XTLSConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "Dot1XTLSConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "Dot1XTLSConfig configures EAP-TLS authentication." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "Dot1XTLSConfig configures EAP-TLS authentication.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "Dot1XConfigV1Alpha1",
+				FieldName: "tls",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "clientIdentity",
+				Type:        "CertificateAndKey",
+				Note:        "",
+				Description: "PEM-encoded client certificate and key.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "PEM-encoded client certificate and key." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "clientIssuer",
+				Type:        "CertificateAndKey",
+				Note:        "",
+				Description: "PEM-encoded CA certificate and key to issue the client certificate.\n\nThe client certificate is generated on the machine with the identity as the common name,\nand it is renewed automatically.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "PEM-encoded CA certificate and key to issue the client certificate." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (Dot1XPEAPConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "Dot1XPEAPConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "Dot1XPEAPConfig configures PEAP authentication." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "Dot1XPEAPConfig configures PEAP authentication.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "Dot1XConfigV1Alpha1",
+				FieldName: "peap",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "password",
+				Type:        "string",
+				Note:        "",
+				Description: "Password for the inner EAP-MSCHAPv2 authentication.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Password for the inner EAP-MSCHAPv2 authentication." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
 
 	return doc
 }
@@ -1423,7 +1641,7 @@ func (Layer2VIPConfigV1Alpha1) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "Layer2VIPConfig",
 		Comments:    [3]string{"" /* encoder.HeadComment */, "Layer2VIPConfig is a config document to configure virtual IP using Layer 2 (Ethernet) advertisement." /* encoder.LineComment */, "" /* encoder.FootComment */},
-		Description: "Layer2VIPConfig is a config document to configure virtual IP using Layer 2 (Ethernet) advertisement.\nVirtual IP configuration should be used only on controlplane nodes to provide virtual IP for Kubernetes API server.\nAny other use cases are not supported and may lead to unexpected behavior.\nVirtual IP will be announced from only one node at a time using gratuitous ARP announcements for IPv4.\n",
+		Description: "Layer2VIPConfig is a config document to configure virtual IP using Layer 2 (Ethernet) advertisement.\nBy default, the node which announces the virtual IP is elected using etcd, so the virtual IP can be used only on controlplane nodes\nto provide virtual IP for Kubernetes API server.\nWith the `vrrp` section, the node is elected using VRRPv3 instead, which doesn't require etcd, so the virtual IP can be used\non any node (e.g. for ingress on worker nodes).\nVirtual IP will be announced from only one node at a time using gratuitous ARP announcements for IPv4, and unsolicited\nneighbor advertisements for IPv6.\n",
 		Fields: []encoder.Doc{
 			{
 				Type:   "Meta",
@@ -1443,17 +1661,75 @@ func (Layer2VIPConfigV1Alpha1) Doc() *encoder.Doc {
 				Description: "Name of the link to assign the VIP to.\n\nSelector must match exactly one link, otherwise an error is returned.\nIf multiple selectors match the same link, the first one is used.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the link to assign the VIP to." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "vrrp",
+				Type:        "Layer2VIPVRRPConfig",
+				Note:        "",
+				Description: "Elect the node which announces the virtual IP using VRRPv3 instead of etcd.\n\nAll nodes sharing the virtual IP should use the same virtual router ID, and the virtual router ID\nshould be unique on the link.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Elect the node which announces the virtual IP using VRRPv3 instead of etcd." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
 	doc.AddExample("", exampleLayer2VIPConfigV1Alpha1())
 
+	doc.AddExample("", exampleLayer2VIPConfigV1Alpha1VRRP())
+
 	doc.Fields[1].AddExample("", "192.168.100.1")
 	doc.Fields[1].AddExample("", "fd00::1")
 
 	return doc
 }
 
+func (Layer2VIPVRRPConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "Layer2VIPVRRPConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "Layer2VIPVRRPConfig configures VRRPv3 election of the virtual IP." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "Layer2VIPVRRPConfig configures VRRPv3 election of the virtual IP.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "Layer2VIPConfigV1Alpha1",
+				FieldName: "vrrp",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "virtualRouterID",
+				Type:        "uint8",
+				Note:        "",
+				Description: "Virtual router ID (1-255).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Virtual router ID (1-255)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "priority",
+				Type:        "uint8",
+				Note:        "",
+				Description: "Priority of the node (1-254), the node with the highest priority announces the virtual IP.\nDefaults to 100.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Priority of the node (1-254), the node with the highest priority announces the virtual IP." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "preempt",
+				Type:        "bool",
+				Note:        "",
+				Description: "Whether a node with higher priority takes over the virtual IP from a node with lower priority.\nDefaults to true.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Whether a node with higher priority takes over the virtual IP from a node with lower priority." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "advertisementInterval",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Interval between VRRP advertisements (10ms-40.95s, in 10ms steps).\nThe virtual IP fails over after approximately three advertisement intervals.\nDefaults to 1s.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Interval between VRRP advertisements (10ms-40.95s, in 10ms steps)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", uint8(51))
+	doc.Fields[1].AddExample("", uint8(150))
+
+	return doc
+}
+
 func (LinkConfigV1Alpha1) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "LinkConfig",
@@ -2595,6 +2871,11 @@ func GetFileDoc() *encoder.FileDoc {
 			DefaultActionConfigV1Alpha1{}.Doc(),
 			DHCPv4ConfigV1Alpha1{}.Doc(),
 			DHCPv6ConfigV1Alpha1{}.Doc(),
+			DHCPv6PrefixDelegationConfig{}.Doc(),
+			DHCPv6PrefixDelegationDownstream{}.Doc(),
+			Dot1XConfigV1Alpha1{}.Doc(),
+			Dot1XTLSConfig{}.Doc(),
+			Dot1XPEAPConfig{}.Doc(),
 			DummyLinkConfigV1Alpha1{}.Doc(),
 			EthernetConfigV1Alpha1{}.Doc(),
 			EthernetRingsConfig{}.Doc(),
@@ -2606,6 +2887,7 @@ func GetFileDoc() *encoder.FileDoc {
 			KubeSpanFiltersConfig{}.Doc(),
 			KubespanEndpointsConfigV1Alpha1{}.Doc(),
 			Layer2VIPConfigV1Alpha1{}.Doc(),
+			Layer2VIPVRRPConfig{}.Doc(),
 			LinkConfigV1Alpha1{}.Doc(),
 			CommonLinkConfig{}.Doc(),
 			AddressConfig{}.Doc(),
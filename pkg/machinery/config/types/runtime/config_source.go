@@ -0,0 +1,234 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/siderolabs/gen/ensure"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/signature"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// ConfigSourceKind is a ConfigSourceConfig config document kind.
+const ConfigSourceKind = "ConfigSourceConfig"
+
+func init() {
+	registry.Register(ConfigSourceKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &ConfigSourceV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.ConfigSourceConfig = &ConfigSourceV1Alpha1{}
+	_ config.Validator          = &ConfigSourceV1Alpha1{}
+)
+
+// Interval constants.
+const (
+	MinConfigSourceInterval     = 30 * time.Second
+	DefaultConfigSourceInterval = 5 * time.Minute
+)
+
+// ConfigSourceV1Alpha1 configures periodic pull of the machine configuration from a remote source.
+//
+//	examples:
+//	  - value: exampleConfigSourceV1Alpha1()
+//	alias: ConfigSourceConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/ConfigSourceConfig
+type ConfigSourceV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     The URL of the machine configuration.
+	//
+	//     The scheme must be https:// or oci://.
+	//     For oci:// URLs, the configuration is the single layer of the referenced OCI artifact,
+	//     and the detached signature is stored in the `dev.talos.config.signature` manifest annotation.
+	//
+	//     The pulled configuration replaces the whole machine configuration,
+	//     so it should contain this document to keep pulling the configuration.
+	//   examples:
+	//     - value: >
+	//        "https://config.example.com/nodes/worker.yaml"
+	//     - value: >
+	//        "oci://registry.example.com/fleet/worker:latest"
+	//   schema:
+	//     type: string
+	//     pattern: "^(https|oci)://"
+	SourceURL meta.URL `yaml:"url"`
+	//   description: |
+	//     The URL of the detached signature of the machine configuration (https:// sources only).
+	//
+	//     Defaults to the configuration URL with `.sig` suffix appended to the path.
+	//   schema:
+	//     type: string
+	//     pattern: "^https://"
+	SourceSignatureURL meta.URL `yaml:"signatureURL,omitempty"`
+	//   description: |
+	//     The interval between the configuration pulls.
+	//
+	//     Default value is 5 minutes, minimum value is 30 seconds.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	SourceInterval time.Duration `yaml:"interval,omitempty"`
+	//   description: |
+	//     The mode to apply the pulled configuration with.
+	//
+	//     `no-reboot` applies the configuration immediately,
+	//     `reboot` applies the configuration and reboots the machine,
+	//     `staged` applies the configuration on the next reboot.
+	//     Defaults to `no-reboot`.
+	//   values:
+	//     - no-reboot
+	//     - reboot
+	//     - staged
+	SourceApplyMode config.ConfigSourceApplyMode `yaml:"mode,omitempty"`
+	//   description: |
+	//     The list of PEM-encoded public keys (or certificates) trusted to sign the machine configuration.
+	//
+	//     The signature is verified over the raw bytes of the configuration:
+	//     Ed25519 keys sign the data directly, ECDSA and RSA keys sign the SHA-256 digest of the data
+	//     (as produced by `openssl dgst -sha256 -sign`).
+	//     The signature might be raw or base64-encoded.
+	SourceTrustedKeys []string `yaml:"trustedKeys"`
+}
+
+// NewConfigSourceV1Alpha1 creates a new ConfigSourceConfig config document.
+func NewConfigSourceV1Alpha1() *ConfigSourceV1Alpha1 {
+	return &ConfigSourceV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       ConfigSourceKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleConfigSourceV1Alpha1() *ConfigSourceV1Alpha1 {
+	cfg := NewConfigSourceV1Alpha1()
+	cfg.SourceURL.URL = ensure.Value(url.Parse("https://config.example.com/nodes/worker.yaml"))
+	cfg.SourceInterval = 10 * time.Minute
+	cfg.SourceApplyMode = config.ConfigSourceApplyModeNoReboot
+	cfg.SourceTrustedKeys = []string{
+		"-----BEGIN PUBLIC KEY-----\nMCowBQYDK2VwAyEA/9PUzWqS/Tofp2VeLCGKB1Iw63HrguH52YIJeXKNPBU=\n-----END PUBLIC KEY-----\n",
+	}
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *ConfigSourceV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// URL implements config.ConfigSourceConfig interface.
+func (s *ConfigSourceV1Alpha1) URL() *url.URL {
+	return s.SourceURL.URL
+}
+
+// SignatureURL implements config.ConfigSourceConfig interface.
+func (s *ConfigSourceV1Alpha1) SignatureURL() *url.URL {
+	if s.SourceSignatureURL.URL != nil {
+		return s.SourceSignatureURL.URL
+	}
+
+	if s.SourceURL.URL == nil || s.SourceURL.URL.Scheme != "https" {
+		return nil
+	}
+
+	u := *s.SourceURL.URL
+	u.Path += ".sig"
+	u.RawPath = ""
+
+	return &u
+}
+
+// Interval implements config.ConfigSourceConfig interface.
+func (s *ConfigSourceV1Alpha1) Interval() time.Duration {
+	if s.SourceInterval == 0 {
+		return DefaultConfigSourceInterval
+	}
+
+	return s.SourceInterval
+}
+
+// ApplyMode implements config.ConfigSourceConfig interface.
+func (s *ConfigSourceV1Alpha1) ApplyMode() config.ConfigSourceApplyMode {
+	if s.SourceApplyMode == "" {
+		return config.ConfigSourceApplyModeNoReboot
+	}
+
+	return s.SourceApplyMode
+}
+
+// TrustedKeys implements config.ConfigSourceConfig interface.
+func (s *ConfigSourceV1Alpha1) TrustedKeys() []string {
+	return s.SourceTrustedKeys
+}
+
+// Validate implements config.Validator interface.
+//
+//nolint:gocyclo
+func (s *ConfigSourceV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	switch {
+	case s.SourceURL.URL == nil:
+		errs = errors.Join(errs, errors.New("url: empty value"))
+	case s.SourceURL.URL.Scheme != "https" && s.SourceURL.URL.Scheme != "oci":
+		errs = errors.Join(errs, fmt.Errorf("url: scheme must be https:// or oci://, got %q", s.SourceURL.URL.Scheme))
+	case s.SourceURL.URL.Host == "":
+		errs = errors.Join(errs, errors.New("url: host is required"))
+	}
+
+	if s.SourceSignatureURL.URL != nil {
+		if s.SourceSignatureURL.URL.Scheme != "https" {
+			errs = errors.Join(errs, fmt.Errorf("signatureURL: scheme must be https://, got %q", s.SourceSignatureURL.URL.Scheme))
+		}
+
+		if s.SourceURL.URL != nil && s.SourceURL.URL.Scheme == "oci" {
+			errs = errors.Join(errs, errors.New("signatureURL: not supported for oci:// sources"))
+		}
+	}
+
+	if s.SourceInterval != 0 && s.SourceInterval < MinConfigSourceInterval {
+		errs = errors.Join(errs, fmt.Errorf("interval: minimum value is %s", MinConfigSourceInterval))
+	}
+
+	switch s.SourceApplyMode {
+	case "", config.ConfigSourceApplyModeNoReboot, config.ConfigSourceApplyModeReboot, config.ConfigSourceApplyModeStaged:
+	default:
+		errs = errors.Join(errs, fmt.Errorf("mode: unsupported value %q", s.SourceApplyMode))
+	}
+
+	if len(s.SourceTrustedKeys) == 0 {
+		errs = errors.Join(errs, errors.New("trustedKeys: at least one trusted key is required"))
+	}
+
+	for i, key := range s.SourceTrustedKeys {
+		if _, err := signature.ParsePublicKeys([]byte(key)); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("trustedKeys[%d]: %w", i, err))
+		}
+	}
+
+	return nil, errs
+}
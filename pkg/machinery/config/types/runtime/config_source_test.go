@@ -0,0 +1,131 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	_ "embed"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/gen/ensure"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+)
+
+//go:embed testdata/configsource.yaml
+var expectedConfigSourceDocument []byte
+
+const testTrustedKey = "-----BEGIN PUBLIC KEY-----\nMCowBQYDK2VwAyEA/9PUzWqS/Tofp2VeLCGKB1Iw63HrguH52YIJeXKNPBU=\n-----END PUBLIC KEY-----\n"
+
+func TestConfigSourceMarshalStability(t *testing.T) {
+	cfg := runtime.NewConfigSourceV1Alpha1()
+	cfg.SourceURL.URL = ensure.Value(url.Parse("oci://registry.example.com/fleet/worker:latest"))
+	cfg.SourceInterval = 15 * time.Minute
+	cfg.SourceApplyMode = config.ConfigSourceApplyModeStaged
+	cfg.SourceTrustedKeys = []string{testTrustedKey}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedConfigSourceDocument, marshaled)
+}
+
+func TestConfigSourceDefaults(t *testing.T) {
+	t.Parallel()
+
+	cfg := runtime.NewConfigSourceV1Alpha1()
+	cfg.SourceURL.URL = ensure.Value(url.Parse("https://config.example.com/nodes/worker.yaml?token=abc"))
+
+	assert.Equal(t, "https://config.example.com/nodes/worker.yaml.sig?token=abc", cfg.SignatureURL().String())
+	assert.Equal(t, runtime.DefaultConfigSourceInterval, cfg.Interval())
+	assert.Equal(t, config.ConfigSourceApplyModeNoReboot, cfg.ApplyMode())
+
+	cfg.SourceSignatureURL.URL = ensure.Value(url.Parse("https://signatures.example.com/worker.sig"))
+
+	assert.Equal(t, "https://signatures.example.com/worker.sig", cfg.SignatureURL().String())
+
+	cfg.SourceURL.URL = ensure.Value(url.Parse("oci://registry.example.com/fleet/worker:latest"))
+	cfg.SourceSignatureURL.URL = nil
+
+	assert.Nil(t, cfg.SignatureURL())
+}
+
+func TestConfigSourceValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *runtime.ConfigSourceV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  runtime.NewConfigSourceV1Alpha1,
+
+			expectedError: "url: empty value\ntrustedKeys: at least one trusted key is required",
+		},
+		{
+			name: "invalid",
+			cfg: func() *runtime.ConfigSourceV1Alpha1 {
+				cfg := runtime.NewConfigSourceV1Alpha1()
+				cfg.SourceURL.URL = ensure.Value(url.Parse("oci://registry.example.com/fleet/worker:latest"))
+				cfg.SourceSignatureURL.URL = ensure.Value(url.Parse("http://config.example.com/worker.sig"))
+				cfg.SourceInterval = time.Second
+				cfg.SourceApplyMode = "auto"
+				cfg.SourceTrustedKeys = []string{"foo"}
+
+				return cfg
+			},
+
+			expectedError: "signatureURL: scheme must be https://, got \"http\"\nsignatureURL: not supported for oci:// sources\n" +
+				"interval: minimum value is 30s\nmode: unsupported value \"auto\"\ntrustedKeys[0]: trailing data after PEM blocks",
+		},
+		{
+			name: "plain http",
+			cfg: func() *runtime.ConfigSourceV1Alpha1 {
+				cfg := runtime.NewConfigSourceV1Alpha1()
+				cfg.SourceURL.URL = ensure.Value(url.Parse("http://config.example.com/worker.yaml"))
+				cfg.SourceTrustedKeys = []string{testTrustedKey}
+
+				return cfg
+			},
+
+			expectedError: "url: scheme must be https:// or oci://, got \"http\"",
+		},
+		{
+			name: "valid",
+			cfg: func() *runtime.ConfigSourceV1Alpha1 {
+				cfg := runtime.NewConfigSourceV1Alpha1()
+				cfg.SourceURL.URL = ensure.Value(url.Parse("https://config.example.com/worker.yaml"))
+				cfg.SourceApplyMode = config.ConfigSourceApplyModeReboot
+				cfg.SourceTrustedKeys = []string{testTrustedKey}
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, err := test.cfg().Validate(validationMode{})
+
+			assert.Empty(t, warnings)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type EventSinkV1Alpha1 -type EnvironmentV1Alpha1 -type KmsgLogV1Alpha1 -type OOMV1Alpha1 -type SysctlConfigV1Alpha1 -type SysfsConfigV1Alpha1 -type EtcFileConfigV1Alpha1 -type UdevRulesConfigV1Alpha1 -type UnattendedInstallConfigV1Alpha1 -type WatchdogTimerV1Alpha1 -type SecurityProfileConfigV1Alpha1 -type KernelModuleConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type EventSinkV1Alpha1 -type EnvironmentV1Alpha1 -type KmsgLogV1Alpha1 -type OOMV1Alpha1 -type SysctlConfigV1Alpha1 -type SysfsConfigV1Alpha1 -type EtcFileConfigV1Alpha1 -type UdevRulesConfigV1Alpha1 -type UnattendedInstallConfigV1Alpha1 -type WatchdogTimerV1Alpha1 -type SecurityProfileConfigV1Alpha1 -type KernelModuleConfigV1Alpha1 -type ConfigSourceV1Alpha1 -type MaintenancePolicyV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package runtime
 
@@ -131,3 +131,43 @@ func (o *KernelModuleConfigV1Alpha1) DeepCopy() *KernelModuleConfigV1Alpha1 {
 	}
 	return &cp
 }
+
+// DeepCopy generates a deep copy of *ConfigSourceV1Alpha1.
+func (o *ConfigSourceV1Alpha1) DeepCopy() *ConfigSourceV1Alpha1 {
+	var cp ConfigSourceV1Alpha1 = *o
+	if o.SourceURL.URL != nil {
+		cp.SourceURL.URL = new(url.URL)
+		*cp.SourceURL.URL = *o.SourceURL.URL
+		if o.SourceURL.URL.User != nil {
+			cp.SourceURL.URL.User = new(url.Userinfo)
+			*cp.SourceURL.URL.User = *o.SourceURL.URL.User
+		}
+	}
+	if o.SourceSignatureURL.URL != nil {
+		cp.SourceSignatureURL.URL = new(url.URL)
+		*cp.SourceSignatureURL.URL = *o.SourceSignatureURL.URL
+		if o.SourceSignatureURL.URL.User != nil {
+			cp.SourceSignatureURL.URL.User = new(url.Userinfo)
+			*cp.SourceSignatureURL.URL.User = *o.SourceSignatureURL.URL.User
+		}
+	}
+	if o.SourceTrustedKeys != nil {
+		cp.SourceTrustedKeys = make([]string, len(o.SourceTrustedKeys))
+		copy(cp.SourceTrustedKeys, o.SourceTrustedKeys)
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *MaintenancePolicyV1Alpha1.
+func (o *MaintenancePolicyV1Alpha1) DeepCopy() *MaintenancePolicyV1Alpha1 {
+	var cp MaintenancePolicyV1Alpha1 = *o
+	if o.MaintenanceWindows != nil {
+		cp.MaintenanceWindows = make([]MaintenanceWindow, len(o.MaintenanceWindows))
+		copy(cp.MaintenanceWindows, o.MaintenanceWindows)
+	}
+	if o.MaintenanceConcurrency.ConcurrencyLabels != nil {
+		cp.MaintenanceConcurrency.ConcurrencyLabels = make([]MaintenanceLabelLimit, len(o.MaintenanceConcurrency.ConcurrencyLabels))
+		copy(cp.MaintenanceConcurrency.ConcurrencyLabels, o.MaintenanceConcurrency.ConcurrencyLabels)
+	}
+	return &cp
+}
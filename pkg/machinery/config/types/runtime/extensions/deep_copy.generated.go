@@ -17,5 +17,13 @@ func (o *ServiceConfigV1Alpha1) DeepCopy() *ServiceConfigV1Alpha1 {
 		cp.ServiceEnvironment = make([]string, len(o.ServiceEnvironment))
 		copy(cp.ServiceEnvironment, o.ServiceEnvironment)
 	}
+	if o.ServiceResources.ResourcesOOMScoreAdj != nil {
+		cp.ServiceResources.ResourcesOOMScoreAdj = new(int)
+		*cp.ServiceResources.ResourcesOOMScoreAdj = *o.ServiceResources.ResourcesOOMScoreAdj
+	}
+	if o.ServiceSecurity.SecurityDropCapabilities != nil {
+		cp.ServiceSecurity.SecurityDropCapabilities = make([]string, len(o.ServiceSecurity.SecurityDropCapabilities))
+		copy(cp.ServiceSecurity.SecurityDropCapabilities, o.ServiceSecurity.SecurityDropCapabilities)
+	}
 	return &cp
 }
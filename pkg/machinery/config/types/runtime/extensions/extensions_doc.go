@@ -41,6 +41,20 @@ func (ServiceConfigV1Alpha1) Doc() *encoder.Doc {
 				Description: "The environment for the extension service.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "The environment for the extension service." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "resources",
+				Type:        "ResourcesConfig",
+				Note:        "",
+				Description: "Resource limits for the extension service.\n\nLimits override the values defined by the extension.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Resource limits for the extension service." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "security",
+				Type:        "SecurityConfig",
+				Note:        "",
+				Description: "Security settings for the extension service.\n\nSecurity settings can only tighten the sandbox defined by the extension.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Security settings for the extension service." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -49,6 +63,115 @@ func (ServiceConfigV1Alpha1) Doc() *encoder.Doc {
 	return doc
 }
 
+func (ResourcesConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "ResourcesConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "ResourcesConfig is a resource limits config for extension services." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "ResourcesConfig is a resource limits config for extension services.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "ServiceConfigV1Alpha1",
+				FieldName: "resources",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "cpuWeight",
+				Type:        "uint64",
+				Note:        "",
+				Description: "The relative CPU weight of the service (cgroup v2 `cpu.weight`), 1-10000.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The relative CPU weight of the service (cgroup v2 `cpu.weight`), 1-10000." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "cpuMax",
+				Type:        "string",
+				Note:        "",
+				Description: "The CPU limit of the service in millicores (cgroup v2 `cpu.max`).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The CPU limit of the service in millicores (cgroup v2 `cpu.max`)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "memoryMax",
+				Type:        "ByteSize",
+				Note:        "",
+				Description: "The hard memory limit of the service (cgroup v2 `memory.max`).\n\nSize is specified in bytes, but can be expressed in human readable format, e.g. 256MiB.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The hard memory limit of the service (cgroup v2 `memory.max`)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "memoryHigh",
+				Type:        "ByteSize",
+				Note:        "",
+				Description: "The memory throttling limit of the service (cgroup v2 `memory.high`).\n\nSize is specified in bytes, but can be expressed in human readable format, e.g. 200MiB.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The memory throttling limit of the service (cgroup v2 `memory.high`)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "ioWeight",
+				Type:        "uint64",
+				Note:        "",
+				Description: "The relative IO weight of the service (cgroup v2 `io.weight`), 1-10000.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The relative IO weight of the service (cgroup v2 `io.weight`), 1-10000." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "oomScoreAdj",
+				Type:        "int",
+				Note:        "",
+				Description: "The OOM score adjustment of the service process, -1000 to 1000.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The OOM score adjustment of the service process, -1000 to 1000." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", 50)
+	doc.Fields[1].AddExample("", "500m")
+	doc.Fields[2].AddExample("", "256MiB")
+	doc.Fields[3].AddExample("", "200MiB")
+	doc.Fields[4].AddExample("", 50)
+	doc.Fields[5].AddExample("", 500)
+
+	return doc
+}
+
+func (SecurityConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "SecurityConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "SecurityConfig is a security config for extension services." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "SecurityConfig is a security config for extension services.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "ServiceConfigV1Alpha1",
+				FieldName: "security",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "dropCapabilities",
+				Type:        "[]string",
+				Note:        "",
+				Description: "The list of capabilities to drop in addition to the capabilities dropped by the extension.\n\n`ALL` drops all capabilities.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The list of capabilities to drop in addition to the capabilities dropped by the extension." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "seccompProfile",
+				Type:        "string",
+				Note:        "",
+				Description: "The seccomp profile of the service.\n\nValid values: `runtime/default`, `localhost/<path>`, where `<path>` is\nthe path to the OCI seccomp profile (JSON) relative to the extension rootfs.\nThe profile can't be relaxed to `unconfined`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The seccomp profile of the service." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "readonlyRootfs",
+				Type:        "bool",
+				Note:        "",
+				Description: "Mount the extension rootfs read-only even if the extension requests a writeable rootfs.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Mount the extension rootfs read-only even if the extension requests a writeable rootfs." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", []string{"CAP_SYS_ADMIN", "CAP_NET_RAW"})
+	doc.Fields[1].AddExample("", "runtime/default")
+
+	return doc
+}
+
 func (ConfigFile) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "ConfigFile",
@@ -88,6 +211,8 @@ func GetFileDoc() *encoder.FileDoc {
 		Description: "Package extensions provides extensions config documents.\n",
 		Structs: []*encoder.Doc{
 			ServiceConfigV1Alpha1{}.Doc(),
+			ResourcesConfig{}.Doc(),
+			SecurityConfig{}.Doc(),
 			ConfigFile{}.Doc(),
 		},
 	}
@@ -7,15 +7,20 @@ package extensions
 //docgen:jsonschema
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/siderolabs/gen/optional"
 	"github.com/siderolabs/gen/xslices"
 
 	"github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
 	"github.com/siderolabs/talos/pkg/machinery/config/merge"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/block"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
 	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/extensions/services"
 )
 
 // ServiceConfigKind is a Extension config document kind.
@@ -59,6 +64,81 @@ type ServiceConfigV1Alpha1 struct {
 	//   description: |
 	//     The environment for the extension service.
 	ServiceEnvironment []string `yaml:"environment,omitempty"`
+	//   description: |
+	//     Resource limits for the extension service.
+	//
+	//     Limits override the values defined by the extension.
+	ServiceResources ResourcesConfig `yaml:"resources,omitempty"`
+	//   description: |
+	//     Security settings for the extension service.
+	//
+	//     Security settings can only tighten the sandbox defined by the extension.
+	ServiceSecurity SecurityConfig `yaml:"security,omitempty"`
+}
+
+// ResourcesConfig is a resource limits config for extension services.
+type ResourcesConfig struct {
+	//   description: |
+	//     The relative CPU weight of the service (cgroup v2 `cpu.weight`), 1-10000.
+	//   examples:
+	//     - value: 50
+	ResourcesCPUWeight uint64 `yaml:"cpuWeight,omitempty"`
+	//   description: |
+	//     The CPU limit of the service in millicores (cgroup v2 `cpu.max`).
+	//   examples:
+	//     - value: '"500m"'
+	ResourcesCPUMax string `yaml:"cpuMax,omitempty"`
+	//   description: |
+	//     The hard memory limit of the service (cgroup v2 `memory.max`).
+	//
+	//     Size is specified in bytes, but can be expressed in human readable format, e.g. 256MiB.
+	//   examples:
+	//     - value: '"256MiB"'
+	//   schema:
+	//     type: string
+	ResourcesMemoryMax block.ByteSize `yaml:"memoryMax,omitempty"`
+	//   description: |
+	//     The memory throttling limit of the service (cgroup v2 `memory.high`).
+	//
+	//     Size is specified in bytes, but can be expressed in human readable format, e.g. 200MiB.
+	//   examples:
+	//     - value: '"200MiB"'
+	//   schema:
+	//     type: string
+	ResourcesMemoryHigh block.ByteSize `yaml:"memoryHigh,omitempty"`
+	//   description: |
+	//     The relative IO weight of the service (cgroup v2 `io.weight`), 1-10000.
+	//   examples:
+	//     - value: 50
+	ResourcesIOWeight uint64 `yaml:"ioWeight,omitempty"`
+	//   description: |
+	//     The OOM score adjustment of the service process, -1000 to 1000.
+	//   examples:
+	//     - value: 500
+	ResourcesOOMScoreAdj *int `yaml:"oomScoreAdj,omitempty"`
+}
+
+// SecurityConfig is a security config for extension services.
+type SecurityConfig struct {
+	//   description: |
+	//     The list of capabilities to drop in addition to the capabilities dropped by the extension.
+	//
+	//     `ALL` drops all capabilities.
+	//   examples:
+	//     - value: '[]string{"CAP_SYS_ADMIN", "CAP_NET_RAW"}'
+	SecurityDropCapabilities []string `yaml:"dropCapabilities,omitempty"`
+	//   description: |
+	//     The seccomp profile of the service.
+	//
+	//     Valid values: `runtime/default`, `localhost/<path>`, where `<path>` is
+	//     the path to the OCI seccomp profile (JSON) relative to the extension rootfs.
+	//     The profile can't be relaxed to `unconfined`.
+	//   examples:
+	//     - value: '"runtime/default"'
+	SecuritySeccompProfile string `yaml:"seccompProfile,omitempty"`
+	//   description: |
+	//     Mount the extension rootfs read-only even if the extension requests a writeable rootfs.
+	SecurityReadonlyRootfs bool `yaml:"readonlyRootfs,omitempty"`
 }
 
 // ConfigFileList is a list of ConfigFiles.
@@ -133,14 +213,8 @@ func (e *ServiceConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.O
 		return nil, fmt.Errorf("name is required")
 	}
 
-	if len(e.ServiceConfigFiles) == 0 && len(e.ServiceEnvironment) == 0 {
-		if len(e.ServiceConfigFiles) == 0 {
-			return nil, fmt.Errorf("no config files found for extension %q", e.ServiceName)
-		}
-
-		if len(e.ServiceEnvironment) == 0 {
-			return nil, fmt.Errorf("no environment defined for extension %q", e.ServiceName)
-		}
+	if len(e.ServiceConfigFiles) == 0 && len(e.ServiceEnvironment) == 0 && e.ServiceResources.IsZero() && e.ServiceSecurity.IsZero() {
+		return nil, fmt.Errorf("no config files, environment, resources or security settings defined for extension %q", e.ServiceName)
 	}
 
 	for _, file := range e.ServiceConfigFiles {
@@ -153,9 +227,78 @@ func (e *ServiceConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.O
 		}
 	}
 
+	if err := errors.Join(e.ServiceResources.validate(), e.ServiceSecurity.validate()); err != nil {
+		return nil, fmt.Errorf("invalid settings for extension %q: %w", e.ServiceName, err)
+	}
+
 	return nil, nil
 }
 
+// IsZero returns true if no resource limits are set.
+func (r ResourcesConfig) IsZero() bool {
+	return r.ResourcesCPUWeight == 0 && r.ResourcesCPUMax == "" && r.ResourcesMemoryMax.IsZero() &&
+		r.ResourcesMemoryHigh.IsZero() && r.ResourcesIOWeight == 0 && r.ResourcesOOMScoreAdj == nil
+}
+
+func (r ResourcesConfig) validate() error {
+	var errs error
+
+	if r.ResourcesCPUWeight > services.MaxCgroupWeight {
+		errs = errors.Join(errs, fmt.Errorf("resources.cpuWeight should be in range 1-%d: %d", services.MaxCgroupWeight, r.ResourcesCPUWeight))
+	}
+
+	if r.ResourcesCPUMax != "" {
+		if _, err := services.ParseMillicores(r.ResourcesCPUMax); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("resources.cpuMax: %w", err))
+		}
+	}
+
+	if r.ResourcesMemoryMax.IsNegative() {
+		errs = errors.Join(errs, errors.New("resources.memoryMax must not be negative"))
+	}
+
+	if r.ResourcesMemoryHigh.IsNegative() {
+		errs = errors.Join(errs, errors.New("resources.memoryHigh must not be negative"))
+	}
+
+	if r.ResourcesIOWeight > services.MaxCgroupWeight {
+		errs = errors.Join(errs, fmt.Errorf("resources.ioWeight should be in range 1-%d: %d", services.MaxCgroupWeight, r.ResourcesIOWeight))
+	}
+
+	if r.ResourcesOOMScoreAdj != nil && (*r.ResourcesOOMScoreAdj < -1000 || *r.ResourcesOOMScoreAdj > 1000) {
+		errs = errors.Join(errs, fmt.Errorf("resources.oomScoreAdj should be in range -1000-1000: %d", *r.ResourcesOOMScoreAdj))
+	}
+
+	return errs
+}
+
+// IsZero returns true if no security settings are set.
+func (s SecurityConfig) IsZero() bool {
+	return len(s.SecurityDropCapabilities) == 0 && s.SecuritySeccompProfile == "" && !s.SecurityReadonlyRootfs
+}
+
+func (s SecurityConfig) validate() error {
+	var errs error
+
+	for _, capability := range s.SecurityDropCapabilities {
+		if strings.ToUpper(capability) == services.CapabilityAll {
+			continue
+		}
+
+		if err := services.ValidateCapability(capability); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("security.dropCapabilities: %w", err))
+		}
+	}
+
+	if s.SecuritySeccompProfile == services.SeccompProfileUnconfined {
+		errs = errors.Join(errs, errors.New("security.seccompProfile can't be set to unconfined"))
+	} else if err := services.ValidateSeccompProfile(s.SecuritySeccompProfile); err != nil {
+		errs = errors.Join(errs, fmt.Errorf("security.seccompProfile: %w", err))
+	}
+
+	return errs
+}
+
 // Name implements config.ExtensionServiceConfig interface.
 func (e *ServiceConfigV1Alpha1) Name() string {
 	return e.ServiceName
@@ -173,6 +316,75 @@ func (e *ServiceConfigV1Alpha1) Environment() []string {
 	return e.ServiceEnvironment
 }
 
+// Resources implements config.ExtensionServiceConfig interface.
+func (e *ServiceConfigV1Alpha1) Resources() config.ExtensionServiceResourcesConfig {
+	return e.ServiceResources
+}
+
+// Security implements config.ExtensionServiceConfig interface.
+func (e *ServiceConfigV1Alpha1) Security() config.ExtensionServiceSecurityConfig {
+	return e.ServiceSecurity
+}
+
+// CPUWeight implements config.ExtensionServiceResourcesConfig interface.
+func (r ResourcesConfig) CPUWeight() uint64 {
+	return r.ResourcesCPUWeight
+}
+
+// CPUMaxMillicores implements config.ExtensionServiceResourcesConfig interface.
+func (r ResourcesConfig) CPUMaxMillicores() uint64 {
+	if r.ResourcesCPUMax == "" {
+		return 0
+	}
+
+	millicores, err := services.ParseMillicores(r.ResourcesCPUMax)
+	if err != nil {
+		// validated in Validate()
+		return 0
+	}
+
+	return millicores
+}
+
+// MemoryMax implements config.ExtensionServiceResourcesConfig interface.
+func (r ResourcesConfig) MemoryMax() uint64 {
+	return r.ResourcesMemoryMax.Value()
+}
+
+// MemoryHigh implements config.ExtensionServiceResourcesConfig interface.
+func (r ResourcesConfig) MemoryHigh() uint64 {
+	return r.ResourcesMemoryHigh.Value()
+}
+
+// IOWeight implements config.ExtensionServiceResourcesConfig interface.
+func (r ResourcesConfig) IOWeight() uint64 {
+	return r.ResourcesIOWeight
+}
+
+// OOMScoreAdj implements config.ExtensionServiceResourcesConfig interface.
+func (r ResourcesConfig) OOMScoreAdj() optional.Optional[int] {
+	if r.ResourcesOOMScoreAdj == nil {
+		return optional.None[int]()
+	}
+
+	return optional.Some(*r.ResourcesOOMScoreAdj)
+}
+
+// DropCapabilities implements config.ExtensionServiceSecurityConfig interface.
+func (s SecurityConfig) DropCapabilities() []string {
+	return s.SecurityDropCapabilities
+}
+
+// SeccompProfile implements config.ExtensionServiceSecurityConfig interface.
+func (s SecurityConfig) SeccompProfile() string {
+	return s.SecuritySeccompProfile
+}
+
+// ReadonlyRootfs implements config.ExtensionServiceSecurityConfig interface.
+func (s SecurityConfig) ReadonlyRootfs() bool {
+	return s.SecurityReadonlyRootfs
+}
+
 // Content implements config.ExtensionServiceConfigFile interface.
 func (e ConfigFile) Content() string {
 	return e.ConfigFileContent
@@ -193,6 +405,10 @@ func extensionServiceConfigV1Alpha1() *ServiceConfigV1Alpha1 {
 		},
 	}
 	cfg.ServiceEnvironment = []string{"NUT_UPS=upsname"}
+	cfg.ServiceResources = ResourcesConfig{
+		ResourcesCPUMax:    "500m",
+		ResourcesMemoryMax: block.MustByteSize("128MiB"),
+	}
 
 	return cfg
 }
@@ -13,6 +13,7 @@ import (
 
 	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
 	"github.com/siderolabs/talos/pkg/machinery/config/merge"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/block"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime/extensions"
 )
 
@@ -31,6 +32,15 @@ func TestExtensionServiceConfigMarshalStability(t *testing.T) {
 		},
 	}
 	cfg.ServiceEnvironment = []string{"FOO=BAR"}
+	cfg.ServiceResources = extensions.ResourcesConfig{
+		ResourcesCPUMax:      "500m",
+		ResourcesMemoryMax:   block.MustByteSize("256MiB"),
+		ResourcesOOMScoreAdj: new(500),
+	}
+	cfg.ServiceSecurity = extensions.SecurityConfig{
+		SecurityDropCapabilities: []string{"CAP_NET_RAW"},
+		SecurityReadonlyRootfs:   true,
+	}
 
 	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
 	require.NoError(t, err)
@@ -70,3 +80,120 @@ func TestExtensionServiceConfigMerge(t *testing.T) {
 	assert.Equal(t, "hello world", cfgLeft.ConfigFiles()[0].Content())
 	assert.Equal(t, "bar", cfgLeft.ConfigFiles()[1].Content())
 }
+
+func TestExtensionServiceConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *extensions.ServiceConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg: func() *extensions.ServiceConfigV1Alpha1 {
+				cfg := extensions.NewServicesConfigV1Alpha1()
+				cfg.ServiceName = "foo"
+
+				return cfg
+			},
+
+			expectedError: `no config files, environment, resources or security settings defined for extension "foo"`,
+		},
+		{
+			name: "resources only",
+			cfg: func() *extensions.ServiceConfigV1Alpha1 {
+				cfg := extensions.NewServicesConfigV1Alpha1()
+				cfg.ServiceName = "foo"
+				cfg.ServiceResources.ResourcesCPUWeight = 10
+
+				return cfg
+			},
+		},
+		{
+			name: "security only",
+			cfg: func() *extensions.ServiceConfigV1Alpha1 {
+				cfg := extensions.NewServicesConfigV1Alpha1()
+				cfg.ServiceName = "foo"
+				cfg.ServiceSecurity.SecuritySeccompProfile = "localhost/etc/seccomp.json"
+
+				return cfg
+			},
+		},
+		{
+			name: "invalid",
+			cfg: func() *extensions.ServiceConfigV1Alpha1 {
+				cfg := extensions.NewServicesConfigV1Alpha1()
+				cfg.ServiceName = "foo"
+				cfg.ServiceResources = extensions.ResourcesConfig{
+					ResourcesCPUWeight:   20000,
+					ResourcesCPUMax:      "1.5",
+					ResourcesMemoryMax:   block.MustByteSize("-1GiB"),
+					ResourcesOOMScoreAdj: new(-1001),
+				}
+				cfg.ServiceSecurity = extensions.SecurityConfig{
+					SecurityDropCapabilities: []string{"ALL", "net_raw"},
+					SecuritySeccompProfile:   "unconfined",
+				}
+
+				return cfg
+			},
+
+			expectedError: "invalid settings for extension \"foo\": resources.cpuWeight should be in range 1-10000: 20000\n" +
+				"resources.cpuMax: cpu \"1.5\" must be expressed in millicores, e.g. 500m\n" +
+				"resources.memoryMax must not be negative\n" +
+				"resources.oomScoreAdj should be in range -1000-1000: -1001\n" +
+				"security.dropCapabilities: capability \"net_raw\" is invalid\n" +
+				"security.seccompProfile can't be set to unconfined",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
+func TestExtensionServiceConfigResources(t *testing.T) {
+	t.Parallel()
+
+	cfg := extensions.NewServicesConfigV1Alpha1()
+	cfg.ServiceName = "foo"
+
+	assert.Zero(t, cfg.Resources().CPUMaxMillicores())
+	assert.False(t, cfg.Resources().OOMScoreAdj().IsPresent())
+
+	cfg.ServiceResources = extensions.ResourcesConfig{
+		ResourcesCPUMax:      "1500m",
+		ResourcesMemoryHigh:  block.MustByteSize("1GiB"),
+		ResourcesOOMScoreAdj: new(0),
+	}
+
+	assert.EqualValues(t, 1500, cfg.Resources().CPUMaxMillicores())
+	assert.EqualValues(t, 1<<30, cfg.Resources().MemoryHigh())
+	assert.Zero(t, cfg.Resources().MemoryMax())
+	assert.Equal(t, 0, cfg.Resources().OOMScoreAdj().ValueOrZero())
+	assert.True(t, cfg.Resources().OOMScoreAdj().IsPresent())
+}
+
+type validationMode struct{}
+
+func (validationMode) String() string {
+	return ""
+}
+
+func (validationMode) RequiresInstall() bool {
+	return false
+}
+
+func (validationMode) InContainer() bool {
+	return false
+}
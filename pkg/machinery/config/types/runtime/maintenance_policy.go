@@ -0,0 +1,346 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/schedule"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// MaintenancePolicyKind is a MaintenancePolicyConfig config document kind.
+const MaintenancePolicyKind = "MaintenancePolicyConfig"
+
+func init() {
+	registry.Register(MaintenancePolicyKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &MaintenancePolicyV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.MaintenancePolicyConfig = &MaintenancePolicyV1Alpha1{}
+	_ config.Validator               = &MaintenancePolicyV1Alpha1{}
+)
+
+// Maintenance policy defaults.
+const (
+	DefaultMaintenanceMaxNodes             = 1
+	DefaultMaintenanceMaxControlPlaneNodes = 1
+	DefaultMaintenanceLabelMaxNodes        = 1
+
+	MinMaintenanceLockTTL     = 5 * time.Minute
+	DefaultMaintenanceLockTTL = 30 * time.Minute
+
+	MaxMaintenanceWindowDuration = 7 * 24 * time.Hour
+)
+
+// MaintenancePolicyV1Alpha1 configures when and how many nodes of the cluster can be disrupted by reboots and upgrades.
+//
+//	examples:
+//	  - value: exampleMaintenancePolicyV1Alpha1()
+//	alias: MaintenancePolicyConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/MaintenancePolicyConfig
+type MaintenancePolicyV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     The maintenance windows when the disruptive operations are allowed.
+	//
+	//     Disruptive operations (reboots, upgrades and configuration changes applied with a reboot)
+	//     requested outside of the windows are queued until a window opens.
+	//     If no windows are configured, the operations are allowed at any time.
+	MaintenanceWindows []MaintenanceWindow `yaml:"windows,omitempty"`
+	//   description: |
+	//     The limits on the number of nodes disrupted at the same time across the cluster.
+	//
+	//     The limits are enforced with a cluster-wide lock stored in etcd,
+	//     worker nodes acquire the lock slots via the control plane nodes.
+	//     The limits of the node requesting a lock slot apply, so the document should be the same on all nodes.
+	MaintenanceConcurrency MaintenanceConcurrency `yaml:"concurrency,omitempty"`
+	//   description: |
+	//     The maximum time the lock slot is held after the disruptive operation starts.
+	//
+	//     The slot is released once the node is back up and running,
+	//     the TTL releases the slot of the node which doesn't come back.
+	//     Default value is 30 minutes, minimum value is 5 minutes.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	MaintenanceLockTTL time.Duration `yaml:"lockTTL,omitempty"`
+}
+
+// MaintenanceWindow describes a recurring maintenance window.
+type MaintenanceWindow struct {
+	//   description: |
+	//     The start of the window as a cron expression (minute, hour, day of month, month, day of week).
+	//
+	//     Macros like `@daily` and `@weekly` are supported as well.
+	//   examples:
+	//     - value: >
+	//        "0 2 * * sat,sun"
+	WindowSchedule string `yaml:"schedule"`
+	//   description: |
+	//     The duration of the window.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	WindowDuration time.Duration `yaml:"duration"`
+	//   description: |
+	//     The time zone of the schedule as an IANA time zone name.
+	//
+	//     Defaults to UTC.
+	//   examples:
+	//     - value: >
+	//        "Europe/Berlin"
+	WindowTimezone string `yaml:"timezone,omitempty"`
+}
+
+// MaintenanceConcurrency describes the limits on the number of nodes disrupted at the same time.
+type MaintenanceConcurrency struct {
+	//   description: |
+	//     The number of nodes which might be disrupted at the same time.
+	//
+	//     Default value is 1.
+	ConcurrencyMaxNodes int `yaml:"maxNodes,omitempty"`
+	//   description: |
+	//     The number of control plane nodes which might be disrupted at the same time.
+	//
+	//     Default value is 1, so that etcd doesn't lose the quorum.
+	ConcurrencyMaxControlPlaneNodes int `yaml:"maxControlPlaneNodes,omitempty"`
+	//   description: |
+	//     The limits on the number of nodes with the same label value disrupted at the same time.
+	//
+	//     The labels are the Kubernetes node labels managed by Talos (e.g. `.machine.nodeLabels`).
+	//     Nodes without the label are not limited by it.
+	ConcurrencyLabels []MaintenanceLabelLimit `yaml:"labels,omitempty"`
+}
+
+// MaintenanceLabelLimit describes the limit on the number of nodes with the same label value disrupted at the same time.
+type MaintenanceLabelLimit struct {
+	//   description: |
+	//     The node label key.
+	//   examples:
+	//     - value: >
+	//        "topology.kubernetes.io/zone"
+	LabelKey string `yaml:"key"`
+	//   description: |
+	//     The number of nodes with the same label value which might be disrupted at the same time.
+	//
+	//     Default value is 1.
+	LabelMaxNodes int `yaml:"maxNodes,omitempty"`
+}
+
+// NewMaintenancePolicyV1Alpha1 creates a new MaintenancePolicyConfig config document.
+func NewMaintenancePolicyV1Alpha1() *MaintenancePolicyV1Alpha1 {
+	return &MaintenancePolicyV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       MaintenancePolicyKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleMaintenancePolicyV1Alpha1() *MaintenancePolicyV1Alpha1 {
+	cfg := NewMaintenancePolicyV1Alpha1()
+	cfg.MaintenanceWindows = []MaintenanceWindow{
+		{
+			WindowSchedule: "0 2 * * sat,sun",
+			WindowDuration: 4 * time.Hour,
+			WindowTimezone: "Europe/Berlin",
+		},
+	}
+	cfg.MaintenanceConcurrency.ConcurrencyMaxNodes = 3
+	cfg.MaintenanceConcurrency.ConcurrencyLabels = []MaintenanceLabelLimit{
+		{
+			LabelKey: "topology.kubernetes.io/zone",
+		},
+	}
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *MaintenancePolicyV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// InMaintenanceWindow implements config.MaintenancePolicyConfig interface.
+func (s *MaintenancePolicyV1Alpha1) InMaintenanceWindow(t time.Time) bool {
+	if len(s.MaintenanceWindows) == 0 {
+		return true
+	}
+
+	for _, window := range s.MaintenanceWindows {
+		w, err := window.parse()
+		if err != nil {
+			continue
+		}
+
+		if w.Contains(t) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NextMaintenanceWindow implements config.MaintenancePolicyConfig interface.
+func (s *MaintenancePolicyV1Alpha1) NextMaintenanceWindow(t time.Time) time.Time {
+	var next time.Time
+
+	for _, window := range s.MaintenanceWindows {
+		w, err := window.parse()
+		if err != nil {
+			continue
+		}
+
+		start := w.Next(t)
+
+		if !start.IsZero() && (next.IsZero() || start.Before(next)) {
+			next = start
+		}
+	}
+
+	return next
+}
+
+// MaxNodes implements config.MaintenancePolicyConfig interface.
+func (s *MaintenancePolicyV1Alpha1) MaxNodes() int {
+	if s.MaintenanceConcurrency.ConcurrencyMaxNodes == 0 {
+		return DefaultMaintenanceMaxNodes
+	}
+
+	return s.MaintenanceConcurrency.ConcurrencyMaxNodes
+}
+
+// MaxControlPlaneNodes implements config.MaintenancePolicyConfig interface.
+func (s *MaintenancePolicyV1Alpha1) MaxControlPlaneNodes() int {
+	if s.MaintenanceConcurrency.ConcurrencyMaxControlPlaneNodes == 0 {
+		return DefaultMaintenanceMaxControlPlaneNodes
+	}
+
+	return s.MaintenanceConcurrency.ConcurrencyMaxControlPlaneNodes
+}
+
+// LabelLimits implements config.MaintenancePolicyConfig interface.
+func (s *MaintenancePolicyV1Alpha1) LabelLimits() map[string]int {
+	if len(s.MaintenanceConcurrency.ConcurrencyLabels) == 0 {
+		return nil
+	}
+
+	limits := make(map[string]int, len(s.MaintenanceConcurrency.ConcurrencyLabels))
+
+	for _, label := range s.MaintenanceConcurrency.ConcurrencyLabels {
+		limits[label.LabelKey] = label.LabelMaxNodes
+
+		if label.LabelMaxNodes == 0 {
+			limits[label.LabelKey] = DefaultMaintenanceLabelMaxNodes
+		}
+	}
+
+	return limits
+}
+
+// LockTTL implements config.MaintenancePolicyConfig interface.
+func (s *MaintenancePolicyV1Alpha1) LockTTL() time.Duration {
+	if s.MaintenanceLockTTL == 0 {
+		return DefaultMaintenanceLockTTL
+	}
+
+	return s.MaintenanceLockTTL
+}
+
+// Validate implements config.Validator interface.
+//
+//nolint:gocyclo
+func (s *MaintenancePolicyV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	for i, window := range s.MaintenanceWindows {
+		w, err := window.parse()
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("windows[%d]: %w", i, err))
+
+			continue
+		}
+
+		if w.Next(time.Now()).IsZero() {
+			errs = errors.Join(errs, fmt.Errorf("windows[%d].schedule: %q never matches", i, window.WindowSchedule))
+		}
+
+		if window.WindowDuration <= 0 || window.WindowDuration > MaxMaintenanceWindowDuration {
+			errs = errors.Join(errs, fmt.Errorf("windows[%d].duration: must be between 0 and %s", i, MaxMaintenanceWindowDuration))
+		}
+	}
+
+	if s.MaintenanceConcurrency.ConcurrencyMaxNodes < 0 {
+		errs = errors.Join(errs, errors.New("concurrency.maxNodes: must be positive"))
+	}
+
+	if s.MaintenanceConcurrency.ConcurrencyMaxControlPlaneNodes < 0 {
+		errs = errors.Join(errs, errors.New("concurrency.maxControlPlaneNodes: must be positive"))
+	}
+
+	seenLabels := map[string]struct{}{}
+
+	for i, label := range s.MaintenanceConcurrency.ConcurrencyLabels {
+		if label.LabelKey == "" {
+			errs = errors.Join(errs, fmt.Errorf("concurrency.labels[%d].key: must be set", i))
+		}
+
+		if _, ok := seenLabels[label.LabelKey]; ok {
+			errs = errors.Join(errs, fmt.Errorf("concurrency.labels[%d].key: duplicate label %q", i, label.LabelKey))
+		}
+
+		seenLabels[label.LabelKey] = struct{}{}
+
+		if label.LabelMaxNodes < 0 {
+			errs = errors.Join(errs, fmt.Errorf("concurrency.labels[%d].maxNodes: must be positive", i))
+		}
+	}
+
+	if s.MaintenanceLockTTL != 0 && s.MaintenanceLockTTL < MinMaintenanceLockTTL {
+		errs = errors.Join(errs, fmt.Errorf("lockTTL: minimum value is %s", MinMaintenanceLockTTL))
+	}
+
+	return nil, errs
+}
+
+func (window MaintenanceWindow) parse() (schedule.Window, error) {
+	sched, err := schedule.Parse(window.WindowSchedule)
+	if err != nil {
+		return schedule.Window{}, fmt.Errorf("schedule: invalid cron expression %q: %w", window.WindowSchedule, err)
+	}
+
+	loc := time.UTC
+
+	if window.WindowTimezone != "" {
+		loc, err = time.LoadLocation(window.WindowTimezone)
+		if err != nil {
+			return schedule.Window{}, fmt.Errorf("timezone: %w", err)
+		}
+	}
+
+	return schedule.Window{
+		Schedule: sched,
+		Duration: window.WindowDuration,
+		Location: loc,
+	}, nil
+}
@@ -0,0 +1,230 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	_ "embed"
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+)
+
+//go:embed testdata/maintenancepolicy.yaml
+var expectedMaintenancePolicyDocument []byte
+
+func TestMaintenancePolicyMarshalStability(t *testing.T) {
+	cfg := runtime.NewMaintenancePolicyV1Alpha1()
+	cfg.MaintenanceWindows = []runtime.MaintenanceWindow{
+		{
+			WindowSchedule: "0 2 * * sat,sun",
+			WindowDuration: 4 * time.Hour,
+			WindowTimezone: "Europe/Berlin",
+		},
+	}
+	cfg.MaintenanceConcurrency.ConcurrencyMaxNodes = 2
+	cfg.MaintenanceConcurrency.ConcurrencyLabels = []runtime.MaintenanceLabelLimit{
+		{
+			LabelKey:      "topology.kubernetes.io/zone",
+			LabelMaxNodes: 1,
+		},
+	}
+	cfg.MaintenanceLockTTL = time.Hour
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedMaintenancePolicyDocument, marshaled)
+}
+
+func TestMaintenancePolicyDefaults(t *testing.T) {
+	t.Parallel()
+
+	cfg := runtime.NewMaintenancePolicyV1Alpha1()
+
+	assert.Equal(t, 1, cfg.MaxNodes())
+	assert.Equal(t, 1, cfg.MaxControlPlaneNodes())
+	assert.Nil(t, cfg.LabelLimits())
+	assert.Equal(t, runtime.DefaultMaintenanceLockTTL, cfg.LockTTL())
+
+	// no windows: always open
+	assert.True(t, cfg.InMaintenanceWindow(time.Now()))
+	assert.True(t, cfg.NextMaintenanceWindow(time.Now()).IsZero())
+
+	cfg.MaintenanceConcurrency.ConcurrencyLabels = []runtime.MaintenanceLabelLimit{
+		{LabelKey: "zone"},
+		{LabelKey: "rack", LabelMaxNodes: 3},
+	}
+
+	assert.Equal(t, map[string]int{"zone": 1, "rack": 3}, cfg.LabelLimits())
+}
+
+func TestMaintenancePolicyWindows(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	cfg := runtime.NewMaintenancePolicyV1Alpha1()
+	cfg.MaintenanceWindows = []runtime.MaintenanceWindow{
+		{
+			// weekends at 02:00 Berlin time
+			WindowSchedule: "0 2 * * sat,sun",
+			WindowDuration: 4 * time.Hour,
+			WindowTimezone: "Europe/Berlin",
+		},
+		{
+			// every 15 minutes on the 1st day of the month
+			WindowSchedule: "*/15 * 1 * *",
+			WindowDuration: 5 * time.Minute,
+		},
+	}
+
+	for _, test := range []struct {
+		t time.Time
+
+		expectedOpen bool
+		expectedNext time.Time
+	}{
+		{
+			// Friday
+			t: time.Date(2026, 10, 16, 12, 0, 0, 0, berlin),
+
+			expectedNext: time.Date(2026, 10, 17, 2, 0, 0, 0, berlin),
+		},
+		{
+			// Saturday, within the window
+			t: time.Date(2026, 10, 17, 5, 59, 0, 0, berlin),
+
+			expectedOpen: true,
+			expectedNext: time.Date(2026, 10, 18, 2, 0, 0, 0, berlin),
+		},
+		{
+			// Saturday, the window is over
+			t: time.Date(2026, 10, 17, 6, 0, 0, 0, berlin),
+
+			expectedNext: time.Date(2026, 10, 18, 2, 0, 0, 0, berlin),
+		},
+		{
+			// Sunday, DST change in Berlin
+			t: time.Date(2026, 10, 25, 3, 30, 0, 0, time.UTC),
+
+			expectedOpen: true,
+			expectedNext: time.Date(2026, 10, 31, 2, 0, 0, 0, berlin),
+		},
+		{
+			// 1st of the month, UTC
+			t: time.Date(2026, 11, 1, 10, 34, 0, 0, time.UTC),
+
+			expectedOpen: true,
+			expectedNext: time.Date(2026, 11, 1, 10, 45, 0, 0, time.UTC),
+		},
+		{
+			t: time.Date(2026, 11, 1, 10, 35, 0, 0, time.UTC),
+
+			expectedNext: time.Date(2026, 11, 1, 10, 45, 0, 0, time.UTC),
+		},
+	} {
+		t.Run(test.t.String(), func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expectedOpen, cfg.InMaintenanceWindow(test.t))
+			assert.True(t, test.expectedNext.Equal(cfg.NextMaintenanceWindow(test.t)), "expected %s, got %s", test.expectedNext, cfg.NextMaintenanceWindow(test.t))
+		})
+	}
+}
+
+func TestMaintenancePolicyValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *runtime.MaintenancePolicyV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  runtime.NewMaintenancePolicyV1Alpha1,
+		},
+		{
+			name: "valid",
+			cfg: func() *runtime.MaintenancePolicyV1Alpha1 {
+				cfg := runtime.NewMaintenancePolicyV1Alpha1()
+				cfg.MaintenanceWindows = []runtime.MaintenanceWindow{
+					{WindowSchedule: "@daily", WindowDuration: time.Hour},
+					{WindowSchedule: "30 22 * jan-mar,oct mon-fri", WindowDuration: 2 * time.Hour, WindowTimezone: "America/New_York"},
+					{WindowSchedule: "0 0-23/6 1,15 * 7", WindowDuration: 30 * time.Minute},
+				}
+				cfg.MaintenanceConcurrency.ConcurrencyLabels = []runtime.MaintenanceLabelLimit{
+					{LabelKey: "topology.kubernetes.io/zone", LabelMaxNodes: 2},
+				}
+				cfg.MaintenanceLockTTL = time.Hour
+
+				return cfg
+			},
+		},
+		{
+			name: "invalid windows",
+			cfg: func() *runtime.MaintenancePolicyV1Alpha1 {
+				cfg := runtime.NewMaintenancePolicyV1Alpha1()
+				cfg.MaintenanceWindows = []runtime.MaintenanceWindow{
+					{WindowSchedule: "0 2 * *", WindowDuration: time.Hour},
+					{WindowSchedule: "60 2 * * *", WindowDuration: time.Hour},
+					{WindowSchedule: "0 2 * * *", WindowDuration: time.Hour, WindowTimezone: "Mars/Olympus"},
+					{WindowSchedule: "0 0 30 feb *", WindowDuration: time.Hour},
+					{WindowSchedule: "0 2 * * *"},
+				}
+
+				return cfg
+			},
+
+			expectedError: "windows[0]: schedule: invalid cron expression \"0 2 * *\": expected 5 fields, got 4\n" +
+				"windows[1]: schedule: invalid cron expression \"60 2 * * *\": minute: value 60 is out of range [0, 59]\n" +
+				"windows[2]: timezone: unknown time zone Mars/Olympus\n" +
+				"windows[3].schedule: \"0 0 30 feb *\" never matches\n" +
+				"windows[4].duration: must be between 0 and 168h0m0s",
+		},
+		{
+			name: "invalid limits",
+			cfg: func() *runtime.MaintenancePolicyV1Alpha1 {
+				cfg := runtime.NewMaintenancePolicyV1Alpha1()
+				cfg.MaintenanceConcurrency.ConcurrencyMaxNodes = -1
+				cfg.MaintenanceConcurrency.ConcurrencyLabels = []runtime.MaintenanceLabelLimit{
+					{LabelKey: "zone"},
+					{LabelKey: "zone", LabelMaxNodes: -1},
+					{},
+				}
+				cfg.MaintenanceLockTTL = time.Minute
+
+				return cfg
+			},
+
+			expectedError: "concurrency.maxNodes: must be positive\n" +
+				"concurrency.labels[1].key: duplicate label \"zone\"\n" +
+				"concurrency.labels[1].maxNodes: must be positive\n" +
+				"concurrency.labels[2].key: must be set\n" +
+				"lockTTL: minimum value is 5m0s",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+			if test.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
@@ -497,6 +497,221 @@ func (SecurityProfileConfigV1Alpha1) Doc() *encoder.Doc {
 	return doc
 }
 
+func (ConfigSourceV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "ConfigSourceConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "ConfigSourceConfig configures periodic pull of the machine configuration from a remote source." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "ConfigSourceConfig configures periodic pull of the machine configuration from a remote source.",
+		Fields: []encoder.Doc{
+			{
+				Type:   "Meta",
+				Inline: true,
+			},
+			{
+				Name:        "url",
+				Type:        "URL",
+				Note:        "",
+				Description: "The URL of the machine configuration.\n\nThe scheme must be https:// or oci://.\nFor oci:// URLs, the configuration is the single layer of the referenced OCI artifact,\nand the detached signature is stored in the `dev.talos.config.signature` manifest annotation.\n\nThe pulled configuration replaces the whole machine configuration,\nso it should contain this document to keep pulling the configuration.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The URL of the machine configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "signatureURL",
+				Type:        "URL",
+				Note:        "",
+				Description: "The URL of the detached signature of the machine configuration (https:// sources only).\n\nDefaults to the configuration URL with `.sig` suffix appended to the path.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The URL of the detached signature of the machine configuration (https:// sources only)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "interval",
+				Type:        "Duration",
+				Note:        "",
+				Description: "The interval between the configuration pulls.\n\nDefault value is 5 minutes, minimum value is 30 seconds.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The interval between the configuration pulls." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "mode",
+				Type:        "ConfigSourceApplyMode",
+				Note:        "",
+				Description: "The mode to apply the pulled configuration with.\n\n`no-reboot` applies the configuration immediately,\n`reboot` applies the configuration and reboots the machine,\n`staged` applies the configuration on the next reboot.\nDefaults to `no-reboot`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The mode to apply the pulled configuration with." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"no-reboot",
+					"reboot",
+					"staged",
+				},
+			},
+			{
+				Name:        "trustedKeys",
+				Type:        "[]string",
+				Note:        "",
+				Description: "The list of PEM-encoded public keys (or certificates) trusted to sign the machine configuration.\n\nThe signature is verified over the raw bytes of the configuration:\nEd25519 keys sign the data directly, ECDSA and RSA keys sign the SHA-256 digest of the data\n(as produced by `openssl dgst -sha256 -sign`).\nThe signature might be raw or base64-encoded.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The list of PEM-encoded public keys (or certificates) trusted to sign the machine configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleConfigSourceV1Alpha1())
+
+	doc.Fields[1].AddExample("", "https://config.example.com/nodes/worker.yaml")
+	doc.Fields[1].AddExample("", "oci://registry.example.com/fleet/worker:latest")
+
+	return doc
+}
+
+func (MaintenancePolicyV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "MaintenancePolicyConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "MaintenancePolicyConfig configures when and how many nodes of the cluster can be disrupted by reboots and upgrades." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "MaintenancePolicyConfig configures when and how many nodes of the cluster can be disrupted by reboots and upgrades.",
+		Fields: []encoder.Doc{
+			{
+				Type:   "Meta",
+				Inline: true,
+			},
+			{
+				Name:        "windows",
+				Type:        "[]MaintenanceWindow",
+				Note:        "",
+				Description: "The maintenance windows when the disruptive operations are allowed.\n\nDisruptive operations (reboots, upgrades and configuration changes applied with a reboot)\nrequested outside of the windows are queued until a window opens.\nIf no windows are configured, the operations are allowed at any time.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The maintenance windows when the disruptive operations are allowed." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "concurrency",
+				Type:        "MaintenanceConcurrency",
+				Note:        "",
+				Description: "The limits on the number of nodes disrupted at the same time across the cluster.\n\nThe limits are enforced with a cluster-wide lock stored in etcd,\nworker nodes acquire the lock slots via the control plane nodes.\nThe limits of the node requesting a lock slot apply, so the document should be the same on all nodes.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The limits on the number of nodes disrupted at the same time across the cluster." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "lockTTL",
+				Type:        "Duration",
+				Note:        "",
+				Description: "The maximum time the lock slot is held after the disruptive operation starts.\n\nThe slot is released once the node is back up and running,\nthe TTL releases the slot of the node which doesn't come back.\nDefault value is 30 minutes, minimum value is 5 minutes.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The maximum time the lock slot is held after the disruptive operation starts." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleMaintenancePolicyV1Alpha1())
+
+	return doc
+}
+
+func (MaintenanceWindow) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "MaintenanceWindow",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "MaintenanceWindow describes a recurring maintenance window." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "MaintenanceWindow describes a recurring maintenance window.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "MaintenancePolicyV1Alpha1",
+				FieldName: "windows",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "schedule",
+				Type:        "string",
+				Note:        "",
+				Description: "The start of the window as a cron expression (minute, hour, day of month, month, day of week).\n\nMacros like `@daily` and `@weekly` are supported as well.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The start of the window as a cron expression (minute, hour, day of month, month, day of week)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "duration",
+				Type:        "Duration",
+				Note:        "",
+				Description: "The duration of the window.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The duration of the window." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "timezone",
+				Type:        "string",
+				Note:        "",
+				Description: "The time zone of the schedule as an IANA time zone name.\n\nDefaults to UTC.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The time zone of the schedule as an IANA time zone name." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", "0 2 * * sat,sun")
+	doc.Fields[2].AddExample("", "Europe/Berlin")
+
+	return doc
+}
+
+func (MaintenanceConcurrency) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "MaintenanceConcurrency",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "MaintenanceConcurrency describes the limits on the number of nodes disrupted at the same time." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "MaintenanceConcurrency describes the limits on the number of nodes disrupted at the same time.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "MaintenancePolicyV1Alpha1",
+				FieldName: "concurrency",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "maxNodes",
+				Type:        "int",
+				Note:        "",
+				Description: "The number of nodes which might be disrupted at the same time.\n\nDefault value is 1.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The number of nodes which might be disrupted at the same time." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "maxControlPlaneNodes",
+				Type:        "int",
+				Note:        "",
+				Description: "The number of control plane nodes which might be disrupted at the same time.\n\nDefault value is 1, so that etcd doesn't lose the quorum.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The number of control plane nodes which might be disrupted at the same time." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "labels",
+				Type:        "[]MaintenanceLabelLimit",
+				Note:        "",
+				Description: "The limits on the number of nodes with the same label value disrupted at the same time.\n\nThe labels are the Kubernetes node labels managed by Talos (e.g. `.machine.nodeLabels`).\nNodes without the label are not limited by it.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The limits on the number of nodes with the same label value disrupted at the same time." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (MaintenanceLabelLimit) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "MaintenanceLabelLimit",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "MaintenanceLabelLimit describes the limit on the number of nodes with the same label value disrupted at the same time." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "MaintenanceLabelLimit describes the limit on the number of nodes with the same label value disrupted at the same time.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "MaintenanceConcurrency",
+				FieldName: "labels",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "key",
+				Type:        "string",
+				Note:        "",
+				Description: "The node label key.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The node label key." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "maxNodes",
+				Type:        "int",
+				Note:        "",
+				Description: "The number of nodes with the same label value which might be disrupted at the same time.\n\nDefault value is 1.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The number of nodes with the same label value which might be disrupted at the same time." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", "topology.kubernetes.io/zone")
+
+	return doc
+}
+
 // GetFileDoc returns documentation for the file runtime_doc.go.
 func GetFileDoc() *encoder.FileDoc {
 	return &encoder.FileDoc{
@@ -518,6 +733,11 @@ func GetFileDoc() *encoder.FileDoc {
 			WatchdogTimerV1Alpha1{}.Doc(),
 			KernelModuleConfigV1Alpha1{}.Doc(),
 			SecurityProfileConfigV1Alpha1{}.Doc(),
+			ConfigSourceV1Alpha1{}.Doc(),
+			MaintenancePolicyV1Alpha1{}.Doc(),
+			MaintenanceWindow{}.Doc(),
+			MaintenanceConcurrency{}.Doc(),
+			MaintenanceLabelLimit{}.Doc(),
 		},
 	}
 }
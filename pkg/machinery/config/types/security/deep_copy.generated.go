@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type ImageVerificationConfigV1Alpha1 -type TrustedRootsConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type ImageVerificationConfigV1Alpha1 -type NodeAttestationConfigV1Alpha1 -type TrustedRootsConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package security
 
@@ -34,6 +34,34 @@ func (o *ImageVerificationConfigV1Alpha1) DeepCopy() *ImageVerificationConfigV1A
 	return &cp
 }
 
+// DeepCopy generates a deep copy of *NodeAttestationConfigV1Alpha1.
+func (o *NodeAttestationConfigV1Alpha1) DeepCopy() *NodeAttestationConfigV1Alpha1 {
+	var cp NodeAttestationConfigV1Alpha1 = *o
+	if o.ConfigPCRs != nil {
+		cp.ConfigPCRs = make([]int, len(o.ConfigPCRs))
+		copy(cp.ConfigPCRs, o.ConfigPCRs)
+	}
+	if o.AllowedPCRValues != nil {
+		cp.AllowedPCRValues = make([]NodeAttestationPCRValueV1Alpha1, len(o.AllowedPCRValues))
+		copy(cp.AllowedPCRValues, o.AllowedPCRValues)
+		for i2 := range o.AllowedPCRValues {
+			if o.AllowedPCRValues[i2].PCRValues != nil {
+				cp.AllowedPCRValues[i2].PCRValues = make([]string, len(o.AllowedPCRValues[i2].PCRValues))
+				copy(cp.AllowedPCRValues[i2].PCRValues, o.AllowedPCRValues[i2].PCRValues)
+			}
+		}
+	}
+	if o.PCRSigningPublicKeys != nil {
+		cp.PCRSigningPublicKeys = make([]string, len(o.PCRSigningPublicKeys))
+		copy(cp.PCRSigningPublicKeys, o.PCRSigningPublicKeys)
+	}
+	if o.EndorsementCARoots != nil {
+		cp.EndorsementCARoots = make([]string, len(o.EndorsementCARoots))
+		copy(cp.EndorsementCARoots, o.EndorsementCARoots)
+	}
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *TrustedRootsConfigV1Alpha1.
 func (o *TrustedRootsConfigV1Alpha1) DeepCopy() *TrustedRootsConfigV1Alpha1 {
 	var cp TrustedRootsConfigV1Alpha1 = *o
@@ -0,0 +1,281 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package security
+
+//docgen:jsonschema
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// NodeAttestationConfigKind defines the NodeAttestationConfig configuration kind.
+const NodeAttestationConfigKind = "NodeAttestationConfig"
+
+func init() {
+	registry.Register(NodeAttestationConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &NodeAttestationConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NodeAttestationConfig = &NodeAttestationConfigV1Alpha1{}
+	_ config.Validator             = &NodeAttestationConfigV1Alpha1{}
+)
+
+// NodeAttestationConfigV1Alpha1 configures TPM-based attestation of nodes joining the cluster.
+//
+//	description: |
+//	  When enabled, worker nodes send a TPM 2.0 quote over the configured PCRs together with
+//	  the endorsement key (EK) and the attestation key (AK) to trustd when requesting
+//	  their certificates.
+//	  Control plane nodes verify the quote against the list of allowed PCR values or signed
+//	  PCR policies before issuing certificates.
+//	  The result of the attestation is recorded in the `NodeAttestation` resource on the control plane node.
+//
+//	  This document should be applied to all nodes of the cluster.
+//	examples:
+//	  - value: exampleNodeAttestationConfigV1Alpha1()
+//	alias: NodeAttestationConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/NodeAttestationConfig
+type NodeAttestationConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     Node attestation mode.
+	//
+	//     With `disabled` mode, nodes join with the machine token only.
+	//     With `optional` mode, nodes attest if they have a TPM, and the result is recorded, but failed attestation does not block the join.
+	//     With `required` mode, certificates are issued only to nodes which pass the attestation,
+	//     and `endorsementCARoots` must be set.
+	//   values:
+	//     - disabled
+	//     - optional
+	//     - required
+	ConfigMode string `yaml:"mode"`
+
+	//   description: |
+	//     List of PCRs to include in the quote.
+	//
+	//     Defaults to PCRs 7 (Secure Boot state) and 11 (UKI measurements).
+	//   examples:
+	//     - value: >
+	//         []int{7, 11}
+	ConfigPCRs []int `yaml:"pcrs,omitempty"`
+
+	//   description: |
+	//     List of allowed PCR values (SHA-256 bank).
+	//
+	//     If a PCR has any values listed, the quoted value must match one of them.
+	AllowedPCRValues []NodeAttestationPCRValueV1Alpha1 `yaml:"allowedPCRValues,omitempty"`
+
+	//   description: |
+	//     List of PEM-encoded RSA public keys used to sign UKI PCR policies (PCR 11).
+	//
+	//     If set, the node should provide the signed PCR policy embedded into the UKI,
+	//     and PCR 11 is accepted if the quoted value matches a policy signed by one of these keys.
+	//
+	//     Signed PCR policies match PCR 11 in the `enter-machined` boot phase only, while PCR 11 is extended
+	//     once again after the node volumes are mounted, so for nodes joining after that point
+	//     the PCR 11 value should be listed in `allowedPCRValues` (observed values are recorded in the `NodeAttestation` resource).
+	PCRSigningPublicKeys []string `yaml:"pcrSigningPublicKeys,omitempty"`
+
+	//   description: |
+	//     List of PEM-encoded CA certificates used to verify TPM endorsement key certificates.
+	//
+	//     Required in the `required` mode.
+	//     If not set, the endorsement key certificate is not verified, and only the binding of the
+	//     attestation key to the endorsement key is checked.
+	EndorsementCARoots []string `yaml:"endorsementCARoots,omitempty"`
+}
+
+// NodeAttestationPCRValueV1Alpha1 describes allowed values for a PCR.
+type NodeAttestationPCRValueV1Alpha1 struct {
+	//   description: |
+	//     PCR index.
+	PCRIndex int `yaml:"pcr"`
+	//   description: |
+	//     List of hex-encoded allowed PCR values.
+	PCRValues []string `yaml:"values"`
+}
+
+// NewNodeAttestationConfigV1Alpha1 creates a new NodeAttestationConfig config document.
+func NewNodeAttestationConfigV1Alpha1() *NodeAttestationConfigV1Alpha1 {
+	return &NodeAttestationConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       NodeAttestationConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleNodeAttestationConfigV1Alpha1() *NodeAttestationConfigV1Alpha1 {
+	cfg := NewNodeAttestationConfigV1Alpha1()
+	cfg.ConfigMode = string(config.NodeAttestationModeRequired)
+	cfg.ConfigPCRs = []int{constants.SecureBootStatePCR, constants.UKIPCR}
+	cfg.AllowedPCRValues = []NodeAttestationPCRValueV1Alpha1{
+		{
+			PCRIndex:  constants.SecureBootStatePCR,
+			PCRValues: []string{"3d458cfe55cc03ea1f443f1562beec8df51c75e14a9fcf9a7234a13f198e7969"},
+		},
+	}
+	cfg.PCRSigningPublicKeys = []string{
+		`-----BEGIN PUBLIC KEY-----
+MII--Sample Value--
+-----END PUBLIC KEY-----`,
+	}
+	cfg.EndorsementCARoots = []string{
+		`-----BEGIN CERTIFICATE-----
+MII--Sample Value--
+-----END CERTIFICATE-----`,
+	}
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *NodeAttestationConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Validate implements config.Validator interface.
+//
+//nolint:gocyclo,cyclop
+func (s *NodeAttestationConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var (
+		errs     error
+		warnings []string
+	)
+
+	mode, err := config.ParseNodeAttestationMode(s.ConfigMode)
+	if err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	for _, pcr := range s.ConfigPCRs {
+		if pcr < 0 || pcr > 23 {
+			errs = errors.Join(errs, fmt.Errorf("PCR index %d is out of range [0, 23]", pcr))
+		}
+	}
+
+	pcrs := s.PCRs()
+
+	for i, allowed := range s.AllowedPCRValues {
+		if !slices.Contains(pcrs, allowed.PCRIndex) {
+			errs = errors.Join(errs, fmt.Errorf("allowedPCRValues[%d]: PCR %d is not quoted", i, allowed.PCRIndex))
+		}
+
+		if len(allowed.PCRValues) == 0 {
+			errs = errors.Join(errs, fmt.Errorf("allowedPCRValues[%d]: at least one value must be specified", i))
+		}
+
+		for _, value := range allowed.PCRValues {
+			decoded, decodeErr := hex.DecodeString(value)
+			if decodeErr != nil || len(decoded) != 32 {
+				errs = errors.Join(errs, fmt.Errorf("allowedPCRValues[%d]: value %q is not a hex-encoded SHA-256 digest", i, value))
+			}
+		}
+	}
+
+	for i, key := range s.PCRSigningPublicKeys {
+		block, _ := pem.Decode([]byte(key))
+		if block == nil {
+			errs = errors.Join(errs, fmt.Errorf("pcrSigningPublicKeys[%d]: failed to decode PEM block", i))
+
+			continue
+		}
+
+		pubKey, parseErr := x509.ParsePKIXPublicKey(block.Bytes)
+		if parseErr != nil {
+			errs = errors.Join(errs, fmt.Errorf("pcrSigningPublicKeys[%d]: %w", i, parseErr))
+
+			continue
+		}
+
+		if _, ok := pubKey.(*rsa.PublicKey); !ok {
+			errs = errors.Join(errs, fmt.Errorf("pcrSigningPublicKeys[%d]: only RSA keys are supported", i))
+		}
+	}
+
+	for i, root := range s.EndorsementCARoots {
+		if !x509.NewCertPool().AppendCertsFromPEM([]byte(root)) {
+			errs = errors.Join(errs, fmt.Errorf("endorsementCARoots[%d]: failed to parse PEM certificates", i))
+		}
+	}
+
+	// without the endorsement CA roots, any TPM (including a software one) passes the attestation
+	if mode == config.NodeAttestationModeRequired && len(s.EndorsementCARoots) == 0 {
+		errs = errors.Join(errs, errors.New("endorsementCARoots are required in the required mode"))
+	}
+
+	if mode == config.NodeAttestationModeOptional && len(s.EndorsementCARoots) == 0 {
+		warnings = append(warnings, "endorsementCARoots are not set, the TPM identity is not going to be verified")
+	}
+
+	if mode != config.NodeAttestationModeDisabled && len(s.AllowedPCRValues) == 0 && len(s.PCRSigningPublicKeys) == 0 {
+		warnings = append(warnings, "neither allowedPCRValues nor pcrSigningPublicKeys are set, the boot state is not going to be verified")
+	}
+
+	if len(s.PCRSigningPublicKeys) > 0 && !slices.Contains(pcrs, constants.UKIPCR) {
+		warnings = append(warnings, fmt.Sprintf("pcrSigningPublicKeys are set, but PCR %d is not quoted", constants.UKIPCR))
+	}
+
+	return warnings, errs
+}
+
+// Mode implements config.NodeAttestationConfig interface.
+func (s *NodeAttestationConfigV1Alpha1) Mode() config.NodeAttestationMode {
+	mode, _ := config.ParseNodeAttestationMode(s.ConfigMode) //nolint:errcheck
+
+	return mode
+}
+
+// PCRs implements config.NodeAttestationConfig interface.
+func (s *NodeAttestationConfigV1Alpha1) PCRs() []int {
+	if len(s.ConfigPCRs) == 0 {
+		return []int{constants.SecureBootStatePCR, constants.UKIPCR}
+	}
+
+	return s.ConfigPCRs
+}
+
+// AllowedPCRs implements config.NodeAttestationConfig interface.
+func (s *NodeAttestationConfigV1Alpha1) AllowedPCRs() map[int][]string {
+	result := make(map[int][]string, len(s.AllowedPCRValues))
+
+	for _, allowed := range s.AllowedPCRValues {
+		result[allowed.PCRIndex] = append(result[allowed.PCRIndex], allowed.PCRValues...)
+	}
+
+	return result
+}
+
+// PCRSigningKeys implements config.NodeAttestationConfig interface.
+func (s *NodeAttestationConfigV1Alpha1) PCRSigningKeys() []string {
+	return s.PCRSigningPublicKeys
+}
+
+// EndorsementCAs implements config.NodeAttestationConfig interface.
+func (s *NodeAttestationConfigV1Alpha1) EndorsementCAs() []string {
+	return s.EndorsementCARoots
+}
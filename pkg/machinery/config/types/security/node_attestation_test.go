@@ -0,0 +1,267 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package security_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	_ "embed"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/security"
+)
+
+//go:embed testdata/nodeattestationconfig.yaml
+var expectedNodeAttestationConfigDocument []byte
+
+const samplePCR7Value = "3d458cfe55cc03ea1f443f1562beec8df51c75e14a9fcf9a7234a13f198e7969"
+
+func TestNodeAttestationConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := security.NewNodeAttestationConfigV1Alpha1()
+	cfg.ConfigMode = "required"
+	cfg.ConfigPCRs = []int{7, 11}
+	cfg.AllowedPCRValues = []security.NodeAttestationPCRValueV1Alpha1{
+		{
+			PCRIndex:  7,
+			PCRValues: []string{samplePCR7Value},
+		},
+	}
+	cfg.EndorsementCARoots = []string{
+		`-----BEGIN CERTIFICATE-----
+MII--Sample Value--
+-----END CERTIFICATE-----`,
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, string(expectedNodeAttestationConfigDocument), string(marshaled))
+}
+
+func TestNodeAttestationConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedNodeAttestationConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &security.NodeAttestationConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       security.NodeAttestationConfigKind,
+		},
+		ConfigMode: "required",
+		ConfigPCRs: []int{7, 11},
+		AllowedPCRValues: []security.NodeAttestationPCRValueV1Alpha1{
+			{
+				PCRIndex:  7,
+				PCRValues: []string{samplePCR7Value},
+			},
+		},
+		EndorsementCARoots: []string{
+			`-----BEGIN CERTIFICATE-----
+MII--Sample Value--
+-----END CERTIFICATE-----`,
+		},
+	}, docs[0])
+
+	nodeAttestationConfig := provider.NodeAttestationConfig()
+	require.NotNil(t, nodeAttestationConfig)
+
+	assert.Equal(t, config.NodeAttestationModeRequired, nodeAttestationConfig.Mode())
+	assert.Equal(t, []int{7, 11}, nodeAttestationConfig.PCRs())
+	assert.Equal(t, map[int][]string{7: {samplePCR7Value}}, nodeAttestationConfig.AllowedPCRs())
+}
+
+func TestNodeAttestationConfigDefaults(t *testing.T) {
+	t.Parallel()
+
+	cfg := security.NewNodeAttestationConfigV1Alpha1()
+	cfg.ConfigMode = "optional"
+
+	assert.Equal(t, config.NodeAttestationModeOptional, cfg.Mode())
+	assert.Equal(t, []int{7, 11}, cfg.PCRs())
+	assert.Empty(t, cfg.AllowedPCRs())
+}
+
+func TestNodeAttestationConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	ecKeyDER, err := x509.MarshalPKIXPublicKey(ecKey.Public())
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+
+	keyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: keyDER}))
+
+	caDER, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "EK Root CA"},
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}, &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "EK Root CA"},
+	}, ecKey.Public(), ecKey)
+	require.NoError(t, err)
+
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}))
+
+	for _, test := range []struct {
+		name string
+
+		cfg func() *security.NodeAttestationConfigV1Alpha1
+
+		expectedErrors   string
+		expectedWarnings []string
+	}{
+		{
+			name: "disabled",
+
+			cfg: func() *security.NodeAttestationConfigV1Alpha1 {
+				c := security.NewNodeAttestationConfigV1Alpha1()
+				c.ConfigMode = "disabled"
+
+				return c
+			},
+		},
+		{
+			name: "valid",
+
+			cfg: func() *security.NodeAttestationConfigV1Alpha1 {
+				c := security.NewNodeAttestationConfigV1Alpha1()
+				c.ConfigMode = "required"
+				c.AllowedPCRValues = []security.NodeAttestationPCRValueV1Alpha1{
+					{
+						PCRIndex:  7,
+						PCRValues: []string{samplePCR7Value},
+					},
+				}
+				c.PCRSigningPublicKeys = []string{keyPEM}
+				c.EndorsementCARoots = []string{caPEM}
+
+				return c
+			},
+		},
+		{
+			name: "identity only",
+
+			cfg: func() *security.NodeAttestationConfigV1Alpha1 {
+				c := security.NewNodeAttestationConfigV1Alpha1()
+				c.ConfigMode = "optional"
+				c.ConfigPCRs = []int{7}
+				c.PCRSigningPublicKeys = []string{keyPEM}
+
+				return c
+			},
+
+			expectedWarnings: []string{
+				"endorsementCARoots are not set, the TPM identity is not going to be verified",
+				"pcrSigningPublicKeys are set, but PCR 11 is not quoted",
+			},
+		},
+		{
+			name: "no policy",
+
+			cfg: func() *security.NodeAttestationConfigV1Alpha1 {
+				c := security.NewNodeAttestationConfigV1Alpha1()
+				c.ConfigMode = "required"
+				c.EndorsementCARoots = []string{caPEM}
+
+				return c
+			},
+
+			expectedWarnings: []string{
+				"neither allowedPCRValues nor pcrSigningPublicKeys are set, the boot state is not going to be verified",
+			},
+		},
+		{
+			name: "no endorsement CA",
+
+			cfg: func() *security.NodeAttestationConfigV1Alpha1 {
+				c := security.NewNodeAttestationConfigV1Alpha1()
+				c.ConfigMode = "required"
+				c.AllowedPCRValues = []security.NodeAttestationPCRValueV1Alpha1{
+					{
+						PCRIndex:  7,
+						PCRValues: []string{samplePCR7Value},
+					},
+				}
+
+				return c
+			},
+
+			expectedErrors: "endorsementCARoots are required in the required mode",
+		},
+		{
+			name: "invalid",
+
+			cfg: func() *security.NodeAttestationConfigV1Alpha1 {
+				c := security.NewNodeAttestationConfigV1Alpha1()
+				c.ConfigMode = "enforcing"
+				c.ConfigPCRs = []int{7, 24}
+				c.AllowedPCRValues = []security.NodeAttestationPCRValueV1Alpha1{
+					{
+						PCRIndex:  4,
+						PCRValues: []string{"abcd"},
+					},
+					{
+						PCRIndex: 7,
+					},
+				}
+				c.PCRSigningPublicKeys = []string{"foo", string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: ecKeyDER}))}
+				c.EndorsementCARoots = []string{"bar"}
+
+				return c
+			},
+
+			expectedErrors: "invalid node attestation mode \"enforcing\"\nPCR index 24 is out of range [0, 23]\nallowedPCRValues[0]: PCR 4 is not quoted\nallowedPCRValues[0]: value \"abcd\" is not a hex-encoded SHA-256 digest\nallowedPCRValues[1]: at least one value must be specified\npcrSigningPublicKeys[0]: failed to decode PEM block\npcrSigningPublicKeys[1]: only RSA keys are supported\nendorsementCARoots[0]: failed to parse PEM certificates", //nolint:lll
+			expectedWarnings: []string{
+				"pcrSigningPublicKeys are set, but PCR 11 is not quoted",
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, err := test.cfg().Validate(validationMode{})
+			assert.Equal(t, test.expectedWarnings, warnings)
+
+			if test.expectedErrors == "" {
+				require.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedErrors)
+			}
+		})
+	}
+}
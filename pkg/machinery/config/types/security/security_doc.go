@@ -191,6 +191,98 @@ func (ImagePublicKeyVerifierV1Alpha1) Doc() *encoder.Doc {
 	return doc
 }
 
+func (NodeAttestationConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "NodeAttestationConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "NodeAttestationConfig configures TPM-based attestation of nodes joining the cluster." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "NodeAttestationConfig configures TPM-based attestation of nodes joining the cluster.\nWhen enabled, worker nodes send a TPM 2.0 quote over the configured PCRs together with\nthe endorsement key (EK) and the attestation key (AK) to trustd when requesting\ntheir certificates.\nControl plane nodes verify the quote against the list of allowed PCR values or signed\nPCR policies before issuing certificates.\nThe result of the attestation is recorded in the `NodeAttestation` resource on the control plane node.\n\nThis document should be applied to all nodes of the cluster.\n",
+		Fields: []encoder.Doc{
+			{
+				Type:   "Meta",
+				Inline: true,
+			},
+			{
+				Name:        "mode",
+				Type:        "string",
+				Note:        "",
+				Description: "Node attestation mode.\n\nWith `disabled` mode, nodes join with the machine token only.\nWith `optional` mode, nodes attest if they have a TPM, and the result is recorded, but failed attestation does not block the join.\nWith `required` mode, certificates are issued only to nodes which pass the attestation,\nand `endorsementCARoots` must be set.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Node attestation mode." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"disabled",
+					"optional",
+					"required",
+				},
+			},
+			{
+				Name:        "pcrs",
+				Type:        "[]int",
+				Note:        "",
+				Description: "List of PCRs to include in the quote.\n\nDefaults to PCRs 7 (Secure Boot state) and 11 (UKI measurements).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of PCRs to include in the quote." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "allowedPCRValues",
+				Type:        "[]NodeAttestationPCRValueV1Alpha1",
+				Note:        "",
+				Description: "List of allowed PCR values (SHA-256 bank).\n\nIf a PCR has any values listed, the quoted value must match one of them.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of allowed PCR values (SHA-256 bank)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "pcrSigningPublicKeys",
+				Type:        "[]string",
+				Note:        "",
+				Description: "List of PEM-encoded RSA public keys used to sign UKI PCR policies (PCR 11).\n\nIf set, the node should provide the signed PCR policy embedded into the UKI,\nand PCR 11 is accepted if the quoted value matches a policy signed by one of these keys.\n\nSigned PCR policies match PCR 11 in the `enter-machined` boot phase only, while PCR 11 is extended\nonce again after the node volumes are mounted, so for nodes joining after that point\nthe PCR 11 value should be listed in `allowedPCRValues` (observed values are recorded in the `NodeAttestation` resource).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of PEM-encoded RSA public keys used to sign UKI PCR policies (PCR 11)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "endorsementCARoots",
+				Type:        "[]string",
+				Note:        "",
+				Description: "List of PEM-encoded CA certificates used to verify TPM endorsement key certificates.\n\nRequired in the `required` mode.\nIf not set, the endorsement key certificate is not verified, and only the binding of the\nattestation key to the endorsement key is checked.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of PEM-encoded CA certificates used to verify TPM endorsement key certificates." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleNodeAttestationConfigV1Alpha1())
+
+	doc.Fields[2].AddExample("", []int{7, 11})
+
+	return doc
+}
+
+func (NodeAttestationPCRValueV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "NodeAttestationPCRValueV1Alpha1",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "NodeAttestationPCRValueV1Alpha1 describes allowed values for a PCR." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "NodeAttestationPCRValueV1Alpha1 describes allowed values for a PCR.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "NodeAttestationConfigV1Alpha1",
+				FieldName: "allowedPCRValues",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "pcr",
+				Type:        "int",
+				Note:        "",
+				Description: "PCR index.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "PCR index." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "values",
+				Type:        "[]string",
+				Note:        "",
+				Description: "List of hex-encoded allowed PCR values.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of hex-encoded allowed PCR values." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
 // GetFileDoc returns documentation for the file security_doc.go.
 func GetFileDoc() *encoder.FileDoc {
 	return &encoder.FileDoc{
@@ -202,6 +294,8 @@ func GetFileDoc() *encoder.FileDoc {
 			ImageVerificationRuleV1Alpha1{}.Doc(),
 			ImageKeylessVerifierV1Alpha1{}.Doc(),
 			ImagePublicKeyVerifierV1Alpha1{}.Doc(),
+			NodeAttestationConfigV1Alpha1{}.Doc(),
+			NodeAttestationPCRValueV1Alpha1{}.Doc(),
 		},
 	}
 }
@@ -28,6 +28,19 @@ func (e *EtcdConfig) CA() *x509.PEMEncodedCertificateAndKey {
 	return e.RootCA
 }
 
+// AcceptedCAs implements the config.Etcd interface.
+func (e *EtcdConfig) AcceptedCAs() []*x509.PEMEncodedCertificate {
+	var acceptedCAs []*x509.PEMEncodedCertificate
+
+	if e.RootCA != nil {
+		acceptedCAs = append(acceptedCAs, &x509.PEMEncodedCertificate{
+			Crt: e.RootCA.Crt,
+		})
+	}
+
+	return append(acceptedCAs, e.EtcdAcceptedCAs...)
+}
+
 // ExtraArgs implements the config.Etcd interface.
 func (e *EtcdConfig) ExtraArgs() map[string][]string {
 	return e.EtcdExtraArgs.ToMap()
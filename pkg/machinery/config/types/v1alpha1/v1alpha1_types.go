@@ -1186,6 +1186,18 @@ type EtcdConfig struct {
 	//         type: string
 	RootCA *x509.PEMEncodedCertificateAndKey `yaml:"ca"`
 	//   description: |
+	//     The certificates issued by certificate authorities are accepted in addition to issuing 'ca'.
+	//     It is composed of a base64 encoded `crt`.
+	//
+	//     This field is used during the etcd CA rotation.
+	//   schema:
+	//     type: object
+	//     additionalProperties: false
+	//     properties:
+	//       crt:
+	//         type: string
+	EtcdAcceptedCAs []*x509.PEMEncodedCertificate `yaml:"acceptedCAs,omitempty"`
+	//   description: |
 	//     Extra arguments to supply to etcd.
 	//     Note that the following args are not allowed:
 	//
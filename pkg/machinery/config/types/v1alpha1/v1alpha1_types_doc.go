@@ -321,6 +321,13 @@ func (EtcdConfig) Doc() *encoder.Doc {
 				Description: "The `ca` is the root certificate authority of the PKI.\nIt is composed of a base64 encoded `crt` and `key`.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "The `ca` is the root certificate authority of the PKI." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "acceptedCAs",
+				Type:        "[]PEMEncodedCertificate",
+				Note:        "",
+				Description: "The certificates issued by certificate authorities are accepted in addition to issuing 'ca'.\nIt is composed of a base64 encoded `crt`.\n\nThis field is used during the etcd CA rotation.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The certificates issued by certificate authorities are accepted in addition to issuing 'ca'." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 			{
 				Name:        "extraArgs",
 				Type:        "Args",
@@ -350,7 +357,7 @@ func (EtcdConfig) Doc() *encoder.Doc {
 
 	doc.Fields[0].AddExample("", clusterEtcdImageExample())
 	doc.Fields[1].AddExample("", pemEncodedCertificateExample())
-	doc.Fields[4].AddExample("", clusterEtcdAdvertisedSubnetsExample())
+	doc.Fields[5].AddExample("", clusterEtcdAdvertisedSubnetsExample())
 
 	return doc
 }
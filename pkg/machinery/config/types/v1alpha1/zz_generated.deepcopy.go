@@ -933,6 +933,16 @@ func (in *EtcdConfig) DeepCopyInto(out *EtcdConfig) {
 		in, out := &in.RootCA, &out.RootCA
 		*out = (*in).DeepCopy()
 	}
+	if in.EtcdAcceptedCAs != nil {
+		in, out := &in.EtcdAcceptedCAs, &out.EtcdAcceptedCAs
+		*out = make([]*x509.PEMEncodedCertificate, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = (*in).DeepCopy()
+			}
+		}
+	}
 	if in.EtcdExtraArgs != nil {
 		in, out := &in.EtcdExtraArgs, &out.EtcdExtraArgs
 		*out = make(meta.Args, len(*in))
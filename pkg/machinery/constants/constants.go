@@ -64,6 +64,10 @@ const (
 	// platform.
 	KernelParamPlatform = "talos.platform"
 
+	// KernelParamPlatformDescriptor is the kernel parameter name for specifying the
+	// URL of the descriptor of the generic platform.
+	KernelParamPlatformDescriptor = "talos.platform.descriptor"
+
 	// KernelParamEventsSink is the kernel parameter name for specifying the
 	// events sink server.
 	KernelParamEventsSink = "talos.events.sink"
@@ -454,6 +458,9 @@ const (
 	// EtcdTalosServiceAccountCRDControllerMutex is the etcd mutex prefix used by Talos ServiceAccount crd controller.
 	EtcdTalosServiceAccountCRDControllerMutex = EtcdRootTalosKey + ":serviceAccountCRDController"
 
+	// EtcdTalosKMSPluginStateKey is the etcd key storing the state of the Talos-managed KMS plugin.
+	EtcdTalosKMSPluginStateKey = EtcdRootTalosKey + ":kmsPluginState"
+
 	// EtcdImage is the reposistory for the etcd image.
 	EtcdImage = "registry.k8s.io/etcd"
 
@@ -561,6 +568,9 @@ const (
 	// TrustdUserID is the user ID for trustd.
 	TrustdUserID = 51
 
+	// EmbeddedDiscoveryPort is the default port for the embedded discovery service on the control plane nodes.
+	EmbeddedDiscoveryPort = 50002
+
 	// DefaultContainerdVersion is the default container runtime version.
 	DefaultContainerdVersion = "2.3.4"
 
@@ -624,6 +634,12 @@ const (
 	// TrustdRuntimeSocketLabel is the SELinux label for trustd runtime socket file.
 	TrustdRuntimeSocketLabel = "system_u:object_r:trustd_runtime_socket_t:s0"
 
+	// KubernetesKMSPluginSocketPath is the path to file socket of the Talos-managed KMS plugin for kube-apiserver.
+	KubernetesKMSPluginSocketPath = SystemRunPath + "/kms-plugin/kms.sock"
+
+	// KubernetesKMSPluginSocketLabel is the SELinux label for the KMS plugin socket file.
+	KubernetesKMSPluginSocketLabel = "system_u:object_r:kms_plugin_socket_t:s0"
+
 	// MachineSocketPath is the path to file socket of machine API.
 	MachineSocketPath = SystemRunPath + "/machined/machine.sock"
 
@@ -675,6 +691,12 @@ const (
 	// SDBootAssetPath is the path to the SDBoot in the installer.
 	SDBootAssetPath = "/usr/install/%s/" + SDBootAsset
 
+	// SBOMAsset defines a well known name for the Talos SBOM filename.
+	SBOMAsset = "talos.spdx.json"
+
+	// SBOMAssetPath is the path to the Talos SBOM in the imager.
+	SBOMAssetPath = "/usr/install/%s/" + SBOMAsset
+
 	// ImagerOverlayBasePath is the base path for the imager overlay.
 	ImagerOverlayBasePath = "/overlay"
 	// ImagerOverlayArtifactsPath is the path to the artifacts in the imager overlay.
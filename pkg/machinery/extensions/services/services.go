@@ -10,7 +10,10 @@ import (
 	"fmt"
 	"path/filepath"
 	"regexp"
+	"strconv"
+	"strings"
 
+	"github.com/dustin/go-humanize"
 	"github.com/hashicorp/go-multierror"
 	"github.com/opencontainers/runtime-spec/specs-go"
 
@@ -49,8 +52,50 @@ type Container struct {
 	Mounts []specs.Mount `yaml:"mounts"`
 	// Security options.
 	Security Security `yaml:"security"`
+	// Resources limits the resources available to the service.
+	Resources Resources `yaml:"resources,omitempty"`
 }
 
+// Resources describes cgroup v2 resource limits for the service.
+//
+// Zero values leave the setting at the default value.
+type Resources struct {
+	// CPUWeight is the relative CPU weight (cgroup v2 `cpu.weight`), 1-10000.
+	CPUWeight uint64 `yaml:"cpuWeight,omitempty"`
+	// CPUMax is the CPU limit in millicores (cgroup v2 `cpu.max`), e.g. `500m`.
+	CPUMax string `yaml:"cpuMax,omitempty"`
+	// MemoryMax is the hard memory limit (cgroup v2 `memory.max`), e.g. `256MiB`.
+	MemoryMax string `yaml:"memoryMax,omitempty"`
+	// MemoryHigh is the memory throttling limit (cgroup v2 `memory.high`), e.g. `200MiB`.
+	MemoryHigh string `yaml:"memoryHigh,omitempty"`
+	// IOWeight is the relative IO weight (cgroup v2 `io.weight`), 1-10000.
+	IOWeight uint64 `yaml:"ioWeight,omitempty"`
+	// OOMScoreAdj is the OOM score adjustment of the service process, -1000 to 1000.
+	//
+	// Defaults to -600.
+	OOMScoreAdj *int `yaml:"oomScoreAdj,omitempty"`
+}
+
+// Capabilities describes the capabilities granted to the service.
+//
+// By default, the service gets all capabilities which can be granted, except for CAP_SYS_BOOT and CAP_SYS_MODULE.
+type Capabilities struct {
+	// Drop is a list of capabilities to drop, `ALL` drops all capabilities.
+	Drop []string `yaml:"drop,omitempty"`
+	// Add is a list of capabilities to grant after dropping.
+	Add []string `yaml:"add,omitempty"`
+}
+
+// Seccomp profile kinds.
+const (
+	// SeccompProfileRuntimeDefault is the default seccomp profile.
+	SeccompProfileRuntimeDefault = "runtime/default"
+	// SeccompProfileUnconfined disables seccomp filtering.
+	SeccompProfileUnconfined = "unconfined"
+	// SeccompProfileLocalhostPrefix is the prefix of the profile loaded from the container rootfs.
+	SeccompProfileLocalhostPrefix = "localhost/"
+)
+
 // Security options for containers.
 type Security struct {
 	// WriteableSysfs makes the '/sys' path writeable in the container namespace if set to true.
@@ -63,6 +108,13 @@ type Security struct {
 	WriteableRootfs bool `yaml:"writeableRootfs"`
 	// RootfsPropagation is the propagation mode for the rootfs mount.
 	RootfsPropagation string `yaml:"rootfsPropagation,omitempty"`
+	// Capabilities granted to the service.
+	Capabilities Capabilities `yaml:"capabilities,omitempty"`
+	// SeccompProfile is the seccomp profile of the service.
+	//
+	// Valid values: `runtime/default` (default), `unconfined`, `localhost/<path>`,
+	// where `<path>` is the path to the OCI seccomp profile (JSON) relative to the container rootfs.
+	SeccompProfile string `yaml:"seccompProfile,omitempty"`
 }
 
 // Dependency describes a service Dependency.
@@ -83,7 +135,16 @@ type Dependency struct {
 	Configuration bool `yaml:"configuration,omitempty"`
 }
 
-var nameRe = regexp.MustCompile(`^[-_a-z0-9]{1,}$`)
+var (
+	nameRe       = regexp.MustCompile(`^[-_a-z0-9]{1,}$`)
+	capabilityRe = regexp.MustCompile(`^(?i)cap_[a-z_]+$`)
+)
+
+// CapabilityAll is the special capability name to drop all capabilities.
+const CapabilityAll = "ALL"
+
+// MaxCgroupWeight is the maximum value of the cgroup v2 weight.
+const MaxCgroupWeight = 10000
 
 // Validate the service spec.
 func (spec *Spec) Validate() error {
@@ -114,9 +175,119 @@ func (ctr *Container) Validate() error {
 		multiErr = multierror.Append(multiErr, errors.New("container endpoint can't be empty"))
 	}
 
+	multiErr = multierror.Append(multiErr, ctr.Resources.Validate())
+	multiErr = multierror.Append(multiErr, ctr.Security.Validate())
+
 	return multiErr.ErrorOrNil()
 }
 
+// Validate the resources spec.
+func (res *Resources) Validate() error {
+	var multiErr *multierror.Error
+
+	if res.CPUWeight > MaxCgroupWeight {
+		multiErr = multierror.Append(multiErr, fmt.Errorf("cpu weight should be in range 1-%d: %d", MaxCgroupWeight, res.CPUWeight))
+	}
+
+	if res.CPUMax != "" {
+		if _, err := ParseMillicores(res.CPUMax); err != nil {
+			multiErr = multierror.Append(multiErr, err)
+		}
+	}
+
+	for _, limit := range []struct {
+		name  string
+		value string
+	}{
+		{name: "memory max", value: res.MemoryMax},
+		{name: "memory high", value: res.MemoryHigh},
+	} {
+		if limit.value == "" {
+			continue
+		}
+
+		if _, err := humanize.ParseBytes(limit.value); err != nil {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("%s %q is not a valid size: %w", limit.name, limit.value, err))
+		}
+	}
+
+	if res.IOWeight > MaxCgroupWeight {
+		multiErr = multierror.Append(multiErr, fmt.Errorf("io weight should be in range 1-%d: %d", MaxCgroupWeight, res.IOWeight))
+	}
+
+	if res.OOMScoreAdj != nil && (*res.OOMScoreAdj < -1000 || *res.OOMScoreAdj > 1000) {
+		multiErr = multierror.Append(multiErr, fmt.Errorf("oom score adj should be in range -1000-1000: %d", *res.OOMScoreAdj))
+	}
+
+	return multiErr.ErrorOrNil()
+}
+
+// Validate the security spec.
+func (sec *Security) Validate() error {
+	var multiErr *multierror.Error
+
+	for _, capability := range sec.Capabilities.Drop {
+		if strings.ToUpper(capability) == CapabilityAll {
+			continue
+		}
+
+		multiErr = multierror.Append(multiErr, ValidateCapability(capability))
+	}
+
+	for _, capability := range sec.Capabilities.Add {
+		multiErr = multierror.Append(multiErr, ValidateCapability(capability))
+	}
+
+	multiErr = multierror.Append(multiErr, ValidateSeccompProfile(sec.SeccompProfile))
+
+	return multiErr.ErrorOrNil()
+}
+
+// ValidateCapability validates the capability name, e.g. `CAP_NET_ADMIN`.
+func ValidateCapability(capability string) error {
+	if !capabilityRe.MatchString(capability) {
+		return fmt.Errorf("capability %q is invalid", capability)
+	}
+
+	return nil
+}
+
+// ValidateSeccompProfile validates the seccomp profile value.
+func ValidateSeccompProfile(profile string) error {
+	switch {
+	case profile == "", profile == SeccompProfileRuntimeDefault, profile == SeccompProfileUnconfined:
+		return nil
+	case strings.HasPrefix(profile, SeccompProfileLocalhostPrefix):
+		path := strings.TrimPrefix(profile, SeccompProfileLocalhostPrefix)
+
+		if path == "" || filepath.IsAbs(path) || !filepath.IsLocal(path) {
+			return fmt.Errorf("seccomp profile path should be relative to the container rootfs: %q", path)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("seccomp profile %q is invalid", profile)
+	}
+}
+
+// ParseMillicores parses a CPU quantity in millicores (e.g. `500m`).
+func ParseMillicores(value string) (uint64, error) {
+	if !strings.HasSuffix(value, "m") {
+		return 0, fmt.Errorf("cpu %q must be expressed in millicores, e.g. 500m", value)
+	}
+
+	millicores, err := strconv.ParseUint(strings.TrimSuffix(value, "m"), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("cpu %q is not a valid millicore quantity: %w", value, err)
+	}
+
+	if millicores == 0 {
+		return 0, fmt.Errorf("cpu %q must be greater than zero", value)
+	}
+
+	return millicores, nil
+}
+
 // Validate the dependency spec.
 //
 //nolint:gocyclo
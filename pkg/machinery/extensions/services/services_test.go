@@ -38,6 +38,21 @@ func TestUnmarshal(t *testing.T) {
 					Options:     []string{"rbind", "ro"},
 				},
 			},
+			Security: services.Security{
+				Capabilities: services.Capabilities{
+					Drop: []string{"ALL"},
+					Add:  []string{"CAP_NET_ADMIN"},
+				},
+				SeccompProfile: services.SeccompProfileRuntimeDefault,
+			},
+			Resources: services.Resources{
+				CPUWeight:   50,
+				CPUMax:      "500m",
+				MemoryMax:   "256MiB",
+				MemoryHigh:  "200MiB",
+				IOWeight:    50,
+				OOMScoreAdj: new(500),
+			},
 		},
 		Depends: []services.Dependency{
 			{
@@ -106,6 +121,31 @@ func TestValidate(t *testing.T) {
 			},
 			expectedError: "4 errors occurred:\n\t* no dependency specified\n\t* path is not absolute: \"./somefile\"\n\t* invalid network dependency: Status(0)\n\t* more than a single dependency is set\n\n",
 		},
+		{
+			name: "invalid resources and security",
+			spec: services.Spec{
+				Name: "foo",
+				Container: services.Container{
+					Entrypoint: "foo",
+					Security: services.Security{
+						Capabilities: services.Capabilities{
+							Drop: []string{"ALL", "net_admin"},
+							Add:  []string{"ALL"},
+						},
+						SeccompProfile: "localhost/../profile.json",
+					},
+					Resources: services.Resources{
+						CPUWeight:   20000,
+						CPUMax:      "1",
+						MemoryMax:   "lots",
+						IOWeight:    10001,
+						OOMScoreAdj: new(-1001),
+					},
+				},
+				Restart: services.RestartAlways,
+			},
+			expectedError: "8 errors occurred:\n\t* cpu weight should be in range 1-10000: 20000\n\t* cpu \"1\" must be expressed in millicores, e.g. 500m\n\t* memory max \"lots\" is not a valid size: strconv.ParseFloat: parsing \"\": invalid syntax\n\t* io weight should be in range 1-10000: 10001\n\t* oom score adj should be in range -1000-1000: -1001\n\t* capability \"net_admin\" is invalid\n\t* capability \"ALL\" is invalid\n\t* seccomp profile path should be relative to the container rootfs: \"../profile.json\"\n\n",
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			err := tt.spec.Validate()
@@ -29,4 +29,8 @@ const (
 	UniqueMachineToken
 	// DiskImageBootloader stores the bootloader used for the disk image, this key is wiped on first boot.
 	DiskImageBootloader
+	// ConfigTrustPolicy stores PEM-encoded public keys trusted to sign the machine configuration applied via the API.
+	//
+	// The key is set at install time, and it can't be changed via the API.
+	ConfigTrustPolicy
 )
@@ -0,0 +1,44 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+import (
+	"fmt"
+	"net/netip"
+)
+
+// Subnet returns the subnet of the prefix with the specified ID and length.
+//
+// The subnet ID fills the bits of the address between the prefix length and the subnet length,
+// e.g. the subnet 1 of length 64 of 2001:db8:0:100::/56 is 2001:db8:0:101::/64.
+func Subnet(prefix netip.Prefix, subnetID uint32, bits int) (netip.Prefix, error) {
+	if !prefix.IsValid() {
+		return netip.Prefix{}, fmt.Errorf("invalid prefix %s", prefix)
+	}
+
+	prefix = prefix.Masked()
+
+	if bits < prefix.Bits() || bits > prefix.Addr().BitLen() {
+		return netip.Prefix{}, fmt.Errorf("subnet length %d is out of range for prefix %s", bits, prefix)
+	}
+
+	if subnetBits := bits - prefix.Bits(); subnetBits < 32 && uint64(subnetID) >= 1<<subnetBits {
+		return netip.Prefix{}, fmt.Errorf("subnet ID %d doesn't fit into %d bits of prefix %s", subnetID, subnetBits, prefix)
+	}
+
+	addr := prefix.Addr().AsSlice()
+
+	for bit := bits - 1; subnetID != 0; bit-- {
+		if subnetID&1 != 0 {
+			addr[bit/8] |= 0x80 >> (bit % 8)
+		}
+
+		subnetID >>= 1
+	}
+
+	subnet, _ := netip.AddrFromSlice(addr)
+
+	return netip.PrefixFrom(subnet, bits), nil
+}
@@ -0,0 +1,98 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers_test
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+func TestSubnet(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name     string
+		prefix   string
+		subnetID uint32
+		bits     int
+
+		expected      string
+		expectedError string
+	}{
+		{
+			name:     "first",
+			prefix:   "2001:db8:0:100::/56",
+			bits:     64,
+			expected: "2001:db8:0:100::/64",
+		},
+		{
+			name:     "second",
+			prefix:   "2001:db8:0:100::/56",
+			subnetID: 1,
+			bits:     64,
+			expected: "2001:db8:0:101::/64",
+		},
+		{
+			name:     "last",
+			prefix:   "2001:db8:0:100::/56",
+			subnetID: 255,
+			bits:     64,
+			expected: "2001:db8:0:1ff::/64",
+		},
+		{
+			name:     "unaligned",
+			prefix:   "2001:db8:0:100::/60",
+			subnetID: 5,
+			bits:     66,
+			expected: "2001:db8:0:101:4000::/66",
+		},
+		{
+			name:     "whole prefix",
+			prefix:   "2001:db8::1/64",
+			bits:     64,
+			expected: "2001:db8::/64",
+		},
+		{
+			name:     "IPv4",
+			prefix:   "10.0.0.0/16",
+			subnetID: 3,
+			bits:     24,
+			expected: "10.0.3.0/24",
+		},
+		{
+			name:          "ID overflow",
+			prefix:        "2001:db8:0:100::/56",
+			subnetID:      256,
+			bits:          64,
+			expectedError: "subnet ID 256 doesn't fit into 8 bits of prefix 2001:db8:0:100::/56",
+		},
+		{
+			name:          "too short",
+			prefix:        "2001:db8:0:100::/56",
+			bits:          48,
+			expectedError: "subnet length 48 is out of range for prefix 2001:db8:0:100::/56",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			subnet, err := nethelpers.Subnet(netip.MustParsePrefix(test.prefix), test.subnetID, test.bits)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, subnet.String())
+		})
+	}
+}
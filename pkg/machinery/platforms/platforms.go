@@ -398,5 +398,19 @@ func CloudPlatforms() []Platform {
 			},
 			MinVersion: semver.MustParse("1.14.0-beta.2"),
 		},
+		{
+			Name: "generic",
+
+			Label:       "Generic",
+			Description: "Runs on clouds with the metadata service described by a platform descriptor",
+
+			Architectures:   []Arch{ArchAmd64, ArchArm64},
+			DiskImageSuffix: "raw.zst",
+			BootMethods: []BootMethod{
+				BootMethodDiskImage,
+				BootMethodISO,
+			},
+			MinVersion: semver.MustParse("1.15.0-alpha.0"),
+		},
 	}
 }
@@ -51,6 +51,8 @@ type ServiceEndpoint struct {
 	Name     string `yaml:"name" protobuf:"1"`
 	Endpoint string `yaml:"endpoint" protobuf:"2"`
 	Insecure bool   `yaml:"insecure" protobuf:"3"`
+	// CACerts is a PEM-encoded CA bundle to verify the endpoint against, if empty, the system roots are used.
+	CACerts []byte `yaml:"caCerts,omitempty" protobuf:"4"`
 }
 
 // NewConfig initializes a Config resource.
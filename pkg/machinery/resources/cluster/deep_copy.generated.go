@@ -46,6 +46,12 @@ func (o ConfigSpec) DeepCopy() ConfigSpec {
 	if o.ServiceEndpoints != nil {
 		cp.ServiceEndpoints = make([]ServiceEndpoint, len(o.ServiceEndpoints))
 		copy(cp.ServiceEndpoints, o.ServiceEndpoints)
+		for i2 := range o.ServiceEndpoints {
+			if o.ServiceEndpoints[i2].CACerts != nil {
+				cp.ServiceEndpoints[i2].CACerts = make([]byte, len(o.ServiceEndpoints[i2].CACerts))
+				copy(cp.ServiceEndpoints[i2].CACerts, o.ServiceEndpoints[i2].CACerts)
+			}
+		}
 	}
 	return cp
 }
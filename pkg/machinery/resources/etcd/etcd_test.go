@@ -26,6 +26,7 @@ func TestRegisterResource(t *testing.T) {
 
 	for _, resource := range []meta.ResourceWithRD{
 		&etcd.PKIStatus{},
+		&etcd.MaintenanceStatus{},
 	} {
 		assert.NoError(t, resourceRegistry.Register(ctx, resource))
 	}
@@ -0,0 +1,126 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// MaintenanceStatusType is type of MaintenanceStatus resource.
+const MaintenanceStatusType = resource.Type("EtcdMaintenanceStatuses.etcd.talos.dev")
+
+// MaintenanceStatusID is resource ID for MaintenanceStatus resource for etcd.
+const MaintenanceStatusID = resource.ID("local")
+
+// MaxMaintenanceHistory is the number of the last maintenance events kept in the MaintenanceStatus.
+const MaxMaintenanceHistory = 16
+
+// MaintenanceOperationDefragment is the defragmentation maintenance operation.
+const MaintenanceOperationDefragment = "defragment"
+
+// MaintenanceStatus resource holds the state of the local etcd member database and the maintenance history.
+type MaintenanceStatus = typed.Resource[MaintenanceStatusSpec, MaintenanceStatusExtension]
+
+// MaintenanceStatusSpec describes the state of the local etcd member database.
+//
+//gotagsrewrite:gen
+type MaintenanceStatusSpec struct {
+	MemberID      string             `yaml:"memberID" protobuf:"1"`
+	Leader        bool               `yaml:"leader" protobuf:"2"`
+	DBSize        int64              `yaml:"dbSize" protobuf:"3"`
+	DBSizeInUse   int64              `yaml:"dbSizeInUse" protobuf:"4"`
+	DBSizeQuota   int64              `yaml:"dbSizeQuota" protobuf:"5"`
+	LastCheckTime time.Time          `yaml:"lastCheckTime" protobuf:"6"`
+	History       []MaintenanceEvent `yaml:"history,omitempty" protobuf:"7"`
+}
+
+// MaintenanceEvent describes a single maintenance operation on the etcd member.
+//
+//gotagsrewrite:gen
+type MaintenanceEvent struct {
+	Operation    string        `yaml:"operation" protobuf:"1"`
+	StartTime    time.Time     `yaml:"startTime" protobuf:"2"`
+	Duration     time.Duration `yaml:"duration" protobuf:"3"`
+	DBSizeBefore int64         `yaml:"dbSizeBefore" protobuf:"4"`
+	DBSizeAfter  int64         `yaml:"dbSizeAfter" protobuf:"5"`
+	Error        string        `yaml:"error,omitempty" protobuf:"6"`
+}
+
+// Fragmentation returns the fraction of the database size which is not in use.
+func (spec *MaintenanceStatusSpec) Fragmentation() float64 {
+	if spec.DBSize == 0 {
+		return 0
+	}
+
+	return 1 - float64(spec.DBSizeInUse)/float64(spec.DBSize)
+}
+
+// QuotaUsage returns the fraction of the database quota which is used.
+func (spec *MaintenanceStatusSpec) QuotaUsage() float64 {
+	if spec.DBSizeQuota == 0 {
+		return 0
+	}
+
+	return float64(spec.DBSize) / float64(spec.DBSizeQuota)
+}
+
+// AddEvent appends the maintenance event to the history, keeping only the last MaxMaintenanceHistory events.
+func (spec *MaintenanceStatusSpec) AddEvent(event MaintenanceEvent) {
+	spec.History = append(spec.History, event)
+
+	if len(spec.History) > MaxMaintenanceHistory {
+		spec.History = spec.History[len(spec.History)-MaxMaintenanceHistory:]
+	}
+}
+
+// NewMaintenanceStatus initializes a MaintenanceStatus resource.
+func NewMaintenanceStatus(namespace resource.Namespace, id resource.ID) *MaintenanceStatus {
+	return typed.NewResource[MaintenanceStatusSpec, MaintenanceStatusExtension](
+		resource.NewMetadata(namespace, MaintenanceStatusType, id, resource.VersionUndefined),
+		MaintenanceStatusSpec{},
+	)
+}
+
+// MaintenanceStatusExtension provides auxiliary methods for MaintenanceStatus.
+type MaintenanceStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (MaintenanceStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             MaintenanceStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "DB Size",
+				JSONPath: "{.dbSize}",
+			},
+			{
+				Name:     "In Use",
+				JSONPath: "{.dbSizeInUse}",
+			},
+			{
+				Name:     "Quota",
+				JSONPath: "{.dbSizeQuota}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[MaintenanceStatusSpec](MaintenanceStatusType, &MaintenanceStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type CPUCoreSpec -type MemoryModuleSpec -type PCIDeviceSpec -type PCIDriverRebindConfigSpec -type PCIDriverRebindStatusSpec -type PCRStatusSpec -type ProcessorSpec -type SystemInformationSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type CPUCoreSpec -type MemoryModuleSpec -type PCIDeviceSpec -type PCIDriverRebindConfigSpec -type PCIDriverRebindStatusSpec -type PCRStatusSpec -type ProcessorSpec -type SRIOVConfigSpec -type SRIOVStatusSpec -type SystemInformationSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package hardware
 
@@ -60,6 +60,32 @@ func (o ProcessorSpec) DeepCopy() ProcessorSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of SRIOVConfigSpec.
+func (o SRIOVConfigSpec) DeepCopy() SRIOVConfigSpec {
+	var cp SRIOVConfigSpec = o
+	if o.VFs != nil {
+		cp.VFs = make([]SRIOVVirtualFunctionSpec, len(o.VFs))
+		copy(cp.VFs, o.VFs)
+		for i2 := range o.VFs {
+			if o.VFs[i2].HardwareAddr != nil {
+				cp.VFs[i2].HardwareAddr = make([]byte, len(o.VFs[i2].HardwareAddr))
+				copy(cp.VFs[i2].HardwareAddr, o.VFs[i2].HardwareAddr)
+			}
+		}
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of SRIOVStatusSpec.
+func (o SRIOVStatusSpec) DeepCopy() SRIOVStatusSpec {
+	var cp SRIOVStatusSpec = o
+	if o.VFs != nil {
+		cp.VFs = make([]SRIOVVirtualFunctionStatus, len(o.VFs))
+		copy(cp.VFs, o.VFs)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of SystemInformationSpec.
 func (o SystemInformationSpec) DeepCopy() SystemInformationSpec {
 	var cp SystemInformationSpec = o
@@ -31,6 +31,8 @@ func TestRegisterResource(t *testing.T) {
 		&hardware.PCIDriverRebindStatus{},
 		&hardware.PCRStatus{},
 		&hardware.Processor{},
+		&hardware.SRIOVConfig{},
+		&hardware.SRIOVStatus{},
 		&hardware.SystemInformation{},
 	} {
 		assert.NoError(t, resourceRegistry.Register(ctx, resource))
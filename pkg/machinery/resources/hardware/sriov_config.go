@@ -0,0 +1,88 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardware
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// SRIOVConfigType is type of SRIOVConfig resource.
+const SRIOVConfigType = resource.Type("SRIOVConfigs.hardware.talos.dev")
+
+// SRIOVConfig resource holds SR-IOV configuration of a physical function.
+type SRIOVConfig = typed.Resource[SRIOVConfigSpec, SRIOVConfigExtension]
+
+// SRIOVConfigSpec describes SR-IOV configuration of a physical function.
+//
+//gotagsrewrite:gen
+type SRIOVConfigSpec struct {
+	// PCI address of the physical function.
+	PhysicalFunction string `yaml:"physicalFunction" protobuf:"1"`
+	// Link name of the physical function.
+	LinkName string `yaml:"linkName,omitempty" protobuf:"2"`
+
+	NumVFs uint32                     `yaml:"numVFs" protobuf:"3"`
+	Driver string                     `yaml:"driver,omitempty" protobuf:"4"`
+	VFs    []SRIOVVirtualFunctionSpec `yaml:"vfs,omitempty" protobuf:"5"`
+}
+
+// SRIOVVirtualFunctionSpec describes configuration of a virtual function.
+//
+//gotagsrewrite:gen
+type SRIOVVirtualFunctionSpec struct {
+	Index        uint32                  `yaml:"index" protobuf:"1"`
+	HardwareAddr nethelpers.HardwareAddr `yaml:"hardwareAddr,omitempty" protobuf:"2"`
+	VLANID       uint16                  `yaml:"vlanID,omitempty" protobuf:"3"`
+	Trust        bool                    `yaml:"trust" protobuf:"4"`
+	SpoofCheck   bool                    `yaml:"spoofCheck" protobuf:"5"`
+}
+
+// SRIOVConfigExtension is auxiliary resource data for SRIOVConfig.
+type SRIOVConfigExtension struct{}
+
+// NewSRIOVConfig initializes a SRIOVConfig resource.
+func NewSRIOVConfig(id resource.ID) *SRIOVConfig {
+	return typed.NewResource[SRIOVConfigSpec, SRIOVConfigExtension](
+		resource.NewMetadata(NamespaceName, SRIOVConfigType, id, resource.VersionUndefined),
+		SRIOVConfigSpec{},
+	)
+}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (SRIOVConfigExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             SRIOVConfigType,
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Link",
+				JSONPath: `{.linkName}`,
+			},
+			{
+				Name:     "VFs",
+				JSONPath: `{.numVFs}`,
+			},
+			{
+				Name:     "Driver",
+				JSONPath: `{.driver}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[SRIOVConfigSpec](SRIOVConfigType, &SRIOVConfig{})
+	if err != nil {
+		panic(err)
+	}
+}
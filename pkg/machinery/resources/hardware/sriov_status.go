@@ -0,0 +1,89 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardware
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// SRIOVStatusType is type of SRIOVStatus resource.
+const SRIOVStatusType = resource.Type("SRIOVStatuses.hardware.talos.dev")
+
+// SRIOVStatus resource holds SR-IOV status of a physical function.
+type SRIOVStatus = typed.Resource[SRIOVStatusSpec, SRIOVStatusExtension]
+
+// SRIOVStatusSpec describes SR-IOV status of a physical function.
+//
+//gotagsrewrite:gen
+type SRIOVStatusSpec struct {
+	// PCI address of the physical function.
+	PhysicalFunction string `yaml:"physicalFunction" protobuf:"1"`
+	// Link name of the physical function.
+	LinkName string `yaml:"linkName,omitempty" protobuf:"2"`
+
+	TotalVFs uint32                       `yaml:"totalVFs" protobuf:"3"`
+	NumVFs   uint32                       `yaml:"numVFs" protobuf:"4"`
+	VFs      []SRIOVVirtualFunctionStatus `yaml:"vfs,omitempty" protobuf:"5"`
+}
+
+// SRIOVVirtualFunctionStatus describes status of a virtual function.
+//
+//gotagsrewrite:gen
+type SRIOVVirtualFunctionStatus struct {
+	Index uint32 `yaml:"index" protobuf:"1"`
+	// PCI address of the virtual function.
+	PCIAddress string `yaml:"pciAddress" protobuf:"2"`
+	// Link name of the virtual function, if it is bound to a network driver.
+	LinkName string `yaml:"linkName,omitempty" protobuf:"3"`
+	Driver   string `yaml:"driver,omitempty" protobuf:"4"`
+}
+
+// SRIOVStatusExtension is auxiliary resource data for SRIOVStatus.
+type SRIOVStatusExtension struct{}
+
+// NewSRIOVStatus initializes a SRIOVStatus resource.
+func NewSRIOVStatus(id resource.ID) *SRIOVStatus {
+	return typed.NewResource[SRIOVStatusSpec, SRIOVStatusExtension](
+		resource.NewMetadata(NamespaceName, SRIOVStatusType, id, resource.VersionUndefined),
+		SRIOVStatusSpec{},
+	)
+}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (SRIOVStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             SRIOVStatusType,
+		Aliases:          []resource.Type{"sriov"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Link",
+				JSONPath: `{.linkName}`,
+			},
+			{
+				Name:     "Total VFs",
+				JSONPath: `{.totalVFs}`,
+			},
+			{
+				Name:     "VFs",
+				JSONPath: `{.numVFs}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[SRIOVStatusSpec](SRIOVStatusType, &SRIOVStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,86 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// KMSPluginStatusType is type of KMSPluginStatus resource.
+const KMSPluginStatusType = resource.Type("KMSPluginStatuses.kubernetes.talos.dev")
+
+// KMSPluginStatusID is resource ID for KMSPluginStatus resource.
+const KMSPluginStatusID = resource.ID("kms-plugin")
+
+// KMSPluginStatus resource holds the status of the Talos-managed KMS plugin for kube-apiserver.
+type KMSPluginStatus = typed.Resource[KMSPluginStatusSpec, KMSPluginStatusExtension]
+
+// KMSPluginStatusSpec describes the status of the KMS plugin.
+//
+//gotagsrewrite:gen
+type KMSPluginStatusSpec struct {
+	Healthy      bool      `yaml:"healthy" protobuf:"1"`
+	Provider     string    `yaml:"provider" protobuf:"2"`
+	NodeKeyID    string    `yaml:"nodeKeyID" protobuf:"3"`
+	KeyID        string    `yaml:"keyID" protobuf:"4"`
+	KeyCreated   time.Time `yaml:"keyCreated" protobuf:"5"`
+	KeyCount     int       `yaml:"keyCount" protobuf:"6"`
+	LastSyncTime time.Time `yaml:"lastSyncTime" protobuf:"7"`
+	Error        string    `yaml:"error,omitempty" protobuf:"8"`
+}
+
+// NewKMSPluginStatus initializes a KMSPluginStatus resource.
+func NewKMSPluginStatus() *KMSPluginStatus {
+	return typed.NewResource[KMSPluginStatusSpec, KMSPluginStatusExtension](
+		resource.NewMetadata(ControlPlaneNamespaceName, KMSPluginStatusType, KMSPluginStatusID, resource.VersionUndefined),
+		KMSPluginStatusSpec{},
+	)
+}
+
+// KMSPluginStatusExtension provides auxiliary methods for KMSPluginStatus.
+type KMSPluginStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (KMSPluginStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             KMSPluginStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: ControlPlaneNamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Healthy",
+				JSONPath: "{.healthy}",
+			},
+			{
+				Name:     "Provider",
+				JSONPath: "{.provider}",
+			},
+			{
+				Name:     "Key ID",
+				JSONPath: "{.keyID}",
+			},
+			{
+				Name:     "Key Created",
+				JSONPath: "{.keyCreated}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[KMSPluginStatusSpec](KMSPluginStatusType, &KMSPluginStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
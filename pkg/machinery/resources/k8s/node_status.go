@@ -25,12 +25,14 @@ type NodeStatus = typed.Resource[NodeStatusSpec, NodeStatusExtension]
 //
 //gotagsrewrite:gen
 type NodeStatusSpec struct {
-	Nodename      string            `yaml:"nodename" protobuf:"1"`
-	NodeReady     bool              `yaml:"nodeReady" protobuf:"2"`
-	Unschedulable bool              `yaml:"unschedulable" protobuf:"3"`
-	Labels        map[string]string `yaml:"labels" protobuf:"4"`
-	Annotations   map[string]string `yaml:"annotations" protobuf:"5"`
-	PodCIDRs      []netip.Prefix    `yaml:"podCIDRs" protobuf:"6"`
+	Nodename       string            `yaml:"nodename" protobuf:"1"`
+	NodeReady      bool              `yaml:"nodeReady" protobuf:"2"`
+	Unschedulable  bool              `yaml:"unschedulable" protobuf:"3"`
+	Labels         map[string]string `yaml:"labels" protobuf:"4"`
+	Annotations    map[string]string `yaml:"annotations" protobuf:"5"`
+	PodCIDRs       []netip.Prefix    `yaml:"podCIDRs" protobuf:"6"`
+	MemoryPressure bool              `yaml:"memoryPressure" protobuf:"7"`
+	DiskPressure   bool              `yaml:"diskPressure" protobuf:"8"`
 }
 
 // NewNodeStatus initializes a NodeStatus resource.
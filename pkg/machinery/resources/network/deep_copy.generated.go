@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type AddressSpecSpec -type AddressStatusSpec -type BGPBFDConfigSpec -type BGPNeighborConfigSpec -type BGPInstanceConfigSpec -type BGPPeerStatusSpec -type BondMasterSpec -type DNSResolveCacheSpec -type EthernetSpecSpec -type EthernetStatusSpec -type HardwareAddrSpec -type HostDNSConfigSpec -type HostnameSpecSpec -type HostnameStatusSpec -type LinkAliasSpecSpec -type LinkRefreshSpec -type LinkSpecSpec -type LinkStatusSpec -type NfTablesChainSpec -type NodeAddressSpec -type NodeAddressSortAlgorithmSpec -type NodeAddressFilterSpec -type OperatorSpecSpec -type PlatformConfigSpec -type ProbeSpecSpec -type ProbeStatusSpec -type ResolverSpecSpec -type ResolverStatusSpec -type RouteSpecSpec -type RouteStatusSpec -type RoutingRuleSpecSpec -type RoutingRuleStatusSpec -type StaticHostSpec -type StatusSpec -type TimeServerSpecSpec -type TimeServerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type AddressSpecSpec -type AddressStatusSpec -type BGPBFDConfigSpec -type BGPNeighborConfigSpec -type BGPInstanceConfigSpec -type BGPPeerStatusSpec -type BondMasterSpec -type DelegatedPrefixSpec -type DNSResolveCacheSpec -type Dot1XConfigSpec -type Dot1XStatusSpec -type EthernetSpecSpec -type EthernetStatusSpec -type HardwareAddrSpec -type HostDNSConfigSpec -type HostnameSpecSpec -type HostnameStatusSpec -type LinkAliasSpecSpec -type LinkRefreshSpec -type LinkSpecSpec -type LinkStatusSpec -type NfTablesChainSpec -type NodeAddressSpec -type NodeAddressSortAlgorithmSpec -type NodeAddressFilterSpec -type OperatorSpecSpec -type PlatformConfigSpec -type ProbeSpecSpec -type ProbeStatusSpec -type ResolverSpecSpec -type ResolverStatusSpec -type RouteSpecSpec -type RouteStatusSpec -type RoutingRuleSpecSpec -type RoutingRuleStatusSpec -type StaticHostSpec -type StatusSpec -type TimeServerSpecSpec -type TimeServerStatusSpec -type VIPStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package network
 
@@ -96,12 +96,34 @@ func (o BondMasterSpec) DeepCopy() BondMasterSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of DelegatedPrefixSpec.
+func (o DelegatedPrefixSpec) DeepCopy() DelegatedPrefixSpec {
+	var cp DelegatedPrefixSpec = o
+	if o.Downstream != nil {
+		cp.Downstream = make([]DelegatedPrefixDownstreamSpec, len(o.Downstream))
+		copy(cp.Downstream, o.Downstream)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of DNSResolveCacheSpec.
 func (o DNSResolveCacheSpec) DeepCopy() DNSResolveCacheSpec {
 	var cp DNSResolveCacheSpec = o
 	return cp
 }
 
+// DeepCopy generates a deep copy of Dot1XConfigSpec.
+func (o Dot1XConfigSpec) DeepCopy() Dot1XConfigSpec {
+	var cp Dot1XConfigSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of Dot1XStatusSpec.
+func (o Dot1XStatusSpec) DeepCopy() Dot1XStatusSpec {
+	var cp Dot1XStatusSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of EthernetSpecSpec.
 func (o EthernetSpecSpec) DeepCopy() EthernetSpecSpec {
 	var cp EthernetSpecSpec = o
@@ -543,6 +565,10 @@ func (o NodeAddressFilterSpec) DeepCopy() NodeAddressFilterSpec {
 // DeepCopy generates a deep copy of OperatorSpecSpec.
 func (o OperatorSpecSpec) DeepCopy() OperatorSpecSpec {
 	var cp OperatorSpecSpec = o
+	if o.DHCP6.PrefixDelegation.Downstream != nil {
+		cp.DHCP6.PrefixDelegation.Downstream = make([]DHCP6PrefixDelegationDownstreamSpec, len(o.DHCP6.PrefixDelegation.Downstream))
+		copy(cp.DHCP6.PrefixDelegation.Downstream, o.DHCP6.PrefixDelegation.Downstream)
+	}
 	return cp
 }
 
@@ -739,3 +765,9 @@ func (o TimeServerStatusSpec) DeepCopy() TimeServerStatusSpec {
 	}
 	return cp
 }
+
+// DeepCopy generates a deep copy of VIPStatusSpec.
+func (o VIPStatusSpec) DeepCopy() VIPStatusSpec {
+	var cp VIPStatusSpec = o
+	return cp
+}
@@ -0,0 +1,94 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// DelegatedPrefixType is type of DelegatedPrefix resource.
+const DelegatedPrefixType = resource.Type("DelegatedPrefixes.net.talos.dev")
+
+// DelegatedPrefix resource holds the prefix delegated to the node via DHCPv6.
+//
+// The ID of the resource is the name of the upstream link.
+type DelegatedPrefix = typed.Resource[DelegatedPrefixSpec, DelegatedPrefixExtension]
+
+// DelegatedPrefixSpec describes the delegated prefix and its subnets assigned to downstream links.
+//
+//gotagsrewrite:gen
+type DelegatedPrefixSpec struct {
+	// LinkName is the upstream link the prefix was delegated on.
+	LinkName string `yaml:"linkName" protobuf:"1"`
+	// Prefix is the delegated prefix.
+	Prefix netip.Prefix `yaml:"prefix" protobuf:"2"`
+	// PreferredLifetime and ValidLifetime of the delegated prefix as of the last renewal.
+	PreferredLifetime time.Duration `yaml:"preferredLifetime" protobuf:"3"`
+	ValidLifetime     time.Duration `yaml:"validLifetime" protobuf:"4"`
+	// Downstream is the list of subnets assigned to the downstream links.
+	Downstream []DelegatedPrefixDownstreamSpec `yaml:"downstream,omitempty" protobuf:"5"`
+}
+
+// DelegatedPrefixDownstreamSpec describes a subnet of the delegated prefix assigned to a downstream link.
+//
+//gotagsrewrite:gen
+type DelegatedPrefixDownstreamSpec struct {
+	LinkName string       `yaml:"linkName" protobuf:"1"`
+	Subnet   netip.Prefix `yaml:"subnet" protobuf:"2"`
+	// Address is the address of the node in the subnet.
+	Address              netip.Prefix `yaml:"address" protobuf:"3"`
+	RouterAdvertisements bool         `yaml:"routerAdvertisements" protobuf:"4"`
+}
+
+// NewDelegatedPrefix initializes a DelegatedPrefix resource.
+func NewDelegatedPrefix(namespace resource.Namespace, id resource.ID) *DelegatedPrefix {
+	return typed.NewResource[DelegatedPrefixSpec, DelegatedPrefixExtension](
+		resource.NewMetadata(namespace, DelegatedPrefixType, id, resource.VersionUndefined),
+		DelegatedPrefixSpec{},
+	)
+}
+
+// DelegatedPrefixExtension provides auxiliary methods for DelegatedPrefix.
+type DelegatedPrefixExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (DelegatedPrefixExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DelegatedPrefixType,
+		Aliases:          []resource.Type{"pd"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Link",
+				JSONPath: `{.linkName}`,
+			},
+			{
+				Name:     "Prefix",
+				JSONPath: `{.prefix}`,
+			},
+			{
+				Name:     "Valid Lifetime",
+				JSONPath: `{.validLifetime}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[DelegatedPrefixSpec](DelegatedPrefixType, &DelegatedPrefix{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,152 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// Dot1XConfigType is the type of the Dot1XConfig resource.
+const Dot1XConfigType = resource.Type("Dot1XConfigs.net.talos.dev")
+
+// Dot1XStatusType is the type of the Dot1XStatus resource.
+const Dot1XStatusType = resource.Type("Dot1XStatuses.net.talos.dev")
+
+// 802.1X authentication methods.
+const (
+	Dot1XMethodTLS  = "tls"
+	Dot1XMethodPEAP = "peap"
+)
+
+// Dot1XConfig contains the IEEE 802.1X port authentication configuration of a link.
+type Dot1XConfig = typed.Resource[Dot1XConfigSpec, Dot1XConfigExtension]
+
+// Dot1XConfigSpec describes the IEEE 802.1X port authentication configuration of a link.
+//
+//gotagsrewrite:gen
+type Dot1XConfigSpec struct {
+	// LinkName might be a link alias.
+	LinkName          string `yaml:"linkName" protobuf:"1"`
+	Method            string `yaml:"method" protobuf:"2"`
+	Identity          string `yaml:"identity" protobuf:"3"`
+	AnonymousIdentity string `yaml:"anonymousIdentity,omitempty" protobuf:"4"`
+	CA                string `yaml:"ca" protobuf:"5"`
+	ServerName        string `yaml:"serverName,omitempty" protobuf:"6"`
+
+	// EAP-TLS: either the client certificate and key, or the issuer to generate them.
+	ClientCertificate       string `yaml:"clientCertificate,omitempty" protobuf:"7"`
+	ClientKey               string `yaml:"clientKey,omitempty" protobuf:"8"`
+	ClientIssuerCertificate string `yaml:"clientIssuerCertificate,omitempty" protobuf:"9"`
+	ClientIssuerKey         string `yaml:"clientIssuerKey,omitempty" protobuf:"10"`
+
+	// PEAP.
+	Password string `yaml:"password,omitempty" protobuf:"11"`
+}
+
+// NewDot1XConfig initializes a Dot1XConfig resource.
+func NewDot1XConfig(namespace resource.Namespace, id resource.ID) *Dot1XConfig {
+	return typed.NewResource[Dot1XConfigSpec, Dot1XConfigExtension](
+		resource.NewMetadata(namespace, Dot1XConfigType, id, resource.VersionUndefined),
+		Dot1XConfigSpec{},
+	)
+}
+
+// Dot1XConfigExtension provides auxiliary methods for Dot1XConfig.
+type Dot1XConfigExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (Dot1XConfigExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             Dot1XConfigType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Method",
+				JSONPath: `{.method}`,
+			},
+			{
+				Name:     "Identity",
+				JSONPath: `{.identity}`,
+			},
+		},
+		Sensitivity: meta.Sensitive,
+	}
+}
+
+// Dot1XStatus contains the state of the IEEE 802.1X supplicant on a link.
+type Dot1XStatus = typed.Resource[Dot1XStatusSpec, Dot1XStatusExtension]
+
+// Dot1XStatusSpec describes the state of the IEEE 802.1X supplicant on a link.
+//
+//gotagsrewrite:gen
+type Dot1XStatusSpec struct {
+	LinkName          string    `yaml:"linkName" protobuf:"1"`
+	Method            string    `yaml:"method" protobuf:"2"`
+	Identity          string    `yaml:"identity" protobuf:"3"`
+	State             string    `yaml:"state" protobuf:"4"`
+	Authorized        bool      `yaml:"authorized" protobuf:"5"`
+	LastAuthenticated time.Time `yaml:"lastAuthenticated,omitempty" protobuf:"6"`
+	Authentications   uint32    `yaml:"authentications" protobuf:"7"`
+	Failures          uint32    `yaml:"failures" protobuf:"8"`
+	LastError         string    `yaml:"lastError,omitempty" protobuf:"9"`
+}
+
+// NewDot1XStatus initializes a Dot1XStatus resource.
+func NewDot1XStatus(namespace resource.Namespace, id resource.ID) *Dot1XStatus {
+	return typed.NewResource[Dot1XStatusSpec, Dot1XStatusExtension](
+		resource.NewMetadata(namespace, Dot1XStatusType, id, resource.VersionUndefined),
+		Dot1XStatusSpec{},
+	)
+}
+
+// Dot1XStatusExtension provides auxiliary methods for Dot1XStatus.
+type Dot1XStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (Dot1XStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             Dot1XStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Method",
+				JSONPath: `{.method}`,
+			},
+			{
+				Name:     "State",
+				JSONPath: `{.state}`,
+			},
+			{
+				Name:     "Authorized",
+				JSONPath: `{.authorized}`,
+			},
+			{
+				Name:     "Last Authenticated",
+				JSONPath: `{.lastAuthenticated}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	if err := protobuf.RegisterDynamic[Dot1XConfigSpec](Dot1XConfigType, &Dot1XConfig{}); err != nil {
+		panic(err)
+	}
+
+	if err := protobuf.RegisterDynamic[Dot1XStatusSpec](Dot1XStatusType, &Dot1XStatus{}); err != nil {
+		panic(err)
+	}
+}
@@ -34,6 +34,9 @@ func TestRegisterResource(t *testing.T) {
 		&network.AddressStatus{},
 		&network.AddressSpec{},
 		&network.BGPInstanceConfig{},
+		&network.DelegatedPrefix{},
+		&network.Dot1XConfig{},
+		&network.Dot1XStatus{},
 		&network.HardwareAddr{},
 		&network.DNSUpstream{},
 		&network.EthernetSpec{},
@@ -60,6 +63,7 @@ func TestRegisterResource(t *testing.T) {
 		&network.Status{},
 		&network.TimeServerStatus{},
 		&network.TimeServerSpec{},
+		&network.VIPStatus{},
 	} {
 		assert.NoError(t, resourceRegistry.Register(ctx, resource))
 	}
@@ -6,6 +6,8 @@ package network
 
 import (
 	"net/netip"
+	"reflect"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
@@ -43,7 +45,7 @@ func (spec OperatorSpecSpec) Equal(other OperatorSpecSpec) bool {
 	// config layer is not important for equality check
 	spec.ConfigLayer = other.ConfigLayer
 
-	return spec == other
+	return reflect.DeepEqual(spec, other)
 }
 
 // ClientIdentifierSpec is a shared DHCP4/DHCP6 client identifier spec.
@@ -71,6 +73,28 @@ type DHCP6OperatorSpec struct {
 	RouteMetric         uint32               `yaml:"routeMetric" protobuf:"2"`
 	SkipHostnameRequest bool                 `yaml:"skipHostnameRequest,omitempty" protobuf:"3"`
 	ClientIdentifier    ClientIdentifierSpec `yaml:"clientIdentifier,omitempty" protobuf:"4"`
+
+	PrefixDelegation DHCP6PrefixDelegationSpec `yaml:"prefixDelegation,omitempty" protobuf:"5"`
+}
+
+// DHCP6PrefixDelegationSpec describes DHCP6 prefix delegation (IA_PD) options.
+//
+//gotagsrewrite:gen
+type DHCP6PrefixDelegationSpec struct {
+	Enabled bool `yaml:"enabled" protobuf:"1"`
+	// PrefixLength is the prefix length hint sent to the server, zero means no hint.
+	PrefixLength uint8                                 `yaml:"prefixLength,omitempty" protobuf:"2"`
+	Downstream   []DHCP6PrefixDelegationDownstreamSpec `yaml:"downstream,omitempty" protobuf:"3"`
+}
+
+// DHCP6PrefixDelegationDownstreamSpec describes a subnet of the delegated prefix assigned to a downstream link.
+//
+//gotagsrewrite:gen
+type DHCP6PrefixDelegationDownstreamSpec struct {
+	LinkName             string `yaml:"linkName" protobuf:"1"`
+	SubnetID             uint32 `yaml:"subnetID" protobuf:"2"`
+	SubnetLength         uint8  `yaml:"subnetLength" protobuf:"3"`
+	RouterAdvertisements bool   `yaml:"routerAdvertisements,omitempty" protobuf:"4"`
 }
 
 // VIPOperatorSpec describes virtual IP operator options.
@@ -82,6 +106,7 @@ type VIPOperatorSpec struct {
 
 	EquinixMetal VIPEquinixMetalSpec `yaml:"equinixMetal,omitempty" protobuf:"3"`
 	HCloud       VIPHCloudSpec       `yaml:"hcloud,omitempty" protobuf:"4"`
+	VRRP         VIPVRRPSpec         `yaml:"vrrp,omitempty" protobuf:"5"`
 }
 
 // VIPEquinixMetalSpec describes virtual (elastic) IP settings for Equinix Metal.
@@ -102,6 +127,18 @@ type VIPHCloudSpec struct {
 	APIToken  string `yaml:"apiToken" protobuf:"3" redact:"replace"`
 }
 
+// VIPVRRPSpec describes VRRP election settings of the virtual IP.
+//
+// If the spec is empty, the virtual IP is elected using etcd.
+//
+//gotagsrewrite:gen
+type VIPVRRPSpec struct {
+	VirtualRouterID       uint8         `yaml:"virtualRouterID" protobuf:"1"`
+	Priority              uint8         `yaml:"priority" protobuf:"2"`
+	Preempt               bool          `yaml:"preempt" protobuf:"3"`
+	AdvertisementInterval time.Duration `yaml:"advertisementInterval" protobuf:"4"`
+}
+
 // NewOperatorSpec initializes a OperatorSpec resource.
 func NewOperatorSpec(namespace resource.Namespace, id resource.ID) *OperatorSpec {
 	return typed.NewResource[OperatorSpecSpec, OperatorSpecExtension](
@@ -0,0 +1,91 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// VIPStatusType is type of VIPStatus resource.
+const VIPStatusType = resource.Type("VIPStatuses.net.talos.dev")
+
+// VIPStatus resource holds the observed state of a virtual IP election.
+type VIPStatus = typed.Resource[VIPStatusSpec, VIPStatusExtension]
+
+// VIP election methods.
+const (
+	VIPElectionEtcd = "etcd"
+	VIPElectionVRRP = "vrrp"
+)
+
+// VIPStatusSpec describes the status of a virtual IP election.
+//
+//gotagsrewrite:gen
+type VIPStatusSpec struct {
+	// IP is the virtual IP.
+	IP netip.Addr `yaml:"ip" protobuf:"1"`
+	// LinkName is the link the virtual IP is assigned to.
+	LinkName string `yaml:"linkName" protobuf:"2"`
+	// Election is the method used to elect the owner of the virtual IP.
+	Election string `yaml:"election" protobuf:"3"`
+	// Leader is true if the virtual IP is currently assigned to this node.
+	Leader bool `yaml:"leader" protobuf:"4"`
+	// Owner is the current owner of the virtual IP (hostname for etcd election, address of the VRRP master).
+	Owner string `yaml:"owner,omitempty" protobuf:"5"`
+}
+
+// NewVIPStatus initializes a VIPStatus resource.
+func NewVIPStatus(namespace resource.Namespace, id resource.ID) *VIPStatus {
+	return typed.NewResource[VIPStatusSpec, VIPStatusExtension](
+		resource.NewMetadata(namespace, VIPStatusType, id, resource.VersionUndefined),
+		VIPStatusSpec{},
+	)
+}
+
+// VIPStatusExtension provides auxiliary methods for VIPStatus.
+type VIPStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (VIPStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             VIPStatusType,
+		Aliases:          []resource.Type{"vip", "vips"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Link",
+				JSONPath: `{.linkName}`,
+			},
+			{
+				Name:     "Election",
+				JSONPath: `{.election}`,
+			},
+			{
+				Name:     "Leader",
+				JSONPath: `{.leader}`,
+			},
+			{
+				Name:     "Owner",
+				JSONPath: `{.owner}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[VIPStatusSpec](VIPStatusType, &VIPStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
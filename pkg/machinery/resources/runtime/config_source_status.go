@@ -0,0 +1,82 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// ConfigSourceStatusType is the type of ConfigSourceStatus resource.
+const ConfigSourceStatusType = resource.Type("ConfigSourceStatuses.runtime.talos.dev")
+
+// ConfigSourceStatusID is the singleton ID of the ConfigSourceStatus resource.
+const ConfigSourceStatusID = resource.ID("config-source")
+
+// ConfigSourceStatus is the sync status of the machine configuration pulled from the remote source.
+type ConfigSourceStatus = typed.Resource[ConfigSourceStatusSpec, ConfigSourceStatusExtension]
+
+// ConfigSourceStatusSpec describes the sync status of the remote configuration source.
+//
+//gotagsrewrite:gen
+type ConfigSourceStatusSpec struct {
+	URL  string `yaml:"url" protobuf:"1"`
+	Mode string `yaml:"mode" protobuf:"2"`
+	// Synced is true if the last pull succeeded and the pulled configuration is applied.
+	Synced bool `yaml:"synced" protobuf:"3"`
+	// Revision is the SHA-256 digest of the last applied configuration.
+	Revision        string    `yaml:"revision,omitempty" protobuf:"4"`
+	LastAppliedTime time.Time `yaml:"lastAppliedTime,omitempty" protobuf:"5"`
+	LastSyncTime    time.Time `yaml:"lastSyncTime,omitempty" protobuf:"6"`
+	Error           string    `yaml:"error,omitempty" protobuf:"7"`
+}
+
+// ConfigSourceStatusExtension provides auxiliary methods for ConfigSourceStatus resource.
+type ConfigSourceStatusExtension struct{}
+
+// NewConfigSourceStatus initializes a new ConfigSourceStatus resource.
+func NewConfigSourceStatus() *ConfigSourceStatus {
+	return typed.NewResource[ConfigSourceStatusSpec, ConfigSourceStatusExtension](
+		resource.NewMetadata(NamespaceName, ConfigSourceStatusType, ConfigSourceStatusID, resource.VersionUndefined),
+		ConfigSourceStatusSpec{},
+	)
+}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (ConfigSourceStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ConfigSourceStatusType,
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "URL",
+				JSONPath: `{.url}`,
+			},
+			{
+				Name:     "Synced",
+				JSONPath: `{.synced}`,
+			},
+			{
+				Name:     "Revision",
+				JSONPath: `{.revision}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[ConfigSourceStatusSpec](ConfigSourceStatusType, &ConfigSourceStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type APIServiceConfigSpec -type BootIDSpec -type BootedEntrySpec -type DevicesStatusSpec -type DiagnosticSpec -type EnvironmentSpec -type EventSinkConfigSpec -type ExtensionServiceConfigSpec -type ExtensionServiceConfigStatusSpec -type ImageFactorySchematicSpec -type KernelCmdlineSpec -type KernelModuleStatusSpec -type KernelModuleSpecSpec -type KernelParamSpecSpec -type KernelParamStatusSpec -type KmsgLogConfigSpec -type LoadedKernelModuleSpec -type MaintenanceServiceConfigSpec -type MaintenanceServiceRequestSpec -type MachineResetSignalSpec -type MachineStatusSpec -type MetaKeySpec -type MountStatusSpec -type OOMActionSpec -type PlatformMetadataSpec -type RebootRequestSpec -type SecurityStateSpec -type MetaLoadedSpec -type SBOMItemSpec -type ServicePIDSpec -type UnattendedInstallStatusSpec -type UniqueMachineTokenSpec -type VersionSpec -type WatchdogTimerConfigSpec -type WatchdogTimerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type APIServiceConfigSpec -type BootIDSpec -type BootedEntrySpec -type ConfigSourceStatusSpec -type DevicesStatusSpec -type DiagnosticSpec -type DisruptionLockRequestSpec -type DisruptionLockStatusSpec -type DisruptionRequestSpec -type DisruptionStatusSpec -type EnvironmentSpec -type EventSinkConfigSpec -type ExtensionServiceConfigSpec -type ExtensionServiceConfigStatusSpec -type ImageFactorySchematicSpec -type KernelCmdlineSpec -type KernelModuleStatusSpec -type KernelModuleSpecSpec -type KernelParamSpecSpec -type KernelParamStatusSpec -type KmsgLogConfigSpec -type LoadedKernelModuleSpec -type MaintenanceServiceConfigSpec -type MaintenanceServiceRequestSpec -type MachineResetSignalSpec -type MachineStatusSpec -type MetaKeySpec -type MountStatusSpec -type OOMActionSpec -type PlatformMetadataSpec -type RebootRequestSpec -type SecurityStateSpec -type MetaLoadedSpec -type SBOMItemSpec -type ServicePIDSpec -type UnattendedInstallStatusSpec -type UniqueMachineTokenSpec -type VersionSpec -type WatchdogTimerConfigSpec -type WatchdogTimerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package runtime
 
@@ -29,6 +29,12 @@ func (o BootedEntrySpec) DeepCopy() BootedEntrySpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of ConfigSourceStatusSpec.
+func (o ConfigSourceStatusSpec) DeepCopy() ConfigSourceStatusSpec {
+	var cp ConfigSourceStatusSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of DevicesStatusSpec.
 func (o DevicesStatusSpec) DeepCopy() DevicesStatusSpec {
 	var cp DevicesStatusSpec = o
@@ -45,6 +51,52 @@ func (o DiagnosticSpec) DeepCopy() DiagnosticSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of DisruptionLockRequestSpec.
+func (o DisruptionLockRequestSpec) DeepCopy() DisruptionLockRequestSpec {
+	var cp DisruptionLockRequestSpec = o
+	if o.Labels != nil {
+		cp.Labels = make(map[string]string, len(o.Labels))
+		for k2, v2 := range o.Labels {
+			cp.Labels[k2] = v2
+		}
+	}
+	if o.LabelLimits != nil {
+		cp.LabelLimits = make([]DisruptionLabelLimit, len(o.LabelLimits))
+		copy(cp.LabelLimits, o.LabelLimits)
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of DisruptionLockStatusSpec.
+func (o DisruptionLockStatusSpec) DeepCopy() DisruptionLockStatusSpec {
+	var cp DisruptionLockStatusSpec = o
+	if o.Holders != nil {
+		cp.Holders = make([]string, len(o.Holders))
+		copy(cp.Holders, o.Holders)
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of DisruptionRequestSpec.
+func (o DisruptionRequestSpec) DeepCopy() DisruptionRequestSpec {
+	var cp DisruptionRequestSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of DisruptionStatusSpec.
+func (o DisruptionStatusSpec) DeepCopy() DisruptionStatusSpec {
+	var cp DisruptionStatusSpec = o
+	if o.Operations != nil {
+		cp.Operations = make([]string, len(o.Operations))
+		copy(cp.Operations, o.Operations)
+	}
+	if o.Holders != nil {
+		cp.Holders = make([]string, len(o.Holders))
+		copy(cp.Holders, o.Holders)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of EnvironmentSpec.
 func (o EnvironmentSpec) DeepCopy() EnvironmentSpec {
 	var cp EnvironmentSpec = o
@@ -55,6 +107,16 @@ func (o EnvironmentSpec) DeepCopy() EnvironmentSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of EraseReportSpec.
+func (o EraseReportSpec) DeepCopy() EraseReportSpec {
+	var cp EraseReportSpec = o
+	if o.Signature != nil {
+		cp.Signature = make([]byte, len(o.Signature))
+		copy(cp.Signature, o.Signature)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of EventSinkConfigSpec.
 func (o EventSinkConfigSpec) DeepCopy() EventSinkConfigSpec {
 	var cp EventSinkConfigSpec = o
@@ -72,6 +134,14 @@ func (o ExtensionServiceConfigSpec) DeepCopy() ExtensionServiceConfigSpec {
 		cp.Environment = make([]string, len(o.Environment))
 		copy(cp.Environment, o.Environment)
 	}
+	if o.Resources.OOMScoreAdj != nil {
+		cp.Resources.OOMScoreAdj = new(int32)
+		*cp.Resources.OOMScoreAdj = *o.Resources.OOMScoreAdj
+	}
+	if o.Security.DropCapabilities != nil {
+		cp.Security.DropCapabilities = make([]string, len(o.Security.DropCapabilities))
+		copy(cp.Security.DropCapabilities, o.Security.DropCapabilities)
+	}
 	return cp
 }
 
@@ -0,0 +1,99 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// DisruptionLockRequestType is the type of DisruptionLockRequest resource.
+const DisruptionLockRequestType = resource.Type("DisruptionLockRequests.runtime.talos.dev")
+
+// DisruptionLockRequest resource is a request for the cluster-wide disruption lock slot made by a remote node.
+//
+// The requests are recorded by trustd on the control plane nodes (with the node name as the ID),
+// and processed against etcd by the control plane.
+type DisruptionLockRequest = typed.Resource[DisruptionLockRequestSpec, DisruptionLockRequestExtension]
+
+// DisruptionLockRequestSpec describes the spec of DisruptionLockRequest.
+//
+//gotagsrewrite:gen
+type DisruptionLockRequestSpec struct {
+	Node         string            `yaml:"node" protobuf:"1"`
+	ControlPlane bool              `yaml:"controlPlane,omitempty" protobuf:"2"`
+	Labels       map[string]string `yaml:"labels,omitempty" protobuf:"3"`
+	Operation    string            `yaml:"operation,omitempty" protobuf:"4"`
+	// RequestedAt identifies the request, it is kept by the node while the request is refreshed.
+	RequestedAt time.Time `yaml:"requestedAt" protobuf:"5"`
+	// RefreshedAt is the time of the last refresh of the request, stale requests are ignored.
+	RefreshedAt time.Time `yaml:"refreshedAt" protobuf:"6"`
+	// Release is set when the node releases the lock slot.
+	Release bool `yaml:"release,omitempty" protobuf:"7"`
+
+	MaxNodes             int                    `yaml:"maxNodes" protobuf:"8"`
+	MaxControlPlaneNodes int                    `yaml:"maxControlPlaneNodes" protobuf:"9"`
+	LabelLimits          []DisruptionLabelLimit `yaml:"labelLimits,omitempty" protobuf:"10"`
+	LockTTL              time.Duration          `yaml:"lockTTL" protobuf:"11"`
+	// Address is the address of the node which made the request, the node name is bound to it while the request exists.
+	Address string `yaml:"address,omitempty" protobuf:"12"`
+}
+
+// DisruptionLabelLimit describes the limit on the number of nodes with the same label value disrupted at the same time.
+//
+//gotagsrewrite:gen
+type DisruptionLabelLimit struct {
+	Key      string `yaml:"key" protobuf:"1"`
+	MaxNodes int    `yaml:"maxNodes" protobuf:"2"`
+}
+
+// NewDisruptionLockRequest initializes a DisruptionLockRequest resource.
+func NewDisruptionLockRequest(node string) *DisruptionLockRequest {
+	return typed.NewResource[DisruptionLockRequestSpec, DisruptionLockRequestExtension](
+		resource.NewMetadata(NamespaceName, DisruptionLockRequestType, node, resource.VersionUndefined),
+		DisruptionLockRequestSpec{},
+	)
+}
+
+// DisruptionLockRequestExtension is auxiliary resource data for DisruptionLockRequest.
+type DisruptionLockRequestExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (DisruptionLockRequestExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DisruptionLockRequestType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Operation",
+				JSONPath: `{.operation}`,
+			},
+			{
+				Name:     "Refreshed",
+				JSONPath: `{.refreshedAt}`,
+			},
+			{
+				Name:     "Release",
+				JSONPath: `{.release}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[DisruptionLockRequestSpec](DisruptionLockRequestType, &DisruptionLockRequest{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,72 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// DisruptionLockStatusType is the type of DisruptionLockStatus resource.
+const DisruptionLockStatusType = resource.Type("DisruptionLockStatuses.runtime.talos.dev")
+
+// DisruptionLockStatus is the result of processing the DisruptionLockRequest with the same ID.
+type DisruptionLockStatus = typed.Resource[DisruptionLockStatusSpec, DisruptionLockStatusExtension]
+
+// DisruptionLockStatusSpec describes the result of processing the DisruptionLockRequest.
+//
+//gotagsrewrite:gen
+type DisruptionLockStatusSpec struct {
+	// RequestedAt matches the processed DisruptionLockRequest.
+	RequestedAt   time.Time `yaml:"requestedAt" protobuf:"1"`
+	Granted       bool      `yaml:"granted" protobuf:"2"`
+	QueuePosition int       `yaml:"queuePosition,omitempty" protobuf:"3"`
+	Holders       []string  `yaml:"holders,omitempty" protobuf:"4"`
+	Error         string    `yaml:"error,omitempty" protobuf:"5"`
+}
+
+// DisruptionLockStatusExtension provides auxiliary methods for DisruptionLockStatus resource.
+type DisruptionLockStatusExtension struct{}
+
+// NewDisruptionLockStatus initializes a new DisruptionLockStatus resource.
+func NewDisruptionLockStatus(node string) *DisruptionLockStatus {
+	return typed.NewResource[DisruptionLockStatusSpec, DisruptionLockStatusExtension](
+		resource.NewMetadata(NamespaceName, DisruptionLockStatusType, node, resource.VersionUndefined),
+		DisruptionLockStatusSpec{},
+	)
+}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (DisruptionLockStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DisruptionLockStatusType,
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Granted",
+				JSONPath: `{.granted}`,
+			},
+			{
+				Name:     "Position",
+				JSONPath: `{.queuePosition}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[DisruptionLockStatusSpec](DisruptionLockStatusType, &DisruptionLockStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,75 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// DisruptionRequestType is the type of DisruptionRequest resource.
+const DisruptionRequestType = resource.Type("DisruptionRequests.runtime.talos.dev")
+
+// Disruptive operations, used as DisruptionRequest IDs.
+const (
+	DisruptionOperationReboot  = "reboot"
+	DisruptionOperationUpgrade = "upgrade"
+)
+
+// DisruptionRequest resource is a disruptive operation waiting for the maintenance policy to allow it.
+//
+// The operation proceeds once DisruptionStatus reports the lock slot as granted.
+type DisruptionRequest = typed.Resource[DisruptionRequestSpec, DisruptionRequestExtension]
+
+// DisruptionRequestSpec describes the spec of DisruptionRequest.
+//
+//gotagsrewrite:gen
+type DisruptionRequestSpec struct {
+	Operation   string    `yaml:"operation" protobuf:"1"`
+	RequestedAt time.Time `yaml:"requestedAt" protobuf:"2"`
+}
+
+// NewDisruptionRequest initializes a DisruptionRequest resource.
+func NewDisruptionRequest(operation string) *DisruptionRequest {
+	return typed.NewResource[DisruptionRequestSpec, DisruptionRequestExtension](
+		resource.NewMetadata(NamespaceName, DisruptionRequestType, operation, resource.VersionUndefined),
+		DisruptionRequestSpec{
+			Operation: operation,
+		},
+	)
+}
+
+// DisruptionRequestExtension is auxiliary resource data for DisruptionRequest.
+type DisruptionRequestExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (DisruptionRequestExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DisruptionRequestType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Requested",
+				JSONPath: `{.requestedAt}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[DisruptionRequestSpec](DisruptionRequestType, &DisruptionRequest{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,97 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// DisruptionStatusType is the type of DisruptionStatus resource.
+const DisruptionStatusType = resource.Type("DisruptionStatuses.runtime.talos.dev")
+
+// DisruptionStatusID is the singleton ID of the DisruptionStatus resource.
+const DisruptionStatusID = resource.ID("disruption")
+
+// DisruptionStatus states.
+const (
+	// DisruptionStateIdle means there are no disruptive operations waiting.
+	DisruptionStateIdle = "idle"
+	// DisruptionStateWaitingWindow means the operations wait for the maintenance window to open.
+	DisruptionStateWaitingWindow = "waiting-window"
+	// DisruptionStateWaitingLock means the operations wait in the cluster-wide queue for a lock slot.
+	DisruptionStateWaitingLock = "waiting-lock"
+	// DisruptionStateGranted means the lock slot is held, and the operations might proceed.
+	DisruptionStateGranted = "granted"
+)
+
+// DisruptionStatus is the state of the disruptive operations of the node in the cluster-wide queue.
+type DisruptionStatus = typed.Resource[DisruptionStatusSpec, DisruptionStatusExtension]
+
+// DisruptionStatusSpec describes the state of the disruptive operations of the node.
+//
+//gotagsrewrite:gen
+type DisruptionStatusSpec struct {
+	State      string   `yaml:"state" protobuf:"1"`
+	Operations []string `yaml:"operations,omitempty" protobuf:"2"`
+	// QueuePosition is the 1-based position of the node in the cluster-wide queue.
+	QueuePosition int       `yaml:"queuePosition,omitempty" protobuf:"3"`
+	NextWindow    time.Time `yaml:"nextWindow,omitempty" protobuf:"4"`
+	// Holders are the nodes holding the lock slots.
+	Holders []string `yaml:"holders,omitempty" protobuf:"5"`
+	Error   string   `yaml:"error,omitempty" protobuf:"6"`
+}
+
+// DisruptionStatusExtension provides auxiliary methods for DisruptionStatus resource.
+type DisruptionStatusExtension struct{}
+
+// NewDisruptionStatus initializes a new DisruptionStatus resource.
+func NewDisruptionStatus() *DisruptionStatus {
+	return typed.NewResource[DisruptionStatusSpec, DisruptionStatusExtension](
+		resource.NewMetadata(NamespaceName, DisruptionStatusType, DisruptionStatusID, resource.VersionUndefined),
+		DisruptionStatusSpec{},
+	)
+}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (DisruptionStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DisruptionStatusType,
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "State",
+				JSONPath: `{.state}`,
+			},
+			{
+				Name:     "Operations",
+				JSONPath: `{.operations}`,
+			},
+			{
+				Name:     "Position",
+				JSONPath: `{.queuePosition}`,
+			},
+			{
+				Name:     "Holders",
+				JSONPath: `{.holders}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[DisruptionStatusSpec](DisruptionStatusType, &DisruptionStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,66 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+const (
+	// EraseReportType is type of [EraseReport] resource.
+	EraseReportType = resource.Type("EraseReports.runtime.talos.dev")
+
+	// EraseReportID is the ID of [EraseReport] resource.
+	EraseReportID = resource.ID("erase-report")
+)
+
+// EraseReport resource is published on reset after the disks are wiped, if the erase report was requested.
+type EraseReport = typed.Resource[EraseReportSpec, EraseReportExtension]
+
+// EraseReportSpec is the signed report of the disk erase performed on reset.
+//
+//gotagsrewrite:gen
+type EraseReportSpec struct {
+	// Report is the JSON-encoded erase report.
+	Report string `yaml:"report" protobuf:"1"`
+	// Signature of the report.
+	Signature []byte `yaml:"signature" protobuf:"2"`
+	// Certificate (PEM-encoded) of the key which signed the report.
+	Certificate string `yaml:"certificate" protobuf:"3"`
+}
+
+// NewEraseReport initializes a [EraseReport] resource.
+func NewEraseReport() *EraseReport {
+	return typed.NewResource[EraseReportSpec, EraseReportExtension](
+		resource.NewMetadata(NamespaceName, EraseReportType, EraseReportID, resource.VersionUndefined),
+		EraseReportSpec{},
+	)
+}
+
+// EraseReportExtension is auxiliary resource data for [EraseReport].
+type EraseReportExtension struct{}
+
+// ResourceDefinition implements [meta.ResourceDefinitionProvider] interface.
+func (EraseReportExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             EraseReportType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[EraseReportSpec](EraseReportType, &EraseReport{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -23,8 +23,33 @@ type ExtensionServiceConfig = typed.Resource[ExtensionServiceConfigSpec, Extensi
 //
 //gotagsrewrite:gen
 type ExtensionServiceConfigSpec struct {
-	Files       []ExtensionServiceConfigFile `yaml:"files,omitempty" protobuf:"1"`
-	Environment []string                     `yaml:"environment,omitempty" protobuf:"2"`
+	Files       []ExtensionServiceConfigFile  `yaml:"files,omitempty" protobuf:"1"`
+	Environment []string                      `yaml:"environment,omitempty" protobuf:"2"`
+	Resources   ExtensionServiceResourcesSpec `yaml:"resources,omitempty" protobuf:"3"`
+	Security    ExtensionServiceSecuritySpec  `yaml:"security,omitempty" protobuf:"4"`
+}
+
+// ExtensionServiceResourcesSpec describes resource limits overrides for the extension service.
+//
+// Zero values keep the limits defined by the extension.
+//
+//gotagsrewrite:gen
+type ExtensionServiceResourcesSpec struct {
+	CPUWeight        uint64 `yaml:"cpuWeight,omitempty" protobuf:"1"`
+	CPUMaxMillicores uint64 `yaml:"cpuMaxMillicores,omitempty" protobuf:"2"`
+	MemoryMax        uint64 `yaml:"memoryMax,omitempty" protobuf:"3"`
+	MemoryHigh       uint64 `yaml:"memoryHigh,omitempty" protobuf:"4"`
+	IOWeight         uint64 `yaml:"ioWeight,omitempty" protobuf:"5"`
+	OOMScoreAdj      *int32 `yaml:"oomScoreAdj,omitempty" protobuf:"6"`
+}
+
+// ExtensionServiceSecuritySpec describes security overrides for the extension service.
+//
+//gotagsrewrite:gen
+type ExtensionServiceSecuritySpec struct {
+	DropCapabilities []string `yaml:"dropCapabilities,omitempty" protobuf:"1"`
+	SeccompProfile   string   `yaml:"seccompProfile,omitempty" protobuf:"2"`
+	ReadonlyRootfs   bool     `yaml:"readonlyRootfs,omitempty" protobuf:"3"`
 }
 
 // ExtensionServiceConfigFile describes extensions service config files.
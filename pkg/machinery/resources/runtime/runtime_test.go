@@ -27,8 +27,14 @@ func TestRegisterResource(t *testing.T) {
 		&runtime.APIServiceConfig{},
 		&runtime.BootID{},
 		&runtime.BootedEntry{},
+		&runtime.ConfigSourceStatus{},
 		&runtime.DevicesStatus{},
 		&runtime.Diagnostic{},
+		&runtime.DisruptionLockRequest{},
+		&runtime.DisruptionLockStatus{},
+		&runtime.DisruptionRequest{},
+		&runtime.DisruptionStatus{},
+		&runtime.EraseReport{},
 		&runtime.EventSinkConfig{},
 		&runtime.ExtensionStatus{},
 		&runtime.KernelCmdline{},
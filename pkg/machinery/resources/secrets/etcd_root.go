@@ -5,11 +5,14 @@
 package secrets
 
 import (
+	"bytes"
+
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
 	"github.com/cosi-project/runtime/pkg/resource/protobuf"
 	"github.com/cosi-project/runtime/pkg/resource/typed"
 	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/gen/xslices"
 
 	"github.com/siderolabs/talos/pkg/machinery/proto"
 )
@@ -27,7 +30,17 @@ type EtcdRoot = typed.Resource[EtcdRootSpec, EtcdRootExtension]
 //
 //gotagsrewrite:gen
 type EtcdRootSpec struct {
-	EtcdCA *x509.PEMEncodedCertificateAndKey `yaml:"etcdCA" protobuf:"1"`
+	EtcdCA      *x509.PEMEncodedCertificateAndKey `yaml:"etcdCA" protobuf:"1"`
+	AcceptedCAs []*x509.PEMEncodedCertificate     `yaml:"acceptedCAs" protobuf:"2"`
+}
+
+// AcceptedCABundle returns the PEM encoded bundle of CA certificates trusted by etcd.
+func (spec *EtcdRootSpec) AcceptedCABundle() []byte {
+	if len(spec.AcceptedCAs) == 0 {
+		return spec.EtcdCA.Crt
+	}
+
+	return bytes.Join(xslices.Map(spec.AcceptedCAs, func(ca *x509.PEMEncodedCertificate) []byte { return ca.Crt }), nil)
 }
 
 // NewEtcdRoot initializes a EtcdRoot resource.
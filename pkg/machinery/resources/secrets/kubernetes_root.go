@@ -65,6 +65,8 @@ type KubernetesRootSpec struct {
 	SecretboxEncryptionSecret string `yaml:"secretboxEncryptionSecret" protobuf:"13"`
 
 	EtcdEncryptionConfig map[string]any `yaml:"etcdEncryptionConfig" protobuf:"16"`
+	// KMSPluginEnabled enables the Talos-managed KMS plugin as the encryption provider for secrets.
+	KMSPluginEnabled bool `yaml:"kmsPluginEnabled" protobuf:"22"`
 }
 
 // NewKubernetesRoot initializes a KubernetesRoot resource.
@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type ImageVerificationRuleSpec -type TUFTrustedRootSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type ImageVerificationRuleSpec -type NodeAttestationSpec -type NodeAttestationPolicySpec -type TUFTrustedRootSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package security
 
@@ -20,6 +20,50 @@ func (o ImageVerificationRuleSpec) DeepCopy() ImageVerificationRuleSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of NodeAttestationSpec.
+func (o NodeAttestationSpec) DeepCopy() NodeAttestationSpec {
+	var cp NodeAttestationSpec = o
+	if o.PCRValues != nil {
+		cp.PCRValues = make([]NodeAttestationPCRValueSpec, len(o.PCRValues))
+		copy(cp.PCRValues, o.PCRValues)
+		for i2 := range o.PCRValues {
+			if o.PCRValues[i2].Values != nil {
+				cp.PCRValues[i2].Values = make([]string, len(o.PCRValues[i2].Values))
+				copy(cp.PCRValues[i2].Values, o.PCRValues[i2].Values)
+			}
+		}
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of NodeAttestationPolicySpec.
+func (o NodeAttestationPolicySpec) DeepCopy() NodeAttestationPolicySpec {
+	var cp NodeAttestationPolicySpec = o
+	if o.PCRs != nil {
+		cp.PCRs = make([]int, len(o.PCRs))
+		copy(cp.PCRs, o.PCRs)
+	}
+	if o.AllowedPCRValues != nil {
+		cp.AllowedPCRValues = make([]NodeAttestationPCRValueSpec, len(o.AllowedPCRValues))
+		copy(cp.AllowedPCRValues, o.AllowedPCRValues)
+		for i2 := range o.AllowedPCRValues {
+			if o.AllowedPCRValues[i2].Values != nil {
+				cp.AllowedPCRValues[i2].Values = make([]string, len(o.AllowedPCRValues[i2].Values))
+				copy(cp.AllowedPCRValues[i2].Values, o.AllowedPCRValues[i2].Values)
+			}
+		}
+	}
+	if o.PCRSigningKeys != nil {
+		cp.PCRSigningKeys = make([]string, len(o.PCRSigningKeys))
+		copy(cp.PCRSigningKeys, o.PCRSigningKeys)
+	}
+	if o.EndorsementCAs != nil {
+		cp.EndorsementCAs = make([]string, len(o.EndorsementCAs))
+		copy(cp.EndorsementCAs, o.EndorsementCAs)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of TUFTrustedRootSpec.
 func (o TUFTrustedRootSpec) DeepCopy() TUFTrustedRootSpec {
 	var cp TUFTrustedRootSpec = o
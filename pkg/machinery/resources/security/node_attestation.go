@@ -0,0 +1,100 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint:revive
+package security
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// NodeAttestationType is type of NodeAttestation resource.
+const NodeAttestationType = resource.Type("NodeAttestations.security.talos.dev")
+
+// NodeAttestation represents NodeAttestation typed resource.
+//
+// NodeAttestation is created by trustd on control plane nodes for each node requesting
+// a certificate, resource ID is the address the request came from, as the certificate common name
+// is controlled by the node.
+type NodeAttestation = typed.Resource[NodeAttestationSpec, NodeAttestationExtension]
+
+// NodeAttestationSpec describes the result of the TPM-based attestation of a node.
+//
+//gotagsrewrite:gen
+type NodeAttestationSpec struct {
+	// Address is the address the request came from.
+	Address string `yaml:"address" protobuf:"1"`
+	// Attested is true if the node provided valid TPM attestation evidence matching the policy.
+	Attested bool `yaml:"attested" protobuf:"2"`
+	// Accepted is true if the certificate was issued to the node.
+	Accepted bool `yaml:"accepted" protobuf:"3"`
+	// Error is the attestation failure reason.
+	Error string `yaml:"error,omitempty" protobuf:"4"`
+	// EKFingerprint is the SHA-256 fingerprint of the TPM endorsement key (public part).
+	EKFingerprint string `yaml:"ekFingerprint,omitempty" protobuf:"5"`
+	// EKCertificateSubject is the subject of the TPM endorsement key certificate.
+	EKCertificateSubject string `yaml:"ekCertificateSubject,omitempty" protobuf:"6"`
+	// AKName is the TPM name of the attestation key.
+	AKName string `yaml:"akName,omitempty" protobuf:"7"`
+	// PCRValues is the list of quoted PCR values.
+	PCRValues []NodeAttestationPCRValueSpec `yaml:"pcrValues,omitempty" protobuf:"8"`
+	// Timestamp is the time of the attestation.
+	Timestamp time.Time `yaml:"timestamp" protobuf:"9"`
+	// Subject is the subject common name from the certificate request (as claimed by the node).
+	Subject string `yaml:"subject,omitempty" protobuf:"10"`
+}
+
+// NewNodeAttestation creates new NodeAttestation object.
+func NewNodeAttestation(id resource.ID) *NodeAttestation {
+	return typed.NewResource[NodeAttestationSpec, NodeAttestationExtension](
+		resource.NewMetadata(NamespaceName, NodeAttestationType, id, resource.VersionUndefined),
+		NodeAttestationSpec{},
+	)
+}
+
+// NodeAttestationExtension is an auxiliary type for NodeAttestation resource.
+type NodeAttestationExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (NodeAttestationExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             NodeAttestationType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Subject",
+				JSONPath: "{.subject}",
+			},
+			{
+				Name:     "Attested",
+				JSONPath: "{.attested}",
+			},
+			{
+				Name:     "Accepted",
+				JSONPath: "{.accepted}",
+			},
+			{
+				Name:     "Error",
+				JSONPath: "{.error}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic(NodeAttestationType, &NodeAttestation{})
+	if err != nil {
+		panic(err)
+	}
+}
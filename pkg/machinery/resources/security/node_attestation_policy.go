@@ -0,0 +1,89 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint:revive
+package security
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// NodeAttestationPolicyType is type of NodeAttestationPolicy resource.
+const NodeAttestationPolicyType = resource.Type("NodeAttestationPolicies.security.talos.dev")
+
+// NodeAttestationPolicyID is the ID of the singleton NodeAttestationPolicy resource.
+const NodeAttestationPolicyID = resource.ID("node-attestation")
+
+// NodeAttestationPolicy represents NodeAttestationPolicy typed resource.
+type NodeAttestationPolicy = typed.Resource[NodeAttestationPolicySpec, NodeAttestationPolicyExtension]
+
+// NodeAttestationPolicySpec describes the TPM-based node attestation policy.
+//
+//gotagsrewrite:gen
+type NodeAttestationPolicySpec struct {
+	// Mode is the attestation mode (disabled, optional, required).
+	Mode string `yaml:"mode" protobuf:"1"`
+	// PCRs is the list of PCRs to be quoted.
+	PCRs []int `yaml:"pcrs" protobuf:"2"`
+	// AllowedPCRValues is the list of allowed values per PCR.
+	AllowedPCRValues []NodeAttestationPCRValueSpec `yaml:"allowedPCRValues,omitempty" protobuf:"3"`
+	// PCRSigningKeys is the list of PEM-encoded public keys accepted for signed PCR policies.
+	PCRSigningKeys []string `yaml:"pcrSigningKeys,omitempty" protobuf:"4"`
+	// EndorsementCAs is the list of PEM-encoded CA certificates to verify EK certificates.
+	EndorsementCAs []string `yaml:"endorsementCAs,omitempty" protobuf:"5"`
+}
+
+// NodeAttestationPCRValueSpec describes (allowed or quoted) values of a PCR.
+//
+//gotagsrewrite:gen
+type NodeAttestationPCRValueSpec struct {
+	// PCR is the PCR index.
+	PCR int `yaml:"pcr" protobuf:"1"`
+	// Values is the list of hex-encoded PCR values.
+	Values []string `yaml:"values" protobuf:"2"`
+}
+
+// NewNodeAttestationPolicy creates new NodeAttestationPolicy object.
+func NewNodeAttestationPolicy() *NodeAttestationPolicy {
+	return typed.NewResource[NodeAttestationPolicySpec, NodeAttestationPolicyExtension](
+		resource.NewMetadata(NamespaceName, NodeAttestationPolicyType, NodeAttestationPolicyID, resource.VersionUndefined),
+		NodeAttestationPolicySpec{},
+	)
+}
+
+// NodeAttestationPolicyExtension is an auxiliary type for NodeAttestationPolicy resource.
+type NodeAttestationPolicyExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (NodeAttestationPolicyExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             NodeAttestationPolicyType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Mode",
+				JSONPath: "{.mode}",
+			},
+			{
+				Name:     "PCRs",
+				JSONPath: "{.pcrs}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic(NodeAttestationPolicyType, &NodeAttestationPolicy{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -25,6 +25,8 @@ func TestRegisterResource(t *testing.T) {
 
 	for _, resource := range []meta.ResourceWithRD{
 		&security.ImageVerificationRule{},
+		&security.NodeAttestation{},
+		&security.NodeAttestationPolicy{},
 		&security.TUFTrustedRoot{},
 	} {
 		assert.NoError(t, resourceRegistry.Register(ctx, resource))
@@ -0,0 +1,128 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package netem implements network fault injection between the nodes of a local cluster.
+//
+// Faults are applied with tc netem on every port (veth) of the cluster bridge: the traffic leaving the port
+// goes to the node attached to it, so matching the source and destination addresses is enough to apply
+// the fault between the groups of nodes regardless of the port the node is attached to.
+package netem
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+
+	yaml "go.yaml.in/yaml/v4"
+
+	"github.com/siderolabs/talos/pkg/provision"
+)
+
+// StateFileName is the name of the file in the cluster state directory which stores the applied faults.
+const StateFileName = "netem.yaml"
+
+// Load returns the faults stored in the cluster state directory.
+func Load(statePath string) ([]provision.NetworkFault, error) {
+	data, err := os.ReadFile(filepath.Join(statePath, StateFileName))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var faults []provision.NetworkFault
+
+	if err = yaml.Unmarshal(data, &faults); err != nil {
+		return nil, fmt.Errorf("error parsing network faults: %w", err)
+	}
+
+	return faults, nil
+}
+
+// Save stores the faults in the cluster state directory.
+func Save(statePath string, faults []provision.NetworkFault) error {
+	if len(faults) == 0 {
+		if err := os.Remove(filepath.Join(statePath, StateFileName)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+
+		return nil
+	}
+
+	data, err := yaml.Marshal(faults)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(statePath, StateFileName), data, 0o644)
+}
+
+// Add assigns the ID to the fault, applies it to the bridge along with the existing faults and stores them.
+func Add(bridgeName, statePath string, fault provision.NetworkFault) (provision.NetworkFault, error) {
+	if err := fault.Validate(); err != nil {
+		return provision.NetworkFault{}, fmt.Errorf("invalid network fault: %w", err)
+	}
+
+	faults, err := Load(statePath)
+	if err != nil {
+		return provision.NetworkFault{}, err
+	}
+
+	if len(faults) >= provision.MaxNetworkFaults {
+		return provision.NetworkFault{}, fmt.Errorf("at most %d network faults can be applied at the same time", provision.MaxNetworkFaults)
+	}
+
+	fault.ID = nextID(faults)
+	faults = append(faults, fault)
+
+	if err = Apply(bridgeName, faults); err != nil {
+		return provision.NetworkFault{}, err
+	}
+
+	return fault, Save(statePath, faults)
+}
+
+// Remove removes the faults with the specified IDs, or all faults if no IDs are given.
+func Remove(bridgeName, statePath string, ids ...string) error {
+	faults, err := Load(statePath)
+	if err != nil {
+		return err
+	}
+
+	if len(ids) == 0 {
+		faults = nil
+	}
+
+	for _, id := range ids {
+		idx := slices.IndexFunc(faults, func(fault provision.NetworkFault) bool { return fault.ID == id })
+		if idx == -1 {
+			return fmt.Errorf("network fault %q not found", id)
+		}
+
+		faults = slices.Delete(faults, idx, idx+1)
+	}
+
+	if err = Apply(bridgeName, faults); err != nil {
+		return err
+	}
+
+	return Save(statePath, faults)
+}
+
+func nextID(faults []provision.NetworkFault) string {
+	var last int
+
+	for _, fault := range faults {
+		if id, err := strconv.Atoi(fault.ID); err == nil {
+			last = max(last, id)
+		}
+	}
+
+	return strconv.Itoa(last + 1)
+}
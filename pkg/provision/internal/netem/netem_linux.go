@@ -0,0 +1,225 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package netem
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/netip"
+
+	"github.com/florianl/go-tc"
+	"github.com/florianl/go-tc/core"
+	"github.com/jsimonetti/rtnetlink/v2"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/pkg/provision"
+)
+
+// rootMajor is the major handle of the root qdisc installed on the bridge ports,
+// the netem qdisc of each fault gets the next major handle.
+const rootMajor = 0x7a00
+
+// Apply replaces the faults applied to the ports of the bridge.
+func Apply(bridgeName string, faults []provision.NetworkFault) error {
+	bridge, err := net.InterfaceByName(bridgeName)
+	if err != nil {
+		return fmt.Errorf("error looking up bridge interface %q: %w", bridgeName, err)
+	}
+
+	rtconn, err := rtnetlink.Dial(nil)
+	if err != nil {
+		return fmt.Errorf("error dialing rtnetlink: %w", err)
+	}
+
+	defer rtconn.Close() //nolint:errcheck
+
+	links, err := rtconn.Link.List()
+	if err != nil {
+		return fmt.Errorf("error listing links: %w", err)
+	}
+
+	tcnl, err := tc.Open(&tc.Config{})
+	if err != nil {
+		return fmt.Errorf("could not open tc: %w", err)
+	}
+
+	defer tcnl.Close() //nolint:errcheck
+
+	for _, link := range links {
+		if link.Attributes == nil || link.Attributes.Master == nil || *link.Attributes.Master != uint32(bridge.Index) {
+			continue
+		}
+
+		if err = applyToPort(tcnl, link.Index, faults); err != nil {
+			return fmt.Errorf("error applying network faults to %q: %w", link.Attributes.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func applyToPort(tcnl *tc.Tc, ifindex uint32, faults []provision.NetworkFault) error {
+	if err := cleanupPort(tcnl, ifindex); err != nil {
+		return err
+	}
+
+	if len(faults) == 0 {
+		return nil
+	}
+
+	// all unmatched traffic goes to the band 0
+	root := tc.Object{
+		Msg: tc.Msg{
+			Family:  unix.AF_UNSPEC,
+			Ifindex: ifindex,
+			Handle:  core.BuildHandle(rootMajor, 0),
+			Parent:  tc.HandleRoot,
+		},
+		Attribute: tc.Attribute{
+			Kind: "prio",
+			Prio: &tc.Prio{
+				Bands: uint32(len(faults) + 1),
+			},
+		},
+	}
+
+	if err := tcnl.Qdisc().Add(&root); err != nil {
+		return fmt.Errorf("could not add prio qdisc: %w", err)
+	}
+
+	for i, fault := range faults {
+		// prio classes are numbered from 1, and the band 0 is kept for the unmatched traffic
+		classID := core.BuildHandle(rootMajor, uint32(i+2))
+
+		qdisc := tc.Object{
+			Msg: tc.Msg{
+				Family:  unix.AF_UNSPEC,
+				Ifindex: ifindex,
+				Handle:  core.BuildHandle(rootMajor+uint32(i+1), 0),
+				Parent:  classID,
+			},
+			Attribute: tc.Attribute{
+				Kind:  "netem",
+				Netem: netemAttributes(fault),
+			},
+		}
+
+		if err := tcnl.Qdisc().Add(&qdisc); err != nil {
+			return fmt.Errorf("could not add netem qdisc for fault %q: %w", fault.ID, err)
+		}
+
+		for _, pair := range [][2][]netip.Addr{{fault.From, fault.To}, {fault.To, fault.From}} {
+			for _, src := range pair[0] {
+				for _, dst := range pair[1] {
+					if err := addFilter(tcnl, ifindex, classID, src, dst); err != nil {
+						return fmt.Errorf("could not add filter for fault %q: %w", fault.ID, err)
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// cleanupPort removes the root qdisc installed by Apply, all the children and filters are removed with it.
+func cleanupPort(tcnl *tc.Tc, ifindex uint32) error {
+	qdiscs, err := tcnl.Qdisc().Get()
+	if err != nil {
+		return fmt.Errorf("could not list qdiscs: %w", err)
+	}
+
+	for _, qdisc := range qdiscs {
+		if qdisc.Ifindex != ifindex || qdisc.Parent != tc.HandleRoot || qdisc.Handle != core.BuildHandle(rootMajor, 0) {
+			continue
+		}
+
+		if err = tcnl.Qdisc().Delete(&qdisc); err != nil && !errors.Is(err, unix.ENOENT) {
+			return fmt.Errorf("could not delete qdisc: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func netemAttributes(fault provision.NetworkFault) *tc.Netem {
+	netem := &tc.Netem{
+		Qopt: tc.NetemQopt{
+			Limit: 10000,
+		},
+	}
+
+	if fault.Latency > 0 {
+		netem.Latency64 = new(int64(fault.Latency))
+		netem.Jitter64 = new(int64(fault.Jitter))
+	}
+
+	switch {
+	case fault.Partition:
+		netem.Qopt.Loss = math.MaxUint32
+	case fault.PacketLoss > 0:
+		netem.Qopt.Loss = uint32(fault.PacketLoss * math.MaxUint32)
+	}
+
+	if fault.Bandwidth > 0 {
+		netem.Rate64 = new(fault.Bandwidth * 1000 / 8) // bytes per second
+	}
+
+	return netem
+}
+
+func addFilter(tcnl *tc.Tc, ifindex, classID uint32, src, dst netip.Addr) error {
+	if src.Is4() != dst.Is4() {
+		return nil
+	}
+
+	flower := &tc.Flower{
+		ClassID: new(classID),
+	}
+
+	srcIP, dstIP := net.IP(src.AsSlice()), net.IP(dst.AsSlice())
+
+	var (
+		protocol uint16
+		prio     uint32
+	)
+
+	if src.Is4() {
+		protocol, prio = unix.ETH_P_IP, 1
+		srcMask, dstMask := net.IP(net.CIDRMask(32, 32)), net.IP(net.CIDRMask(32, 32))
+
+		flower.KeyIPv4Src, flower.KeyIPv4SrcMask = &srcIP, &srcMask
+		flower.KeyIPv4Dst, flower.KeyIPv4DstMask = &dstIP, &dstMask
+	} else {
+		protocol, prio = unix.ETH_P_IPV6, 2
+		srcMask, dstMask := net.IP(net.CIDRMask(128, 128)), net.IP(net.CIDRMask(128, 128))
+
+		flower.KeyIPv6Src, flower.KeyIPv6SrcMask = &srcIP, &srcMask
+		flower.KeyIPv6Dst, flower.KeyIPv6DstMask = &dstIP, &dstMask
+	}
+
+	flower.KeyEthType = new(protocol)
+
+	filter := tc.Object{
+		Msg: tc.Msg{
+			Family:  unix.AF_UNSPEC,
+			Ifindex: ifindex,
+			Parent:  core.BuildHandle(rootMajor, 0),
+			Info:    core.BuildHandle(prio, uint32(htons(protocol))),
+		},
+		Attribute: tc.Attribute{
+			Kind:   "flower",
+			Flower: flower,
+		},
+	}
+
+	return tcnl.Filter().Add(&filter)
+}
+
+func htons(v uint16) uint16 {
+	return (v << 8) | (v >> 8)
+}
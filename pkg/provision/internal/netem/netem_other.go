@@ -0,0 +1,18 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//go:build !linux
+
+package netem
+
+import (
+	"errors"
+
+	"github.com/siderolabs/talos/pkg/provision"
+)
+
+// Apply replaces the faults applied to the ports of the bridge.
+func Apply(string, []provision.NetworkFault) error {
+	return errors.New("network faults are only supported on Linux")
+}
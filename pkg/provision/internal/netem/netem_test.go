@@ -0,0 +1,58 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package netem_test
+
+import (
+	"net/netip"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/provision"
+	"github.com/siderolabs/talos/pkg/provision/internal/netem"
+)
+
+func TestSaveLoad(t *testing.T) {
+	t.Parallel()
+
+	statePath := t.TempDir()
+
+	faults, err := netem.Load(statePath)
+	require.NoError(t, err)
+	assert.Empty(t, faults)
+
+	expected := []provision.NetworkFault{
+		{
+			ID:      "1",
+			From:    []netip.Addr{netip.MustParseAddr("10.5.0.2")},
+			To:      []netip.Addr{netip.MustParseAddr("10.5.0.3"), netip.MustParseAddr("10.5.0.4")},
+			Latency: 100 * time.Millisecond,
+			Jitter:  5 * time.Millisecond,
+		},
+		{
+			ID:        "2",
+			From:      []netip.Addr{netip.MustParseAddr("fd00::2")},
+			To:        []netip.Addr{netip.MustParseAddr("fd00::3")},
+			Partition: true,
+		},
+	}
+
+	require.NoError(t, netem.Save(statePath, expected))
+
+	faults, err = netem.Load(statePath)
+	require.NoError(t, err)
+	assert.Equal(t, expected, faults)
+
+	// saving no faults removes the state file
+	require.NoError(t, netem.Save(statePath, nil))
+	assert.NoFileExists(t, filepath.Join(statePath, netem.StateFileName))
+
+	faults, err = netem.Load(statePath)
+	require.NoError(t, err)
+	assert.Empty(t, faults)
+}
@@ -0,0 +1,102 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package provision
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+)
+
+// MaxNetworkFaults is the maximum number of network faults which can be applied to a cluster at the same time.
+const MaxNetworkFaults = 15
+
+// NetworkFault describes the degradation of the network between two groups of nodes.
+//
+// The fault is applied to the traffic in both directions.
+type NetworkFault struct {
+	ID string `yaml:"id"`
+
+	// From and To are the addresses of the nodes in each group.
+	From []netip.Addr `yaml:"from"`
+	To   []netip.Addr `yaml:"to"`
+
+	Latency time.Duration `yaml:"latency,omitempty"`
+	Jitter  time.Duration `yaml:"jitter,omitempty"`
+	// PacketLoss is the share of the dropped packets, from 0 to 1.
+	PacketLoss float64 `yaml:"packetLoss,omitempty"`
+	// Bandwidth is the bandwidth limit in kbps.
+	Bandwidth uint64 `yaml:"bandwidth,omitempty"`
+	// Partition drops all traffic between the groups.
+	Partition bool `yaml:"partition,omitempty"`
+}
+
+// Validate checks the network fault.
+func (fault NetworkFault) Validate() error {
+	var errs error
+
+	if len(fault.From) == 0 || len(fault.To) == 0 {
+		errs = errors.Join(errs, errors.New("both groups of nodes are required"))
+	}
+
+	for _, addr := range fault.From {
+		if slices.Contains(fault.To, addr) {
+			errs = errors.Join(errs, fmt.Errorf("node %s can't be in both groups", addr))
+		}
+	}
+
+	if fault.Latency < 0 || fault.Jitter < 0 {
+		errs = errors.Join(errs, errors.New("latency and jitter can't be negative"))
+	}
+
+	if fault.Jitter > 0 && fault.Latency == 0 {
+		errs = errors.Join(errs, errors.New("jitter requires latency"))
+	}
+
+	if fault.PacketLoss < 0 || fault.PacketLoss > 1 {
+		errs = errors.Join(errs, errors.New("packet loss should be in range [0, 1]"))
+	}
+
+	degraded := fault.Latency > 0 || fault.PacketLoss > 0 || fault.Bandwidth > 0
+
+	switch {
+	case fault.Partition && degraded:
+		errs = errors.Join(errs, errors.New("partition can't be combined with other faults"))
+	case !fault.Partition && !degraded:
+		errs = errors.Join(errs, errors.New("no fault specified"))
+	}
+
+	return errs
+}
+
+// String implements fmt.Stringer.
+func (fault NetworkFault) String() string {
+	if fault.Partition {
+		return "partition"
+	}
+
+	var desc []string
+
+	if fault.Latency > 0 {
+		desc = append(desc, "latency "+fault.Latency.String())
+	}
+
+	if fault.Jitter > 0 {
+		desc = append(desc, "jitter "+fault.Jitter.String())
+	}
+
+	if fault.PacketLoss > 0 {
+		desc = append(desc, fmt.Sprintf("loss %v%%", fault.PacketLoss*100))
+	}
+
+	if fault.Bandwidth > 0 {
+		desc = append(desc, fmt.Sprintf("bandwidth %dkbps", fault.Bandwidth))
+	}
+
+	return strings.Join(desc, ", ")
+}
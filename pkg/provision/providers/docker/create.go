@@ -34,7 +34,7 @@ func (p *provisioner) Create(ctx context.Context, request provision.ClusterReque
 		return nil, fmt.Errorf("failed to initialize provisioner state: %w", err)
 	}
 
-	if err = p.ensureImageExists(ctx, request.Image, &options); err != nil {
+	if err = p.ensureNodeImagesExist(ctx, request, request.Nodes, &options); err != nil {
 		return nil, err
 	}
 
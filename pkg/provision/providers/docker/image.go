@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"slices"
 
 	"github.com/distribution/reference"
 	"github.com/moby/moby/client"
@@ -61,3 +62,22 @@ func (p *provisioner) ensureImageExists(ctx context.Context, containerImage stri
 
 	return nil
 }
+
+// ensureNodeImagesExist pulls the cluster image and the images overridden for the nodes.
+func (p *provisioner) ensureNodeImagesExist(ctx context.Context, request provision.ClusterRequest, nodeReqs []provision.NodeRequest, options *provision.Options) error {
+	images := []string{request.Image}
+
+	for _, nodeReq := range nodeReqs {
+		if nodeReq.Image != "" && !slices.Contains(images, nodeReq.Image) {
+			images = append(images, nodeReq.Image)
+		}
+	}
+
+	for _, image := range images {
+		if err := p.ensureImageExists(ctx, image, options); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/siderolabs/talos/pkg/provision"
+	"github.com/siderolabs/talos/pkg/provision/internal/netem"
+)
+
+// AddNetworkFault applies the fault to the traffic between the containers on the cluster network bridge.
+//
+// Docker daemon should be running on the same host, as the faults are applied to the bridge of the network.
+func (p *provisioner) AddNetworkFault(ctx context.Context, cluster provision.Cluster, fault provision.NetworkFault) (provision.NetworkFault, error) {
+	bridgeName, statePath, err := p.networkFaultState(ctx, cluster)
+	if err != nil {
+		return provision.NetworkFault{}, err
+	}
+
+	return netem.Add(bridgeName, statePath, fault)
+}
+
+// RemoveNetworkFaults removes the faults from the cluster network bridge.
+func (p *provisioner) RemoveNetworkFaults(ctx context.Context, cluster provision.Cluster, ids ...string) error {
+	bridgeName, statePath, err := p.networkFaultState(ctx, cluster)
+	if err != nil {
+		return err
+	}
+
+	return netem.Remove(bridgeName, statePath, ids...)
+}
+
+// ListNetworkFaults returns the faults applied to the cluster network bridge.
+func (p *provisioner) ListNetworkFaults(_ context.Context, cluster provision.Cluster) ([]provision.NetworkFault, error) {
+	statePath, err := clusterStatePath(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return netem.Load(statePath)
+}
+
+func (p *provisioner) networkFaultState(ctx context.Context, cluster provision.Cluster) (bridgeName, statePath string, err error) {
+	networks, err := p.listNetworks(ctx, cluster.Info().ClusterName)
+	if err != nil {
+		return "", "", err
+	}
+
+	if len(networks) == 0 {
+		return "", "", fmt.Errorf("network of the cluster %q not found", cluster.Info().ClusterName)
+	}
+
+	bridgeName, ok := networks[0].Options["com.docker.network.bridge.name"]
+	if !ok {
+		bridgeName = "br-" + networks[0].ID[:12]
+	}
+
+	statePath, err = clusterStatePath(cluster)
+	if err != nil {
+		return "", "", err
+	}
+
+	return bridgeName, statePath, nil
+}
+
+// clusterStatePath returns the state directory of the cluster.
+//
+// The reflected cluster points to the parent state directory, while the created one points to the cluster state directory.
+func clusterStatePath(cluster provision.Cluster) (string, error) {
+	statePath, err := cluster.StatePath()
+	if err != nil {
+		return "", err
+	}
+
+	if filepath.Base(statePath) != cluster.Info().ClusterName {
+		statePath = filepath.Join(statePath, cluster.Info().ClusterName)
+	}
+
+	return statePath, nil
+}
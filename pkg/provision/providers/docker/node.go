@@ -5,6 +5,7 @@
 package docker
 
 import (
+	"cmp"
 	"context"
 	"encoding/base64"
 	"errors"
@@ -109,7 +110,7 @@ func (p *provisioner) createNode(ctx context.Context, clusterReq provision.Clust
 	// Create the container config.
 	containerConfig := &container.Config{
 		Hostname: nodeReq.Name,
-		Image:    clusterReq.Image,
+		Image:    cmp.Or(nodeReq.Image, clusterReq.Image),
 		Env:      env,
 		Labels: map[string]string{
 			"talos.owned":        "true",
@@ -266,6 +267,10 @@ func (p *provisioner) destroyNodes(ctx context.Context, clusterName string, opti
 		return err
 	}
 
+	return p.destroyContainers(ctx, containers, options)
+}
+
+func (p *provisioner) destroyContainers(ctx context.Context, containers []container.Summary, options *provision.Options) error {
 	errCh := make(chan error)
 
 	for _, ctr := range containers {
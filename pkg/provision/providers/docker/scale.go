@@ -0,0 +1,69 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/moby/moby/api/types/container"
+	"github.com/siderolabs/gen/xslices"
+
+	"github.com/siderolabs/talos/pkg/provision"
+)
+
+// ScaleNodes adds and removes the containers of the running cluster to match the request.
+func (p *provisioner) ScaleNodes(ctx context.Context, cluster provision.Cluster, request provision.ClusterRequest, opts ...provision.Option) (provision.Cluster, error) {
+	options := provision.DefaultOptions()
+
+	for _, opt := range opts {
+		if err := opt(&options); err != nil {
+			return nil, err
+		}
+	}
+
+	containers, err := p.listNodes(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	containerName := func(ctr container.Summary) string {
+		return strings.TrimLeft(ctr.Names[0], "/")
+	}
+
+	toDestroy := xslices.Filter(containers, func(ctr container.Summary) bool {
+		return !slices.ContainsFunc(request.Nodes, func(nodeReq provision.NodeRequest) bool { return nodeReq.Name == containerName(ctr) })
+	})
+
+	toCreate := xslices.Filter(request.Nodes, func(nodeReq provision.NodeRequest) bool {
+		return !slices.ContainsFunc(containers, func(ctr container.Summary) bool { return containerName(ctr) == nodeReq.Name })
+	})
+
+	if err = p.destroyContainers(ctx, toDestroy, &options); err != nil {
+		return nil, err
+	}
+
+	if len(toCreate) > 0 {
+		if err = p.ensureNodeImagesExist(ctx, request, toCreate, &options); err != nil {
+			return nil, err
+		}
+
+		fmt.Fprintln(options.LogWriter, "creating nodes", xslices.Map(toCreate, func(nodeReq provision.NodeRequest) string { return nodeReq.Name }))
+
+		// ports are only mapped to the first control plane node, which is already running
+		if _, err = p.createNodes(ctx, request, toCreate, &options, false); err != nil {
+			return nil, err
+		}
+	}
+
+	statePath, err := cluster.StatePath()
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Reflect(ctx, request.Name, statePath)
+}
@@ -0,0 +1,57 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package qemu
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/siderolabs/talos/pkg/provision"
+	"github.com/siderolabs/talos/pkg/provision/internal/netem"
+)
+
+// AddNetworkFault applies the fault to the traffic between the nodes on the cluster bridge.
+func (p *provisioner) AddNetworkFault(_ context.Context, cluster provision.Cluster, fault provision.NetworkFault) (provision.NetworkFault, error) {
+	state, statePath, err := networkFaultState(cluster)
+	if err != nil {
+		return provision.NetworkFault{}, err
+	}
+
+	return netem.Add(state.BridgeName, statePath, fault)
+}
+
+// RemoveNetworkFaults removes the faults from the cluster bridge.
+func (p *provisioner) RemoveNetworkFaults(_ context.Context, cluster provision.Cluster, ids ...string) error {
+	state, statePath, err := networkFaultState(cluster)
+	if err != nil {
+		return err
+	}
+
+	return netem.Remove(state.BridgeName, statePath, ids...)
+}
+
+// ListNetworkFaults returns the faults applied to the cluster bridge.
+func (p *provisioner) ListNetworkFaults(_ context.Context, cluster provision.Cluster) ([]provision.NetworkFault, error) {
+	_, statePath, err := networkFaultState(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return netem.Load(statePath)
+}
+
+func networkFaultState(cluster provision.Cluster) (*provision.State, string, error) {
+	state, ok := cluster.(*provision.State)
+	if !ok {
+		return nil, "", fmt.Errorf("error inspecting QEMU state, %#+v", cluster)
+	}
+
+	statePath, err := state.StatePath()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return state, statePath, nil
+}
@@ -44,7 +44,12 @@ func (p *provisioner) createNode(ctx context.Context, state *provision.State, cl
 		pflashSpec   []PFlash
 	)
 
-	if spec := arch.PFlash(opts.UEFIEnabled, opts.ExtraUEFISearchPaths); spec != nil {
+	uefiEnabled := opts.UEFIEnabled
+	if nodeReq.UEFI != nil {
+		uefiEnabled = *nodeReq.UEFI
+	}
+
+	if spec := arch.PFlash(uefiEnabled, opts.ExtraUEFISearchPaths); spec != nil {
 		var err error
 		if pflashImages, err = p.createPFlashImages(state, nodeReq.Name, spec); err != nil {
 			return provision.NodeInfo{}, fmt.Errorf("error creating flash images: %w", err)
@@ -214,6 +219,15 @@ func (p *provisioner) createNode(ctx context.Context, state *provision.State, cl
 		CLOSNoNet0: clusterReq.Network.CLOSNoNet0,
 	}
 
+	// extra NICs are L2-only, DHCP on the cluster bridge only serves the primary NIC
+	for i := range nodeReq.ExtraNICs {
+		launchConfig.FabricUplinks = append(launchConfig.FabricUplinks, FabricUplink{
+			BridgeName:  state.BridgeName,
+			IfName:      fmt.Sprintf("vethnic%d", i),
+			CNIConfList: fabricCNIConfList(state.BridgeName, clusterReq.Network.MTU),
+		})
+	}
+
 	if clusterReq.IPXEBootScript != "" {
 		launchConfig.TFTPServer = clusterReq.Network.GatewayAddrs[0].String()
 		launchConfig.IPXEBootFileName = fmt.Sprintf("ipxe/%s/snp.efi", string(arch))
@@ -234,8 +248,19 @@ func (p *provisioner) createNode(ctx context.Context, state *provision.State, cl
 		APIPort: apiBind.Port,
 	}
 
-	if opts.TPM1_2Enabled || opts.TPM2Enabled {
-		tpmConfig, tpm2Err := p.createVirtualTPMState(state, nodeReq.Name, opts.TPM2Enabled)
+	tpm1_2Enabled, tpm2Enabled := opts.TPM1_2Enabled, opts.TPM2Enabled
+
+	switch nodeReq.TPM {
+	case "none":
+		tpm1_2Enabled, tpm2Enabled = false, false
+	case "1.2":
+		tpm1_2Enabled, tpm2Enabled = true, false
+	case "2.0":
+		tpm1_2Enabled, tpm2Enabled = false, true
+	}
+
+	if tpm1_2Enabled || tpm2Enabled {
+		tpmConfig, tpm2Err := p.createVirtualTPMState(state, nodeReq.Name, tpm2Enabled)
 		if tpm2Err != nil {
 			return provision.NodeInfo{}, tpm2Err
 		}
@@ -258,6 +283,10 @@ func (p *provisioner) createNode(ctx context.Context, state *provision.State, cl
 		launchConfig.ISOPath = strings.ReplaceAll(clusterReq.ISOPath, constants.ArchVariable, opts.TargetArch)
 		launchConfig.USBPath = strings.ReplaceAll(clusterReq.USBPath, constants.ArchVariable, opts.TargetArch)
 		launchConfig.UKIPath = strings.ReplaceAll(clusterReq.UKIPath, constants.ArchVariable, opts.TargetArch)
+
+		if nodeReq.ISOPath != "" {
+			launchConfig.ISOPath = strings.ReplaceAll(nodeReq.ISOPath, constants.ArchVariable, opts.TargetArch)
+		}
 	}
 
 	launchConfig.StatePath, err = state.StatePath()
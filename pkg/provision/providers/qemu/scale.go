@@ -0,0 +1,145 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package qemu
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/siderolabs/gen/xslices"
+
+	"github.com/siderolabs/talos/pkg/provision"
+)
+
+// ScaleNodes adds and removes the nodes of the running cluster to match the request.
+//
+//nolint:gocyclo
+func (p *provisioner) ScaleNodes(ctx context.Context, cluster provision.Cluster, request provision.ClusterRequest, opts ...provision.Option) (provision.Cluster, error) {
+	options := provision.DefaultOptions()
+
+	for _, opt := range opts {
+		if err := opt(&options); err != nil {
+			return nil, err
+		}
+	}
+
+	state, ok := cluster.(*provision.State)
+	if !ok {
+		return nil, fmt.Errorf("error inspecting QEMU state, %#+v", cluster)
+	}
+
+	existing := state.ClusterInfo.Nodes
+
+	var toCreate []provision.NodeRequest
+
+	for _, nodeReq := range request.Nodes {
+		if nodeReq.PXEBooted {
+			continue
+		}
+
+		if !slices.ContainsFunc(existing, func(node provision.NodeInfo) bool { return node.Name == nodeReq.Name }) {
+			toCreate = append(toCreate, nodeReq)
+		}
+	}
+
+	toDestroy := xslices.Filter(existing, func(node provision.NodeInfo) bool {
+		return !slices.ContainsFunc(request.Nodes, func(nodeReq provision.NodeRequest) bool { return nodeReq.Name == node.Name })
+	})
+
+	controlPlanesChanged := slices.ContainsFunc(toCreate, func(nodeReq provision.NodeRequest) bool { return nodeReq.Type.IsControlPlane() }) ||
+		slices.ContainsFunc(toDestroy, func(node provision.NodeInfo) bool { return node.Type.IsControlPlane() })
+
+	for _, node := range toDestroy {
+		fmt.Fprintln(options.LogWriter, "removing node", node.Name)
+
+		if err := p.DestroyNode(node); err != nil {
+			return nil, fmt.Errorf("error stopping node %q: %w", node.Name, err)
+		}
+
+		if err := p.destroyVirtualTPMs(provision.ClusterInfo{Nodes: []provision.NodeInfo{node}}); err != nil {
+			return nil, err
+		}
+
+		if err := p.destroyNodeFiles(state, node.Name); err != nil {
+			return nil, fmt.Errorf("error removing files of node %q: %w", node.Name, err)
+		}
+	}
+
+	nodes := xslices.Filter(existing, func(node provision.NodeInfo) bool {
+		return !slices.ContainsFunc(toDestroy, func(destroyed provision.NodeInfo) bool { return destroyed.Name == node.Name })
+	})
+
+	if len(toCreate) > 0 {
+		fmt.Fprintln(options.LogWriter, "creating nodes", xslices.Map(toCreate, func(nodeReq provision.NodeRequest) string { return nodeReq.Name }))
+
+		created, err := p.createNodes(ctx, state, request, toCreate, &options)
+
+		// record the nodes which were created even on error, so that they can be cleaned up
+		nodes = append(nodes, created...)
+
+		if err != nil {
+			state.ClusterInfo.Nodes = nodes
+
+			return nil, errors.Join(err, state.Save())
+		}
+	}
+
+	state.ClusterInfo.Nodes = nodes
+
+	if controlPlanesChanged {
+		fmt.Fprintln(options.LogWriter, "updating load balancer")
+
+		if err := p.DestroyLoadBalancer(state); err != nil {
+			return nil, fmt.Errorf("error stopping loadbalancer: %w", err)
+		}
+
+		if err := p.CreateLoadBalancer(state, request); err != nil {
+			return nil, fmt.Errorf("error creating loadbalancer: %w", err)
+		}
+	}
+
+	if err := state.Save(); err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// destroyNodeFiles removes the files created by createNode.
+func (p *provisioner) destroyNodeFiles(state *provision.State, nodeName string) error {
+	paths := []string{
+		state.GetRelativePath(nodeName + ".pid"),
+		state.GetRelativePath(nodeName + ".log"),
+		state.GetRelativePath(nodeName + ".monitor"),
+		state.GetRelativePath(nodeName + ".config"),
+		state.GetRelativePath(nodeName + "-metal-config.iso"),
+		state.GetRelativePath(nodeName + "-tpm"),
+		state.GetShmPath("shm-" + nodeName),
+	}
+
+	// disks and flash images are numbered sequentially
+	for _, pattern := range []string{"%s-%d.disk", "%s-%d.virtiofs.sock", "%s-flash%d.img"} {
+		for i := 0; ; i++ {
+			path := state.GetRelativePath(fmt.Sprintf(pattern, nodeName, i))
+
+			if _, err := os.Lstat(path); err != nil {
+				break
+			}
+
+			paths = append(paths, path)
+		}
+	}
+
+	for _, path := range paths {
+		if err := os.RemoveAll(path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -48,6 +48,30 @@ type RebootProvisioner interface {
 	RebootNode(ctx context.Context, cluster Cluster, node NodeInfo) error
 }
 
+// ScaleProvisioner is an optional interface implemented by provisioners that support
+// adding and removing nodes of a running cluster.
+type ScaleProvisioner interface {
+	// ScaleNodes reconciles the nodes of the cluster with the request.
+	//
+	// Nodes are matched by name: missing nodes are created, and the nodes not in the request are destroyed.
+	// Existing nodes are left untouched.
+	// The nodes are destroyed without leaving the cluster, so the caller should remove the control plane nodes from etcd first.
+	ScaleNodes(ctx context.Context, cluster Cluster, request ClusterRequest, opts ...Option) (Cluster, error)
+}
+
+// NetworkFaultProvisioner is an optional interface implemented by provisioners that support
+// injecting network faults between the nodes of the cluster.
+type NetworkFaultProvisioner interface {
+	// AddNetworkFault applies the fault to the traffic between the nodes of the cluster.
+	//
+	// The returned fault has the ID assigned.
+	AddNetworkFault(ctx context.Context, cluster Cluster, fault NetworkFault) (NetworkFault, error)
+	// RemoveNetworkFaults removes the faults with the specified IDs, all faults are removed if no IDs are given.
+	RemoveNetworkFaults(ctx context.Context, cluster Cluster, ids ...string) error
+	// ListNetworkFaults returns the faults applied to the cluster.
+	ListNetworkFaults(ctx context.Context, cluster Cluster) ([]NetworkFault, error)
+}
+
 const (
 	// HTTPProbeDefaultTimeout is the default provisioner-side HTTP probe timeout.
 	HTTPProbeDefaultTimeout = 5 * time.Second
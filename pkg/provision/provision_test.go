@@ -35,3 +35,59 @@ func TestHTTPProbeRequestNormalize(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, provision.HTTPProbeMaxTimeout, normalized.Timeout)
 }
+
+func TestNetworkFaultValidate(t *testing.T) {
+	a, b := netip.MustParseAddr("10.5.0.2"), netip.MustParseAddr("10.5.0.3")
+
+	for _, test := range []struct {
+		name     string
+		fault    provision.NetworkFault
+		expected string
+	}{
+		{
+			name:  "latency",
+			fault: provision.NetworkFault{From: []netip.Addr{a}, To: []netip.Addr{b}, Latency: 100 * time.Millisecond, Jitter: 10 * time.Millisecond},
+		},
+		{
+			name:  "partition",
+			fault: provision.NetworkFault{From: []netip.Addr{a}, To: []netip.Addr{b}, Partition: true},
+		},
+		{
+			name:     "no groups",
+			fault:    provision.NetworkFault{From: []netip.Addr{a}, PacketLoss: 0.1},
+			expected: "both groups of nodes are required",
+		},
+		{
+			name:     "overlap",
+			fault:    provision.NetworkFault{From: []netip.Addr{a}, To: []netip.Addr{a, b}, Bandwidth: 1000},
+			expected: "node 10.5.0.2 can't be in both groups",
+		},
+		{
+			name:     "partition with loss",
+			fault:    provision.NetworkFault{From: []netip.Addr{a}, To: []netip.Addr{b}, Partition: true, PacketLoss: 0.5},
+			expected: "partition can't be combined with other faults",
+		},
+		{
+			name:     "empty",
+			fault:    provision.NetworkFault{From: []netip.Addr{a}, To: []netip.Addr{b}},
+			expected: "no fault specified",
+		},
+		{
+			name:     "loss out of range",
+			fault:    provision.NetworkFault{From: []netip.Addr{a}, To: []netip.Addr{b}, PacketLoss: 2},
+			expected: "packet loss should be in range [0, 1]",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.fault.Validate()
+
+			if test.expected == "" {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.ErrorContains(t, err, test.expected)
+		})
+	}
+}
@@ -254,6 +254,17 @@ type NodeRequest struct {
 	// If not specified, a random UUID will be generated.
 	UUID *uuid.UUID
 
+	// UEFI overrides the cluster-wide UEFI setting for the node (VMs only).
+	UEFI *bool
+	// TPM overrides the cluster-wide TPM emulation setting for the node: "none", "1.2" or "2.0" (VMs only).
+	TPM string
+	// ExtraNICs is the number of network interfaces attached to the cluster bridge in addition to the primary one (VMs only).
+	ExtraNICs int
+	// ISOPath overrides the cluster-wide boot ISO for the node (VMs only).
+	ISOPath string
+	// Image overrides the cluster-wide container image for the node (containers only).
+	Image string
+
 	// Testing features
 
 	// BadRTC resets RTC to well known time in the past (QEMU provisioner).
@@ -0,0 +1,288 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package aggregator implements safe Kubernetes aggregator (front-proxy) PKI rotation for the cluster.
+package aggregator
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/siderolabs/crypto/x509"
+	"go.yaml.in/yaml/v4"
+
+	"github.com/siderolabs/talos/pkg/cluster"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate/rotatepatcher"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	secretsres "github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+	"github.com/siderolabs/talos/pkg/rotate/pki/internal/helpers"
+)
+
+// Options is the input to the Kubernetes aggregator PKI rotation process.
+type Options struct {
+	// DryRun is the flag to enable dry-run mode.
+	//
+	// In dry-run mode, the rotation process will not make any changes to the cluster.
+	DryRun bool
+
+	// TalosClient is a Talos API client
+	TalosClient *client.Client
+	// ClusterInfo provides information about cluster topology.
+	ClusterInfo cluster.Info
+
+	// KubernetesEndpoint overrides the default Kubernetes API endpoint.
+	KubernetesEndpoint string
+
+	// NewAggregatorCA is the new CA for the Kubernetes API aggregation layer.
+	NewAggregatorCA *x509.PEMEncodedCertificateAndKey
+
+	// EncoderOption is the option for encoding machine configuration (while patching).
+	EncoderOption encoder.Option
+
+	// Printf is the function used to print messages.
+	Printf func(format string, args ...any)
+}
+
+type rotator struct {
+	opts Options
+
+	currentCA []byte
+
+	kubernetes *cluster.KubernetesClient
+}
+
+// Rotate rotates the Kubernetes aggregator PKI.
+//
+// The aggregator CA is only present on the control plane nodes, so only the control plane
+// nodes are patched. The legacy .cluster.aggregatorCA field is migrated to the KubeAggregatorCAConfig
+// document on the first patch.
+//
+// The process overview:
+//   - fetch current information
+//   - verify connectivity with the existing PKI
+//   - add new aggregator CA as accepted
+//   - make new CA issuing, old CA is still accepted
+//   - verify connectivity
+//   - remove old CA
+//   - verify connectivity.
+func Rotate(ctx context.Context, opts Options) error {
+	r := rotator{
+		opts: opts,
+	}
+
+	defer func() {
+		if r.kubernetes != nil {
+			r.kubernetes.K8sClose() //nolint:errcheck
+		}
+	}()
+
+	return r.rotate(ctx)
+}
+
+func (r *rotator) rotate(ctx context.Context) error {
+	r.printIntro()
+
+	if err := r.fetchClient(ctx); err != nil {
+		return err
+	}
+
+	if err := r.fetchCurrentCA(ctx); err != nil {
+		return err
+	}
+
+	if err := r.printNewCA(); err != nil {
+		return err
+	}
+
+	if err := r.verifyConnectivity(ctx, "existing PKI"); err != nil {
+		return err
+	}
+
+	if err := r.addNewCAAccepted(ctx); err != nil {
+		return err
+	}
+
+	if err := r.swapCAs(ctx); err != nil {
+		return err
+	}
+
+	if err := r.verifyConnectivity(ctx, "new PKI"); err != nil {
+		return err
+	}
+
+	if err := r.dropOldCA(ctx); err != nil {
+		return err
+	}
+
+	return r.verifyConnectivity(ctx, "new PKI")
+}
+
+func (r *rotator) controlPlaneNodes() []cluster.NodeInfo {
+	return append(
+		r.opts.ClusterInfo.NodesByType(machine.TypeInit),
+		r.opts.ClusterInfo.NodesByType(machine.TypeControlPlane)...,
+	)
+}
+
+func (r *rotator) printIntro() {
+	r.opts.Printf("> Starting Kubernetes aggregator PKI rotation, dry-run mode %v...\n", r.opts.DryRun)
+
+	r.opts.Printf("> Cluster topology:\n")
+
+	r.opts.Printf(
+		"  - control plane nodes: %q\n",
+		helpers.MapToInternalIP(r.controlPlaneNodes()),
+	)
+}
+
+func (r *rotator) fetchClient(ctx context.Context) error {
+	r.opts.Printf("> Building Kubernetes client...\n")
+
+	r.kubernetes = &cluster.KubernetesClient{
+		ClientProvider: &cluster.ConfigClientProvider{
+			DefaultClient: r.opts.TalosClient,
+		},
+		ForceEndpoint: r.opts.KubernetesEndpoint,
+	}
+
+	_, err := r.kubernetes.K8sClient(client.WithNode(ctx, r.controlPlaneNodes()[0].InternalIP.String()))
+	if err != nil {
+		return fmt.Errorf("error fetching kubeconfig: %w", err)
+	}
+
+	return nil
+}
+
+func (r *rotator) fetchCurrentCA(ctx context.Context) error {
+	r.opts.Printf("> Current Kubernetes aggregator CA:\n")
+
+	firstNode := r.controlPlaneNodes()[0]
+
+	k8sRoot, err := safe.StateGetByID[*secretsres.KubernetesRoot](client.WithNode(ctx, firstNode.InternalIP.String()), r.opts.TalosClient.COSI, secretsres.KubernetesRootID)
+	if err != nil {
+		return fmt.Errorf("error fetching current Kubernetes aggregator CA: %w", err)
+	}
+
+	r.currentCA = k8sRoot.TypedSpec().AggregatorCA.Crt
+
+	var b bytes.Buffer
+
+	if err = yaml.NewEncoder(&b).Encode(&x509.PEMEncodedCertificate{Crt: r.currentCA}); err != nil {
+		return fmt.Errorf("error encoding current Kubernetes aggregator CA: %w", err)
+	}
+
+	for scanner := bufio.NewScanner(&b); scanner.Scan(); {
+		r.opts.Printf("  %s\n", scanner.Text())
+	}
+
+	return nil
+}
+
+func (r *rotator) printNewCA() error {
+	r.opts.Printf("> New Kubernetes aggregator CA:\n")
+
+	var b bytes.Buffer
+
+	if err := yaml.NewEncoder(&b).Encode(r.opts.NewAggregatorCA); err != nil {
+		return fmt.Errorf("error encoding new Kubernetes aggregator CA: %w", err)
+	}
+
+	for scanner := bufio.NewScanner(&b); scanner.Scan(); {
+		r.opts.Printf("  %s\n", scanner.Text())
+	}
+
+	return nil
+}
+
+func (r *rotator) verifyConnectivity(ctx context.Context, label string) error {
+	r.opts.Printf("> Verifying connectivity with %s...\n", label)
+
+	if r.opts.DryRun {
+		r.opts.Printf(" - OK (dry-run mode)\n")
+
+		return nil
+	}
+
+	return helpers.VerifyKubernetesConnectivity(ctx, r.kubernetes, r.opts.Printf)
+}
+
+func (r *rotator) addNewCAAccepted(ctx context.Context) error {
+	r.opts.Printf("> Adding new Kubernetes aggregator CA as accepted...\n")
+
+	if err := r.patchAllNodes(ctx,
+		rotatepatcher.K8sAggregatorAddAcceptedCA(r.opts.NewAggregatorCA.Crt),
+	); err != nil {
+		return fmt.Errorf("error patching all machine configs: %w", err)
+	}
+
+	return nil
+}
+
+func (r *rotator) swapCAs(ctx context.Context) error {
+	r.opts.Printf("> Making new Kubernetes aggregator CA the issuing CA, old Kubernetes aggregator CA the accepted CA...\n")
+
+	if err := r.patchAllNodes(ctx,
+		func(provider config.Provider) (config.Provider, error) {
+			provider, err := rotatepatcher.K8sAggregatorAddAcceptedCA(r.currentCA)(provider)
+			if err != nil {
+				return nil, err
+			}
+
+			provider, err = rotatepatcher.K8sAggregatorDeleteAcceptedCA(r.opts.NewAggregatorCA.Crt)(provider)
+			if err != nil {
+				return nil, err
+			}
+
+			return rotatepatcher.K8sAggregatorSetCA(r.opts.NewAggregatorCA)(provider)
+		}); err != nil {
+		return fmt.Errorf("error patching all machine configs: %w", err)
+	}
+
+	return nil
+}
+
+func (r *rotator) dropOldCA(ctx context.Context) error {
+	r.opts.Printf("> Removing old Kubernetes aggregator CA from the accepted CAs...\n")
+
+	if err := r.patchAllNodes(
+		ctx,
+		rotatepatcher.K8sAggregatorDeleteAcceptedCA(r.currentCA),
+	); err != nil {
+		return fmt.Errorf("error patching all machine configs: %w", err)
+	}
+
+	return nil
+}
+
+func (r *rotator) patchAllNodes(ctx context.Context, patchFunc func(provider config.Provider) (config.Provider, error)) error {
+	for _, node := range r.controlPlaneNodes() {
+		if r.opts.DryRun {
+			r.opts.Printf("  - %s: skipped (dry-run)\n", node.InternalIP)
+
+			continue
+		}
+
+		if err := helpers.PatchNodeConfigWithStaticPodSecretsUpdate(
+			ctx, r.opts.TalosClient, node.InternalIP.String(), r.opts.EncoderOption,
+			patchFunc,
+		); err != nil {
+			return fmt.Errorf("error patching node %s: %w", node.InternalIP, err)
+		}
+
+		// wait for the API server to come back before moving to the next node
+		if err := helpers.VerifyKubernetesConnectivity(ctx, r.kubernetes, func(string, ...any) {}); err != nil {
+			return fmt.Errorf("error verifying connectivity after patching node %s: %w", node.InternalIP, err)
+		}
+
+		r.opts.Printf("  - %s: OK\n", node.InternalIP)
+	}
+
+	return nil
+}
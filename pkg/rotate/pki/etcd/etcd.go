@@ -0,0 +1,291 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package etcd implements safe etcd PKI rotation for the cluster.
+package etcd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/go-retry/retry"
+	"go.yaml.in/yaml/v4"
+
+	"github.com/siderolabs/talos/pkg/cluster"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate/rotatepatcher"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	secretsres "github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+	"github.com/siderolabs/talos/pkg/rotate/pki/internal/helpers"
+)
+
+// Options is the input to the etcd PKI rotation process.
+type Options struct {
+	// DryRun is the flag to enable dry-run mode.
+	//
+	// In dry-run mode, the rotation process will not make any changes to the cluster.
+	DryRun bool
+
+	// TalosClient is a Talos API client
+	TalosClient *client.Client
+	// ClusterInfo provides information about cluster topology.
+	ClusterInfo cluster.Info
+
+	// NewEtcdCA is the new CA for etcd.
+	NewEtcdCA *x509.PEMEncodedCertificateAndKey
+
+	// EncoderOption is the option for encoding machine configuration (while patching).
+	EncoderOption encoder.Option
+
+	// Printf is the function used to print messages.
+	Printf func(format string, args ...any)
+}
+
+type rotator struct {
+	opts Options
+
+	currentCA []byte
+}
+
+// Rotate rotates the etcd PKI.
+//
+// The etcd CA is only present on the control plane nodes, and the nodes are patched one by one,
+// waiting for etcd to be restarted and healthy before moving to the next node, so that the
+// etcd quorum is preserved through the rotation.
+//
+// The process overview:
+//   - fetch current information
+//   - verify etcd health with the existing PKI
+//   - add new etcd CA as accepted
+//   - verify etcd health
+//   - make new CA issuing, old CA is still accepted
+//   - verify etcd health with the new PKI
+//   - remove old CA
+//   - verify etcd health with the new PKI.
+func Rotate(ctx context.Context, opts Options) error {
+	r := rotator{
+		opts: opts,
+	}
+
+	return r.rotate(ctx)
+}
+
+func (r *rotator) rotate(ctx context.Context) error {
+	r.printIntro()
+
+	if err := r.fetchCurrentCA(ctx); err != nil {
+		return err
+	}
+
+	if err := r.printNewCA(); err != nil {
+		return err
+	}
+
+	if err := r.verifyHealth(ctx, "existing PKI"); err != nil {
+		return err
+	}
+
+	if err := r.addNewCAAccepted(ctx); err != nil {
+		return err
+	}
+
+	if err := r.verifyHealth(ctx, "new CA accepted"); err != nil {
+		return err
+	}
+
+	if err := r.swapCAs(ctx); err != nil {
+		return err
+	}
+
+	if err := r.verifyHealth(ctx, "new PKI"); err != nil {
+		return err
+	}
+
+	if err := r.dropOldCA(ctx); err != nil {
+		return err
+	}
+
+	return r.verifyHealth(ctx, "new PKI")
+}
+
+func (r *rotator) controlPlaneNodes() []cluster.NodeInfo {
+	return append(
+		r.opts.ClusterInfo.NodesByType(machine.TypeInit),
+		r.opts.ClusterInfo.NodesByType(machine.TypeControlPlane)...,
+	)
+}
+
+func (r *rotator) printIntro() {
+	r.opts.Printf("> Starting etcd PKI rotation, dry-run mode %v...\n", r.opts.DryRun)
+
+	r.opts.Printf("> Cluster topology:\n")
+
+	r.opts.Printf(
+		"  - control plane nodes: %q\n",
+		helpers.MapToInternalIP(r.controlPlaneNodes()),
+	)
+}
+
+func (r *rotator) fetchCurrentCA(ctx context.Context) error {
+	r.opts.Printf("> Current etcd CA:\n")
+
+	firstNode := r.controlPlaneNodes()[0]
+
+	etcdRoot, err := safe.StateGetByID[*secretsres.EtcdRoot](client.WithNode(ctx, firstNode.InternalIP.String()), r.opts.TalosClient.COSI, secretsres.EtcdRootID)
+	if err != nil {
+		return fmt.Errorf("error fetching current etcd CA: %w", err)
+	}
+
+	r.currentCA = etcdRoot.TypedSpec().EtcdCA.Crt
+
+	var b bytes.Buffer
+
+	if err = yaml.NewEncoder(&b).Encode(&x509.PEMEncodedCertificate{Crt: r.currentCA}); err != nil {
+		return fmt.Errorf("error encoding current etcd CA: %w", err)
+	}
+
+	for scanner := bufio.NewScanner(&b); scanner.Scan(); {
+		r.opts.Printf("  %s\n", scanner.Text())
+	}
+
+	return nil
+}
+
+func (r *rotator) printNewCA() error {
+	r.opts.Printf("> New etcd CA:\n")
+
+	var b bytes.Buffer
+
+	if err := yaml.NewEncoder(&b).Encode(r.opts.NewEtcdCA); err != nil {
+		return fmt.Errorf("error encoding new etcd CA: %w", err)
+	}
+
+	for scanner := bufio.NewScanner(&b); scanner.Scan(); {
+		r.opts.Printf("  %s\n", scanner.Text())
+	}
+
+	return nil
+}
+
+func (r *rotator) verifyHealth(ctx context.Context, label string) error {
+	r.opts.Printf("> Verifying etcd health with %s...\n", label)
+
+	if r.opts.DryRun {
+		r.opts.Printf(" - OK (dry-run mode)\n")
+
+		return nil
+	}
+
+	nodes := r.controlPlaneNodes()
+
+	return retry.Constant(3*time.Minute, retry.WithUnits(time.Second), retry.WithErrorLogging(true)).RetryWithContext(ctx,
+		func(ctx context.Context) error {
+			leaders := map[uint64]struct{}{}
+
+			for _, node := range nodes {
+				resp, err := r.opts.TalosClient.EtcdStatus(client.WithNode(ctx, node.InternalIP.String()))
+				if err != nil {
+					return retry.ExpectedErrorf("error getting etcd status on %s: %w", node.InternalIP, err)
+				}
+
+				for _, msg := range resp.Messages {
+					status := msg.GetMemberStatus()
+
+					if len(status.GetErrors()) > 0 {
+						return retry.ExpectedErrorf("etcd member on %s reports errors: %q", node.InternalIP, status.GetErrors())
+					}
+
+					if status.GetLeader() == 0 {
+						return retry.ExpectedErrorf("etcd member on %s has no leader", node.InternalIP)
+					}
+
+					leaders[status.GetLeader()] = struct{}{}
+				}
+			}
+
+			if len(leaders) != 1 {
+				return retry.ExpectedErrorf("etcd members disagree on the leader: %d leaders", len(leaders))
+			}
+
+			r.opts.Printf(" - OK (%d members healthy)\n", len(nodes))
+
+			return nil
+		})
+}
+
+func (r *rotator) addNewCAAccepted(ctx context.Context) error {
+	r.opts.Printf("> Adding new etcd CA as accepted...\n")
+
+	if err := r.patchAllNodes(ctx,
+		rotatepatcher.EtcdAddAcceptedCA(r.opts.NewEtcdCA.Crt),
+	); err != nil {
+		return fmt.Errorf("error patching all machine configs: %w", err)
+	}
+
+	return nil
+}
+
+func (r *rotator) swapCAs(ctx context.Context) error {
+	r.opts.Printf("> Making new etcd CA the issuing CA, old etcd CA the accepted CA...\n")
+
+	if err := r.patchAllNodes(ctx,
+		func(provider config.Provider) (config.Provider, error) {
+			provider, err := rotatepatcher.EtcdAddAcceptedCA(r.currentCA)(provider)
+			if err != nil {
+				return nil, err
+			}
+
+			provider, err = rotatepatcher.EtcdDeleteAcceptedCA(r.opts.NewEtcdCA.Crt)(provider)
+			if err != nil {
+				return nil, err
+			}
+
+			return rotatepatcher.EtcdSetCA(r.opts.NewEtcdCA)(provider)
+		}); err != nil {
+		return fmt.Errorf("error patching all machine configs: %w", err)
+	}
+
+	return nil
+}
+
+func (r *rotator) dropOldCA(ctx context.Context) error {
+	r.opts.Printf("> Removing old etcd CA from the accepted CAs...\n")
+
+	if err := r.patchAllNodes(
+		ctx,
+		rotatepatcher.EtcdDeleteAcceptedCA(r.currentCA),
+	); err != nil {
+		return fmt.Errorf("error patching all machine configs: %w", err)
+	}
+
+	return nil
+}
+
+func (r *rotator) patchAllNodes(ctx context.Context, patchFunc func(provider config.Provider) (config.Provider, error)) error {
+	for _, node := range r.controlPlaneNodes() {
+		if r.opts.DryRun {
+			r.opts.Printf("  - %s: skipped (dry-run)\n", node.InternalIP)
+
+			continue
+		}
+
+		if err := helpers.PatchNodeConfigWithServiceRestart(
+			ctx, r.opts.TalosClient, node.InternalIP.String(), "etcd", r.opts.EncoderOption,
+			patchFunc,
+		); err != nil {
+			return fmt.Errorf("error patching node %s: %w", node.InternalIP, err)
+		}
+
+		r.opts.Printf("  - %s: OK\n", node.InternalIP)
+	}
+
+	return nil
+}
@@ -7,7 +7,6 @@ package helpers
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"time"
 
@@ -52,9 +51,16 @@ func PatchNodeConfig(ctx context.Context, c *client.Client, node string, encoder
 }
 
 // PatchNodeConfigWithKubeletRestart patches the node config for the given node waiting for the kubelet to be restarted.
+func PatchNodeConfigWithKubeletRestart(ctx context.Context, c *client.Client, node string, encoderOpt encoder.Option, patchFunc func(config.Provider) (config.Provider, error)) error {
+	return PatchNodeConfigWithServiceRestart(ctx, c, node, "kubelet", encoderOpt, patchFunc)
+}
+
+// PatchNodeConfigWithServiceRestart patches the node config for the given node waiting for the service to be restarted.
 //
 //nolint:gocyclo,cyclop
-func PatchNodeConfigWithKubeletRestart(ctx context.Context, c *client.Client, node string, encoderOpt encoder.Option, patchFunc func(config.Provider) (config.Provider, error)) error {
+func PatchNodeConfigWithServiceRestart(
+	ctx context.Context, c *client.Client, node, serviceID string, encoderOpt encoder.Option, patchFunc func(config.Provider) (config.Provider, error),
+) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -62,7 +68,7 @@ func PatchNodeConfigWithKubeletRestart(ctx context.Context, c *client.Client, no
 
 	watchCh := make(chan safe.WrappedStateEvent[*v1alpha1res.Service])
 
-	if err := safe.StateWatch(ctx, c.COSI, resource.NewMetadata(v1alpha1res.NamespaceName, v1alpha1res.ServiceType, "kubelet", resource.VersionUndefined), watchCh); err != nil {
+	if err := safe.StateWatch(ctx, c.COSI, resource.NewMetadata(v1alpha1res.NamespaceName, v1alpha1res.ServiceType, serviceID, resource.VersionUndefined), watchCh); err != nil {
 		return fmt.Errorf("error watching service: %w", err)
 	}
 
@@ -84,14 +90,14 @@ func PatchNodeConfigWithKubeletRestart(ctx context.Context, c *client.Client, no
 	}
 
 	if !initialService.TypedSpec().Running || !initialService.TypedSpec().Healthy {
-		return errors.New("kubelet is not healthy")
+		return fmt.Errorf("%s is not healthy", serviceID)
 	}
 
 	if err = PatchNodeConfig(ctx, c, node, encoderOpt, patchFunc); err != nil {
 		return fmt.Errorf("error patching node config: %w", err)
 	}
 
-	// first, wait for kubelet to go down
+	// first, wait for the service to go down
 	for {
 		select {
 		case ev = <-watchCh:
@@ -104,7 +110,7 @@ func PatchNodeConfigWithKubeletRestart(ctx context.Context, c *client.Client, no
 		}
 	}
 
-	// now wait for kubelet to go up & healthy
+	// now wait for the service to go up & healthy
 	for {
 		select {
 		case ev = <-watchCh:
@@ -0,0 +1,93 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/siderolabs/go-retry/retry"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/siderolabs/talos/pkg/cluster"
+	taloskubernetes "github.com/siderolabs/talos/pkg/kubernetes"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+// VerifyKubernetesConnectivity verifies that the Kubernetes API is reachable and all nodes are ready.
+func VerifyKubernetesConnectivity(ctx context.Context, client *cluster.KubernetesClient, printf func(format string, args ...any)) error {
+	clientset, err := client.K8sClient(ctx)
+	if err != nil {
+		return fmt.Errorf("error building Kubernetes client: %w", err)
+	}
+
+	return retry.Constant(3*time.Minute, retry.WithUnits(time.Second), retry.WithErrorLogging(true)).RetryWithContext(ctx,
+		func(ctx context.Context) error {
+			nodes, err := clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+			if err != nil {
+				if taloskubernetes.IsRetryableError(err) {
+					return retry.ExpectedError(err)
+				}
+
+				return err
+			}
+
+			var notReadyNodes []string
+
+			for _, node := range nodes.Items {
+				for _, cond := range node.Status.Conditions {
+					if cond.Type == corev1.NodeReady {
+						if cond.Status != corev1.ConditionTrue {
+							notReadyNodes = append(notReadyNodes, node.Name)
+
+							break
+						}
+					}
+				}
+			}
+
+			if len(notReadyNodes) > 0 {
+				return retry.ExpectedErrorf("nodes not ready: %q", notReadyNodes)
+			}
+
+			printf(" - OK (%d nodes ready)\n", len(nodes.Items))
+
+			return nil
+		})
+}
+
+// PatchNodeConfigWithStaticPodSecretsUpdate patches the node config for the given node waiting for the control plane static pod secrets to be updated.
+func PatchNodeConfigWithStaticPodSecretsUpdate(ctx context.Context, c *client.Client, node string, encoderOpt encoder.Option, patchFunc func(config.Provider) (config.Provider, error)) error {
+	ctx = client.WithNode(ctx, node)
+
+	initialStatus, err := safe.StateGetByID[*k8s.SecretsStatus](ctx, c.COSI, k8s.StaticPodSecretsStaticPodID)
+	if err != nil {
+		return fmt.Errorf("error fetching static pod secrets status: %w", err)
+	}
+
+	if err = PatchNodeConfig(ctx, c, node, encoderOpt, patchFunc); err != nil {
+		return fmt.Errorf("error patching node config: %w", err)
+	}
+
+	return retry.Constant(3*time.Minute, retry.WithUnits(time.Second)).RetryWithContext(ctx,
+		func(ctx context.Context) error {
+			status, err := safe.StateGetByID[*k8s.SecretsStatus](ctx, c.COSI, k8s.StaticPodSecretsStaticPodID)
+			if err != nil {
+				return retry.ExpectedError(err)
+			}
+
+			if !status.TypedSpec().Ready || status.TypedSpec().Version == initialStatus.TypedSpec().Version {
+				return retry.ExpectedErrorf("static pod secrets are not updated yet")
+			}
+
+			return nil
+		})
+}
@@ -10,17 +10,12 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/siderolabs/crypto/x509"
-	"github.com/siderolabs/go-retry/retry"
 	"go.yaml.in/yaml/v4"
-	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/siderolabs/talos/pkg/cluster"
-	taloskubernetes "github.com/siderolabs/talos/pkg/kubernetes"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 	"github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
@@ -237,44 +232,7 @@ func (r *rotator) verifyConnectivity(ctx context.Context, client *cluster.Kubern
 		return nil
 	}
 
-	clientset, err := client.K8sClient(ctx)
-	if err != nil {
-		return fmt.Errorf("error building Kubernetes client: %w", err)
-	}
-
-	return retry.Constant(3*time.Minute, retry.WithUnits(time.Second), retry.WithErrorLogging(true)).RetryWithContext(ctx,
-		func(ctx context.Context) error {
-			nodes, err := clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
-			if err != nil {
-				if taloskubernetes.IsRetryableError(err) {
-					return retry.ExpectedError(err)
-				}
-
-				return err
-			}
-
-			var notReadyNodes []string
-
-			for _, node := range nodes.Items {
-				for _, cond := range node.Status.Conditions {
-					if cond.Type == corev1.NodeReady {
-						if cond.Status != corev1.ConditionTrue {
-							notReadyNodes = append(notReadyNodes, node.Name)
-
-							break
-						}
-					}
-				}
-			}
-
-			if len(notReadyNodes) > 0 {
-				return retry.ExpectedErrorf("nodes not ready: %q", notReadyNodes)
-			}
-
-			r.opts.Printf(" - OK (%d nodes ready)\n", len(nodes.Items))
-
-			return nil
-		})
+	return helpers.VerifyKubernetesConnectivity(ctx, client, r.opts.Printf)
 }
 
 func (r *rotator) addNewCAAccepted(ctx context.Context) error {
@@ -0,0 +1,328 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package serviceaccount implements safe Kubernetes service account signing key rotation for the cluster.
+package serviceaccount
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/siderolabs/crypto/x509"
+
+	"github.com/siderolabs/talos/pkg/cluster"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate/rotatepatcher"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	secretsres "github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+	"github.com/siderolabs/talos/pkg/rotate/pki/internal/helpers"
+)
+
+// Options is the input to the Kubernetes service account key rotation process.
+type Options struct {
+	// DryRun is the flag to enable dry-run mode.
+	//
+	// In dry-run mode, the rotation process will not make any changes to the cluster.
+	DryRun bool
+
+	// TalosClient is a Talos API client
+	TalosClient *client.Client
+	// ClusterInfo provides information about cluster topology.
+	ClusterInfo cluster.Info
+
+	// KubernetesEndpoint overrides the default Kubernetes API endpoint.
+	KubernetesEndpoint string
+
+	// NewServiceAccountKey is the new service account signing key.
+	NewServiceAccountKey *x509.PEMEncodedKey
+
+	// Overlap is the duration both old and new service account keys are accepted
+	// after the new key becomes the signing key.
+	//
+	// The overlap should be long enough for the workloads to refresh their service account tokens.
+	Overlap time.Duration
+
+	// EncoderOption is the option for encoding machine configuration (while patching).
+	EncoderOption encoder.Option
+
+	// Printf is the function used to print messages.
+	Printf func(format string, args ...any)
+}
+
+type rotator struct {
+	opts Options
+
+	currentPublicKey []byte
+	newPublicKey     []byte
+
+	kubernetes *cluster.KubernetesClient
+}
+
+// Rotate rotates the Kubernetes service account signing key.
+//
+// The service account key is only present on the control plane nodes, so only the control plane
+// nodes are patched. The legacy .cluster.serviceAccount field is migrated to the KubeServiceAccountConfig
+// document on the first patch.
+//
+// The process overview:
+//   - fetch current information
+//   - verify connectivity with the existing key
+//   - add new public key as accepted
+//   - make new key the signing key, old public key is still accepted
+//   - verify connectivity
+//   - wait for the overlap window, so that the workloads refresh their tokens
+//   - remove old public key
+//   - verify connectivity.
+func Rotate(ctx context.Context, opts Options) error {
+	r := rotator{
+		opts: opts,
+	}
+
+	defer func() {
+		if r.kubernetes != nil {
+			r.kubernetes.K8sClose() //nolint:errcheck
+		}
+	}()
+
+	return r.rotate(ctx)
+}
+
+func (r *rotator) rotate(ctx context.Context) error {
+	r.printIntro()
+
+	if err := r.fetchClient(ctx); err != nil {
+		return err
+	}
+
+	if err := r.fetchCurrentKey(ctx); err != nil {
+		return err
+	}
+
+	if err := r.printNewKey(); err != nil {
+		return err
+	}
+
+	if err := r.verifyConnectivity(ctx, "existing key"); err != nil {
+		return err
+	}
+
+	if err := r.addNewKeyAccepted(ctx); err != nil {
+		return err
+	}
+
+	if err := r.swapKeys(ctx); err != nil {
+		return err
+	}
+
+	if err := r.verifyConnectivity(ctx, "new key"); err != nil {
+		return err
+	}
+
+	if err := r.waitOverlap(ctx); err != nil {
+		return err
+	}
+
+	if err := r.dropOldKey(ctx); err != nil {
+		return err
+	}
+
+	return r.verifyConnectivity(ctx, "new key")
+}
+
+func (r *rotator) controlPlaneNodes() []cluster.NodeInfo {
+	return append(
+		r.opts.ClusterInfo.NodesByType(machine.TypeInit),
+		r.opts.ClusterInfo.NodesByType(machine.TypeControlPlane)...,
+	)
+}
+
+func (r *rotator) printIntro() {
+	r.opts.Printf("> Starting Kubernetes service account key rotation, dry-run mode %v...\n", r.opts.DryRun)
+
+	r.opts.Printf("> Cluster topology:\n")
+
+	r.opts.Printf(
+		"  - control plane nodes: %q\n",
+		helpers.MapToInternalIP(r.controlPlaneNodes()),
+	)
+}
+
+func (r *rotator) fetchClient(ctx context.Context) error {
+	r.opts.Printf("> Building Kubernetes client...\n")
+
+	r.kubernetes = &cluster.KubernetesClient{
+		ClientProvider: &cluster.ConfigClientProvider{
+			DefaultClient: r.opts.TalosClient,
+		},
+		ForceEndpoint: r.opts.KubernetesEndpoint,
+	}
+
+	_, err := r.kubernetes.K8sClient(client.WithNode(ctx, r.controlPlaneNodes()[0].InternalIP.String()))
+	if err != nil {
+		return fmt.Errorf("error fetching kubeconfig: %w", err)
+	}
+
+	return nil
+}
+
+func (r *rotator) fetchCurrentKey(ctx context.Context) error {
+	r.opts.Printf("> Current service account public key:\n")
+
+	firstNode := r.controlPlaneNodes()[0]
+
+	k8sRoot, err := safe.StateGetByID[*secretsres.KubernetesRoot](client.WithNode(ctx, firstNode.InternalIP.String()), r.opts.TalosClient.COSI, secretsres.KubernetesRootID)
+	if err != nil {
+		return fmt.Errorf("error fetching current service account key: %w", err)
+	}
+
+	r.currentPublicKey, err = publicKeyPEM(k8sRoot.TypedSpec().ServiceAccount)
+	if err != nil {
+		return fmt.Errorf("error decoding current service account key: %w", err)
+	}
+
+	r.printPEM(r.currentPublicKey)
+
+	return nil
+}
+
+func (r *rotator) printNewKey() error {
+	r.opts.Printf("> New service account public key:\n")
+
+	var err error
+
+	r.newPublicKey, err = publicKeyPEM(r.opts.NewServiceAccountKey)
+	if err != nil {
+		return fmt.Errorf("error decoding new service account key: %w", err)
+	}
+
+	r.printPEM(r.newPublicKey)
+
+	return nil
+}
+
+func (r *rotator) printPEM(pem []byte) {
+	for line := range strings.Lines(string(pem)) {
+		r.opts.Printf("  %s", line)
+	}
+}
+
+func (r *rotator) verifyConnectivity(ctx context.Context, label string) error {
+	r.opts.Printf("> Verifying connectivity with %s...\n", label)
+
+	if r.opts.DryRun {
+		r.opts.Printf(" - OK (dry-run mode)\n")
+
+		return nil
+	}
+
+	return helpers.VerifyKubernetesConnectivity(ctx, r.kubernetes, r.opts.Printf)
+}
+
+func (r *rotator) addNewKeyAccepted(ctx context.Context) error {
+	r.opts.Printf("> Adding new service account public key as accepted...\n")
+
+	if err := r.patchAllNodes(ctx,
+		rotatepatcher.K8sServiceAccountAddAcceptedKey(r.newPublicKey),
+	); err != nil {
+		return fmt.Errorf("error patching all machine configs: %w", err)
+	}
+
+	return nil
+}
+
+func (r *rotator) swapKeys(ctx context.Context) error {
+	r.opts.Printf("> Making new service account key the signing key, old public key the accepted key...\n")
+
+	if err := r.patchAllNodes(ctx,
+		func(provider config.Provider) (config.Provider, error) {
+			provider, err := rotatepatcher.K8sServiceAccountAddAcceptedKey(r.currentPublicKey)(provider)
+			if err != nil {
+				return nil, err
+			}
+
+			provider, err = rotatepatcher.K8sServiceAccountDeleteAcceptedKey(r.newPublicKey)(provider)
+			if err != nil {
+				return nil, err
+			}
+
+			return rotatepatcher.K8sServiceAccountSetKey(r.opts.NewServiceAccountKey)(provider)
+		}); err != nil {
+		return fmt.Errorf("error patching all machine configs: %w", err)
+	}
+
+	return nil
+}
+
+func (r *rotator) waitOverlap(ctx context.Context) error {
+	r.opts.Printf("> Waiting %s for the workloads to refresh service account tokens...\n", r.opts.Overlap)
+
+	if r.opts.DryRun {
+		r.opts.Printf(" - skipped (dry-run mode)\n")
+
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(r.opts.Overlap):
+	}
+
+	r.opts.Printf(" - OK\n")
+
+	return nil
+}
+
+func (r *rotator) dropOldKey(ctx context.Context) error {
+	r.opts.Printf("> Removing old service account public key from the accepted keys...\n")
+
+	if err := r.patchAllNodes(
+		ctx,
+		rotatepatcher.K8sServiceAccountDeleteAcceptedKey(r.currentPublicKey),
+	); err != nil {
+		return fmt.Errorf("error patching all machine configs: %w", err)
+	}
+
+	return nil
+}
+
+func (r *rotator) patchAllNodes(ctx context.Context, patchFunc func(provider config.Provider) (config.Provider, error)) error {
+	for _, node := range r.controlPlaneNodes() {
+		if r.opts.DryRun {
+			r.opts.Printf("  - %s: skipped (dry-run)\n", node.InternalIP)
+
+			continue
+		}
+
+		if err := helpers.PatchNodeConfigWithStaticPodSecretsUpdate(
+			ctx, r.opts.TalosClient, node.InternalIP.String(), r.opts.EncoderOption,
+			patchFunc,
+		); err != nil {
+			return fmt.Errorf("error patching node %s: %w", node.InternalIP, err)
+		}
+
+		// wait for the API server to come back before moving to the next node
+		if err := helpers.VerifyKubernetesConnectivity(ctx, r.kubernetes, func(string, ...any) {}); err != nil {
+			return fmt.Errorf("error verifying connectivity after patching node %s: %w", node.InternalIP, err)
+		}
+
+		r.opts.Printf("  - %s: OK\n", node.InternalIP)
+	}
+
+	return nil
+}
+
+func publicKeyPEM(key *x509.PEMEncodedKey) ([]byte, error) {
+	k, err := key.GetKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return k.GetPublicKeyPEM(), nil
+}